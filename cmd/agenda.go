@@ -0,0 +1,274 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// defaultAgendaLabel flags an item for the next meeting
+	defaultAgendaLabel = "discuss"
+
+	// defaultTimeboxFieldName is the project field with an item's time box
+	// when no timebox field is configured
+	defaultTimeboxFieldName = "Time Box"
+
+	// defaultAgendaCategory is the discussion category for --post discussion
+	defaultAgendaCategory = "General"
+)
+
+type agendaOptions struct {
+	label     string
+	iteration string
+	state     string
+	title     string
+	post      string
+	category  string
+	repo      string
+}
+
+// agendaClient defines the interface for API methods used by agenda.
+// This allows for easier testing with mock implementations.
+type agendaClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	GetProjectFields(projectID string) ([]api.ProjectField, error)
+	CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error)
+	CreateDiscussion(owner, repo, category, title, body string) (*api.Discussion, error)
+}
+
+func newAgendaCommand() *cobra.Command {
+	opts := &agendaOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "agenda",
+		Short: "Build a meeting agenda from flagged items",
+		Long: `Compile the project items flagged for discussion into a Markdown agenda,
+with a link, owners, and time box for each item and the total time.
+
+Items are flagged with a label ("discuss" by default). The time box is
+the project's "Time Box" field (map another with fields.timebox in
+.gh-pmu.yml): minutes as a number, or a duration such as 15m or 1h.
+
+The agenda is printed; with --post it is also posted as a new issue, or
+as a discussion in --category, in the first configured repository or
+--repo.`,
+		Example: `  # Agenda for this iteration's planning meeting
+  gh pmu agenda --label discuss --iteration current
+
+  # Post it as a discussion before the meeting
+  gh pmu agenda --iteration current --post discussion --category "Meeting Notes"
+
+  # Post it as an issue
+  gh pmu agenda --post issue --title "Planning 2026-03-10"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runAgendaWithDeps(cmd, opts, cfg, api.NewClient(), time.Now())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.label, "label", "l", defaultAgendaLabel, "Label that flags items for the agenda")
+	cmd.Flags().StringVar(&opts.iteration, "iteration", "", "Only items in an iteration: current, next, or an iteration title")
+	cmd.Flags().StringVarP(&opts.state, "state", "s", "open", "Filter by state: open, closed, or all")
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Agenda title (default: Agenda, with the iteration or date)")
+	cmd.Flags().StringVar(&opts.post, "post", "", "Also post the agenda: issue or discussion")
+	cmd.Flags().StringVar(&opts.category, "category", defaultAgendaCategory, "Discussion category for --post discussion")
+	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository to post in (owner/repo); defaults to the first configured")
+
+	return cmd
+}
+
+// agendaItem is one topic on the agenda
+type agendaItem struct {
+	ref     string
+	title   string
+	url     string
+	owners  []string
+	timebox time.Duration
+}
+
+// runAgendaWithDeps is the testable implementation of agenda
+func runAgendaWithDeps(cmd *cobra.Command, opts *agendaOptions, cfg *config.Config, client agendaClient, now time.Time) error {
+	post := strings.ToLower(opts.post)
+	if post != "" && post != "issue" && post != "discussion" {
+		return fmt.Errorf("invalid --post %q: must be issue or discussion", opts.post)
+	}
+	stateFilter, err := resolveStateFilter(opts.state, nil)
+	if err != nil {
+		return err
+	}
+
+	// Resolve where to post before doing any work
+	owner, repo := "", ""
+	if post != "" {
+		target := opts.repo
+		if target == "" {
+			if len(cfg.Repositories) == 0 {
+				return fmt.Errorf("no repository specified and none configured")
+			}
+			target = cfg.Repositories[0]
+		}
+		owner, repo = splitRepository(target)
+		if owner == "" || repo == "" {
+			return fmt.Errorf("invalid repository format: %s (expected owner/repo)", target)
+		}
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	items = filterByLabel(items, opts.label)
+	items = filterByState(items, stateFilter)
+
+	title := opts.title
+	if opts.iteration != "" {
+		fields, err := client.GetProjectFields(project.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get project fields: %w", err)
+		}
+		fieldName, iteration, err := resolveIteration(fields, cfg.GetFieldName("iteration"), opts.iteration, now)
+		if err != nil {
+			return err
+		}
+		items = filterByFieldValue(items, fieldName, iteration.Title)
+		if title == "" {
+			title = "Agenda: " + iteration.Title
+		}
+	}
+	if title == "" {
+		title = "Agenda: " + now.Format("2006-01-02")
+	}
+
+	timeboxField := cfg.FieldNameOr("timebox", defaultTimeboxFieldName)
+	var agenda []agendaItem
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		timebox, _ := parseTimebox(getFieldValue(item, timeboxField))
+		agenda = append(agenda, agendaItem{
+			ref:     itemKey(item),
+			title:   item.Issue.Title,
+			url:     item.Issue.URL,
+			owners:  actorLogins(item.Issue.Assignees),
+			timebox: timebox,
+		})
+	}
+
+	body := buildAgenda(agenda, opts.label)
+	fmt.Fprintf(cmd.OutOrStdout(), "# %s\n\n%s", title, body)
+
+	switch post {
+	case "issue":
+		issue, err := client.CreateIssueWithOptions(owner, repo, title, body, nil, nil, "")
+		if err != nil {
+			return fmt.Errorf("failed to create issue: %w", err)
+		}
+		cmd.Printf("\n✓ Posted agenda as issue #%d\n  %s\n", issue.Number, issue.URL)
+	case "discussion":
+		discussion, err := client.CreateDiscussion(owner, repo, opts.category, title, body)
+		if err != nil {
+			return err
+		}
+		cmd.Printf("\n✓ Posted agenda as discussion #%d in %s\n  %s\n", discussion.Number, discussion.Category, discussion.URL)
+	}
+	return nil
+}
+
+// buildAgenda renders the agenda items as a numbered Markdown list with the
+// total time. Items without a time box are counted but not timed.
+func buildAgenda(items []agendaItem, label string) string {
+	if len(items) == 0 {
+		return fmt.Sprintf("_Nothing to discuss: no items are labeled %q._\n", label)
+	}
+
+	var b strings.Builder
+	var total time.Duration
+	untimed := 0
+	for i, item := range items {
+		owners := "unassigned"
+		if len(item.owners) > 0 {
+			owners = "@" + strings.Join(item.owners, ", @")
+		}
+		timebox := ""
+		if item.timebox > 0 {
+			timebox = " · " + formatTimebox(item.timebox)
+			total += item.timebox
+		} else {
+			untimed++
+		}
+
+		link := item.title
+		if item.url != "" {
+			link = fmt.Sprintf("[%s](%s)", item.title, item.url)
+		}
+		fmt.Fprintf(&b, "%d. **%s** (%s) — %s%s\n", i+1, link, item.ref, owners, timebox)
+	}
+
+	noun := "items"
+	if len(items) == 1 {
+		noun = "item"
+	}
+	fmt.Fprintf(&b, "\n_%d %s, %s", len(items), noun, formatTimebox(total))
+	if untimed > 0 {
+		fmt.Fprintf(&b, " plus %d without a time box", untimed)
+	}
+	b.WriteString("_\n")
+	return b.String()
+}
+
+// parseTimebox reads a time box: minutes as a number ("15"), with a minute
+// suffix ("15 min"), or a duration ("1h30m")
+func parseTimebox(value string) (time.Duration, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return 0, false
+	}
+	for _, suffix := range []string{"minutes", "minute", "mins", "min"} {
+		if strings.HasSuffix(value, suffix) {
+			value = strings.TrimSpace(strings.TrimSuffix(value, suffix))
+			break
+		}
+	}
+	if minutes, err := strconv.ParseFloat(value, 64); err == nil {
+		if minutes <= 0 {
+			return 0, false
+		}
+		return time.Duration(minutes * float64(time.Minute)), true
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, false
+	}
+	return d, true
+}
+
+// formatTimebox formats a time box in minutes, or hours and minutes from an
+// hour up
+func formatTimebox(d time.Duration) string {
+	minutes := int(d.Round(time.Minute) / time.Minute)
+	if minutes < 60 {
+		return fmt.Sprintf("%d min", minutes)
+	}
+	if minutes%60 == 0 {
+		return fmt.Sprintf("%d h", minutes/60)
+	}
+	return fmt.Sprintf("%d h %d min", minutes/60, minutes%60)
+}
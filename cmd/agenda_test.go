@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// mockAgendaClient implements agendaClient interface for testing
+type mockAgendaClient struct {
+	items          []api.ProjectItem
+	issueTitle     string
+	issueBody      string
+	discussionRepo string
+	category       string
+}
+
+func (m *mockAgendaClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockAgendaClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func (m *mockAgendaClient) GetProjectFields(projectID string) ([]api.ProjectField, error) {
+	return []api.ProjectField{{Name: "Sprint", DataType: "ITERATION", Iterations: []api.Iteration{
+		{Title: "Sprint 4", StartDate: "2026-02-24", Duration: 14},
+		{Title: "Sprint 5", StartDate: "2026-03-10", Duration: 14},
+	}}}, nil
+}
+
+func (m *mockAgendaClient) CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error) {
+	m.issueTitle, m.issueBody = title, body
+	return &api.Issue{Number: 90, URL: "https://github.com/owner/repo/issues/90"}, nil
+}
+
+func (m *mockAgendaClient) CreateDiscussion(owner, repo, category, title, body string) (*api.Discussion, error) {
+	m.discussionRepo, m.category = owner+"/"+repo, category
+	return &api.Discussion{Number: 7, Category: "Meeting Notes", URL: "https://github.com/owner/repo/discussions/7"}, nil
+}
+
+var agendaTestNow = time.Date(2026, 3, 12, 9, 0, 0, 0, time.UTC)
+
+func newAgendaTestClient() *mockAgendaClient {
+	item := func(number int, title, state, sprint, timebox string, labels []string, owners ...string) api.ProjectItem {
+		issue := &api.Issue{
+			Number:     number,
+			Title:      title,
+			State:      state,
+			URL:        "https://github.com/owner/repo/issues/" + title,
+			Repository: api.Repository{Owner: "owner", Name: "repo"},
+		}
+		for _, l := range labels {
+			issue.Labels = append(issue.Labels, api.Label{Name: l})
+		}
+		for _, o := range owners {
+			issue.Assignees = append(issue.Assignees, api.Actor{Login: o})
+		}
+		values := []api.FieldValue{{Field: "Sprint", Value: sprint}}
+		if timebox != "" {
+			values = append(values, api.FieldValue{Field: "Time Box", Value: timebox})
+		}
+		return api.ProjectItem{Issue: issue, FieldValues: values}
+	}
+	discuss := []string{"discuss"}
+	return &mockAgendaClient{items: []api.ProjectItem{
+		item(1, "Release plan", "OPEN", "Sprint 5", "15", discuss, "alice"),
+		item(2, "Flaky CI", "OPEN", "Sprint 5", "1h", []string{"Discuss", "bug"}, "bob", "carol"),
+		item(3, "Naming", "OPEN", "Sprint 5", "", discuss),
+		item(4, "Old topic", "CLOSED", "Sprint 5", "10", discuss),
+		item(5, "Next sprint", "OPEN", "Sprint 4", "10", discuss),
+		item(6, "Not flagged", "OPEN", "Sprint 5", "10", nil),
+	}}
+}
+
+func TestRunAgenda_CurrentIteration(t *testing.T) {
+	cmd, buf := newTestCmd()
+	opts := &agendaOptions{label: "discuss", iteration: "current", state: "open"}
+	if err := runAgendaWithDeps(cmd, opts, newTestConfig(), newAgendaTestClient(), agendaTestNow); err != nil {
+		t.Fatalf("runAgendaWithDeps() error = %v", err)
+	}
+
+	want := `# Agenda: Sprint 5
+
+1. **[Release plan](https://github.com/owner/repo/issues/Release plan)** (owner/repo#1) — @alice · 15 min
+2. **[Flaky CI](https://github.com/owner/repo/issues/Flaky CI)** (owner/repo#2) — @bob, @carol · 1 h
+3. **[Naming](https://github.com/owner/repo/issues/Naming)** (owner/repo#3) — unassigned
+
+_3 items, 1 h 15 min plus 1 without a time box_
+`
+	if buf.String() != want {
+		t.Errorf("Unexpected agenda:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRunAgenda_PostIssue(t *testing.T) {
+	client := newAgendaTestClient()
+	cmd, buf := newTestCmd()
+	opts := &agendaOptions{label: "discuss", state: "open", post: "issue"}
+	if err := runAgendaWithDeps(cmd, opts, newTestConfig(), client, agendaTestNow); err != nil {
+		t.Fatalf("runAgendaWithDeps() error = %v", err)
+	}
+	if client.issueTitle != "Agenda: 2026-03-12" || !strings.Contains(client.issueBody, "Next sprint") || strings.HasPrefix(client.issueBody, "#") {
+		t.Errorf("Unexpected issue %q:\n%s", client.issueTitle, client.issueBody)
+	}
+	if !strings.Contains(buf.String(), "Posted agenda as issue #90") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunAgenda_PostDiscussion(t *testing.T) {
+	client := newAgendaTestClient()
+	cmd, _ := newTestCmd()
+	opts := &agendaOptions{label: "discuss", state: "open", post: "Discussion", category: "meeting-notes", repo: "owner/meetings"}
+	if err := runAgendaWithDeps(cmd, opts, newTestConfig(), client, agendaTestNow); err != nil {
+		t.Fatalf("runAgendaWithDeps() error = %v", err)
+	}
+	if client.discussionRepo != "owner/meetings" || client.category != "meeting-notes" {
+		t.Errorf("Unexpected discussion target %s in %s", client.discussionRepo, client.category)
+	}
+}
+
+func TestRunAgenda_ConfiguredTimeboxAndEmpty(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{"timebox": {Field: "Minutes"}}
+	cmd, buf := newTestCmd()
+	if err := runAgendaWithDeps(cmd, &agendaOptions{label: "retro", state: "open"}, cfg, newAgendaTestClient(), agendaTestNow); err != nil {
+		t.Fatalf("runAgendaWithDeps() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `no items are labeled "retro"`) {
+		t.Errorf("Expected an empty agenda, got:\n%s", buf.String())
+	}
+
+	if err := runAgendaWithDeps(cmd, &agendaOptions{label: "discuss", state: "open", post: "email"}, cfg, newAgendaTestClient(), agendaTestNow); err == nil {
+		t.Error("Expected an error for an invalid --post")
+	}
+}
+
+func TestParseTimebox(t *testing.T) {
+	tests := map[string]time.Duration{
+		"15":       15 * time.Minute,
+		"7.5":      7*time.Minute + 30*time.Second,
+		"20 min":   20 * time.Minute,
+		"1h30m":    90 * time.Minute,
+		"45m":      45 * time.Minute,
+		"":         0,
+		"soon":     0,
+		"0":        0,
+		"10 mins ": 10 * time.Minute,
+	}
+	for value, want := range tests {
+		if got, _ := parseTimebox(value); got != want {
+			t.Errorf("parseTimebox(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/scooter-indie/gh-pmu/internal/annotate"
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/spf13/cobra"
+)
+
+type annotateOptions struct {
+	check bool
+}
+
+func newAnnotateCommand() *cobra.Command {
+	opts := &annotateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "annotate <file>...",
+		Short: "Annotate issue references in text files with status and title",
+		Long: `Scan text files for references to project issues (#123, owner/repo#123,
+or an issue URL) and rewrite each one with the issue's status badge,
+status, and title:
+
+  #123          ->  #123 ✅ Done — Fix login
+  owner/repo#7  ->  owner/repo#7 🔄 In Progress — Add SSO
+
+Badges: ✅ done, 🔄 in progress, 👀 in review, ⛔ blocked, ⚪ no status,
+and ⬜ any other status. A bare #123 refers to the first configured
+repository. References in fenced code blocks and inline code, and issues
+that are not in the project, are left alone. Running annotate again
+updates the annotations in place, so design docs and plans stay current.
+
+Files are rewritten in place; use - to read stdin and write stdout.
+With --check nothing is written, and the command fails if any
+annotation is missing or out of date (useful in CI).`,
+		Example: `  gh pmu annotate docs/plan.md
+
+  # Fail when annotations are stale
+  gh pmu annotate --check docs/*.md
+
+  # Filter text
+  cat notes.md | gh pmu annotate -`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+
+			return runAnnotateWithDeps(cmd, args, opts, cfg, projectItemsSource(cfg, api.NewClient()))
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.check, "check", false, "Report stale annotations and fail instead of writing")
+
+	return cmd
+}
+
+// runAnnotateWithDeps is the testable implementation of annotate
+func runAnnotateWithDeps(cmd *cobra.Command, args []string, opts *annotateOptions, cfg *config.Config, client projectItemsReader) error {
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	issues := make(map[string]annotate.Issue)
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		key := itemKey(item)
+		issues[key] = annotate.Issue{Title: item.Issue.Title, Status: getFieldValue(item, "Status")}
+	}
+	lookup := func(owner, repo string, number int) (annotate.Issue, bool) {
+		issue, ok := issues[localstore.Key(owner+"/"+repo, number)]
+		return issue, ok
+	}
+
+	defaultRepo := ""
+	if len(cfg.Repositories) > 0 {
+		defaultRepo = cfg.Repositories[0]
+	}
+
+	stale := 0
+	for _, path := range args {
+		var data []byte
+		if path == "-" {
+			data, err = io.ReadAll(cmd.InOrStdin())
+		} else {
+			data, err = os.ReadFile(path)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		result := annotate.Text(string(data), defaultRepo, lookup)
+		switch {
+		case path == "-" && !opts.check:
+			cmd.Print(result.Text)
+		case opts.check:
+			if result.Changed > 0 {
+				stale++
+				cmd.Printf("%s: %d of %d references need annotating\n", path, result.Changed, result.Refs)
+			}
+		case result.Changed > 0:
+			info, err := os.Stat(path)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", path, err)
+			}
+			if err := os.WriteFile(path, []byte(result.Text), info.Mode().Perm()); err != nil {
+				return fmt.Errorf("failed to write %s: %w", path, err)
+			}
+			cmd.Printf("%s: annotated %d of %d references\n", path, result.Changed, result.Refs)
+		default:
+			cmd.Printf("%s: %d references up to date\n", path, result.Refs)
+		}
+	}
+
+	if stale > 0 {
+		return fmt.Errorf("%d of %d files have stale annotations; run 'gh pmu annotate' to update them", stale, len(args))
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunAnnotate_RewritesFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plan.md")
+	if err := os.WriteFile(path, []byte("- #1\n- owner/other#3\n- #9\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	client := newDaemonTestClient()
+
+	checkCmd, checkBuf := newTestCmd()
+	err := runAnnotateWithDeps(checkCmd, []string{path}, &annotateOptions{check: true}, newTestConfig(), client)
+	if err == nil || !strings.Contains(checkBuf.String(), "2 of 2 references need annotating") {
+		t.Errorf("Expected --check to fail, got %v:\n%s", err, checkBuf.String())
+	}
+
+	cmd, buf := newTestCmd()
+	if err := runAnnotateWithDeps(cmd, []string{path}, &annotateOptions{}, newTestConfig(), client); err != nil {
+		t.Fatalf("annotate failed: %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	want := "- #1 🔄 In Progress — Issue item-1\n- owner/other#3 🔄 In Progress — Issue item-3\n- #9\n"
+	if string(data) != want {
+		t.Errorf("Unexpected file:\n%s", data)
+	}
+	if !strings.Contains(buf.String(), "annotated 2 of 2 references") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+
+	checkCmd, _ = newTestCmd()
+	if err := runAnnotateWithDeps(checkCmd, []string{path}, &annotateOptions{check: true}, newTestConfig(), client); err != nil {
+		t.Errorf("Expected --check to pass after annotating, got %v", err)
+	}
+}
+
+func TestRunAnnotate_Stdin(t *testing.T) {
+	cmd, buf := newTestCmd()
+	cmd.SetIn(strings.NewReader("Shipped in #2.\n"))
+	if err := runAnnotateWithDeps(cmd, []string{"-"}, &annotateOptions{}, newTestConfig(), newDaemonTestClient()); err != nil {
+		t.Fatalf("annotate failed: %v", err)
+	}
+	if buf.String() != "Shipped in #2 ✅ Done — Issue item-2.\n" {
+		t.Errorf("Unexpected output %q", buf.String())
+	}
+}
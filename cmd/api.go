@@ -0,0 +1,301 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newAPICommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "api",
+		Short: "Make authenticated GitHub API requests with project context",
+		Long:  `Make authenticated GitHub API requests using IDs resolved from the project configuration.`,
+	}
+
+	cmd.AddCommand(newAPIGraphQLCommand())
+
+	return cmd
+}
+
+type apiGraphQLOptions struct {
+	queryFile string
+	query     string
+	vars      []string
+}
+
+// graphqlClient defines the interface for API methods used by the graphql passthrough.
+// This allows for easier testing with mock implementations.
+type graphqlClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	ExecuteGraphQL(query string, variables map[string]interface{}) (json.RawMessage, error)
+}
+
+func newAPIGraphQLCommand() *cobra.Command {
+	opts := &apiGraphQLOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "graphql",
+		Short: "Run a GraphQL query with project IDs injected as variables",
+		Long: `Run a custom GraphQL query or mutation against the GitHub API.
+
+Variables given with --var are resolved against the project configuration
+before the request is sent:
+
+  #42, owner/repo#42   Project item ID of the issue
+  issue:#42            Node ID of the issue itself
+  field:<name>         Project field ID (aliases from config are honored)
+  option:<field>/<val> Single-select option ID (value aliases are honored)
+  project              Project node ID
+
+Any other value is passed through, with integers, true/false and null
+converted to their JSON types. If the query references $projectId and no
+such variable is given, the project node ID is injected automatically.`,
+		Example: `  # Query an item using its issue number
+  gh pmu api graphql --query-file item.graphql --var item=#42
+
+  # Set a single-select field without looking up any IDs
+  gh pmu api graphql --query-file set-field.graphql \
+    --var item=#42 --var field=field:status --var option=option:status/done`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAPIGraphQL(cmd, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.queryFile, "query-file", "f", "", "Path to a file containing the GraphQL document")
+	cmd.Flags().StringVarP(&opts.query, "query", "q", "", "GraphQL document given inline")
+	cmd.Flags().StringArrayVar(&opts.vars, "var", nil, "Variable in key=value format (can be specified multiple times)")
+
+	return cmd
+}
+
+func runAPIGraphQL(cmd *cobra.Command, opts *apiGraphQLOptions) error {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient()
+
+	return runAPIGraphQLWithDeps(cmd, opts, cfg, client)
+}
+
+// runAPIGraphQLWithDeps is the testable implementation of runAPIGraphQL
+func runAPIGraphQLWithDeps(cmd *cobra.Command, opts *apiGraphQLOptions, cfg *config.Config, client graphqlClient) error {
+	if opts.queryFile == "" && opts.query == "" {
+		return fmt.Errorf("one of --query-file or --query is required")
+	}
+	if opts.queryFile != "" && opts.query != "" {
+		return fmt.Errorf("--query-file and --query cannot be used together")
+	}
+
+	query := opts.query
+	if opts.queryFile != "" {
+		data, err := os.ReadFile(opts.queryFile)
+		if err != nil {
+			return fmt.Errorf("failed to read query file %s: %w", opts.queryFile, err)
+		}
+		query = string(data)
+	}
+
+	resolver := &graphqlVarResolver{cfg: cfg, client: client}
+
+	variables := make(map[string]interface{})
+	for _, v := range opts.vars {
+		key, value, ok := strings.Cut(v, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return fmt.Errorf("invalid --var %q: expected key=value", v)
+		}
+
+		resolved, err := resolver.resolve(value)
+		if err != nil {
+			return fmt.Errorf("failed to resolve --var %s: %w", key, err)
+		}
+		variables[key] = resolved
+	}
+
+	if _, ok := variables["projectId"]; !ok && strings.Contains(query, "$projectId") {
+		projectID, err := resolver.projectID()
+		if err != nil {
+			return err
+		}
+		variables["projectId"] = projectID
+	}
+
+	data, err := client.ExecuteGraphQL(query, variables)
+	if err != nil {
+		return err
+	}
+
+	var out bytes.Buffer
+	if err := json.Indent(&out, data, "", "  "); err != nil {
+		return fmt.Errorf("failed to format response: %w", err)
+	}
+	out.WriteByte('\n')
+
+	_, err = cmd.OutOrStdout().Write(out.Bytes())
+	return err
+}
+
+// graphqlVarResolver turns symbolic --var values into node IDs, caching
+// lookups so each API call happens at most once per invocation
+type graphqlVarResolver struct {
+	cfg     *config.Config
+	client  graphqlClient
+	project string
+	items   []api.ProjectItem
+	loaded  bool
+}
+
+// resolve converts a single --var value
+func (r *graphqlVarResolver) resolve(value string) (interface{}, error) {
+	switch {
+	case value == "project":
+		return r.projectID()
+	case strings.HasPrefix(value, "issue:"):
+		item, err := r.findItem(strings.TrimPrefix(value, "issue:"))
+		if err != nil {
+			return nil, err
+		}
+		return item.Issue.ID, nil
+	case strings.HasPrefix(value, "field:"):
+		field, err := r.findField(strings.TrimPrefix(value, "field:"))
+		if err != nil {
+			return nil, err
+		}
+		return field.ID, nil
+	case strings.HasPrefix(value, "option:"):
+		return r.findOption(strings.TrimPrefix(value, "option:"))
+	case strings.Contains(value, "#"):
+		if _, _, _, err := parseIssueReference(value); err == nil {
+			item, err := r.findItem(value)
+			if err != nil {
+				return nil, err
+			}
+			return item.ID, nil
+		}
+	}
+
+	return parseGraphQLLiteral(value), nil
+}
+
+// projectID returns the project node ID, preferring cached metadata
+func (r *graphqlVarResolver) projectID() (string, error) {
+	if r.project != "" {
+		return r.project, nil
+	}
+
+	if r.cfg.Metadata != nil && r.cfg.Metadata.Project.ID != "" {
+		r.project = r.cfg.Metadata.Project.ID
+		return r.project, nil
+	}
+
+	project, err := r.client.GetProject(r.cfg.Project.Owner, r.cfg.Project.Number)
+	if err != nil {
+		return "", fmt.Errorf("failed to get project: %w", err)
+	}
+	r.project = project.ID
+	return r.project, nil
+}
+
+// findItem locates the project item for an issue reference
+func (r *graphqlVarResolver) findItem(ref string) (*api.ProjectItem, error) {
+	owner, repo, number, err := parseIssueReference(ref)
+	if err != nil {
+		return nil, err
+	}
+	if owner == "" || repo == "" {
+		if len(r.cfg.Repositories) == 0 {
+			return nil, fmt.Errorf("no repository specified and none configured")
+		}
+		owner, repo = splitRepository(r.cfg.Repositories[0])
+	}
+
+	if !r.loaded {
+		projectID, err := r.projectID()
+		if err != nil {
+			return nil, err
+		}
+		items, err := r.client.GetProjectItems(projectID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project items: %w", err)
+		}
+		r.items = items
+		r.loaded = true
+	}
+
+	for i := range r.items {
+		issue := r.items[i].Issue
+		if issue != nil && issue.Number == number &&
+			strings.EqualFold(issue.Repository.Owner, owner) && strings.EqualFold(issue.Repository.Name, repo) {
+			return &r.items[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("issue %s/%s#%d is not in the project", owner, repo, number)
+}
+
+// findField locates a field in the cached metadata by alias or name
+func (r *graphqlVarResolver) findField(name string) (*config.FieldMetadata, error) {
+	if r.cfg.Metadata == nil || len(r.cfg.Metadata.Fields) == 0 {
+		return nil, fmt.Errorf("no field metadata in config\nRun 'gh pmu init' to refresh project metadata")
+	}
+
+	fieldName := r.cfg.GetFieldName(name)
+	for i := range r.cfg.Metadata.Fields {
+		if strings.EqualFold(r.cfg.Metadata.Fields[i].Name, fieldName) {
+			return &r.cfg.Metadata.Fields[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("field %q not found in project metadata", fieldName)
+}
+
+// findOption resolves "<field>/<value>" to a single-select option ID
+func (r *graphqlVarResolver) findOption(spec string) (string, error) {
+	fieldKey, value, ok := strings.Cut(spec, "/")
+	if !ok || fieldKey == "" || value == "" {
+		return "", fmt.Errorf("invalid option %q: expected option:<field>/<value>", spec)
+	}
+
+	field, err := r.findField(fieldKey)
+	if err != nil {
+		return "", err
+	}
+
+	resolved := r.cfg.ResolveFieldValue(fieldKey, value)
+	for _, opt := range field.Options {
+		if strings.EqualFold(opt.Name, resolved) {
+			return opt.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("option %q not found for field %q", resolved, field.Name)
+}
+
+// parseGraphQLLiteral converts integers, booleans and null to their JSON types
+func parseGraphQLLiteral(value string) interface{} {
+	switch value {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+
+	if n, err := strconv.Atoi(value); err == nil {
+		return n
+	}
+
+	return value
+}
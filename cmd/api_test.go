@@ -0,0 +1,303 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// mockGraphQLPassthroughClient implements graphqlClient interface for testing
+type mockGraphQLPassthroughClient struct {
+	project         *api.Project
+	projectError    error
+	items           []api.ProjectItem
+	itemsError      error
+	getItemsCalls   int
+	getProjectCalls int
+	execQuery       string
+	execVariables   map[string]interface{}
+	execResponse    string
+	execError       error
+}
+
+func (m *mockGraphQLPassthroughClient) GetProject(owner string, number int) (*api.Project, error) {
+	m.getProjectCalls++
+	return m.project, m.projectError
+}
+
+func (m *mockGraphQLPassthroughClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	m.getItemsCalls++
+	return m.items, m.itemsError
+}
+
+func (m *mockGraphQLPassthroughClient) ExecuteGraphQL(query string, variables map[string]interface{}) (json.RawMessage, error) {
+	m.execQuery = query
+	m.execVariables = variables
+	if m.execError != nil {
+		return nil, m.execError
+	}
+	return json.RawMessage(m.execResponse), nil
+}
+
+func newGraphQLTestConfig() *config.Config {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{
+		"status": {Field: "Status", Values: map[string]string{"done": "Done"}},
+	}
+	cfg.Metadata = &config.Metadata{
+		Project: config.ProjectMetadata{ID: "PVT_meta"},
+		Fields: []config.FieldMetadata{
+			{
+				Name:     "Status",
+				ID:       "PVTSSF_status",
+				DataType: "SINGLE_SELECT",
+				Options: []config.OptionMetadata{
+					{Name: "Backlog", ID: "opt-backlog"},
+					{Name: "Done", ID: "opt-done"},
+				},
+			},
+		},
+	}
+	return cfg
+}
+
+func newGraphQLTestClient() *mockGraphQLPassthroughClient {
+	return &mockGraphQLPassthroughClient{
+		project: &api.Project{ID: "PVT_api"},
+		items: []api.ProjectItem{
+			{ID: "PVTI_42", Issue: &api.Issue{ID: "I_42", Number: 42, Repository: api.Repository{Owner: "owner", Name: "repo"}}},
+			{ID: "PVTI_other", Issue: &api.Issue{ID: "I_other", Number: 42, Repository: api.Repository{Owner: "owner", Name: "other"}}},
+		},
+		execResponse: `{"node":{"id":"PVTI_42"}}`,
+	}
+}
+
+func TestAPIGraphQLCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"api", "graphql"})
+	if err != nil {
+		t.Fatalf("api graphql command not found: %v", err)
+	}
+
+	for _, name := range []string{"query-file", "query", "var"} {
+		if sub.Flags().Lookup(name) == nil {
+			t.Errorf("Expected --%s flag to exist", name)
+		}
+	}
+}
+
+func TestRunAPIGraphQL_ResolvesVariables(t *testing.T) {
+	client := newGraphQLTestClient()
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+
+	opts := &apiGraphQLOptions{
+		query: "mutation($projectId: ID!, $item: ID!, $field: ID!, $option: String!) { x }",
+		vars: []string{
+			"item=#42",
+			"issue=issue:owner/other#42",
+			"field=field:status",
+			"option=option:status/done",
+			"count=3",
+			"flag=true",
+			"name=plain text",
+		},
+	}
+
+	if err := runAPIGraphQLWithDeps(cmd, opts, newGraphQLTestConfig(), client); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := map[string]interface{}{
+		"projectId": "PVT_meta",
+		"item":      "PVTI_42",
+		"issue":     "I_other",
+		"field":     "PVTSSF_status",
+		"option":    "opt-done",
+		"count":     3,
+		"flag":      true,
+		"name":      "plain text",
+	}
+	for k, v := range want {
+		if client.execVariables[k] != v {
+			t.Errorf("variable %s = %v, want %v", k, client.execVariables[k], v)
+		}
+	}
+
+	if client.getItemsCalls != 1 {
+		t.Errorf("Expected project items to be fetched once, got %d", client.getItemsCalls)
+	}
+	if client.getProjectCalls != 0 {
+		t.Errorf("Expected project ID to come from metadata, got %d GetProject calls", client.getProjectCalls)
+	}
+	if !strings.Contains(buf.String(), `"id": "PVTI_42"`) {
+		t.Errorf("Expected indented JSON output, got: %s", buf.String())
+	}
+}
+
+func TestRunAPIGraphQL_ProjectIDFallsBackToAPI(t *testing.T) {
+	client := newGraphQLTestClient()
+	cfg := newGraphQLTestConfig()
+	cfg.Metadata = nil
+
+	cmd := &cobra.Command{}
+	cmd.SetOut(new(bytes.Buffer))
+
+	opts := &apiGraphQLOptions{query: "query($projectId: ID!) { node(id: $projectId) { id } }"}
+	if err := runAPIGraphQLWithDeps(cmd, opts, cfg, client); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if client.execVariables["projectId"] != "PVT_api" {
+		t.Errorf("Expected projectId from API, got %v", client.execVariables["projectId"])
+	}
+}
+
+func TestRunAPIGraphQL_DoesNotInjectUnreferencedProjectID(t *testing.T) {
+	client := newGraphQLTestClient()
+	cmd := &cobra.Command{}
+	cmd.SetOut(new(bytes.Buffer))
+
+	opts := &apiGraphQLOptions{query: "query { viewer { login } }"}
+	if err := runAPIGraphQLWithDeps(cmd, opts, newGraphQLTestConfig(), client); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, ok := client.execVariables["projectId"]; ok {
+		t.Error("Expected projectId not to be injected")
+	}
+}
+
+func TestRunAPIGraphQL_QueryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "q.graphql")
+	if err := os.WriteFile(path, []byte("query { viewer { login } }"), 0644); err != nil {
+		t.Fatalf("failed to write query file: %v", err)
+	}
+
+	client := newGraphQLTestClient()
+	cmd := &cobra.Command{}
+	cmd.SetOut(new(bytes.Buffer))
+
+	if err := runAPIGraphQLWithDeps(cmd, &apiGraphQLOptions{queryFile: path}, newGraphQLTestConfig(), client); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.execQuery != "query { viewer { login } }" {
+		t.Errorf("Expected query from file, got %q", client.execQuery)
+	}
+}
+
+func TestRunAPIGraphQL_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *apiGraphQLOptions
+		client  func() *mockGraphQLPassthroughClient
+		wantErr string
+	}{
+		{
+			name:    "no query",
+			opts:    &apiGraphQLOptions{},
+			wantErr: "one of --query-file or --query is required",
+		},
+		{
+			name:    "both query sources",
+			opts:    &apiGraphQLOptions{query: "q", queryFile: "f"},
+			wantErr: "cannot be used together",
+		},
+		{
+			name:    "missing query file",
+			opts:    &apiGraphQLOptions{queryFile: filepath.Join(os.TempDir(), "does-not-exist.graphql")},
+			wantErr: "failed to read query file",
+		},
+		{
+			name:    "malformed var",
+			opts:    &apiGraphQLOptions{query: "q", vars: []string{"novalue"}},
+			wantErr: "expected key=value",
+		},
+		{
+			name:    "issue not in project",
+			opts:    &apiGraphQLOptions{query: "q", vars: []string{"item=#99"}},
+			wantErr: "not in the project",
+		},
+		{
+			name:    "unknown field",
+			opts:    &apiGraphQLOptions{query: "q", vars: []string{"f=field:estimate"}},
+			wantErr: "not found in project metadata",
+		},
+		{
+			name:    "unknown option",
+			opts:    &apiGraphQLOptions{query: "q", vars: []string{"o=option:status/blocked"}},
+			wantErr: "option \"blocked\" not found",
+		},
+		{
+			name:    "malformed option",
+			opts:    &apiGraphQLOptions{query: "q", vars: []string{"o=option:status"}},
+			wantErr: "expected option:<field>/<value>",
+		},
+		{
+			name: "items error",
+			opts: &apiGraphQLOptions{query: "q", vars: []string{"item=#42"}},
+			client: func() *mockGraphQLPassthroughClient {
+				c := newGraphQLTestClient()
+				c.itemsError = errors.New("boom")
+				return c
+			},
+			wantErr: "failed to get project items",
+		},
+		{
+			name: "request error",
+			opts: &apiGraphQLOptions{query: "q"},
+			client: func() *mockGraphQLPassthroughClient {
+				c := newGraphQLTestClient()
+				c.execError = errors.New("GraphQL request failed: bad")
+				return c
+			},
+			wantErr: "GraphQL request failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newGraphQLTestClient()
+			if tt.client != nil {
+				client = tt.client()
+			}
+			cmd := &cobra.Command{}
+			cmd.SetOut(new(bytes.Buffer))
+
+			err := runAPIGraphQLWithDeps(cmd, tt.opts, newGraphQLTestConfig(), client)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseGraphQLLiteral(t *testing.T) {
+	tests := []struct {
+		input string
+		want  interface{}
+	}{
+		{"42", 42},
+		{"-1", -1},
+		{"true", true},
+		{"false", false},
+		{"null", nil},
+		{"hello", "hello"},
+		{"1.5", "1.5"},
+	}
+
+	for _, tt := range tests {
+		if got := parseGraphQLLiteral(tt.input); got != tt.want {
+			t.Errorf("parseGraphQLLiteral(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
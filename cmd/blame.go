@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/journal"
+	"github.com/spf13/cobra"
+)
+
+type blameOptions struct {
+	field string
+	json  bool
+}
+
+// blameClient defines the interface for API methods used by blame.
+// This allows for easier testing with mock implementations.
+type blameClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	GetIssueProjectHistory(owner, repo string, number int) ([]api.ProjectEvent, error)
+}
+
+// Where a blamed change was found
+const (
+	blameSourceTimeline = "timeline" // A GitHub timeline event, with actor and previous value
+	blameSourceJournal  = "journal"  // A local 'gh pmu move', with previous value
+	blameSourceProject  = "project"  // Only GitHub's last update time for the value
+)
+
+// blameJournalWindow is how far apart a journaled move and GitHub's
+// timestamp can be and still be the same change
+const blameJournalWindow = 2 * time.Minute
+
+// blameChange is one known change to a field
+type blameChange struct {
+	At     string `json:"at"`
+	Actor  string `json:"actor,omitempty"` // Empty when not recorded
+	From   string `json:"from"`            // Only meaningful when the source records it
+	To     string `json:"to"`
+	Source string `json:"source"`
+}
+
+// blameResult is the history of one field on one issue
+type blameResult struct {
+	Issue   string        `json:"issue"`
+	Field   string        `json:"field"`
+	Value   string        `json:"value"`
+	Last    *blameChange  `json:"last"`
+	Earlier []blameChange `json:"earlier"` // Newest first
+}
+
+func newBlameCommand() *cobra.Command {
+	opts := &blameOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "blame <issue> --field <field>",
+		Short: "Show who last changed a project field on an issue",
+		Long: `Show who last changed a project field on an issue, when, and what the
+value was before.
+
+GitHub records who changed Status and from what in the issue timeline.
+For other fields it only records when the value was last set, so blame
+fills in the previous value from the local journal of 'gh pmu move'
+when the change was made from this machine. Otherwise the actor and
+previous value are reported as unknown.
+
+The field may be a name from fields in .gh-pmu.yml or a project field
+name.`,
+		Example: `  gh pmu blame 42 --field priority
+  gh pmu blame owner/repo#42 --field status --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			path, err := journal.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+			if err != nil {
+				return err
+			}
+			return runBlameWithDeps(cmd, args, opts, cfg, api.NewClient(), path)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.field, "field", "", "Project field to blame (required)")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+	_ = cmd.MarkFlagRequired("field")
+
+	return cmd
+}
+
+// runBlameWithDeps is the testable implementation of blame
+func runBlameWithDeps(cmd *cobra.Command, args []string, opts *blameOptions, cfg *config.Config, client blameClient, journalPath string) error {
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	owner, repo, number, _ := parseIssueReference(key)
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	item, err := client.GetIssueProjectItem(owner, repo, number, project.ID)
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		return fmt.Errorf("%s is not in the project", key)
+	}
+
+	events, err := client.GetIssueProjectHistory(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get history: %w", err)
+	}
+	j, err := journal.Load(journalPath)
+	if err != nil {
+		return err
+	}
+
+	field := cfg.GetFieldName(opts.field)
+	// Keep the project's spelling of the field name
+	for _, fv := range item.FieldValues {
+		if strings.EqualFold(fv.Field, field) {
+			field = fv.Field
+			break
+		}
+	}
+
+	result := &blameResult{
+		Issue:   key,
+		Field:   field,
+		Value:   getFieldValue(*item, field),
+		Earlier: []blameChange{},
+	}
+	changes := blameChanges(filterProjectHistory(events, project.Number), j, key, field)
+	if len(changes) > 0 {
+		result.Last = &changes[0]
+		result.Earlier = append(result.Earlier, changes[1:]...)
+	}
+
+	if opts.json {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(result)
+	}
+	writeBlame(cmd, result)
+	return nil
+}
+
+// blameChanges merges what GitHub and the move journal know about changes
+// to field on the issue, newest first. A journaled move that GitHub also
+// recorded is kept only where it adds the previous value.
+func blameChanges(history []api.ProjectEvent, j *journal.Journal, key, field string) []blameChange {
+	var timeline []blameChange
+	var lastSet *blameChange
+	for _, e := range history {
+		if !strings.EqualFold(e.Field, field) {
+			continue
+		}
+		switch e.Type {
+		case api.ProjectEventStatusChanged:
+			timeline = append(timeline, blameChange{At: e.CreatedAt, Actor: e.Actor, From: e.From, To: e.To, Source: blameSourceTimeline})
+		case api.ProjectEventFieldUpdated:
+			lastSet = &blameChange{At: e.CreatedAt, To: e.To, Source: blameSourceProject}
+		}
+	}
+
+	changes := timeline
+	for _, entry := range j.Entries {
+		for _, c := range entry.Changes {
+			if c.Issue != key {
+				continue
+			}
+			for _, fc := range c.Fields {
+				if !strings.EqualFold(fc.Field, field) {
+					continue
+				}
+				moved := blameChange{At: entry.At, From: fc.Old, To: fc.New, Source: blameSourceJournal}
+				if !blameRecorded(timeline, moved) {
+					changes = append(changes, moved)
+				}
+			}
+		}
+	}
+	if lastSet != nil && !blameRecorded(changes, *lastSet) {
+		changes = append(changes, *lastSet)
+	}
+
+	sort.SliceStable(changes, func(i, k int) bool {
+		return blameTime(changes[i].At).After(blameTime(changes[k].At))
+	})
+	return changes
+}
+
+// blameRecorded reports whether c, to the same value at about the same
+// time, is already among changes
+func blameRecorded(changes []blameChange, c blameChange) bool {
+	at := blameTime(c.At)
+	for _, known := range changes {
+		d := blameTime(known.At).Sub(at)
+		if known.To == c.To && d <= blameJournalWindow && d >= -blameJournalWindow {
+			return true
+		}
+	}
+	return false
+}
+
+// blameTime parses an RFC 3339 timestamp; unparseable ones sort oldest
+func blameTime(ts string) time.Time {
+	t, _ := time.Parse(time.RFC3339, ts)
+	return t
+}
+
+// describeBlameChange renders who changed a field, from what, for display
+func describeBlameChange(c blameChange) string {
+	from := c.From
+	if from == "" {
+		from = "(none)"
+	}
+	switch c.Source {
+	case blameSourceTimeline:
+		actor := "an unknown user"
+		if c.Actor != "" {
+			actor = "@" + c.Actor
+		}
+		return fmt.Sprintf("%s → %s by %s", from, c.To, actor)
+	case blameSourceJournal:
+		return fmt.Sprintf("%s → %s by you, with gh pmu move", from, c.To)
+	default:
+		return fmt.Sprintf("set to %s; GitHub does not record by whom or the previous value", c.To)
+	}
+}
+
+// writeBlame prints a blame result
+func writeBlame(cmd *cobra.Command, r *blameResult) {
+	value := r.Value
+	if value == "" {
+		value = "(none)"
+	}
+	cmd.Printf("%s %s: %s\n\n", r.Issue, r.Field, value)
+
+	if r.Last == nil {
+		cmd.Printf("No recorded changes to %s\n", r.Field)
+		return
+	}
+	cmd.Printf("Last changed %s: %s\n", formatHistoryTime(r.Last.At), describeBlameChange(*r.Last))
+
+	if len(r.Earlier) > 0 {
+		cmd.Println()
+		cmd.Println("Earlier:")
+		for _, c := range r.Earlier {
+			cmd.Printf("  %s  %s\n", formatHistoryTime(c.At), describeBlameChange(c))
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/journal"
+)
+
+// mockBlameClient implements blameClient interface for testing
+type mockBlameClient struct {
+	item   *api.ProjectItem
+	events []api.ProjectEvent
+}
+
+func (m *mockBlameClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1", Number: number}, nil
+}
+
+func (m *mockBlameClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	return m.item, nil
+}
+
+func (m *mockBlameClient) GetIssueProjectHistory(owner, repo string, number int) ([]api.ProjectEvent, error) {
+	return m.events, nil
+}
+
+// saveBlameJournal writes a journal with one move of owner/repo#42
+func saveBlameJournal(t *testing.T, at string, fields ...journal.FieldChange) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "journal.json")
+	entry := journal.Entry{Summary: "moved", Changes: []journal.Change{{Issue: "owner/repo#42", Fields: fields}}}
+	j := &journal.Journal{}
+	now, _ := time.Parse(time.RFC3339, at)
+	j.Record(entry, now)
+	if err := j.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestBlameChanges(t *testing.T) {
+	history := []api.ProjectEvent{
+		{Type: api.ProjectEventStatusChanged, Field: "Status", From: "Todo", To: "In Progress", Actor: "bob", CreatedAt: "2026-10-01T10:00:00Z"},
+		{Type: api.ProjectEventStatusChanged, Field: "Status", From: "In Progress", To: "Done", Actor: "alice", CreatedAt: "2026-10-05T10:00:00Z"},
+		{Type: api.ProjectEventFieldUpdated, Field: "Priority", To: "P0", CreatedAt: "2026-10-14T09:12:30Z"},
+	}
+	j := &journal.Journal{Entries: []journal.Entry{
+		{At: "2026-10-02T08:00:00Z", Changes: []journal.Change{{Issue: "owner/repo#42", Fields: []journal.FieldChange{{Field: "Priority", Old: "P2", New: "P1"}}}}},
+		{At: "2026-10-05T10:00:20Z", Changes: []journal.Change{{Issue: "owner/repo#42", Fields: []journal.FieldChange{{Field: "Status", Old: "In Progress", New: "Done"}}}}},
+		{At: "2026-10-14T09:12:00Z", Changes: []journal.Change{
+			{Issue: "owner/repo#7", Fields: []journal.FieldChange{{Field: "Priority", Old: "P2", New: "P0"}}},
+			{Issue: "owner/repo#42", Fields: []journal.FieldChange{{Field: "Priority", Old: "P1", New: "P0"}}},
+		}},
+	}}
+
+	// The journaled move is the change GitHub last saw, so it supplies the previous value
+	changes := blameChanges(history, j, "owner/repo#42", "priority")
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 priority changes, got %+v", changes)
+	}
+	if changes[0].Source != blameSourceJournal || changes[0].From != "P1" || changes[0].To != "P0" {
+		t.Errorf("Unexpected last change: %+v", changes[0])
+	}
+	if changes[1].From != "P2" || changes[1].To != "P1" {
+		t.Errorf("Unexpected earlier change: %+v", changes[1])
+	}
+
+	// The timeline already has the journaled status move, with its actor
+	changes = blameChanges(history, j, "owner/repo#42", "Status")
+	if len(changes) != 2 || changes[0].Actor != "alice" || changes[0].Source != blameSourceTimeline || changes[1].Actor != "bob" {
+		t.Errorf("Unexpected status changes: %+v", changes)
+	}
+
+	// Without the journal only GitHub's update time is known
+	changes = blameChanges(history, &journal.Journal{}, "owner/repo#42", "Priority")
+	if len(changes) != 1 || changes[0].Source != blameSourceProject || changes[0].At != "2026-10-14T09:12:30Z" {
+		t.Errorf("Unexpected changes without a journal: %+v", changes)
+	}
+}
+
+func TestRunBlame(t *testing.T) {
+	client := &mockBlameClient{
+		item: &api.ProjectItem{ID: "item-1", FieldValues: []api.FieldValue{
+			{Field: "Status", Value: "Done"},
+			{Field: "Priority", Value: "P0"},
+		}},
+		events: []api.ProjectEvent{
+			{Type: api.ProjectEventStatusChanged, ProjectNumber: 1, Field: "Status", From: "Todo", To: "In Progress", Actor: "bob", CreatedAt: "2026-10-01T10:00:00Z"},
+			{Type: api.ProjectEventStatusChanged, ProjectNumber: 1, Field: "Status", From: "In Progress", To: "Done", Actor: "alice", CreatedAt: "2026-10-05T10:00:00Z"},
+			{Type: api.ProjectEventStatusChanged, ProjectNumber: 9, Field: "Status", To: "Done", Actor: "carol", CreatedAt: "2026-10-06T10:00:00Z"},
+			{Type: api.ProjectEventFieldUpdated, ProjectNumber: 1, Field: "Priority", To: "P0", CreatedAt: "2026-10-14T09:12:30Z"},
+		},
+	}
+	cfg := newTestConfig()
+
+	t.Run("status from the timeline", func(t *testing.T) {
+		cmd, buf := newTestCmd()
+		path := filepath.Join(t.TempDir(), "journal.json")
+		if err := runBlameWithDeps(cmd, []string{"42"}, &blameOptions{field: "status"}, cfg, client, path); err != nil {
+			t.Fatalf("runBlameWithDeps() error = %v", err)
+		}
+		want := `owner/repo#42 Status: Done
+
+Last changed 2026-10-05 10:00: In Progress → Done by @alice
+
+Earlier:
+  2026-10-01 10:00  Todo → In Progress by @bob
+`
+		if buf.String() != want {
+			t.Errorf("Output =\n%s\nwant\n%s", buf.String(), want)
+		}
+	})
+
+	t.Run("field without a journal", func(t *testing.T) {
+		cmd, buf := newTestCmd()
+		path := filepath.Join(t.TempDir(), "journal.json")
+		if err := runBlameWithDeps(cmd, []string{"42"}, &blameOptions{field: "priority"}, cfg, client, path); err != nil {
+			t.Fatalf("runBlameWithDeps() error = %v", err)
+		}
+		if !strings.Contains(buf.String(), "Last changed 2026-10-14 09:12: set to P0; GitHub does not record by whom or the previous value") {
+			t.Errorf("Unexpected output:\n%s", buf.String())
+		}
+	})
+
+	t.Run("field from the journal as JSON", func(t *testing.T) {
+		cmd, buf := newTestCmd()
+		path := saveBlameJournal(t, "2026-10-14T09:12:00Z", journal.FieldChange{Field: "Priority", Old: "P2", New: "P0"})
+		if err := runBlameWithDeps(cmd, []string{"42"}, &blameOptions{field: "Priority", json: true}, cfg, client, path); err != nil {
+			t.Fatalf("runBlameWithDeps() error = %v", err)
+		}
+		var result blameResult
+		if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+			t.Fatalf("Invalid JSON: %v\n%s", err, buf.String())
+		}
+		if result.Value != "P0" || result.Last == nil || result.Last.From != "P2" || result.Last.Source != blameSourceJournal || len(result.Earlier) != 0 {
+			t.Errorf("Unexpected result: %+v", result)
+		}
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		cmd, buf := newTestCmd()
+		path := filepath.Join(t.TempDir(), "journal.json")
+		if err := runBlameWithDeps(cmd, []string{"42"}, &blameOptions{field: "Estimate"}, cfg, client, path); err != nil {
+			t.Fatalf("runBlameWithDeps() error = %v", err)
+		}
+		if buf.String() != "owner/repo#42 Estimate: (none)\n\nNo recorded changes to Estimate\n" {
+			t.Errorf("Unexpected output:\n%s", buf.String())
+		}
+	})
+
+	t.Run("not in project", func(t *testing.T) {
+		cmd, _ := newTestCmd()
+		err := runBlameWithDeps(cmd, []string{"42"}, &blameOptions{field: "status"}, cfg, &mockBlameClient{}, "")
+		if err == nil || !strings.Contains(err.Error(), "owner/repo#42 is not in the project") {
+			t.Errorf("Expected a not in project error, got %v", err)
+		}
+	})
+}
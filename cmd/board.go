@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/board"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/scooter-indie/gh-pmu/internal/notes"
+	"github.com/spf13/cobra"
+)
+
+type boardOptions struct {
+	screenshot string
+	maxCards   int
+}
+
+// boardClient defines the interface for API methods used by board.
+// This allows for easier testing with mock implementations.
+type boardClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+}
+
+func newBoardCommand() *cobra.Command {
+	opts := &boardOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "board",
+		Short: "Show the project board by status",
+		Long: `Show the project's issues grouped into a column per status, in the
+order of the status field's options. Cards for issues with private
+notes from 'gh pmu note add' show how many notes they have.
+
+With --screenshot, the board is rendered headlessly to an SVG image
+instead, for slide decks and status reports. PNG output is not supported;
+convert the SVG with a tool such as rsvg-convert.`,
+		Example: `  gh pmu board
+
+  # Save the board as an image
+  gh pmu board --screenshot board.svg
+
+  # Draw at most 5 cards per column
+  gh pmu board --screenshot board.svg --max-cards 5`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+
+			return runBoardWithDeps(cmd, opts, cfg, projectItemsSource(cfg, api.NewClient()))
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.screenshot, "screenshot", "", "Render the board to an SVG file")
+	cmd.Flags().IntVar(&opts.maxCards, "max-cards", 10, "Maximum cards per column (0 for all)")
+
+	return cmd
+}
+
+// runBoardWithDeps is the testable implementation of board
+func runBoardWithDeps(cmd *cobra.Command, opts *boardOptions, cfg *config.Config, client boardClient) error {
+	if opts.maxCards < 0 {
+		return fmt.Errorf("--max-cards must not be negative")
+	}
+	if opts.screenshot != "" {
+		switch strings.ToLower(filepath.Ext(opts.screenshot)) {
+		case ".svg":
+		case ".png":
+			return fmt.Errorf("PNG screenshots are not supported; write an .svg file and convert it (e.g. rsvg-convert board.svg -o board.png)")
+		default:
+			return fmt.Errorf("--screenshot must be an .svg file, got %s", opts.screenshot)
+		}
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var filter *api.ProjectItemsFilter
+	if len(cfg.Repositories) > 0 {
+		filter = &api.ProjectItemsFilter{Repository: cfg.Repositories[0]}
+	}
+	items, err := client.GetProjectItems(project.ID, filter)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	statusField := cfg.GetFieldName("status")
+	b := board.Build(project.Title, items, statusField, priorityFieldName(cfg), fieldOptionOrder(cfg, statusField))
+
+	// Local notes are marked on the cards
+	markBoardNotes(b, loadProjectNotes(cfg))
+
+	if opts.screenshot != "" {
+		f, err := os.Create(opts.screenshot)
+		if err != nil {
+			return fmt.Errorf("failed to create screenshot: %w", err)
+		}
+		if err := b.WriteSVG(f, board.SVGOptions{MaxCards: opts.maxCards}); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to write screenshot: %w", err)
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("failed to write screenshot: %w", err)
+		}
+		cmd.Printf("✓ Saved board to %s\n", opts.screenshot)
+		return nil
+	}
+
+	outputBoardText(cmd, b, opts.maxCards)
+	return nil
+}
+
+// fieldOptionOrder returns a single-select field's options from the cached
+// metadata, in project order
+func fieldOptionOrder(cfg *config.Config, fieldName string) []string {
+	if cfg.Metadata == nil {
+		return nil
+	}
+	var order []string
+	for _, f := range cfg.Metadata.Fields {
+		if strings.EqualFold(f.Name, fieldName) {
+			for _, opt := range f.Options {
+				order = append(order, opt.Name)
+			}
+		}
+	}
+	return order
+}
+
+// markBoardNotes sets each card's note count from store
+func markBoardNotes(b *board.Board, store *notes.Store) {
+	for _, c := range b.Columns {
+		for i := range c.Cards {
+			c.Cards[i].Notes = len(store.List(localstore.Key(c.Cards[i].Repository, c.Cards[i].Number)))
+		}
+	}
+}
+
+// outputBoardText prints each column and its cards
+func outputBoardText(cmd *cobra.Command, b *board.Board, maxCards int) {
+	for i, c := range b.Columns {
+		if i > 0 {
+			cmd.Println()
+		}
+		cmd.Printf("%s (%d)\n", c.Name, len(c.Cards))
+
+		cards := c.Cards
+		if maxCards > 0 && len(cards) > maxCards {
+			cards = cards[:maxCards]
+		}
+		for _, card := range cards {
+			line := fmt.Sprintf("  #%d %s", card.Number, card.Title)
+			if card.Priority != "" {
+				line += fmt.Sprintf(" [%s]", card.Priority)
+			}
+			if len(card.Assignees) > 0 {
+				line += " @" + strings.Join(card.Assignees, " @")
+			}
+			if card.Notes > 0 {
+				line += fmt.Sprintf(" (%d note(s))", card.Notes)
+			}
+			cmd.Println(line)
+		}
+		if hidden := len(c.Cards) - len(cards); hidden > 0 {
+			cmd.Printf("  +%d more\n", hidden)
+		}
+	}
+}
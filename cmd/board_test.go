@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/board"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/notes"
+)
+
+// mockBoardClient implements boardClient interface for testing
+type mockBoardClient struct {
+	items []api.ProjectItem
+}
+
+func (m *mockBoardClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1", Title: "Roadmap"}, nil
+}
+
+func (m *mockBoardClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func newBoardTestSetup() (*config.Config, *mockBoardClient) {
+	cfg := newTestConfig()
+	cfg.Metadata = &config.Metadata{Fields: []config.FieldMetadata{
+		{Name: "Status", DataType: "SINGLE_SELECT", Options: []config.OptionMetadata{{Name: "Todo"}, {Name: "In Progress"}, {Name: "Done"}}},
+	}}
+
+	item := func(number int, title, status string) api.ProjectItem {
+		return api.ProjectItem{
+			Issue:       &api.Issue{Number: number, Title: title, Repository: api.Repository{Owner: "owner", Name: "repo"}},
+			FieldValues: []api.FieldValue{{Field: "Status", Value: status}, {Field: "Priority", Value: "P1"}},
+		}
+	}
+	return cfg, &mockBoardClient{items: []api.ProjectItem{
+		item(1, "Login", "In Progress"),
+		item(2, "Search", "In Progress"),
+		item(3, "Docs", "Done"),
+	}}
+}
+
+func TestBoardCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"board"})
+	if err != nil || sub.Name() != "board" {
+		t.Fatalf("board command not found: %v", err)
+	}
+	for _, flag := range []string{"screenshot", "max-cards"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag to exist", flag)
+		}
+	}
+}
+
+func TestRunBoard_Text(t *testing.T) {
+	cfg, client := newBoardTestSetup()
+	cmd, buf := newTestCmd()
+
+	if err := runBoardWithDeps(cmd, &boardOptions{maxCards: 1}, cfg, client); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := "Todo (0)\n\nIn Progress (2)\n  #1 Login [P1]\n  +1 more\n\nDone (1)\n  #3 Docs [P1]\n"
+	if buf.String() != want {
+		t.Errorf("Unexpected board:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRunBoard_Screenshot(t *testing.T) {
+	cfg, client := newBoardTestSetup()
+	path := filepath.Join(t.TempDir(), "board.svg")
+
+	cmd, buf := newTestCmd()
+	if err := runBoardWithDeps(cmd, &boardOptions{screenshot: path}, cfg, client); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Saved board to") {
+		t.Errorf("Expected confirmation, got %q", buf.String())
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected the screenshot to be written: %v", err)
+	}
+	if !strings.HasPrefix(string(data), "<svg") || !strings.Contains(string(data), "Roadmap") {
+		t.Errorf("Expected an SVG of the board, got:\n%s", data)
+	}
+
+	for _, name := range []string{"board.png", "board.pdf"} {
+		err := runBoardWithDeps(cmd, &boardOptions{screenshot: name}, cfg, client)
+		if err == nil {
+			t.Errorf("Expected %s to be rejected", name)
+		}
+	}
+}
+
+func TestMarkBoardNotes(t *testing.T) {
+	cfg, client := newBoardTestSetup()
+	store := &notes.Store{}
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	store.Add("owner/repo#2", "Waiting on design", now)
+	store.Add("owner/repo#2", "Design done", now)
+
+	b := board.Build("Roadmap", client.items, "Status", "Priority", fieldOptionOrder(cfg, "Status"))
+	markBoardNotes(b, store)
+
+	cmd, buf := newTestCmd()
+	outputBoardText(cmd, b, 0)
+	want := "Todo (0)\n\nIn Progress (2)\n  #1 Login [P1]\n  #2 Search [P1] (2 note(s))\n\nDone (1)\n  #3 Docs [P1]\n"
+	if buf.String() != want {
+		t.Errorf("Unexpected board:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,306 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+type commitMsgOptions struct {
+	commitType string
+	hook       string
+	force      bool
+}
+
+// commitMsgClient defines the API methods used by commit-msg
+type commitMsgClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+}
+
+// commitMsgHookMarker identifies a prepare-commit-msg hook written by gh-pmu
+const commitMsgHookMarker = "# Installed by gh pmu commit-msg --hook install"
+
+// commitMsgHookScript is the prepare-commit-msg hook. It never blocks a
+// commit: without gh-pmu, a config, or a focused issue it does nothing.
+const commitMsgHookScript = `#!/bin/sh
+` + commitMsgHookMarker + `
+# Adds a "Refs:" trailer for the issue set with 'gh pmu focus'.
+command -v gh >/dev/null 2>&1 || exit 0
+gh pmu commit-msg --hook run "$@" || true
+`
+
+// labelCommitTypes maps issue labels to conventional commit types
+var labelCommitTypes = []struct {
+	label      string
+	commitType string
+}{
+	{"bug", "fix"},
+	{"documentation", "docs"},
+	{"docs", "docs"},
+	{"refactor", "refactor"},
+	{"test", "test"},
+	{"chore", "chore"},
+	{"performance", "perf"},
+}
+
+func newCommitMsgCommand() *cobra.Command {
+	opts := &commitMsgOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "commit-msg [summary]",
+		Short: "Build commit messages that reference the focused issue",
+		Long: `Print a conventional commit message for the issue set with 'gh pmu focus'.
+
+With a summary, prints "<type>: <summary> (#42)". Without one, the
+focused issue's title is used. The type defaults to one matching the
+issue's labels (bug -> fix, documentation -> docs, ...) and to feat
+otherwise; --type overrides it.
+
+--hook install writes a prepare-commit-msg hook that adds a "Refs: #42"
+trailer for the focused issue to commit messages given with -m, -F, or a
+template, and --hook uninstall removes it. The hook does nothing when no
+issue is focused, and skips merges, squashes, and amended commits.`,
+		Example: `  gh pmu focus 42
+  git commit -m "$(gh pmu commit-msg "add dark mode toggle")"
+  gh pmu commit-msg --type fix
+
+  # Reference the focused issue from every commit
+  gh pmu commit-msg --hook install`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCommitMsg(cmd, args, opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.commitType, "type", "", "Conventional commit type (default: from the issue's labels, or feat)")
+	cmd.Flags().StringVar(&opts.hook, "hook", "", "Manage the prepare-commit-msg hook: install or uninstall")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Replace an existing prepare-commit-msg hook")
+
+	return cmd
+}
+
+func runCommitMsg(cmd *cobra.Command, args []string, opts *commitMsgOptions) error {
+	switch opts.hook {
+	case "":
+	case "install", "uninstall":
+		if len(args) > 0 {
+			return fmt.Errorf("--hook %s does not take arguments", opts.hook)
+		}
+		hooksDir, err := gitHooksDir()
+		if err != nil {
+			return err
+		}
+		if opts.hook == "install" {
+			return installCommitMsgHook(cmd, hooksDir, opts.force)
+		}
+		return uninstallCommitMsgHook(cmd, hooksDir)
+	case "run":
+		// Called from the hook; failures must not block the commit
+		if len(args) == 0 {
+			return nil
+		}
+		cfg, path, err := loadFocusConfig()
+		if err != nil {
+			return nil
+		}
+		source := ""
+		if len(args) > 1 {
+			source = args[1]
+		}
+		return runCommitMsgHook(args[0], source, cfg, path)
+	default:
+		return fmt.Errorf("invalid --hook %q: must be install or uninstall", opts.hook)
+	}
+
+	cfg, path, err := loadFocusConfig()
+	if err != nil {
+		return err
+	}
+
+	focused, err := focusedIssueArgs(path)
+	if err != nil {
+		return err
+	}
+
+	return runCommitMsgWithDeps(cmd, args, opts, cfg, focused[0], api.NewClient())
+}
+
+// runCommitMsgWithDeps is the testable implementation of commit-msg
+func runCommitMsgWithDeps(cmd *cobra.Command, args []string, opts *commitMsgOptions, cfg *config.Config, issueKey string, client commitMsgClient) error {
+	owner, repo, number, err := parseIssueReference(issueKey)
+	if err != nil {
+		return err
+	}
+
+	summary := strings.TrimSpace(strings.Join(args, " "))
+	commitType := opts.commitType
+
+	// The issue is only fetched when its title or labels are needed
+	if summary == "" || commitType == "" {
+		issue, err := client.GetIssue(owner, repo, number)
+		if err != nil {
+			return fmt.Errorf("failed to get issue: %w", err)
+		}
+		if summary == "" {
+			summary = issue.Title
+		}
+		if commitType == "" {
+			commitType = commitTypeForLabels(issue.Labels)
+		}
+	}
+
+	cmd.Println(buildCommitMessage(commitType, summary, commitIssueRef(cfg, owner, repo, number)))
+	return nil
+}
+
+// commitTypeForLabels picks a conventional commit type from issue labels
+func commitTypeForLabels(labels []api.Label) string {
+	for _, m := range labelCommitTypes {
+		for _, l := range labels {
+			if strings.EqualFold(l.Name, m.label) {
+				return m.commitType
+			}
+		}
+	}
+	return "feat"
+}
+
+// commitIssueRef returns "#42" for the default repository, or else
+// "owner/repo#42"
+func commitIssueRef(cfg *config.Config, owner, repo string, number int) string {
+	if len(cfg.Repositories) > 0 && strings.EqualFold(cfg.Repositories[0], owner+"/"+repo) {
+		return fmt.Sprintf("#%d", number)
+	}
+	return fmt.Sprintf("%s/%s#%d", owner, repo, number)
+}
+
+// buildCommitMessage formats "<type>: <summary> (<ref>)"
+func buildCommitMessage(commitType, summary, ref string) string {
+	return fmt.Sprintf("%s: %s (%s)", commitType, summary, ref)
+}
+
+// runCommitMsgHook adds the focused issue's trailer to the message file.
+// Merges, squashes, and reused messages are left alone.
+func runCommitMsgHook(msgFile, source string, cfg *config.Config, focusPath string) error {
+	switch source {
+	case "merge", "squash", "commit":
+		return nil
+	}
+
+	focused, err := focusedIssueArgs(focusPath)
+	if err != nil {
+		return nil
+	}
+	owner, repo, number, err := parseIssueReference(focused[0])
+	if err != nil {
+		return nil
+	}
+
+	data, err := os.ReadFile(msgFile)
+	if err != nil {
+		return fmt.Errorf("failed to read commit message: %w", err)
+	}
+
+	message, changed := addCommitTrailer(string(data), commitIssueRef(cfg, owner, repo, number))
+	if !changed {
+		return nil
+	}
+	if err := os.WriteFile(msgFile, []byte(message), 0644); err != nil {
+		return fmt.Errorf("failed to write commit message: %w", err)
+	}
+	return nil
+}
+
+// addCommitTrailer appends a "Refs: <ref>" trailer above git's comment
+// lines, unless the message already mentions the issue. An empty message
+// is left alone so that git still aborts a commit whose message is empty.
+func addCommitTrailer(message, ref string) (string, bool) {
+	mention := regexp.MustCompile(regexp.QuoteMeta(ref) + `\b`)
+	if mention.MatchString(message) {
+		return message, false
+	}
+
+	lines := strings.Split(message, "\n")
+	split := len(lines)
+	for i, line := range lines {
+		if strings.HasPrefix(line, "#") {
+			split = i
+			break
+		}
+	}
+	content := lines[:split]
+	comments := lines[split:]
+	for len(content) > 0 && strings.TrimSpace(content[len(content)-1]) == "" {
+		content = content[:len(content)-1]
+	}
+
+	if len(content) == 0 {
+		return message, false
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Join(content, "\n") + "\n")
+	fmt.Fprintf(&b, "\nRefs: %s\n", ref)
+	if len(comments) > 0 {
+		b.WriteString(strings.Join(comments, "\n"))
+	}
+	return b.String(), true
+}
+
+// gitHooksDir returns the hooks directory of the current repository
+func gitHooksDir() (string, error) {
+	out, err := exec.Command("git", "rev-parse", "--git-path", "hooks").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate git hooks directory (not a git repository?): %w", err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// installCommitMsgHook writes the prepare-commit-msg hook. A hook that
+// gh-pmu did not write is only replaced with force.
+func installCommitMsgHook(cmd *cobra.Command, hooksDir string, force bool) error {
+	path := filepath.Join(hooksDir, "prepare-commit-msg")
+
+	if data, err := os.ReadFile(path); err == nil && !strings.Contains(string(data), commitMsgHookMarker) && !force {
+		return fmt.Errorf("%s already exists; use --force to replace it", path)
+	}
+
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		return fmt.Errorf("failed to create hooks directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(commitMsgHookScript), 0755); err != nil {
+		return fmt.Errorf("failed to write hook: %w", err)
+	}
+
+	cmd.Printf("Installed prepare-commit-msg hook at %s\n", path)
+	return nil
+}
+
+// uninstallCommitMsgHook removes the prepare-commit-msg hook if gh-pmu wrote it
+func uninstallCommitMsgHook(cmd *cobra.Command, hooksDir string) error {
+	path := filepath.Join(hooksDir, "prepare-commit-msg")
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		cmd.Println("No prepare-commit-msg hook is installed")
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read hook: %w", err)
+	}
+	if !strings.Contains(string(data), commitMsgHookMarker) {
+		return fmt.Errorf("%s was not installed by gh pmu; leaving it in place", path)
+	}
+
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove hook: %w", err)
+	}
+	cmd.Printf("Removed prepare-commit-msg hook from %s\n", path)
+	return nil
+}
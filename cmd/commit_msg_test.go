@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/focus"
+)
+
+// mockCommitMsgClient implements commitMsgClient interface for testing
+type mockCommitMsgClient struct {
+	issue *api.Issue
+	err   error
+	calls int
+}
+
+func (m *mockCommitMsgClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	m.calls++
+	return m.issue, m.err
+}
+
+func TestCommitMsgCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"commit-msg"})
+	if err != nil || sub.Name() != "commit-msg" {
+		t.Fatalf("commit-msg command not found: %v", err)
+	}
+	for _, flag := range []string{"type", "hook", "force"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag to exist", flag)
+		}
+	}
+}
+
+func TestRunCommitMsg(t *testing.T) {
+	cfg := newTestConfig()
+
+	tests := []struct {
+		name      string
+		args      []string
+		opts      commitMsgOptions
+		issueKey  string
+		issue     *api.Issue
+		want      string
+		wantCalls int
+	}{
+		{
+			name:     "title and labels from the issue",
+			issueKey: "owner/repo#42",
+			issue:    &api.Issue{Title: "Login fails on Safari", Labels: []api.Label{{Name: "Bug"}}},
+			want:     "fix: Login fails on Safari (#42)\n", wantCalls: 1,
+		},
+		{
+			name:     "summary and type given",
+			args:     []string{"add", "toggle"},
+			opts:     commitMsgOptions{commitType: "chore"},
+			issueKey: "owner/repo#42",
+			want:     "chore: add toggle (#42)\n", wantCalls: 0,
+		},
+		{
+			name:     "other repository",
+			args:     []string{"add toggle"},
+			issueKey: "other/repo#7",
+			issue:    &api.Issue{},
+			want:     "feat: add toggle (other/repo#7)\n", wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &mockCommitMsgClient{issue: tt.issue}
+			cmd, buf := newTestCmd()
+			opts := tt.opts
+			if err := runCommitMsgWithDeps(cmd, tt.args, &opts, cfg, tt.issueKey, client); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("Got %q, want %q", buf.String(), tt.want)
+			}
+			if client.calls != tt.wantCalls {
+				t.Errorf("Expected %d GetIssue calls, got %d", tt.wantCalls, client.calls)
+			}
+		})
+	}
+
+	cmd, _ := newTestCmd()
+	err := runCommitMsgWithDeps(cmd, nil, &commitMsgOptions{}, cfg, "owner/repo#42", &mockCommitMsgClient{err: errors.New("boom")})
+	if err == nil || !strings.Contains(err.Error(), "failed to get issue") {
+		t.Errorf("Expected issue error, got %v", err)
+	}
+}
+
+func TestAddCommitTrailer(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    string
+		changed bool
+	}{
+		{
+			name:    "message with comments",
+			message: "feat: add toggle\n\n# Please enter the commit message\n",
+			want:    "feat: add toggle\n\nRefs: #42\n# Please enter the commit message\n",
+			changed: true,
+		},
+		{
+			name:    "message only",
+			message: "feat: add toggle\n",
+			want:    "feat: add toggle\n\nRefs: #42\n",
+			changed: true,
+		},
+		{
+			name:    "already references the issue",
+			message: "feat: add toggle (#42)\n",
+			changed: false,
+		},
+		{
+			name:    "empty message",
+			message: "\n# Please enter the commit message\n",
+			changed: false,
+		},
+		{
+			name:    "different issue with the same prefix",
+			message: "fix: see #421\n",
+			want:    "fix: see #421\n\nRefs: #42\n",
+			changed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := addCommitTrailer(tt.message, "#42")
+			if changed != tt.changed {
+				t.Fatalf("changed = %v, want %v", changed, tt.changed)
+			}
+			if !changed {
+				tt.want = tt.message
+			}
+			if got != tt.want {
+				t.Errorf("Got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunCommitMsgHook(t *testing.T) {
+	dir := t.TempDir()
+	cfg := newTestConfig()
+	focusPath := filepath.Join(dir, "focus.json")
+	msgFile := filepath.Join(dir, "COMMIT_EDITMSG")
+
+	write := func(text string) {
+		if err := os.WriteFile(msgFile, []byte(text), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	read := func() string {
+		data, err := os.ReadFile(msgFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+
+	// No focused issue: the message is untouched
+	write("feat: add toggle\n")
+	if err := runCommitMsgHook(msgFile, "message", cfg, focusPath); err != nil || read() != "feat: add toggle\n" {
+		t.Errorf("Expected no change without focus, got %q, %v", read(), err)
+	}
+
+	state := &focus.State{}
+	state.Set("owner/repo#42", time.Now())
+	if err := state.Save(focusPath); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runCommitMsgHook(msgFile, "merge", cfg, focusPath); err != nil || read() != "feat: add toggle\n" {
+		t.Errorf("Expected merges to be skipped, got %q, %v", read(), err)
+	}
+
+	if err := runCommitMsgHook(msgFile, "message", cfg, focusPath); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if read() != "feat: add toggle\n\nRefs: #42\n" {
+		t.Errorf("Unexpected message: %q", read())
+	}
+}
+
+func TestInstallCommitMsgHook(t *testing.T) {
+	hooksDir := filepath.Join(t.TempDir(), "hooks")
+	path := filepath.Join(hooksDir, "prepare-commit-msg")
+
+	cmd, _ := newTestCmd()
+	if err := installCommitMsgHook(cmd, hooksDir, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Expected hook file: %v", err)
+	}
+	if info.Mode().Perm()&0100 == 0 {
+		t.Errorf("Expected hook to be executable, mode %v", info.Mode())
+	}
+
+	// Reinstalling over our own hook is fine
+	if err := installCommitMsgHook(cmd, hooksDir, false); err != nil {
+		t.Errorf("Unexpected error reinstalling: %v", err)
+	}
+
+	if err := uninstallCommitMsgHook(cmd, hooksDir); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected hook to be removed, got %v", err)
+	}
+
+	// A foreign hook is kept unless forced
+	if err := os.WriteFile(path, []byte("#!/bin/sh\necho custom\n"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := installCommitMsgHook(cmd, hooksDir, false); err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Errorf("Expected existing hook error, got %v", err)
+	}
+	if err := uninstallCommitMsgHook(cmd, hooksDir); err == nil {
+		t.Error("Expected error removing a foreign hook")
+	}
+	if err := installCommitMsgHook(cmd, hooksDir, true); err != nil {
+		t.Errorf("Unexpected error with --force: %v", err)
+	}
+}
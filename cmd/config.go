@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the .gh-pmu.yml configuration",
+		Long:  `Manage the .gh-pmu.yml configuration of the current directory.`,
+	}
+
+	cmd.AddCommand(newConfigMigrateCommand())
+
+	return cmd
+}
+
+func newConfigMigrateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate",
+		Short: "Upgrade .gh-pmu.yml to the current config version",
+		Long: `Upgrade .gh-pmu.yml to the config version of this release.
+
+Other commands read an older file by migrating it in memory and never
+write it. This rewrites the file: when only the version is new, the
+version line is the only change; otherwise the file is re-encoded with
+its own indentation, keeping comments and key order. The original is
+kept in the gh-pmu/config-backups directory of your user config
+directory, not in the repository.`,
+		Example: `  gh pmu config migrate`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cwd, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get current directory: %w", err)
+			}
+			backupDir, err := config.BackupDir()
+			if err != nil {
+				return err
+			}
+			return runConfigMigrate(cmd, filepath.Join(cwd, config.ConfigFileName), backupDir)
+		},
+	}
+}
+
+// runConfigMigrate is the testable implementation of config migrate
+func runConfigMigrate(cmd *cobra.Command, path, backupDir string) error {
+	migrated, err := config.Migrate(path, backupDir)
+	if err != nil {
+		return err
+	}
+	if !migrated {
+		cmd.Printf("%s is already at config version %d\n", filepath.Base(path), config.CurrentVersion)
+	}
+	return nil
+}
+
+// loadProjectConfig loads and validates the project config in the current directory
+func loadProjectConfig() (*config.Config, error) {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current directory: %w", err)
+	}
+
+	cfg, err := config.LoadFromDirectory(cwd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return cfg, nil
+}
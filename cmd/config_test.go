@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+func TestRunConfigMigrate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), config.ConfigFileName)
+	if err := os.WriteFile(path, []byte("project:\n    owner: octo-org\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, buf := newTestCmd()
+	if err := runConfigMigrate(cmd, path, t.TempDir()); err != nil {
+		t.Fatalf("runConfigMigrate() error = %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "version: 1\nproject:\n    owner: octo-org\n" {
+		t.Errorf("Expected the version stamped, got %q", data)
+	}
+
+	if err := runConfigMigrate(cmd, path, t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), ".gh-pmu.yml is already at config version 1") {
+		t.Errorf("Expected an up-to-date message, got %q", buf.String())
+	}
+}
@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// conflictClient defines the interface for API methods used to detect
+// concurrent edits. This allows for easier testing with mock implementations.
+type conflictClient interface {
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+}
+
+// checkItemConflict re-fetches an issue's project item and returns an
+// error describing every field whose value changed since read was taken
+func checkItemConflict(client conflictClient, projectID, owner, repo string, number int, read []api.FieldValue) error {
+	changes, err := itemConflicts(client, projectID, owner, repo, number, read)
+	if err != nil {
+		return err
+	}
+	return conflictError(number, changes)
+}
+
+// itemConflicts re-fetches an issue's project item and describes the
+// fields that changed since read was taken
+func itemConflicts(client conflictClient, projectID, owner, repo string, number int, read []api.FieldValue) ([]string, error) {
+	current, err := client.GetIssueProjectItem(owner, repo, number, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return []string{"removed from the project"}, nil
+	}
+	return fieldConflicts(read, current.FieldValues), nil
+}
+
+// conflictError reports an issue's changes since it was read, or nil
+// when there are none
+func conflictError(number int, changes []string) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	return fmt.Errorf("#%d changed since it was read: %s", number, strings.Join(changes, "; "))
+}
+
+// fieldConflicts describes the fields whose values differ between read and
+// current, as "Field: old → new", sorted by field
+func fieldConflicts(read, current []api.FieldValue) []string {
+	before := fieldValueMap(read)
+	after := fieldValueMap(current)
+
+	names := make(map[string]bool)
+	for name := range before {
+		names[name] = true
+	}
+	for name := range after {
+		names[name] = true
+	}
+
+	var changes []string
+	for name := range names {
+		if before[name] != after[name] {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", name, conflictValue(before[name]), conflictValue(after[name])))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+// issueConflicts describes the title, body, labels, and assignees that
+// differ between two reads of an issue
+func issueConflicts(read, current *api.Issue) []string {
+	var changes []string
+	if read.Title != current.Title {
+		changes = append(changes, fmt.Sprintf("Title: %s → %s", read.Title, current.Title))
+	}
+	if read.Body != current.Body {
+		changes = append(changes, "Body edited")
+	}
+
+	labelNames := func(labels []api.Label) string {
+		var names []string
+		for _, l := range labels {
+			names = append(names, l.Name)
+		}
+		sort.Strings(names)
+		return strings.Join(names, ", ")
+	}
+	if before, after := labelNames(read.Labels), labelNames(current.Labels); before != after {
+		changes = append(changes, fmt.Sprintf("Labels: %s → %s", conflictValue(before), conflictValue(after)))
+	}
+
+	logins := func(actors []api.Actor) string {
+		var names []string
+		for _, a := range actors {
+			names = append(names, "@"+a.Login)
+		}
+		sort.Strings(names)
+		return strings.Join(names, ", ")
+	}
+	if before, after := logins(read.Assignees), logins(current.Assignees); before != after {
+		changes = append(changes, fmt.Sprintf("Assignees: %s → %s", conflictValue(before), conflictValue(after)))
+	}
+	return changes
+}
+
+// fieldValueMap indexes field values by field name
+func fieldValueMap(values []api.FieldValue) map[string]string {
+	m := make(map[string]string, len(values))
+	for _, fv := range values {
+		m[fv.Field] = fv.Value
+	}
+	return m
+}
+
+// conflictValue renders a value in a conflict description
+func conflictValue(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
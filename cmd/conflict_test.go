@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+func TestFieldConflicts(t *testing.T) {
+	read := []api.FieldValue{{Field: "Status", Value: "Todo"}, {Field: "Priority", Value: "P1"}, {Field: "Estimate", Value: "3"}}
+	current := []api.FieldValue{{Field: "Status", Value: "Done"}, {Field: "Estimate", Value: "3"}, {Field: "Sprint", Value: "Sprint 4"}}
+
+	want := []string{"Priority: P1 → (none)", "Sprint: (none) → Sprint 4", "Status: Todo → Done"}
+	if got := fieldConflicts(read, current); !reflect.DeepEqual(got, want) {
+		t.Errorf("fieldConflicts() = %v, want %v", got, want)
+	}
+	if got := fieldConflicts(read, read); len(got) != 0 {
+		t.Errorf("Expected no conflicts, got %v", got)
+	}
+}
+
+func TestIssueConflicts(t *testing.T) {
+	read := &api.Issue{
+		Title:     "Login fails",
+		Body:      "Steps",
+		Labels:    []api.Label{{Name: "bug"}, {Name: "auth"}},
+		Assignees: []api.Actor{{Login: "alice"}},
+	}
+	same := &api.Issue{
+		Title:     "Login fails",
+		Body:      "Steps",
+		Labels:    []api.Label{{Name: "auth"}, {Name: "bug"}},
+		Assignees: []api.Actor{{Login: "alice"}},
+	}
+	if got := issueConflicts(read, same); len(got) != 0 {
+		t.Errorf("Expected label order to be ignored, got %v", got)
+	}
+
+	changed := &api.Issue{Title: "Login fails on Safari", Body: "Steps", Labels: []api.Label{{Name: "bug"}}}
+	want := []string{"Title: Login fails → Login fails on Safari", "Labels: auth, bug → bug", "Assignees: @alice → (none)"}
+	if got := issueConflicts(read, changed); !reflect.DeepEqual(got, want) {
+		t.Errorf("issueConflicts() = %v, want %v", got, want)
+	}
+}
@@ -3,11 +3,18 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
 	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/ui"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
 )
@@ -21,8 +28,19 @@ type createOptions struct {
 	assignees   []string
 	milestone   string
 	repo        string
+	fields      []string
 	fromFile    string
+	template    string
+	editor      bool
 	interactive bool
+	parent      string
+	noInherit   bool
+	batch       string
+	dryRun      bool
+	json        bool
+
+	checkDuplicates bool
+	noDuplicates    bool
 }
 
 func newCreateCommand() *cobra.Command {
@@ -34,10 +52,95 @@ func newCreateCommand() *cobra.Command {
 		Long: `Create a new issue and add it to the configured project.
 
 When --title is provided, creates the issue non-interactively.
-Otherwise, opens an editor for composing the issue.
+Otherwise, on a terminal, a wizard prompts for the title and body and
+offers the status, priority, and size options from the project metadata.
+Other flags are used as the wizard's defaults; skipped status and
+priority fields fall back to the config defaults.
 
 The issue is automatically added to the configured project and
-any specified field values (status, priority) are set.`,
+any specified field values (status, priority) are set.
+
+Any other project field can be set with --field key=value, where key is
+a field alias from .gh-pmu.yml or the field's name. Values are checked
+against the cached project metadata before the issue is created:
+single-select options, numbers, dates (YYYY-MM-DD), and iterations
+(current, next, or an iteration title).
+
+With --from-file the issue is read from a file, or from stdin with "-".
+A Markdown file holds the title, labels, assignees, milestone, status,
+priority, and fields in YAML front matter, with the body below it:
+
+  ---
+  title: Add dark mode
+  labels: [enhancement]
+  status: backlog
+  fields:
+    size: M
+  ---
+  Body text in Markdown.
+
+YAML and JSON files use the same keys, including body. Flags given on the
+command line are added to, or override, the values from the file.
+
+With --template, the issue starts from a template in .github/ISSUE_TEMPLATE,
+named by file (without extension) or by its name. Markdown templates
+supply the title prefix, labels, assignees, and body; for issue forms the
+form fields are prompted for and rendered into the body as GitHub does.
+Project fields and the other flags are layered on top.
+
+With --editor, the same Markdown format opens in $EDITOR, prefilled from
+the other flags and with a stanza for each project field. The issue is
+created when the file is saved with a title.
+
+With --parent, the new issue is also linked as a sub-issue of the given
+issue. It inherits the parent's labels (same repository only) and
+iteration unless --no-inherit is given; labels and an iteration set
+explicitly are kept.
+
+With --batch, many issues are created from a CSV, YAML, or JSON manifest,
+or from stdin with "-". CSV files have a header row with title, body,
+labels, assignees, milestone, status, priority, and parent columns; any
+other column is a project field. YAML and JSON manifests are lists of
+the --from-file keys plus parent. A parent is an issue reference, or
+row:N for an issue created earlier in the batch. Use --dry-run to
+preview the rows and --json for a report of created and failed rows.
+Other flags apply to every row.
+
+With --check-duplicates, open project issues in the configured
+repositories whose titles resemble the new title are listed as a warning
+before the issue is created. --no-duplicates lists them and does not
+create the issue; with --batch, such rows are reported as failed.`,
+		Example: `  # Create an issue with status and priority
+  gh pmu create --title "Fix login" --status backlog --priority p1
+
+  # Set other project fields
+  gh pmu create --title "Fix login" --field size=M --field estimate=3 \
+    --field "Target date=2025-03-01" --field iteration=next
+
+  # Create from a Markdown file kept in the repo
+  gh pmu create --from-file .github/issues/dark-mode.md
+
+  # Answer prompts for the title, body, and fields
+  gh pmu create
+
+  # Fill in the repository's bug report form, then set project fields
+  gh pmu create --template bug_report --status backlog --priority p1
+
+  # Compose the issue in $EDITOR
+  gh pmu create --editor --label bug
+
+  # Create the issue as a sub-issue of #10
+  gh pmu create --title "Write migration" --parent 10
+
+  # Preview, then import a planning spreadsheet
+  gh pmu create --batch backlog.csv --dry-run
+  gh pmu create --batch backlog.csv --label imported --json
+
+  # Refuse to file an issue that looks like an open one
+  gh pmu create --title "Login page crashes" --no-duplicates
+
+  # Read the issue from stdin
+  cat issue.md | gh pmu create --from-file -`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runCreate(cmd, opts)
 		},
@@ -48,24 +151,35 @@ any specified field values (status, priority) are set.`,
 	cmd.Flags().StringVarP(&opts.status, "status", "s", "", "Set project status field (e.g., backlog, in_progress)")
 	cmd.Flags().StringVarP(&opts.priority, "priority", "p", "", "Set project priority field (e.g., p0, p1, p2)")
 	cmd.Flags().StringArrayVarP(&opts.labels, "label", "l", nil, "Add labels (can be specified multiple times)")
-	cmd.Flags().StringArrayVarP(&opts.assignees, "assignee", "a", nil, "Assign users (can be specified multiple times)")
+	cmd.Flags().StringArrayVarP(&opts.assignees, "assignee", "a", nil, "Assign users, @me for yourself (can be specified multiple times)")
+	cmd.Flags().StringArrayVar(&opts.fields, "field", nil, "Set a project field as key=value (can be specified multiple times)")
 	cmd.Flags().StringVarP(&opts.milestone, "milestone", "m", "", "Set milestone (title or number)")
 	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Target repository (owner/repo format)")
-	cmd.Flags().StringVarP(&opts.fromFile, "from-file", "f", "", "Create issue from YAML/JSON file")
+	cmd.Flags().StringVarP(&opts.fromFile, "from-file", "f", "", "Create issue from a Markdown, YAML, or JSON file (- for stdin)")
+	cmd.Flags().StringVarP(&opts.template, "template", "T", "", "Start from a .github/ISSUE_TEMPLATE template or issue form (file or template name)")
+	cmd.Flags().BoolVarP(&opts.editor, "editor", "e", false, "Compose the issue and its project fields in $EDITOR")
 	cmd.Flags().BoolVarP(&opts.interactive, "interactive", "i", false, "Use interactive mode with prompts")
+	cmd.Flags().StringVar(&opts.parent, "parent", "", "Link the new issue as a sub-issue of this issue")
+	cmd.Flags().BoolVar(&opts.noInherit, "no-inherit", false, "With --parent, do not inherit the parent's labels and iteration")
+	cmd.Flags().StringVar(&opts.batch, "batch", "", "Create issues from a CSV, YAML, or JSON manifest (- for stdin)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "With --batch, preview the issues without creating them")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "With --batch, output a JSON report of created and failed rows")
+	cmd.Flags().BoolVar(&opts.checkDuplicates, "check-duplicates", false, "Warn about open issues with similar titles before creating")
+	cmd.Flags().BoolVar(&opts.noDuplicates, "no-duplicates", false, "Do not create the issue if open issues with similar titles exist")
 
 	return cmd
 }
 
 // issueFromFile represents an issue definition in a YAML/JSON file
 type issueFromFile struct {
-	Title     string   `json:"title" yaml:"title"`
-	Body      string   `json:"body" yaml:"body"`
-	Labels    []string `json:"labels" yaml:"labels"`
-	Assignees []string `json:"assignees" yaml:"assignees"`
-	Milestone string   `json:"milestone" yaml:"milestone"`
-	Status    string   `json:"status" yaml:"status"`
-	Priority  string   `json:"priority" yaml:"priority"`
+	Title     string            `json:"title" yaml:"title"`
+	Body      string            `json:"body" yaml:"body"`
+	Labels    []string          `json:"labels" yaml:"labels"`
+	Assignees []string          `json:"assignees" yaml:"assignees"`
+	Milestone string            `json:"milestone" yaml:"milestone"`
+	Status    string            `json:"status" yaml:"status"`
+	Priority  string            `json:"priority" yaml:"priority"`
+	Fields    map[string]string `json:"fields" yaml:"fields"`
 }
 
 func runCreate(cmd *cobra.Command, opts *createOptions) error {
@@ -105,106 +219,98 @@ func runCreate(cmd *cobra.Command, opts *createOptions) error {
 		owner, repo = repoParts[0], repoParts[1]
 	}
 
-	// Handle --from-file
-	if opts.fromFile != "" {
-		return runCreateFromFile(cmd, opts, cfg, owner, repo)
+	if opts.template != "" && (opts.fromFile != "" || opts.editor) {
+		return fmt.Errorf("--template cannot be used with --from-file or --editor")
 	}
 
-	// Handle interactive mode
-	if opts.interactive {
-		return fmt.Errorf("interactive mode not yet implemented")
+	if opts.batch != "" {
+		if opts.title != "" || opts.fromFile != "" || opts.template != "" || opts.editor || opts.interactive {
+			return fmt.Errorf("--batch cannot be used with --title, --from-file, --template, --editor, or --interactive")
+		}
+		return runCreateBatch(cmd, opts, cfg, owner, repo)
 	}
-
-	// Handle non-interactive mode
-	title := opts.title
-	body := opts.body
-
-	if title == "" {
-		return fmt.Errorf("--title is required (use --interactive for prompted mode)")
+	if opts.dryRun || opts.json {
+		return fmt.Errorf("--dry-run and --json can only be used with --batch")
 	}
 
-	// Merge labels: config defaults + command line
-	labels := append([]string{}, cfg.Defaults.Labels...)
-	labels = append(labels, opts.labels...)
-
-	// Create API client
-	client := api.NewClient()
-
-	// Create the issue with extended options
-	issue, err := client.CreateIssueWithOptions(owner, repo, title, body, labels, opts.assignees, opts.milestone)
-	if err != nil {
-		return fmt.Errorf("failed to create issue: %w", err)
+	// Handle --template
+	if opts.template != "" {
+		return runCreateFromTemplate(cmd, opts, cfg, owner, repo)
 	}
 
-	// Add issue to project
-	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
-	if err != nil {
-		return fmt.Errorf("failed to get project: %w", err)
+	// Handle --from-file
+	if opts.fromFile != "" {
+		return runCreateFromFile(cmd, opts, cfg, owner, repo)
 	}
 
-	itemID, err := client.AddIssueToProject(project.ID, issue.ID)
-	if err != nil {
-		return fmt.Errorf("failed to add issue to project: %w", err)
+	// Handle --editor
+	if opts.editor {
+		return runCreateInEditor(cmd, opts, cfg, owner, repo, editTextInEditor)
 	}
 
-	// Set project field values
-	if opts.status != "" {
-		statusValue := cfg.ResolveFieldValue("status", opts.status)
-		if err := client.SetProjectItemField(project.ID, itemID, "Status", statusValue); err != nil {
-			// Non-fatal - warn but continue
-			fmt.Fprintf(os.Stderr, "Warning: failed to set status: %v\n", err)
-		}
-	} else if cfg.Defaults.Status != "" {
-		// Apply default status from config
-		statusValue := cfg.ResolveFieldValue("status", cfg.Defaults.Status)
-		if err := client.SetProjectItemField(project.ID, itemID, "Status", statusValue); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: failed to set default status: %v\n", err)
-		}
+	// Handle interactive mode, also used for a bare create on a terminal
+	if opts.interactive || (opts.title == "" && isInteractiveTerminal(cmd)) {
+		return runCreateWizardAndCreate(cmd, opts, cfg, owner, repo)
 	}
 
-	if opts.priority != "" {
-		priorityValue := cfg.ResolveFieldValue("priority", opts.priority)
-		if err := client.SetProjectItemField(project.ID, itemID, "Priority", priorityValue); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: failed to set priority: %v\n", err)
-		}
-	} else if cfg.Defaults.Priority != "" {
-		// Apply default priority from config
-		priorityValue := cfg.ResolveFieldValue("priority", cfg.Defaults.Priority)
-		if err := client.SetProjectItemField(project.ID, itemID, "Priority", priorityValue); err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: failed to set default priority: %v\n", err)
-		}
+	// Handle non-interactive mode
+	if opts.title == "" {
+		return fmt.Errorf("--title is required when not running on a terminal")
 	}
 
-	// Output the result
-	fmt.Printf("Created issue #%d: %s\n", issue.Number, issue.Title)
-	fmt.Printf("%s\n", issue.URL)
-
-	return nil
+	return createFromIssueData(cmd, opts, cfg, owner, repo, issueFromFile{Title: opts.title})
 }
 
 func runCreateFromFile(cmd *cobra.Command, opts *createOptions, cfg *config.Config, owner, repo string) error {
-	// Read the file
-	data, err := os.ReadFile(opts.fromFile)
-	if err != nil {
-		return fmt.Errorf("failed to read file %s: %w", opts.fromFile, err)
-	}
-
-	// Parse the file (try YAML first, then JSON)
-	var issueData issueFromFile
-	if strings.HasSuffix(opts.fromFile, ".json") {
-		if err := json.Unmarshal(data, &issueData); err != nil {
-			return fmt.Errorf("failed to parse JSON file: %w", err)
+	// Read the file, or stdin for "-"
+	var data []byte
+	var err error
+	if opts.fromFile == "-" {
+		data, err = io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return fmt.Errorf("failed to read issue from stdin: %w", err)
 		}
 	} else {
-		if err := yaml.Unmarshal(data, &issueData); err != nil {
-			return fmt.Errorf("failed to parse YAML file: %w", err)
+		data, err = os.ReadFile(opts.fromFile)
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", opts.fromFile, err)
 		}
 	}
 
+	issueData, err := parseIssueFile(opts.fromFile, data)
+	if err != nil {
+		return err
+	}
+	if opts.title != "" {
+		issueData.Title = opts.title
+	}
+
 	if issueData.Title == "" {
 		return fmt.Errorf("title is required in file")
 	}
 
+	return createFromIssueData(cmd, opts, cfg, owner, repo, issueData)
+}
+
+// createFromIssueData creates an issue from a file or editor definition,
+// merged with the command line options, sets its project fields, and
+// prints a summary
+func createFromIssueData(cmd *cobra.Command, opts *createOptions, cfg *config.Config, owner, repo string, issueData issueFromFile) error {
+	issue, applied, err := createIssueWithFields(api.NewClient(), opts, cfg, owner, repo, issueData)
+	if err != nil {
+		return err
+	}
+
+	// Output the result
+	printCreateSummary(ui.NewWithOptions(cmd.OutOrStdout(), colorDisabled(cmd)), issue, applied)
+
+	return nil
+}
+
+// createIssueWithFields creates the issue, adds it to the project, and sets
+// its project fields, returning the issue and the fields that were set.
+// Field failures after the issue exists are reported as warnings.
+func createIssueWithFields(client *api.Client, opts *createOptions, cfg *config.Config, owner, repo string, issueData issueFromFile) (*api.Issue, []createFieldValue, error) {
 	// Merge with command line options (command line takes precedence)
 	title := issueData.Title
 	body := issueData.Body
@@ -234,36 +340,62 @@ func runCreateFromFile(cmd *cobra.Command, opts *createOptions, cfg *config.Conf
 		priority = opts.priority
 	}
 
-	// Create API client
-	client := api.NewClient()
+	// File fields first so --field values take precedence
+	pairs := append(fieldPairs(issueData.Fields), opts.fields...)
+
+	fields, err := resolveCreateFields(pairs, cfg, time.Now())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Look up the parent first so a bad reference fails before anything is created
+	var parent *createParent
+	if opts.parent != "" {
+		parent, err = resolveCreateParent(client, opts.parent, owner, repo)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !opts.noInherit && parent.sameRepo(owner, repo) {
+			labels = inheritParentLabels(labels, parent.issue)
+		}
+	}
+
+	if err := checkCreateDuplicates(client, opts, cfg, owner, repo, title); err != nil {
+		return nil, nil, err
+	}
 
 	// Create the issue
 	issue, err := client.CreateIssueWithOptions(owner, repo, title, body, labels, assignees, milestone)
 	if err != nil {
-		return fmt.Errorf("failed to create issue: %w", err)
+		return nil, nil, fmt.Errorf("failed to create issue: %w", err)
 	}
 
 	// Add issue to project
 	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
 	if err != nil {
-		return fmt.Errorf("failed to get project: %w", err)
+		return issue, nil, fmt.Errorf("failed to get project: %w", err)
 	}
 
 	itemID, err := client.AddIssueToProject(project.ID, issue.ID)
 	if err != nil {
-		return fmt.Errorf("failed to add issue to project: %w", err)
+		return issue, nil, fmt.Errorf("failed to add issue to project: %w", err)
 	}
 
-	// Set project field values
+	// Set project field values, keeping the ones that were applied
+	var applied []createFieldValue
 	if status != "" {
 		statusValue := cfg.ResolveFieldValue("status", status)
 		if err := client.SetProjectItemField(project.ID, itemID, "Status", statusValue); err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: failed to set status: %v\n", err)
+		} else {
+			applied = append(applied, createFieldValue{Field: "Status", Value: statusValue})
 		}
 	} else if cfg.Defaults.Status != "" {
 		statusValue := cfg.ResolveFieldValue("status", cfg.Defaults.Status)
 		if err := client.SetProjectItemField(project.ID, itemID, "Status", statusValue); err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: failed to set default status: %v\n", err)
+		} else {
+			applied = append(applied, createFieldValue{Field: "Status", Value: statusValue})
 		}
 	}
 
@@ -271,17 +403,276 @@ func runCreateFromFile(cmd *cobra.Command, opts *createOptions, cfg *config.Conf
 		priorityValue := cfg.ResolveFieldValue("priority", priority)
 		if err := client.SetProjectItemField(project.ID, itemID, "Priority", priorityValue); err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: failed to set priority: %v\n", err)
+		} else {
+			applied = append(applied, createFieldValue{Field: "Priority", Value: priorityValue})
 		}
 	} else if cfg.Defaults.Priority != "" {
 		priorityValue := cfg.ResolveFieldValue("priority", cfg.Defaults.Priority)
 		if err := client.SetProjectItemField(project.ID, itemID, "Priority", priorityValue); err != nil {
 			fmt.Fprintf(os.Stderr, "Warning: failed to set default priority: %v\n", err)
+		} else {
+			applied = append(applied, createFieldValue{Field: "Priority", Value: priorityValue})
 		}
 	}
 
-	// Output the result
-	fmt.Printf("Created issue #%d: %s\n", issue.Number, issue.Title)
-	fmt.Printf("%s\n", issue.URL)
+	applied = append(applied, setCreateFields(client, project.ID, itemID, fields)...)
 
-	return nil
+	if parent != nil {
+		applied = append(applied, linkCreateParent(client, cfg, project.ID, itemID, issue, parent, fields, !opts.noInherit)...)
+	}
+
+	return issue, applied, nil
+}
+
+// printCreateSummary prints the created issue with its assignees,
+// milestone, and the project fields that were set
+func printCreateSummary(u *ui.UI, issue *api.Issue, fields []createFieldValue) {
+	items := map[string]string{
+		"URL":        issue.URL,
+		"Repository": issue.Repository.Owner + "/" + issue.Repository.Name,
+	}
+	order := []string{"URL", "Repository"}
+
+	if len(issue.Assignees) > 0 {
+		logins := make([]string, 0, len(issue.Assignees))
+		for _, a := range issue.Assignees {
+			logins = append(logins, a.Login)
+		}
+		items["Assignees"] = strings.Join(logins, ", ")
+		order = append(order, "Assignees")
+	}
+	if issue.Milestone != nil {
+		items["Milestone"] = issue.Milestone.Title
+		order = append(order, "Milestone")
+	}
+	for _, f := range fields {
+		if _, ok := items[f.Field]; !ok {
+			order = append(order, f.Field)
+		}
+		items[f.Field] = f.Value
+	}
+
+	u.SummaryBox(fmt.Sprintf("Created issue #%d: %s", issue.Number, issue.Title), items, order)
+}
+
+// parseIssueFile parses an issue definition. JSON and YAML are chosen by
+// extension; Markdown (.md, or any input starting with ---) takes YAML
+// front matter for the metadata and the text below it as the body. When
+// the front matter has no title, a leading "# Heading" becomes the title.
+// Input from stdin ("-") is detected by content.
+func parseIssueFile(name string, data []byte) (issueFromFile, error) {
+	var issue issueFromFile
+
+	ext := strings.ToLower(filepath.Ext(name))
+	text := strings.TrimLeft(string(data), "\ufeff")
+	trimmed := strings.TrimSpace(text)
+
+	switch {
+	case ext == ".json" || (name == "-" && strings.HasPrefix(trimmed, "{")):
+		if err := json.Unmarshal(data, &issue); err != nil {
+			return issue, fmt.Errorf("failed to parse JSON file: %w", err)
+		}
+	case ext == ".md" || ext == ".markdown" || strings.HasPrefix(trimmed, "---"):
+		return parseMarkdownIssue(text)
+	default:
+		if err := yaml.Unmarshal(data, &issue); err != nil {
+			return issue, fmt.Errorf("failed to parse YAML file: %w", err)
+		}
+	}
+
+	return issue, nil
+}
+
+// parseMarkdownIssue splits optional front matter from the Markdown body
+func parseMarkdownIssue(text string) (issueFromFile, error) {
+	var issue issueFromFile
+
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	body := text
+	if rest, ok := strings.CutPrefix(strings.TrimLeft(text, "\n"), "---\n"); ok {
+		frontMatter, after, found := strings.Cut(rest, "\n---")
+		if !found {
+			return issue, fmt.Errorf("failed to parse Markdown file: front matter is not closed with ---")
+		}
+		if err := yaml.Unmarshal([]byte(frontMatter), &issue); err != nil {
+			return issue, fmt.Errorf("failed to parse front matter: %w", err)
+		}
+		// Drop the rest of the closing delimiter line
+		_, body, _ = strings.Cut(after, "\n")
+	}
+
+	body = strings.TrimSpace(body)
+	if issue.Title == "" {
+		if heading, rest, _ := strings.Cut(body, "\n"); strings.HasPrefix(heading, "# ") {
+			issue.Title = strings.TrimSpace(strings.TrimPrefix(heading, "# "))
+			body = strings.TrimSpace(rest)
+		}
+	}
+	if body != "" {
+		issue.Body = body
+	}
+
+	return issue, nil
+}
+
+// fieldPairs converts a file's fields map to key=value pairs, sorted by key
+func fieldPairs(fields map[string]string) []string {
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+fields[key])
+	}
+	return pairs
+}
+
+// editableFieldTypes are the project field types create can set
+var editableFieldTypes = map[string]string{
+	"SINGLE_SELECT": "",
+	"TEXT":          "text",
+	"NUMBER":        "number",
+	"DATE":          "YYYY-MM-DD",
+	"ITERATION":     "current, next, or an iteration title",
+}
+
+// editorBufferHelp ends the --editor buffer; HTML comments are dropped
+const editorBufferHelp = `<!--
+Write the issue body above. Empty values are skipped; status, priority,
+and labels from the config defaults are applied as with the flags.
+Save with an empty title to cancel.
+-->
+`
+
+// buildCreateEditorTemplate renders the --editor buffer: front matter
+// prefilled from the flags, with a stanza for every settable project field
+func buildCreateEditorTemplate(opts *createOptions, cfg *config.Config) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	fmt.Fprintf(&b, "title: %s\n", yamlScalar(opts.title))
+	fmt.Fprintf(&b, "labels: %s\n", yamlList(opts.labels))
+	fmt.Fprintf(&b, "assignees: %s\n", yamlList(opts.assignees))
+	fmt.Fprintf(&b, "milestone: %s\n", yamlScalar(opts.milestone))
+	fmt.Fprintf(&b, "status: %s%s\n", yamlScalar(opts.status), fieldValuesHint(cfg, "status"))
+	fmt.Fprintf(&b, "priority: %s%s\n", yamlScalar(opts.priority), fieldValuesHint(cfg, "priority"))
+
+	preset := map[string]string{}
+	for _, pair := range opts.fields {
+		if key, value, ok := strings.Cut(pair, "="); ok {
+			preset[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+		}
+	}
+
+	var stanzas []string
+	if cfg.Metadata != nil {
+		for _, f := range cfg.Metadata.Fields {
+			hint, ok := editableFieldTypes[f.DataType]
+			if !ok || strings.EqualFold(f.Name, cfg.GetFieldName("status")) || strings.EqualFold(f.Name, cfg.GetFieldName("priority")) {
+				continue
+			}
+			key := f.Name
+			if alias := fieldAliasFor(cfg, "", f.Name); alias != "" {
+				key = alias
+			}
+			if f.DataType == "SINGLE_SELECT" {
+				names := make([]string, 0, len(f.Options))
+				for _, opt := range f.Options {
+					names = append(names, opt.Name)
+				}
+				hint = strings.Join(names, ", ")
+			}
+			value := preset[strings.ToLower(key)]
+			stanzas = append(stanzas, fmt.Sprintf("  %s: %s # %s", yamlScalar(key), yamlScalar(value), hint))
+		}
+	}
+	if len(stanzas) > 0 {
+		b.WriteString("fields:\n")
+		b.WriteString(strings.Join(stanzas, "\n"))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("---\n")
+	if opts.body != "" {
+		b.WriteString(opts.body + "\n")
+	}
+	b.WriteString("\n")
+	b.WriteString(editorBufferHelp)
+	return b.String()
+}
+
+// fieldValuesHint returns a YAML comment listing an alias's values
+func fieldValuesHint(cfg *config.Config, alias string) string {
+	field, ok := cfg.Fields[alias]
+	if !ok || len(field.Values) == 0 {
+		return ""
+	}
+	values := make([]string, 0, len(field.Values))
+	for v := range field.Values {
+		values = append(values, v)
+	}
+	sort.Strings(values)
+	return " # " + strings.Join(values, ", ")
+}
+
+// yamlScalar quotes a string for the editor's front matter when needed
+func yamlScalar(s string) string {
+	if s == "" {
+		return ""
+	}
+	out, err := yaml.Marshal(s)
+	if err != nil {
+		return strconv.Quote(s)
+	}
+	return strings.TrimSuffix(string(out), "\n")
+}
+
+// yamlList renders a flow-style YAML list
+func yamlList(items []string) string {
+	quoted := make([]string, 0, len(items))
+	for _, item := range items {
+		quoted = append(quoted, yamlScalar(item))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// htmlComment matches the HTML comments dropped from an editor buffer
+var htmlComment = regexp.MustCompile(`(?s)<!--.*?-->`)
+
+// parseCreateEditorBuffer parses a saved --editor buffer, dropping HTML
+// comments and empty field values
+func parseCreateEditorBuffer(text string) (issueFromFile, error) {
+	issue, err := parseMarkdownIssue(text)
+	if err != nil {
+		return issue, err
+	}
+
+	issue.Body = strings.TrimSpace(htmlComment.ReplaceAllString(issue.Body, ""))
+	for key, value := range issue.Fields {
+		if strings.TrimSpace(value) == "" {
+			delete(issue.Fields, key)
+		}
+	}
+	return issue, nil
+}
+
+// runCreateInEditor composes the issue in an editor and creates it
+func runCreateInEditor(cmd *cobra.Command, opts *createOptions, cfg *config.Config, owner, repo string, edit func(text, pattern string) (string, error)) error {
+	text, err := edit(buildCreateEditorTemplate(opts, cfg), "gh-pmu-issue-*.md")
+	if err != nil {
+		return err
+	}
+
+	issueData, err := parseCreateEditorBuffer(text)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(issueData.Title) == "" {
+		return fmt.Errorf("issue not created: the title is empty")
+	}
+
+	// The buffer already holds the flag values, so they are not merged again
+	return createFromIssueData(cmd, &createOptions{parent: opts.parent, noInherit: opts.noInherit, checkDuplicates: opts.checkDuplicates, noDuplicates: opts.noDuplicates}, cfg, owner, repo, issueData)
 }
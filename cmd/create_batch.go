@@ -0,0 +1,319 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// batchRow is one issue in a --batch manifest. Parent is an issue
+// reference, or row:N for an issue created earlier in the same batch.
+type batchRow struct {
+	issueFromFile `yaml:",inline"`
+	Parent        string `json:"parent" yaml:"parent"`
+}
+
+// batchCSVColumns are the CSV columns with a fixed meaning; any other
+// column is a project field, by alias or name
+var batchCSVColumns = map[string]bool{
+	"title": true, "body": true, "labels": true, "assignees": true,
+	"milestone": true, "status": true, "priority": true, "parent": true,
+}
+
+// batchResult is the outcome of one manifest row
+type batchResult struct {
+	Row    int    `json:"row"`
+	Title  string `json:"title"`
+	Parent string `json:"parent,omitempty"`
+	Number int    `json:"number,omitempty"`
+	URL    string `json:"url,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+// batchReport is the JSON report of a --batch run
+type batchReport struct {
+	DryRun  bool          `json:"dryRun"`
+	Planned []batchResult `json:"planned,omitempty"`
+	Created []batchResult `json:"created"`
+	Failed  []batchResult `json:"failed"`
+}
+
+// batchCreateFunc creates one issue; it is replaced in tests
+type batchCreateFunc func(opts *createOptions, issueData issueFromFile) (*api.Issue, error)
+
+// runCreateBatch reads the --batch manifest and creates its issues
+func runCreateBatch(cmd *cobra.Command, opts *createOptions, cfg *config.Config, owner, repo string) error {
+	var data []byte
+	var err error
+	if opts.batch == "-" {
+		data, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		data, err = os.ReadFile(opts.batch)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read batch file: %w", err)
+	}
+
+	rows, err := parseBatchManifest(opts.batch, data)
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient()
+	create := func(rowOpts *createOptions, issueData issueFromFile) (*api.Issue, error) {
+		issue, _, err := createIssueWithFields(client, rowOpts, cfg, owner, repo, issueData)
+		return issue, err
+	}
+	return runCreateBatchWithDeps(cmd, opts, cfg, owner, repo, rows, create, time.Now())
+}
+
+// parseBatchManifest parses a CSV, YAML, or JSON manifest. CSV is chosen by
+// extension, or for stdin when the input does not look like YAML or JSON.
+func parseBatchManifest(name string, data []byte) ([]batchRow, error) {
+	ext := strings.ToLower(filepath.Ext(name))
+	trimmed := strings.TrimSpace(strings.TrimLeft(string(data), "\ufeff"))
+
+	var rows []batchRow
+	switch {
+	case ext == ".csv" || (name == "-" && !strings.HasPrefix(trimmed, "[") && !strings.HasPrefix(trimmed, "-")):
+		return parseBatchCSV(trimmed)
+	case ext == ".json" || (name == "-" && strings.HasPrefix(trimmed, "[")):
+		if err := json.Unmarshal([]byte(trimmed), &rows); err != nil {
+			return nil, fmt.Errorf("failed to parse batch JSON: %w", err)
+		}
+	default:
+		if err := yaml.Unmarshal([]byte(trimmed), &rows); err != nil {
+			return nil, fmt.Errorf("failed to parse batch YAML: %w", err)
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("batch file has no issues")
+	}
+	return rows, nil
+}
+
+// parseBatchCSV parses a CSV manifest with a header row. Labels and
+// assignees are separated by commas or semicolons within their cell.
+func parseBatchCSV(text string) ([]batchRow, error) {
+	reader := csv.NewReader(strings.NewReader(text))
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse batch CSV: %w", err)
+	}
+	if len(records) < 2 {
+		return nil, fmt.Errorf("batch file has no issues")
+	}
+
+	header := make([]string, len(records[0]))
+	hasTitle := false
+	for i, h := range records[0] {
+		header[i] = strings.TrimSpace(h)
+		if strings.EqualFold(header[i], "title") {
+			hasTitle = true
+		}
+	}
+	if !hasTitle {
+		return nil, fmt.Errorf("batch CSV needs a title column")
+	}
+
+	rows := make([]batchRow, 0, len(records)-1)
+	for _, record := range records[1:] {
+		var row batchRow
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			if value == "" || i >= len(header) {
+				continue
+			}
+			column := strings.ToLower(header[i])
+			switch column {
+			case "title":
+				row.Title = value
+			case "body":
+				row.Body = value
+			case "labels":
+				row.Labels = splitBatchList(value)
+			case "assignees":
+				row.Assignees = splitBatchList(value)
+			case "milestone":
+				row.Milestone = value
+			case "status":
+				row.Status = value
+			case "priority":
+				row.Priority = value
+			case "parent":
+				row.Parent = value
+			default:
+				if row.Fields == nil {
+					row.Fields = map[string]string{}
+				}
+				row.Fields[header[i]] = value
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// splitBatchList splits a CSV cell on commas and semicolons
+func splitBatchList(value string) []string {
+	var items []string
+	for _, item := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ';' }) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// batchRowRef parses a row:N parent reference
+func batchRowRef(parent string) (int, bool) {
+	rest, ok := strings.CutPrefix(strings.ToLower(strings.TrimSpace(parent)), "row:")
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(rest)
+	return n, err == nil
+}
+
+// validateBatchRow checks a row before anything is created
+func validateBatchRow(row batchRow, index int, opts *createOptions, cfg *config.Config, now time.Time) error {
+	if strings.TrimSpace(row.Title) == "" {
+		return fmt.Errorf("title is required")
+	}
+	if row.Parent != "" {
+		if n, ok := batchRowRef(row.Parent); ok {
+			if n < 1 || n >= index {
+				return fmt.Errorf("parent %s must refer to an earlier row", row.Parent)
+			}
+		} else if _, _, _, err := parseIssueReference(row.Parent); err != nil {
+			return fmt.Errorf("invalid parent: %w", err)
+		}
+	}
+	_, err := resolveCreateFields(append(fieldPairs(row.Fields), opts.fields...), cfg, now)
+	return err
+}
+
+// runCreateBatchWithDeps is the testable implementation of create --batch.
+// Rows are numbered from 1 in file order. Invalid rows, and rows whose
+// parent row failed, are reported as failed; the other rows are created.
+func runCreateBatchWithDeps(cmd *cobra.Command, opts *createOptions, cfg *config.Config, owner, repo string, rows []batchRow, create batchCreateFunc, now time.Time) error {
+	report := batchReport{DryRun: opts.dryRun, Created: []batchResult{}, Failed: []batchResult{}}
+	created := map[int]*api.Issue{}
+
+	for i, row := range rows {
+		index := i + 1
+		result := batchResult{Row: index, Title: row.Title, Parent: row.Parent}
+		if result.Parent == "" {
+			result.Parent = opts.parent
+		}
+
+		if err := validateBatchRow(row, index, opts, cfg, now); err != nil {
+			result.Error = err.Error()
+			report.Failed = append(report.Failed, result)
+			continue
+		}
+
+		if opts.dryRun {
+			report.Planned = append(report.Planned, result)
+			continue
+		}
+
+		rowOpts := *opts
+		rowOpts.parent = result.Parent
+		if n, ok := batchRowRef(result.Parent); ok {
+			parent, ok := created[n]
+			if !ok {
+				result.Error = fmt.Sprintf("parent row %d was not created", n)
+				report.Failed = append(report.Failed, result)
+				continue
+			}
+			rowOpts.parent = fmt.Sprintf("%s/%s#%d", parent.Repository.Owner, parent.Repository.Name, parent.Number)
+		}
+
+		issue, err := create(&rowOpts, row.issueFromFile)
+		if issue != nil {
+			created[index] = issue
+			result.Number = issue.Number
+			result.URL = issue.URL
+		}
+		if err != nil {
+			result.Error = err.Error()
+			report.Failed = append(report.Failed, result)
+			continue
+		}
+		report.Created = append(report.Created, result)
+	}
+
+	if opts.json {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return err
+		}
+	} else if err := outputBatchTable(cmd, rows, report); err != nil {
+		return err
+	}
+
+	if len(report.Failed) > 0 && !opts.dryRun {
+		return fmt.Errorf("%d of %d issues failed", len(report.Failed), len(rows))
+	}
+	return nil
+}
+
+// outputBatchTable prints the dry-run preview, or the created and failed rows
+func outputBatchTable(cmd *cobra.Command, rows []batchRow, report batchReport) error {
+	out := cmd.OutOrStdout()
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	if report.DryRun {
+		fmt.Fprintln(w, "ROW\tTITLE\tLABELS\tFIELDS\tPARENT")
+		for _, r := range report.Planned {
+			row := rows[r.Row-1]
+			fields := make([]string, 0, len(row.Fields)+2)
+			if row.Status != "" {
+				fields = append(fields, "status="+row.Status)
+			}
+			if row.Priority != "" {
+				fields = append(fields, "priority="+row.Priority)
+			}
+			fields = append(fields, fieldPairs(row.Fields)...)
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", r.Row, r.Title, strings.Join(row.Labels, ", "), strings.Join(fields, " "), r.Parent)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "\nDry run: %d issue(s) would be created", len(report.Planned))
+		if len(report.Failed) > 0 {
+			fmt.Fprintf(out, ", %d row(s) have errors", len(report.Failed))
+		}
+		fmt.Fprintln(out)
+	} else {
+		fmt.Fprintln(w, "ROW\tISSUE\tTITLE\tPARENT")
+		for _, r := range report.Created {
+			fmt.Fprintf(w, "%d\t#%d\t%s\t%s\n", r.Row, r.Number, r.Title, r.Parent)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "\nCreated %d of %d issue(s)\n", len(report.Created), len(rows))
+	}
+
+	for _, r := range report.Failed {
+		fmt.Fprintf(out, "  ✗ row %d (%s): %s\n", r.Row, r.Title, r.Error)
+	}
+	return nil
+}
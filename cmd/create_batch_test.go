@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+const testBatchCSV = `title,labels,status,priority,Size,parent
+Epic: billing,"epic, billing",backlog,p1,L,
+Invoice export,billing;export,,p2,M,row:1
+Fix rounding,,,,S,#10
+`
+
+func TestParseBatchManifest_CSV(t *testing.T) {
+	rows, err := parseBatchManifest("backlog.csv", []byte(testBatchCSV))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("Expected 3 rows, got %d", len(rows))
+	}
+
+	first := rows[0]
+	if first.Title != "Epic: billing" || strings.Join(first.Labels, "|") != "epic|billing" || first.Status != "backlog" || first.Fields["Size"] != "L" {
+		t.Errorf("Unexpected first row: %+v", first)
+	}
+	if rows[1].Parent != "row:1" || strings.Join(rows[1].Labels, "|") != "billing|export" {
+		t.Errorf("Unexpected second row: %+v", rows[1])
+	}
+	if rows[2].Parent != "#10" || rows[2].Labels != nil {
+		t.Errorf("Unexpected third row: %+v", rows[2])
+	}
+}
+
+func TestParseBatchManifest_YAML(t *testing.T) {
+	manifest := `- title: Epic
+  labels: [epic]
+  fields:
+    size: L
+- title: Child
+  parent: row:1
+`
+	rows, err := parseBatchManifest("plan.yml", []byte(manifest))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(rows) != 2 || rows[0].Fields["size"] != "L" || rows[1].Parent != "row:1" {
+		t.Errorf("Unexpected rows: %+v", rows)
+	}
+}
+
+func TestParseBatchManifest_Errors(t *testing.T) {
+	tests := map[string]string{
+		"no title column": "name,labels\nx,y\n",
+		"no rows":         "title,labels\n",
+	}
+	for name, input := range tests {
+		if _, err := parseBatchManifest("x.csv", []byte(input)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+	if _, err := parseBatchManifest("x.yml", []byte("[]")); err == nil {
+		t.Error("Expected error for an empty YAML manifest")
+	}
+}
+
+func TestRunCreateBatch_DryRun(t *testing.T) {
+	rows, _ := parseBatchManifest("backlog.csv", []byte(testBatchCSV+",,,,,\n"))
+	called := false
+	create := func(opts *createOptions, issueData issueFromFile) (*api.Issue, error) {
+		called = true
+		return nil, nil
+	}
+
+	cmd, buf := newTestCmd()
+	opts := &createOptions{dryRun: true}
+	if err := runCreateBatchWithDeps(cmd, opts, newTestConfig(), "owner", "repo", rows, create, time.Now()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if called {
+		t.Error("Expected no issues to be created in a dry run")
+	}
+
+	out := buf.String()
+	for _, want := range []string{"Epic: billing", "status=backlog priority=p1 Size=L", "row:1", "3 issue(s) would be created, 1 row(s) have errors", "row 4 (): title is required"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunCreateBatch_CreatesWithParentsAndReportsFailures(t *testing.T) {
+	rows := []batchRow{
+		{issueFromFile: issueFromFile{Title: "Epic"}},
+		{issueFromFile: issueFromFile{Title: "Child"}, Parent: "row:1"},
+		{issueFromFile: issueFromFile{Title: "Broken"}},
+		{issueFromFile: issueFromFile{Title: "Orphan"}, Parent: "row:3"},
+		{issueFromFile: issueFromFile{Title: "Standalone"}},
+	}
+
+	var parents []string
+	next := 100
+	create := func(opts *createOptions, issueData issueFromFile) (*api.Issue, error) {
+		parents = append(parents, opts.parent)
+		if issueData.Title == "Broken" {
+			return nil, errors.New("label not found")
+		}
+		next++
+		return &api.Issue{Number: next, Title: issueData.Title, URL: "u", Repository: api.Repository{Owner: "owner", Name: "repo"}}, nil
+	}
+
+	cmd, buf := newTestCmd()
+	opts := &createOptions{json: true, parent: "#7"}
+	err := runCreateBatchWithDeps(cmd, opts, newTestConfig(), "owner", "repo", rows, create, time.Now())
+	if err == nil || !strings.Contains(err.Error(), "2 of 5 issues failed") {
+		t.Errorf("Expected failure summary error, got %v", err)
+	}
+
+	var report batchReport
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("Invalid JSON: %v\n%s", err, buf.String())
+	}
+	if len(report.Created) != 3 || len(report.Failed) != 2 {
+		t.Fatalf("Unexpected report: %+v", report)
+	}
+	if report.Created[1].Number != 102 || report.Created[1].Parent != "row:1" {
+		t.Errorf("Unexpected child result: %+v", report.Created[1])
+	}
+	if report.Failed[1].Row != 4 || !strings.Contains(report.Failed[1].Error, "parent row 3 was not created") {
+		t.Errorf("Unexpected orphan result: %+v", report.Failed[1])
+	}
+
+	// Rows without a parent use --parent; row:N resolves to the created issue
+	want := []string{"#7", "owner/repo#101", "#7", "#7"}
+	if strings.Join(parents, " ") != strings.Join(want, " ") {
+		t.Errorf("Expected parents %v, got %v", want, parents)
+	}
+}
+
+func TestValidateBatchRow(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Metadata = &config.Metadata{Fields: []config.FieldMetadata{{Name: "Estimate", DataType: "NUMBER"}}}
+
+	tests := []struct {
+		name    string
+		row     batchRow
+		index   int
+		wantErr string
+	}{
+		{"valid", batchRow{issueFromFile: issueFromFile{Title: "x", Fields: map[string]string{"Estimate": "3"}}}, 1, ""},
+		{"forward parent", batchRow{issueFromFile: issueFromFile{Title: "x"}, Parent: "row:2"}, 2, "earlier row"},
+		{"bad parent", batchRow{issueFromFile: issueFromFile{Title: "x"}, Parent: "nope"}, 1, "invalid parent"},
+		{"bad field", batchRow{issueFromFile: issueFromFile{Title: "x", Fields: map[string]string{"Estimate": "lots"}}}, 1, "expected a number"},
+	}
+
+	for _, tt := range tests {
+		err := validateBatchRow(tt.row, tt.index, &createOptions{}, cfg, time.Now())
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.name, tt.wantErr, err)
+		}
+	}
+}
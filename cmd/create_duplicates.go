@@ -0,0 +1,109 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/similar"
+)
+
+// duplicateTitleThreshold is the title similarity from which an open issue
+// is reported as a possible duplicate
+const duplicateTitleThreshold = 0.6
+
+// maxDuplicateCandidates bounds the candidates listed for one title
+const maxDuplicateCandidates = 5
+
+// createDuplicateClient defines the interface for API methods used by the
+// duplicate check. This allows for easier testing with mock implementations.
+type createDuplicateClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+}
+
+// createDuplicate is an open issue whose title resembles a new issue's
+type createDuplicate struct {
+	issue *api.Issue
+	score float64
+}
+
+// findCreateDuplicates returns the open project issues in the configured
+// repositories, and in owner/repo, whose titles resemble title, best first
+func findCreateDuplicates(client createDuplicateClient, cfg *config.Config, owner, repo, title string) ([]createDuplicate, error) {
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	repos := map[string]bool{strings.ToLower(owner + "/" + repo): true}
+	for _, r := range cfg.Repositories {
+		repos[strings.ToLower(r)] = true
+	}
+
+	var candidates []createDuplicate
+	for _, item := range items {
+		issue := item.Issue
+		if issue == nil || !strings.EqualFold(issue.State, "OPEN") {
+			continue
+		}
+		if !repos[strings.ToLower(issue.Repository.Owner+"/"+issue.Repository.Name)] {
+			continue
+		}
+		if score := similar.TitleSimilarity(title, issue.Title); score >= duplicateTitleThreshold {
+			candidates = append(candidates, createDuplicate{issue: issue, score: score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+	if len(candidates) > maxDuplicateCandidates {
+		candidates = candidates[:maxDuplicateCandidates]
+	}
+	return candidates, nil
+}
+
+// formatCreateDuplicates lists duplicate candidates, one per line
+func formatCreateDuplicates(candidates []createDuplicate) string {
+	var b strings.Builder
+	for _, c := range candidates {
+		fmt.Fprintf(&b, "\n  %s/%s#%d  %s  (%.0f%% similar)", c.issue.Repository.Owner, c.issue.Repository.Name, c.issue.Number, c.issue.Title, c.score*100)
+	}
+	return b.String()
+}
+
+// checkCreateDuplicates runs the --check-duplicates and --no-duplicates
+// check before an issue is created. Candidates are a warning, or with
+// --no-duplicates an error that stops the issue from being created.
+func checkCreateDuplicates(client createDuplicateClient, opts *createOptions, cfg *config.Config, owner, repo, title string) error {
+	if !opts.checkDuplicates && !opts.noDuplicates {
+		return nil
+	}
+
+	candidates, err := findCreateDuplicates(client, cfg, owner, repo, title)
+	if err != nil {
+		if opts.noDuplicates {
+			return fmt.Errorf("failed to check for duplicates: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "Warning: failed to check for duplicates: %v\n", err)
+		return nil
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	if opts.noDuplicates {
+		return fmt.Errorf("not creating %q: %d possible duplicate(s) found (drop --no-duplicates to create it anyway):%s", title, len(candidates), formatCreateDuplicates(candidates))
+	}
+	fmt.Fprintf(os.Stderr, "Warning: %q may duplicate:%s\n", title, formatCreateDuplicates(candidates))
+	return nil
+}
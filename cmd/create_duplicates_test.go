@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// mockDuplicateClient implements createDuplicateClient interface for testing
+type mockDuplicateClient struct {
+	items    []api.ProjectItem
+	itemsErr error
+}
+
+func (m *mockDuplicateClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockDuplicateClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, m.itemsErr
+}
+
+func duplicateTestItem(repo string, number int, title, state string) api.ProjectItem {
+	owner, name := splitRepository(repo)
+	return api.ProjectItem{Issue: &api.Issue{
+		Number:     number,
+		Title:      title,
+		State:      state,
+		Repository: api.Repository{Owner: owner, Name: name},
+	}}
+}
+
+func TestFindCreateDuplicates(t *testing.T) {
+	client := &mockDuplicateClient{items: []api.ProjectItem{
+		duplicateTestItem("owner/repo", 1, "Login page crashes on Safari", "OPEN"),
+		duplicateTestItem("owner/repo", 2, "Login page crashes", "OPEN"),
+		duplicateTestItem("owner/repo", 3, "Login page crashes", "CLOSED"),
+		duplicateTestItem("other/repo", 4, "Login page crashes", "OPEN"),
+		duplicateTestItem("owner/target", 5, "The login page crashed", "OPEN"),
+		duplicateTestItem("owner/repo", 6, "Add dark mode", "OPEN"),
+		{ID: "draft"},
+	}}
+
+	cfg := &config.Config{Project: config.Project{Owner: "owner", Number: 1}, Repositories: []string{"owner/repo"}}
+	candidates, err := findCreateDuplicates(client, cfg, "owner", "target", "Login page crashes")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var got []int
+	for _, c := range candidates {
+		got = append(got, c.issue.Number)
+	}
+	if len(got) != 3 || got[0] != 2 || got[1] != 1 || got[2] != 5 {
+		t.Errorf("Expected open issues in the configured and target repos, best first (#2, #1, #5), got %v", got)
+	}
+}
+
+func TestCheckCreateDuplicates(t *testing.T) {
+	cfg := newTestConfig()
+	client := &mockDuplicateClient{items: []api.ProjectItem{
+		duplicateTestItem("owner/repo", 7, "Login page crashes", "OPEN"),
+	}}
+
+	if err := checkCreateDuplicates(client, &createOptions{}, cfg, "owner", "repo", "Login page crashes"); err != nil {
+		t.Errorf("Expected no check without a flag, got %v", err)
+	}
+
+	if err := checkCreateDuplicates(client, &createOptions{checkDuplicates: true}, cfg, "owner", "repo", "Login page crashes"); err != nil {
+		t.Errorf("Expected only a warning with --check-duplicates, got %v", err)
+	}
+
+	err := checkCreateDuplicates(client, &createOptions{noDuplicates: true}, cfg, "owner", "repo", "Login page crash")
+	if err == nil {
+		t.Fatal("Expected --no-duplicates to refuse a duplicate")
+	}
+	for _, want := range []string{"1 possible duplicate", "owner/repo#7  Login page crashes  (100% similar)"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to contain %q, got: %v", want, err)
+		}
+	}
+
+	if err := checkCreateDuplicates(client, &createOptions{noDuplicates: true}, cfg, "owner", "repo", "Add dark mode"); err != nil {
+		t.Errorf("Expected a distinct title to pass, got %v", err)
+	}
+
+	// A failed lookup only blocks creation with --no-duplicates
+	failing := &mockDuplicateClient{itemsErr: errors.New("timeout")}
+	if err := checkCreateDuplicates(failing, &createOptions{checkDuplicates: true}, cfg, "owner", "repo", "Anything"); err != nil {
+		t.Errorf("Expected a warning when the lookup fails, got %v", err)
+	}
+	if err := checkCreateDuplicates(failing, &createOptions{noDuplicates: true}, cfg, "owner", "repo", "Anything"); err == nil {
+		t.Error("Expected --no-duplicates to fail when the lookup fails")
+	}
+}
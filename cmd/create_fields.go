@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// createFieldValue is a --field assignment resolved to the project field's
+// name and a value the API accepts
+type createFieldValue struct {
+	Field string
+	Value string
+}
+
+// resolveCreateFields parses --field key=value pairs. Keys are config aliases
+// (status, priority, ...) or project field names; values are resolved through
+// the alias's value map and checked against the cached field metadata:
+// options for single-select fields, numbers, YYYY-MM-DD dates, and
+// current, next, or an iteration title for iteration fields. A later pair
+// for the same field replaces an earlier one.
+func resolveCreateFields(pairs []string, cfg *config.Config, now time.Time) ([]createFieldValue, error) {
+	var resolved []createFieldValue
+	index := map[string]int{}
+
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid --field %q: expected key=value", pair)
+		}
+		value = strings.TrimSpace(value)
+
+		name, ok := resolveProjectFieldName(cfg, key)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q: not a configured alias or a field in the project metadata\nRun 'gh pmu init' to refresh the metadata", key)
+		}
+
+		value, err := resolveCreateFieldValue(cfg, key, name, value, now)
+		if err != nil {
+			return nil, err
+		}
+
+		if i, seen := index[strings.ToLower(name)]; seen {
+			resolved[i].Value = value
+			continue
+		}
+		index[strings.ToLower(name)] = len(resolved)
+		resolved = append(resolved, createFieldValue{Field: name, Value: value})
+	}
+
+	return resolved, nil
+}
+
+// resolveCreateFieldValue maps a value alias to the field's value and
+// validates it against the field's data type when metadata is cached
+func resolveCreateFieldValue(cfg *config.Config, key, name, value string, now time.Time) (string, error) {
+	if alias := fieldAliasFor(cfg, key, name); alias != "" {
+		value = cfg.ResolveFieldValue(alias, value)
+	}
+
+	meta := findFieldMetadata(cfg, name)
+	if meta == nil {
+		return value, nil
+	}
+
+	switch meta.DataType {
+	case "SINGLE_SELECT":
+		names := make([]string, 0, len(meta.Options))
+		for _, opt := range meta.Options {
+			if strings.EqualFold(opt.Name, value) {
+				return opt.Name, nil
+			}
+			names = append(names, opt.Name)
+		}
+		return "", fmt.Errorf("invalid value %q for %s: use one of %s", value, meta.Name, strings.Join(names, ", "))
+	case "NUMBER":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return "", fmt.Errorf("invalid value %q for %s: expected a number", value, meta.Name)
+		}
+	case "DATE":
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return "", fmt.Errorf("invalid value %q for %s: expected a date (YYYY-MM-DD)", value, meta.Name)
+		}
+	case "ITERATION":
+		field := api.ProjectField{Name: meta.Name, DataType: meta.DataType}
+		for _, it := range meta.Iterations {
+			field.Iterations = append(field.Iterations, api.Iteration{
+				ID:        it.ID,
+				Title:     it.Title,
+				StartDate: it.StartDate,
+				Duration:  it.Duration,
+			})
+		}
+		_, iteration, err := resolveIteration([]api.ProjectField{field}, meta.Name, value, now)
+		if err != nil {
+			return "", err
+		}
+		return iteration.Title, nil
+	}
+
+	return value, nil
+}
+
+// fieldAliasFor returns the config alias for a --field key: the key itself
+// when it is an alias, otherwise the alias mapped to the field name
+func fieldAliasFor(cfg *config.Config, key, name string) string {
+	if cfg == nil {
+		return ""
+	}
+	if _, ok := cfg.Fields[key]; ok {
+		return key
+	}
+	aliases := make([]string, 0, len(cfg.Fields))
+	for alias := range cfg.Fields {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+	for _, alias := range aliases {
+		if strings.EqualFold(cfg.Fields[alias].Field, name) {
+			return alias
+		}
+	}
+	return ""
+}
+
+// findFieldMetadata returns the cached metadata for a project field
+func findFieldMetadata(cfg *config.Config, name string) *config.FieldMetadata {
+	if cfg == nil || cfg.Metadata == nil {
+		return nil
+	}
+	for i, f := range cfg.Metadata.Fields {
+		if strings.EqualFold(f.Name, name) {
+			return &cfg.Metadata.Fields[i]
+		}
+	}
+	return nil
+}
+
+// setCreateFields sets resolved --field values on a new project item and
+// returns the ones that were set. Failures are reported as warnings, like
+// the status and priority fields.
+func setCreateFields(client *api.Client, projectID, itemID string, fields []createFieldValue) []createFieldValue {
+	var applied []createFieldValue
+	for _, f := range fields {
+		if err := client.SetProjectItemField(projectID, itemID, f.Field, f.Value); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to set %s: %v\n", f.Field, err)
+			continue
+		}
+		applied = append(applied, f)
+	}
+	return applied
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+func newCreateFieldsTestConfig() *config.Config {
+	return &config.Config{
+		Fields: map[string]config.Field{
+			"status": {Field: "Status", Values: map[string]string{"backlog": "Backlog", "done": "Done"}},
+			"size":   {Field: "Size", Values: map[string]string{"m": "M"}},
+		},
+		Metadata: &config.Metadata{
+			Fields: []config.FieldMetadata{
+				{Name: "Status", DataType: "SINGLE_SELECT", Options: []config.OptionMetadata{{Name: "Backlog"}, {Name: "Done"}}},
+				{Name: "Size", DataType: "SINGLE_SELECT", Options: []config.OptionMetadata{{Name: "S"}, {Name: "M"}, {Name: "L"}}},
+				{Name: "Estimate", DataType: "NUMBER"},
+				{Name: "Target date", DataType: "DATE"},
+				{Name: "Notes", DataType: "TEXT"},
+				{Name: "Sprint", DataType: "ITERATION", Iterations: []config.IterationMetadata{
+					{ID: "it-1", Title: "Sprint 1", StartDate: "2025-03-03", Duration: 14},
+					{ID: "it-2", Title: "Sprint 2", StartDate: "2025-03-17", Duration: 14},
+				}},
+			},
+		},
+	}
+}
+
+func TestResolveCreateFields(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	got, err := resolveCreateFields([]string{
+		"size=m",
+		"Size=l",
+		"estimate=3.5",
+		"target date=2025-04-01",
+		"notes=needs design review",
+		"sprint=next",
+		"status=done",
+	}, newCreateFieldsTestConfig(), now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []createFieldValue{
+		{Field: "Size", Value: "L"},
+		{Field: "Estimate", Value: "3.5"},
+		{Field: "Target date", Value: "2025-04-01"},
+		{Field: "Notes", Value: "needs design review"},
+		{Field: "Sprint", Value: "Sprint 2"},
+		{Field: "Status", Value: "Done"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("resolveCreateFields() = %+v, want %+v", got, want)
+	}
+}
+
+func TestResolveCreateFields_Errors(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		pair    string
+		wantErr string
+	}{
+		{"missing equals", "size", "expected key=value"},
+		{"empty key", "=M", "expected key=value"},
+		{"unknown field", "color=red", `unknown field "color"`},
+		{"invalid option", "size=XXL", "use one of S, M, L"},
+		{"invalid number", "estimate=lots", "expected a number"},
+		{"invalid date", "target date=tomorrow", "expected a date"},
+		{"unknown iteration", "sprint=Sprint 9", `iteration "Sprint 9" not found`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resolveCreateFields([]string{tt.pair}, newCreateFieldsTestConfig(), now)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestResolveCreateFields_NoMetadata(t *testing.T) {
+	cfg := &config.Config{}
+
+	got, err := resolveCreateFields([]string{"Size=XL", "Estimate=5"}, cfg, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []createFieldValue{{Field: "Size", Value: "XL"}, {Field: "Estimate", Value: "5"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected values to pass through without metadata, got %+v", got)
+	}
+}
+
+func TestCreateCommand_HasFieldFlag(t *testing.T) {
+	cmd := newCreateCommand()
+
+	flag := cmd.Flags().Lookup("field")
+	if flag == nil {
+		t.Fatal("Expected --field flag to exist")
+	}
+	if flag.Value.Type() != "stringArray" {
+		t.Errorf("Expected --field to be repeatable, got type %s", flag.Value.Type())
+	}
+}
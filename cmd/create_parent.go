@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// createParent is the issue given with create --parent
+type createParent struct {
+	owner string
+	repo  string
+	issue *api.Issue
+}
+
+// sameRepo reports whether the parent lives in owner/repo
+func (p *createParent) sameRepo(owner, repo string) bool {
+	return strings.EqualFold(p.owner+"/"+p.repo, owner+"/"+repo)
+}
+
+// resolveCreateParent fetches the --parent issue. A bare number refers to
+// the repository the new issue is created in.
+func resolveCreateParent(client *api.Client, ref, owner, repo string) (*createParent, error) {
+	parentOwner, parentRepo, number, err := parseIssueReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid parent issue: %w", err)
+	}
+	if parentOwner == "" || parentRepo == "" {
+		parentOwner, parentRepo = owner, repo
+	}
+
+	issue, err := client.GetIssue(parentOwner, parentRepo, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parent issue #%d: %w", number, err)
+	}
+	return &createParent{owner: parentOwner, repo: parentRepo, issue: issue}, nil
+}
+
+// inheritParentLabels adds the parent's labels that are not already present
+func inheritParentLabels(labels []string, parent *api.Issue) []string {
+	for _, l := range parent.Labels {
+		dupe := false
+		for _, existing := range labels {
+			if strings.EqualFold(existing, l.Name) {
+				dupe = true
+				break
+			}
+		}
+		if !dupe {
+			labels = append(labels, l.Name)
+		}
+	}
+	return labels
+}
+
+// parentIterationValue returns the parent item's iteration to copy to the
+// new issue, or false when the parent has none or one was set explicitly
+func parentIterationValue(cfg *config.Config, item *api.ProjectItem, fields []createFieldValue) (createFieldValue, bool) {
+	if item == nil {
+		return createFieldValue{}, false
+	}
+	name := prIterationFieldName(cfg)
+	for _, f := range fields {
+		if strings.EqualFold(f.Field, name) {
+			return createFieldValue{}, false
+		}
+	}
+	for _, fv := range item.FieldValues {
+		if strings.EqualFold(fv.Field, name) && fv.Value != "" {
+			return createFieldValue{Field: fv.Field, Value: fv.Value}, true
+		}
+	}
+	return createFieldValue{}, false
+}
+
+// linkCreateParent links a new issue under its parent and, when inherit is
+// set, copies the parent's iteration. It returns the values to show in the
+// create summary. Failures are warnings: the issue already exists.
+func linkCreateParent(client *api.Client, cfg *config.Config, projectID, itemID string, issue *api.Issue, parent *createParent, fields []createFieldValue, inherit bool) []createFieldValue {
+	var shown []createFieldValue
+
+	if inherit {
+		item, err := client.GetIssueProjectItem(parent.owner, parent.repo, parent.issue.Number, projectID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to read the parent's project fields: %v\n", err)
+		} else if iteration, ok := parentIterationValue(cfg, item, fields); ok {
+			if err := client.SetProjectItemField(projectID, itemID, iteration.Field, iteration.Value); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to set %s: %v\n", iteration.Field, err)
+			} else {
+				shown = append(shown, iteration)
+			}
+		}
+	}
+
+	ref := fmt.Sprintf("#%d", parent.issue.Number)
+	if !parent.sameRepo(issue.Repository.Owner, issue.Repository.Name) {
+		ref = fmt.Sprintf("%s/%s#%d", parent.owner, parent.repo, parent.issue.Number)
+	}
+	if err := client.AddSubIssue(parent.issue.ID, issue.ID); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: issue created but failed to link as sub-issue of %s: %v\n", ref, err)
+		return shown
+	}
+	return append(shown, createFieldValue{Field: "Parent", Value: ref + " " + parent.issue.Title})
+}
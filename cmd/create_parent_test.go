@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+func TestCreateCommand_HasParentFlags(t *testing.T) {
+	cmd := NewRootCommand()
+	createCmd, _, err := cmd.Find([]string{"create"})
+	if err != nil {
+		t.Fatalf("create command not found: %v", err)
+	}
+	for _, flag := range []string{"parent", "no-inherit"} {
+		if createCmd.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag", flag)
+		}
+	}
+}
+
+func TestCreateParent_SameRepo(t *testing.T) {
+	p := &createParent{owner: "Owner", repo: "Repo"}
+	if !p.sameRepo("owner", "repo") {
+		t.Error("Expected repositories to match case-insensitively")
+	}
+	if p.sameRepo("owner", "other") {
+		t.Error("Expected a different repository not to match")
+	}
+}
+
+func TestInheritParentLabels(t *testing.T) {
+	parent := &api.Issue{Labels: []api.Label{{Name: "epic"}, {Name: "Backend"}}}
+
+	got := inheritParentLabels([]string{"pm-tracked", "backend"}, parent)
+	want := []string{"pm-tracked", "backend", "epic"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("inheritParentLabels() = %v, want %v", got, want)
+	}
+}
+
+func TestParentIterationValue(t *testing.T) {
+	cfg := &config.Config{Fields: map[string]config.Field{"iteration": {Field: "Sprint"}}}
+	item := &api.ProjectItem{FieldValues: []api.FieldValue{
+		{Field: "Status", Value: "In progress"},
+		{Field: "Sprint", Value: "Sprint 4"},
+	}}
+
+	got, ok := parentIterationValue(cfg, item, nil)
+	if !ok || got != (createFieldValue{Field: "Sprint", Value: "Sprint 4"}) {
+		t.Errorf("parentIterationValue() = %+v, %v", got, ok)
+	}
+
+	// An iteration given with --field wins over the parent's
+	if _, ok := parentIterationValue(cfg, item, []createFieldValue{{Field: "sprint", Value: "Sprint 5"}}); ok {
+		t.Error("Expected an explicit iteration not to be replaced")
+	}
+
+	if _, ok := parentIterationValue(cfg, &api.ProjectItem{}, nil); ok {
+		t.Error("Expected no iteration when the parent has none")
+	}
+	if _, ok := parentIterationValue(cfg, nil, nil); ok {
+		t.Error("Expected no iteration when the parent is not in the project")
+	}
+}
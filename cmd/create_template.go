@@ -0,0 +1,351 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/ui"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// issueTemplateDir is where GitHub looks for issue templates
+const issueTemplateDir = ".github/ISSUE_TEMPLATE"
+
+// issueTemplate is a Markdown issue template or a YAML issue form
+type issueTemplate struct {
+	Name        string             `yaml:"name"`
+	About       string             `yaml:"about"`
+	Description string             `yaml:"description"`
+	Title       string             `yaml:"title"`
+	Labels      templateList       `yaml:"labels"`
+	Assignees   templateList       `yaml:"assignees"`
+	Form        []issueFormElement `yaml:"body"`
+
+	// Body is the text of a Markdown template
+	Body string `yaml:"-"`
+}
+
+// issueFormElement is one element of an issue form body
+type issueFormElement struct {
+	Type       string `yaml:"type"`
+	ID         string `yaml:"id"`
+	Attributes struct {
+		Label       string       `yaml:"label"`
+		Description string       `yaml:"description"`
+		Placeholder string       `yaml:"placeholder"`
+		Value       string       `yaml:"value"`
+		Render      string       `yaml:"render"`
+		Multiple    bool         `yaml:"multiple"`
+		Options     []formOption `yaml:"options"`
+		Default     *int         `yaml:"default"`
+	} `yaml:"attributes"`
+	Validations struct {
+		Required bool `yaml:"required"`
+	} `yaml:"validations"`
+}
+
+// formOption is a dropdown or checkbox option. Dropdown options are plain
+// strings; checkbox options have a label.
+type formOption struct {
+	Label    string `yaml:"label"`
+	Required bool   `yaml:"required"`
+}
+
+// UnmarshalYAML accepts a plain string option as well as a mapping
+func (o *formOption) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		o.Label = node.Value
+		return nil
+	}
+	type plain formOption
+	return node.Decode((*plain)(o))
+}
+
+// templateList is a list that templates may also write as "a, b"
+type templateList []string
+
+// UnmarshalYAML accepts a YAML list or a comma-separated string
+func (l *templateList) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		for _, item := range strings.Split(node.Value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				*l = append(*l, item)
+			}
+		}
+		return nil
+	}
+	var items []string
+	if err := node.Decode(&items); err != nil {
+		return err
+	}
+	*l = items
+	return nil
+}
+
+// repositoryRoot returns the top level of the current git checkout, or the
+// working directory outside one
+func repositoryRoot() (string, error) {
+	if out, err := exec.Command("git", "rev-parse", "--show-toplevel").Output(); err == nil {
+		return strings.TrimSpace(string(out)), nil
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current directory: %w", err)
+	}
+	return cwd, nil
+}
+
+// findIssueTemplate finds a template by file name (without extension) or
+// by its name: field
+func findIssueTemplate(dir, name string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", fmt.Errorf("no issue templates found in %s", issueTemplateDir)
+	}
+
+	var available []string
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		base := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".md" && ext != ".yml" && ext != ".yaml") || strings.EqualFold(base, "config") {
+			continue
+		}
+		available = append(available, base)
+
+		path := filepath.Join(dir, entry.Name())
+		if strings.EqualFold(base, name) {
+			return path, nil
+		}
+		if tmpl, err := loadIssueTemplate(path); err == nil && strings.EqualFold(tmpl.Name, name) {
+			return path, nil
+		}
+	}
+
+	sort.Strings(available)
+	if len(available) == 0 {
+		return "", fmt.Errorf("no issue templates found in %s", issueTemplateDir)
+	}
+	return "", fmt.Errorf("issue template %q not found; available: %s", name, strings.Join(available, ", "))
+}
+
+// loadIssueTemplate reads a Markdown template (front matter and body) or
+// a YAML issue form
+func loadIssueTemplate(path string) (*issueTemplate, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read issue template: %w", err)
+	}
+
+	tmpl := &issueTemplate{}
+	if strings.EqualFold(filepath.Ext(path), ".md") {
+		text := strings.ReplaceAll(string(data), "\r\n", "\n")
+		body := text
+		if rest, ok := strings.CutPrefix(strings.TrimLeft(text, "\n"), "---\n"); ok {
+			frontMatter, after, found := strings.Cut(rest, "\n---")
+			if !found {
+				return nil, fmt.Errorf("failed to parse issue template %s: front matter is not closed with ---", path)
+			}
+			if err := yaml.Unmarshal([]byte(frontMatter), tmpl); err != nil {
+				return nil, fmt.Errorf("failed to parse issue template %s: %w", path, err)
+			}
+			_, body, _ = strings.Cut(after, "\n")
+		}
+		tmpl.Body = strings.TrimSpace(body)
+		return tmpl, nil
+	}
+
+	if err := yaml.Unmarshal(data, tmpl); err != nil {
+		return nil, fmt.Errorf("failed to parse issue form %s: %w", path, err)
+	}
+	return tmpl, nil
+}
+
+// runCreateFromTemplate creates an issue from a repository issue template,
+// prompting for the fields of an issue form
+func runCreateFromTemplate(cmd *cobra.Command, opts *createOptions, cfg *config.Config, owner, repo string) error {
+	root, err := repositoryRoot()
+	if err != nil {
+		return err
+	}
+	path, err := findIssueTemplate(filepath.Join(root, issueTemplateDir), opts.template)
+	if err != nil {
+		return err
+	}
+	tmpl, err := loadIssueTemplate(path)
+	if err != nil {
+		return err
+	}
+
+	u := ui.NewWithOptions(cmd.OutOrStdout(), colorDisabled(cmd))
+	issueData, err := promptIssueTemplate(cmd.OutOrStdout(), bufio.NewReader(cmd.InOrStdin()), u, tmpl, opts.title)
+	if err != nil {
+		return err
+	}
+
+	return createFromIssueData(cmd, opts, cfg, owner, repo, issueData)
+}
+
+// promptIssueTemplate asks for the title and the issue form fields, and
+// renders the body the way GitHub does for forms
+func promptIssueTemplate(out io.Writer, reader *bufio.Reader, u *ui.UI, tmpl *issueTemplate, title string) (issueFromFile, error) {
+	issueData := issueFromFile{
+		Labels:    tmpl.Labels,
+		Assignees: tmpl.Assignees,
+		Body:      tmpl.Body,
+	}
+
+	if tmpl.Name != "" {
+		u.Header(tmpl.Name, firstNonEmpty(tmpl.About, tmpl.Description))
+		fmt.Fprintln(out)
+	}
+
+	if title == "" {
+		label := "Title"
+		if tmpl.Title != "" {
+			label = fmt.Sprintf("Title (after %q)", tmpl.Title)
+		}
+		fmt.Fprint(out, u.Prompt(label, ""))
+		input, _ := reader.ReadString('\n')
+		title = strings.TrimSpace(tmpl.Title + strings.TrimSpace(input))
+	}
+	if title == "" || title == strings.TrimSpace(tmpl.Title) {
+		return issueData, fmt.Errorf("issue not created: the title is empty")
+	}
+	issueData.Title = title
+
+	if len(tmpl.Form) == 0 {
+		return issueData, nil
+	}
+
+	var sections []string
+	for _, el := range tmpl.Form {
+		if el.Type == "markdown" {
+			continue
+		}
+		value, err := promptFormElement(out, reader, u, el)
+		if err != nil {
+			return issueData, err
+		}
+		if value == "" {
+			value = "_No response_"
+		} else if el.Type == "textarea" && el.Attributes.Render != "" {
+			value = fmt.Sprintf("```%s\n%s\n```", el.Attributes.Render, value)
+		}
+		sections = append(sections, fmt.Sprintf("### %s\n\n%s", el.Attributes.Label, value))
+	}
+	issueData.Body = strings.Join(sections, "\n\n")
+
+	return issueData, nil
+}
+
+// promptFormElement asks for one issue form field and returns its value
+func promptFormElement(out io.Writer, reader *bufio.Reader, u *ui.UI, el issueFormElement) (string, error) {
+	attrs := el.Attributes
+	label := attrs.Label
+	if el.Validations.Required {
+		label += " *"
+	}
+
+	fmt.Fprintln(out)
+	if attrs.Description != "" {
+		u.Info(attrs.Description)
+	}
+
+	var value string
+	switch el.Type {
+	case "input":
+		fmt.Fprint(out, u.Prompt(label, attrs.Value))
+		input, _ := reader.ReadString('\n')
+		value = firstNonEmpty(strings.TrimSpace(input), attrs.Value)
+
+	case "textarea":
+		fmt.Fprint(out, u.Prompt(label+" (finish with an empty line)", ""))
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			line = strings.TrimRight(line, "\r\n")
+			if line == "" {
+				break
+			}
+			lines = append(lines, line)
+			if err != nil {
+				break
+			}
+		}
+		value = firstNonEmpty(strings.Join(lines, "\n"), strings.TrimSpace(attrs.Value))
+
+	case "dropdown":
+		options := make([]string, 0, len(attrs.Options))
+		for _, opt := range attrs.Options {
+			options = append(options, opt.Label)
+		}
+		u.PrintMenu(options, false)
+		defaultSelection := ""
+		if attrs.Default != nil && *attrs.Default >= 0 && *attrs.Default < len(options) {
+			defaultSelection = strconv.Itoa(*attrs.Default + 1)
+		}
+		if attrs.Multiple {
+			label += " (comma-separated)"
+		}
+		fmt.Fprint(out, u.Prompt(label, defaultSelection))
+		input, _ := reader.ReadString('\n')
+		input = firstNonEmpty(strings.TrimSpace(input), defaultSelection)
+
+		var chosen []string
+		for _, part := range strings.Split(input, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			n, err := strconv.Atoi(part)
+			if err != nil || n < 1 || n > len(options) {
+				return "", fmt.Errorf("invalid selection for %q: %s", attrs.Label, part)
+			}
+			chosen = append(chosen, options[n-1])
+		}
+		if len(chosen) > 1 && !attrs.Multiple {
+			return "", fmt.Errorf("%q takes a single selection", attrs.Label)
+		}
+		value = strings.Join(chosen, ", ")
+
+	case "checkboxes":
+		fmt.Fprintln(out, strings.TrimSuffix(u.Prompt(label, ""), " "))
+		var lines []string
+		for _, opt := range attrs.Options {
+			optLabel := opt.Label
+			if opt.Required {
+				optLabel += " *"
+			}
+			fmt.Fprint(out, u.Prompt("  "+optLabel, "y/N"))
+			input, _ := reader.ReadString('\n')
+			input = strings.ToLower(strings.TrimSpace(input))
+			checked := input == "y" || input == "yes"
+			if opt.Required && !checked {
+				return "", fmt.Errorf("%q must be checked", opt.Label)
+			}
+			mark := " "
+			if checked {
+				mark = "x"
+			}
+			lines = append(lines, fmt.Sprintf("- [%s] %s", mark, opt.Label))
+		}
+		return strings.Join(lines, "\n"), nil
+
+	default:
+		return "", nil
+	}
+
+	if value == "" && el.Validations.Required {
+		return "", fmt.Errorf("%q is required", attrs.Label)
+	}
+	return value, nil
+}
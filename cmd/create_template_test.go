@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/ui"
+)
+
+const testIssueForm = `name: Bug report
+description: File a bug report
+title: "[Bug]: "
+labels: ["bug", "triage"]
+body:
+  - type: markdown
+    attributes:
+      value: Thanks for taking the time to fill out this bug report!
+  - type: input
+    id: version
+    attributes:
+      label: Version
+      value: latest
+  - type: textarea
+    id: what-happened
+    attributes:
+      label: What happened?
+    validations:
+      required: true
+  - type: dropdown
+    id: browsers
+    attributes:
+      label: Browsers
+      multiple: true
+      options:
+        - Firefox
+        - Chrome
+        - Safari
+  - type: textarea
+    id: logs
+    attributes:
+      label: Logs
+      render: shell
+  - type: checkboxes
+    id: terms
+    attributes:
+      label: Code of Conduct
+      options:
+        - label: I agree to follow the Code of Conduct
+          required: true
+`
+
+const testMarkdownTemplate = `---
+name: Feature request
+about: Suggest an idea
+title: "[Feature] "
+labels: enhancement, ui
+assignees: octocat
+---
+
+## Problem
+
+Describe the problem.
+`
+
+func writeIssueTemplates(t *testing.T) string {
+	t.Helper()
+	dir := filepath.Join(t.TempDir(), ".github", "ISSUE_TEMPLATE")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"bug_report.yml":     testIssueForm,
+		"feature_request.md": testMarkdownTemplate,
+		"config.yml":         "blank_issues_enabled: false\n",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func promptTestTemplate(t *testing.T, tmpl *issueTemplate, title, input string) (issueFromFile, string, error) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	got, err := promptIssueTemplate(buf, bufio.NewReader(strings.NewReader(input)), ui.NewWithOptions(buf, true), tmpl, title)
+	return got, buf.String(), err
+}
+
+func TestCreateCommand_HasTemplateFlag(t *testing.T) {
+	flag := newCreateCommand().Flags().Lookup("template")
+	if flag == nil || flag.Shorthand != "T" {
+		t.Fatal("Expected --template/-T flag to exist")
+	}
+}
+
+func TestFindIssueTemplate(t *testing.T) {
+	dir := writeIssueTemplates(t)
+
+	for _, name := range []string{"bug_report", "Bug report", "feature_request", "FEATURE REQUEST"} {
+		if _, err := findIssueTemplate(dir, name); err != nil {
+			t.Errorf("findIssueTemplate(%q) error = %v", name, err)
+		}
+	}
+
+	_, err := findIssueTemplate(dir, "config")
+	if err == nil || !strings.Contains(err.Error(), "available: bug_report, feature_request") {
+		t.Errorf("Expected not found error listing templates, got %v", err)
+	}
+
+	if _, err := findIssueTemplate(filepath.Join(t.TempDir(), "missing"), "bug"); err == nil || !strings.Contains(err.Error(), "no issue templates") {
+		t.Errorf("Expected no templates error, got %v", err)
+	}
+}
+
+func TestLoadIssueTemplate_Markdown(t *testing.T) {
+	dir := writeIssueTemplates(t)
+
+	tmpl, err := loadIssueTemplate(filepath.Join(dir, "feature_request.md"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if tmpl.Name != "Feature request" || tmpl.Title != "[Feature] " {
+		t.Errorf("Unexpected template: %+v", tmpl)
+	}
+	if strings.Join(tmpl.Labels, ",") != "enhancement,ui" || strings.Join(tmpl.Assignees, ",") != "octocat" {
+		t.Errorf("Unexpected labels/assignees: %v / %v", tmpl.Labels, tmpl.Assignees)
+	}
+
+	got, _, err := promptTestTemplate(t, tmpl, "", "Dark mode\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got.Title != "[Feature] Dark mode" || got.Body != "## Problem\n\nDescribe the problem." {
+		t.Errorf("Unexpected issue: %+v", got)
+	}
+}
+
+func TestPromptIssueTemplate_Form(t *testing.T) {
+	dir := writeIssueTemplates(t)
+	tmpl, err := loadIssueTemplate(filepath.Join(dir, "bug_report.yml"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Version keeps its default, two lines for what happened, two browsers,
+	// no logs, and the required checkbox
+	input := "Crash on save\n\nIt crashed.\nTwice.\n\n1,3\n\ny\n"
+	got, output, err := promptTestTemplate(t, tmpl, "", input)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.Title != "[Bug]: Crash on save" {
+		t.Errorf("Unexpected title: %q", got.Title)
+	}
+	if strings.Join(got.Labels, ",") != "bug,triage" {
+		t.Errorf("Unexpected labels: %v", got.Labels)
+	}
+	want := "### Version\n\nlatest\n\n" +
+		"### What happened?\n\nIt crashed.\nTwice.\n\n" +
+		"### Browsers\n\nFirefox, Safari\n\n" +
+		"### Logs\n\n_No response_\n\n" +
+		"### Code of Conduct\n\n- [x] I agree to follow the Code of Conduct"
+	if got.Body != want {
+		t.Errorf("Unexpected body:\n%s\nwant:\n%s", got.Body, want)
+	}
+	for _, want := range []string{"Bug report", "File a bug report", "What happened? *", "3. Safari"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestPromptIssueTemplate_Errors(t *testing.T) {
+	dir := writeIssueTemplates(t)
+	tmpl, err := loadIssueTemplate(filepath.Join(dir, "bug_report.yml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		title string
+		input string
+		want  string
+	}{
+		{"empty title", "", "\n", "title is empty"},
+		{"required textarea", "Given", "\n\n", `"What happened?" is required`},
+		{"invalid dropdown", "Given", "\nIt broke\n\n9\n", "invalid selection"},
+		{"required checkbox", "Given", "\nIt broke\n\n1\n\nn\n", "must be checked"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := promptTestTemplate(t, tmpl, tt.title, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestPromptIssueTemplate_RendersShellBlock(t *testing.T) {
+	tmpl := &issueTemplate{Form: []issueFormElement{{Type: "textarea"}}}
+	tmpl.Form[0].Attributes.Label = "Logs"
+	tmpl.Form[0].Attributes.Render = "shell"
+
+	got, _, err := promptTestTemplate(t, tmpl, "Title", "panic: boom\n\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got.Body != "### Logs\n\n```shell\npanic: boom\n```" {
+		t.Errorf("Unexpected body: %q", got.Body)
+	}
+}
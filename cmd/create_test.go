@@ -6,6 +6,11 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/ui"
+	"github.com/spf13/cobra"
 )
 
 func TestCreateCommand_Exists(t *testing.T) {
@@ -706,3 +711,194 @@ defaults:
 		t.Errorf("Expected to pass config validation with defaults, got: %v", err)
 	}
 }
+
+func TestParseIssueFile_Markdown(t *testing.T) {
+	data := []byte(`---
+title: Add dark mode
+labels: [enhancement, ui]
+status: backlog
+fields:
+  size: M
+---
+
+Users want a **dark** theme.
+
+- [ ] settings toggle
+`)
+
+	issue, err := parseIssueFile("issue.md", data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if issue.Title != "Add dark mode" || issue.Status != "backlog" {
+		t.Errorf("Unexpected metadata: %+v", issue)
+	}
+	if len(issue.Labels) != 2 || issue.Fields["size"] != "M" {
+		t.Errorf("Expected labels and fields from front matter, got %+v", issue)
+	}
+	if issue.Body != "Users want a **dark** theme.\n\n- [ ] settings toggle" {
+		t.Errorf("Unexpected body: %q", issue.Body)
+	}
+}
+
+func TestParseIssueFile_MarkdownHeadingTitle(t *testing.T) {
+	issue, err := parseIssueFile("issue.md", []byte("# Fix login\n\nSteps to reproduce.\n"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if issue.Title != "Fix login" || issue.Body != "Steps to reproduce." {
+		t.Errorf("Expected title from heading, got %+v", issue)
+	}
+}
+
+func TestParseIssueFile_Stdin(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		title string
+	}{
+		{"markdown", "---\ntitle: From markdown\n---\nbody", "From markdown"},
+		{"json", `{"title": "From JSON"}`, "From JSON"},
+		{"yaml", "title: From YAML\nbody: text\n", "From YAML"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue, err := parseIssueFile("-", []byte(tt.input))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if issue.Title != tt.title {
+				t.Errorf("Expected title %q, got %q", tt.title, issue.Title)
+			}
+		})
+	}
+}
+
+func TestParseIssueFile_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		input   string
+		wantErr string
+	}{
+		{"unclosed front matter", "issue.md", "---\ntitle: x\nbody", "not closed"},
+		{"bad front matter", "issue.md", "---\ntitle: [x\n---\n", "failed to parse front matter"},
+		{"bad json", "issue.json", "{", "failed to parse JSON"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseIssueFile(tt.file, []byte(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBuildCreateEditorTemplate(t *testing.T) {
+	cfg := newCreateFieldsTestConfig()
+	cfg.Fields["priority"] = config.Field{Field: "Priority", Values: map[string]string{"p1": "P1", "p2": "P2"}}
+	opts := &createOptions{
+		title:  "Fix: login",
+		body:   "It breaks.",
+		labels: []string{"bug"},
+		status: "backlog",
+		fields: []string{"size=L"},
+	}
+
+	buf := buildCreateEditorTemplate(opts, cfg)
+
+	for _, want := range []string{
+		"title: 'Fix: login'\n",
+		"labels: [bug]\n",
+		"status: backlog # backlog, done\n",
+		"priority:  # p1, p2\n",
+		"  size: L # S, M, L\n",
+		"  Estimate:  # number\n",
+		"  Target date:  # YYYY-MM-DD\n",
+		"  Sprint:  # current, next, or an iteration title\n",
+		"---\nIt breaks.\n",
+	} {
+		if !strings.Contains(buf, want) {
+			t.Errorf("Expected buffer to contain %q, got:\n%s", want, buf)
+		}
+	}
+	if strings.Contains(buf, "  Status:") {
+		t.Errorf("Status belongs at the top level, not in fields:\n%s", buf)
+	}
+
+	// The untouched template round-trips through the parser
+	issue, err := parseCreateEditorBuffer(buf)
+	if err != nil {
+		t.Fatalf("parseCreateEditorBuffer() error = %v", err)
+	}
+	if issue.Title != "Fix: login" || issue.Body != "It breaks." || issue.Status != "backlog" {
+		t.Errorf("Unexpected parsed issue: %+v", issue)
+	}
+	if len(issue.Fields) != 1 || issue.Fields["size"] != "L" {
+		t.Errorf("Expected only non-empty fields, got %v", issue.Fields)
+	}
+}
+
+func TestRunCreateInEditor_EmptyTitleCancels(t *testing.T) {
+	cfg := newCreateFieldsTestConfig()
+	var pattern string
+	edit := func(text, p string) (string, error) {
+		pattern = p
+		return text, nil
+	}
+
+	err := runCreateInEditor(&cobra.Command{}, &createOptions{}, cfg, "owner", "repo", edit)
+	if err == nil || !strings.Contains(err.Error(), "title is empty") {
+		t.Errorf("Expected empty title error, got %v", err)
+	}
+	if !strings.HasSuffix(pattern, ".md") {
+		t.Errorf("Expected a Markdown temp file pattern, got %q", pattern)
+	}
+}
+
+func TestCreateCommand_HasEditorFlag(t *testing.T) {
+	flag := newCreateCommand().Flags().Lookup("editor")
+	if flag == nil || flag.Shorthand != "e" {
+		t.Fatal("Expected --editor/-e flag to exist")
+	}
+}
+
+func TestPrintCreateSummary(t *testing.T) {
+	issue := &api.Issue{
+		Number:     7,
+		Title:      "Add dark mode",
+		URL:        "https://github.com/owner/repo/issues/7",
+		Repository: api.Repository{Owner: "owner", Name: "repo"},
+		Assignees:  []api.Actor{{Login: "octocat"}, {Login: "hubot"}},
+		Milestone:  &api.Milestone{Title: "v1.0"},
+	}
+
+	buf := new(bytes.Buffer)
+	printCreateSummary(ui.NewWithOptions(buf, true), issue, []createFieldValue{
+		{Field: "Status", Value: "Backlog"},
+		{Field: "Size", Value: "M"},
+	})
+
+	output := buf.String()
+	for _, want := range []string{
+		"Created issue #7: Add dark mode",
+		"https://github.com/owner/repo/issues/7",
+		"Assignees:  octocat, hubot",
+		"Milestone:  v1.0",
+		"Status:     Backlog",
+		"Size:       M",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected summary to contain %q, got:\n%s", want, output)
+		}
+	}
+
+	buf.Reset()
+	printCreateSummary(ui.NewWithOptions(buf, true), &api.Issue{Number: 8, Title: "Plain"}, nil)
+	if strings.Contains(buf.String(), "Assignees") || strings.Contains(buf.String(), "Milestone") {
+		t.Errorf("Expected no assignee or milestone rows, got:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// wizardFieldKeys are the project fields the create wizard asks for
+var wizardFieldKeys = []string{"status", "priority", "size"}
+
+// isInteractiveTerminal reports whether the command's input and output are
+// both terminals, or its input is a --script
+func isInteractiveTerminal(cmd *cobra.Command) bool {
+	if isScripted(cmd) {
+		return true
+	}
+	in, ok := cmd.InOrStdin().(*os.File)
+	if !ok || !term.IsTerminal(in) {
+		return false
+	}
+	out, ok := cmd.OutOrStdout().(*os.File)
+	if out == os.Stdout {
+		return term.FromEnv().IsTerminalOutput()
+	}
+	return ok && term.IsTerminal(out)
+}
+
+// runCreateWizardAndCreate prompts for the issue and creates it
+func runCreateWizardAndCreate(cmd *cobra.Command, opts *createOptions, cfg *config.Config, owner, repo string) error {
+	u := ui.NewWithOptions(cmd.OutOrStdout(), colorDisabled(cmd))
+	issueData, err := runCreateWizard(cmd.OutOrStdout(), bufio.NewReader(cmd.InOrStdin()), u, opts, cfg)
+	if err != nil {
+		return err
+	}
+
+	// The answers already hold the flag values, so they are not merged again
+	return createFromIssueData(cmd, &createOptions{parent: opts.parent, noInherit: opts.noInherit, checkDuplicates: opts.checkDuplicates, noDuplicates: opts.noDuplicates}, cfg, owner, repo, issueData)
+}
+
+// runCreateWizard asks for the title, body, and the status, priority, and
+// size fields. Flag values are offered as the defaults.
+func runCreateWizard(out io.Writer, reader *bufio.Reader, u *ui.UI, opts *createOptions, cfg *config.Config) (issueFromFile, error) {
+	issueData := issueFromFile{
+		Labels:    opts.labels,
+		Assignees: opts.assignees,
+		Milestone: opts.milestone,
+		Fields:    map[string]string{},
+	}
+	for _, pair := range opts.fields {
+		if key, value, ok := strings.Cut(pair, "="); ok {
+			issueData.Fields[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+
+	u.Header("gh pmu create", fmt.Sprintf("New issue in %s/%d", cfg.Project.Owner, cfg.Project.Number))
+	fmt.Fprintln(out)
+
+	fmt.Fprint(out, u.Prompt("Title", opts.title))
+	title, _ := reader.ReadString('\n')
+	issueData.Title = strings.TrimSpace(title)
+	if issueData.Title == "" {
+		issueData.Title = opts.title
+	}
+	if issueData.Title == "" {
+		return issueData, fmt.Errorf("issue not created: the title is empty")
+	}
+
+	if opts.body != "" {
+		issueData.Body = opts.body
+	} else {
+		fmt.Fprint(out, u.Prompt("Body (finish with an empty line)", ""))
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			line = strings.TrimRight(line, "\r\n")
+			if line == "" {
+				break
+			}
+			lines = append(lines, line)
+			if err != nil {
+				break
+			}
+		}
+		issueData.Body = strings.Join(lines, "\n")
+	}
+
+	for _, key := range wizardFieldKeys {
+		options := wizardFieldOptions(cfg, key)
+		if len(options) == 0 {
+			continue
+		}
+
+		name := cfg.GetFieldName(key)
+		current := ""
+		switch key {
+		case "status":
+			current = firstNonEmpty(opts.status, cfg.Defaults.Status)
+		case "priority":
+			current = firstNonEmpty(opts.priority, cfg.Defaults.Priority)
+		default:
+			current = issueData.Fields[key]
+		}
+
+		fmt.Fprintln(out)
+		u.PrintMenu(options, false)
+		defaultSelection := ""
+		if current != "" {
+			resolved := cfg.ResolveFieldValue(key, current)
+			for i, opt := range options {
+				if strings.EqualFold(opt, resolved) {
+					defaultSelection = strconv.Itoa(i + 1)
+				}
+			}
+		}
+		fmt.Fprint(out, u.Prompt(fmt.Sprintf("%s (0 to skip)", name), defaultSelection))
+		input, _ := reader.ReadString('\n')
+		input = strings.TrimSpace(input)
+		if input == "" {
+			input = defaultSelection
+		}
+		if input == "" || input == "0" {
+			continue
+		}
+
+		selection, err := strconv.Atoi(input)
+		if err != nil || selection < 1 || selection > len(options) {
+			return issueData, fmt.Errorf("invalid %s selection: %s", strings.ToLower(name), input)
+		}
+		value := options[selection-1]
+
+		switch key {
+		case "status":
+			issueData.Status = value
+		case "priority":
+			issueData.Priority = value
+		default:
+			issueData.Fields[key] = value
+		}
+	}
+
+	fmt.Fprintln(out)
+	return issueData, nil
+}
+
+// wizardFieldOptions lists the values offered for a field: the options in
+// the cached project metadata, or else the aliases from the config
+func wizardFieldOptions(cfg *config.Config, key string) []string {
+	if f := findFieldMetadata(cfg, cfg.GetFieldName(key)); f != nil {
+		if f.DataType != "SINGLE_SELECT" {
+			return nil
+		}
+		options := make([]string, 0, len(f.Options))
+		for _, opt := range f.Options {
+			options = append(options, opt.Name)
+		}
+		return options
+	}
+
+	field, ok := cfg.Fields[key]
+	if !ok {
+		return nil
+	}
+	seen := map[string]bool{}
+	options := make([]string, 0, len(field.Values))
+	for _, value := range field.Values {
+		if !seen[value] {
+			seen[value] = true
+			options = append(options, value)
+		}
+	}
+	sort.Strings(options)
+	return options
+}
+
+// firstNonEmpty returns the first value that is not empty
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
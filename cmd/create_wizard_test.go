@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/ui"
+)
+
+func runTestCreateWizard(t *testing.T, input string, opts *createOptions, cfg *config.Config) (issueFromFile, string, error) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	got, err := runCreateWizard(buf, bufio.NewReader(strings.NewReader(input)), ui.NewWithOptions(buf, true), opts, cfg)
+	return got, buf.String(), err
+}
+
+func TestRunCreateWizard(t *testing.T) {
+	cfg := newCreateFieldsTestConfig()
+	cfg.Defaults.Status = "backlog"
+
+	// Title, two body lines, then Enter for the default status and size 3
+	got, output, err := runTestCreateWizard(t, "Add dark mode\nFirst line\nSecond line\n\n\n3\n", &createOptions{labels: []string{"ui"}}, cfg)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got.Title != "Add dark mode" || got.Body != "First line\nSecond line" {
+		t.Errorf("Unexpected title/body: %q / %q", got.Title, got.Body)
+	}
+	if got.Status != "Backlog" || got.Priority != "" {
+		t.Errorf("Unexpected status/priority: %q / %q", got.Status, got.Priority)
+	}
+	if got.Fields["size"] != "L" {
+		t.Errorf("Expected size L, got %+v", got.Fields)
+	}
+	if len(got.Labels) != 1 || got.Labels[0] != "ui" {
+		t.Errorf("Expected flag labels to be kept, got %v", got.Labels)
+	}
+	for _, want := range []string{"Title: ", "1. Backlog", "Status (0 to skip) [1]: ", "3. L", "Size (0 to skip): "} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestRunCreateWizard_FlagDefaults(t *testing.T) {
+	opts := &createOptions{title: "From flag", body: "Flag body", fields: []string{"size=M"}}
+
+	// Enter keeps the title, then 0 skips status and Enter keeps size M
+	got, _, err := runTestCreateWizard(t, "\n0\n\n", opts, newCreateFieldsTestConfig())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got.Title != "From flag" || got.Body != "Flag body" {
+		t.Errorf("Unexpected title/body: %q / %q", got.Title, got.Body)
+	}
+	if got.Status != "" || got.Fields["size"] != "M" {
+		t.Errorf("Unexpected fields: status %q, %+v", got.Status, got.Fields)
+	}
+}
+
+func TestRunCreateWizard_Errors(t *testing.T) {
+	if _, _, err := runTestCreateWizard(t, "\n", &createOptions{}, newCreateFieldsTestConfig()); err == nil || !strings.Contains(err.Error(), "title is empty") {
+		t.Errorf("Expected empty title error, got %v", err)
+	}
+
+	if _, _, err := runTestCreateWizard(t, "Title\n\n9\n", &createOptions{}, newCreateFieldsTestConfig()); err == nil || !strings.Contains(err.Error(), "invalid status selection") {
+		t.Errorf("Expected invalid selection error, got %v", err)
+	}
+}
+
+func TestWizardFieldOptions(t *testing.T) {
+	cfg := &config.Config{
+		Fields: map[string]config.Field{
+			"priority": {Field: "Priority", Values: map[string]string{"p0": "P0", "urgent": "P0", "p1": "P1"}},
+		},
+	}
+
+	if got := wizardFieldOptions(cfg, "priority"); strings.Join(got, ",") != "P0,P1" {
+		t.Errorf("Expected config values, got %v", got)
+	}
+	if got := wizardFieldOptions(cfg, "size"); got != nil {
+		t.Errorf("Expected no options for an unknown field, got %v", got)
+	}
+
+	cfg = newCreateFieldsTestConfig()
+	if got := wizardFieldOptions(cfg, "size"); strings.Join(got, ",") != "S,M,L" {
+		t.Errorf("Expected metadata options, got %v", got)
+	}
+}
@@ -0,0 +1,722 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/daemon"
+	"github.com/scooter-indie/gh-pmu/internal/focus"
+	"github.com/spf13/cobra"
+)
+
+type daemonOptions struct {
+	addr string
+	ttl  time.Duration // how long project items are served from the cache
+}
+
+// daemonClient defines the interface for API methods used by the daemon.
+// This allows for easier testing with mock implementations.
+type daemonClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	SetProjectItemFields(projectID string, updates []api.ItemFieldUpdate) []error
+}
+
+func newDaemonCommand() *cobra.Command {
+	opts := &daemonOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Serve the project from a warm, cached client over a local HTTP API",
+		Long: `Run in the foreground, keeping an authenticated client and a cache of the
+project's items, and serve them over an HTTP/JSON API on localhost.
+
+While the daemon runs, 'gh pmu list' and 'gh pmu board' read the project
+from it instead of querying GitHub, and 'gh pmu move' tells it to refresh
+after a change.
+Editors and scripts can call the API directly. The address and a bearer
+token are written, readable only by you, to the user config directory;
+every request must send "Authorization: Bearer <token>".
+
+Endpoints:
+  GET  /v1/status    Project, start time, and cache age
+  GET  /v1/project   The project
+  GET  /v1/items     Project items; ?query= takes the same qualifiers as
+                     move --query, ?repo= limits to a repository
+  POST /v1/move      {"issues": ["42", "owner/repo#7"], "status": "done",
+                     "priority": "p1"}, with a result per issue
+  POST /v1/refresh   Drop the cached items
+  POST /v1/shutdown  Stop the daemon
+  POST /v1/rpc       JSON-RPC 2.0 for editors; see 'gh pmu serve --help'
+
+Items are cached for --ttl and refreshed after every move.`,
+		Example: `  # Start the daemon in the background, then use the CLI as usual
+  gh pmu daemon &
+  gh pmu list --status in_progress
+
+  # Check on it, and stop it
+  gh pmu daemon status
+  gh pmu daemon stop`,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{longRunningAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := loadDaemonConfig()
+			if err != nil {
+				return err
+			}
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+			return runDaemonWithDeps(ctx, cmd, opts, cfg, api.NewClient(), path)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.addr, "addr", "127.0.0.1:0", "Address to listen on (port 0 picks a free port)")
+	cmd.Flags().DurationVar(&opts.ttl, "ttl", time.Minute, "How long to serve project items from the cache")
+
+	cmd.AddCommand(newDaemonStatusCommand())
+	cmd.AddCommand(newDaemonStopCommand())
+
+	return cmd
+}
+
+func newDaemonStatusCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show whether the daemon is running",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, path, err := loadDaemonConfig()
+			if err != nil {
+				return err
+			}
+			return runDaemonStatusWithDeps(cmd, path)
+		},
+	}
+}
+
+func newDaemonStopCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "stop",
+		Short: "Stop the running daemon",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, path, err := loadDaemonConfig()
+			if err != nil {
+				return err
+			}
+			return runDaemonStopWithDeps(cmd, path)
+		},
+	}
+}
+
+// loadDaemonConfig loads the project config and the location of its daemon state
+func loadDaemonConfig() (*config.Config, string, error) {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return nil, "", err
+	}
+	path, err := daemon.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, "", err
+	}
+	return cfg, path, nil
+}
+
+// runDaemonWithDeps is the testable implementation of daemon. It serves
+// until ctx is done or a shutdown request arrives, then removes its state.
+func runDaemonWithDeps(ctx context.Context, cmd *cobra.Command, opts *daemonOptions, cfg *config.Config, client daemonClient, path string) error {
+	if running := daemon.Connect(path); running != nil {
+		return fmt.Errorf("a daemon is already running at %s (pid %d)", running.State.Addr, running.State.PID)
+	}
+
+	token, err := daemon.NewToken()
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", opts.addr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", opts.addr, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	focusPath, err := focus.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		listener.Close()
+		return err
+	}
+
+	service := newDaemonService(cfg, client, opts.ttl, focusPath)
+	service.shutdown = cancel
+	server := &http.Server{Handler: daemon.Authorize(token, service.handler())}
+
+	state := &daemon.State{
+		Project:   fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number),
+		Addr:      listener.Addr().String(),
+		Token:     token,
+		PID:       os.Getpid(),
+		StartedAt: service.started.UTC().Format(time.RFC3339),
+	}
+	if err := state.Save(path); err != nil {
+		listener.Close()
+		return err
+	}
+	defer func() { _ = daemon.Remove(path) }()
+
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- server.Serve(listener) }()
+	cmd.Printf("Serving %s on http://%s (Ctrl-C to stop)\n", state.Project, state.Addr)
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("daemon stopped: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, done := context.WithTimeout(context.Background(), 5*time.Second)
+	defer done()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to stop daemon: %w", err)
+	}
+	cmd.Println("Daemon stopped")
+	return nil
+}
+
+// runDaemonStatusWithDeps is the testable implementation of daemon status
+func runDaemonStatusWithDeps(cmd *cobra.Command, path string) error {
+	conn := daemon.Connect(path)
+	if conn == nil {
+		cmd.Println("No daemon is running; start one with 'gh pmu daemon &'")
+		return nil
+	}
+
+	var status daemonStatus
+	if err := conn.Get("/v1/status", &status); err != nil {
+		return err
+	}
+	cmd.Printf("Daemon for %s running at %s (pid %d, since %s)\n", status.Project, conn.State.Addr, conn.State.PID, formatHistoryTime(status.StartedAt))
+	if status.CachedAt == "" {
+		cmd.Println("No project items cached")
+	} else {
+		cmd.Printf("%d project items cached at %s\n", status.Items, formatHistoryTime(status.CachedAt))
+	}
+	return nil
+}
+
+// runDaemonStopWithDeps is the testable implementation of daemon stop
+func runDaemonStopWithDeps(cmd *cobra.Command, path string) error {
+	conn := daemon.Connect(path)
+	if conn == nil {
+		cmd.Println("No daemon is running")
+		return nil
+	}
+	if err := conn.Post("/v1/shutdown", nil, nil); err != nil {
+		return err
+	}
+	cmd.Printf("Stopped the daemon at %s\n", conn.State.Addr)
+	return nil
+}
+
+// daemonStatus is the response of GET /v1/status
+type daemonStatus struct {
+	Project   string `json:"project"`
+	StartedAt string `json:"startedAt"`
+	Items     int    `json:"items"`
+	CachedAt  string `json:"cachedAt,omitempty"` // empty when nothing is cached
+}
+
+// daemonMoveRequest is the body of POST /v1/move
+type daemonMoveRequest struct {
+	Issues   []string `json:"issues"`
+	Status   string   `json:"status,omitempty"`
+	Priority string   `json:"priority,omitempty"`
+}
+
+// daemonMoveResult is the outcome of moving one issue through the daemon
+type daemonMoveResult struct {
+	Issue string `json:"issue"` // owner/repo#number
+	Title string `json:"title,omitempty"`
+	Error string `json:"error,omitempty"` // empty when the issue was updated
+}
+
+// daemonProjectJSON is the project as served by GET /v1/project
+type daemonProjectJSON struct {
+	ID        string `json:"id"`
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+	Owner     string `json:"owner"`
+	OwnerType string `json:"ownerType"` // "User" or "Organization"
+	Closed    bool   `json:"closed"`
+}
+
+// daemonItemJSON is a project item as served by GET /v1/items and items/list
+type daemonItemJSON struct {
+	ID          string                 `json:"id"`
+	DatabaseID  int                    `json:"databaseId,omitempty"`
+	Issue       *daemonIssueJSON       `json:"issue,omitempty"` // nil for drafts and pull requests
+	FieldValues []daemonFieldValueJSON `json:"fieldValues"`
+	Archived    bool                   `json:"archived"`
+}
+
+// daemonIssueJSON is an issue as served by the daemon and serve
+type daemonIssueJSON struct {
+	ID         string            `json:"id"`
+	Number     int               `json:"number"`
+	Title      string            `json:"title"`
+	Body       string            `json:"body,omitempty"`
+	State      string            `json:"state"`
+	URL        string            `json:"url"`
+	Repository string            `json:"repository"` // owner/repo
+	Author     string            `json:"author,omitempty"`
+	Assignees  []string          `json:"assignees"`
+	Labels     []daemonLabelJSON `json:"labels"`
+	Milestone  string            `json:"milestone,omitempty"`
+}
+
+// daemonLabelJSON is a label on an issue
+type daemonLabelJSON struct {
+	Name  string `json:"name"`
+	Color string `json:"color,omitempty"`
+}
+
+// daemonFieldValueJSON is a project field value on an item
+type daemonFieldValueJSON struct {
+	Field     string `json:"field"`
+	Value     string `json:"value"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+// toDaemonProjectJSON converts a project to its wire form
+func toDaemonProjectJSON(p *api.Project) daemonProjectJSON {
+	return daemonProjectJSON{
+		ID:        p.ID,
+		Number:    p.Number,
+		Title:     p.Title,
+		URL:       p.URL,
+		Owner:     p.Owner.Login,
+		OwnerType: p.Owner.Type,
+		Closed:    p.Closed,
+	}
+}
+
+// project converts the wire form back to a project
+func (j daemonProjectJSON) project() *api.Project {
+	return &api.Project{
+		ID:     j.ID,
+		Number: j.Number,
+		Title:  j.Title,
+		URL:    j.URL,
+		Owner:  api.ProjectOwner{Type: j.OwnerType, Login: j.Owner},
+		Closed: j.Closed,
+	}
+}
+
+// toDaemonItemsJSON converts project items to their wire form
+func toDaemonItemsJSON(items []api.ProjectItem) []daemonItemJSON {
+	out := make([]daemonItemJSON, 0, len(items))
+	for _, item := range items {
+		out = append(out, daemonItemJSON{
+			ID:          item.ID,
+			DatabaseID:  item.DatabaseID,
+			Issue:       toDaemonIssueJSON(item.Issue),
+			FieldValues: toDaemonFieldValuesJSON(item.FieldValues),
+			Archived:    item.IsArchived,
+		})
+	}
+	return out
+}
+
+// item converts the wire form back to a project item
+func (j daemonItemJSON) item() api.ProjectItem {
+	item := api.ProjectItem{ID: j.ID, DatabaseID: j.DatabaseID, IsArchived: j.Archived}
+	if j.Issue != nil {
+		item.Issue = j.Issue.issue()
+	}
+	for _, fv := range j.FieldValues {
+		item.FieldValues = append(item.FieldValues, api.FieldValue{Field: fv.Field, Value: fv.Value, UpdatedAt: fv.UpdatedAt})
+	}
+	return item
+}
+
+// toDaemonIssueJSON converts an issue to its wire form; nil stays nil
+func toDaemonIssueJSON(issue *api.Issue) *daemonIssueJSON {
+	if issue == nil {
+		return nil
+	}
+	j := &daemonIssueJSON{
+		ID:         issue.ID,
+		Number:     issue.Number,
+		Title:      issue.Title,
+		Body:       issue.Body,
+		State:      issue.State,
+		URL:        issue.URL,
+		Repository: issue.Repository.Owner + "/" + issue.Repository.Name,
+		Author:     issue.Author.Login,
+		Assignees:  []string{},
+		Labels:     []daemonLabelJSON{},
+	}
+	for _, a := range issue.Assignees {
+		j.Assignees = append(j.Assignees, a.Login)
+	}
+	for _, l := range issue.Labels {
+		j.Labels = append(j.Labels, daemonLabelJSON{Name: l.Name, Color: l.Color})
+	}
+	if issue.Milestone != nil {
+		j.Milestone = issue.Milestone.Title
+	}
+	return j
+}
+
+// issue converts the wire form back to an issue
+func (j daemonIssueJSON) issue() *api.Issue {
+	issue := &api.Issue{
+		ID:     j.ID,
+		Number: j.Number,
+		Title:  j.Title,
+		Body:   j.Body,
+		State:  j.State,
+		URL:    j.URL,
+		Author: api.Actor{Login: j.Author},
+	}
+	if owner, name, ok := strings.Cut(j.Repository, "/"); ok {
+		issue.Repository = api.Repository{Owner: owner, Name: name}
+	}
+	for _, login := range j.Assignees {
+		issue.Assignees = append(issue.Assignees, api.Actor{Login: login})
+	}
+	for _, l := range j.Labels {
+		issue.Labels = append(issue.Labels, api.Label{Name: l.Name, Color: l.Color})
+	}
+	if j.Milestone != "" {
+		issue.Milestone = &api.Milestone{Title: j.Milestone}
+	}
+	return issue
+}
+
+// toDaemonFieldValuesJSON converts field values to their wire form
+func toDaemonFieldValuesJSON(values []api.FieldValue) []daemonFieldValueJSON {
+	out := make([]daemonFieldValueJSON, 0, len(values))
+	for _, fv := range values {
+		out = append(out, daemonFieldValueJSON{Field: fv.Field, Value: fv.Value, UpdatedAt: fv.UpdatedAt})
+	}
+	return out
+}
+
+// daemonService serves the API, caching the project and its items
+type daemonService struct {
+	cfg      *config.Config
+	client   daemonClient
+	ttl      time.Duration
+	now      func() time.Time
+	started  time.Time
+	shutdown func()
+	onMove   func() // called after a move, if set
+
+	focusPath string // focus file read and written by the focus methods
+
+	mu       sync.Mutex
+	project  *api.Project
+	items    []api.ProjectItem
+	cachedAt time.Time // zero when the items must be fetched
+}
+
+// newDaemonService creates a service with an empty cache, started now
+func newDaemonService(cfg *config.Config, client daemonClient, ttl time.Duration, focusPath string) *daemonService {
+	s := &daemonService{cfg: cfg, client: client, ttl: ttl, now: time.Now, focusPath: focusPath}
+	s.started = s.now()
+	return s
+}
+
+func (s *daemonService) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/status", s.handleStatus)
+	mux.HandleFunc("GET /v1/project", s.handleProject)
+	mux.HandleFunc("GET /v1/items", s.handleItems)
+	mux.HandleFunc("POST /v1/move", s.handleMove)
+	mux.HandleFunc("POST /v1/refresh", s.handleRefresh)
+	mux.HandleFunc("POST /v1/shutdown", s.handleShutdown)
+	mux.Handle("POST /v1/rpc", s.rpc())
+	return mux
+}
+
+// getProject returns the project, fetching it once
+func (s *daemonService) getProject() (*api.Project, error) {
+	if s.project != nil {
+		return s.project, nil
+	}
+	project, err := s.client.GetProject(s.cfg.Project.Owner, s.cfg.Project.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	s.project = project
+	return project, nil
+}
+
+// getItems returns the project items, fetching them when the cache is
+// empty or older than the TTL. The caller holds s.mu.
+func (s *daemonService) getItems() ([]api.ProjectItem, error) {
+	if !s.cachedAt.IsZero() && s.now().Sub(s.cachedAt) < s.ttl {
+		return s.items, nil
+	}
+	project, err := s.getProject()
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project items: %w", err)
+	}
+	s.items = items
+	s.cachedAt = s.now()
+	return items, nil
+}
+
+func (s *daemonService) handleStatus(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	daemon.WriteJSON(w, http.StatusOK, s.status())
+}
+
+// status describes the daemon and its cache. The caller holds s.mu.
+func (s *daemonService) status() daemonStatus {
+	status := daemonStatus{
+		Project:   fmt.Sprintf("%s/%d", s.cfg.Project.Owner, s.cfg.Project.Number),
+		StartedAt: s.started.UTC().Format(time.RFC3339),
+		Items:     len(s.items),
+	}
+	if !s.cachedAt.IsZero() {
+		status.CachedAt = s.cachedAt.UTC().Format(time.RFC3339)
+	}
+	return status
+}
+
+func (s *daemonService) handleProject(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	project, err := s.getProject()
+	if err != nil {
+		daemon.WriteError(w, http.StatusBadGateway, err)
+		return
+	}
+	daemon.WriteJSON(w, http.StatusOK, toDaemonProjectJSON(project))
+}
+
+func (s *daemonService) handleItems(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items, err := s.listItems(r.URL.Query().Get("query"), r.URL.Query().Get("repo"))
+	if err != nil {
+		daemon.WriteError(w, http.StatusBadGateway, err)
+		return
+	}
+	daemon.WriteJSON(w, http.StatusOK, toDaemonItemsJSON(items))
+}
+
+// listItems returns the cached items matching query and repo, either of
+// which may be empty. The caller holds s.mu.
+func (s *daemonService) listItems(query, repo string) ([]api.ProjectItem, error) {
+	items, err := s.getItems()
+	if err != nil {
+		return nil, err
+	}
+
+	if query != "" {
+		items = filterItemsByQuery(s.cfg, items, query)
+	}
+	if repo != "" {
+		var inRepo []api.ProjectItem
+		for _, item := range items {
+			if item.Issue != nil && strings.EqualFold(item.Issue.Repository.Owner+"/"+item.Issue.Repository.Name, repo) {
+				inRepo = append(inRepo, item)
+			}
+		}
+		items = inRepo
+	}
+	if items == nil {
+		items = []api.ProjectItem{}
+	}
+	return items, nil
+}
+
+func (s *daemonService) handleMove(w http.ResponseWriter, r *http.Request) {
+	var req daemonMoveRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		daemon.WriteError(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %w", err))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	results, err := s.move(req)
+	var invalid *daemon.RPCError
+	if errors.As(err, &invalid) {
+		daemon.WriteError(w, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		daemon.WriteError(w, http.StatusBadGateway, err)
+		return
+	}
+	daemon.WriteJSON(w, http.StatusOK, map[string]interface{}{"results": results})
+}
+
+// move applies req with one batch of field updates and returns a result
+// per issue. An invalid request yields a *daemon.RPCError. The caller
+// holds s.mu.
+func (s *daemonService) move(req daemonMoveRequest) ([]daemonMoveResult, error) {
+	if len(req.Issues) == 0 || (req.Status == "" && req.Priority == "") {
+		return nil, daemon.InvalidParams("issues and at least one of status or priority are required")
+	}
+
+	items, err := s.getItems()
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]api.ProjectItem)
+	for _, item := range items {
+		if item.Issue != nil {
+			byKey[itemKey(item)] = item
+		}
+	}
+
+	var changes []moveFieldChange
+	if req.Status != "" {
+		changes = append(changes, moveFieldChange{field: "Status", value: s.cfg.ResolveFieldValue("status", req.Status)})
+	}
+	if req.Priority != "" {
+		changes = append(changes, moveFieldChange{field: "Priority", value: s.cfg.ResolveFieldValue("priority", req.Priority)})
+	}
+
+	results := make([]daemonMoveResult, len(req.Issues))
+	var updates []api.ItemFieldUpdate
+	var updateResults []int
+	for i, ref := range req.Issues {
+		key, err := issueKey(s.cfg, ref)
+		if err != nil {
+			results[i] = daemonMoveResult{Issue: ref, Error: err.Error()}
+			continue
+		}
+		results[i].Issue = key
+		item, ok := byKey[key]
+		if !ok {
+			results[i].Error = "not in project"
+			continue
+		}
+		results[i].Title = item.Issue.Title
+		for _, c := range changes {
+			updates = append(updates, api.ItemFieldUpdate{ItemID: item.ID, Field: c.field, Value: c.value})
+			updateResults = append(updateResults, i)
+		}
+	}
+
+	project, err := s.getProject()
+	if err != nil {
+		return nil, err
+	}
+	for i, err := range s.client.SetProjectItemFields(project.ID, updates) {
+		if result := &results[updateResults[i]]; err != nil && result.Error == "" {
+			result.Error = err.Error()
+		}
+	}
+
+	// The cached field values are stale now
+	s.cachedAt = time.Time{}
+	if s.onMove != nil {
+		s.onMove()
+	}
+	return results, nil
+}
+
+func (s *daemonService) handleRefresh(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.cachedAt = time.Time{}
+	s.mu.Unlock()
+	daemon.WriteJSON(w, http.StatusOK, map[string]bool{"refreshed": true})
+}
+
+func (s *daemonService) handleShutdown(w http.ResponseWriter, r *http.Request) {
+	daemon.WriteJSON(w, http.StatusOK, map[string]bool{"stopping": true})
+	s.shutdown()
+}
+
+// connectDaemon returns a client for the project's running daemon, or nil
+func connectDaemon(cfg *config.Config) *daemon.Client {
+	path, err := daemon.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil
+	}
+	return daemon.Connect(path)
+}
+
+// refreshDaemon asks a running daemon to drop its cached items after a
+// change; without a daemon it does nothing
+func refreshDaemon(cfg *config.Config) {
+	if conn := connectDaemon(cfg); conn != nil {
+		if err := conn.Post("/v1/refresh", nil, nil); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to refresh the daemon: %v\n", err)
+		}
+	}
+}
+
+// projectItemsReader reads a project and its items
+type projectItemsReader interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+}
+
+// projectItemsSource returns the project's running daemon as a reader, or
+// else client
+func projectItemsSource(cfg *config.Config, client projectItemsReader) projectItemsReader {
+	if conn := connectDaemon(cfg); conn != nil {
+		return daemonProjectReader{conn: conn}
+	}
+	return client
+}
+
+// daemonProjectReader reads the project and its items from a running daemon
+type daemonProjectReader struct {
+	conn *daemon.Client
+}
+
+func (r daemonProjectReader) GetProject(owner string, number int) (*api.Project, error) {
+	var project daemonProjectJSON
+	if err := r.conn.Get("/v1/project", &project); err != nil {
+		return nil, err
+	}
+	return project.project(), nil
+}
+
+func (r daemonProjectReader) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	path := "/v1/items"
+	if filter != nil && filter.Repository != "" {
+		path += "?repo=" + url.QueryEscape(filter.Repository)
+	}
+	var wire []daemonItemJSON
+	if err := r.conn.Get(path, &wire); err != nil {
+		return nil, err
+	}
+	items := make([]api.ProjectItem, 0, len(wire))
+	for _, j := range wire {
+		items = append(items, j.item())
+	}
+	return items, nil
+}
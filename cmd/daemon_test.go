@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/daemon"
+)
+
+// mockDaemonClient implements daemonClient for testing
+type mockDaemonClient struct {
+	items      []api.ProjectItem
+	itemCalls  int
+	updates    []api.ItemFieldUpdate
+	failItemID string
+}
+
+func (m *mockDaemonClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1", Title: "Roadmap", URL: "https://github.com/orgs/owner/projects/1"}, nil
+}
+
+func (m *mockDaemonClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	m.itemCalls++
+	return m.items, nil
+}
+
+func (m *mockDaemonClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{ID: fmt.Sprintf("issue-%d", number), Number: number, Title: fmt.Sprintf("Issue %d", number), Body: "Details", State: "OPEN",
+		Repository: api.Repository{Owner: owner, Name: repo}}, nil
+}
+
+func (m *mockDaemonClient) SetProjectItemFields(projectID string, updates []api.ItemFieldUpdate) []error {
+	errs := make([]error, len(updates))
+	for i, u := range updates {
+		if u.ItemID == m.failItemID {
+			errs[i] = os.ErrPermission
+			continue
+		}
+		m.updates = append(m.updates, u)
+	}
+	return errs
+}
+
+func newDaemonTestClient() *mockDaemonClient {
+	item := func(id string, number int, repo, status string) api.ProjectItem {
+		return api.ProjectItem{
+			ID: id,
+			Issue: &api.Issue{
+				Number:     number,
+				Title:      "Issue " + id,
+				State:      "OPEN",
+				Repository: api.Repository{Owner: "owner", Name: repo},
+			},
+			FieldValues: []api.FieldValue{{Field: "Status", Value: status}},
+		}
+	}
+	return &mockDaemonClient{items: []api.ProjectItem{
+		item("item-1", 1, "repo", "In Progress"),
+		item("item-2", 2, "repo", "Done"),
+		item("item-3", 3, "other", "In Progress"),
+	}}
+}
+
+func newDaemonTestService(client *mockDaemonClient, now *time.Time) *daemonService {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{
+		"status": {Field: "Status", Values: map[string]string{"done": "Done", "in_progress": "In Progress"}},
+	}
+	return &daemonService{cfg: cfg, client: client, ttl: time.Minute, now: func() time.Time { return *now }, started: *now}
+}
+
+func serveDaemonRequest(t *testing.T, s *daemonService, method, target, body string, out interface{}) int {
+	t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	s.handler().ServeHTTP(rec, req)
+	if out != nil {
+		if err := json.Unmarshal(rec.Body.Bytes(), out); err != nil {
+			t.Fatalf("Failed to parse %s %s response %q: %v", method, target, rec.Body.String(), err)
+		}
+	}
+	return rec.Code
+}
+
+func TestDaemonService_CachesItems(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	client := newDaemonTestClient()
+	s := newDaemonTestService(client, &now)
+
+	var items []daemonItemJSON
+	for i := 0; i < 2; i++ {
+		if code := serveDaemonRequest(t, s, "GET", "/v1/items", "", &items); code != http.StatusOK {
+			t.Fatalf("Expected 200, got %d", code)
+		}
+	}
+	if len(items) != 3 || client.itemCalls != 1 {
+		t.Errorf("Expected 3 items fetched once, got %d items and %d fetches", len(items), client.itemCalls)
+	}
+
+	now = now.Add(2 * time.Minute)
+	serveDaemonRequest(t, s, "GET", "/v1/items", "", &items)
+	if client.itemCalls != 2 {
+		t.Errorf("Expected a fetch after the TTL, got %d fetches", client.itemCalls)
+	}
+
+	serveDaemonRequest(t, s, "POST", "/v1/refresh", "", nil)
+	serveDaemonRequest(t, s, "GET", "/v1/items", "", &items)
+	if client.itemCalls != 3 {
+		t.Errorf("Expected a fetch after refresh, got %d fetches", client.itemCalls)
+	}
+
+	var status daemonStatus
+	serveDaemonRequest(t, s, "GET", "/v1/status", "", &status)
+	if status.Project != "owner/1" || status.Items != 3 || status.CachedAt != "2026-03-02T09:02:00Z" {
+		t.Errorf("Unexpected status %+v", status)
+	}
+}
+
+func TestDaemonService_FiltersItems(t *testing.T) {
+	now := time.Now()
+	s := newDaemonTestService(newDaemonTestClient(), &now)
+
+	var items []daemonItemJSON
+	serveDaemonRequest(t, s, "GET", "/v1/items?query=status:in_progress", "", &items)
+	if len(items) != 2 {
+		t.Errorf("Expected 2 in-progress items, got %d", len(items))
+	}
+
+	serveDaemonRequest(t, s, "GET", "/v1/items?repo=owner/other", "", &items)
+	if len(items) != 1 || items[0].ID != "item-3" {
+		t.Errorf("Expected only the other repository's item, got %v", items)
+	}
+
+	serveDaemonRequest(t, s, "GET", "/v1/items?query=status:todo", "", &items)
+	if items == nil || len(items) != 0 {
+		t.Errorf("Expected an empty list, got %v", items)
+	}
+}
+
+func TestDaemonService_ItemsJSON(t *testing.T) {
+	now := time.Now()
+	s := newDaemonTestService(newDaemonTestClient(), &now)
+
+	var items []map[string]interface{}
+	serveDaemonRequest(t, s, "GET", "/v1/items?repo=owner/other", "", &items)
+	if len(items) != 1 {
+		t.Fatalf("Expected 1 item, got %d", len(items))
+	}
+	for _, key := range []string{"id", "issue", "fieldValues", "archived"} {
+		if _, ok := items[0][key]; !ok {
+			t.Errorf("Expected key %q in %v", key, items[0])
+		}
+	}
+	issue, _ := items[0]["issue"].(map[string]interface{})
+	if issue["repository"] != "owner/other" || issue["number"] != float64(3) {
+		t.Errorf("Unexpected issue %v", issue)
+	}
+	if _, ok := issue["Repository"]; ok {
+		t.Errorf("Expected only camelCase keys, got %v", issue)
+	}
+}
+
+func TestDaemonService_Move(t *testing.T) {
+	now := time.Now()
+	client := newDaemonTestClient()
+	client.failItemID = "item-2"
+	s := newDaemonTestService(client, &now)
+
+	var resp struct {
+		Results []daemonMoveResult `json:"results"`
+	}
+	code := serveDaemonRequest(t, s, "POST", "/v1/move", `{"issues": ["1", "#2", "owner/repo#9"], "status": "done"}`, &resp)
+	if code != http.StatusOK || len(resp.Results) != 3 {
+		t.Fatalf("Unexpected response %d: %+v", code, resp)
+	}
+	if r := resp.Results[0]; r.Issue != "owner/repo#1" || r.Title != "Issue item-1" || r.Error != "" {
+		t.Errorf("Expected #1 updated, got %+v", r)
+	}
+	if resp.Results[1].Error == "" {
+		t.Errorf("Expected #2 to fail, got %+v", resp.Results[1])
+	}
+	if resp.Results[2].Error != "not in project" {
+		t.Errorf("Expected #9 not in project, got %+v", resp.Results[2])
+	}
+	if len(client.updates) != 1 || client.updates[0] != (api.ItemFieldUpdate{ItemID: "item-1", Field: "Status", Value: "Done"}) {
+		t.Errorf("Unexpected updates %v", client.updates)
+	}
+	if !s.cachedAt.IsZero() {
+		t.Error("Expected the cache to be dropped after a move")
+	}
+
+	var e map[string]string
+	if code := serveDaemonRequest(t, s, "POST", "/v1/move", `{"issues": ["1"]}`, &e); code != http.StatusBadRequest || e["error"] == "" {
+		t.Errorf("Expected bad request without a change, got %d %v", code, e)
+	}
+}
+
+func TestRunDaemon_ServesStatusAndStops(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "daemon.json")
+	client := newDaemonTestClient()
+	cmd, buf := newTestCmd()
+
+	errc := make(chan error, 1)
+	go func() {
+		errc <- runDaemonWithDeps(context.Background(), cmd, &daemonOptions{addr: "127.0.0.1:0", ttl: time.Minute}, newTestConfig(), client, path)
+	}()
+
+	var conn *daemon.Client
+	for i := 0; i < 100 && conn == nil; i++ {
+		time.Sleep(10 * time.Millisecond)
+		conn = daemon.Connect(path)
+	}
+	if conn == nil {
+		t.Fatal("Daemon did not start")
+	}
+
+	reader := daemonProjectReader{conn: conn}
+	project, err := reader.GetProject("owner", 1)
+	if err != nil || project.ID != "proj-1" {
+		t.Errorf("Unexpected project %+v, %v", project, err)
+	}
+	items, err := reader.GetProjectItems(project.ID, &api.ProjectItemsFilter{Repository: "owner/repo"})
+	if err != nil || len(items) != 2 {
+		t.Errorf("Expected the repository's 2 items, got %d, %v", len(items), err)
+	} else if items[0].Issue == nil || items[0].Issue.Repository.Name != "repo" || getFieldValue(items[0], "Status") != "In Progress" {
+		t.Errorf("Unexpected item %+v", items[0])
+	}
+
+	statusCmd, statusBuf := newTestCmd()
+	if err := runDaemonStatusWithDeps(statusCmd, path); err != nil {
+		t.Fatalf("status failed: %v", err)
+	}
+	if !strings.Contains(statusBuf.String(), "Daemon for owner/1 running") || !strings.Contains(statusBuf.String(), "3 project items cached") {
+		t.Errorf("Unexpected status output:\n%s", statusBuf.String())
+	}
+
+	if err := runDaemonWithDeps(context.Background(), cmd, &daemonOptions{addr: "127.0.0.1:0"}, newTestConfig(), client, path); err == nil || !strings.Contains(err.Error(), "already running") {
+		t.Errorf("Expected already running error, got %v", err)
+	}
+
+	stopCmd, _ := newTestCmd()
+	if err := runDaemonStopWithDeps(stopCmd, path); err != nil {
+		t.Fatalf("stop failed: %v", err)
+	}
+	select {
+	case err := <-errc:
+		if err != nil {
+			t.Errorf("Expected a clean stop, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Daemon did not stop")
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected the state file removed, got %v", err)
+	}
+	if !strings.Contains(buf.String(), "Serving owner/1 on http://127.0.0.1:") {
+		t.Errorf("Unexpected daemon output:\n%s", buf.String())
+	}
+
+	statusCmd, statusBuf = newTestCmd()
+	if err := runDaemonStatusWithDeps(statusCmd, path); err != nil || !strings.Contains(statusBuf.String(), "No daemon is running") {
+		t.Errorf("Expected no daemon, got %v:\n%s", err, statusBuf.String())
+	}
+}
@@ -0,0 +1,334 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// decisionLabel marks an issue as a decision record
+	decisionLabel = "decision"
+
+	// decisionTitlePrefix starts the title of every decision record, so
+	// decisions are found even in repositories without the label
+	decisionTitlePrefix = "ADR: "
+
+	// areaLabelPrefix starts the labels that put an issue in an area
+	areaLabelPrefix = "area:"
+)
+
+// decisionClient defines the interface for API methods used by decision commands.
+// This allows for easier testing with mock implementations.
+type decisionClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error)
+	AddIssueToProject(projectID, issueID string) (string, error)
+	AddIssueComment(issueID, body string) (string, error)
+}
+
+func newDecisionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decision",
+		Short: "Record and find architectural decisions",
+		Long: `Record architectural decisions as ADR-style issues in the project, next to
+the work they were made for.
+
+A decision record is an issue titled "ADR: <decision>" and labeled
+"decision", with an "area:<name>" label for its area. Create the labels
+in the repository once; decisions are still found by their title
+without them.`,
+	}
+
+	cmd.AddCommand(newDecisionRecordCommand())
+	cmd.AddCommand(newDecisionListCommand())
+
+	return cmd
+}
+
+type decisionRecordOptions struct {
+	context      string
+	area         string
+	status       string
+	decision     string
+	consequences string
+	repo         string
+}
+
+func newDecisionRecordCommand() *cobra.Command {
+	opts := &decisionRecordOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "record <title>",
+		Short: "Record a decision made for an issue or epic",
+		Long: `Create a decision record: an issue with Status, Context, Decision, and
+Consequences sections, added to the project. The context issue, usually
+the epic the decision was made for, is referenced from the record and
+gets a comment linking back to it.`,
+		Example: `  gh pmu decision record "Use Postgres" --context 42 --area storage
+
+  gh pmu decision record "Drop IE11 support" --context 17 --status proposed \
+    --consequences "Polyfills can be removed from the bundle"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runDecisionRecordWithDeps(cmd, args, opts, cfg, api.NewClient())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.context, "context", "", "Issue or epic the decision was made for (required)")
+	cmd.Flags().StringVar(&opts.area, "area", "", "Area of the decision, as an area:<name> label")
+	cmd.Flags().StringVar(&opts.status, "status", "accepted", "Decision status: proposed, accepted, deprecated, or superseded")
+	cmd.Flags().StringVar(&opts.decision, "decision", "", "What was decided, if more than the title")
+	cmd.Flags().StringVar(&opts.consequences, "consequences", "", "What follows from the decision")
+	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository for the record (owner/repo); defaults to the context issue's")
+	_ = cmd.MarkFlagRequired("context")
+
+	return cmd
+}
+
+type decisionListOptions struct {
+	area  string
+	state string
+	json  bool
+}
+
+func newDecisionListCommand() *cobra.Command {
+	opts := &decisionListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List decision records",
+		Long: `List the project's decision records with their area, newest first.
+Closed records, for decisions that no longer stand, are included
+unless --state narrows them.`,
+		Example: `  gh pmu decision list
+  gh pmu decision list --area storage
+  gh pmu decision list --state open --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runDecisionListWithDeps(cmd, opts, cfg, api.NewClient())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.area, "area", "", "Only decisions in this area")
+	cmd.Flags().StringVarP(&opts.state, "state", "s", "all", "Filter by state: open, closed, all")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+
+	return cmd
+}
+
+// decisionStatuses are the ADR statuses a record can be created with
+var decisionStatuses = []string{"proposed", "accepted", "deprecated", "superseded"}
+
+// buildDecisionBody returns the ADR body of a decision record
+func buildDecisionBody(opts *decisionRecordOptions, title, contextRef, contextTitle string) string {
+	decision := strings.TrimSpace(opts.decision)
+	if decision == "" {
+		decision = title + "."
+	}
+	consequences := strings.TrimSpace(opts.consequences)
+	if consequences == "" {
+		consequences = "_To be described._"
+	}
+
+	var b strings.Builder
+	b.WriteString("## Status\n\n")
+	b.WriteString(strings.ToUpper(opts.status[:1]) + opts.status[1:] + "\n\n")
+	b.WriteString("## Context\n\n")
+	fmt.Fprintf(&b, "Decided for %s: %s\n\n", contextRef, contextTitle)
+	b.WriteString("## Decision\n\n")
+	b.WriteString(decision + "\n\n")
+	b.WriteString("## Consequences\n\n")
+	b.WriteString(consequences + "\n")
+	return b.String()
+}
+
+// runDecisionRecordWithDeps is the testable implementation of decision record
+func runDecisionRecordWithDeps(cmd *cobra.Command, args []string, opts *decisionRecordOptions, cfg *config.Config, client decisionClient) error {
+	title := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(args[0]), strings.TrimSpace(decisionTitlePrefix)))
+	if title == "" {
+		return fmt.Errorf("decision title cannot be empty")
+	}
+	opts.status = strings.ToLower(opts.status)
+	if !containsFold(decisionStatuses, opts.status) {
+		return fmt.Errorf("invalid status: %s (must be %s)", opts.status, strings.Join(decisionStatuses, ", "))
+	}
+
+	contextKey, err := issueKey(cfg, opts.context)
+	if err != nil {
+		return fmt.Errorf("invalid --context: %w", err)
+	}
+	ctxOwner, ctxRepo, ctxNumber, err := parseIssueReference(contextKey)
+	if err != nil {
+		return err
+	}
+	contextIssue, err := client.GetIssue(ctxOwner, ctxRepo, ctxNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get context issue %s: %w", contextKey, err)
+	}
+	if contextIssue.Repository.Owner != "" && contextIssue.Repository.Name != "" {
+		ctxOwner, ctxRepo = contextIssue.Repository.Owner, contextIssue.Repository.Name
+	}
+
+	owner, repo := ctxOwner, ctxRepo
+	if opts.repo != "" {
+		owner, repo = splitRepository(opts.repo)
+		if owner == "" || repo == "" {
+			return fmt.Errorf("invalid repository %q: expected owner/repo", opts.repo)
+		}
+	}
+	contextRef := fmt.Sprintf("#%d", ctxNumber)
+	if !strings.EqualFold(owner+"/"+repo, ctxOwner+"/"+ctxRepo) {
+		contextRef = contextKey
+	}
+
+	labels := []string{decisionLabel}
+	if area := strings.TrimSpace(opts.area); area != "" {
+		labels = append(labels, areaLabelPrefix+area)
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	body := buildDecisionBody(opts, title, contextRef, contextIssue.Title)
+	issue, err := client.CreateIssueWithOptions(owner, repo, decisionTitlePrefix+title, body, labels, nil, "")
+	if err != nil {
+		return fmt.Errorf("failed to create issue: %w", err)
+	}
+	for _, label := range labels {
+		if !hasLabel(issue.Labels, label) {
+			fmt.Fprintf(os.Stderr, "Warning: label %q does not exist in %s/%s and was not applied\n", label, owner, repo)
+		}
+	}
+
+	if _, err := client.AddIssueToProject(project.ID, issue.ID); err != nil {
+		return fmt.Errorf("failed to add issue to project: %w", err)
+	}
+
+	recordRef := fmt.Sprintf("%s/%s#%d", owner, repo, issue.Number)
+	comment := fmt.Sprintf("📝 Decision recorded in %s: %s", recordRef, title)
+	if _, err := client.AddIssueComment(contextIssue.ID, comment); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to link the decision from %s: %v\n", contextKey, err)
+	}
+
+	cmd.Printf("Recorded decision %s: %s\n", recordRef, title)
+	cmd.Printf("  • Context: %s - %s\n", contextKey, contextIssue.Title)
+	cmd.Printf("🔗 %s\n", issue.URL)
+	return nil
+}
+
+// decisionRow is a decision record in a list
+type decisionRow struct {
+	Issue  string   `json:"issue"` // owner/repo#number
+	Number int      `json:"number"`
+	Title  string   `json:"title"`
+	State  string   `json:"state"`
+	Areas  []string `json:"areas"`
+	URL    string   `json:"url"`
+}
+
+// issueAreas returns the areas of an issue's area labels
+func issueAreas(labels []api.Label) []string {
+	areas := []string{}
+	for _, l := range labels {
+		name := strings.ToLower(l.Name)
+		for _, prefix := range []string{areaLabelPrefix, "area/"} {
+			if strings.HasPrefix(name, prefix) {
+				areas = append(areas, strings.TrimSpace(l.Name[len(prefix):]))
+				break
+			}
+		}
+	}
+	return areas
+}
+
+// isDecision reports whether an issue is a decision record
+func isDecision(issue *api.Issue) bool {
+	return hasLabel(issue.Labels, decisionLabel) || strings.HasPrefix(strings.ToUpper(issue.Title), strings.ToUpper(strings.TrimSpace(decisionTitlePrefix)))
+}
+
+// runDecisionListWithDeps is the testable implementation of decision list
+func runDecisionListWithDeps(cmd *cobra.Command, opts *decisionListOptions, cfg *config.Config, client decisionClient) error {
+	state := strings.ToLower(opts.state)
+	if !config.IsValidState(state) {
+		return fmt.Errorf("invalid state: %s (must be open, closed, or all)", opts.state)
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	rows := []decisionRow{}
+	for _, item := range items {
+		if item.Issue == nil || !isDecision(item.Issue) {
+			continue
+		}
+		if state != "all" && !strings.EqualFold(item.Issue.State, state) {
+			continue
+		}
+		areas := issueAreas(item.Issue.Labels)
+		if opts.area != "" && !containsFold(areas, opts.area) {
+			continue
+		}
+		title := item.Issue.Title
+		if len(title) >= len(decisionTitlePrefix) && strings.EqualFold(title[:len(decisionTitlePrefix)], decisionTitlePrefix) {
+			title = title[len(decisionTitlePrefix):]
+		}
+		rows = append(rows, decisionRow{
+			Issue:  itemKey(item),
+			Number: item.Issue.Number,
+			Title:  title,
+			State:  item.Issue.State,
+			Areas:  areas,
+			URL:    item.Issue.URL,
+		})
+	}
+	// Issue numbers grow with time, so the highest is the newest
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].Number > rows[j].Number })
+
+	if opts.json {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(rows)
+	}
+
+	out := cmd.OutOrStdout()
+	if len(rows) == 0 {
+		fmt.Fprintln(out, "No decisions found")
+		return nil
+	}
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ISSUE\tDECISION\tAREA\tSTATE")
+	for _, r := range rows {
+		area := strings.Join(r.Areas, ", ")
+		if area == "" {
+			area = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Issue, r.Title, area, r.State)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockDecisionClient implements decisionClient interface for testing
+type mockDecisionClient struct {
+	items          []api.ProjectItem
+	existingLabels []string
+	createdTitle   string
+	createdBody    string
+	createdLabels  []string
+	createdRepo    string
+	addedToProject string
+	comments       []string
+}
+
+func (m *mockDecisionClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockDecisionClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func (m *mockDecisionClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{
+		ID:         "issue-ctx",
+		Number:     number,
+		Title:      "Storage epic",
+		Repository: api.Repository{Owner: "Owner", Name: "repo"},
+	}, nil
+}
+
+func (m *mockDecisionClient) CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error) {
+	m.createdRepo = owner + "/" + repo
+	m.createdTitle = title
+	m.createdBody = body
+	m.createdLabels = labels
+	issue := &api.Issue{ID: "issue-adr", Number: 60, Title: title, URL: "https://github.com/Owner/repo/issues/60"}
+	for _, l := range labels {
+		if containsFold(m.existingLabels, l) {
+			issue.Labels = append(issue.Labels, api.Label{Name: l})
+		}
+	}
+	return issue, nil
+}
+
+func (m *mockDecisionClient) AddIssueToProject(projectID, issueID string) (string, error) {
+	m.addedToProject = issueID
+	return "item-adr", nil
+}
+
+func (m *mockDecisionClient) AddIssueComment(issueID, body string) (string, error) {
+	m.comments = append(m.comments, issueID+": "+body)
+	return "comment-1", nil
+}
+
+func TestRunDecisionRecord_CreatesADR(t *testing.T) {
+	client := &mockDecisionClient{existingLabels: []string{"decision", "area:storage"}}
+	cmd, buf := newTestCmd()
+	opts := &decisionRecordOptions{context: "42", area: "storage", status: "Accepted", consequences: "We run migrations"}
+
+	if err := runDecisionRecordWithDeps(cmd, []string{"Use Postgres"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runDecisionRecordWithDeps() error = %v", err)
+	}
+
+	if client.createdTitle != "ADR: Use Postgres" || client.createdRepo != "Owner/repo" {
+		t.Errorf("Unexpected issue %q in %s", client.createdTitle, client.createdRepo)
+	}
+	if len(client.createdLabels) != 2 || client.createdLabels[1] != "area:storage" {
+		t.Errorf("Unexpected labels: %v", client.createdLabels)
+	}
+	for _, want := range []string{"## Status\n\nAccepted", "Decided for #42: Storage epic", "## Decision\n\nUse Postgres.", "We run migrations"} {
+		if !strings.Contains(client.createdBody, want) {
+			t.Errorf("Expected body to contain %q, got:\n%s", want, client.createdBody)
+		}
+	}
+	if client.addedToProject != "issue-adr" {
+		t.Error("Expected the record to be added to the project")
+	}
+	if len(client.comments) != 1 || !strings.HasPrefix(client.comments[0], "issue-ctx: ") || !strings.Contains(client.comments[0], "Owner/repo#60") {
+		t.Errorf("Expected a comment on the context issue, got %v", client.comments)
+	}
+	if !strings.Contains(buf.String(), "Recorded decision Owner/repo#60: Use Postgres") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunDecisionRecord_OtherRepoReferencesContextFully(t *testing.T) {
+	client := &mockDecisionClient{}
+	cmd, _ := newTestCmd()
+	opts := &decisionRecordOptions{context: "42", status: "proposed", repo: "owner/adrs"}
+
+	if err := runDecisionRecordWithDeps(cmd, []string{"ADR: Use Postgres"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runDecisionRecordWithDeps() error = %v", err)
+	}
+	if client.createdTitle != "ADR: Use Postgres" || client.createdRepo != "owner/adrs" {
+		t.Errorf("Unexpected issue %q in %s", client.createdTitle, client.createdRepo)
+	}
+	if !strings.Contains(client.createdBody, "Decided for owner/repo#42") || !strings.Contains(client.createdBody, "Proposed") {
+		t.Errorf("Unexpected body:\n%s", client.createdBody)
+	}
+}
+
+func TestRunDecisionRecord_InvalidStatus(t *testing.T) {
+	cmd, _ := newTestCmd()
+	opts := &decisionRecordOptions{context: "42", status: "maybe"}
+	err := runDecisionRecordWithDeps(cmd, []string{"Use Postgres"}, opts, newTestConfig(), &mockDecisionClient{})
+	if err == nil || !strings.Contains(err.Error(), "invalid status") {
+		t.Errorf("Expected an invalid status error, got %v", err)
+	}
+}
+
+func newDecisionListTestClient() *mockDecisionClient {
+	item := func(number int, title, state string, labels ...string) api.ProjectItem {
+		issue := &api.Issue{Number: number, Title: title, State: state, Repository: api.Repository{Owner: "owner", Name: "repo"}}
+		for _, l := range labels {
+			issue.Labels = append(issue.Labels, api.Label{Name: l})
+		}
+		return api.ProjectItem{ID: "item", Issue: issue}
+	}
+	return &mockDecisionClient{items: []api.ProjectItem{
+		item(5, "ADR: Use Postgres", "OPEN", "decision", "area:storage"),
+		item(9, "Adopt gRPC", "CLOSED", "decision", "area/api"),
+		item(12, "ADR: Cache in Redis", "OPEN", "area:storage"),
+		item(3, "Fix login", "OPEN", "bug", "area:storage"),
+	}}
+}
+
+func TestRunDecisionList_FiltersByArea(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runDecisionListWithDeps(cmd, &decisionListOptions{area: "Storage", state: "all"}, newTestConfig(), newDecisionListTestClient()); err != nil {
+		t.Fatalf("runDecisionListWithDeps() error = %v", err)
+	}
+	out := buf.String()
+	if strings.Contains(out, "Fix login") || strings.Contains(out, "gRPC") {
+		t.Errorf("Expected only storage decisions, got:\n%s", out)
+	}
+	if strings.Index(out, "Cache in Redis") > strings.Index(out, "Use Postgres") || strings.Contains(out, "ADR:") {
+		t.Errorf("Expected newest first without the title prefix, got:\n%s", out)
+	}
+}
+
+func TestRunDecisionList_JSON(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runDecisionListWithDeps(cmd, &decisionListOptions{state: "closed", json: true}, newTestConfig(), newDecisionListTestClient()); err != nil {
+		t.Fatalf("runDecisionListWithDeps() error = %v", err)
+	}
+	var rows []decisionRow
+	if err := json.Unmarshal(buf.Bytes(), &rows); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(rows) != 1 || rows[0].Number != 9 || len(rows[0].Areas) != 1 || rows[0].Areas[0] != "api" {
+		t.Errorf("Unexpected rows: %+v", rows)
+	}
+}
@@ -0,0 +1,331 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+type editOptions struct {
+	title           string
+	body            string
+	bodySet         bool
+	editor          bool
+	addLabels       []string
+	removeLabels    []string
+	addAssignees    []string
+	removeAssignees []string
+	fields          []string
+	checkConflicts  bool
+	force           bool
+}
+
+// editClient defines the interface for API methods used by edit.
+// This allows for easier testing with mock implementations.
+type editClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	ResolveOwner(owner string) (string, error)
+	UpdateIssue(owner, repo, issueID string, update api.IssueUpdate) error
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
+func newEditCommand() *cobra.Command {
+	opts := &editOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "edit [issue]",
+		Short: "Edit an issue's content and project fields",
+		Long: `Edit an issue's title, body, labels, and assignees together with its
+project fields. Without an issue, the focused issue is edited.
+
+The title, body, labels, and assignees are changed in a single mutation;
+project fields are then set with --field key=value, resolved and checked
+as for 'gh pmu create'. Labels, users, and fields are all checked before
+anything is changed.
+
+With --editor, the body opens in $EDITOR.
+
+With --check-conflicts, the issue (and its project item, with --field) is
+read again just before it is changed. If someone else changed it in the
+meantime, for example while the editor was open, nothing is changed
+unless --force is given.`,
+		Example: `  gh pmu edit 42 --title "Fix login on Safari" --add-label bug --remove-label triage
+
+  # Hand the issue over and re-estimate it
+  gh pmu edit 42 --remove-assignee @me --add-assignee alice --field estimate=5
+
+  # Rewrite the body of the focused issue in $EDITOR
+  gh pmu edit --editor
+
+  # Refuse to overwrite edits made while the editor was open
+  gh pmu edit 42 --editor --check-conflicts`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.bodySet = cmd.Flags().Changed("body")
+
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+
+			args, err = issueArgsOrFocus(args, cfg)
+			if err != nil {
+				return err
+			}
+
+			return runEditWithDeps(cmd, args[0], opts, cfg, api.NewClient(), editTextInEditor)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Set the title")
+	cmd.Flags().StringVarP(&opts.body, "body", "b", "", "Set the body")
+	cmd.Flags().BoolVarP(&opts.editor, "editor", "e", false, "Edit the body in $EDITOR")
+	cmd.Flags().StringArrayVar(&opts.addLabels, "add-label", nil, "Add a label (can be specified multiple times)")
+	cmd.Flags().StringArrayVar(&opts.removeLabels, "remove-label", nil, "Remove a label (can be specified multiple times)")
+	cmd.Flags().StringArrayVar(&opts.addAssignees, "add-assignee", nil, "Assign a user, @me for yourself (can be specified multiple times)")
+	cmd.Flags().StringArrayVar(&opts.removeAssignees, "remove-assignee", nil, "Unassign a user, @me for yourself (can be specified multiple times)")
+	cmd.Flags().StringArrayVar(&opts.fields, "field", nil, "Set a project field as key=value (can be specified multiple times)")
+	cmd.Flags().BoolVar(&opts.checkConflicts, "check-conflicts", false, "Refuse to edit if the issue changed since it was read")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "With --check-conflicts, edit the issue even if it changed")
+
+	return cmd
+}
+
+// runEditWithDeps is the testable implementation of edit
+func runEditWithDeps(cmd *cobra.Command, issueArg string, opts *editOptions, cfg *config.Config, client editClient, edit func(text, pattern string) (string, error)) error {
+	if opts.editor && opts.bodySet {
+		return fmt.Errorf("--editor cannot be used with --body")
+	}
+	if opts.title == "" && !opts.bodySet && !opts.editor && len(opts.addLabels) == 0 && len(opts.removeLabels) == 0 &&
+		len(opts.addAssignees) == 0 && len(opts.removeAssignees) == 0 && len(opts.fields) == 0 {
+		return fmt.Errorf("nothing to edit; pass --title, --body, --editor, a label or assignee flag, or --field")
+	}
+	if opts.force && !opts.checkConflicts {
+		return fmt.Errorf("--force requires --check-conflicts")
+	}
+
+	owner, repo, number, err := parseIssueReference(issueArg)
+	if err != nil {
+		return err
+	}
+	if owner == "" || repo == "" {
+		if len(cfg.Repositories) == 0 {
+			return fmt.Errorf("no repository specified and none configured")
+		}
+		owner, repo = splitRepository(cfg.Repositories[0])
+	}
+
+	// Check the fields before anything is changed
+	fields, err := resolveCreateFields(opts.fields, cfg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	issue, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	var projectID, itemID string
+	var readFields []api.FieldValue
+	if len(fields) > 0 {
+		project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+		if err != nil {
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+		item, err := client.GetIssueProjectItem(owner, repo, number, project.ID)
+		if err != nil {
+			return err
+		}
+		if item == nil {
+			return fmt.Errorf("issue #%d is not in the project; its fields cannot be set", number)
+		}
+		projectID, itemID, readFields = project.ID, item.ID, item.FieldValues
+	}
+
+	update, changes, err := buildIssueUpdate(issue, opts, client, edit)
+	if err != nil {
+		return err
+	}
+
+	if opts.checkConflicts {
+		if err := checkEditConflict(client, owner, repo, number, issue, projectID, readFields); err != nil {
+			if !opts.force {
+				return fmt.Errorf("%w; nothing was changed, use --force to edit it anyway", err)
+			}
+			fmt.Fprintf(os.Stderr, "Warning: %v; editing anyway\n", err)
+		}
+	}
+
+	if update != nil {
+		if err := client.UpdateIssue(owner, repo, issue.ID, *update); err != nil {
+			return err
+		}
+	}
+
+	for _, f := range fields {
+		if err := client.SetProjectItemField(projectID, itemID, f.Field, f.Value); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to set %s: %v\n", f.Field, err)
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%s → %s", f.Field, f.Value))
+	}
+
+	title := issue.Title
+	if update != nil && update.Title != nil {
+		title = *update.Title
+	}
+	cmd.Printf("✓ Updated issue #%d: %s\n", number, title)
+	for _, c := range changes {
+		cmd.Printf("  • %s\n", c)
+	}
+	if len(changes) == 0 {
+		cmd.Println("  • No changes")
+	}
+	return nil
+}
+
+// checkEditConflict reads the issue again, and its project item when
+// projectID is set, returning an error describing what changed since read
+func checkEditConflict(client editClient, owner, repo string, number int, read *api.Issue, projectID string, readFields []api.FieldValue) error {
+	current, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+	changes := issueConflicts(read, current)
+
+	if projectID != "" {
+		fieldChanges, err := itemConflicts(client, projectID, owner, repo, number, readFields)
+		if err != nil {
+			return err
+		}
+		changes = append(changes, fieldChanges...)
+	}
+	return conflictError(number, changes)
+}
+
+// buildIssueUpdate works out the title, body, label, and assignee changes
+// for an issue, returning nil when its content is unchanged
+func buildIssueUpdate(issue *api.Issue, opts *editOptions, client editClient, edit func(text, pattern string) (string, error)) (*api.IssueUpdate, []string, error) {
+	update := api.IssueUpdate{}
+	var changes []string
+
+	if opts.title != "" && opts.title != issue.Title {
+		update.Title = &opts.title
+		changes = append(changes, fmt.Sprintf("Title → %s", opts.title))
+	}
+
+	body := opts.body
+	if opts.editor {
+		edited, err := edit(issue.Body, "gh-pmu-edit-*.md")
+		if err != nil {
+			return nil, nil, err
+		}
+		body = edited
+	}
+	if (opts.bodySet || opts.editor) && body != issue.Body {
+		update.Body = &body
+		changes = append(changes, "Body updated")
+	}
+
+	var current []string
+	for _, l := range issue.Labels {
+		current = append(current, l.Name)
+	}
+	labels, added, removed := applyEditSet(current, opts.addLabels, opts.removeLabels)
+	if len(added) > 0 || len(removed) > 0 {
+		update.Labels = labels
+		changes = append(changes, describeEditSet("Labels", added, removed, "")...)
+	}
+
+	current = nil
+	for _, a := range issue.Assignees {
+		current = append(current, a.Login)
+	}
+	addAssignees, err := resolveEditLogins(client, opts.addAssignees)
+	if err != nil {
+		return nil, nil, err
+	}
+	removeAssignees, err := resolveEditLogins(client, opts.removeAssignees)
+	if err != nil {
+		return nil, nil, err
+	}
+	assignees, added, removed := applyEditSet(current, addAssignees, removeAssignees)
+	if len(added) > 0 || len(removed) > 0 {
+		update.Assignees = assignees
+		changes = append(changes, describeEditSet("Assignees", added, removed, "@")...)
+	}
+
+	if len(changes) == 0 {
+		return nil, nil, nil
+	}
+	return &update, changes, nil
+}
+
+// applyEditSet removes and then adds names (case-insensitively) to a set,
+// returning the new set and the names that were actually added and removed
+func applyEditSet(current, add, remove []string) (result, added, removed []string) {
+	result = []string{}
+	for _, name := range current {
+		if containsFold(remove, name) {
+			removed = append(removed, name)
+			continue
+		}
+		result = append(result, name)
+	}
+	for _, name := range add {
+		if containsFold(result, name) {
+			continue
+		}
+		result = append(result, name)
+		added = append(added, name)
+	}
+	return result, added, removed
+}
+
+// describeEditSet describes additions and removals as "+a" and "-b"
+func describeEditSet(label string, added, removed []string, prefix string) []string {
+	var parts []string
+	for _, name := range added {
+		parts = append(parts, "+"+prefix+name)
+	}
+	for _, name := range removed {
+		parts = append(parts, "-"+prefix+name)
+	}
+	return []string{fmt.Sprintf("%s: %s", label, strings.Join(parts, " "))}
+}
+
+// resolveEditLogins resolves @me to the authenticated user's login
+func resolveEditLogins(client interface {
+	ResolveOwner(owner string) (string, error)
+}, logins []string) ([]string, error) {
+	var resolved []string
+	for _, login := range logins {
+		if login != api.ViewerOwner {
+			login = strings.TrimPrefix(login, "@")
+		}
+		login, err := client.ResolveOwner(login)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, login)
+	}
+	return resolved, nil
+}
+
+// containsFold reports whether names contains name, ignoring case
+func containsFold(names []string, name string) bool {
+	for _, n := range names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+package cmd
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// mockEditClient implements editClient interface for testing
+type mockEditClient struct {
+	issue     *api.Issue
+	item      *api.ProjectItem
+	updateErr error
+
+	updates []api.IssueUpdate
+	fields  map[string]string
+}
+
+func (m *mockEditClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return m.issue, nil
+}
+
+func (m *mockEditClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockEditClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	return m.item, nil
+}
+
+func (m *mockEditClient) ResolveOwner(owner string) (string, error) {
+	if owner == api.ViewerOwner {
+		return "me-login", nil
+	}
+	return owner, nil
+}
+
+func (m *mockEditClient) UpdateIssue(owner, repo, issueID string, update api.IssueUpdate) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	m.updates = append(m.updates, update)
+	return nil
+}
+
+func (m *mockEditClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	if m.fields == nil {
+		m.fields = map[string]string{}
+	}
+	m.fields[fieldName] = value
+	return nil
+}
+
+func newEditTestSetup() (*config.Config, *mockEditClient) {
+	cfg := newCreateFieldsTestConfig()
+	cfg.Project = config.Project{Owner: "owner", Number: 1}
+	cfg.Repositories = []string{"owner/repo"}
+
+	return cfg, &mockEditClient{
+		issue: &api.Issue{
+			ID:        "I_42",
+			Number:    42,
+			Title:     "Login broken",
+			Body:      "Old body",
+			Labels:    []api.Label{{Name: "triage"}, {Name: "ui"}},
+			Assignees: []api.Actor{{Login: "me-login"}},
+		},
+		item: &api.ProjectItem{ID: "item-42"},
+	}
+}
+
+func noEditor(text, pattern string) (string, error) {
+	return "", errors.New("editor not expected")
+}
+
+func TestEditCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"edit"})
+	if err != nil || sub.Name() != "edit" {
+		t.Fatalf("edit command not found: %v", err)
+	}
+	for _, flag := range []string{"title", "body", "editor", "add-label", "remove-label", "add-assignee", "remove-assignee", "field"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag to exist", flag)
+		}
+	}
+}
+
+func TestRunEdit(t *testing.T) {
+	cfg, client := newEditTestSetup()
+	opts := &editOptions{
+		title:           "Fix login on Safari",
+		addLabels:       []string{"bug", "UI"},
+		removeLabels:    []string{"Triage"},
+		addAssignees:    []string{"@alice"},
+		removeAssignees: []string{"@me"},
+		fields:          []string{"estimate=5", "status=done"},
+	}
+
+	cmd, buf := newTestCmd()
+	if err := runEditWithDeps(cmd, "42", opts, cfg, client, noEditor); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(client.updates) != 1 {
+		t.Fatalf("Expected a single issue update, got %d", len(client.updates))
+	}
+	update := client.updates[0]
+	if update.Title == nil || *update.Title != "Fix login on Safari" || update.Body != nil {
+		t.Errorf("Unexpected title/body update: %+v", update)
+	}
+	if !reflect.DeepEqual(update.Labels, []string{"ui", "bug"}) {
+		t.Errorf("Expected labels [ui bug], got %v", update.Labels)
+	}
+	if !reflect.DeepEqual(update.Assignees, []string{"alice"}) {
+		t.Errorf("Expected assignees [alice], got %v", update.Assignees)
+	}
+	if client.fields["Estimate"] != "5" || client.fields["Status"] != "Done" {
+		t.Errorf("Expected project fields set, got %v", client.fields)
+	}
+
+	for _, want := range []string{
+		"✓ Updated issue #42: Fix login on Safari",
+		"Labels: +bug -triage",
+		"Assignees: +@alice -@me-login",
+		"Estimate → 5",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestRunEdit_EditorAndNoChanges(t *testing.T) {
+	cfg, client := newEditTestSetup()
+
+	editor := func(text, pattern string) (string, error) {
+		if text != "Old body" {
+			t.Errorf("Expected the editor to start from the current body, got %q", text)
+		}
+		return "New body", nil
+	}
+	cmd, _ := newTestCmd()
+	if err := runEditWithDeps(cmd, "42", &editOptions{editor: true}, cfg, client, editor); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(client.updates) != 1 || client.updates[0].Body == nil || *client.updates[0].Body != "New body" || client.updates[0].Labels != nil {
+		t.Errorf("Expected only the body updated, got %+v", client.updates)
+	}
+
+	// Changes that match the current issue skip the mutation
+	client.updates = nil
+	cmd, buf := newTestCmd()
+	opts := &editOptions{title: "Login broken", addLabels: []string{"ui"}, removeAssignees: []string{"bob"}}
+	if err := runEditWithDeps(cmd, "42", opts, cfg, client, noEditor); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(client.updates) != 0 || !strings.Contains(buf.String(), "No changes") {
+		t.Errorf("Expected no update, got %+v:\n%s", client.updates, buf.String())
+	}
+}
+
+func TestRunEdit_Errors(t *testing.T) {
+	cfg, client := newEditTestSetup()
+	cmd, _ := newTestCmd()
+
+	if err := runEditWithDeps(cmd, "42", &editOptions{}, cfg, client, noEditor); err == nil || !strings.Contains(err.Error(), "nothing to edit") {
+		t.Errorf("Expected nothing to edit error, got %v", err)
+	}
+	if err := runEditWithDeps(cmd, "42", &editOptions{editor: true, bodySet: true}, cfg, client, noEditor); err == nil {
+		t.Error("Expected --editor and --body to conflict")
+	}
+
+	// Bad fields and items outside the project fail before the issue is changed
+	if err := runEditWithDeps(cmd, "42", &editOptions{title: "New", fields: []string{"size=XXL"}}, cfg, client, noEditor); err == nil {
+		t.Error("Expected an invalid field value error")
+	}
+	client.item = nil
+	if err := runEditWithDeps(cmd, "42", &editOptions{title: "New", fields: []string{"estimate=1"}}, cfg, client, noEditor); err == nil || !strings.Contains(err.Error(), "not in the project") {
+		t.Errorf("Expected not in project error, got %v", err)
+	}
+	if len(client.updates) != 0 {
+		t.Errorf("Expected no updates, got %+v", client.updates)
+	}
+
+	client.updateErr = errors.New(`label "nope" not found`)
+	if err := runEditWithDeps(cmd, "42", &editOptions{addLabels: []string{"nope"}}, cfg, client, noEditor); err == nil || !strings.Contains(err.Error(), "nope") {
+		t.Errorf("Expected the update error, got %v", err)
+	}
+}
+
+func TestRunEdit_CheckConflicts(t *testing.T) {
+	read := &api.Issue{ID: "I_1", Number: 42, Title: "Login fails", Body: "Steps"}
+	client := &conflictingEditClient{
+		mockEditClient: mockEditClient{issue: read, item: &api.ProjectItem{ID: "item-42"}},
+		current:        &api.Issue{ID: "I_1", Number: 42, Title: "Login fails", Body: "Steps, with logs"},
+	}
+
+	cmd, _ := newTestCmd()
+	opts := &editOptions{title: "Login fails on Safari", checkConflicts: true}
+	err := runEditWithDeps(cmd, "42", opts, newTestConfig(), client, nil)
+	if err == nil || !strings.Contains(err.Error(), "Body edited") || !strings.Contains(err.Error(), "nothing was changed") {
+		t.Errorf("Expected conflict error, got %v", err)
+	}
+	if len(client.updates) != 0 {
+		t.Errorf("Expected no update, got %+v", client.updates)
+	}
+
+	opts.force = true
+	if err := runEditWithDeps(cmd, "42", opts, newTestConfig(), client, nil); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(client.updates) != 1 {
+		t.Errorf("Expected the forced update, got %+v", client.updates)
+	}
+
+	if err := runEditWithDeps(cmd, "42", &editOptions{title: "x", force: true}, newTestConfig(), client, nil); err == nil {
+		t.Error("Expected --force without --check-conflicts to fail")
+	}
+}
+
+// conflictingEditClient returns current when the issue is read again
+type conflictingEditClient struct {
+	mockEditClient
+	current *api.Issue
+	reads   int
+}
+
+func (m *conflictingEditClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	m.reads++
+	if m.reads%2 == 0 {
+		return m.current, nil
+	}
+	return m.issue, nil
+}
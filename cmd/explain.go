@@ -0,0 +1,376 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/scooter-indie/gh-pmu/internal/triage"
+	"github.com/spf13/cobra"
+)
+
+type explainOptions struct {
+	json bool
+}
+
+// explainClient defines the interface for API methods used by explain.
+// This allows for easier testing with mock implementations.
+type explainClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetParentIssue(owner, repo string, number int) (*api.Issue, error)
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	GetIssueProjectHistory(owner, repo string, number int) ([]api.ProjectEvent, error)
+	GetIssueSignals(owner, repo string, number int) (*api.IssueSignals, error)
+}
+
+// maxExplainParents bounds the walk up the parent chain
+const maxExplainParents = 10
+
+// explanation is how an issue fits the configured process
+type explanation struct {
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	Repository string `json:"repository"`
+	State      string `json:"state"`
+	InProject  bool   `json:"inProject"`
+
+	Status      string   `json:"status,omitempty"`
+	Workflow    []string `json:"workflow,omitempty"`    // Statuses in project order
+	StatusSince string   `json:"statusSince,omitempty"` // When the issue entered its status
+	Next        string   `json:"next,omitempty"`        // The status after the current one
+
+	TriageRules []explainTriageRule `json:"triageRules"`
+
+	Parents         []explainIssue `json:"parents"` // Nearest first
+	SubIssues       int            `json:"subIssues"`
+	ClosedSubIssues int            `json:"closedSubIssues"`
+
+	SLA *explainSLA `json:"sla,omitempty"`
+}
+
+// explainTriageRule is a configured triage rule and how it relates to the
+// issue. Applied means the issue carries the labels the rule applies.
+type explainTriageRule struct {
+	Name    string `json:"name"`
+	Query   string `json:"query"`
+	Applied bool   `json:"applied"`
+	Matches bool   `json:"matches"`
+}
+
+// explainIssue is an issue in the parent chain
+type explainIssue struct {
+	Number     int    `json:"number"`
+	Title      string `json:"title"`
+	Repository string `json:"repository"`
+}
+
+// explainSLA is the issue's age against prioritization.sla
+type explainSLA struct {
+	Days    int `json:"days"`
+	AgeDays int `json:"ageDays"`
+}
+
+func newExplainCommand() *cobra.Command {
+	opts := &explainOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "explain <issue>",
+		Short: "Explain how an issue fits the project's process",
+		Long: `Show how an issue fits the process configured in .gh-pmu.yml:
+
+  - its place in the status workflow, how long it has been there, and
+    the status it is expected to move to next
+  - the triage rules that were applied to it (it carries their labels)
+    or that match it now
+  - its parent chain up to the top-level epic, and its sub-issues
+  - while open, its age against the SLA in prioritization.sla
+
+The workflow order comes from the project metadata cached by 'gh pmu init'.`,
+		Example: `  gh pmu explain 42
+  gh pmu explain owner/repo#42 --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runExplainWithDeps(cmd, args, opts, cfg, api.NewClient(), time.Now())
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+
+	return cmd
+}
+
+// runExplainWithDeps is the testable implementation of explain
+func runExplainWithDeps(cmd *cobra.Command, args []string, opts *explainOptions, cfg *config.Config, client explainClient, now time.Time) error {
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	owner, repo, number, _ := parseIssueReference(key)
+
+	issue, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+	if issue.Repository.Owner != "" && issue.Repository.Name != "" {
+		owner, repo = issue.Repository.Owner, issue.Repository.Name
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	e := &explanation{
+		Number:      issue.Number,
+		Title:       issue.Title,
+		Repository:  owner + "/" + repo,
+		State:       issue.State,
+		TriageRules: explainTriageRules(cfg, *issue),
+		Parents:     []explainIssue{},
+	}
+
+	item, err := client.GetIssueProjectItem(owner, repo, number, project.ID)
+	if err != nil {
+		return err
+	}
+	if item != nil {
+		e.InProject = true
+		statusField := cfg.GetFieldName("status")
+		e.Status = getFieldValue(*item, statusField)
+		e.Workflow = fieldOptionOrder(cfg, statusField)
+		e.Next = nextWorkflowStatus(e.Workflow, e.Status)
+
+		events, err := client.GetIssueProjectHistory(owner, repo, number)
+		if err != nil {
+			return fmt.Errorf("failed to get history: %w", err)
+		}
+		e.StatusSince = statusSince(filterProjectHistory(events, project.Number), e.Status)
+	}
+
+	if err := explainHierarchy(client, e, owner, repo, number); err != nil {
+		return err
+	}
+
+	if p := cfg.Prioritization; p != nil && p.SLA != nil && p.SLA.Days > 0 && !strings.EqualFold(issue.State, "CLOSED") {
+		signals, err := client.GetIssueSignals(owner, repo, number)
+		if err != nil {
+			return fmt.Errorf("failed to get issue age: %w", err)
+		}
+		if created, err := time.Parse(time.RFC3339, signals.CreatedAt); err == nil {
+			e.SLA = &explainSLA{Days: p.SLA.Days, AgeDays: daysBetween(created, now)}
+		}
+	}
+
+	if opts.json {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(e)
+	}
+	writeExplanation(cmd, e, now)
+	return nil
+}
+
+// explainTriageRules reports each triage rule, by name, as applied to the
+// issue or matching it
+func explainTriageRules(cfg *config.Config, issue api.Issue) []explainTriageRule {
+	names := make([]string, 0, len(cfg.Triage))
+	for name := range cfg.Triage {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rules := []explainTriageRule{}
+	for _, name := range names {
+		tc := cfg.Triage[name]
+		applied := len(tc.Apply.Labels) > 0
+		for _, label := range tc.Apply.Labels {
+			if !hasLabel(issue.Labels, label) {
+				applied = false
+			}
+		}
+		rules = append(rules, explainTriageRule{
+			Name:    name,
+			Query:   tc.Query,
+			Applied: applied,
+			Matches: triage.Matches(issue, tc.Query),
+		})
+	}
+	return rules
+}
+
+// nextWorkflowStatus returns the status after current in the workflow. An
+// issue without a status is expected to enter the first one.
+func nextWorkflowStatus(workflow []string, current string) string {
+	if len(workflow) == 0 {
+		return ""
+	}
+	if current == "" {
+		return workflow[0]
+	}
+	for i, status := range workflow {
+		if strings.EqualFold(status, current) && i+1 < len(workflow) {
+			return workflow[i+1]
+		}
+	}
+	return ""
+}
+
+// statusSince returns when the issue last moved to status, or when it was
+// added to the project if it has not moved since
+func statusSince(history []api.ProjectEvent, status string) string {
+	since := ""
+	for _, e := range history {
+		switch {
+		case e.Type == api.ProjectEventAdded:
+			since = e.CreatedAt
+		case e.Type == api.ProjectEventStatusChanged && strings.EqualFold(e.To, status):
+			since = e.CreatedAt
+		}
+	}
+	return since
+}
+
+// explainHierarchy fills in the issue's parent chain and sub-issue counts
+func explainHierarchy(client explainClient, e *explanation, owner, repo string, number int) error {
+	pOwner, pRepo, pNumber := owner, repo, number
+	seen := map[string]bool{localstore.Key(owner+"/"+repo, number): true}
+	for len(e.Parents) < maxExplainParents {
+		parent, err := client.GetParentIssue(pOwner, pRepo, pNumber)
+		if err != nil {
+			return err
+		}
+		if parent == nil {
+			break
+		}
+		if parent.Repository.Owner != "" && parent.Repository.Name != "" {
+			pOwner, pRepo = parent.Repository.Owner, parent.Repository.Name
+		}
+		pNumber = parent.Number
+		key := localstore.Key(pOwner+"/"+pRepo, pNumber)
+		if seen[key] {
+			break
+		}
+		seen[key] = true
+		e.Parents = append(e.Parents, explainIssue{Number: parent.Number, Title: parent.Title, Repository: pOwner + "/" + pRepo})
+	}
+
+	subs, err := client.GetSubIssues(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get sub-issues: %w", err)
+	}
+	e.SubIssues = len(subs)
+	for _, sub := range subs {
+		if strings.EqualFold(sub.State, "CLOSED") {
+			e.ClosedSubIssues++
+		}
+	}
+	return nil
+}
+
+// daysBetween returns the whole days from t to now
+func daysBetween(t, now time.Time) int {
+	if now.Before(t) {
+		return 0
+	}
+	return int(now.Sub(t).Hours() / 24)
+}
+
+// writeExplanation prints an explanation section by section
+func writeExplanation(cmd *cobra.Command, e *explanation, now time.Time) {
+	cmd.Printf("#%d - %s\n", e.Number, e.Title)
+	cmd.Printf("%s, %s\n", e.Repository, strings.ToLower(e.State))
+
+	cmd.Println()
+	cmd.Println("Workflow:")
+	if !e.InProject {
+		cmd.Println("  Not in the project; 'gh pmu intake' or a triage rule adds it")
+	} else {
+		status := e.Status
+		if status == "" {
+			status = "(none)"
+		}
+		position := ""
+		for i, s := range e.Workflow {
+			if strings.EqualFold(s, e.Status) {
+				position = fmt.Sprintf(" (%d of %d)", i+1, len(e.Workflow))
+			}
+		}
+		cmd.Printf("  Status: %s%s\n", status, position)
+
+		if len(e.Workflow) > 0 {
+			steps := make([]string, len(e.Workflow))
+			for i, s := range e.Workflow {
+				steps[i] = s
+				if strings.EqualFold(s, e.Status) {
+					steps[i] = "[" + s + "]"
+				}
+			}
+			cmd.Printf("  Flow:   %s\n", strings.Join(steps, " → "))
+		} else {
+			cmd.Println("  Flow:   unknown; run 'gh pmu init' to cache the project's statuses")
+		}
+
+		if since, err := time.Parse(time.RFC3339, e.StatusSince); err == nil {
+			cmd.Printf("  Since:  %s (%d days)\n", since.UTC().Format("2006-01-02"), daysBetween(since, now))
+		}
+
+		switch {
+		case strings.EqualFold(e.State, "CLOSED"):
+			cmd.Println("  Next:   none, the issue is closed")
+		case e.Next != "":
+			cmd.Printf("  Next:   %s (gh pmu move %d --status %q)\n", e.Next, e.Number, e.Next)
+		case len(e.Workflow) > 0:
+			cmd.Printf("  Next:   close it (gh pmu move %d --done)\n", e.Number)
+		}
+	}
+
+	cmd.Println()
+	cmd.Println("Triage rules:")
+	if len(e.TriageRules) == 0 {
+		cmd.Println("  None configured")
+	}
+	for _, r := range e.TriageRules {
+		switch {
+		case r.Applied:
+			cmd.Printf("  %s: applied (the issue has its labels)\n", r.Name)
+		case r.Matches:
+			cmd.Printf("  %s: matches, so 'gh pmu triage %s' would apply it\n", r.Name, r.Name)
+		default:
+			cmd.Printf("  %s: does not match %q\n", r.Name, r.Query)
+		}
+	}
+
+	cmd.Println()
+	cmd.Println("Hierarchy:")
+	indent := "  "
+	for i := len(e.Parents) - 1; i >= 0; i-- {
+		p := e.Parents[i]
+		cmd.Printf("%s#%d - %s\n", indent, p.Number, p.Title)
+		indent += "  "
+	}
+	cmd.Printf("%s#%d - %s (this issue)\n", indent, e.Number, e.Title)
+	if e.SubIssues > 0 {
+		cmd.Printf("  Sub-issues: %d (%d closed)\n", e.SubIssues, e.ClosedSubIssues)
+	}
+
+	if e.SLA != nil {
+		cmd.Println()
+		cmd.Println("SLA:")
+		if remaining := e.SLA.Days - e.SLA.AgeDays; remaining >= 0 {
+			cmd.Printf("  Open %d of %d days; %d days left\n", e.SLA.AgeDays, e.SLA.Days, remaining)
+		} else {
+			cmd.Printf("  Open %d days, %d past the %d-day SLA\n", e.SLA.AgeDays, -remaining, e.SLA.Days)
+		}
+	}
+}
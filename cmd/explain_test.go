@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// mockExplainClient implements explainClient interface for testing.
+// #42 is a sub-issue of #10, which is a sub-issue of #1.
+type mockExplainClient struct {
+	notInProject bool
+}
+
+func (m *mockExplainClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{
+		ID:     "issue-42",
+		Number: 42,
+		Title:  "Fix login redirect",
+		State:  "OPEN",
+		Labels: []api.Label{{Name: "bug"}, {Name: "pm-tracked"}},
+	}, nil
+}
+
+func (m *mockExplainClient) GetParentIssue(owner, repo string, number int) (*api.Issue, error) {
+	switch number {
+	case 42:
+		return &api.Issue{Number: 10, Title: "Auth epic"}, nil
+	case 10:
+		return &api.Issue{Number: 1, Title: "Roadmap"}, nil
+	}
+	return nil, nil
+}
+
+func (m *mockExplainClient) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	return []api.SubIssue{{Number: 43, State: "CLOSED"}, {Number: 44, State: "OPEN"}}, nil
+}
+
+func (m *mockExplainClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1", Number: 1}, nil
+}
+
+func (m *mockExplainClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	if m.notInProject {
+		return nil, nil
+	}
+	return &api.ProjectItem{ID: "item-42", FieldValues: []api.FieldValue{{Field: "Status", Value: "In Progress"}}}, nil
+}
+
+func (m *mockExplainClient) GetIssueProjectHistory(owner, repo string, number int) ([]api.ProjectEvent, error) {
+	return []api.ProjectEvent{
+		{Type: api.ProjectEventAdded, ProjectNumber: 1, CreatedAt: "2026-03-01T10:00:00Z"},
+		{Type: api.ProjectEventStatusChanged, ProjectNumber: 1, Field: "Status", From: "Backlog", To: "In Progress", CreatedAt: "2026-03-10T10:00:00Z"},
+		{Type: api.ProjectEventStatusChanged, ProjectNumber: 2, Field: "Status", To: "In Progress", CreatedAt: "2026-03-15T10:00:00Z"},
+	}, nil
+}
+
+func (m *mockExplainClient) GetIssueSignals(owner, repo string, number int) (*api.IssueSignals, error) {
+	return &api.IssueSignals{CreatedAt: "2026-02-20T10:00:00Z"}, nil
+}
+
+var explainTestNow = time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+
+func newExplainTestConfig() *config.Config {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{"status": {Field: "Status"}}
+	cfg.Metadata = &config.Metadata{Fields: []config.FieldMetadata{{
+		Name:    "Status",
+		Options: []config.OptionMetadata{{Name: "Backlog"}, {Name: "In Progress"}, {Name: "In Review"}, {Name: "Done"}},
+	}}}
+	cfg.Triage = map[string]config.Triage{
+		"untracked": {Query: "is:issue is:open -label:pm-tracked", Apply: config.TriageApply{Labels: []string{"pm-tracked"}}},
+		"bugs":      {Query: "is:issue is:open label:bug", Apply: config.TriageApply{Fields: map[string]string{"priority": "p1"}}},
+		"closed":    {Query: "is:issue is:closed"},
+	}
+	cfg.Prioritization = &config.Prioritization{SLA: &config.PrioritySLA{Days: 30, Points: 20}}
+	return cfg
+}
+
+func TestRunExplain(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runExplainWithDeps(cmd, []string{"42"}, &explainOptions{}, newExplainTestConfig(), &mockExplainClient{}, explainTestNow); err != nil {
+		t.Fatalf("runExplainWithDeps() error = %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"Status: In Progress (2 of 4)",
+		"Flow:   Backlog → [In Progress] → In Review → Done",
+		"Since:  2026-03-10 (10 days)",
+		`Next:   In Review (gh pmu move 42 --status "In Review")`,
+		"bugs: matches, so 'gh pmu triage bugs' would apply it",
+		`closed: does not match "is:issue is:closed"`,
+		"untracked: applied (the issue has its labels)",
+		"  #1 - Roadmap\n    #10 - Auth epic\n      #42 - Fix login redirect (this issue)\n",
+		"Sub-issues: 2 (1 closed)",
+		"Open 28 of 30 days; 2 days left",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunExplain_JSONNotInProject(t *testing.T) {
+	cmd, buf := newTestCmd()
+	client := &mockExplainClient{notInProject: true}
+	if err := runExplainWithDeps(cmd, []string{"42"}, &explainOptions{json: true}, newExplainTestConfig(), client, explainTestNow); err != nil {
+		t.Fatalf("runExplainWithDeps() error = %v", err)
+	}
+
+	var e explanation
+	if err := json.Unmarshal(buf.Bytes(), &e); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if e.InProject || e.Status != "" || e.Next != "" {
+		t.Errorf("Expected no workflow for an issue not in the project, got %+v", e)
+	}
+	if len(e.Parents) != 2 || e.Parents[0].Number != 10 {
+		t.Errorf("Expected parents nearest first, got %+v", e.Parents)
+	}
+	if e.SLA == nil || e.SLA.AgeDays != 28 {
+		t.Errorf("Unexpected SLA: %+v", e.SLA)
+	}
+}
+
+func TestNextWorkflowStatus(t *testing.T) {
+	workflow := []string{"Backlog", "In Progress", "Done"}
+	tests := map[string]string{"": "Backlog", "backlog": "In Progress", "Done": "", "Unknown": ""}
+	for current, want := range tests {
+		if got := nextWorkflowStatus(workflow, current); got != want {
+			t.Errorf("nextWorkflowStatus(%q) = %q, want %q", current, got, want)
+		}
+	}
+}
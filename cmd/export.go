@@ -0,0 +1,219 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/warehouse"
+	"github.com/spf13/cobra"
+)
+
+type exportWarehouseOptions struct {
+	driver string
+	dsn    string
+	print  bool
+	full   bool
+}
+
+// exportWarehouseClient defines the interface for API methods used by
+// export warehouse. This allows for easier testing with mock implementations.
+type exportWarehouseClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+}
+
+func newExportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export project data to other tools",
+	}
+
+	cmd.AddCommand(newExportWarehouseCommand())
+
+	return cmd
+}
+
+func newExportWarehouseCommand() *cobra.Command {
+	opts := &exportWarehouseOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "warehouse",
+		Short: "Sync project items into a SQL warehouse",
+		Long: `Load the project's issues, field values, and change events into a
+relational database for BI tools.
+
+The sync is incremental: only items added, changed, or removed since the
+last sync to the same warehouse are written, and each change is recorded
+as an event. Tables are created on first use:
+
+  pmu_items         one row per issue
+  pmu_item_fields   one row per issue and field value
+  pmu_item_events   added, removed, and field changes with their time
+
+The SQL is loaded with psql (--driver postgres) or sqlite3 (--driver
+sqlite), which must be on your PATH. A password in a postgres --dsn is
+passed to psql in PGPASSWORD, not on its command line. Use --print to
+write the SQL to stdout instead. Run the command from cron or CI to keep the warehouse
+up to date.`,
+		Example: `  gh pmu export warehouse --driver postgres --dsn postgres://bi@db/pm
+
+  # Keep a local SQLite copy
+  gh pmu export warehouse --driver sqlite --dsn project.db
+
+  # Reload everything
+  gh pmu export warehouse --driver sqlite --dsn project.db --full
+
+  # Hourly from cron
+  0 * * * * cd ~/src/app && gh pmu export warehouse --driver postgres --dsn "$PM_DSN"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+
+			path, err := warehouse.DefaultPath(cfg.Project.Owner, cfg.Project.Number, opts.driver, opts.dsn)
+			if err != nil {
+				return err
+			}
+			return runExportWarehouseWithDeps(cmd, opts, cfg, api.NewClient(), path, warehouse.RunReader, time.Now())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.driver, "driver", "postgres", "Warehouse driver: postgres or sqlite")
+	cmd.Flags().StringVar(&opts.dsn, "dsn", "", "Connection string (postgres) or database file (sqlite)")
+	cmd.Flags().BoolVar(&opts.print, "print", false, "Write the SQL to stdout instead of loading it")
+	cmd.Flags().BoolVar(&opts.full, "full", false, "Reload all items instead of only changes since the last sync")
+
+	return cmd
+}
+
+// runExportWarehouseWithDeps is the testable implementation of export
+// warehouse. load runs the SQL against the warehouse.
+func runExportWarehouseWithDeps(cmd *cobra.Command, opts *exportWarehouseOptions, cfg *config.Config, client exportWarehouseClient, path string, load func(driver, dsn string, sql io.Reader) error, now time.Time) error {
+	validDriver := false
+	for _, driver := range warehouse.Drivers {
+		if opts.driver == driver {
+			validDriver = true
+		}
+	}
+	if !validDriver {
+		return fmt.Errorf("unsupported driver %q: use postgres or sqlite", opts.driver)
+	}
+	if opts.dsn == "" && !opts.print {
+		return fmt.Errorf("--dsn is required unless --print is given")
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := fetchWarehouseItems(client, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	state := &warehouse.State{Items: map[string]warehouse.Item{}}
+	if !opts.full && !opts.print {
+		state, err = warehouse.Load(path)
+		if err != nil {
+			return err
+		}
+	}
+
+	key := fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number)
+	changes := state.Diff(items)
+
+	if opts.print {
+		return warehouse.WriteSQL(cmd.OutOrStdout(), key, changes, now)
+	}
+
+	if changes.Empty() {
+		cmd.Println("Warehouse is up to date")
+		return nil
+	}
+
+	// The SQL for a large project is spilled to a temporary file and
+	// streamed to the client rather than built in memory
+	sql, err := os.CreateTemp("", "gh-pmu-warehouse-*.sql")
+	if err != nil {
+		return fmt.Errorf("failed to create SQL file: %w", err)
+	}
+	defer os.Remove(sql.Name())
+	defer sql.Close()
+	if err := warehouse.WriteSQL(sql, key, changes, now); err != nil {
+		return fmt.Errorf("failed to write SQL file: %w", err)
+	}
+	if _, err := sql.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read SQL file: %w", err)
+	}
+
+	if err := load(opts.driver, opts.dsn, sql); err != nil {
+		return err
+	}
+
+	// Only remember what was loaded once the load succeeded, so a failed
+	// sync is retried in full next time
+	state.Apply(key, items, now)
+	if err := state.Save(path); err != nil {
+		return err
+	}
+
+	cmd.Printf("✓ Synced %d item(s) to the warehouse (%d upserted, %d removed, %d event(s))\n",
+		len(items), len(changes.Upserts), len(changes.Removed), len(changes.Events))
+	return nil
+}
+
+// fetchWarehouseItems returns the project's issues as warehouse items.
+// When the client can page, each page is converted as it arrives so only
+// the compact warehouse items are held.
+func fetchWarehouseItems(client exportWarehouseClient, projectID string) ([]warehouse.Item, error) {
+	batches, ok := client.(projectItemsBatchReader)
+	if !ok {
+		projectItems, err := client.GetProjectItems(projectID, nil)
+		if err != nil {
+			return nil, err
+		}
+		return warehouseItems(projectItems), nil
+	}
+
+	var items []warehouse.Item
+	err := batches.EachProjectItemsBatch(projectID, nil, func(batch []api.ProjectItem) error {
+		items = append(items, warehouseItems(batch)...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// warehouseItems converts project issues to warehouse items, skipping
+// items without an issue
+func warehouseItems(items []api.ProjectItem) []warehouse.Item {
+	var result []warehouse.Item
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		fields := make(map[string]string, len(item.FieldValues))
+		for _, fv := range item.FieldValues {
+			fields[fv.Field] = fv.Value
+		}
+		result = append(result, warehouse.Item{
+			ID:         item.ID,
+			Repository: item.Issue.Repository.Owner + "/" + item.Issue.Repository.Name,
+			Number:     item.Issue.Number,
+			Title:      item.Issue.Title,
+			State:      item.Issue.State,
+			URL:        item.Issue.URL,
+			Fields:     fields,
+		})
+	}
+	return result
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/warehouse"
+)
+
+// mockExportWarehouseClient implements exportWarehouseClient interface for testing
+type mockExportWarehouseClient struct {
+	items []api.ProjectItem
+}
+
+func (m *mockExportWarehouseClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockExportWarehouseClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+// mockBatchExportWarehouseClient also serves items one page at a time
+type mockBatchExportWarehouseClient struct {
+	mockExportWarehouseClient
+	pages int
+}
+
+func (m *mockBatchExportWarehouseClient) EachProjectItemsBatch(projectID string, filter *api.ProjectItemsFilter, fn func(batch []api.ProjectItem) error) error {
+	for i := range m.items {
+		m.pages++
+		if err := fn(m.items[i : i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newExportTestItems() []api.ProjectItem {
+	repo := api.Repository{Owner: "owner", Name: "repo"}
+	return []api.ProjectItem{
+		{ID: "item-1", Issue: &api.Issue{Number: 1, Title: "Login", State: "OPEN", Repository: repo}, FieldValues: []api.FieldValue{{Field: "Status", Value: "Todo"}}},
+		{ID: "item-2", Issue: &api.Issue{Number: 2, Title: "Search", State: "OPEN", Repository: repo}},
+		{ID: "draft-1"},
+	}
+}
+
+func TestExportWarehouseCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"export", "warehouse"})
+	if err != nil || sub.Name() != "warehouse" {
+		t.Fatalf("export warehouse command not found: %v", err)
+	}
+	for _, flag := range []string{"driver", "dsn", "print", "full"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag to exist", flag)
+		}
+	}
+}
+
+func TestRunExportWarehouse_Incremental(t *testing.T) {
+	client := &mockExportWarehouseClient{items: newExportTestItems()}
+	path := filepath.Join(t.TempDir(), "state.json")
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	opts := &exportWarehouseOptions{driver: "sqlite", dsn: "bi.db"}
+
+	var loaded []string
+	load := func(driver, dsn string, sql io.Reader) error {
+		if driver != "sqlite" || dsn != "bi.db" {
+			t.Errorf("Unexpected driver %s and dsn %s", driver, dsn)
+		}
+		data, err := io.ReadAll(sql)
+		loaded = append(loaded, string(data))
+		return err
+	}
+
+	cmd, buf := newTestCmd()
+	if err := runExportWarehouseWithDeps(cmd, opts, newTestConfig(), client, path, load, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(loaded) != 1 || !strings.Contains(loaded[0], "'owner/repo', 2, 'Search'") || strings.Contains(loaded[0], "draft-1") {
+		t.Fatalf("Expected both issues loaded, got %v", loaded)
+	}
+	if !strings.Contains(buf.String(), "Synced 2 item(s) to the warehouse (2 upserted, 0 removed, 2 event(s))") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+
+	// Nothing changed: nothing is loaded
+	cmd, buf = newTestCmd()
+	if err := runExportWarehouseWithDeps(cmd, opts, newTestConfig(), client, path, load, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(loaded) != 1 || !strings.Contains(buf.String(), "up to date") {
+		t.Errorf("Expected no load, got %d loads and %q", len(loaded), buf.String())
+	}
+
+	// Only the moved item is loaded
+	client.items[0].FieldValues[0].Value = "Done"
+	cmd, _ = newTestCmd()
+	if err := runExportWarehouseWithDeps(cmd, opts, newTestConfig(), client, path, load, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(loaded) != 2 || strings.Contains(loaded[1], "'Search'") || !strings.Contains(loaded[1], "'changed', 'Status', 'Todo', 'Done'") {
+		t.Errorf("Expected only the status change loaded, got:\n%s", loaded[len(loaded)-1])
+	}
+}
+
+func TestRunExportWarehouse_FailedLoadIsRetried(t *testing.T) {
+	client := &mockExportWarehouseClient{items: newExportTestItems()}
+	path := filepath.Join(t.TempDir(), "state.json")
+	opts := &exportWarehouseOptions{driver: "postgres", dsn: "postgres://db/bi"}
+
+	cmd, _ := newTestCmd()
+	failing := func(driver, dsn string, sql io.Reader) error { return errors.New("connection refused") }
+	if err := runExportWarehouseWithDeps(cmd, opts, newTestConfig(), client, path, failing, time.Now()); err == nil {
+		t.Fatal("Expected load error")
+	}
+
+	state, err := warehouse.Load(path)
+	if err != nil || len(state.Items) != 0 {
+		t.Errorf("Expected no state saved after a failed load, got %+v, %v", state, err)
+	}
+}
+
+func TestRunExportWarehouse_PrintAndValidation(t *testing.T) {
+	client := &mockExportWarehouseClient{items: newExportTestItems()}
+	path := filepath.Join(t.TempDir(), "state.json")
+	load := func(driver, dsn string, sql io.Reader) error {
+		t.Error("Expected nothing loaded")
+		return nil
+	}
+
+	cmd, buf := newTestCmd()
+	if err := runExportWarehouseWithDeps(cmd, &exportWarehouseOptions{driver: "postgres", print: true}, newTestConfig(), client, path, load, time.Now()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "CREATE TABLE IF NOT EXISTS pmu_items") || !strings.Contains(buf.String(), "COMMIT;") {
+		t.Errorf("Expected SQL on stdout, got:\n%s", buf.String())
+	}
+
+	tests := []struct {
+		opts *exportWarehouseOptions
+		want string
+	}{
+		{&exportWarehouseOptions{driver: "mysql", dsn: "x"}, "unsupported driver"},
+		{&exportWarehouseOptions{driver: "sqlite"}, "--dsn is required"},
+	}
+	for _, tt := range tests {
+		cmd, _ := newTestCmd()
+		err := runExportWarehouseWithDeps(cmd, tt.opts, newTestConfig(), client, path, load, time.Now())
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected error containing %q, got %v", tt.want, err)
+		}
+	}
+}
+
+func TestRunExportWarehouse_ReadsPages(t *testing.T) {
+	client := &mockBatchExportWarehouseClient{mockExportWarehouseClient: mockExportWarehouseClient{items: newExportTestItems()}}
+	path := filepath.Join(t.TempDir(), "state.json")
+	var loaded string
+	load := func(driver, dsn string, sql io.Reader) error {
+		data, err := io.ReadAll(sql)
+		loaded = string(data)
+		return err
+	}
+
+	cmd, buf := newTestCmd()
+	if err := runExportWarehouseWithDeps(cmd, &exportWarehouseOptions{driver: "sqlite", dsn: "bi.db"}, newTestConfig(), client, path, load, time.Now()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.pages != 3 || !strings.Contains(loaded, "'Login'") || !strings.HasSuffix(loaded, "COMMIT;\n") {
+		t.Errorf("Expected every page converted and the SQL loaded, got %d pages and:\n%s", client.pages, loaded)
+	}
+	if !strings.Contains(buf.String(), "Synced 2 item(s)") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+}
@@ -0,0 +1,204 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/favorites"
+	"github.com/spf13/cobra"
+)
+
+func newFavCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fav",
+		Short: "Bookmark frequently referenced issues",
+		Long: `Keep a personal set of favorite issues for the configured project.
+
+Favorites are stored locally, per project, in the user config directory.
+Use 'gh pmu list --fav' to list them with their project fields.`,
+	}
+
+	cmd.AddCommand(newFavAddCommand())
+	cmd.AddCommand(newFavListCommand())
+	cmd.AddCommand(newFavRemoveCommand())
+
+	return cmd
+}
+
+func newFavAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <issue>...",
+		Short: "Add issues to your favorites",
+		Example: `  gh pmu fav add 42
+  gh pmu fav add 42 57 owner/other-repo#3`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := loadFavConfig()
+			if err != nil {
+				return err
+			}
+			return runFavAddWithDeps(cmd, args, cfg, path, time.Now())
+		},
+	}
+
+	return cmd
+}
+
+type favListOptions struct {
+	json bool
+}
+
+func newFavListCommand() *cobra.Command {
+	opts := &favListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List your favorite issues",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, path, err := loadFavConfig()
+			if err != nil {
+				return err
+			}
+			return runFavListWithDeps(cmd, opts, path)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+
+	return cmd
+}
+
+func newFavRemoveCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <issue>...",
+		Short: "Remove issues from your favorites",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := loadFavConfig()
+			if err != nil {
+				return err
+			}
+			return runFavRemoveWithDeps(cmd, args, cfg, path)
+		},
+	}
+
+	return cmd
+}
+
+// loadFavConfig loads the project config and the location of its favorites file
+func loadFavConfig() (*config.Config, string, error) {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return nil, "", err
+	}
+
+	path, err := favorites.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, "", err
+	}
+	return cfg, path, nil
+}
+
+// loadProjectFavorites reads the favorites kept for the configured project
+func loadProjectFavorites(cfg *config.Config) (*favorites.Store, error) {
+	path, err := favorites.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, err
+	}
+	return favorites.Load(path)
+}
+
+// runFavAddWithDeps is the testable implementation of fav add
+func runFavAddWithDeps(cmd *cobra.Command, args []string, cfg *config.Config, path string, now time.Time) error {
+	keys := make([]string, 0, len(args))
+	for _, arg := range args {
+		key, err := issueKey(cfg, arg)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+
+	store, err := favorites.Load(path)
+	if err != nil {
+		return err
+	}
+	store.Project = fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number)
+
+	for _, key := range keys {
+		if store.Add(key, now) {
+			cmd.Printf("Added %s to favorites\n", key)
+		} else {
+			cmd.Printf("%s is already a favorite\n", key)
+		}
+	}
+
+	return store.Save(path)
+}
+
+// runFavListWithDeps is the testable implementation of fav list
+func runFavListWithDeps(cmd *cobra.Command, opts *favListOptions, path string) error {
+	store, err := favorites.Load(path)
+	if err != nil {
+		return err
+	}
+
+	if opts.json {
+		list := store.Favorites
+		if list == nil {
+			list = []favorites.Favorite{}
+		}
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(list)
+	}
+
+	if len(store.Favorites) == 0 {
+		cmd.Println("No favorites yet; add one with 'gh pmu fav add <issue>'")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ISSUE\tADDED")
+	for _, f := range store.Favorites {
+		fmt.Fprintf(w, "%s\t%s\n", f.Key, formatHistoryTime(f.AddedAt))
+	}
+	return w.Flush()
+}
+
+// runFavRemoveWithDeps is the testable implementation of fav remove
+func runFavRemoveWithDeps(cmd *cobra.Command, args []string, cfg *config.Config, path string) error {
+	store, err := favorites.Load(path)
+	if err != nil {
+		return err
+	}
+
+	for _, arg := range args {
+		key, err := issueKey(cfg, arg)
+		if err != nil {
+			return err
+		}
+		if !store.Remove(key) {
+			return fmt.Errorf("%s is not a favorite", key)
+		}
+		cmd.Printf("Removed %s from favorites\n", key)
+	}
+
+	return store.Save(path)
+}
+
+// filterByFavorites keeps items whose issue is in the favorites store
+func filterByFavorites(items []api.ProjectItem, store *favorites.Store) []api.ProjectItem {
+	var filtered []api.ProjectItem
+	for _, item := range items {
+		if item.Issue != nil && store.Has(itemKey(item)) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
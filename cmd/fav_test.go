@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/favorites"
+)
+
+func TestFavCommand_HasSubcommands(t *testing.T) {
+	cmd := NewRootCommand()
+	favCmd, _, err := cmd.Find([]string{"fav"})
+	if err != nil {
+		t.Fatalf("fav command not found: %v", err)
+	}
+
+	for _, name := range []string{"add", "list", "remove"} {
+		if sub, _, err := favCmd.Find([]string{name}); err != nil || sub.Name() != name {
+			t.Errorf("Expected fav %s subcommand", name)
+		}
+	}
+}
+
+func TestListCommand_HasFavFlag(t *testing.T) {
+	if newListCommand().Flags().Lookup("fav") == nil {
+		t.Fatal("Expected --fav flag to exist")
+	}
+}
+
+func TestRunFavAddListRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "favorites.json")
+	cfg := newTestConfig()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	cmd, buf := newTestCmd()
+	if err := runFavAddWithDeps(cmd, []string{"42", "other/repo#7", "42"}, cfg, path, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, want := range []string{"Added owner/repo#42", "Added other/repo#7", "owner/repo#42 is already a favorite"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, buf.String())
+		}
+	}
+
+	cmd, buf = newTestCmd()
+	if err := runFavListWithDeps(cmd, &favListOptions{}, path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "owner/repo#42  2025-03-01 09:00") {
+		t.Errorf("Unexpected list output:\n%s", buf.String())
+	}
+
+	cmd, _ = newTestCmd()
+	if err := runFavRemoveWithDeps(cmd, []string{"42"}, cfg, path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	cmd, _ = newTestCmd()
+	if err := runFavRemoveWithDeps(cmd, []string{"42"}, cfg, path); err == nil || !strings.Contains(err.Error(), "not a favorite") {
+		t.Errorf("Expected not a favorite error, got %v", err)
+	}
+
+	cmd, buf = newTestCmd()
+	if err := runFavListWithDeps(cmd, &favListOptions{json: true}, path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var list []favorites.Favorite
+	if err := json.Unmarshal(buf.Bytes(), &list); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(list) != 1 || list[0].Key != "other/repo#7" {
+		t.Errorf("Unexpected favorites: %+v", list)
+	}
+}
+
+func TestRunFavList_Empty(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runFavListWithDeps(cmd, &favListOptions{}, filepath.Join(t.TempDir(), "none.json")); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No favorites yet") {
+		t.Errorf("Expected empty message, got: %s", buf.String())
+	}
+}
+
+func TestFilterByFavorites(t *testing.T) {
+	store := &favorites.Store{}
+	store.Add("owner/repo#2", time.Now())
+
+	items := []api.ProjectItem{
+		{Issue: &api.Issue{Number: 1, Repository: api.Repository{Owner: "owner", Name: "repo"}}},
+		{Issue: &api.Issue{Number: 2, Repository: api.Repository{Owner: "Owner", Name: "Repo"}}},
+		{ID: "draft"},
+	}
+
+	got := filterByFavorites(items, store)
+	if len(got) != 1 || got[0].Issue.Number != 2 {
+		t.Errorf("Unexpected favorites: %+v", got)
+	}
+}
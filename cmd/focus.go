@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/focus"
+	"github.com/spf13/cobra"
+)
+
+type focusOptions struct {
+	clear bool
+}
+
+func newFocusCommand() *cobra.Command {
+	opts := &focusOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "focus [issue]",
+		Short: "Set the issue you are working on",
+		Long: `Record the issue you are currently working on.
+
+While an issue is focused, commands that take an issue (such as move)
+default to it when none is given. Without an argument, prints the
+focused issue. The focus is stored locally, per project, in the user
+config directory.`,
+		Example: `  gh pmu focus 42
+  gh pmu move --status in_review
+  gh pmu focus --clear`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := loadFocusConfig()
+			if err != nil {
+				return err
+			}
+			return runFocusWithDeps(cmd, args, opts, cfg, path, time.Now())
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.clear, "clear", false, "Clear the focused issue")
+
+	return cmd
+}
+
+// loadFocusConfig loads the project config and the location of its focus file
+func loadFocusConfig() (*config.Config, string, error) {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return nil, "", err
+	}
+
+	path, err := focus.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, "", err
+	}
+	return cfg, path, nil
+}
+
+// runFocusWithDeps is the testable implementation of focus
+func runFocusWithDeps(cmd *cobra.Command, args []string, opts *focusOptions, cfg *config.Config, path string, now time.Time) error {
+	if opts.clear {
+		if len(args) > 0 {
+			return fmt.Errorf("--clear does not take an issue")
+		}
+		cleared, err := focus.Clear(path)
+		if err != nil {
+			return err
+		}
+		if cleared {
+			cmd.Println("Cleared the focused issue")
+		} else {
+			cmd.Println("No issue is focused")
+		}
+		return nil
+	}
+
+	state, err := focus.Load(path)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		if state.Issue == "" {
+			cmd.Println("No issue is focused; set one with 'gh pmu focus <issue>'")
+			return nil
+		}
+		cmd.Printf("Focused on %s (since %s)\n", state.Issue, formatHistoryTime(state.SetAt))
+		return nil
+	}
+
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	state.Project = fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number)
+	state.Set(key, now)
+	if err := state.Save(path); err != nil {
+		return err
+	}
+	cmd.Printf("Focused on %s\n", key)
+	return nil
+}
+
+// issueArgsOrFocus returns args unchanged when an issue was given, or else
+// the focused issue for the project
+func issueArgsOrFocus(args []string, cfg *config.Config) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+	path, err := focus.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, err
+	}
+	return focusedIssueArgs(path)
+}
+
+// focusedIssueArgs returns the focused issue stored at path as arguments
+func focusedIssueArgs(path string) ([]string, error) {
+	state, err := focus.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	if state.Issue == "" {
+		return nil, fmt.Errorf("no issue given and none focused; pass an issue or run 'gh pmu focus <issue>'")
+	}
+	return []string{state.Issue}, nil
+}
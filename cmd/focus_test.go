@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFocusCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	focusCmd, _, err := cmd.Find([]string{"focus"})
+	if err != nil || focusCmd.Name() != "focus" {
+		t.Fatalf("focus command not found: %v", err)
+	}
+	if focusCmd.Flags().Lookup("clear") == nil {
+		t.Error("Expected --clear flag to exist")
+	}
+}
+
+func TestMoveCommand_IssueIsOptional(t *testing.T) {
+	if err := newMoveCommand().Args(nil, []string{}); err != nil {
+		t.Errorf("Expected move to accept no issue argument, got %v", err)
+	}
+}
+
+func TestRunFocus(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "focus.json")
+	cfg := newTestConfig()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	cmd, buf := newTestCmd()
+	if err := runFocusWithDeps(cmd, nil, &focusOptions{}, cfg, path, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No issue is focused") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+
+	if _, err := focusedIssueArgs(path); err == nil || !strings.Contains(err.Error(), "none focused") {
+		t.Errorf("Expected no focus error, got %v", err)
+	}
+
+	cmd, buf = newTestCmd()
+	if err := runFocusWithDeps(cmd, []string{"42"}, &focusOptions{}, cfg, path, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Focused on owner/repo#42") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+
+	cmd, buf = newTestCmd()
+	if err := runFocusWithDeps(cmd, nil, &focusOptions{}, cfg, path, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Focused on owner/repo#42 (since 2025-03-01 09:00)") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+
+	args, err := focusedIssueArgs(path)
+	if err != nil || len(args) != 1 || args[0] != "owner/repo#42" {
+		t.Errorf("focusedIssueArgs() = %v, %v", args, err)
+	}
+	if args, err := issueArgsOrFocus([]string{"7"}, cfg); err != nil || args[0] != "7" {
+		t.Errorf("Expected explicit args to win, got %v, %v", args, err)
+	}
+
+	cmd, _ = newTestCmd()
+	if err := runFocusWithDeps(cmd, []string{"42"}, &focusOptions{clear: true}, cfg, path, now); err == nil {
+		t.Error("Expected error for --clear with an issue")
+	}
+
+	cmd, buf = newTestCmd()
+	if err := runFocusWithDeps(cmd, nil, &focusOptions{clear: true}, cfg, path, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Cleared the focused issue") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+	if _, err := focusedIssueArgs(path); err == nil {
+		t.Error("Expected no focus after --clear")
+	}
+}
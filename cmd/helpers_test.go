@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"bytes"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// newTestConfig returns a minimal valid config: project owner/1 with the
+// repository owner/repo. Tests add the fields and sections they need.
+func newTestConfig() *config.Config {
+	return &config.Config{
+		Project:      config.Project{Owner: "owner", Number: 1},
+		Repositories: []string{"owner/repo"},
+	}
+}
+
+// newTestCmd returns a command whose output and errors are captured in buf
+func newTestCmd() (*cobra.Command, *bytes.Buffer) {
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	return cmd, buf
+}
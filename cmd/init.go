@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
 	"github.com/scooter-indie/gh-pmu/internal/ui"
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
@@ -24,18 +25,53 @@ func newInitCommand() *cobra.Command {
 
 This command will:
 - Auto-detect the current repository from git remote
+- Use the repository owner's projects, or those of --owner (a user,
+  an organization, or @me for your personal projects)
 - Discover and list available projects for selection
 - Fetch and cache project field metadata from GitHub
-- Create a .gh-pmu.yml configuration file`,
+- Create a .gh-pmu.yml configuration file
+
+Use --preset to start from a methodology instead of the default settings:
+
+  scrum     Sprint iteration, story points, sprint/backlog/review views
+  kanban    Flow statuses, expedite priority, board/WIP/review views
+  shape-up  Shaping-to-shipped statuses, appetite, cycle and pitch views
+
+Presets set field mappings, defaults, triage rules, and list views. init
+warns about any preset field or option missing from the project.
+
+Without --preset, init analyzes the project's existing field values and
+label usage and offers detected conventions instead of generic defaults:
+status and priority mappings built from the project's own options, the
+most used priority as the default, labels carried by most issues as
+default labels, and matching triage rules. Use --no-detect to skip this.`,
+		Example: `  # Configure with the default settings
+  gh pmu init
+
+  # Use one of your personal projects
+  gh pmu init --owner @me
+
+  # Configure a Scrum team
+  gh pmu init --preset scrum`,
 		RunE: runInit,
 	}
 
+	cmd.Flags().String("owner", "", "Project owner: user, organization, or @me (default: repository owner)")
+	cmd.Flags().String("preset", "", "Methodology preset: "+strings.Join(presetNames(), ", "))
+	cmd.Flags().Bool("no-detect", false, "Do not suggest settings from the project's existing conventions")
+
 	return cmd
 }
 
 func runInit(cmd *cobra.Command, args []string) error {
-	u := ui.New(cmd.OutOrStdout())
-	reader := bufio.NewReader(os.Stdin)
+	u := ui.NewWithOptions(cmd.OutOrStdout(), colorDisabled(cmd))
+	reader := bufio.NewReader(cmd.InOrStdin())
+
+	presetName, _ := cmd.Flags().GetString("preset")
+	preset, err := getInitPreset(presetName)
+	if err != nil {
+		return err
+	}
 
 	// Print header
 	u.Header("gh-pmu init", "Configure project management settings")
@@ -59,7 +95,16 @@ func runInit(cmd *cobra.Command, args []string) error {
 	var owner string
 	var defaultRepo string
 
-	if detectedRepo != "" {
+	ownerFlag, _ := cmd.Flags().GetString("owner")
+
+	if ownerFlag != "" {
+		// Explicit project owner, e.g. a personal project for an org repository
+		owner = ownerFlag
+		if detectedRepo != "" {
+			defaultRepo = detectedRepo
+			u.Success(fmt.Sprintf("Detected repository: %s", detectedRepo))
+		}
+	} else if detectedRepo != "" {
 		o, _ := splitRepository(detectedRepo)
 		owner = o
 		defaultRepo = detectedRepo
@@ -77,6 +122,16 @@ func runInit(cmd *cobra.Command, args []string) error {
 	// Initialize API client
 	client := api.NewClient()
 
+	// Store the actual login so the config means the same for everyone
+	if owner == api.ViewerOwner {
+		login, err := client.GetViewerLogin()
+		if err != nil {
+			return err
+		}
+		owner = login
+		u.Success(fmt.Sprintf("Project owner: %s", owner))
+	}
+
 	// Fetch projects for owner
 	fmt.Fprintln(cmd.OutOrStdout())
 	spinner := ui.NewSpinner(cmd.OutOrStdout(), fmt.Sprintf("Fetching projects for %s...", owner))
@@ -212,6 +267,38 @@ func runInit(cmd *cobra.Command, args []string) error {
 		fields = nil
 	}
 
+	if presetName != "" && fields != nil {
+		for _, m := range missingPresetFields(preset, fields) {
+			u.Warning(fmt.Sprintf("Preset %s expects %s, which the project does not have", presetName, m))
+		}
+	}
+
+	// Without a preset, suggest settings based on how the project is used
+	var conventions *detectedConventions
+	noDetect, _ := cmd.Flags().GetBool("no-detect")
+	if presetName == "" && !noDetect && fields != nil {
+		spinner = ui.NewSpinner(cmd.OutOrStdout(), "Analyzing project conventions...")
+		spinner.Start()
+		items, err := client.GetProjectItems(selectedProject.ID, nil)
+		spinner.Stop()
+
+		if err != nil {
+			u.Warning(fmt.Sprintf("Could not analyze project items: %v", err))
+		} else if conventions = detectInitConventions(fields, items); conventions != nil {
+			fmt.Fprintln(cmd.OutOrStdout())
+			u.Info("Detected project conventions:")
+			for _, note := range conventions.Notes {
+				fmt.Fprintf(cmd.OutOrStdout(), "  • %s\n", note)
+			}
+			fmt.Fprint(cmd.OutOrStdout(), u.Prompt("Use detected conventions?", "Y/n"))
+			response, _ := reader.ReadString('\n')
+			response = strings.TrimSpace(strings.ToLower(response))
+			if response == "n" || response == "no" {
+				conventions = nil
+			}
+		}
+	}
+
 	// Convert to metadata
 	metadata := &ProjectMetadata{
 		ProjectID: selectedProject.ID,
@@ -228,6 +315,14 @@ func runInit(cmd *cobra.Command, args []string) error {
 				Name: opt.Name,
 			})
 		}
+		for _, it := range f.Iterations {
+			fm.Iterations = append(fm.Iterations, IterationMetadata{
+				ID:        it.ID,
+				Title:     it.Title,
+				StartDate: it.StartDate,
+				Duration:  it.Duration,
+			})
+		}
 		metadata.Fields = append(metadata.Fields, fm)
 	}
 
@@ -237,6 +332,10 @@ func runInit(cmd *cobra.Command, args []string) error {
 		ProjectOwner:  owner,
 		ProjectNumber: projectNumber,
 		Repositories:  []string{repo},
+		Preset:        presetName,
+	}
+	if conventions != nil {
+		cfg.Conventions = &conventions.Preset
 	}
 
 	// Write config
@@ -246,12 +345,21 @@ func runInit(cmd *cobra.Command, args []string) error {
 	}
 
 	// Print summary
-	u.SummaryBox("Configuration saved", map[string]string{
+	summary := map[string]string{
 		"Project":    fmt.Sprintf("%s (#%d)", selectedProject.Title, selectedProject.Number),
 		"Repository": repo,
 		"Fields":     fmt.Sprintf("%d cached", len(fields)),
 		"Config":     ".gh-pmu.yml",
-	}, []string{"Project", "Repository", "Fields", "Config"})
+	}
+	order := []string{"Project", "Repository", "Fields", "Config"}
+	if presetName != "" {
+		summary["Preset"] = fmt.Sprintf("%s (%s)", presetName, preset.Description)
+		order = append(order, "Preset")
+	} else if conventions != nil {
+		summary["Preset"] = conventions.Preset.Description
+		order = append(order, "Preset")
+	}
+	u.SummaryBox("Configuration saved", summary, order)
 
 	return nil
 }
@@ -305,15 +413,28 @@ type InitConfig struct {
 	ProjectOwner  string
 	ProjectNumber int
 	Repositories  []string
+	Preset        string      // Built-in methodology preset; empty for the default configuration
+	Conventions   *initPreset // Conventions detected from the project; takes precedence over Preset
+}
+
+// resolvePreset returns the configuration to write: detected conventions,
+// the named preset, or the default configuration
+func (c *InitConfig) resolvePreset() (initPreset, error) {
+	if c.Conventions != nil {
+		return *c.Conventions, nil
+	}
+	return getInitPreset(c.Preset)
 }
 
 // ConfigFile represents the .gh-pmu.yml file structure.
 type ConfigFile struct {
+	Version      int                     `yaml:"version"`
 	Project      ProjectConfig           `yaml:"project"`
 	Repositories []string                `yaml:"repositories"`
 	Defaults     DefaultsConfig          `yaml:"defaults"`
 	Fields       map[string]FieldMapping `yaml:"fields"`
 	Triage       map[string]TriageRule   `yaml:"triage,omitempty"`
+	Views        map[string]config.View  `yaml:"views,omitempty"`
 }
 
 // ProjectConfig represents the project section of config.
@@ -325,15 +446,15 @@ type ProjectConfig struct {
 
 // DefaultsConfig represents default values for new items.
 type DefaultsConfig struct {
-	Priority string   `yaml:"priority"`
-	Status   string   `yaml:"status"`
+	Priority string   `yaml:"priority,omitempty"`
+	Status   string   `yaml:"status,omitempty"`
 	Labels   []string `yaml:"labels,omitempty"`
 }
 
 // FieldMapping represents a field alias mapping.
 type FieldMapping struct {
 	Field  string            `yaml:"field"`
-	Values map[string]string `yaml:"values"`
+	Values map[string]string `yaml:"values,omitempty"`
 }
 
 // ProjectMetadata holds cached project information from GitHub API.
@@ -344,10 +465,11 @@ type ProjectMetadata struct {
 
 // FieldMetadata holds cached field information.
 type FieldMetadata struct {
-	ID       string
-	Name     string
-	DataType string
-	Options  []OptionMetadata
+	ID         string
+	Name       string
+	DataType   string
+	Options    []OptionMetadata
+	Iterations []IterationMetadata
 }
 
 // OptionMetadata holds option information for single-select fields.
@@ -356,6 +478,14 @@ type OptionMetadata struct {
 	Name string
 }
 
+// IterationMetadata holds iteration information for iteration fields.
+type IterationMetadata struct {
+	ID        string
+	Title     string
+	StartDate string
+	Duration  int
+}
+
 // MetadataSection represents the metadata section in config file.
 type MetadataSection struct {
 	Project MetadataProject `yaml:"project"`
@@ -369,10 +499,11 @@ type MetadataProject struct {
 
 // MetadataField represents a field in the metadata section.
 type MetadataField struct {
-	Name     string                `yaml:"name"`
-	ID       string                `yaml:"id"`
-	DataType string                `yaml:"data_type"`
-	Options  []MetadataFieldOption `yaml:"options,omitempty"`
+	Name       string                   `yaml:"name"`
+	ID         string                   `yaml:"id"`
+	DataType   string                   `yaml:"data_type"`
+	Options    []MetadataFieldOption    `yaml:"options,omitempty"`
+	Iterations []MetadataFieldIteration `yaml:"iterations,omitempty"`
 }
 
 // MetadataFieldOption represents a field option.
@@ -381,6 +512,14 @@ type MetadataFieldOption struct {
 	ID   string `yaml:"id"`
 }
 
+// MetadataFieldIteration represents an iteration of an iteration field.
+type MetadataFieldIteration struct {
+	Title     string `yaml:"title"`
+	ID        string `yaml:"id"`
+	StartDate string `yaml:"start_date"`
+	Duration  int    `yaml:"duration"`
+}
+
 // TriageRule represents a single triage rule configuration.
 type TriageRule struct {
 	Query       string          `yaml:"query"`
@@ -396,11 +535,13 @@ type TriageApply struct {
 
 // ConfigFileWithMetadata extends ConfigFile with metadata section.
 type ConfigFileWithMetadata struct {
+	Version      int                     `yaml:"version"`
 	Project      ProjectConfig           `yaml:"project"`
 	Repositories []string                `yaml:"repositories"`
 	Defaults     DefaultsConfig          `yaml:"defaults"`
 	Fields       map[string]FieldMapping `yaml:"fields"`
 	Triage       map[string]TriageRule   `yaml:"triage,omitempty"`
+	Views        map[string]config.View  `yaml:"views,omitempty"`
 	Metadata     MetadataSection         `yaml:"metadata"`
 }
 
@@ -417,60 +558,23 @@ func validateProject(client ProjectValidator, owner string, number int) error {
 
 // writeConfig writes the configuration to a .gh-pmu.yml file.
 func writeConfig(dir string, cfg *InitConfig) error {
+	preset, err := cfg.resolvePreset()
+	if err != nil {
+		return err
+	}
+
 	configFile := &ConfigFile{
+		Version: config.CurrentVersion,
 		Project: ProjectConfig{
 			Name:   cfg.ProjectName,
 			Owner:  cfg.ProjectOwner,
 			Number: cfg.ProjectNumber,
 		},
 		Repositories: cfg.Repositories,
-		Defaults: DefaultsConfig{
-			Priority: "p2",
-			Status:   "backlog",
-			Labels:   []string{"pm-tracked"},
-		},
-		Fields: map[string]FieldMapping{
-			"priority": {
-				Field: "Priority",
-				Values: map[string]string{
-					"p0": "P0",
-					"p1": "P1",
-					"p2": "P2",
-				},
-			},
-			"status": {
-				Field: "Status",
-				Values: map[string]string{
-					"backlog":     "Backlog",
-					"ready":       "Ready",
-					"in_progress": "In progress",
-					"in_review":   "In review",
-					"done":        "Done",
-				},
-			},
-		},
-		Triage: map[string]TriageRule{
-			"estimate": {
-				Query: "is:issue is:open -has:estimate",
-				Apply: TriageApply{},
-				Interactive: map[string]bool{
-					"estimate": true,
-				},
-			},
-			"tracked": {
-				Query: "is:issue is:open -label:pm-tracked",
-				Apply: TriageApply{
-					Labels: []string{"pm-tracked"},
-					Fields: map[string]string{
-						"priority": "p1",
-						"status":   "backlog",
-					},
-				},
-				Interactive: map[string]bool{
-					"status": true,
-				},
-			},
-		},
+		Defaults:     preset.Defaults,
+		Fields:       preset.Fields,
+		Triage:       preset.Triage,
+		Views:        preset.Views,
 	}
 
 	data, err := yaml.Marshal(configFile)
@@ -488,6 +592,11 @@ func writeConfig(dir string, cfg *InitConfig) error {
 
 // writeConfigWithMetadata writes the configuration with project metadata.
 func writeConfigWithMetadata(dir string, cfg *InitConfig, metadata *ProjectMetadata) error {
+	preset, err := cfg.resolvePreset()
+	if err != nil {
+		return err
+	}
+
 	// Convert metadata to YAML format
 	var metadataFields []MetadataField
 	for _, f := range metadata.Fields {
@@ -502,63 +611,29 @@ func writeConfigWithMetadata(dir string, cfg *InitConfig, metadata *ProjectMetad
 				ID:   opt.ID,
 			})
 		}
+		for _, it := range f.Iterations {
+			mf.Iterations = append(mf.Iterations, MetadataFieldIteration{
+				Title:     it.Title,
+				ID:        it.ID,
+				StartDate: it.StartDate,
+				Duration:  it.Duration,
+			})
+		}
 		metadataFields = append(metadataFields, mf)
 	}
 
 	configFile := &ConfigFileWithMetadata{
+		Version: config.CurrentVersion,
 		Project: ProjectConfig{
 			Name:   cfg.ProjectName,
 			Owner:  cfg.ProjectOwner,
 			Number: cfg.ProjectNumber,
 		},
 		Repositories: cfg.Repositories,
-		Defaults: DefaultsConfig{
-			Priority: "p2",
-			Status:   "backlog",
-			Labels:   []string{"pm-tracked"},
-		},
-		Fields: map[string]FieldMapping{
-			"priority": {
-				Field: "Priority",
-				Values: map[string]string{
-					"p0": "P0",
-					"p1": "P1",
-					"p2": "P2",
-				},
-			},
-			"status": {
-				Field: "Status",
-				Values: map[string]string{
-					"backlog":     "Backlog",
-					"ready":       "Ready",
-					"in_progress": "In progress",
-					"in_review":   "In review",
-					"done":        "Done",
-				},
-			},
-		},
-		Triage: map[string]TriageRule{
-			"estimate": {
-				Query: "is:issue is:open -has:estimate",
-				Apply: TriageApply{},
-				Interactive: map[string]bool{
-					"estimate": true,
-				},
-			},
-			"tracked": {
-				Query: "is:issue is:open -label:pm-tracked",
-				Apply: TriageApply{
-					Labels: []string{"pm-tracked"},
-					Fields: map[string]string{
-						"priority": "p1",
-						"status":   "backlog",
-					},
-				},
-				Interactive: map[string]bool{
-					"status": true,
-				},
-			},
-		},
+		Defaults:     preset.Defaults,
+		Fields:       preset.Fields,
+		Triage:       preset.Triage,
+		Views:        preset.Views,
 		Metadata: MetadataSection{
 			Project: MetadataProject{
 				ID: metadata.ProjectID,
@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// defaultStatusAliases are the status aliases preferred as the default for
+// new issues, in order
+var defaultStatusAliases = []string{"backlog", "todo", "new", "triage", "inbox"}
+
+// minItemsForLabelDefaults is the number of project items needed before a
+// label's usage is treated as a convention
+const minItemsForLabelDefaults = 3
+
+// detectedConventions is the configuration suggested from an existing
+// project, with one note per suggestion for the wizard to show
+type detectedConventions struct {
+	Preset initPreset
+	Notes  []string
+}
+
+// detectInitConventions analyzes the project's fields and current items to
+// suggest status and priority mappings, default labels, and triage rules.
+// It returns nil when the project has nothing to learn from.
+func detectInitConventions(fields []api.ProjectField, items []api.ProjectItem) *detectedConventions {
+	d := &detectedConventions{
+		Preset: initPreset{
+			Description: "detected from project",
+			Fields:      map[string]FieldMapping{},
+			Triage:      map[string]TriageRule{},
+		},
+	}
+
+	if status := findSingleSelectField(fields, func(name string) bool { return strings.EqualFold(name, "Status") }); status != nil {
+		mapping := conventionFieldMapping(*status)
+		d.Preset.Fields["status"] = mapping
+		d.Preset.Defaults.Status = pickDefaultStatus(*status, mapping)
+		d.Notes = append(d.Notes, fmt.Sprintf("Status: %d values, new issues start in %s",
+			len(mapping.Values), mapping.Values[d.Preset.Defaults.Status]))
+	}
+
+	if priority := findSingleSelectField(fields, func(name string) bool {
+		return strings.Contains(strings.ToLower(name), "priority")
+	}); priority != nil {
+		mapping := conventionFieldMapping(*priority)
+		d.Preset.Fields["priority"] = mapping
+		value, used := mostUsedFieldValue(items, *priority)
+		if used > 0 {
+			d.Notes = append(d.Notes, fmt.Sprintf("%s: %d values, default %s (set on %d items)",
+				priority.Name, len(mapping.Values), value, used))
+		} else {
+			value = priority.Options[len(priority.Options)-1].Name
+			d.Notes = append(d.Notes, fmt.Sprintf("%s: %d values, default %s", priority.Name, len(mapping.Values), value))
+		}
+		d.Preset.Defaults.Priority = aliasForValue(mapping, value)
+	}
+
+	for _, f := range fields {
+		if f.DataType == "ITERATION" {
+			d.Preset.Fields["iteration"] = FieldMapping{Field: f.Name}
+			d.Notes = append(d.Notes, fmt.Sprintf("Iteration: %s", f.Name))
+			break
+		}
+	}
+
+	labels, counts := commonLabels(items)
+	d.Preset.Defaults.Labels = labels
+	for _, l := range labels {
+		d.Notes = append(d.Notes, fmt.Sprintf("Default label: %s (on %d of %d items)", l, counts[l], len(items)))
+	}
+
+	// Triage candidates: bring unlabeled issues in line with the common
+	// label, and route anything carrying a triage label into the board
+	if tracking := firstQueryableLabel(labels); tracking != "" {
+		rule := TriageRule{
+			Query: "is:issue is:open -label:" + tracking,
+			Apply: TriageApply{Labels: []string{tracking}},
+		}
+		if d.Preset.Defaults.Status != "" {
+			rule.Apply.Fields = map[string]string{"status": d.Preset.Defaults.Status}
+			rule.Interactive = map[string]bool{"status": true}
+		}
+		d.Preset.Triage["tracked"] = rule
+	}
+	if triageLabel := findTriageLabel(items); triageLabel != "" {
+		rule := TriageRule{Query: "is:issue is:open label:" + triageLabel}
+		if d.Preset.Defaults.Status != "" {
+			rule.Apply.Fields = map[string]string{"status": d.Preset.Defaults.Status}
+		}
+		if _, ok := d.Preset.Fields["priority"]; ok {
+			rule.Interactive = map[string]bool{"priority": true}
+		}
+		d.Preset.Triage["needs-triage"] = rule
+	}
+	if len(d.Preset.Triage) > 0 {
+		names := make([]string, 0, len(d.Preset.Triage))
+		for name := range d.Preset.Triage {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		d.Notes = append(d.Notes, "Triage rules: "+strings.Join(names, ", "))
+	}
+
+	if len(d.Notes) == 0 {
+		return nil
+	}
+	return d
+}
+
+// findSingleSelectField returns the first single-select field with options
+// whose name satisfies match
+func findSingleSelectField(fields []api.ProjectField, match func(string) bool) *api.ProjectField {
+	for i, f := range fields {
+		if f.DataType == "SINGLE_SELECT" && len(f.Options) > 0 && match(f.Name) {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+// conventionFieldMapping maps every option of a field to an alias derived
+// from its name, e.g. "In progress" becomes in_progress
+func conventionFieldMapping(field api.ProjectField) FieldMapping {
+	mapping := FieldMapping{Field: field.Name, Values: map[string]string{}}
+	for _, opt := range field.Options {
+		alias := conventionAlias(opt.Name)
+		if alias == "" {
+			continue
+		}
+		unique := alias
+		for n := 2; ; n++ {
+			if _, taken := mapping.Values[unique]; !taken {
+				break
+			}
+			unique = fmt.Sprintf("%s_%d", alias, n)
+		}
+		mapping.Values[unique] = opt.Name
+	}
+	return mapping
+}
+
+// conventionAlias lowercases a value and joins its words with underscores,
+// dropping emoji and punctuation
+func conventionAlias(value string) string {
+	var words []string
+	var current strings.Builder
+	for _, r := range strings.ToLower(value) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			current.WriteRune(r)
+			continue
+		}
+		if current.Len() > 0 {
+			words = append(words, current.String())
+			current.Reset()
+		}
+	}
+	if current.Len() > 0 {
+		words = append(words, current.String())
+	}
+	return strings.Join(words, "_")
+}
+
+// aliasForValue returns the alias mapped to value, or "" if there is none
+func aliasForValue(mapping FieldMapping, value string) string {
+	for alias, v := range mapping.Values {
+		if v == value {
+			return alias
+		}
+	}
+	return ""
+}
+
+// pickDefaultStatus prefers a backlog-like status and falls back to the
+// field's first option
+func pickDefaultStatus(field api.ProjectField, mapping FieldMapping) string {
+	for _, alias := range defaultStatusAliases {
+		if _, ok := mapping.Values[alias]; ok {
+			return alias
+		}
+	}
+	return aliasForValue(mapping, field.Options[0].Name)
+}
+
+// mostUsedFieldValue returns the field value set on the most items and how
+// many items use it; ties go to the option listed last (lowest priority)
+func mostUsedFieldValue(items []api.ProjectItem, field api.ProjectField) (string, int) {
+	counts := map[string]int{}
+	for _, item := range items {
+		for _, fv := range item.FieldValues {
+			if fv.Field == field.Name {
+				counts[fv.Value]++
+			}
+		}
+	}
+
+	best, bestCount := "", 0
+	for _, opt := range field.Options {
+		if counts[opt.Name] > 0 && counts[opt.Name] >= bestCount {
+			best, bestCount = opt.Name, counts[opt.Name]
+		}
+	}
+	return best, bestCount
+}
+
+// commonLabels returns the labels carried by at least half of the project's
+// issues, most used first, along with each label's usage count
+func commonLabels(items []api.ProjectItem) ([]string, map[string]int) {
+	counts := map[string]int{}
+	issues := 0
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		issues++
+		for _, l := range item.Issue.Labels {
+			counts[l.Name]++
+		}
+	}
+	if issues < minItemsForLabelDefaults {
+		return nil, counts
+	}
+
+	var labels []string
+	for name, n := range counts {
+		if n*2 >= issues {
+			labels = append(labels, name)
+		}
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		if counts[labels[i]] != counts[labels[j]] {
+			return counts[labels[i]] > counts[labels[j]]
+		}
+		return labels[i] < labels[j]
+	})
+	return labels, counts
+}
+
+// firstQueryableLabel returns the first label usable in a triage query,
+// which splits on whitespace
+func firstQueryableLabel(labels []string) string {
+	for _, l := range labels {
+		if !strings.ContainsAny(l, " \t") {
+			return l
+		}
+	}
+	return ""
+}
+
+// findTriageLabel returns a label in use that marks issues awaiting
+// triage, such as "needs-triage" (but not "triaged")
+func findTriageLabel(items []api.ProjectItem) string {
+	var found []string
+	seen := map[string]bool{}
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		for _, l := range item.Issue.Labels {
+			name := strings.ToLower(l.Name)
+			if !seen[l.Name] && strings.Contains(name, "triage") && !strings.Contains(name, "triaged") &&
+				firstQueryableLabel([]string{l.Name}) != "" {
+				seen[l.Name] = true
+				found = append(found, l.Name)
+			}
+		}
+	}
+	if len(found) == 0 {
+		return ""
+	}
+	sort.Strings(found)
+	return found[0]
+}
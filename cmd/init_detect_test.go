@@ -0,0 +1,178 @@
+package cmd
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+func detectTestFields() []api.ProjectField {
+	return []api.ProjectField{
+		{Name: "Title", DataType: "TEXT"},
+		{Name: "Status", DataType: "SINGLE_SELECT", Options: []api.FieldOption{
+			{Name: "🆕 New"}, {Name: "📋 Backlog"}, {Name: "🏗 In progress"}, {Name: "✅ Done"},
+		}},
+		{Name: "Team Priority", DataType: "SINGLE_SELECT", Options: []api.FieldOption{
+			{Name: "Urgent"}, {Name: "High"}, {Name: "Medium"}, {Name: "Low"},
+		}},
+		{Name: "Cycle", DataType: "ITERATION"},
+	}
+}
+
+func detectTestItem(number int, priority string, labels ...string) api.ProjectItem {
+	issue := &api.Issue{Number: number}
+	for _, l := range labels {
+		issue.Labels = append(issue.Labels, api.Label{Name: l})
+	}
+	item := api.ProjectItem{Issue: issue}
+	if priority != "" {
+		item.FieldValues = []api.FieldValue{{Field: "Team Priority", Value: priority}}
+	}
+	return item
+}
+
+func TestDetectInitConventions(t *testing.T) {
+	items := []api.ProjectItem{
+		detectTestItem(1, "Medium", "tracked", "bug"),
+		detectTestItem(2, "Medium", "tracked"),
+		detectTestItem(3, "High", "tracked", "needs-triage"),
+		detectTestItem(4, "", "enhancement"),
+	}
+
+	d := detectInitConventions(detectTestFields(), items)
+	if d == nil {
+		t.Fatal("Expected conventions to be detected")
+	}
+	p := d.Preset
+
+	wantStatus := map[string]string{
+		"new":         "🆕 New",
+		"backlog":     "📋 Backlog",
+		"in_progress": "🏗 In progress",
+		"done":        "✅ Done",
+	}
+	if !reflect.DeepEqual(p.Fields["status"].Values, wantStatus) {
+		t.Errorf("Status values = %v, want %v", p.Fields["status"].Values, wantStatus)
+	}
+	if p.Fields["priority"].Field != "Team Priority" || p.Fields["iteration"].Field != "Cycle" {
+		t.Errorf("Unexpected field mappings: %+v", p.Fields)
+	}
+	if p.Defaults.Status != "backlog" || p.Defaults.Priority != "medium" {
+		t.Errorf("Unexpected defaults: %+v", p.Defaults)
+	}
+	if !reflect.DeepEqual(p.Defaults.Labels, []string{"tracked"}) {
+		t.Errorf("Default labels = %v, want [tracked]", p.Defaults.Labels)
+	}
+
+	tracked, ok := p.Triage["tracked"]
+	if !ok || tracked.Query != "is:issue is:open -label:tracked" || tracked.Apply.Fields["status"] != "backlog" {
+		t.Errorf("Unexpected tracked rule: %+v", tracked)
+	}
+	needsTriage, ok := p.Triage["needs-triage"]
+	if !ok || needsTriage.Query != "is:issue is:open label:needs-triage" || !needsTriage.Interactive["priority"] {
+		t.Errorf("Unexpected needs-triage rule: %+v", needsTriage)
+	}
+
+	notes := strings.Join(d.Notes, "\n")
+	for _, want := range []string{"Team Priority: 4 values, default Medium (set on 2 items)", "Default label: tracked (on 3 of 4 items)", "Triage rules: needs-triage, tracked"} {
+		if !strings.Contains(notes, want) {
+			t.Errorf("Expected notes to contain %q, got:\n%s", want, notes)
+		}
+	}
+}
+
+func TestDetectInitConventions_NothingToLearn(t *testing.T) {
+	fields := []api.ProjectField{{Name: "Title", DataType: "TEXT"}}
+	if d := detectInitConventions(fields, nil); d != nil {
+		t.Errorf("Expected nil, got %+v", d)
+	}
+}
+
+func TestDetectInitConventions_UnusedPriorityDefaultsToLast(t *testing.T) {
+	d := detectInitConventions(detectTestFields(), nil)
+	if d == nil {
+		t.Fatal("Expected conventions to be detected")
+	}
+	if d.Preset.Defaults.Priority != "low" {
+		t.Errorf("Expected default priority low, got %q", d.Preset.Defaults.Priority)
+	}
+	if len(d.Preset.Defaults.Labels) != 0 || len(d.Preset.Triage) != 0 {
+		t.Errorf("Expected no labels or triage rules without items, got %+v", d.Preset)
+	}
+}
+
+func TestConventionAlias(t *testing.T) {
+	tests := map[string]string{
+		"In progress":    "in_progress",
+		"🏗 In Review":    "in_review",
+		"P0":             "p0",
+		"Won't fix":      "won_t_fix",
+		"  Done!  ":      "done",
+		"🔥":              "",
+		"Needs-Triage":   "needs_triage",
+		"Ready for QA 🚀": "ready_for_qa",
+	}
+	for in, want := range tests {
+		if got := conventionAlias(in); got != want {
+			t.Errorf("conventionAlias(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestConventionFieldMapping_DuplicateAliases(t *testing.T) {
+	mapping := conventionFieldMapping(api.ProjectField{Name: "Status", Options: []api.FieldOption{
+		{Name: "Done"}, {Name: "DONE"}, {Name: "🎉"},
+	}})
+	want := map[string]string{"done": "Done", "done_2": "DONE"}
+	if !reflect.DeepEqual(mapping.Values, want) {
+		t.Errorf("Values = %v, want %v", mapping.Values, want)
+	}
+}
+
+func TestFindTriageLabel_IgnoresTriaged(t *testing.T) {
+	items := []api.ProjectItem{detectTestItem(1, "", "triaged"), detectTestItem(2, "", "needs triage")}
+	if got := findTriageLabel(items); got != "" {
+		t.Errorf("Expected no triage label, got %q", got)
+	}
+}
+
+func TestWriteConfig_WithConventions(t *testing.T) {
+	d := detectInitConventions(detectTestFields(), nil)
+	dir := t.TempDir()
+	cfg := &InitConfig{
+		ProjectOwner:  "owner",
+		ProjectNumber: 1,
+		Repositories:  []string{"owner/repo"},
+		Conventions:   &d.Preset,
+	}
+
+	if err := writeConfig(dir, cfg); err != nil {
+		t.Fatalf("writeConfig() error = %v", err)
+	}
+
+	loaded, err := config.Load(filepath.Join(dir, config.ConfigFileName))
+	if err != nil {
+		t.Fatalf("Failed to load written config: %v", err)
+	}
+	if loaded.ResolveFieldValue("status", "in_progress") != "🏗 In progress" {
+		t.Errorf("Expected detected status values, got %q", loaded.ResolveFieldValue("status", "in_progress"))
+	}
+	if loaded.Defaults.Priority != "low" {
+		t.Errorf("Expected detected default priority, got %q", loaded.Defaults.Priority)
+	}
+}
+
+func TestInitCommand_HasNoDetectFlag(t *testing.T) {
+	cmd := NewRootCommand()
+	initCmd, _, err := cmd.Find([]string{"init"})
+	if err != nil {
+		t.Fatalf("init command not found: %v", err)
+	}
+	if initCmd.Flags().Lookup("no-detect") == nil {
+		t.Error("Expected --no-detect flag to exist")
+	}
+}
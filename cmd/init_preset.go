@@ -0,0 +1,296 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// initPreset is a methodology configuration written by init: field
+// mappings, defaults, triage rules, and named list views for the
+// methodology's standard boards and reports
+type initPreset struct {
+	Description string
+	Defaults    DefaultsConfig
+	Fields      map[string]FieldMapping
+	Triage      map[string]TriageRule
+	Views       map[string]config.View
+}
+
+// standardPriorityField is the priority mapping shared by the presets
+var standardPriorityField = FieldMapping{
+	Field: "Priority",
+	Values: map[string]string{
+		"p0": "P0",
+		"p1": "P1",
+		"p2": "P2",
+	},
+}
+
+// defaultInitPreset returns the configuration init writes without --preset
+func defaultInitPreset() initPreset {
+	return initPreset{
+		Defaults: DefaultsConfig{
+			Priority: "p2",
+			Status:   "backlog",
+			Labels:   []string{"pm-tracked"},
+		},
+		Fields: map[string]FieldMapping{
+			"priority": standardPriorityField,
+			"status": {
+				Field: "Status",
+				Values: map[string]string{
+					"backlog":     "Backlog",
+					"ready":       "Ready",
+					"in_progress": "In progress",
+					"in_review":   "In review",
+					"done":        "Done",
+				},
+			},
+		},
+		Triage: map[string]TriageRule{
+			"estimate": {
+				Query: "is:issue is:open -has:estimate",
+				Apply: TriageApply{},
+				Interactive: map[string]bool{
+					"estimate": true,
+				},
+			},
+			"tracked": {
+				Query: "is:issue is:open -label:pm-tracked",
+				Apply: TriageApply{
+					Labels: []string{"pm-tracked"},
+					Fields: map[string]string{
+						"priority": "p1",
+						"status":   "backlog",
+					},
+				},
+				Interactive: map[string]bool{
+					"status": true,
+				},
+			},
+		},
+	}
+}
+
+// initPresets are the built-in methodology presets selectable with init --preset
+var initPresets = map[string]func() initPreset{
+	"scrum":    scrumPreset,
+	"kanban":   kanbanPreset,
+	"shape-up": shapeUpPreset,
+}
+
+func scrumPreset() initPreset {
+	p := defaultInitPreset()
+	p.Description = "Sprints with story points, sprint and backlog views"
+	p.Fields["status"] = FieldMapping{
+		Field: "Status",
+		Values: map[string]string{
+			"backlog":     "Backlog",
+			"todo":        "Todo",
+			"in_progress": "In Progress",
+			"in_review":   "In Review",
+			"done":        "Done",
+		},
+	}
+	p.Fields["iteration"] = FieldMapping{Field: "Sprint"}
+	p.Fields["estimate"] = FieldMapping{Field: "Story Points"}
+	p.Views = map[string]config.View{
+		"sprint": {
+			Iteration: "current",
+			Sort:      "status",
+			Columns:   []string{"number", "title", "status", "assignees"},
+		},
+		"next-sprint": {
+			Iteration: "next",
+			Sort:      "priority",
+			Columns:   []string{"number", "title", "priority", "assignees"},
+		},
+		"backlog": {
+			Status:  "backlog",
+			Sort:    "priority",
+			Columns: []string{"number", "title", "priority"},
+		},
+		"sprint-review": {
+			Iteration: "current",
+			Status:    "done",
+			Columns:   []string{"number", "title", "assignees"},
+		},
+	}
+	return p
+}
+
+func kanbanPreset() initPreset {
+	p := defaultInitPreset()
+	p.Description = "Continuous flow with WIP, review, and expedite views"
+	p.Fields["priority"] = FieldMapping{
+		Field: "Priority",
+		Values: map[string]string{
+			"expedite": "Expedite",
+			"high":     "High",
+			"normal":   "Normal",
+			"low":      "Low",
+		},
+	}
+	p.Defaults.Priority = "normal"
+	p.Triage["tracked"].Apply.Fields["priority"] = "normal"
+	delete(p.Triage, "estimate")
+	p.Views = map[string]config.View{
+		"board": {
+			Sort:    "status",
+			Columns: []string{"number", "title", "status", "priority", "assignees"},
+		},
+		"wip": {
+			Status:  "in_progress",
+			Columns: []string{"number", "title", "assignees"},
+		},
+		"review": {
+			Status:  "in_review",
+			Columns: []string{"number", "title", "assignees"},
+		},
+		"expedite": {
+			Priority: "expedite",
+			Columns:  []string{"number", "title", "status", "assignees"},
+		},
+		"ready": {
+			Status:  "ready",
+			Sort:    "priority",
+			Columns: []string{"number", "title", "priority"},
+		},
+	}
+	return p
+}
+
+func shapeUpPreset() initPreset {
+	p := defaultInitPreset()
+	p.Description = "Six-week cycles with pitches, appetite, and cooldown"
+	p.Defaults = DefaultsConfig{
+		Status: "shaping",
+		Labels: []string{"pm-tracked"},
+	}
+	p.Fields = map[string]FieldMapping{
+		"status": {
+			Field: "Status",
+			Values: map[string]string{
+				"shaping":  "Shaping",
+				"pitched":  "Pitched",
+				"bet":      "Bet",
+				"building": "Building",
+				"shipped":  "Shipped",
+				"dropped":  "Dropped",
+			},
+		},
+		"appetite": {
+			Field: "Appetite",
+			Values: map[string]string{
+				"small": "Small Batch",
+				"big":   "Big Batch",
+			},
+		},
+		"iteration": {Field: "Cycle"},
+	}
+	p.Triage = map[string]TriageRule{
+		"tracked": {
+			Query: "is:issue is:open -label:pm-tracked",
+			Apply: TriageApply{
+				Labels: []string{"pm-tracked"},
+				Fields: map[string]string{
+					"status": "shaping",
+				},
+			},
+			Interactive: map[string]bool{
+				"status": true,
+			},
+		},
+	}
+	p.Views = map[string]config.View{
+		"pitches": {
+			Status:  "pitched",
+			Columns: []string{"number", "title", "appetite"},
+		},
+		"betting-table": {
+			Status:  "bet",
+			Columns: []string{"number", "title", "appetite", "assignees"},
+		},
+		"cycle": {
+			Iteration: "current",
+			Sort:      "status",
+			Columns:   []string{"number", "title", "status", "assignees"},
+		},
+		"shipped": {
+			Iteration: "current",
+			Status:    "shipped",
+			Columns:   []string{"number", "title", "assignees"},
+		},
+	}
+	return p
+}
+
+// presetNames returns the built-in preset names in sorted order
+func presetNames() []string {
+	names := make([]string, 0, len(initPresets))
+	for name := range initPresets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// getInitPreset returns the named preset, or the default configuration for an empty name
+func getInitPreset(name string) (initPreset, error) {
+	if name == "" {
+		return defaultInitPreset(), nil
+	}
+	build, ok := initPresets[name]
+	if !ok {
+		return initPreset{}, fmt.Errorf("unknown preset %q: must be one of %s", name, strings.Join(presetNames(), ", "))
+	}
+	return build(), nil
+}
+
+// missingPresetFields lists preset fields and option values that the project
+// does not have, so init can tell the user what to create
+func missingPresetFields(preset initPreset, fields []api.ProjectField) []string {
+	byName := make(map[string]api.ProjectField)
+	for _, f := range fields {
+		byName[strings.ToLower(f.Name)] = f
+	}
+
+	keys := make([]string, 0, len(preset.Fields))
+	for key := range preset.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var missing []string
+	for _, key := range keys {
+		mapping := preset.Fields[key]
+		field, ok := byName[strings.ToLower(mapping.Field)]
+		if !ok {
+			missing = append(missing, fmt.Sprintf("field %q", mapping.Field))
+			continue
+		}
+		if len(field.Options) == 0 {
+			continue
+		}
+
+		options := make(map[string]bool)
+		for _, opt := range field.Options {
+			options[strings.ToLower(opt.Name)] = true
+		}
+		var values []string
+		for _, v := range mapping.Values {
+			if !options[strings.ToLower(v)] {
+				values = append(values, v)
+			}
+		}
+		sort.Strings(values)
+		for _, v := range values {
+			missing = append(missing, fmt.Sprintf("%s option %q", mapping.Field, v))
+		}
+	}
+	return missing
+}
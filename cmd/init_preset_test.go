@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+func TestInitCommand_HasPresetFlag(t *testing.T) {
+	cmd := NewRootCommand()
+	initCmd, _, err := cmd.Find([]string{"init"})
+	if err != nil {
+		t.Fatalf("init command not found: %v", err)
+	}
+	if initCmd.Flags().Lookup("preset") == nil {
+		t.Error("Expected --preset flag to exist")
+	}
+}
+
+func TestGetInitPreset(t *testing.T) {
+	def, err := getInitPreset("")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := def.Triage["estimate"]; !ok || def.Defaults.Priority != "p2" {
+		t.Errorf("Expected default configuration, got %+v", def)
+	}
+
+	_, err = getInitPreset("waterfall")
+	if err == nil || !strings.Contains(err.Error(), "kanban, scrum, shape-up") {
+		t.Errorf("Expected unknown preset error listing presets, got %v", err)
+	}
+}
+
+// TestInitPresets_Consistent checks that every alias a preset uses is
+// defined in its own field mappings
+func TestInitPresets_Consistent(t *testing.T) {
+	for _, name := range presetNames() {
+		t.Run(name, func(t *testing.T) {
+			p, err := getInitPreset(name)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if p.Description == "" || len(p.Views) == 0 {
+				t.Errorf("Expected description and views, got %+v", p)
+			}
+
+			hasAlias := func(key, alias string) bool {
+				_, ok := p.Fields[key].Values[alias]
+				return ok
+			}
+
+			if p.Defaults.Status != "" && !hasAlias("status", p.Defaults.Status) {
+				t.Errorf("Default status %q is not a status alias", p.Defaults.Status)
+			}
+			if p.Defaults.Priority != "" && !hasAlias("priority", p.Defaults.Priority) {
+				t.Errorf("Default priority %q is not a priority alias", p.Defaults.Priority)
+			}
+			for ruleName, rule := range p.Triage {
+				for key, alias := range rule.Apply.Fields {
+					if !hasAlias(key, alias) {
+						t.Errorf("Triage rule %s sets %s to unknown alias %q", ruleName, key, alias)
+					}
+				}
+			}
+			for viewName, view := range p.Views {
+				if view.Status != "" && !hasAlias("status", view.Status) {
+					t.Errorf("View %s uses unknown status %q", viewName, view.Status)
+				}
+				if view.Priority != "" && !hasAlias("priority", view.Priority) {
+					t.Errorf("View %s uses unknown priority %q", viewName, view.Priority)
+				}
+				if view.Iteration != "" {
+					if _, ok := p.Fields["iteration"]; !ok {
+						t.Errorf("View %s filters by iteration but no iteration field is mapped", viewName)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestWriteConfig_WithPreset(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &InitConfig{
+		ProjectOwner:  "owner",
+		ProjectNumber: 1,
+		Repositories:  []string{"owner/repo"},
+		Preset:        "scrum",
+	}
+
+	if err := writeConfig(dir, cfg); err != nil {
+		t.Fatalf("writeConfig() error = %v", err)
+	}
+
+	loaded, err := config.Load(filepath.Join(dir, config.ConfigFileName))
+	if err != nil {
+		t.Fatalf("Failed to load written config: %v", err)
+	}
+	if loaded.GetFieldName("iteration") != "Sprint" {
+		t.Errorf("Expected iteration field mapping, got %q", loaded.GetFieldName("iteration"))
+	}
+	view, err := loaded.GetView("sprint")
+	if err != nil || view.Iteration != "current" {
+		t.Errorf("Expected sprint view, got %+v (%v)", view, err)
+	}
+	if loaded.ResolveFieldValue("status", "in_progress") != "In Progress" {
+		t.Errorf("Expected scrum status values, got %q", loaded.ResolveFieldValue("status", "in_progress"))
+	}
+}
+
+func TestWriteConfigWithMetadata_ShapeUpPreset(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &InitConfig{
+		ProjectOwner:  "owner",
+		ProjectNumber: 1,
+		Repositories:  []string{"owner/repo"},
+		Preset:        "shape-up",
+	}
+
+	if err := writeConfigWithMetadata(dir, cfg, &ProjectMetadata{ProjectID: "PVT_1"}); err != nil {
+		t.Fatalf("writeConfigWithMetadata() error = %v", err)
+	}
+
+	loaded, err := config.Load(filepath.Join(dir, config.ConfigFileName))
+	if err != nil {
+		t.Fatalf("Failed to load written config: %v", err)
+	}
+	if loaded.Defaults.Status != "shaping" || loaded.Defaults.Priority != "" {
+		t.Errorf("Unexpected defaults: %+v", loaded.Defaults)
+	}
+	if _, ok := loaded.Views["betting-table"]; !ok {
+		t.Error("Expected betting-table view")
+	}
+	if loaded.Metadata == nil || loaded.Metadata.Project.ID != "PVT_1" {
+		t.Errorf("Expected metadata to be written, got %+v", loaded.Metadata)
+	}
+}
+
+func TestWriteConfig_UnknownPreset(t *testing.T) {
+	err := writeConfig(t.TempDir(), &InitConfig{Preset: "nope"})
+	if err == nil || !strings.Contains(err.Error(), "unknown preset") {
+		t.Errorf("Expected unknown preset error, got %v", err)
+	}
+}
+
+func TestMissingPresetFields(t *testing.T) {
+	preset, _ := getInitPreset("scrum")
+	fields := []api.ProjectField{
+		{Name: "Status", Options: []api.FieldOption{
+			{Name: "Backlog"}, {Name: "Todo"}, {Name: "In Progress"}, {Name: "Done"},
+		}},
+		{Name: "Priority", Options: []api.FieldOption{{Name: "P0"}, {Name: "P1"}, {Name: "P2"}}},
+		{Name: "Sprint", DataType: "ITERATION"},
+	}
+
+	got := missingPresetFields(preset, fields)
+	want := []string{`field "Story Points"`, `Status option "In Review"`}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("missingPresetFields() = %q, want %q", got, want)
+	}
+}
+
+func TestInitCommand_HasOwnerFlag(t *testing.T) {
+	cmd := NewRootCommand()
+	initCmd, _, err := cmd.Find([]string{"init"})
+	if err != nil {
+		t.Fatalf("init command not found: %v", err)
+	}
+	flag := initCmd.Flags().Lookup("owner")
+	if flag == nil {
+		t.Fatal("Expected --owner flag to exist")
+	}
+	if !strings.Contains(flag.Usage, "@me") {
+		t.Errorf("Expected --owner usage to mention @me, got %q", flag.Usage)
+	}
+}
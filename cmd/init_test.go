@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
 )
 
 func TestInitCommand_Exists(t *testing.T) {
@@ -433,6 +435,47 @@ func TestWriteConfigWithMetadata_FieldOptions(t *testing.T) {
 	}
 }
 
+func TestWriteConfigWithMetadata_Iterations(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	cfg := &InitConfig{
+		ProjectOwner:  "owner",
+		ProjectNumber: 1,
+		Repositories:  []string{"owner/repo"},
+	}
+
+	metadata := &ProjectMetadata{
+		ProjectID: "PVT_test",
+		Fields: []FieldMetadata{
+			{
+				ID:       "PVTIF_sprint",
+				Name:     "Sprint",
+				DataType: "ITERATION",
+				Iterations: []IterationMetadata{
+					{ID: "it_1", Title: "Sprint 1", StartDate: "2024-01-01", Duration: 14},
+				},
+			},
+		},
+	}
+
+	if err := writeConfigWithMetadata(tmpDir, cfg, metadata); err != nil {
+		t.Fatalf("writeConfigWithMetadata failed: %v", err)
+	}
+
+	loaded, err := config.LoadFromDirectory(tmpDir)
+	if err != nil {
+		t.Fatalf("failed to load written config: %v", err)
+	}
+
+	if len(loaded.Metadata.Fields) != 1 || len(loaded.Metadata.Fields[0].Iterations) != 1 {
+		t.Fatalf("Expected one iteration field with one iteration, got %+v", loaded.Metadata.Fields)
+	}
+	it := loaded.Metadata.Fields[0].Iterations[0]
+	if it.ID != "it_1" || it.Title != "Sprint 1" || it.StartDate != "2024-01-01" || it.Duration != 14 {
+		t.Errorf("Unexpected iteration metadata: %+v", it)
+	}
+}
+
 func TestWriteConfig_FilePermissions(t *testing.T) {
 	tmpDir := t.TempDir()
 
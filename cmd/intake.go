@@ -9,6 +9,7 @@ import (
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
 	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/fanout"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +19,7 @@ type intakeOptions struct {
 	json     bool
 	label    []string
 	assignee []string
+	upstream bool
 }
 
 func newIntakeCommand() *cobra.Command {
@@ -29,7 +31,22 @@ func newIntakeCommand() *cobra.Command {
 		Long: `Find open issues in configured repositories that are not yet tracked in the project.
 
 This helps ensure all work is captured on your project board.
-Use --apply to automatically add discovered issues to the project.`,
+Use --apply to automatically add discovered issues to the project.
+
+With --upstream, intake instead scans the upstream repositories in
+.gh-pmu.yml (for example, the project a fork follows) for open issues
+with their configured labels, and lists those without a mirror. With
+--apply, each gets a mirror issue in the target repository (the first
+configured repository unless the upstream sets target), labeled
+"upstream" and linking back to the original, which is added to the
+project:
+
+  upstreams:
+    - repository: upstream-owner/project
+      labels: [fork-attention]
+
+Create the "upstream" label in each target repository; existing mirrors
+are recognized by it.`,
 		Aliases: []string{"in"},
 		Example: `  # List untracked issues
   gh pmu intake
@@ -50,7 +67,10 @@ Use --apply to automatically add discovered issues to the project.`,
   gh pmu intake --apply status:backlog,priority:p1
 
   # Output as JSON
-  gh pmu intake --json`,
+  gh pmu intake --json
+
+  # Mirror labeled upstream issues into the project
+  gh pmu intake --upstream --apply status:backlog`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runIntake(cmd, opts)
 		},
@@ -61,6 +81,7 @@ Use --apply to automatically add discovered issues to the project.`,
 	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
 	cmd.Flags().StringArrayVarP(&opts.label, "label", "l", nil, "Filter issues by label (can be specified multiple times)")
 	cmd.Flags().StringArrayVar(&opts.assignee, "assignee", nil, "Filter issues by assignee (can be specified multiple times)")
+	cmd.Flags().BoolVar(&opts.upstream, "upstream", false, "Mirror labeled issues from the configured upstream repositories")
 
 	return cmd
 }
@@ -85,6 +106,10 @@ func runIntake(cmd *cobra.Command, opts *intakeOptions) error {
 		return fmt.Errorf("no repositories configured in .gh-pmu.yml")
 	}
 
+	if opts.upstream {
+		return runIntakeUpstreamWithDeps(cmd, opts, cfg, api.NewClient())
+	}
+
 	// Create API client
 	client := api.NewClient()
 
@@ -108,30 +133,35 @@ func runIntake(cmd *cobra.Command, opts *intakeOptions) error {
 		}
 	}
 
-	// Find untracked issues from each repository
-	var untrackedIssues []api.Issue
-	for _, repoFullName := range cfg.Repositories {
-		parts := strings.SplitN(repoFullName, "/", 2)
-		if len(parts) != 2 {
-			cmd.PrintErrf("Warning: invalid repository format %q, expected owner/repo\n", repoFullName)
-			continue
-		}
-		owner, repo := parts[0], parts[1]
-
-		// Get open issues from repository
+	// Find untracked issues from each repository, scanning them concurrently
+	results := fanout.Run(cfg.Repositories, cfg.Concurrency(), func(owner, repo string) ([]api.Issue, error) {
 		issues, err := client.GetRepositoryIssues(owner, repo, "open")
 		if err != nil {
-			cmd.PrintErrf("Warning: failed to get issues from %s: %v\n", repoFullName, err)
-			continue
+			return nil, err
 		}
 
-		// Filter to untracked issues
+		var untracked []api.Issue
 		for _, issue := range issues {
 			if !trackedIssues[issue.ID] {
 				issue.Repository = api.Repository{Owner: owner, Name: repo}
-				untrackedIssues = append(untrackedIssues, issue)
+				untracked = append(untracked, issue)
 			}
 		}
+		return untracked, nil
+	})
+
+	var untrackedIssues []api.Issue
+	for _, r := range results {
+		if r.Err != nil && len(results) == 1 {
+			cmd.PrintErrf("Warning: failed to get issues from %s: %v\n", r.Repository, r.Err)
+		}
+		untrackedIssues = append(untrackedIssues, r.Value...)
+	}
+	if len(results) > 1 {
+		_ = fanout.Summary(cmd.ErrOrStderr(), results, func(issues []api.Issue) string {
+			return fmt.Sprintf("%d untracked", len(issues))
+		})
+		cmd.PrintErrln()
 	}
 
 	// Apply label filter if specified
@@ -183,48 +213,7 @@ func runIntake(cmd *cobra.Command, opts *intakeOptions) error {
 				continue
 			}
 
-			// Apply fields from --apply argument first, then fall back to config defaults
-			statusSet := false
-			prioritySet := false
-
-			// Apply fields from --apply key:value pairs
-			for field, value := range applyFields {
-				fieldLower := strings.ToLower(field)
-				if fieldLower == "status" {
-					statusValue := cfg.ResolveFieldValue("status", value)
-					if err := client.SetProjectItemField(project.ID, itemID, "Status", statusValue); err != nil {
-						cmd.PrintErrf("Warning: failed to set status on #%d: %v\n", issue.Number, err)
-					} else {
-						statusSet = true
-					}
-				} else if fieldLower == "priority" {
-					priorityValue := cfg.ResolveFieldValue("priority", value)
-					if err := client.SetProjectItemField(project.ID, itemID, "Priority", priorityValue); err != nil {
-						cmd.PrintErrf("Warning: failed to set priority on #%d: %v\n", issue.Number, err)
-					} else {
-						prioritySet = true
-					}
-				} else {
-					// Generic field
-					if err := client.SetProjectItemField(project.ID, itemID, field, value); err != nil {
-						cmd.PrintErrf("Warning: failed to set %s on #%d: %v\n", field, issue.Number, err)
-					}
-				}
-			}
-
-			// Fall back to config defaults if not set via --apply
-			if !statusSet && cfg.Defaults.Status != "" {
-				statusValue := cfg.ResolveFieldValue("status", cfg.Defaults.Status)
-				if err := client.SetProjectItemField(project.ID, itemID, "Status", statusValue); err != nil {
-					cmd.PrintErrf("Warning: failed to set status on #%d: %v\n", issue.Number, err)
-				}
-			}
-			if !prioritySet && cfg.Defaults.Priority != "" {
-				priorityValue := cfg.ResolveFieldValue("priority", cfg.Defaults.Priority)
-				if err := client.SetProjectItemField(project.ID, itemID, "Priority", priorityValue); err != nil {
-					cmd.PrintErrf("Warning: failed to set priority on #%d: %v\n", issue.Number, err)
-				}
-			}
+			applyIntakeFields(cmd, client, cfg, project.ID, itemID, issue.Number, applyFields)
 
 			added = append(added, issue)
 		}
@@ -254,6 +243,54 @@ func runIntake(cmd *cobra.Command, opts *intakeOptions) error {
 	return nil
 }
 
+// applyIntakeFields sets the --apply fields on a newly added item, then the
+// configured default status and priority for any not given
+func applyIntakeFields(cmd *cobra.Command, client interface {
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}, cfg *config.Config, projectID, itemID string, number int, applyFields map[string]string) {
+	statusSet := false
+	prioritySet := false
+
+	// Apply fields from --apply key:value pairs
+	for field, value := range applyFields {
+		fieldLower := strings.ToLower(field)
+		if fieldLower == "status" {
+			statusValue := cfg.ResolveFieldValue("status", value)
+			if err := client.SetProjectItemField(projectID, itemID, "Status", statusValue); err != nil {
+				cmd.PrintErrf("Warning: failed to set status on #%d: %v\n", number, err)
+			} else {
+				statusSet = true
+			}
+		} else if fieldLower == "priority" {
+			priorityValue := cfg.ResolveFieldValue("priority", value)
+			if err := client.SetProjectItemField(projectID, itemID, "Priority", priorityValue); err != nil {
+				cmd.PrintErrf("Warning: failed to set priority on #%d: %v\n", number, err)
+			} else {
+				prioritySet = true
+			}
+		} else {
+			// Generic field
+			if err := client.SetProjectItemField(projectID, itemID, field, value); err != nil {
+				cmd.PrintErrf("Warning: failed to set %s on #%d: %v\n", field, number, err)
+			}
+		}
+	}
+
+	// Fall back to config defaults if not set via --apply
+	if !statusSet && cfg.Defaults.Status != "" {
+		statusValue := cfg.ResolveFieldValue("status", cfg.Defaults.Status)
+		if err := client.SetProjectItemField(projectID, itemID, "Status", statusValue); err != nil {
+			cmd.PrintErrf("Warning: failed to set status on #%d: %v\n", number, err)
+		}
+	}
+	if !prioritySet && cfg.Defaults.Priority != "" {
+		priorityValue := cfg.ResolveFieldValue("priority", cfg.Defaults.Priority)
+		if err := client.SetProjectItemField(projectID, itemID, "Priority", priorityValue); err != nil {
+			cmd.PrintErrf("Warning: failed to set priority on #%d: %v\n", number, err)
+		}
+	}
+}
+
 func outputIntakeTable(cmd *cobra.Command, issues []api.Issue) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
 	fmt.Fprintln(w, "NUMBER\tTITLE\tREPOSITORY\tSTATE")
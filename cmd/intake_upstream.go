@@ -0,0 +1,223 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/spf13/cobra"
+)
+
+// upstreamMirrorLabel marks issues that mirror an upstream issue. Mirrors
+// are found by this label, so it must exist in each target repository.
+const upstreamMirrorLabel = "upstream"
+
+// upstreamMarkerPattern matches the marker a mirror's body starts with
+var upstreamMarkerPattern = regexp.MustCompile(`<!-- gh-pmu:upstream ([^\s]+#\d+) -->`)
+
+// intakeUpstreamClient defines the interface for API methods used by
+// intake --upstream. This allows for easier testing with mock implementations.
+type intakeUpstreamClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetLabeledIssues(owner, repo string, labels []string, state string) ([]api.Issue, error)
+	CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error)
+	AddIssueToProject(projectID, issueID string) (string, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
+// upstreamCandidate is an upstream issue without a mirror yet
+type upstreamCandidate struct {
+	issue  api.Issue
+	key    string // owner/repo#number
+	target string // owner/repo for the mirror
+}
+
+// upstreamJSONIssue is an upstream issue in intake --upstream JSON output
+type upstreamJSONIssue struct {
+	Upstream string `json:"upstream"`
+	Title    string `json:"title"`
+	URL      string `json:"url"`
+	Target   string `json:"target"`
+	Mirror   string `json:"mirror,omitempty"`
+}
+
+// upstreamMirrorBody returns the body of a mirror issue: the marker, a
+// backlink that GitHub also shows on the upstream issue, and the upstream body
+func upstreamMirrorBody(c upstreamCandidate) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "<!-- gh-pmu:upstream %s -->\n", c.key)
+	fmt.Fprintf(&b, "> Mirrored from upstream %s", c.key)
+	if c.issue.URL != "" {
+		fmt.Fprintf(&b, ": %s", c.issue.URL)
+	}
+	b.WriteString("\n")
+	if body := strings.TrimSpace(c.issue.Body); body != "" {
+		b.WriteString("\n" + body + "\n")
+	}
+	return b.String()
+}
+
+// runIntakeUpstreamWithDeps is the testable implementation of intake --upstream
+func runIntakeUpstreamWithDeps(cmd *cobra.Command, opts *intakeOptions, cfg *config.Config, client intakeUpstreamClient) error {
+	if len(cfg.Upstreams) == 0 {
+		return fmt.Errorf("no upstreams configured in %s", config.ConfigFileName)
+	}
+	if len(opts.label) > 0 || len(opts.assignee) > 0 {
+		return fmt.Errorf("--label and --assignee cannot be used with --upstream; set labels per upstream in %s", config.ConfigFileName)
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	// Find upstream issues that no mirror in their target points to
+	mirrored := make(map[string]map[string]bool) // by target repository
+	var candidates []upstreamCandidate
+	for _, u := range cfg.Upstreams {
+		target := u.Target
+		if target == "" {
+			target = cfg.Repositories[0]
+		}
+		targetOwner, targetRepo := splitRepository(target)
+
+		if _, ok := mirrored[strings.ToLower(target)]; !ok {
+			mirrors, err := client.GetLabeledIssues(targetOwner, targetRepo, []string{upstreamMirrorLabel}, "all")
+			if err != nil {
+				return fmt.Errorf("failed to find existing mirrors in %s: %w", target, err)
+			}
+			keys := make(map[string]bool)
+			for _, m := range mirrors {
+				if match := upstreamMarkerPattern.FindStringSubmatch(m.Body); match != nil {
+					keys[strings.ToLower(match[1])] = true
+				}
+			}
+			mirrored[strings.ToLower(target)] = keys
+		}
+
+		owner, repo := splitRepository(u.Repository)
+		issues, err := client.GetLabeledIssues(owner, repo, u.Labels, "open")
+		if err != nil {
+			cmd.PrintErrf("Warning: failed to get issues from %s: %v\n", u.Repository, err)
+			continue
+		}
+		for _, issue := range issues {
+			key := fmt.Sprintf("%s/%s#%d", owner, repo, issue.Number)
+			if mirrored[strings.ToLower(target)][localstore.Key(owner+"/"+repo, issue.Number)] {
+				continue
+			}
+			// The same issue may match several upstream entries
+			mirrored[strings.ToLower(target)][localstore.Key(owner+"/"+repo, issue.Number)] = true
+			candidates = append(candidates, upstreamCandidate{issue: issue, key: key, target: target})
+		}
+	}
+
+	if len(candidates) == 0 {
+		if opts.json {
+			return outputUpstreamJSON(cmd, nil, nil, "untracked")
+		}
+		cmd.Println("All upstream issues are already mirrored in the project")
+		return nil
+	}
+
+	if opts.dryRun {
+		if opts.json {
+			return outputUpstreamJSON(cmd, candidates, nil, "dry-run")
+		}
+		cmd.Printf("Would mirror %d upstream issue(s) into the project:\n\n", len(candidates))
+		return outputUpstreamTable(cmd, candidates)
+	}
+
+	if !cmd.Flags().Changed("apply") {
+		if opts.json {
+			return outputUpstreamJSON(cmd, candidates, nil, "untracked")
+		}
+		cmd.Printf("Found %d upstream issue(s) without a mirror:\n\n", len(candidates))
+		if err := outputUpstreamTable(cmd, candidates); err != nil {
+			return err
+		}
+		cmd.Println("\nUse --upstream --apply to mirror these issues into the project")
+		return nil
+	}
+
+	// Apply - create a mirror for each and add it to the project
+	applyFields := parseApplyFields(opts.apply)
+	var added []upstreamCandidate
+	var mirrorURLs []string
+	failed := 0
+	unlabeled := make(map[string]bool)
+	for _, c := range candidates {
+		owner, repo := splitRepository(c.target)
+		mirror, err := client.CreateIssueWithOptions(owner, repo, c.issue.Title, upstreamMirrorBody(c), []string{upstreamMirrorLabel}, nil, "")
+		if err != nil {
+			cmd.PrintErrf("Failed to mirror %s: %v\n", c.key, err)
+			failed++
+			continue
+		}
+		if !hasLabel(mirror.Labels, upstreamMirrorLabel) {
+			unlabeled[c.target] = true
+		}
+
+		itemID, err := client.AddIssueToProject(project.ID, mirror.ID)
+		if err != nil {
+			cmd.PrintErrf("Warning: mirrored %s as %s#%d but failed to add it to the project: %v\n", c.key, c.target, mirror.Number, err)
+		} else {
+			applyIntakeFields(cmd, client, cfg, project.ID, itemID, mirror.Number, applyFields)
+		}
+
+		added = append(added, c)
+		mirrorURLs = append(mirrorURLs, mirror.URL)
+		if !opts.json {
+			cmd.Printf("✓ %s → %s#%d: %s\n", c.key, c.target, mirror.Number, c.issue.Title)
+		}
+	}
+	for target := range unlabeled {
+		fmt.Fprintf(os.Stderr, "Warning: label %q not found in %s; create it so these mirrors are recognized next time\n", upstreamMirrorLabel, target)
+	}
+
+	if opts.json {
+		return outputUpstreamJSON(cmd, added, mirrorURLs, "applied")
+	}
+	cmd.Printf("\nMirrored %d upstream issue(s) into the project", len(added))
+	if failed > 0 {
+		cmd.Printf(" (%d failed)", failed)
+	}
+	cmd.Println()
+	return nil
+}
+
+func outputUpstreamTable(cmd *cobra.Command, candidates []upstreamCandidate) error {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "UPSTREAM\tTITLE\tTARGET")
+
+	for _, c := range candidates {
+		title := c.issue.Title
+		if len(title) > 50 {
+			title = title[:47] + "..."
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", c.key, title, c.target)
+	}
+
+	return w.Flush()
+}
+
+func outputUpstreamJSON(cmd *cobra.Command, candidates []upstreamCandidate, mirrorURLs []string, status string) error {
+	issues := make([]upstreamJSONIssue, 0, len(candidates))
+	for i, c := range candidates {
+		issue := upstreamJSONIssue{Upstream: c.key, Title: c.issue.Title, URL: c.issue.URL, Target: c.target}
+		if i < len(mirrorURLs) {
+			issue.Mirror = mirrorURLs[i]
+		}
+		issues = append(issues, issue)
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(map[string]interface{}{"status": status, "count": len(issues), "issues": issues})
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// mockIntakeUpstreamClient implements intakeUpstreamClient interface for testing
+type mockIntakeUpstreamClient struct {
+	issues    map[string][]api.Issue // by owner/repo
+	created   []string
+	bodies    []string
+	labels    [][]string
+	fieldsSet map[string]string
+	noLabel   bool
+}
+
+func (m *mockIntakeUpstreamClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockIntakeUpstreamClient) GetLabeledIssues(owner, repo string, labels []string, state string) ([]api.Issue, error) {
+	var matched []api.Issue
+	for _, issue := range m.issues[owner+"/"+repo] {
+		for _, l := range labels {
+			if hasLabel(issue.Labels, l) && (state == "all" || issue.State == strings.ToUpper(state)) {
+				matched = append(matched, issue)
+				break
+			}
+		}
+	}
+	return matched, nil
+}
+
+func (m *mockIntakeUpstreamClient) CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error) {
+	m.created = append(m.created, owner+"/"+repo+": "+title)
+	m.bodies = append(m.bodies, body)
+	m.labels = append(m.labels, labels)
+	issue := &api.Issue{ID: "mirror", Number: 100 + len(m.created), URL: "https://github.com/fork/mirror"}
+	if !m.noLabel {
+		issue.Labels = []api.Label{{Name: upstreamMirrorLabel}}
+	}
+	return issue, nil
+}
+
+func (m *mockIntakeUpstreamClient) AddIssueToProject(projectID, issueID string) (string, error) {
+	return "item-new", nil
+}
+
+func (m *mockIntakeUpstreamClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	if m.fieldsSet == nil {
+		m.fieldsSet = make(map[string]string)
+	}
+	m.fieldsSet[fieldName] = value
+	return nil
+}
+
+func newIntakeUpstreamTestClient() *mockIntakeUpstreamClient {
+	label := func(names ...string) []api.Label {
+		var labels []api.Label
+		for _, n := range names {
+			labels = append(labels, api.Label{Name: n})
+		}
+		return labels
+	}
+	return &mockIntakeUpstreamClient{issues: map[string][]api.Issue{
+		"up/stream": {
+			{Number: 1, Title: "Crash on start", Body: "Steps to reproduce", State: "OPEN", URL: "https://github.com/up/stream/issues/1", Labels: label("fork-attention")},
+			{Number: 2, Title: "Already mirrored", State: "OPEN", Labels: label("fork-attention")},
+			{Number: 3, Title: "Not for us", State: "OPEN", Labels: label("bug")},
+		},
+		"owner/repo": {
+			{Number: 50, Body: "<!-- gh-pmu:upstream up/stream#2 -->\n> Mirrored", State: "CLOSED", Labels: label("upstream")},
+		},
+	}}
+}
+
+func newIntakeUpstreamTestConfig() *config.Config {
+	cfg := newTestConfig()
+	cfg.Upstreams = []config.Upstream{{Repository: "up/stream", Labels: []string{"fork-attention"}}}
+	return cfg
+}
+
+func TestRunIntakeUpstream_ListsUnmirrored(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runIntakeUpstreamWithDeps(cmd, &intakeOptions{}, newIntakeUpstreamTestConfig(), newIntakeUpstreamTestClient()); err != nil {
+		t.Fatalf("runIntakeUpstreamWithDeps() error = %v", err)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "up/stream#1") || strings.Contains(out, "up/stream#2") || strings.Contains(out, "up/stream#3") {
+		t.Errorf("Expected only the unmirrored labeled issue, got:\n%s", out)
+	}
+	if !strings.Contains(out, "Found 1 upstream issue(s)") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+}
+
+func TestRunIntakeUpstream_ApplyCreatesMirrors(t *testing.T) {
+	client := newIntakeUpstreamTestClient()
+	cmd := newIntakeCommand()
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	if err := cmd.Flags().Set("apply", "status:backlog"); err != nil {
+		t.Fatal(err)
+	}
+	opts := &intakeOptions{upstream: true, apply: "status:backlog"}
+
+	if err := runIntakeUpstreamWithDeps(cmd, opts, newIntakeUpstreamTestConfig(), client); err != nil {
+		t.Fatalf("runIntakeUpstreamWithDeps() error = %v", err)
+	}
+	if len(client.created) != 1 || client.created[0] != "owner/repo: Crash on start" {
+		t.Fatalf("Unexpected mirrors: %v", client.created)
+	}
+	body := client.bodies[0]
+	if !strings.HasPrefix(body, "<!-- gh-pmu:upstream up/stream#1 -->") || !strings.Contains(body, "https://github.com/up/stream/issues/1") || !strings.Contains(body, "Steps to reproduce") {
+		t.Errorf("Unexpected mirror body:\n%s", body)
+	}
+	if len(client.labels[0]) != 1 || client.labels[0][0] != upstreamMirrorLabel {
+		t.Errorf("Expected the mirror label, got %v", client.labels[0])
+	}
+	if client.fieldsSet["Status"] != "backlog" {
+		t.Errorf("Expected --apply fields to be set, got %v", client.fieldsSet)
+	}
+	if !strings.Contains(buf.String(), "✓ up/stream#1 → owner/repo#101") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunIntakeUpstream_TargetAndJSON(t *testing.T) {
+	client := newIntakeUpstreamTestClient()
+	cfg := newIntakeUpstreamTestConfig()
+	cfg.Upstreams[0].Target = "owner/planning"
+	cmd, buf := newTestCmd()
+
+	if err := runIntakeUpstreamWithDeps(cmd, &intakeOptions{dryRun: true, json: true}, cfg, client); err != nil {
+		t.Fatalf("runIntakeUpstreamWithDeps() error = %v", err)
+	}
+	var output struct {
+		Status string              `json:"status"`
+		Count  int                 `json:"count"`
+		Issues []upstreamJSONIssue `json:"issues"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	// The existing mirror is in owner/repo, so #2 needs one in owner/planning
+	if output.Status != "dry-run" || output.Count != 2 || output.Issues[0].Target != "owner/planning" {
+		t.Errorf("Unexpected output: %+v", output)
+	}
+	if len(client.created) != 0 {
+		t.Errorf("Expected no mirrors in a dry run, got %v", client.created)
+	}
+}
+
+func TestRunIntakeUpstream_NotConfigured(t *testing.T) {
+	cmd, _ := newTestCmd()
+	err := runIntakeUpstreamWithDeps(cmd, &intakeOptions{}, newTestConfig(), newIntakeUpstreamTestClient())
+	if err == nil || !strings.Contains(err.Error(), "no upstreams configured") {
+		t.Errorf("Expected a configuration error, got %v", err)
+	}
+}
+
+func TestUpstreamMarkerPattern(t *testing.T) {
+	match := upstreamMarkerPattern.FindStringSubmatch(upstreamMirrorBody(upstreamCandidate{key: "Up/Stream#7"}))
+	if match == nil || match[1] != "Up/Stream#7" {
+		t.Errorf("Expected the marker to round-trip, got %v", match)
+	}
+}
@@ -0,0 +1,143 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// listJSONFields are the keys --fields can select for list output
+var listJSONFields = []string{"number", "title", "state", "url", "repository", "assignees", "fieldValues", "archived", "notes"}
+
+// viewJSONFields are the keys --fields can select for view output
+var viewJSONFields = []string{
+	"number", "title", "state", "body", "url", "author", "assignees", "labels", "milestone",
+	"fieldValues", "subIssues", "subProgress", "parentIssue", "comments", "linkedPullRequests",
+	"summary", "history", "archived", "reactions", "participants", "notes",
+}
+
+// jsonFieldSelection is a parsed --fields value: top-level JSON keys plus
+// project fields kept under fieldValues
+type jsonFieldSelection struct {
+	keys           map[string]bool
+	projectFields  []string
+	allFieldValues bool // fieldValues was requested as a whole
+}
+
+// parseJSONFields resolves --fields names against the command's JSON keys.
+// Any other name is a project field: a config alias such as status, or a
+// field name cached in the config metadata. Returns nil when names is empty.
+func parseJSONFields(names []string, available []string, cfg *config.Config) (*jsonFieldSelection, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	sel := &jsonFieldSelection{keys: map[string]bool{}}
+	for _, raw := range names {
+		name := strings.TrimSpace(raw)
+		if name == "" {
+			continue
+		}
+
+		if key := matchJSONKey(name, available); key != "" {
+			sel.keys[key] = true
+			if key == "fieldValues" {
+				sel.allFieldValues = true
+			}
+			continue
+		}
+
+		field, ok := resolveProjectFieldName(cfg, name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q: use one of %s, or a project field", name, strings.Join(available, ", "))
+		}
+		sel.keys["fieldValues"] = true
+		sel.projectFields = append(sel.projectFields, field)
+	}
+
+	if len(sel.keys) == 0 {
+		return nil, fmt.Errorf("--fields requires at least one field")
+	}
+	return sel, nil
+}
+
+// matchJSONKey returns the JSON key matching name case-insensitively
+func matchJSONKey(name string, available []string) string {
+	for _, key := range available {
+		if strings.EqualFold(key, name) {
+			return key
+		}
+	}
+	return ""
+}
+
+// resolveProjectFieldName maps an alias or field name to the project field
+// name. Names are accepted as-is when no metadata is cached to check against.
+func resolveProjectFieldName(cfg *config.Config, name string) (string, bool) {
+	if cfg == nil {
+		return name, true
+	}
+	if _, ok := cfg.Fields[name]; ok {
+		return cfg.GetFieldName(name), true
+	}
+	if cfg.Metadata == nil || len(cfg.Metadata.Fields) == 0 {
+		return name, true
+	}
+	for _, f := range cfg.Metadata.Fields {
+		if strings.EqualFold(f.Name, name) {
+			return f.Name, true
+		}
+	}
+	return "", false
+}
+
+// wants reports whether key is selected; a nil selection selects everything
+func (s *jsonFieldSelection) wants(key string) bool {
+	return s == nil || s.keys[key]
+}
+
+// apply reduces a JSON object to the selected keys. When project fields
+// were named, fieldValues keeps only those fields.
+func (s *jsonFieldSelection) apply(payload interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode output: %w", err)
+	}
+
+	var full map[string]interface{}
+	if err := json.Unmarshal(data, &full); err != nil {
+		return nil, fmt.Errorf("failed to decode output: %w", err)
+	}
+
+	selected := make(map[string]interface{}, len(s.keys))
+	for key := range s.keys {
+		if v, ok := full[key]; ok {
+			selected[key] = v
+		}
+	}
+
+	if len(s.projectFields) > 0 && !s.allFieldValues {
+		values, _ := full["fieldValues"].(map[string]interface{})
+		filtered := make(map[string]interface{}, len(s.projectFields))
+		for _, name := range s.projectFields {
+			for field, v := range values {
+				if strings.EqualFold(field, name) {
+					filtered[field] = v
+				}
+			}
+		}
+		selected["fieldValues"] = filtered
+	}
+
+	return selected, nil
+}
+
+// encodeJSONPayload writes an indented JSON payload to w
+func encodeJSONPayload(w io.Writer, payload interface{}) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(payload)
+}
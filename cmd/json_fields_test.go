@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+func jsonFieldsTestConfig() *config.Config {
+	return &config.Config{
+		Fields: map[string]config.Field{
+			"status":   {Field: "Status"},
+			"priority": {Field: "Priority"},
+		},
+		Metadata: &config.Metadata{
+			Fields: []config.FieldMetadata{{Name: "Status"}, {Name: "Priority"}, {Name: "Estimate"}},
+		},
+	}
+}
+
+func TestParseJSONFields(t *testing.T) {
+	sel, err := parseJSONFields([]string{"number", "Title", "status", "estimate"}, listJSONFields, jsonFieldsTestConfig())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, key := range []string{"number", "title", "fieldValues"} {
+		if !sel.wants(key) {
+			t.Errorf("Expected %s to be selected", key)
+		}
+	}
+	if sel.wants("url") {
+		t.Error("Expected url not to be selected")
+	}
+	if !reflect.DeepEqual(sel.projectFields, []string{"Status", "Estimate"}) {
+		t.Errorf("projectFields = %v, want [Status Estimate]", sel.projectFields)
+	}
+}
+
+func TestParseJSONFields_Empty(t *testing.T) {
+	sel, err := parseJSONFields(nil, listJSONFields, nil)
+	if err != nil || sel != nil {
+		t.Errorf("Expected nil selection, got %+v, %v", sel, err)
+	}
+	if !sel.wants("anything") {
+		t.Error("Expected a nil selection to select everything")
+	}
+
+	if _, err := parseJSONFields([]string{" "}, listJSONFields, nil); err == nil {
+		t.Error("Expected error for blank --fields")
+	}
+}
+
+func TestParseJSONFields_Unknown(t *testing.T) {
+	_, err := parseJSONFields([]string{"titel"}, listJSONFields, jsonFieldsTestConfig())
+	if err == nil || !strings.Contains(err.Error(), `unknown field "titel"`) {
+		t.Errorf("Expected unknown field error, got %v", err)
+	}
+
+	// Without cached metadata, unknown names are taken as project fields
+	sel, err := parseJSONFields([]string{"Team"}, listJSONFields, &config.Config{})
+	if err != nil || !reflect.DeepEqual(sel.projectFields, []string{"Team"}) {
+		t.Errorf("Expected Team as a project field, got %+v, %v", sel, err)
+	}
+}
+
+func TestJSONFieldSelection_Apply(t *testing.T) {
+	output := buildViewJSON(&api.Issue{Number: 7, Title: "Bug", State: "OPEN", Body: "long body"},
+		[]api.FieldValue{{Field: "Status", Value: "Done"}, {Field: "Priority", Value: "P1"}}, nil, nil, nil)
+
+	sel, _ := parseJSONFields([]string{"number", "status"}, viewJSONFields, jsonFieldsTestConfig())
+	got, err := sel.apply(output)
+	if err != nil {
+		t.Fatalf("apply() error = %v", err)
+	}
+
+	want := map[string]interface{}{
+		"number":      float64(7),
+		"fieldValues": map[string]interface{}{"Status": "Done"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("apply() = %v, want %v", got, want)
+	}
+
+	// Requesting fieldValues as a whole keeps every field
+	sel, _ = parseJSONFields([]string{"fieldValues", "status"}, viewJSONFields, jsonFieldsTestConfig())
+	got, _ = sel.apply(output)
+	if values := got["fieldValues"].(map[string]interface{}); len(values) != 2 {
+		t.Errorf("Expected all field values, got %v", values)
+	}
+}
+
+func TestListJSONPayload_WithFields(t *testing.T) {
+	items := []api.ProjectItem{{
+		Issue:       &api.Issue{Number: 3, Title: "Task", Repository: api.Repository{Owner: "o", Name: "r"}},
+		FieldValues: []api.FieldValue{{Field: "Status", Value: "Todo"}},
+	}}
+	sel, _ := parseJSONFields([]string{"number", "status"}, listJSONFields, jsonFieldsTestConfig())
+
+	payload, err := listJSONPayload(items, sel, nil)
+	if err != nil {
+		t.Fatalf("listJSONPayload() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := encodeJSONPayload(&buf, payload); err != nil {
+		t.Fatalf("encodeJSONPayload() error = %v", err)
+	}
+
+	var decoded struct {
+		Items []map[string]interface{} `json:"items"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(decoded.Items) != 1 || len(decoded.Items[0]) != 2 || decoded.Items[0]["number"] != float64(3) {
+		t.Errorf("Unexpected items: %v", decoded.Items)
+	}
+}
+
+func TestFieldsFlags(t *testing.T) {
+	for _, name := range []string{"list", "view"} {
+		cmd := NewRootCommand()
+		sub, _, err := cmd.Find([]string{name})
+		if err != nil {
+			t.Fatalf("%s command not found: %v", name, err)
+		}
+		if sub.Flags().Lookup("fields") == nil {
+			t.Errorf("Expected --fields flag on %s", name)
+		}
+	}
+}
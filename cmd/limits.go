@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// timeoutExitCode is the exit status when --timeout expires, as with timeout(1)
+	timeoutExitCode = 124
+
+	// longRunningAnnotation marks servers, which limits.timeout does not
+	// stop; only an explicit --timeout does
+	longRunningAnnotation = "gh-pmu/long-running"
+)
+
+// applyLimits applies the limits in .gh-pmu.yml and the --timeout and
+// --request-timeout flags before a command runs. The command timeout bounds
+// cmd.Context() and every API request. It returns a function that cancels
+// the command timeout. A missing or invalid configuration is left for the
+// command itself to report.
+func applyLimits(cmd *cobra.Command) func() {
+	var cfg *config.Config
+	if cwd, err := os.Getwd(); err == nil {
+		cfg, _ = config.LoadFromDirectory(cwd)
+	}
+	applyMemoryLimit(cfg)
+
+	total, request := commandTimeouts(cmd, cfg)
+	api.DefaultRequestTimeout = request
+	if total <= 0 {
+		return func() {}
+	}
+	ctx, stop := startCommandTimeout(cmd.Context(), total)
+	cmd.SetContext(ctx)
+	api.DefaultContext = ctx
+	return stop
+}
+
+// applyMemoryLimit sets the runtime's soft memory limit from limits.memory.
+// GOMEMLIMIT in the environment takes precedence.
+func applyMemoryLimit(cfg *config.Config) {
+	if cfg == nil || os.Getenv("GOMEMLIMIT") != "" {
+		return
+	}
+	if limit, err := cfg.MemoryLimit(); err == nil && limit > 0 {
+		debug.SetMemoryLimit(limit)
+	}
+}
+
+// commandTimeouts returns the total and per-request timeouts for cmd: the
+// flags when given, otherwise limits.timeout and limits.request_timeout.
+// Servers only get a total timeout from the flag.
+func commandTimeouts(cmd *cobra.Command, cfg *config.Config) (total, request time.Duration) {
+	if cfg != nil {
+		if t, r, err := cfg.Timeouts(); err == nil {
+			total, request = t, r
+		}
+	}
+	if isLongRunning(cmd) {
+		total = 0
+	}
+
+	flags := cmd.Flags()
+	if flags.Changed("timeout") {
+		total, _ = flags.GetDuration("timeout")
+	}
+	if flags.Changed("request-timeout") {
+		request, _ = flags.GetDuration("request-timeout")
+	}
+	return total, request
+}
+
+// isLongRunning reports whether cmd is marked as a server
+func isLongRunning(cmd *cobra.Command) bool {
+	return cmd.Annotations[longRunningAnnotation] == "true"
+}
+
+// commandTimeoutError is the cause of a command's context once its
+// timeout expires
+type commandTimeoutError struct {
+	d time.Duration
+}
+
+func (e *commandTimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s; any output above is partial, and changes made before the deadline were kept", e.d)
+}
+
+// startCommandTimeout returns a context that is cancelled once d has
+// passed, with a *commandTimeoutError as its cause. API requests then fail
+// and commands waiting on the context return, so deferred cleanup still
+// runs. It also returns a function that cancels the timeout.
+func startCommandTimeout(parent context.Context, d time.Duration) (context.Context, func()) {
+	return context.WithTimeoutCause(parent, d, &commandTimeoutError{d: d})
+}
+
+// commandTimeout returns the timeout error if cmd's timeout expired
+// before it returned, or else nil
+func commandTimeout(cmd *cobra.Command) error {
+	if cmd == nil || cmd.Context() == nil {
+		return nil
+	}
+	var timeout *commandTimeoutError
+	if errors.As(context.Cause(cmd.Context()), &timeout) {
+		return timeout
+	}
+	return nil
+}
+
+// runUntilDone runs f until it returns or ctx is done, whichever is first,
+// for commands that block where they cannot watch ctx, such as on stdin.
+// Once ctx is done f is abandoned and the context's cause returned.
+func runUntilDone(ctx context.Context, f func() error) error {
+	done := make(chan error, 1)
+	go func() { done <- f() }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+// ticksUntilDone relays ticks until ctx is done, then closes the channel
+func ticksUntilDone(ctx context.Context, ticks <-chan time.Time) <-chan time.Time {
+	out := make(chan time.Time)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case t := <-ticks:
+				select {
+				case out <- t:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func TestStartCommandTimeout_Expires(t *testing.T) {
+	ctx, stop := startCommandTimeout(context.Background(), time.Millisecond)
+	defer stop()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Expected the timeout to fire")
+	}
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+	err := commandTimeout(cmd)
+	if err == nil || !strings.Contains(err.Error(), "timed out after 1ms; any output above is partial") {
+		t.Errorf("Unexpected timeout error: %v", err)
+	}
+	if code := ExitCode(err); code != timeoutExitCode {
+		t.Errorf("ExitCode() = %d, want %d", code, timeoutExitCode)
+	}
+}
+
+func TestStartCommandTimeout_Stopped(t *testing.T) {
+	ctx, stop := startCommandTimeout(context.Background(), 20*time.Millisecond)
+	stop()
+	time.Sleep(50 * time.Millisecond)
+
+	cmd := &cobra.Command{}
+	cmd.SetContext(ctx)
+	if err := commandTimeout(cmd); err != nil {
+		t.Errorf("Expected no timeout after stop, got %v", err)
+	}
+	if code := ExitCode(errors.New("failed")); code != 1 {
+		t.Errorf("ExitCode() = %d, want 1", code)
+	}
+}
+
+func TestCommandTimeout_ReturnsFromRunE(t *testing.T) {
+	defer func() { api.DefaultContext = nil }()
+
+	cleanedUp := false
+	root := NewRootCommand()
+	root.AddCommand(&cobra.Command{
+		Use: "slow",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			defer func() { cleanedUp = true }()
+			<-cmd.Context().Done()
+			return context.Cause(cmd.Context())
+		},
+	})
+	root.SetArgs([]string{"slow", "--timeout", "10ms"})
+	root.SetOut(io.Discard)
+	root.SetErr(io.Discard)
+
+	c, err := root.ExecuteC()
+	if timeout := commandTimeout(c); timeout == nil || err == nil || timeout.Error() != err.Error() {
+		t.Errorf("Expected the timeout error from RunE, got %v (timeout %v)", err, timeout)
+	}
+	if !cleanedUp {
+		t.Error("Expected deferred cleanup to run")
+	}
+	if api.DefaultContext == nil || api.DefaultContext.Err() == nil {
+		t.Error("Expected API requests bounded by the expired timeout")
+	}
+}
+
+func TestRunUntilDone(t *testing.T) {
+	ctx, stop := startCommandTimeout(context.Background(), 10*time.Millisecond)
+	defer stop()
+
+	block := make(chan struct{})
+	defer close(block)
+	err := runUntilDone(ctx, func() error {
+		<-block
+		return nil
+	})
+	if ExitCode(err) != timeoutExitCode {
+		t.Errorf("Expected the timeout, got %v", err)
+	}
+
+	if err := runUntilDone(context.Background(), func() error { return errors.New("done") }); err == nil || err.Error() != "done" {
+		t.Errorf("Expected f's error, got %v", err)
+	}
+}
+
+func TestCommandTimeouts(t *testing.T) {
+	cfg := &config.Config{Limits: &config.Limits{Timeout: "10m", RequestTimeout: "30s"}}
+
+	newCmd := func(longRunning bool, args ...string) *cobra.Command {
+		cmd := &cobra.Command{Use: "test"}
+		if longRunning {
+			cmd.Annotations = map[string]string{longRunningAnnotation: "true"}
+		}
+		cmd.Flags().Duration("timeout", 0, "")
+		cmd.Flags().Duration("request-timeout", 0, "")
+		if err := cmd.Flags().Parse(args); err != nil {
+			t.Fatal(err)
+		}
+		return cmd
+	}
+
+	tests := []struct {
+		name        string
+		cmd         *cobra.Command
+		cfg         *config.Config
+		wantTotal   time.Duration
+		wantRequest time.Duration
+	}{
+		{"no config", newCmd(false), nil, 0, 0},
+		{"config", newCmd(false), cfg, 10 * time.Minute, 30 * time.Second},
+		{"flags override config", newCmd(false, "--timeout", "2m", "--request-timeout", "5s"), cfg, 2 * time.Minute, 5 * time.Second},
+		{"flag disables", newCmd(false, "--timeout", "0"), cfg, 0, 30 * time.Second},
+		{"server ignores config total", newCmd(true), cfg, 0, 30 * time.Second},
+		{"server with flag", newCmd(true, "--timeout", "1h"), cfg, time.Hour, 30 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, request := commandTimeouts(tt.cmd, tt.cfg)
+			if total != tt.wantTotal || request != tt.wantRequest {
+				t.Errorf("commandTimeouts() = %v, %v, want %v, %v", total, request, tt.wantTotal, tt.wantRequest)
+			}
+		})
+	}
+}
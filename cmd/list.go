@@ -6,11 +6,17 @@ import (
 	"os"
 	"os/exec"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"text/template"
+	"time"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
 	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/favorites"
+	"github.com/scooter-indie/gh-pmu/internal/notes"
 	"github.com/spf13/cobra"
 )
 
@@ -20,10 +26,19 @@ type listOptions struct {
 	assignee     string
 	label        string
 	search       string
+	iteration    string
+	state        string
 	limit        int
 	hasSubIssues bool
 	json         bool
 	web          bool
+	view         string
+	sort         string
+	columns      []string
+	template     string
+	fields       []string
+	archived     bool
+	fav          bool
 }
 
 func newListCommand() *cobra.Command {
@@ -35,7 +50,44 @@ func newListCommand() *cobra.Command {
 		Long: `List issues from the configured GitHub project with their field values.
 
 By default, displays Title, Status, Priority, and Assignees for each issue.
-Use filters to narrow down the results.`,
+Use filters to narrow down the results. Issues with private notes
+from 'gh pmu note add' get a NOTES column.
+
+Archived items are not shown by default; use --archived to list them
+instead of the active items.
+
+Open and closed issues are both listed unless --state or defaults.state
+in .gh-pmu.yml narrows them to open or closed.
+
+Named views defined under 'views' in .gh-pmu.yml bundle filters, sort
+order, and columns into a reusable preset. Flags given on the command
+line override the values from the view.`,
+		Example: `  # List issues in progress, highest priority first
+  gh pmu list --status in_progress --sort priority
+
+  # Only open issues
+  gh pmu list --state open
+
+  # List items in the active sprint
+  gh pmu list --iteration current
+
+  # Choose the table columns
+  gh pmu list --columns number,title,status,estimate
+
+  # Use a named view from .gh-pmu.yml
+  gh pmu list --view sprint-board
+
+  # Your bookmarked issues
+  gh pmu list --fav
+
+  # Closed work that was archived from the board
+  gh pmu list --archived --status done
+
+  # Only the data a script needs
+  gh pmu list --fields number,title,status
+
+  # Custom one-line format from the JSON payload
+  gh pmu list --template '{{range .items}}#{{.number}} {{.fieldValues.Status}} {{.title}}{{"\n"}}{{end}}'`,
 		Aliases: []string{"ls"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runList(cmd, opts)
@@ -47,10 +99,19 @@ Use filters to narrow down the results.`,
 	cmd.Flags().StringVarP(&opts.assignee, "assignee", "a", "", "Filter by assignee login")
 	cmd.Flags().StringVarP(&opts.label, "label", "l", "", "Filter by label name")
 	cmd.Flags().StringVarP(&opts.search, "search", "q", "", "Search in issue title and body")
+	cmd.Flags().StringVar(&opts.iteration, "iteration", "", "Filter by iteration: current, next, or an iteration title")
+	cmd.Flags().StringVar(&opts.state, "state", "", "Filter by state: open, closed, or all (default: defaults.state, else all)")
 	cmd.Flags().IntVarP(&opts.limit, "limit", "n", 0, "Limit number of results (0 for no limit)")
 	cmd.Flags().BoolVar(&opts.hasSubIssues, "has-sub-issues", false, "Filter to only show parent issues (issues with sub-issues)")
 	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
 	cmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open project board in browser")
+	cmd.Flags().StringVar(&opts.view, "view", "", "Apply a named view from the config file")
+	cmd.Flags().StringVar(&opts.sort, "sort", "", "Sort by column (e.g., priority, status, number); prefix with - for descending. Single-select fields sort in project order")
+	cmd.Flags().StringSliceVar(&opts.columns, "columns", nil, "Table columns to display (e.g., number,title,status,priority,assignees)")
+	cmd.Flags().StringVarP(&opts.template, "template", "t", "", "Format the JSON output using a Go template")
+	cmd.Flags().BoolVar(&opts.archived, "archived", false, "List archived items instead of active ones")
+	cmd.Flags().BoolVar(&opts.fav, "fav", false, "Only list your favorite issues (see 'gh pmu fav')")
+	cmd.Flags().StringSliceVar(&opts.fields, "fields", nil, "JSON fields to output (e.g., number,title,status); implies --json")
 
 	return cmd
 }
@@ -72,11 +133,43 @@ func runList(cmd *cobra.Command, opts *listOptions) error {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	// Parse the output template before any API calls
+	var tmpl *template.Template
+	if opts.template != "" {
+		tmpl, err = parseOutputTemplate(opts.template)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Resolve the JSON field selection
+	fieldSel, err := parseJSONFields(opts.fields, listJSONFields, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Apply named view (explicit flags take precedence)
+	if opts.view != "" {
+		view, err := cfg.GetView(opts.view)
+		if err != nil {
+			return err
+		}
+		applyListView(cmd, opts, view)
+	}
+
+	state, err := resolveStateFilter(opts.state, cfg)
+	if err != nil {
+		return err
+	}
+
 	// Create API client
 	client := api.NewClient()
 
+	// A running daemon serves the project and its items from its cache
+	reader := projectItemsSource(cfg, client)
+
 	// Get project
-	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	project, err := reader.GetProject(cfg.Project.Owner, cfg.Project.Number)
 	if err != nil {
 		return fmt.Errorf("failed to get project: %w", err)
 	}
@@ -94,37 +187,72 @@ func runList(cmd *cobra.Command, opts *listOptions) error {
 		}
 	}
 
-	// Fetch project items
-	items, err := client.GetProjectItems(project.ID, filter)
-	if err != nil {
-		return fmt.Errorf("failed to get project items: %w", err)
-	}
-
-	// Apply status filter
-	if opts.status != "" {
-		targetStatus := cfg.ResolveFieldValue("status", opts.status)
-		items = filterByFieldValue(items, "Status", targetStatus)
-	}
-
-	// Apply priority filter
-	if opts.priority != "" {
-		targetPriority := cfg.ResolveFieldValue("priority", opts.priority)
-		items = filterByFieldValue(items, "Priority", targetPriority)
+	// Resolve everything the filters need before fetching
+	var favStore *favorites.Store
+	if opts.fav {
+		favStore, err = loadProjectFavorites(cfg)
+		if err != nil {
+			return err
+		}
 	}
-
-	// Apply assignee filter
-	if opts.assignee != "" {
-		items = filterByAssignee(items, opts.assignee)
+	var iterationField, iterationTitle string
+	if opts.iteration != "" {
+		fields, err := client.GetProjectFields(project.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get project fields: %w", err)
+		}
+		fieldName, iteration, err := resolveIteration(fields, cfg.GetFieldName("iteration"), opts.iteration, time.Now())
+		if err != nil {
+			return err
+		}
+		iterationField, iterationTitle = fieldName, iteration.Title
 	}
 
-	// Apply label filter
-	if opts.label != "" {
-		items = filterByLabel(items, opts.label)
+	// keep applies the filters that look at one item at a time
+	keep := func(items []api.ProjectItem) []api.ProjectItem {
+		items = filterByState(items, state)
+		if favStore != nil {
+			items = filterByFavorites(items, favStore)
+		}
+		if opts.status != "" {
+			items = filterByFieldValue(items, "Status", cfg.ResolveFieldValue("status", opts.status))
+		}
+		if opts.priority != "" {
+			items = filterByFieldValue(items, "Priority", cfg.ResolveFieldValue("priority", opts.priority))
+		}
+		if opts.assignee != "" {
+			items = filterByAssignee(items, opts.assignee)
+		}
+		if opts.label != "" {
+			items = filterByLabel(items, opts.label)
+		}
+		if opts.search != "" {
+			items = filterBySearch(items, opts.search)
+		}
+		if iterationField != "" {
+			items = filterByFieldValue(items, iterationField, iterationTitle)
+		}
+		return items
 	}
 
-	// Apply search filter
-	if opts.search != "" {
-		items = filterBySearch(items, opts.search)
+	// Fetch project items. Archived items are not part of the project's
+	// item list, so they are looked up through the repository's issues.
+	var items []api.ProjectItem
+	if opts.archived {
+		var repos []string
+		if filter != nil {
+			repos = []string{filter.Repository}
+		}
+		items, err = client.GetArchivedProjectItems(project.ID, repos)
+		if err != nil {
+			return fmt.Errorf("failed to get archived items: %w", err)
+		}
+		items = keep(items)
+	} else {
+		items, err = fetchListItems(reader, project.ID, filter, keep, listStopAt(opts))
+		if err != nil {
+			return fmt.Errorf("failed to get project items: %w", err)
+		}
 	}
 
 	// Apply has-sub-issues filter
@@ -132,17 +260,246 @@ func runList(cmd *cobra.Command, opts *listOptions) error {
 		items = filterByHasSubIssues(client, items)
 	}
 
+	// Apply sort
+	if opts.sort != "" {
+		sortListItems(items, opts.sort, cfg)
+	}
+
 	// Apply limit
 	if opts.limit > 0 && len(items) > opts.limit {
 		items = items[:opts.limit]
 	}
 
+	// Local notes are shown alongside the items
+	store := loadProjectNotes(cfg)
+
 	// Output
+	if tmpl != nil || fieldSel != nil {
+		payload, err := listJSONPayload(items, fieldSel, store)
+		if err != nil {
+			return err
+		}
+		if tmpl != nil {
+			return executeOutputTemplate(cmd.OutOrStdout(), tmpl, payload)
+		}
+		return encodeJSONPayload(cmd.OutOrStdout(), payload)
+	}
+
 	if opts.json {
-		return outputJSON(cmd, items)
+		return outputJSON(cmd, items, store)
+	}
+
+	if len(opts.columns) > 0 {
+		return outputTableColumns(cmd, items, opts.columns, store)
+	}
+
+	return outputTable(cmd, items, store)
+}
+
+// projectItemsBatchReader reads a project's items one page at a time
+type projectItemsBatchReader interface {
+	EachProjectItemsBatch(projectID string, filter *api.ProjectItemsFilter, fn func(batch []api.ProjectItem) error) error
+}
+
+// fetchListItems returns the project's items that keep accepts. When the
+// reader can page, keep runs on each page as it arrives so only matching
+// items are held, and fetching stops once stopAt items match (0 for no
+// limit).
+func fetchListItems(reader projectItemsReader, projectID string, filter *api.ProjectItemsFilter, keep func([]api.ProjectItem) []api.ProjectItem, stopAt int) ([]api.ProjectItem, error) {
+	batches, ok := reader.(projectItemsBatchReader)
+	if !ok {
+		items, err := reader.GetProjectItems(projectID, filter)
+		if err != nil {
+			return nil, err
+		}
+		return keep(items), nil
+	}
+
+	var items []api.ProjectItem
+	err := batches.EachProjectItemsBatch(projectID, filter, func(batch []api.ProjectItem) error {
+		items = append(items, keep(batch)...)
+		if stopAt > 0 && len(items) >= stopAt {
+			return api.ErrStopBatches
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// listStopAt returns how many matching items are enough for the listing:
+// the limit, unless sorting or the sub-issue filter needs every match first
+func listStopAt(opts *listOptions) int {
+	if opts.sort != "" || opts.hasSubIssues {
+		return 0
+	}
+	return opts.limit
+}
+
+// applyListView copies view settings into opts for every flag not set explicitly
+func applyListView(cmd *cobra.Command, opts *listOptions, view config.View) {
+	changed := func(name string) bool {
+		return cmd.Flags().Changed(name)
+	}
+
+	if view.Status != "" && !changed("status") {
+		opts.status = view.Status
+	}
+	if view.Priority != "" && !changed("priority") {
+		opts.priority = view.Priority
+	}
+	if view.Assignee != "" && !changed("assignee") {
+		opts.assignee = view.Assignee
+	}
+	if view.Label != "" && !changed("label") {
+		opts.label = view.Label
+	}
+	if view.Search != "" && !changed("search") {
+		opts.search = view.Search
+	}
+	if view.Iteration != "" && !changed("iteration") {
+		opts.iteration = view.Iteration
+	}
+	if view.State != "" && !changed("state") {
+		opts.state = view.State
+	}
+	if view.HasSubIssues && !changed("has-sub-issues") {
+		opts.hasSubIssues = true
+	}
+	if view.Limit > 0 && !changed("limit") {
+		opts.limit = view.Limit
+	}
+	if view.Sort != "" && !changed("sort") {
+		opts.sort = view.Sort
+	}
+	if len(view.Columns) > 0 && !changed("columns") {
+		opts.columns = view.Columns
+	}
+}
+
+// listColumnValue returns the display value of a column for an item.
+// Unknown column names are looked up as project field names.
+func listColumnValue(item api.ProjectItem, column string) string {
+	switch strings.ToLower(column) {
+	case "number":
+		return fmt.Sprintf("#%d", item.Issue.Number)
+	case "title":
+		title := item.Issue.Title
+		if len(title) > 50 {
+			title = title[:47] + "..."
+		}
+		return title
+	case "state":
+		return item.Issue.State
+	case "repository", "repo":
+		return fmt.Sprintf("%s/%s", item.Issue.Repository.Owner, item.Issue.Repository.Name)
+	case "assignees":
+		var assignees []string
+		for _, a := range item.Issue.Assignees {
+			assignees = append(assignees, a.Login)
+		}
+		if len(assignees) == 0 {
+			return "-"
+		}
+		return strings.Join(assignees, ", ")
+	default:
+		return getFieldValue(item, column)
+	}
+}
+
+// sortListItems sorts items in place by the given column.
+// A leading "-" sorts in descending order. Items missing a value sort last.
+// Single-select fields such as status sort in the project's option order,
+// and numeric values sort as numbers.
+func sortListItems(items []api.ProjectItem, sortKey string, cfg *config.Config) {
+	descending := strings.HasPrefix(sortKey, "-")
+	column := strings.TrimPrefix(sortKey, "-")
+
+	rank := make(map[string]int)
+	order := fieldOptionOrder(cfg, column)
+	if len(order) == 0 {
+		order = fieldOptionOrder(cfg, cfg.GetFieldName(strings.ToLower(column)))
+	}
+	for i, option := range order {
+		rank[strings.ToLower(option)] = i
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Issue == nil || b.Issue == nil {
+			return a.Issue != nil
+		}
+
+		if strings.EqualFold(column, "number") {
+			if descending {
+				return a.Issue.Number > b.Issue.Number
+			}
+			return a.Issue.Number < b.Issue.Number
+		}
+
+		va := strings.ToLower(listColumnValue(a, column))
+		vb := strings.ToLower(listColumnValue(b, column))
+		if va == "" || vb == "" {
+			return va != ""
+		}
+		if descending {
+			va, vb = vb, va
+		}
+		return listValueLess(va, vb, rank)
+	})
+}
+
+// listValueLess orders two column values: by rank when both are known
+// options, numerically when both are numbers, and otherwise as text
+func listValueLess(a, b string, rank map[string]int) bool {
+	ra, okA := rank[a]
+	rb, okB := rank[b]
+	if okA && okB {
+		return ra < rb
+	}
+	na, errA := strconv.ParseFloat(a, 64)
+	nb, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		return na < nb
+	}
+	return a < b
+}
+
+// outputTableColumns outputs items in a table with the given columns.
+// The notes column shows the item's local notes.
+func outputTableColumns(cmd *cobra.Command, items []api.ProjectItem, columns []string, store *notes.Store) error {
+	if len(items) == 0 {
+		cmd.Println("No issues found")
+		return nil
+	}
+
+	headers := make([]string, len(columns))
+	for i, c := range columns {
+		headers[i] = strings.ToUpper(c)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+
+		values := make([]string, len(columns))
+		for i, c := range columns {
+			if strings.EqualFold(c, "notes") {
+				values[i] = formatItemNotes(store.List(itemKey(item)))
+				continue
+			}
+			values[i] = listColumnValue(item, c)
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
 	}
 
-	return outputTable(cmd, items)
+	return w.Flush()
 }
 
 // filterByFieldValue filters items by a specific field value
@@ -159,6 +516,105 @@ func filterByFieldValue(items []api.ProjectItem, fieldName, value string) []api.
 	return filtered
 }
 
+// resolveStateFilter returns OPEN, CLOSED, or ALL for a --state value.
+// An empty value falls back to defaults.state in the config, then to all.
+func resolveStateFilter(state string, cfg *config.Config) (string, error) {
+	source := "--state"
+	if state == "" && cfg != nil && cfg.Defaults.State != "" {
+		state, source = cfg.Defaults.State, "defaults.state"
+	}
+	if state == "" {
+		return "ALL", nil
+	}
+	if !config.IsValidState(state) {
+		return "", fmt.Errorf("invalid %s %q: must be open, closed, or all", source, state)
+	}
+	return strings.ToUpper(state), nil
+}
+
+// matchesState reports whether an issue state passes a resolved state filter
+func matchesState(state, filter string) bool {
+	return filter == "ALL" || strings.EqualFold(state, filter)
+}
+
+// filterByState keeps items whose issue state passes filter. Draft items
+// have no state and are treated as open.
+func filterByState(items []api.ProjectItem, filter string) []api.ProjectItem {
+	if filter == "ALL" {
+		return items
+	}
+	var filtered []api.ProjectItem
+	for _, item := range items {
+		state := "OPEN"
+		if item.Issue != nil {
+			state = item.Issue.State
+		}
+		if matchesState(state, filter) {
+			filtered = append(filtered, item)
+		}
+	}
+	return filtered
+}
+
+// resolveIteration finds the iteration field and the iteration matching spec.
+// spec is "current", "next", or an iteration title. The field named
+// preferredField is used when present, otherwise the first iteration field.
+func resolveIteration(fields []api.ProjectField, preferredField, spec string, now time.Time) (string, api.Iteration, error) {
+	var field *api.ProjectField
+	for i := range fields {
+		if fields[i].DataType != "ITERATION" {
+			continue
+		}
+		if field == nil || strings.EqualFold(fields[i].Name, preferredField) {
+			field = &fields[i]
+		}
+	}
+
+	if field == nil {
+		return "", api.Iteration{}, fmt.Errorf("project has no iteration field")
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch strings.ToLower(spec) {
+	case "current":
+		for _, it := range field.Iterations {
+			start, err := time.Parse("2006-01-02", it.StartDate)
+			if err != nil {
+				continue
+			}
+			if !today.Before(start) && today.Before(start.AddDate(0, 0, it.Duration)) {
+				return field.Name, it, nil
+			}
+		}
+		return "", api.Iteration{}, fmt.Errorf("no current iteration in field %q", field.Name)
+	case "next":
+		var next *api.Iteration
+		var nextStart time.Time
+		for i, it := range field.Iterations {
+			start, err := time.Parse("2006-01-02", it.StartDate)
+			if err != nil || !start.After(today) {
+				continue
+			}
+			if next == nil || start.Before(nextStart) {
+				next = &field.Iterations[i]
+				nextStart = start
+			}
+		}
+		if next == nil {
+			return "", api.Iteration{}, fmt.Errorf("no upcoming iteration in field %q", field.Name)
+		}
+		return field.Name, *next, nil
+	default:
+		for _, it := range field.Iterations {
+			if strings.EqualFold(it.Title, spec) {
+				return field.Name, it, nil
+			}
+		}
+		return "", api.Iteration{}, fmt.Errorf("iteration %q not found in field %q", spec, field.Name)
+	}
+}
+
 // filterByHasSubIssues filters items to only those with sub-issues
 func filterByHasSubIssues(client *api.Client, items []api.ProjectItem) []api.ProjectItem {
 	var filtered []api.ProjectItem
@@ -195,15 +651,28 @@ func getFieldValue(item api.ProjectItem, fieldName string) string {
 	return ""
 }
 
-// outputTable outputs items in a table format
-func outputTable(cmd *cobra.Command, items []api.ProjectItem) error {
+// outputTable outputs items in a table format. A NOTES column is added
+// when any item has local notes.
+func outputTable(cmd *cobra.Command, items []api.ProjectItem, store *notes.Store) error {
 	if len(items) == 0 {
 		cmd.Println("No issues found")
 		return nil
 	}
 
+	showNotes := false
+	for _, item := range items {
+		if item.Issue != nil && len(store.List(itemKey(item))) > 0 {
+			showNotes = true
+			break
+		}
+	}
+
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "NUMBER\tTITLE\tSTATUS\tPRIORITY\tASSIGNEES")
+	header := "NUMBER\tTITLE\tSTATUS\tPRIORITY\tASSIGNEES"
+	if showNotes {
+		header += "\tNOTES"
+	}
+	fmt.Fprintln(w, header)
 
 	for _, item := range items {
 		if item.Issue == nil {
@@ -230,13 +699,17 @@ func outputTable(cmd *cobra.Command, items []api.ProjectItem) error {
 			title = title[:47] + "..."
 		}
 
-		fmt.Fprintf(w, "#%d\t%s\t%s\t%s\t%s\n",
+		line := fmt.Sprintf("#%d\t%s\t%s\t%s\t%s",
 			item.Issue.Number,
 			title,
 			status,
 			priority,
 			assigneeStr,
 		)
+		if showNotes {
+			line += "\t" + formatItemNotes(store.List(itemKey(item)))
+		}
+		fmt.Fprintln(w, line)
 	}
 
 	w.Flush()
@@ -257,10 +730,39 @@ type JSONItem struct {
 	Repository  string            `json:"repository"`
 	Assignees   []string          `json:"assignees"`
 	FieldValues map[string]string `json:"fieldValues"`
+	Archived    bool              `json:"archived,omitempty"`
+	Notes       []NoteJSON        `json:"notes,omitempty"` // Local notes, never sent to GitHub
 }
 
 // outputJSON outputs items in JSON format
-func outputJSON(cmd *cobra.Command, items []api.ProjectItem) error {
+func outputJSON(cmd *cobra.Command, items []api.ProjectItem, store *notes.Store) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(buildListJSON(items, store))
+}
+
+// listJSONPayload returns the list JSON output, reduced to the selected
+// fields when a selection is given
+func listJSONPayload(items []api.ProjectItem, sel *jsonFieldSelection, store *notes.Store) (interface{}, error) {
+	output := buildListJSON(items, store)
+	if sel == nil {
+		return output, nil
+	}
+
+	selected := make([]map[string]interface{}, 0, len(output.Items))
+	for _, item := range output.Items {
+		m, err := sel.apply(item)
+		if err != nil {
+			return nil, err
+		}
+		selected = append(selected, m)
+	}
+	return map[string]interface{}{"items": selected}, nil
+}
+
+// buildListJSON assembles the JSON output for a list of items, with any
+// local notes from store
+func buildListJSON(items []api.ProjectItem, store *notes.Store) JSONOutput {
 	output := JSONOutput{
 		Items: make([]JSONItem, 0, len(items)),
 	}
@@ -278,6 +780,8 @@ func outputJSON(cmd *cobra.Command, items []api.ProjectItem) error {
 			Repository:  fmt.Sprintf("%s/%s", item.Issue.Repository.Owner, item.Issue.Repository.Name),
 			Assignees:   make([]string, 0),
 			FieldValues: make(map[string]string),
+			Archived:    item.IsArchived,
+			Notes:       buildNotesJSON(store.List(itemKey(item))),
 		}
 
 		for _, a := range item.Issue.Assignees {
@@ -291,9 +795,23 @@ func outputJSON(cmd *cobra.Command, items []api.ProjectItem) error {
 		output.Items = append(output.Items, jsonItem)
 	}
 
-	encoder := json.NewEncoder(os.Stdout)
-	encoder.SetIndent("", "  ")
-	return encoder.Encode(output)
+	return output
+}
+
+// formatItemNotes renders an item's latest note for a table cell, with a
+// count of any earlier ones
+func formatItemNotes(list []notes.Note) string {
+	if len(list) == 0 {
+		return "-"
+	}
+	text := []rune(list[len(list)-1].Text)
+	if len(text) > 40 {
+		text = append(text[:37], []rune("...")...)
+	}
+	if len(list) > 1 {
+		return fmt.Sprintf("%s (+%d)", string(text), len(list)-1)
+	}
+	return string(text)
 }
 
 // filterByAssignee filters items by assignee login
@@ -3,10 +3,13 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
 	"github.com/spf13/cobra"
 )
 
@@ -412,7 +415,7 @@ func TestOutputTable_EmptyItems(t *testing.T) {
 	buf := new(bytes.Buffer)
 	cmd := createTestCmd(buf)
 
-	err := outputTable(cmd, []api.ProjectItem{})
+	err := outputTable(cmd, []api.ProjectItem{}, nil)
 	if err != nil {
 		t.Fatalf("outputTable() error = %v", err)
 	}
@@ -444,7 +447,7 @@ func TestOutputTable_TitleTruncation(t *testing.T) {
 
 	// Note: outputTable writes to os.Stdout, not cmd.Out()
 	// We can't capture this directly, but we can verify no error
-	err := outputTable(cmd, items)
+	err := outputTable(cmd, items, nil)
 	if err != nil {
 		t.Fatalf("outputTable() error = %v", err)
 	}
@@ -473,7 +476,7 @@ func TestOutputTable_WithAssignees(t *testing.T) {
 		},
 	}
 
-	err := outputTable(cmd, items)
+	err := outputTable(cmd, items, nil)
 	if err != nil {
 		t.Fatalf("outputTable() error = %v", err)
 	}
@@ -494,7 +497,7 @@ func TestOutputTable_NoAssignees(t *testing.T) {
 		},
 	}
 
-	err := outputTable(cmd, items)
+	err := outputTable(cmd, items, nil)
 	if err != nil {
 		t.Fatalf("outputTable() error = %v", err)
 	}
@@ -516,7 +519,7 @@ func TestOutputTable_NilIssue(t *testing.T) {
 		},
 	}
 
-	err := outputTable(cmd, items)
+	err := outputTable(cmd, items, nil)
 	if err != nil {
 		t.Fatalf("outputTable() error = %v", err)
 	}
@@ -532,7 +535,7 @@ func TestOutputJSON_EmptyItems(t *testing.T) {
 
 	// outputJSON writes to os.Stdout, not cmd buffer
 	// But we can verify structure by checking for error
-	err := outputJSON(cmd, []api.ProjectItem{})
+	err := outputJSON(cmd, []api.ProjectItem{}, nil)
 	if err != nil {
 		t.Fatalf("outputJSON() error = %v", err)
 	}
@@ -565,7 +568,7 @@ func TestOutputJSON_WithItems(t *testing.T) {
 		},
 	}
 
-	err := outputJSON(cmd, items)
+	err := outputJSON(cmd, items, nil)
 	if err != nil {
 		t.Fatalf("outputJSON() error = %v", err)
 	}
@@ -579,7 +582,7 @@ func TestOutputJSON_NilIssue(t *testing.T) {
 		{ID: "1", Issue: nil},
 	}
 
-	err := outputJSON(cmd, items)
+	err := outputJSON(cmd, items, nil)
 	if err != nil {
 		t.Fatalf("outputJSON() error = %v", err)
 	}
@@ -1040,3 +1043,384 @@ func TestFilterBySearch(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================================
+// Views, sorting, and columns Tests
+// ============================================================================
+
+func TestListCommand_HasViewFlags(t *testing.T) {
+	cmd := NewRootCommand()
+	listCmd, _, err := cmd.Find([]string{"list"})
+	if err != nil {
+		t.Fatalf("list command not found: %v", err)
+	}
+
+	for _, name := range []string{"view", "sort", "columns"} {
+		if listCmd.Flags().Lookup(name) == nil {
+			t.Errorf("Expected --%s flag to exist", name)
+		}
+	}
+}
+
+func TestResolveStateFilter(t *testing.T) {
+	withDefault := &config.Config{Defaults: config.Defaults{State: "open"}}
+
+	tests := []struct {
+		name    string
+		state   string
+		cfg     *config.Config
+		want    string
+		wantErr string
+	}{
+		{"empty defaults to all", "", &config.Config{}, "ALL", ""},
+		{"nil config", "", nil, "ALL", ""},
+		{"flag is case-insensitive", "Closed", &config.Config{}, "CLOSED", ""},
+		{"config default", "", withDefault, "OPEN", ""},
+		{"flag overrides config", "all", withDefault, "ALL", ""},
+		{"invalid flag", "merged", &config.Config{}, "", "invalid --state"},
+		{"invalid config", "", &config.Config{Defaults: config.Defaults{State: "done"}}, "", "invalid defaults.state"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveStateFilter(tt.state, tt.cfg)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("resolveStateFilter() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterByState(t *testing.T) {
+	items := []api.ProjectItem{
+		{Issue: &api.Issue{Number: 1, State: "OPEN"}},
+		{Issue: &api.Issue{Number: 2, State: "CLOSED"}},
+		{ID: "draft"},
+	}
+
+	if got := filterByState(items, "ALL"); len(got) != 3 {
+		t.Errorf("Expected all items, got %d", len(got))
+	}
+
+	open := filterByState(items, "OPEN")
+	if len(open) != 2 || open[0].Issue.Number != 1 || open[1].Issue != nil {
+		t.Errorf("Expected open issue and draft, got %+v", open)
+	}
+
+	closed := filterByState(items, "CLOSED")
+	if len(closed) != 1 || closed[0].Issue.Number != 2 {
+		t.Errorf("Expected closed issue, got %+v", closed)
+	}
+}
+
+func TestApplyListView_FlagsOverrideView(t *testing.T) {
+	cmd := newListCommand()
+	if err := cmd.Flags().Set("status", "done"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+
+	opts := &listOptions{status: "done"}
+	view := config.View{
+		Status:   "in_progress",
+		Priority: "p1",
+		Sort:     "-priority",
+		Columns:  []string{"number", "title"},
+		Limit:    10,
+	}
+
+	applyListView(cmd, opts, view)
+
+	if opts.status != "done" {
+		t.Errorf("Expected explicit --status to win, got %q", opts.status)
+	}
+	if opts.priority != "p1" {
+		t.Errorf("Expected priority from view, got %q", opts.priority)
+	}
+	if opts.sort != "-priority" || opts.limit != 10 || len(opts.columns) != 2 {
+		t.Errorf("Expected sort, limit and columns from view, got %+v", opts)
+	}
+}
+
+func TestSortListItems(t *testing.T) {
+	newItem := func(number int, priority string) api.ProjectItem {
+		item := api.ProjectItem{Issue: &api.Issue{Number: number, Title: fmt.Sprintf("Issue %d", number)}}
+		if priority != "" {
+			item.FieldValues = []api.FieldValue{{Field: "Priority", Value: priority}}
+		}
+		return item
+	}
+
+	t.Run("ascending by field, missing values last", func(t *testing.T) {
+		items := []api.ProjectItem{newItem(1, "P2"), newItem(2, ""), newItem(3, "P0")}
+		sortListItems(items, "priority", newTestConfig())
+		if items[0].Issue.Number != 3 || items[1].Issue.Number != 1 || items[2].Issue.Number != 2 {
+			t.Errorf("Unexpected order: %d, %d, %d", items[0].Issue.Number, items[1].Issue.Number, items[2].Issue.Number)
+		}
+	})
+
+	t.Run("descending by number", func(t *testing.T) {
+		items := []api.ProjectItem{newItem(1, ""), newItem(10, ""), newItem(2, "")}
+		sortListItems(items, "-number", newTestConfig())
+		if items[0].Issue.Number != 10 || items[2].Issue.Number != 1 {
+			t.Errorf("Unexpected order: %d, %d, %d", items[0].Issue.Number, items[1].Issue.Number, items[2].Issue.Number)
+		}
+	})
+
+	t.Run("status in project order", func(t *testing.T) {
+		cfg := newTestConfig()
+		cfg.Fields = map[string]config.Field{"status": {Field: "Status"}}
+		cfg.Metadata = &config.Metadata{Fields: []config.FieldMetadata{{
+			Name:    "Status",
+			Options: []config.OptionMetadata{{Name: "Backlog"}, {Name: "In Progress"}, {Name: "Done"}},
+		}}}
+		withStatus := func(number int, status string) api.ProjectItem {
+			return api.ProjectItem{Issue: &api.Issue{Number: number}, FieldValues: []api.FieldValue{{Field: "Status", Value: status}}}
+		}
+
+		items := []api.ProjectItem{withStatus(1, "Done"), withStatus(2, "Backlog"), withStatus(3, "In Progress")}
+		sortListItems(items, "status", cfg)
+		if items[0].Issue.Number != 2 || items[1].Issue.Number != 3 || items[2].Issue.Number != 1 {
+			t.Errorf("Unexpected order: %d, %d, %d", items[0].Issue.Number, items[1].Issue.Number, items[2].Issue.Number)
+		}
+	})
+
+	t.Run("numbers as numbers", func(t *testing.T) {
+		withEstimate := func(number int, estimate string) api.ProjectItem {
+			return api.ProjectItem{Issue: &api.Issue{Number: number}, FieldValues: []api.FieldValue{{Field: "Estimate", Value: estimate}}}
+		}
+
+		items := []api.ProjectItem{withEstimate(1, "10"), withEstimate(2, "2"), withEstimate(3, "1.5")}
+		sortListItems(items, "-estimate", newTestConfig())
+		if items[0].Issue.Number != 1 || items[1].Issue.Number != 2 || items[2].Issue.Number != 3 {
+			t.Errorf("Unexpected order: %d, %d, %d", items[0].Issue.Number, items[1].Issue.Number, items[2].Issue.Number)
+		}
+	})
+}
+
+func TestListColumnValue(t *testing.T) {
+	item := api.ProjectItem{
+		Issue: &api.Issue{
+			Number:     42,
+			Title:      "Title",
+			State:      "OPEN",
+			Repository: api.Repository{Owner: "owner", Name: "repo"},
+			Assignees:  []api.Actor{{Login: "alice"}, {Login: "bob"}},
+		},
+		FieldValues: []api.FieldValue{{Field: "Estimate", Value: "3"}},
+	}
+
+	tests := map[string]string{
+		"number":     "#42",
+		"title":      "Title",
+		"state":      "OPEN",
+		"repo":       "owner/repo",
+		"assignees":  "alice, bob",
+		"estimate":   "3",
+		"unknowncol": "",
+	}
+
+	for column, want := range tests {
+		if got := listColumnValue(item, column); got != want {
+			t.Errorf("listColumnValue(%q) = %q, want %q", column, got, want)
+		}
+	}
+}
+
+func TestOutputTableColumns_EmptyItems(t *testing.T) {
+	buf := new(bytes.Buffer)
+	cmd := createTestCmd(buf)
+
+	if err := outputTableColumns(cmd, nil, []string{"number"}, nil); err != nil {
+		t.Fatalf("outputTableColumns() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "No issues found") {
+		t.Errorf("Expected 'No issues found', got: %s", buf.String())
+	}
+}
+
+func TestOutputTableColumns_WritesToCommandOutput(t *testing.T) {
+	buf := new(bytes.Buffer)
+	cmd := createTestCmd(buf)
+	items := []api.ProjectItem{{Issue: &api.Issue{Number: 7, Title: "Seven"}}}
+
+	if err := outputTableColumns(cmd, items, []string{"number", "title"}, nil); err != nil {
+		t.Fatalf("outputTableColumns() error = %v", err)
+	}
+	if out := buf.String(); !strings.Contains(out, "NUMBER") || !strings.Contains(out, "#7") {
+		t.Errorf("Expected the table in the command output, got: %s", out)
+	}
+}
+
+// ============================================================================
+// Iteration Tests
+// ============================================================================
+
+func newIterationTestFields() []api.ProjectField {
+	return []api.ProjectField{
+		{Name: "Status", DataType: "SINGLE_SELECT"},
+		{
+			Name:     "Sprint",
+			DataType: "ITERATION",
+			Iterations: []api.Iteration{
+				{ID: "it-1", Title: "Sprint 1", StartDate: "2024-01-01", Duration: 14},
+				{ID: "it-2", Title: "Sprint 2", StartDate: "2024-01-15", Duration: 14},
+				{ID: "it-3", Title: "Sprint 3", StartDate: "2024-01-29", Duration: 14},
+			},
+		},
+	}
+}
+
+func TestResolveIteration(t *testing.T) {
+	now := time.Date(2024, 1, 20, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		spec      string
+		wantTitle string
+		wantErr   string
+	}{
+		{"current", "Sprint 2", ""},
+		{"next", "Sprint 3", ""},
+		{"sprint 1", "Sprint 1", ""},
+		{"Sprint 9", "", "not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			field, it, err := resolveIteration(newIterationTestFields(), "iteration", tt.spec, now)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if field != "Sprint" || it.Title != tt.wantTitle {
+				t.Errorf("resolveIteration(%q) = %s/%s, want Sprint/%s", tt.spec, field, it.Title, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestResolveIteration_IterationBoundaries(t *testing.T) {
+	// Last day of Sprint 1 is Jan 14; Jan 15 belongs to Sprint 2
+	_, it, err := resolveIteration(newIterationTestFields(), "", "current", time.Date(2024, 1, 14, 23, 0, 0, 0, time.UTC))
+	if err != nil || it.Title != "Sprint 1" {
+		t.Errorf("Expected Sprint 1 on its last day, got %q (%v)", it.Title, err)
+	}
+
+	_, it, err = resolveIteration(newIterationTestFields(), "", "current", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil || it.Title != "Sprint 2" {
+		t.Errorf("Expected Sprint 2 on its first day, got %q (%v)", it.Title, err)
+	}
+}
+
+func TestResolveIteration_Errors(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, _, err := resolveIteration([]api.ProjectField{{Name: "Status", DataType: "SINGLE_SELECT"}}, "", "current", now); err == nil {
+		t.Error("Expected error when project has no iteration field")
+	}
+	if _, _, err := resolveIteration(newIterationTestFields(), "", "current", now); err == nil {
+		t.Error("Expected error when no iteration is active")
+	}
+	if _, _, err := resolveIteration(newIterationTestFields(), "", "next", now); err == nil {
+		t.Error("Expected error when no iteration is upcoming")
+	}
+}
+
+func TestResolveIteration_PrefersConfiguredField(t *testing.T) {
+	fields := append(newIterationTestFields(), api.ProjectField{
+		Name:       "Release",
+		DataType:   "ITERATION",
+		Iterations: []api.Iteration{{Title: "R1", StartDate: "2024-01-01", Duration: 90}},
+	})
+
+	field, it, err := resolveIteration(fields, "Release", "current", time.Date(2024, 1, 20, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if field != "Release" || it.Title != "R1" {
+		t.Errorf("Expected Release/R1, got %s/%s", field, it.Title)
+	}
+}
+
+// mockBatchReader serves items in pages of two and counts the pages read
+type mockBatchReader struct {
+	items []api.ProjectItem
+	pages int
+}
+
+func (m *mockBatchReader) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockBatchReader) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func (m *mockBatchReader) EachProjectItemsBatch(projectID string, filter *api.ProjectItemsFilter, fn func(batch []api.ProjectItem) error) error {
+	for i := 0; i < len(m.items); i += 2 {
+		m.pages++
+		end := i + 2
+		if end > len(m.items) {
+			end = len(m.items)
+		}
+		if err := fn(m.items[i:end]); err != nil {
+			if err == api.ErrStopBatches {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func TestFetchListItems_FiltersEachPage(t *testing.T) {
+	reader := &mockBatchReader{}
+	for i := 1; i <= 8; i++ {
+		state := "OPEN"
+		if i%2 == 0 {
+			state = "CLOSED"
+		}
+		reader.items = append(reader.items, api.ProjectItem{Issue: &api.Issue{Number: i, State: state}})
+	}
+	keep := func(items []api.ProjectItem) []api.ProjectItem { return filterByState(items, "open") }
+
+	items, err := fetchListItems(reader, "proj-1", nil, keep, 0)
+	if err != nil {
+		t.Fatalf("fetchListItems() error = %v", err)
+	}
+	if len(items) != 4 || reader.pages != 4 {
+		t.Errorf("Expected 4 open items from 4 pages, got %d items from %d pages", len(items), reader.pages)
+	}
+
+	// Fetching stops once enough items match
+	reader.pages = 0
+	items, err = fetchListItems(reader, "proj-1", nil, keep, 2)
+	if err != nil {
+		t.Fatalf("fetchListItems() error = %v", err)
+	}
+	if len(items) != 2 || reader.pages != 2 {
+		t.Errorf("Expected to stop after 2 pages, got %d items from %d pages", len(items), reader.pages)
+	}
+}
+
+func TestListStopAt(t *testing.T) {
+	if got := listStopAt(&listOptions{limit: 10}); got != 10 {
+		t.Errorf("listStopAt() = %d, want 10", got)
+	}
+	if got := listStopAt(&listOptions{limit: 10, sort: "priority"}); got != 0 {
+		t.Errorf("listStopAt() with sort = %d, want 0", got)
+	}
+}
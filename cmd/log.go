@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/worklog"
+	"github.com/spf13/cobra"
+)
+
+// defaultTimeFieldName is the number field time is accumulated into when
+// no "time" field is configured
+const defaultTimeFieldName = "Time Spent"
+
+type logOptions struct {
+	local bool
+}
+
+// logClient defines the interface for API methods used by log.
+// This allows for easier testing with mock implementations.
+type logClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
+func newLogCommand() *cobra.Command {
+	opts := &logOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "log <issue> <duration> [note]",
+		Short: "Log time spent on an issue",
+		Long: `Log time spent on an issue.
+
+Each entry is kept in a local worklog, per project, in the user config
+directory; 'gh pmu report time' summarizes it. The time is also added,
+in hours, to the issue's "Time Spent" number field in the project. Map
+a different field with fields.time in .gh-pmu.yml, or use --local to
+only record the entry locally.
+
+Durations are written like 2h, 45m, or 1h30m.`,
+		Example: `  gh pmu log 42 2h "debugging"
+  gh pmu log owner/repo#42 45m
+  gh pmu log 42 1h30m --local`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := loadWorklogConfig()
+			if err != nil {
+				return err
+			}
+			return runLogWithDeps(cmd, args, opts, cfg, path, api.NewClient(), time.Now())
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.local, "local", false, "Only record the entry locally; do not update the project field")
+
+	return cmd
+}
+
+// loadWorklogConfig loads the project config and the location of its worklog file
+func loadWorklogConfig() (*config.Config, string, error) {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return nil, "", err
+	}
+
+	path, err := worklog.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, "", err
+	}
+	return cfg, path, nil
+}
+
+// timeFieldName returns the number field that logged time is added to
+func timeFieldName(cfg *config.Config) string {
+	if _, ok := cfg.Fields["time"]; ok {
+		return cfg.GetFieldName("time")
+	}
+	return defaultTimeFieldName
+}
+
+// runLogWithDeps is the testable implementation of log
+func runLogWithDeps(cmd *cobra.Command, args []string, opts *logOptions, cfg *config.Config, path string, client logClient, now time.Time) error {
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	minutes, err := worklog.ParseDuration(args[1])
+	if err != nil {
+		return err
+	}
+	note := strings.TrimSpace(strings.Join(args[2:], " "))
+
+	return recordWorklog(cmd, cfg, path, client, key, minutes, note, opts.local, now)
+}
+
+// recordWorklog adds an entry to the local worklog and, unless local is
+// set, mirrors the time into the issue's time field
+func recordWorklog(cmd *cobra.Command, cfg *config.Config, path string, client logClient, key string, minutes int, note string, local bool, now time.Time) error {
+	log, err := worklog.Load(path)
+	if err != nil {
+		return err
+	}
+	log.Project = fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number)
+
+	log.Add(key, minutes, note, now)
+	if err := log.Save(path); err != nil {
+		return err
+	}
+
+	cmd.Printf("Logged %s on %s (total %s)\n", worklog.FormatMinutes(minutes), key, worklog.FormatMinutes(log.Total(key)))
+
+	if local {
+		return nil
+	}
+
+	// The local entry is the record; the project field is a best-effort mirror
+	fieldName := timeFieldName(cfg)
+	total, err := addProjectTime(cfg, client, key, fieldName, minutes)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v; time recorded locally only\n", err)
+		return nil
+	}
+	cmd.Printf("  • %s → %s\n", fieldName, total)
+	return nil
+}
+
+// addProjectTime adds minutes, in hours, to the issue's time field and
+// returns the new field value
+func addProjectTime(cfg *config.Config, client logClient, key, fieldName string, minutes int) (string, error) {
+	owner, repo, number, err := parseIssueReference(key)
+	if err != nil {
+		return "", err
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return "", fmt.Errorf("failed to get project: %w", err)
+	}
+
+	item, err := client.GetIssueProjectItem(owner, repo, number, project.ID)
+	if err != nil {
+		return "", err
+	}
+	if item == nil {
+		return "", fmt.Errorf("%s is not in the project", key)
+	}
+
+	var current float64
+	for _, fv := range item.FieldValues {
+		if strings.EqualFold(fv.Field, fieldName) {
+			if v, err := strconv.ParseFloat(fv.Value, 64); err == nil {
+				current = v
+			}
+			break
+		}
+	}
+
+	hours := math.Round((current+float64(minutes)/60)*100) / 100
+	value := strconv.FormatFloat(hours, 'f', -1, 64)
+	if err := client.SetProjectItemField(project.ID, item.ID, fieldName, value); err != nil {
+		return "", fmt.Errorf("failed to update %s: %w", fieldName, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/worklog"
+)
+
+// mockLogClient implements logClient interface for testing
+type mockLogClient struct {
+	item   *api.ProjectItem
+	setErr error
+
+	setField string
+	setValue string
+}
+
+func (m *mockLogClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockLogClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	return m.item, nil
+}
+
+func (m *mockLogClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	if m.setErr != nil {
+		return m.setErr
+	}
+	m.setField = fieldName
+	m.setValue = value
+	return nil
+}
+
+func TestLogCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"log"})
+	if err != nil || sub.Name() != "log" {
+		t.Fatalf("log command not found: %v", err)
+	}
+	if sub.Flags().Lookup("local") == nil {
+		t.Error("Expected --local flag")
+	}
+}
+
+func TestRunLog_AddsToTimeField(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "worklog.json")
+	cfg := newTestConfig()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	client := &mockLogClient{item: &api.ProjectItem{
+		ID:          "item-42",
+		FieldValues: []api.FieldValue{{Field: "Time Spent", Value: "1.5"}},
+	}}
+
+	cmd, buf := newTestCmd()
+	if err := runLogWithDeps(cmd, []string{"42", "2h", "debugging", "flaky", "test"}, &logOptions{}, cfg, path, client, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if client.setField != "Time Spent" || client.setValue != "3.5" {
+		t.Errorf("Expected Time Spent → 3.5, got %s → %s", client.setField, client.setValue)
+	}
+	if out := buf.String(); !strings.Contains(out, "Logged 2h on owner/repo#42 (total 2h)") || !strings.Contains(out, "Time Spent → 3.5") {
+		t.Errorf("Unexpected output: %s", out)
+	}
+
+	log, err := worklog.Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(log.Entries) != 1 || log.Entries[0].Minutes != 120 || log.Entries[0].Note != "debugging flaky test" {
+		t.Errorf("Unexpected entries: %+v", log.Entries)
+	}
+	if log.Project != "owner/1" {
+		t.Errorf("Expected project owner/1, got %q", log.Project)
+	}
+}
+
+func TestRunLog_ConfiguredField(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{"time": {Field: "Hours"}}
+	client := &mockLogClient{item: &api.ProjectItem{ID: "item-42"}}
+
+	cmd, _ := newTestCmd()
+	if err := runLogWithDeps(cmd, []string{"42", "20m"}, &logOptions{}, cfg, filepath.Join(t.TempDir(), "w.json"), client, time.Now()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.setField != "Hours" || client.setValue != "0.33" {
+		t.Errorf("Expected Hours → 0.33, got %s → %s", client.setField, client.setValue)
+	}
+}
+
+func TestRunLog_LocalOnly(t *testing.T) {
+	client := &mockLogClient{item: &api.ProjectItem{ID: "item-42"}}
+
+	cmd, _ := newTestCmd()
+	if err := runLogWithDeps(cmd, []string{"42", "1h"}, &logOptions{local: true}, newTestConfig(), filepath.Join(t.TempDir(), "w.json"), client, time.Now()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.setField != "" {
+		t.Errorf("Expected no field update with --local, got %s", client.setField)
+	}
+}
+
+func TestRunLog_FieldFailureKeepsLocalEntry(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "worklog.json")
+	client := &mockLogClient{item: &api.ProjectItem{ID: "item-42"}, setErr: errors.New(`field "Time Spent" not found in project`)}
+
+	cmd, _ := newTestCmd()
+	if err := runLogWithDeps(cmd, []string{"42", "1h"}, &logOptions{}, newTestConfig(), path, client, time.Now()); err != nil {
+		t.Fatalf("Expected field failure to be a warning, got %v", err)
+	}
+
+	log, err := worklog.Load(path)
+	if err != nil || len(log.Entries) != 1 {
+		t.Errorf("Expected the local entry to be kept, got %+v, %v", log, err)
+	}
+}
+
+func TestRunLog_InvalidDuration(t *testing.T) {
+	cmd, _ := newTestCmd()
+	err := runLogWithDeps(cmd, []string{"42", "soon"}, &logOptions{}, newTestConfig(), filepath.Join(t.TempDir(), "w.json"), &mockLogClient{}, time.Now())
+	if err == nil || !strings.Contains(err.Error(), "invalid duration") {
+		t.Errorf("Expected invalid duration error, got %v", err)
+	}
+}
@@ -0,0 +1,541 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/mcp"
+	"github.com/spf13/cobra"
+)
+
+type mcpOptions struct {
+	readOnly bool
+}
+
+// mcpClient defines the interface for API methods used by the MCP server.
+// This allows for easier testing with mock implementations.
+type mcpClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetRepositoryIssues(owner, repo, state string) ([]api.Issue, error)
+	AddIssueToProject(projectID, issueID string) (string, error)
+	AddLabelToIssue(issueID, labelName string) error
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
+func newMCPCommand() *cobra.Command {
+	opts := &mcpOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "mcp",
+		Short: "Run a Model Context Protocol server over stdio",
+		Long: `Run a Model Context Protocol (MCP) server on stdin/stdout.
+
+The server exposes project operations as MCP tools so AI coding assistants
+can read and update the board. It uses the same .gh-pmu.yml configuration
+and gh authentication as the CLI, so field aliases and repositories behave
+exactly as they do on the command line.
+
+Tools:
+  list_items     List project items, filtered by status, priority, assignee, label, or repo
+  view_item      Show an issue with its project field values
+  update_item    Set project fields on an issue (not available with --read-only)
+  list_triage    List configured triage rules and the issues each would match
+  apply_triage   Apply a triage rule to matching issues (not available with --read-only)
+
+Use --read-only to expose only the tools that do not modify anything.`,
+		Example: `  # Register with an MCP client (command + args)
+  gh pmu mcp
+
+  # Expose read-only tools
+  gh pmu mcp --read-only`,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{longRunningAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMCP(cmd, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.readOnly, "read-only", false, "Only expose tools that do not modify issues or the project")
+
+	return cmd
+}
+
+func runMCP(cmd *cobra.Command, opts *mcpOptions) error {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient()
+
+	server := newMCPServer(opts, cfg, client)
+	return runUntilDone(cmd.Context(), func() error {
+		return server.Serve(cmd.InOrStdin(), cmd.OutOrStdout())
+	})
+}
+
+// newMCPServer creates an MCP server with the project tools registered
+func newMCPServer(opts *mcpOptions, cfg *config.Config, client mcpClient) *mcp.Server {
+	tools := &mcpTools{cfg: cfg, client: client}
+	server := mcp.NewServer("gh-pmu", version)
+
+	server.AddTool(mcp.Tool{
+		Name:        "list_items",
+		Description: "List issues in the project with their field values. Status and priority accept config aliases.",
+		InputSchema: mcpObjectSchema(map[string]string{
+			"status":   "Filter by status (e.g. in_progress)",
+			"priority": "Filter by priority (e.g. p1)",
+			"assignee": "Filter by assignee login",
+			"label":    "Filter by label name",
+			"repo":     "Filter by repository (owner/repo)",
+		}),
+		Handler: tools.listItems,
+	})
+
+	server.AddTool(mcp.Tool{
+		Name:        "view_item",
+		Description: "Show an issue with its body, labels, assignees, and project field values.",
+		InputSchema: mcpObjectSchema(map[string]string{
+			"issue": "Issue reference: 42, #42, owner/repo#42, or URL",
+		}, "issue"),
+		Handler: tools.viewItem,
+	})
+
+	server.AddTool(mcp.Tool{
+		Name:        "list_triage",
+		Description: "List configured triage rules with the issues each rule currently matches.",
+		InputSchema: mcpObjectSchema(map[string]string{
+			"rule": "Only evaluate this triage rule",
+			"repo": "Only search this repository (owner/repo)",
+		}),
+		Handler: tools.listTriage,
+	})
+
+	if opts.readOnly {
+		return server
+	}
+
+	server.AddTool(mcp.Tool{
+		Name:        "update_item",
+		Description: "Set project fields on an issue that is in the project. Field names and values accept config aliases.",
+		InputSchema: map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"issue":    map[string]interface{}{"type": "string", "description": "Issue reference: 42, #42, owner/repo#42, or URL"},
+				"status":   map[string]interface{}{"type": "string", "description": "New status"},
+				"priority": map[string]interface{}{"type": "string", "description": "New priority"},
+				"fields": map[string]interface{}{
+					"type":                 "object",
+					"description":          "Other fields to set, keyed by field name or alias",
+					"additionalProperties": map[string]interface{}{"type": "string"},
+				},
+			},
+			"required": []string{"issue"},
+		},
+		Handler: tools.updateItem,
+	})
+
+	server.AddTool(mcp.Tool{
+		Name:        "apply_triage",
+		Description: "Apply a configured triage rule to every matching issue, or to a single issue.",
+		InputSchema: mcpObjectSchema(map[string]string{
+			"rule":  "Triage rule name from the config",
+			"issue": "Only apply to this issue (must match the rule's query)",
+			"repo":  "Only search this repository (owner/repo)",
+		}, "rule"),
+		Handler: tools.applyTriage,
+	})
+
+	return server
+}
+
+// mcpObjectSchema builds a JSON schema for an object of string properties
+func mcpObjectSchema(properties map[string]string, required ...string) map[string]interface{} {
+	props := make(map[string]interface{}, len(properties))
+	for name, desc := range properties {
+		props[name] = map[string]interface{}{"type": "string", "description": desc}
+	}
+
+	schema := map[string]interface{}{
+		"type":       "object",
+		"properties": props,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// mcpTools implements the MCP tool handlers, caching the project between calls
+type mcpTools struct {
+	cfg     *config.Config
+	client  mcpClient
+	project *api.Project
+}
+
+func (t *mcpTools) getProject() (*api.Project, error) {
+	if t.project != nil {
+		return t.project, nil
+	}
+
+	project, err := t.client.GetProject(t.cfg.Project.Owner, t.cfg.Project.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	t.project = project
+	return project, nil
+}
+
+// mcpItem is the JSON representation of a project item returned by tools
+type mcpItem struct {
+	Number     int               `json:"number"`
+	Title      string            `json:"title"`
+	State      string            `json:"state"`
+	URL        string            `json:"url"`
+	Repository string            `json:"repository"`
+	Assignees  []string          `json:"assignees"`
+	Labels     []string          `json:"labels"`
+	Fields     map[string]string `json:"fields"`
+	Body       string            `json:"body,omitempty"`
+}
+
+func toMCPItem(issue *api.Issue, fieldValues []api.FieldValue) mcpItem {
+	item := mcpItem{
+		Number:     issue.Number,
+		Title:      issue.Title,
+		State:      issue.State,
+		URL:        issue.URL,
+		Repository: issue.Repository.Owner + "/" + issue.Repository.Name,
+		Assignees:  make([]string, 0, len(issue.Assignees)),
+		Labels:     make([]string, 0, len(issue.Labels)),
+		Fields:     make(map[string]string, len(fieldValues)),
+	}
+	for _, a := range issue.Assignees {
+		item.Assignees = append(item.Assignees, a.Login)
+	}
+	for _, l := range issue.Labels {
+		item.Labels = append(item.Labels, l.Name)
+	}
+	for _, fv := range fieldValues {
+		item.Fields[fv.Field] = fv.Value
+	}
+	return item
+}
+
+func (t *mcpTools) listItems(raw json.RawMessage) (string, error) {
+	var args struct {
+		Status   string `json:"status"`
+		Priority string `json:"priority"`
+		Assignee string `json:"assignee"`
+		Label    string `json:"label"`
+		Repo     string `json:"repo"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	project, err := t.getProject()
+	if err != nil {
+		return "", err
+	}
+
+	var filter *api.ProjectItemsFilter
+	if args.Repo != "" {
+		filter = &api.ProjectItemsFilter{Repository: args.Repo}
+	}
+
+	items, err := t.client.GetProjectItems(project.ID, filter)
+	if err != nil {
+		return "", fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	if args.Status != "" {
+		items = filterByFieldValue(items, t.cfg.GetFieldName("status"), t.cfg.ResolveFieldValue("status", args.Status))
+	}
+	if args.Priority != "" {
+		items = filterByFieldValue(items, t.cfg.GetFieldName("priority"), t.cfg.ResolveFieldValue("priority", args.Priority))
+	}
+	if args.Assignee != "" {
+		items = filterByAssignee(items, args.Assignee)
+	}
+	if args.Label != "" {
+		items = filterByLabel(items, args.Label)
+	}
+
+	out := make([]mcpItem, 0, len(items))
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		out = append(out, toMCPItem(item.Issue, item.FieldValues))
+	}
+
+	return mcpJSON(out)
+}
+
+func (t *mcpTools) viewItem(raw json.RawMessage) (string, error) {
+	var args struct {
+		Issue string `json:"issue"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	owner, repo, number, err := t.resolveIssue(args.Issue)
+	if err != nil {
+		return "", err
+	}
+
+	issue, err := t.client.GetIssue(owner, repo, number)
+	if err != nil {
+		return "", fmt.Errorf("failed to get issue: %w", err)
+	}
+	if issue.Repository.Owner == "" {
+		issue.Repository = api.Repository{Owner: owner, Name: repo}
+	}
+
+	var fieldValues []api.FieldValue
+	if item, err := t.findItem(owner, repo, number); err == nil {
+		fieldValues = item.FieldValues
+	}
+
+	out := toMCPItem(issue, fieldValues)
+	out.Body = issue.Body
+	return mcpJSON(out)
+}
+
+func (t *mcpTools) updateItem(raw json.RawMessage) (string, error) {
+	var args struct {
+		Issue    string            `json:"issue"`
+		Status   string            `json:"status"`
+		Priority string            `json:"priority"`
+		Fields   map[string]string `json:"fields"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	updates := make(map[string]string, len(args.Fields)+2)
+	for k, v := range args.Fields {
+		updates[k] = v
+	}
+	if args.Status != "" {
+		updates["status"] = args.Status
+	}
+	if args.Priority != "" {
+		updates["priority"] = args.Priority
+	}
+	if len(updates) == 0 {
+		return "", fmt.Errorf("no fields to update: set status, priority, or fields")
+	}
+
+	owner, repo, number, err := t.resolveIssue(args.Issue)
+	if err != nil {
+		return "", err
+	}
+
+	item, err := t.findItem(owner, repo, number)
+	if err != nil {
+		return "", err
+	}
+
+	project, err := t.getProject()
+	if err != nil {
+		return "", err
+	}
+
+	// Apply in a stable order so partial failures are predictable
+	keys := make([]string, 0, len(updates))
+	for k := range updates {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	applied := make(map[string]string, len(keys))
+	for _, key := range keys {
+		fieldName := t.cfg.GetFieldName(key)
+		value := t.cfg.ResolveFieldValue(key, updates[key])
+		if err := t.client.SetProjectItemField(project.ID, item.ID, fieldName, value); err != nil {
+			return "", fmt.Errorf("failed to set %s: %w", fieldName, err)
+		}
+		applied[fieldName] = value
+	}
+
+	return mcpJSON(map[string]interface{}{
+		"issue":   fmt.Sprintf("%s/%s#%d", owner, repo, number),
+		"updated": applied,
+	})
+}
+
+// mcpTriageRule is the JSON representation of a triage rule and its matches
+type mcpTriageRule struct {
+	Name    string            `json:"name"`
+	Query   string            `json:"query"`
+	Labels  []string          `json:"labels,omitempty"`
+	Fields  map[string]string `json:"fields,omitempty"`
+	Matches []string          `json:"matches"`
+}
+
+func (t *mcpTools) listTriage(raw json.RawMessage) (string, error) {
+	var args struct {
+		Rule string `json:"rule"`
+		Repo string `json:"repo"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	names := make([]string, 0, len(t.cfg.Triage))
+	if args.Rule != "" {
+		if _, ok := t.cfg.Triage[args.Rule]; !ok {
+			return "", fmt.Errorf("triage rule %q not found in configuration", args.Rule)
+		}
+		names = append(names, args.Rule)
+	} else {
+		for name := range t.cfg.Triage {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	out := make([]mcpTriageRule, 0, len(names))
+	for _, name := range names {
+		tc := t.cfg.Triage[name]
+		issues, err := t.triageMatches(tc.Query, args.Repo)
+		if err != nil {
+			return "", err
+		}
+
+		rule := mcpTriageRule{
+			Name:    name,
+			Query:   tc.Query,
+			Labels:  tc.Apply.Labels,
+			Fields:  tc.Apply.Fields,
+			Matches: make([]string, 0, len(issues)),
+		}
+		for _, issue := range issues {
+			rule.Matches = append(rule.Matches, fmt.Sprintf("%s/%s#%d", issue.Repository.Owner, issue.Repository.Name, issue.Number))
+		}
+		out = append(out, rule)
+	}
+
+	return mcpJSON(out)
+}
+
+func (t *mcpTools) applyTriage(raw json.RawMessage) (string, error) {
+	var args struct {
+		Rule  string `json:"rule"`
+		Issue string `json:"issue"`
+		Repo  string `json:"repo"`
+	}
+	if err := json.Unmarshal(raw, &args); err != nil {
+		return "", fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	tc, ok := t.cfg.Triage[args.Rule]
+	if !ok {
+		return "", fmt.Errorf("triage rule %q not found in configuration", args.Rule)
+	}
+
+	issues, err := t.triageMatches(tc.Query, args.Repo)
+	if err != nil {
+		return "", err
+	}
+
+	if args.Issue != "" {
+		owner, repo, number, err := t.resolveIssue(args.Issue)
+		if err != nil {
+			return "", err
+		}
+		var selected []api.Issue
+		for _, issue := range issues {
+			if issue.Number == number && strings.EqualFold(issue.Repository.Owner, owner) && strings.EqualFold(issue.Repository.Name, repo) {
+				selected = append(selected, issue)
+			}
+		}
+		if len(selected) == 0 {
+			return "", fmt.Errorf("issue %s/%s#%d does not match triage rule %q", owner, repo, number, args.Rule)
+		}
+		issues = selected
+	}
+
+	project, err := t.getProject()
+	if err != nil {
+		return "", err
+	}
+
+	applied := make([]string, 0, len(issues))
+	for i := range issues {
+		if err := applyTriageRules(t.client, t.cfg, project, &issues[i], &tc); err != nil {
+			return "", fmt.Errorf("failed to triage #%d: %w", issues[i].Number, err)
+		}
+		applied = append(applied, fmt.Sprintf("%s/%s#%d", issues[i].Repository.Owner, issues[i].Repository.Name, issues[i].Number))
+	}
+
+	return mcpJSON(map[string]interface{}{
+		"rule":    args.Rule,
+		"applied": applied,
+	})
+}
+
+// triageMatches returns issues matching a triage query, with the
+// repository filled in from the repository that was searched
+func (t *mcpTools) triageMatches(query, targetRepo string) ([]api.Issue, error) {
+	return searchIssuesForTriage(t.client, t.cfg, query, targetRepo)
+}
+
+// resolveIssue parses an issue reference, defaulting to the first configured repository
+func (t *mcpTools) resolveIssue(ref string) (owner, repo string, number int, err error) {
+	if ref == "" {
+		return "", "", 0, fmt.Errorf("issue is required")
+	}
+
+	owner, repo, number, err = parseIssueReference(ref)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	if owner == "" || repo == "" {
+		if len(t.cfg.Repositories) == 0 {
+			return "", "", 0, fmt.Errorf("no repository specified and none configured")
+		}
+		owner, repo = splitRepository(t.cfg.Repositories[0])
+	}
+
+	return owner, repo, number, nil
+}
+
+// findItem locates the project item for an issue
+func (t *mcpTools) findItem(owner, repo string, number int) (*api.ProjectItem, error) {
+	project, err := t.getProject()
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := t.client.GetProjectItems(project.ID, &api.ProjectItemsFilter{Repository: owner + "/" + repo})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	for i := range items {
+		if items[i].Issue != nil && items[i].Issue.Number == number {
+			return &items[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("issue %s/%s#%d is not in the project", owner, repo, number)
+}
+
+func mcpJSON(v interface{}) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode result: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/mcp"
+)
+
+// mockMCPClient implements mcpClient interface for testing
+type mockMCPClient struct {
+	project     *api.Project
+	items       []api.ProjectItem
+	issue       *api.Issue
+	repoIssues  []api.Issue
+	fieldsSet   map[string]string
+	labelsAdded []string
+	setErr      error
+}
+
+func (m *mockMCPClient) GetProject(owner string, number int) (*api.Project, error) {
+	return m.project, nil
+}
+
+func (m *mockMCPClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func (m *mockMCPClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	if m.issue == nil {
+		return nil, errors.New("not found")
+	}
+	return m.issue, nil
+}
+
+func (m *mockMCPClient) GetRepositoryIssues(owner, repo, state string) ([]api.Issue, error) {
+	return m.repoIssues, nil
+}
+
+func (m *mockMCPClient) AddIssueToProject(projectID, issueID string) (string, error) {
+	return "PVTI_" + issueID, nil
+}
+
+func (m *mockMCPClient) AddLabelToIssue(issueID, labelName string) error {
+	m.labelsAdded = append(m.labelsAdded, labelName)
+	return nil
+}
+
+func (m *mockMCPClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	if m.setErr != nil {
+		return m.setErr
+	}
+	if m.fieldsSet == nil {
+		m.fieldsSet = make(map[string]string)
+	}
+	m.fieldsSet[itemID+"/"+fieldName] = value
+	return nil
+}
+
+func newMCPTestConfig() *config.Config {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{
+		"status":   {Field: "Status", Values: map[string]string{"in_progress": "In progress", "backlog": "Backlog"}},
+		"priority": {Field: "Priority", Values: map[string]string{"p1": "P1"}},
+	}
+	cfg.Triage = map[string]config.Triage{
+		"new": {
+			Query: "is:open -label:triaged",
+			Apply: config.TriageApply{Labels: []string{"triaged"}, Fields: map[string]string{"status": "backlog"}},
+		},
+	}
+	return cfg
+}
+
+func newMCPTestClient() *mockMCPClient {
+	repo := api.Repository{Owner: "owner", Name: "repo"}
+	return &mockMCPClient{
+		project: &api.Project{ID: "PVT_1"},
+		items: []api.ProjectItem{
+			{
+				ID:          "PVTI_1",
+				Issue:       &api.Issue{Number: 1, Title: "One", Repository: repo},
+				FieldValues: []api.FieldValue{{Field: "Status", Value: "In progress"}},
+			},
+			{
+				ID:          "PVTI_2",
+				Issue:       &api.Issue{Number: 2, Title: "Two", Repository: repo},
+				FieldValues: []api.FieldValue{{Field: "Status", Value: "Backlog"}},
+			},
+		},
+		issue: &api.Issue{Number: 1, Title: "One", Body: "Details", Repository: repo},
+		repoIssues: []api.Issue{
+			{ID: "I_5", Number: 5, State: "OPEN"},
+			{ID: "I_6", Number: 6, State: "OPEN", Labels: []api.Label{{Name: "triaged"}}},
+		},
+	}
+}
+
+// callMCPTool invokes a tool through the server and returns its text and error flag
+func callMCPTool(t *testing.T, server *mcp.Server, name string, args map[string]interface{}) (string, bool) {
+	t.Helper()
+
+	params, _ := json.Marshal(map[string]interface{}{"name": name, "arguments": args})
+	msg, _ := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": "tools/call", "params": json.RawMessage(params)})
+
+	var resp struct {
+		Result struct {
+			Content []struct {
+				Text string `json:"text"`
+			} `json:"content"`
+			IsError bool `json:"isError"`
+		} `json:"result"`
+		Error *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(server.HandleMessage(msg), &resp); err != nil {
+		t.Fatalf("invalid response: %v", err)
+	}
+	if resp.Error != nil {
+		t.Fatalf("Unexpected protocol error: %s", resp.Error.Message)
+	}
+	return resp.Result.Content[0].Text, resp.Result.IsError
+}
+
+func TestMCPCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"mcp"})
+	if err != nil {
+		t.Fatalf("mcp command not found: %v", err)
+	}
+	if sub.Flags().Lookup("read-only") == nil {
+		t.Error("Expected --read-only flag to exist")
+	}
+}
+
+func TestNewMCPServer_ReadOnlyOmitsMutatingTools(t *testing.T) {
+	full := newMCPServer(&mcpOptions{}, newMCPTestConfig(), newMCPTestClient())
+	readOnly := newMCPServer(&mcpOptions{readOnly: true}, newMCPTestConfig(), newMCPTestClient())
+
+	if len(full.Tools()) != 5 {
+		t.Errorf("Expected 5 tools, got %d", len(full.Tools()))
+	}
+	for _, tool := range readOnly.Tools() {
+		if tool.Name == "update_item" || tool.Name == "apply_triage" {
+			t.Errorf("Expected %s to be omitted in read-only mode", tool.Name)
+		}
+	}
+}
+
+func TestMCPListItems_FiltersWithAliases(t *testing.T) {
+	server := newMCPServer(&mcpOptions{}, newMCPTestConfig(), newMCPTestClient())
+
+	text, isErr := callMCPTool(t, server, "list_items", map[string]interface{}{"status": "in_progress"})
+	if isErr {
+		t.Fatalf("Unexpected tool error: %s", text)
+	}
+
+	var items []mcpItem
+	if err := json.Unmarshal([]byte(text), &items); err != nil {
+		t.Fatalf("invalid items JSON: %v", err)
+	}
+	if len(items) != 1 || items[0].Number != 1 || items[0].Repository != "owner/repo" {
+		t.Errorf("Unexpected items: %+v", items)
+	}
+}
+
+func TestMCPViewItem(t *testing.T) {
+	server := newMCPServer(&mcpOptions{}, newMCPTestConfig(), newMCPTestClient())
+
+	text, isErr := callMCPTool(t, server, "view_item", map[string]interface{}{"issue": "#1"})
+	if isErr {
+		t.Fatalf("Unexpected tool error: %s", text)
+	}
+
+	var item mcpItem
+	if err := json.Unmarshal([]byte(text), &item); err != nil {
+		t.Fatalf("invalid item JSON: %v", err)
+	}
+	if item.Body != "Details" || item.Fields["Status"] != "In progress" {
+		t.Errorf("Unexpected item: %+v", item)
+	}
+}
+
+func TestMCPUpdateItem(t *testing.T) {
+	client := newMCPTestClient()
+	server := newMCPServer(&mcpOptions{}, newMCPTestConfig(), client)
+
+	text, isErr := callMCPTool(t, server, "update_item", map[string]interface{}{
+		"issue":    "2",
+		"status":   "in_progress",
+		"priority": "p1",
+	})
+	if isErr {
+		t.Fatalf("Unexpected tool error: %s", text)
+	}
+
+	if client.fieldsSet["PVTI_2/Status"] != "In progress" || client.fieldsSet["PVTI_2/Priority"] != "P1" {
+		t.Errorf("Unexpected fields set: %v", client.fieldsSet)
+	}
+}
+
+func TestMCPUpdateItem_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    map[string]interface{}
+		setErr  error
+		wantErr string
+	}{
+		{"no fields", map[string]interface{}{"issue": "1"}, nil, "no fields to update"},
+		{"missing issue", map[string]interface{}{"status": "done"}, nil, "issue is required"},
+		{"not in project", map[string]interface{}{"issue": "99", "status": "done"}, nil, "not in the project"},
+		{"api error", map[string]interface{}{"issue": "1", "status": "done"}, errors.New("boom"), "failed to set Status"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newMCPTestClient()
+			client.setErr = tt.setErr
+			server := newMCPServer(&mcpOptions{}, newMCPTestConfig(), client)
+
+			text, isErr := callMCPTool(t, server, "update_item", tt.args)
+			if !isErr || !strings.Contains(text, tt.wantErr) {
+				t.Errorf("Expected tool error containing %q, got %q (isError=%v)", tt.wantErr, text, isErr)
+			}
+		})
+	}
+}
+
+func TestMCPListTriage(t *testing.T) {
+	server := newMCPServer(&mcpOptions{readOnly: true}, newMCPTestConfig(), newMCPTestClient())
+
+	text, isErr := callMCPTool(t, server, "list_triage", nil)
+	if isErr {
+		t.Fatalf("Unexpected tool error: %s", text)
+	}
+
+	var rules []mcpTriageRule
+	if err := json.Unmarshal([]byte(text), &rules); err != nil {
+		t.Fatalf("invalid triage JSON: %v", err)
+	}
+	if len(rules) != 1 || len(rules[0].Matches) != 1 || rules[0].Matches[0] != "owner/repo#5" {
+		t.Errorf("Unexpected rules: %+v", rules)
+	}
+
+	text, isErr = callMCPTool(t, server, "list_triage", map[string]interface{}{"rule": "missing"})
+	if !isErr || !strings.Contains(text, "not found") {
+		t.Errorf("Expected unknown rule error, got %q", text)
+	}
+}
+
+func TestMCPApplyTriage(t *testing.T) {
+	client := newMCPTestClient()
+	server := newMCPServer(&mcpOptions{}, newMCPTestConfig(), client)
+
+	text, isErr := callMCPTool(t, server, "apply_triage", map[string]interface{}{"rule": "new"})
+	if isErr {
+		t.Fatalf("Unexpected tool error: %s", text)
+	}
+
+	if client.fieldsSet["PVTI_I_5/Status"] != "Backlog" {
+		t.Errorf("Expected Status=Backlog on issue 5, got %v", client.fieldsSet)
+	}
+	if len(client.labelsAdded) != 1 || client.labelsAdded[0] != "triaged" {
+		t.Errorf("Expected triaged label, got %v", client.labelsAdded)
+	}
+}
+
+func TestMCPApplyTriage_IssueMustMatch(t *testing.T) {
+	client := newMCPTestClient()
+	server := newMCPServer(&mcpOptions{}, newMCPTestConfig(), client)
+
+	text, isErr := callMCPTool(t, server, "apply_triage", map[string]interface{}{"rule": "new", "issue": "6"})
+	if !isErr || !strings.Contains(text, "does not match") {
+		t.Errorf("Expected does not match error, got %q", text)
+	}
+	if len(client.fieldsSet) != 0 {
+		t.Errorf("Expected no fields set, got %v", client.fieldsSet)
+	}
+}
@@ -0,0 +1,332 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/mirror"
+	"github.com/spf13/cobra"
+)
+
+// defaultMirrorLogEntries is how many log entries mirror --log shows
+const defaultMirrorLogEntries = 20
+
+type mirrorOptions struct {
+	partner string
+	maps    []string
+	query   string
+	policy  string
+	dryRun  bool
+	log     bool
+}
+
+// mirrorClient defines the interface for API methods used by mirror.
+// This allows for easier testing with mock implementations.
+type mirrorClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	AddIssueToProject(projectID, issueID string) (string, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+	ClearProjectItemField(projectID, itemID, fieldName string) error
+}
+
+func newMirrorCommand() *cobra.Command {
+	opts := &mirrorOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "mirror --partner <owner/number> --map <ours=theirs> --query <query>",
+		Short: "Keep fields in sync with a partner project",
+		Long: `Keep selected items' fields synchronized between this project and a
+partner's project (for example, another organization's board).
+
+Items are selected with --query, as in 'move --query', and added to the
+partner project when missing. Each --map pairs one of our fields with
+one of theirs, as ours:<field>=theirs:<field> (or just <field>=<field>);
+our field may be a configured alias such as status. Values are copied
+as-is, so single-select options must have the same names on both boards.
+
+Each run compares both boards with the values last synchronized: a field
+changed on one side is copied to the other. A field changed on both
+sides is a conflict, settled by --policy:
+  skip     leave both and report it (default)
+  ours     our value wins
+  theirs   the partner's value wins
+  newest   the value set most recently wins, where GitHub reports when
+
+What each run changed is kept in a sync log; show it with --log.`,
+		Example: `  # Share status with a partner org's board
+  gh pmu mirror --partner partner-org/7 --map ours:status=theirs:Stage --query label:shared
+
+  # Several fields, our board wins conflicts
+  gh pmu mirror --partner partner-org/7 --map status=Stage --map Priority=Priority \
+    --query label:shared --policy ours
+
+  # Preview, then show what past runs did
+  gh pmu mirror --partner partner-org/7 --map status=Stage --query label:shared --dry-run
+  gh pmu mirror --partner partner-org/7 --log`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			partnerOwner, partnerNumber, err := parseMirrorPartner(opts.partner)
+			if err != nil {
+				return err
+			}
+			path, err := mirror.DefaultPath(cfg.Project.Owner, cfg.Project.Number, partnerOwner, partnerNumber)
+			if err != nil {
+				return err
+			}
+			return runMirrorWithDeps(cmd, opts, cfg, api.NewClient(), path, time.Now())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.partner, "partner", "", "Partner project as owner/number (required)")
+	cmd.Flags().StringArrayVar(&opts.maps, "map", nil, "Field mapping ours:<field>=theirs:<field> (repeatable)")
+	cmd.Flags().StringVarP(&opts.query, "query", "q", "", "Items to mirror, e.g. label:shared")
+	cmd.Flags().StringVar(&opts.policy, "policy", string(mirror.PolicySkip), "Conflict policy: skip, ours, theirs, or newest")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show what would change without changing it")
+	cmd.Flags().BoolVar(&opts.log, "log", false, "Show the sync log instead of syncing")
+	_ = cmd.MarkFlagRequired("partner")
+
+	return cmd
+}
+
+// mirrorMapping pairs one of our fields with one of the partner's
+type mirrorMapping struct {
+	ours   string
+	theirs string
+}
+
+// key identifies the mapping in the mirror state and log. Field names
+// match case-insensitively, so the key is lowercase.
+func (m mirrorMapping) key() string {
+	return strings.ToLower(m.ours + "=" + m.theirs)
+}
+
+// parseMirrorPartner splits owner/number
+func parseMirrorPartner(value string) (string, int, error) {
+	owner, number, ok := strings.Cut(value, "/")
+	n, err := strconv.Atoi(number)
+	if !ok || owner == "" || err != nil || n <= 0 {
+		return "", 0, fmt.Errorf("invalid --partner %q: expected owner/number", value)
+	}
+	return owner, n, nil
+}
+
+// parseMirrorMapping reads ours:<field>=theirs:<field>; the prefixes are
+// optional and our field may be a configured alias
+func parseMirrorMapping(cfg *config.Config, value string) (mirrorMapping, error) {
+	ours, theirs, ok := strings.Cut(value, "=")
+	ours = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(ours), "ours:"))
+	theirs = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(theirs), "theirs:"))
+	if !ok || ours == "" || theirs == "" {
+		return mirrorMapping{}, fmt.Errorf("invalid --map %q: expected ours:<field>=theirs:<field>", value)
+	}
+	return mirrorMapping{ours: cfg.GetFieldName(ours), theirs: theirs}, nil
+}
+
+// mirrorFieldValue returns an item's value for a field and when it was
+// set, with the field's name as the project spells it when the item has one
+func mirrorFieldValue(item *api.ProjectItem, field string) (string, string, string) {
+	if item != nil {
+		for _, fv := range item.FieldValues {
+			if strings.EqualFold(fv.Field, field) {
+				return fv.Field, fv.Value, fv.UpdatedAt
+			}
+		}
+	}
+	return field, "", ""
+}
+
+// runMirrorWithDeps is the testable implementation of mirror
+func runMirrorWithDeps(cmd *cobra.Command, opts *mirrorOptions, cfg *config.Config, client mirrorClient, path string, now time.Time) error {
+	partnerOwner, partnerNumber, err := parseMirrorPartner(opts.partner)
+	if err != nil {
+		return err
+	}
+	state, err := mirror.Load(path)
+	if err != nil {
+		return err
+	}
+	if opts.log {
+		return outputMirrorLog(cmd, state.Log)
+	}
+
+	policy, err := mirror.ParsePolicy(opts.policy)
+	if err != nil {
+		return err
+	}
+	if len(opts.maps) == 0 {
+		return fmt.Errorf("at least one --map is required")
+	}
+	if strings.TrimSpace(opts.query) == "" {
+		return fmt.Errorf("--query is required to select the items to mirror")
+	}
+	var mappings []mirrorMapping
+	for _, m := range opts.maps {
+		mapping, err := parseMirrorMapping(cfg, m)
+		if err != nil {
+			return err
+		}
+		mappings = append(mappings, mapping)
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	partner, err := client.GetProject(partnerOwner, partnerNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get partner project %s: %w", opts.partner, err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+	selected := filterItemsByQuery(cfg, items, opts.query)
+	if len(selected) == 0 {
+		cmd.Printf("No issues match %q\n", opts.query)
+		return nil
+	}
+
+	partnerItems, err := client.GetProjectItems(partner.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get partner project items: %w", err)
+	}
+	byIssue := make(map[string]*api.ProjectItem)
+	for i := range partnerItems {
+		if partnerItems[i].Issue != nil {
+			byIssue[partnerItems[i].Issue.ID] = &partnerItems[i]
+		}
+	}
+
+	if opts.dryRun {
+		cmd.Println("Dry run - no changes will be made")
+		cmd.Println()
+	}
+
+	var entries []mirror.LogEntry
+	added, pushed, pulled, conflicts, failed := 0, 0, 0, 0, 0
+	for _, item := range selected {
+		issue := fmt.Sprintf("%s/%s#%d", item.Issue.Repository.Owner, item.Issue.Repository.Name, item.Issue.Number)
+
+		theirs := byIssue[item.Issue.ID]
+		if theirs == nil {
+			added++
+			cmd.Printf("+ %s added to %s: %s\n", issue, opts.partner, item.Issue.Title)
+			if !opts.dryRun {
+				itemID, err := client.AddIssueToProject(partner.ID, item.Issue.ID)
+				if err != nil {
+					cmd.PrintErrf("Failed to add %s to the partner project: %v\n", issue, err)
+					failed++
+					continue
+				}
+				theirs = &api.ProjectItem{ID: itemID}
+				entries = append(entries, mirror.LogEntry{Issue: issue, Action: "add"})
+			}
+		}
+
+		for _, m := range mappings {
+			oursField, ours, oursAt := mirrorFieldValue(&item, m.ours)
+			theirsField, theirsValue, theirsAt := mirrorFieldValue(theirs, m.theirs)
+			v := mirror.Values{Ours: ours, Theirs: theirsValue, OursAt: oursAt, TheirsAt: theirsAt}
+			base, hasBase := state.BaseValue(issue, m.key())
+
+			switch mirror.Decide(base, hasBase, v, policy) {
+			case mirror.None:
+				state.SetBase(issue, m.key(), v.Ours)
+			case mirror.Push:
+				cmd.Printf("→ %s %s: %s → %s\n", issue, theirsField, conflictValue(v.Theirs), conflictValue(v.Ours))
+				if !opts.dryRun {
+					if err := setMirrorField(client, partner.ID, theirs.ID, theirsField, v.Ours); err != nil {
+						cmd.PrintErrf("Failed to set %s on %s in the partner project: %v\n", theirsField, issue, err)
+						failed++
+						continue
+					}
+					state.SetBase(issue, m.key(), v.Ours)
+					entries = append(entries, mirror.LogEntry{Issue: issue, Field: m.key(), Action: "push", Old: v.Theirs, New: v.Ours})
+				}
+				pushed++
+			case mirror.Pull:
+				cmd.Printf("← %s %s: %s → %s\n", issue, oursField, conflictValue(v.Ours), conflictValue(v.Theirs))
+				if !opts.dryRun {
+					if err := setMirrorField(client, project.ID, item.ID, oursField, v.Theirs); err != nil {
+						cmd.PrintErrf("Failed to set %s on %s: %v\n", oursField, issue, err)
+						failed++
+						continue
+					}
+					state.SetBase(issue, m.key(), v.Theirs)
+					entries = append(entries, mirror.LogEntry{Issue: issue, Field: m.key(), Action: "pull", Old: v.Ours, New: v.Theirs})
+				}
+				pulled++
+			case mirror.Conflict:
+				cmd.Printf("! %s %s: conflict, ours %s, theirs %s\n", issue, m.key(), conflictValue(v.Ours), conflictValue(v.Theirs))
+				entries = append(entries, mirror.LogEntry{Issue: issue, Field: m.key(), Action: "conflict",
+					Note: fmt.Sprintf("ours %s, theirs %s", conflictValue(v.Ours), conflictValue(v.Theirs))})
+				conflicts++
+			}
+		}
+	}
+
+	if pushed+pulled+added+conflicts+failed > 0 {
+		cmd.Println()
+	}
+	verb := "Synced"
+	if opts.dryRun {
+		verb = "Would sync"
+	}
+	cmd.Printf("%s %d item(s) with %s: %d added, %d pushed, %d pulled, %d conflict(s)", verb, len(selected), opts.partner, added, pushed, pulled, conflicts)
+	if failed > 0 {
+		cmd.Printf(", %d failed", failed)
+	}
+	cmd.Println()
+	if conflicts > 0 && policy == mirror.PolicySkip {
+		cmd.Println("Conflicts were left unchanged; settle them on either board or rerun with --policy")
+	}
+
+	if opts.dryRun {
+		return nil
+	}
+	state.Project = fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number)
+	state.Partner = opts.partner
+	state.Record(entries, now)
+	return state.Save(path)
+}
+
+// setMirrorField sets a field, or clears it when the other board has no value
+func setMirrorField(client mirrorClient, projectID, itemID, field, value string) error {
+	if value == "" {
+		return client.ClearProjectItemField(projectID, itemID, field)
+	}
+	return client.SetProjectItemField(projectID, itemID, field, value)
+}
+
+// outputMirrorLog prints the most recent sync log entries, oldest first
+func outputMirrorLog(cmd *cobra.Command, log []mirror.LogEntry) error {
+	if len(log) == 0 {
+		cmd.Println("No mirror syncs recorded")
+		return nil
+	}
+	if len(log) > defaultMirrorLogEntries {
+		log = log[len(log)-defaultMirrorLogEntries:]
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "AT\tISSUE\tACTION\tFIELD\tCHANGE")
+	for _, e := range log {
+		change := e.Note
+		if e.Action == "push" || e.Action == "pull" {
+			change = conflictValue(e.Old) + " → " + conflictValue(e.New)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", e.At, e.Issue, e.Action, e.Field, change)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/mirror"
+)
+
+// mockMirrorClient implements mirrorClient interface for testing, with the
+// items of our project ("proj-ours") and the partner's ("proj-theirs")
+type mockMirrorClient struct {
+	items   map[string][]api.ProjectItem
+	added   []string
+	set     []string
+	cleared []string
+}
+
+func (m *mockMirrorClient) GetProject(owner string, number int) (*api.Project, error) {
+	if owner == "partner" {
+		return &api.Project{ID: "proj-theirs"}, nil
+	}
+	return &api.Project{ID: "proj-ours"}, nil
+}
+
+func (m *mockMirrorClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items[projectID], nil
+}
+
+func (m *mockMirrorClient) AddIssueToProject(projectID, issueID string) (string, error) {
+	m.added = append(m.added, projectID+":"+issueID)
+	return "new-" + issueID, nil
+}
+
+func (m *mockMirrorClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	m.set = append(m.set, projectID+":"+itemID+":"+fieldName+"="+value)
+	return nil
+}
+
+func (m *mockMirrorClient) ClearProjectItemField(projectID, itemID, fieldName string) error {
+	m.cleared = append(m.cleared, projectID+":"+itemID+":"+fieldName)
+	return nil
+}
+
+func mirrorTestItem(id string, number int, labels []string, fields ...api.FieldValue) api.ProjectItem {
+	issue := &api.Issue{ID: "issue-" + id, Number: number, Title: "Issue " + id, State: "OPEN",
+		Repository: api.Repository{Owner: "owner", Name: "repo"}}
+	for _, l := range labels {
+		issue.Labels = append(issue.Labels, api.Label{Name: l})
+	}
+	return api.ProjectItem{ID: "item-" + id, Issue: issue, FieldValues: fields}
+}
+
+// newMirrorTestClient returns shared #1 and #2 (only #1 on the partner
+// board) and unshared #3
+func newMirrorTestClient() *mockMirrorClient {
+	return &mockMirrorClient{items: map[string][]api.ProjectItem{
+		"proj-ours": {
+			mirrorTestItem("1", 1, []string{"shared"}, api.FieldValue{Field: "Status", Value: "In progress"}),
+			mirrorTestItem("2", 2, []string{"shared"}, api.FieldValue{Field: "Status", Value: "Todo"}),
+			mirrorTestItem("3", 3, nil, api.FieldValue{Field: "Status", Value: "Todo"}),
+		},
+		"proj-theirs": {
+			mirrorTestItem("1", 1, nil, api.FieldValue{Field: "Stage", Value: "Building"}),
+		},
+	}}
+}
+
+func newMirrorTestOptions() *mirrorOptions {
+	return &mirrorOptions{partner: "partner/7", maps: []string{"ours:status=theirs:Stage"}, query: "label:shared", policy: "skip"}
+}
+
+func TestRunMirror_FirstSync(t *testing.T) {
+	client := newMirrorTestClient()
+	path := filepath.Join(t.TempDir(), "mirror.json")
+	cmd, buf := newTestCmd()
+	if err := runMirrorWithDeps(cmd, newMirrorTestOptions(), newTestConfig(), client, path, time.Now()); err != nil {
+		t.Fatalf("runMirrorWithDeps() error = %v", err)
+	}
+
+	// #2 is added to the partner board and its status pushed; #1 differs
+	// on both boards with nothing synchronized yet, so it is a conflict
+	if len(client.added) != 1 || client.added[0] != "proj-theirs:issue-2" {
+		t.Errorf("Unexpected additions: %v", client.added)
+	}
+	if len(client.set) != 1 || client.set[0] != "proj-theirs:new-issue-2:Stage=Todo" {
+		t.Errorf("Unexpected field updates: %v", client.set)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "! owner/repo#1 status=stage: conflict, ours In progress, theirs Building") ||
+		!strings.Contains(out, "1 added, 1 pushed, 0 pulled, 1 conflict(s)") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+
+	state, err := mirror.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value, ok := state.BaseValue("owner/repo#2", "status=stage"); !ok || value != "Todo" {
+		t.Errorf("Expected #2's synchronized value recorded, got %q, %v", value, ok)
+	}
+	if _, ok := state.BaseValue("owner/repo#1", "status=stage"); ok {
+		t.Error("Expected no synchronized value for a conflict")
+	}
+	if len(state.Log) != 3 {
+		t.Errorf("Expected add, push, and conflict logged, got %+v", state.Log)
+	}
+}
+
+func TestRunMirror_PullsPartnerChange(t *testing.T) {
+	client := newMirrorTestClient()
+	path := filepath.Join(t.TempDir(), "mirror.json")
+	state, _ := mirror.Load(path)
+	state.SetBase("owner/repo#1", "status=stage", "In progress")
+	if err := state.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, buf := newTestCmd()
+	if err := runMirrorWithDeps(cmd, newMirrorTestOptions(), newTestConfig(), client, path, time.Now()); err != nil {
+		t.Fatalf("runMirrorWithDeps() error = %v", err)
+	}
+	if len(client.set) != 2 || client.set[0] != "proj-ours:item-1:Status=Building" {
+		t.Errorf("Unexpected field updates: %v", client.set)
+	}
+	if !strings.Contains(buf.String(), "← owner/repo#1 Status: In progress → Building") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunMirror_PolicyAndDryRun(t *testing.T) {
+	client := newMirrorTestClient()
+	path := filepath.Join(t.TempDir(), "mirror.json")
+	opts := newMirrorTestOptions()
+	opts.policy = "ours"
+	opts.dryRun = true
+
+	cmd, buf := newTestCmd()
+	if err := runMirrorWithDeps(cmd, opts, newTestConfig(), client, path, time.Now()); err != nil {
+		t.Fatalf("runMirrorWithDeps() error = %v", err)
+	}
+	if len(client.added)+len(client.set) != 0 {
+		t.Errorf("Expected no changes in a dry run, got %v %v", client.added, client.set)
+	}
+	if !strings.Contains(buf.String(), "→ owner/repo#1 Stage: Building → In progress") {
+		t.Errorf("Expected our value to win the conflict, got:\n%s", buf.String())
+	}
+	state, _ := mirror.Load(path)
+	if len(state.Log) != 0 {
+		t.Error("Expected nothing recorded in a dry run")
+	}
+}
+
+func TestRunMirror_ShowLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mirror.json")
+	state, _ := mirror.Load(path)
+	state.Record([]mirror.LogEntry{{Issue: "owner/repo#1", Field: "status=stage", Action: "pull", Old: "Todo", New: "Done"}},
+		time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+	if err := state.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, buf := newTestCmd()
+	opts := &mirrorOptions{partner: "partner/7", log: true}
+	if err := runMirrorWithDeps(cmd, opts, newTestConfig(), newMirrorTestClient(), path, time.Now()); err != nil {
+		t.Fatalf("runMirrorWithDeps() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "2026-03-02T09:00:00Z  owner/repo#1  pull    status=stage  Todo → Done") {
+		t.Errorf("Unexpected log:\n%s", buf.String())
+	}
+}
+
+func TestRunMirror_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(*mirrorOptions)
+		wantErr string
+	}{
+		{"bad partner", func(o *mirrorOptions) { o.partner = "partner" }, "expected owner/number"},
+		{"bad policy", func(o *mirrorOptions) { o.policy = "mine" }, "invalid conflict policy"},
+		{"no mapping", func(o *mirrorOptions) { o.maps = nil }, "at least one --map"},
+		{"bad mapping", func(o *mirrorOptions) { o.maps = []string{"status"} }, "invalid --map"},
+		{"no query", func(o *mirrorOptions) { o.query = "" }, "--query is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := newMirrorTestOptions()
+			tt.modify(opts)
+			cmd, _ := newTestCmd()
+			err := runMirrorWithDeps(cmd, opts, newTestConfig(), newMirrorTestClient(), filepath.Join(t.TempDir(), "m.json"), time.Now())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
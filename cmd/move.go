@@ -1,12 +1,18 @@
 package cmd
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
 	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/journal"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/scooter-indie/gh-pmu/internal/outbox"
 	"github.com/spf13/cobra"
 )
 
@@ -17,6 +23,19 @@ type moveOptions struct {
 	depth     int
 	dryRun    bool
 	yes       bool // skip confirmation
+	query     string
+	iteration string
+	done      bool     // set the done status and close the issue
+	comment   string   // comment posted on each moved issue
+	queue     bool     // record the change in the outbox instead of applying it
+	assignees []string // users to assign, @me for yourself
+	unassign  []string // users to unassign, @me for yourself
+	json      bool
+	undo      bool   // revert the last recorded move
+	journal   string // undo journal the move is recorded in; empty to not record
+
+	checkConflicts bool // re-read each item before updating it
+	force          bool // update items even if they changed
 }
 
 // moveClient defines the interface for API methods used by move functions.
@@ -26,7 +45,17 @@ type moveClient interface {
 	GetProject(owner string, number int) (*api.Project, error)
 	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
 	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	GetProjectFields(projectID string) ([]api.ProjectField, error)
 	SetProjectItemField(projectID, itemID, fieldName, value string) error
+	SetProjectItemFields(projectID string, updates []api.ItemFieldUpdate) []error
+	ClearProjectItemField(projectID, itemID, fieldName string) error
+	AddIssueComment(issueID, body string) (string, error)
+	CloseIssue(issueID string) error
+	ResolveOwner(owner string) (string, error)
+	AddIssueAssignees(issueID string, logins []string) error
+	RemoveIssueAssignees(issueID string, logins []string) error
+	ReopenIssue(issueID string) error
 }
 
 func newMoveCommand() *cobra.Command {
@@ -35,26 +64,105 @@ func newMoveCommand() *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "move <issue-number>",
-		Short: "Update project fields for an issue",
+		Use:   "move [issue-number...]",
+		Short: "Update project fields for issues",
 		Long: `Update project field values for an issue.
 
 Changes the status, priority, or other project fields for an issue
 that is already in the configured project.
 
+Without --status, --priority, --iteration, --done, or an assignee
+change, a menu of the
+project's status options is shown, with the issue's current status
+marked, and the issue is moved to the one you pick.
+
 Field values are resolved through config aliases, so you can use
 shorthand values like "in_progress" which will be mapped to "In Progress".
 
+Without an issue number, the issue set with 'gh pmu focus' is used.
+Give several issue numbers to update them all at once: the field updates
+are sent in batched requests and a table shows the result for each issue.
+
+Use --iteration to pull an issue into a sprint: current, next, or an
+iteration title from the project's iteration field. Use --iteration none
+to take it out of its sprint.
+
+Use --assignee and --unassign to change who works on the issue in the
+same step, @me for yourself; other assignees are kept.
+
+Use --json with one or more issue numbers to print what changed for each
+issue as JSON.
+
+Use --comment to record the reason for a move on the issue in the same
+step, before it is closed with --done. With --recursive the comment goes
+on the named issue only.
+
+Use --done to finish an issue in one step: it is moved to the configured
+done status and closed. Add --recursive to finish and close its
+sub-issues too.
+
+Use --queue to record a --status or --priority change locally instead of
+sending it to GitHub, along with the field's current value; offline, the
+change is queued without it. Push queued changes with 'gh pmu sync --push'.
+A queued change whose field no longer has the value it was queued from is
+reported as a conflict and kept. For a change queued offline, the push
+can only tell from when a single-select field last changed.
+
 Use --recursive to update all sub-issues as well. This will traverse
 the issue tree and apply the same changes to all descendants.
 
+Use --query instead of an issue number to update every project issue
+that matches. The query takes project field qualifiers (status:in_review,
+priority:p1) and label:, -label:, and is:open/closed/all (default: open).
+Matching issues are listed, with progress as each one is updated.
+
+Every move is recorded in a local journal with the values it replaced.
+Use --undo to revert the last one: fields are set back, assignee changes
+are reversed, and issues it closed are reopened; comments are kept. A
+field changed again since the move is left alone. Run --undo again to revert earlier moves;
+the last 20 are kept.
+
+Use --check-conflicts when others may be editing the same issues: each
+item is read again just before it is updated, and skipped if any of its
+fields changed since move first read them. Add --force to update such
+items anyway.
+
 Examples:
   # Move a single issue to "In Progress"
   gh pmu move 42 --status in_progress
 
+  # Move several issues at once
+  gh pmu move 10 11 12 --status ready
+
+  # Assign yourself and start work in one step
+  gh pmu move 42 -s in_progress --assignee @me
+
+  # Move the focused issue to review
+  gh pmu move --status in_review
+
+  # Pick the new status from a menu
+  gh pmu move 42
+
+  # Queue the change now, push it later
+  gh pmu move 42 --status done --queue
+  gh pmu sync --push
+
+  # Say why an issue is blocked
+  gh pmu move 42 --status blocked --comment "Waiting on the auth team's API"
+
   # Set both status and priority
   gh pmu move 42 --status done --priority p1
 
+  # Move to done and close, with a closing comment
+  gh pmu move 42 --done --comment "Shipped in v1.4"
+
+  # Finish an epic and close all of its sub-issues
+  gh pmu move 10 --done --recursive
+
+  # Pull an issue into the next sprint, or out of any sprint
+  gh pmu move 42 --iteration next
+  gh pmu move 42 --iteration none
+
   # Recursively update an epic and all its sub-issues
   gh pmu move 10 --status in_progress --recursive
 
@@ -65,8 +173,19 @@ Examples:
   gh pmu move 10 --status backlog --recursive --yes
 
   # Limit recursion depth (default is 10)
-  gh pmu move 10 --status in_progress --recursive --depth 2`,
-		Args: cobra.ExactArgs(1),
+  gh pmu move 10 --status in_progress --recursive --depth 2
+
+  # Move every approved issue in review to done, previewing first
+  gh pmu move --query "status:in_review label:approved" --status done --dry-run
+  gh pmu move --query "status:in_review label:approved" --status done --yes
+
+  # Revert the last move, previewing first
+  gh pmu move --undo --dry-run
+  gh pmu move --undo
+
+  # Skip issues someone else changed while the confirmation was open
+  gh pmu move --query "status:todo" --iteration next --check-conflicts`,
+		Args: cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runMove(cmd, args, opts)
 		},
@@ -74,10 +193,21 @@ Examples:
 
 	cmd.Flags().StringVarP(&opts.status, "status", "s", "", "Set project status field")
 	cmd.Flags().StringVarP(&opts.priority, "priority", "p", "", "Set project priority field")
+	cmd.Flags().StringVar(&opts.iteration, "iteration", "", "Set the iteration: current, next, a title, or none to clear")
+	cmd.Flags().BoolVar(&opts.done, "done", false, "Move to the done status and close the issue")
+	cmd.Flags().StringVar(&opts.comment, "comment", "", "Comment to post on the issue, such as the reason for the move")
+	cmd.Flags().StringArrayVarP(&opts.assignees, "assignee", "a", nil, "Assign a user, @me for yourself (can be specified multiple times)")
+	cmd.Flags().StringArrayVar(&opts.unassign, "unassign", nil, "Unassign a user, @me for yourself (can be specified multiple times)")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output the changes made to each issue in JSON format")
+	cmd.Flags().BoolVar(&opts.undo, "undo", false, "Revert the last move")
+	cmd.Flags().BoolVar(&opts.queue, "queue", false, "Queue the change locally and push it later with 'gh pmu sync --push'")
 	cmd.Flags().BoolVarP(&opts.recursive, "recursive", "r", false, "Apply changes to all sub-issues recursively")
 	cmd.Flags().IntVar(&opts.depth, "depth", 10, "Maximum depth for recursive operations")
 	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show what would be changed without making changes")
-	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip confirmation prompt for recursive operations")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip confirmation prompt for recursive and --query operations")
+	cmd.Flags().StringVarP(&opts.query, "query", "q", "", "Update every project issue matching a query instead of one issue")
+	cmd.Flags().BoolVar(&opts.checkConflicts, "check-conflicts", false, "Skip issues whose fields changed since they were read")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "With --check-conflicts, update issues even if they changed")
 
 	return cmd
 }
@@ -90,12 +220,46 @@ type issueInfo struct {
 	Title  string
 	ItemID string
 	Depth  int
+	ID     string // issue node ID
+	State  string // OPEN or CLOSED
+
+	Assignees []string // logins as read; unknown for sub-issues
 }
 
 func runMove(cmd *cobra.Command, args []string, opts *moveOptions) error {
+	if opts.undo && (len(args) > 0 || opts.status != "" || opts.priority != "" || opts.iteration != "" || opts.done ||
+		len(opts.assignees) > 0 || len(opts.unassign) > 0 || opts.queue || opts.recursive || opts.query != "" || opts.json || opts.comment != "") {
+		return fmt.Errorf("--undo takes no issue or changes; only --dry-run may be added")
+	}
+
 	// Validate at least one flag is provided
-	if opts.status == "" && opts.priority == "" {
-		return fmt.Errorf("at least one of --status or --priority is required")
+	if opts.queue {
+		if opts.iteration != "" || opts.done || opts.recursive || opts.query != "" || opts.checkConflicts || opts.dryRun || len(args) > 1 ||
+			len(opts.assignees) > 0 || len(opts.unassign) > 0 || opts.json || opts.comment != "" {
+			return fmt.Errorf("--queue only supports --status and --priority on a single issue")
+		}
+		if opts.status == "" && opts.priority == "" {
+			return fmt.Errorf("--queue requires --status or --priority")
+		}
+	}
+
+	// Without a change, the status is picked from a menu when interactive
+	pick := opts.status == "" && opts.priority == "" && opts.iteration == "" && !opts.done &&
+		len(opts.assignees) == 0 && len(opts.unassign) == 0 && !opts.undo
+	if pick && (opts.query != "" || opts.recursive || len(args) > 1 || opts.json || !isInteractiveTerminal(cmd)) {
+		return fmt.Errorf("at least one of --status, --priority, --iteration, --done, --assignee, or --unassign is required")
+	}
+	if opts.json && (opts.recursive || opts.query != "" || opts.dryRun) {
+		return fmt.Errorf("--json cannot be used with --recursive, --query, or --dry-run")
+	}
+	if opts.done && opts.status != "" {
+		return fmt.Errorf("--done cannot be used with --status")
+	}
+	if opts.force && !opts.checkConflicts {
+		return fmt.Errorf("--force requires --check-conflicts")
+	}
+	if opts.recursive && len(args) > 1 {
+		return fmt.Errorf("--recursive takes a single issue")
 	}
 
 	// Load configuration
@@ -113,10 +277,54 @@ func runMove(cmd *cobra.Command, args []string, opts *moveOptions) error {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	opts.journal, err = journal.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return err
+	}
+
+	if opts.undo {
+		err = runMoveUndoWithDeps(cmd, opts, cfg, api.NewClient(), opts.journal)
+		refreshDaemon(cfg)
+		return err
+	}
+
+	if opts.query != "" {
+		if len(args) > 0 || opts.recursive {
+			return fmt.Errorf("--query cannot be used with an issue number or --recursive")
+		}
+		err := runMoveQueryWithDeps(cmd, opts, cfg, api.NewClient())
+		refreshDaemon(cfg)
+		return err
+	}
+
+	args, err = issueArgsOrFocus(args, cfg)
+	if err != nil {
+		return err
+	}
+
+	if opts.queue {
+		path, err := outbox.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+		if err != nil {
+			return err
+		}
+		return runMoveQueueWithDeps(cmd, args, opts, cfg, api.NewClient(), path, time.Now())
+	}
+
 	// Create API client
 	client := api.NewClient()
 
-	return runMoveWithDeps(cmd, args, opts, cfg, client)
+	switch {
+	case len(args) > 1:
+		err = runMoveBatchWithDeps(cmd, args, opts, cfg, client)
+	case pick:
+		err = runMovePickWithDeps(cmd, args, opts, cfg, client, bufio.NewReader(cmd.InOrStdin()))
+	default:
+		err = runMoveWithDeps(cmd, args, opts, cfg, client)
+	}
+
+	// A running daemon's cached items are stale after a move
+	refreshDaemon(cfg)
+	return err
 }
 
 // runMoveWithDeps is the testable implementation of runMove
@@ -159,15 +367,17 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 	}
 
 	// Build a map of issue numbers to item IDs for quick lookup
-	itemIDMap := make(map[string]string) // "owner/repo#number" -> itemID
+	itemIDMap := make(map[string]string)            // "owner/repo#number" -> itemID
+	readFields := make(map[string][]api.FieldValue) // "owner/repo#number" -> field values as read
 	for _, item := range items {
 		if item.Issue != nil {
-			key := fmt.Sprintf("%s/%s#%d", item.Issue.Repository.Owner, item.Issue.Repository.Name, item.Issue.Number)
+			key := itemKey(item)
 			itemIDMap[key] = item.ID
+			readFields[key] = item.FieldValues
 		}
 	}
 
-	rootKey := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+	rootKey := localstore.Key(owner+"/"+repo, number)
 	rootItemID, inProject := itemIDMap[rootKey]
 	if !inProject {
 		return fmt.Errorf("issue #%d is not in the project", number)
@@ -181,6 +391,10 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 		Title:  issue.Title,
 		ItemID: rootItemID,
 		Depth:  0,
+		ID:     issue.ID,
+		State:  issue.State,
+
+		Assignees: actorLogins(issue.Assignees),
 	}}
 
 	// If recursive, collect all sub-issues
@@ -197,8 +411,8 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 	priorityValue := ""
 	var changeDescriptions []string
 
-	if opts.status != "" {
-		statusValue = cfg.ResolveFieldValue("status", opts.status)
+	if status := moveStatus(opts); status != "" {
+		statusValue = cfg.ResolveFieldValue("status", status)
 		changeDescriptions = append(changeDescriptions, fmt.Sprintf("Status → %s", statusValue))
 	}
 	if opts.priority != "" {
@@ -206,6 +420,29 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 		changeDescriptions = append(changeDescriptions, fmt.Sprintf("Priority → %s", priorityValue))
 	}
 
+	var iterationChange *moveFieldChange
+	if opts.iteration != "" {
+		change, err := resolveMoveIteration(client, cfg, project.ID, opts.iteration, time.Now())
+		if err != nil {
+			return err
+		}
+		iterationChange = &change
+		changeDescriptions = append(changeDescriptions, change.String())
+	}
+	assign, unassign, err := resolveMoveAssignees(client, opts)
+	if err != nil {
+		return err
+	}
+	if desc := moveAssigneeChange(assign, unassign); desc != "" {
+		changeDescriptions = append(changeDescriptions, desc)
+	}
+	if opts.comment != "" {
+		changeDescriptions = append(changeDescriptions, "Add comment")
+	}
+	if opts.done {
+		changeDescriptions = append(changeDescriptions, "Close issue")
+	}
+
 	// Show what will be updated
 	if opts.recursive || opts.dryRun {
 		if opts.dryRun {
@@ -249,6 +486,14 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 	// Apply updates
 	updatedCount := 0
 	skippedCount := 0
+	conflictCount := 0
+
+	entry := journal.Entry{Summary: strings.Join(changeDescriptions, ", ")}
+	var results []moveJSONResult
+	warn := func(info issueInfo, err error) {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		results = append(results, newMoveJSONResult(info, nil, assign, unassign, err))
+	}
 
 	for _, info := range issuesToUpdate {
 		if info.ItemID == "" {
@@ -256,24 +501,70 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 			continue
 		}
 
+		key := localstore.Key(info.Owner+"/"+info.Repo, info.Number)
+		if err := checkMoveConflict(client, opts, project.ID, info, readFields[key]); err != nil {
+			if !opts.recursive {
+				return fmt.Errorf("%w; use --force to update it anyway", err)
+			}
+			fmt.Fprintf(os.Stderr, "Warning: %v; skipped\n", err)
+			conflictCount++
+			continue
+		}
+
+		change := entry.Add(key, info.ItemID, info.ID)
+
 		// Update status if provided
 		if statusValue != "" {
 			if err := client.SetProjectItemField(project.ID, info.ItemID, "Status", statusValue); err != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to set status for #%d: %v\n", info.Number, err)
+				warn(info, fmt.Errorf("failed to set status for #%d: %w", info.Number, err))
 				continue
 			}
+			recordMoveField(change, readFields[key], moveFieldChange{field: "Status", value: statusValue})
 		}
 
 		// Update priority if provided
 		if priorityValue != "" {
 			if err := client.SetProjectItemField(project.ID, info.ItemID, "Priority", priorityValue); err != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to set priority for #%d: %v\n", info.Number, err)
+				warn(info, fmt.Errorf("failed to set priority for #%d: %w", info.Number, err))
+				continue
+			}
+			recordMoveField(change, readFields[key], moveFieldChange{field: "Priority", value: priorityValue})
+		}
+
+		// Update iteration if provided
+		if iterationChange != nil {
+			if err := applyJournaledFieldChanges(client, project.ID, info.ItemID, []moveFieldChange{*iterationChange}, readFields[key], change); err != nil {
+				warn(info, fmt.Errorf("failed to set iteration for #%d: %w", info.Number, err))
+				continue
+			}
+		}
+
+		// Assign and unassign users if provided
+		if err := applyMoveAssignees(client, info, assign, unassign, change); err != nil {
+			warn(info, fmt.Errorf("failed to update assignees for #%d: %w", info.Number, err))
+			continue
+		}
+
+		// The comment goes on the issue that was named
+		if opts.comment != "" && info.Depth == 0 {
+			if _, err := client.AddIssueComment(info.ID, opts.comment); err != nil {
+				warn(info, fmt.Errorf("failed to comment on #%d: %w", info.Number, err))
+				continue
+			}
+		}
+
+		// Close the issue for --done
+		if opts.done {
+			if err := closeMovedIssue(client, info, change); err != nil {
+				warn(info, fmt.Errorf("failed to close #%d: %w", info.Number, err))
 				continue
 			}
 		}
 
 		updatedCount++
-		if !opts.recursive {
+		if opts.json {
+			results = append(results, newMoveJSONResult(info, changeDescriptions, assign, unassign, nil))
+		} else if !opts.recursive {
 			// Single issue - show detailed output
 			fmt.Printf("✓ Updated issue #%d: %s\n", info.Number, info.Title)
 			for _, desc := range changeDescriptions {
@@ -283,20 +574,156 @@ func runMoveWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *
 		}
 	}
 
+	recordMove(cfg, opts, entry)
+
+	if opts.json {
+		return outputMoveJSON(cmd, results)
+	}
+
 	// Summary for recursive operations
 	if opts.recursive {
 		fmt.Printf("✓ Updated %d issues", updatedCount)
 		if skippedCount > 0 {
 			fmt.Printf(" (%d skipped - not in project)", skippedCount)
 		}
+		if conflictCount > 0 {
+			fmt.Printf(" (%d skipped - changed since read, use --force to update)", conflictCount)
+		}
 		fmt.Println()
 	}
 
 	return nil
 }
 
+// moveStatus returns the status to move to: --status, or done with --done
+func moveStatus(opts *moveOptions) string {
+	if opts.done {
+		return "done"
+	}
+	return opts.status
+}
+
+// resolveMoveAssignees resolves @me in --assignee and --unassign to the
+// authenticated user's login
+func resolveMoveAssignees(client moveClient, opts *moveOptions) (assign, unassign []string, err error) {
+	if assign, err = resolveEditLogins(client, opts.assignees); err != nil {
+		return nil, nil, err
+	}
+	if unassign, err = resolveEditLogins(client, opts.unassign); err != nil {
+		return nil, nil, err
+	}
+	return assign, unassign, nil
+}
+
+// moveAssigneeChange describes an assignee change as "Assignees: +@a -@b",
+// or returns "" when there is none
+func moveAssigneeChange(assign, unassign []string) string {
+	if len(assign) == 0 && len(unassign) == 0 {
+		return ""
+	}
+	return describeEditSet("Assignees", assign, unassign, "@")[0]
+}
+
+// applyMoveAssignees assigns and unassigns users on an issue, recording in
+// change the logins that were not already assigned or unassigned
+func applyMoveAssignees(client moveClient, info issueInfo, assign, unassign []string, change *journal.Change) error {
+	before := make(map[string]bool)
+	for _, login := range info.Assignees {
+		before[strings.ToLower(login)] = true
+	}
+	if len(assign) > 0 {
+		if err := client.AddIssueAssignees(info.ID, assign); err != nil {
+			return err
+		}
+		for _, login := range assign {
+			if !before[strings.ToLower(login)] {
+				change.Assigned = append(change.Assigned, login)
+			}
+		}
+	}
+	if len(unassign) > 0 {
+		if err := client.RemoveIssueAssignees(info.ID, unassign); err != nil {
+			return err
+		}
+		for _, login := range unassign {
+			if before[strings.ToLower(login)] {
+				change.Unassigned = append(change.Unassigned, login)
+			}
+		}
+	}
+	return nil
+}
+
+// moveJSONResult is the JSON output of move for one issue
+type moveJSONResult struct {
+	Issue      string   `json:"issue"` // owner/repo#number
+	Title      string   `json:"title"`
+	URL        string   `json:"url"`
+	Updated    bool     `json:"updated"`
+	Changes    []string `json:"changes,omitempty"`
+	Assigned   []string `json:"assigned,omitempty"`
+	Unassigned []string `json:"unassigned,omitempty"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// newMoveJSONResult builds the JSON result for an issue, updated unless err is set
+func newMoveJSONResult(info issueInfo, changes, assign, unassign []string, err error) moveJSONResult {
+	result := moveJSONResult{
+		Issue: fmt.Sprintf("%s/%s#%d", info.Owner, info.Repo, info.Number),
+		Title: info.Title,
+		URL:   fmt.Sprintf("https://github.com/%s/%s/issues/%d", info.Owner, info.Repo, info.Number),
+	}
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Updated = true
+	result.Changes = changes
+	result.Assigned = assign
+	result.Unassigned = unassign
+	return result
+}
+
+// outputMoveJSON prints move results as a JSON array
+func outputMoveJSON(cmd *cobra.Command, results []moveJSONResult) error {
+	if results == nil {
+		results = []moveJSONResult{}
+	}
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(results)
+}
+
+// closeMovedIssue closes the issue unless it is already closed, recording
+// the close in change
+func closeMovedIssue(client moveClient, info issueInfo, change *journal.Change) error {
+	if strings.EqualFold(info.State, "CLOSED") {
+		return nil
+	}
+	if err := client.CloseIssue(info.ID); err != nil {
+		return err
+	}
+	change.Closed = true
+	return nil
+}
+
+// checkMoveConflict re-reads an item with --check-conflicts and returns an
+// error if its fields changed since read. With --force the change is only
+// reported.
+func checkMoveConflict(client moveClient, opts *moveOptions, projectID string, info issueInfo, read []api.FieldValue) error {
+	if !opts.checkConflicts {
+		return nil
+	}
+	err := checkItemConflict(client, projectID, info.Owner, info.Repo, info.Number, read)
+	if err != nil && opts.force {
+		fmt.Fprintf(os.Stderr, "Warning: %v; updating anyway\n", err)
+		return nil
+	}
+	return err
+}
+
 // collectSubIssuesRecursive recursively collects all sub-issues up to maxDepth
-func collectSubIssuesRecursive(client moveClient, owner, repo string, number int, itemIDMap map[string]string, currentDepth, maxDepth int) ([]issueInfo, error) {
+func collectSubIssuesRecursive(client subTreeClient, owner, repo string, number int, itemIDMap map[string]string, currentDepth, maxDepth int) ([]issueInfo, error) {
 	if currentDepth > maxDepth {
 		return nil, nil
 	}
@@ -318,7 +745,7 @@ func collectSubIssuesRecursive(client moveClient, owner, repo string, number int
 			subRepo = repo
 		}
 
-		key := fmt.Sprintf("%s/%s#%d", subOwner, subRepo, sub.Number)
+		key := localstore.Key(subOwner+"/"+subRepo, sub.Number)
 		itemID := itemIDMap[key] // may be empty if not in project
 
 		info := issueInfo{
@@ -328,6 +755,8 @@ func collectSubIssuesRecursive(client moveClient, owner, repo string, number int
 			Title:  sub.Title,
 			ItemID: itemID,
 			Depth:  currentDepth,
+			ID:     sub.ID,
+			State:  sub.State,
 		}
 		result = append(result, info)
 
@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/journal"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/spf13/cobra"
+)
+
+// moveBatchRow is the outcome of moving one of several issues
+type moveBatchRow struct {
+	info   issueInfo
+	read   []api.FieldValue // field values as read
+	result string
+	failed bool
+	undo   journal.Change // what was changed, for move --undo
+}
+
+// runMoveBatchWithDeps is the testable implementation of move with several
+// issues. The field updates for all of them are sent together in batched
+// requests, and the outcome is printed as a table with a row per issue.
+func runMoveBatchWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *config.Config, client moveClient) error {
+	var rows []*moveBatchRow
+	seen := make(map[string]bool)
+	for _, arg := range args {
+		owner, repo, number, err := parseIssueReference(arg)
+		if err != nil {
+			return err
+		}
+		if owner == "" || repo == "" {
+			if len(cfg.Repositories) == 0 {
+				return fmt.Errorf("no repository specified and none configured")
+			}
+			owner, repo = splitRepository(cfg.Repositories[0])
+		}
+		key := localstore.Key(owner+"/"+repo, number)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		rows = append(rows, &moveBatchRow{info: issueInfo{Owner: owner, Repo: repo, Number: number}})
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+	itemsByKey := make(map[string]api.ProjectItem)
+	for _, item := range items {
+		if item.Issue != nil {
+			itemsByKey[itemKey(item)] = item
+		}
+	}
+
+	for _, row := range rows {
+		item, ok := itemsByKey[localstore.Key(row.info.Owner+"/"+row.info.Repo, row.info.Number)]
+		if !ok {
+			row.result = "✗ not in project"
+			row.failed = true
+			continue
+		}
+		row.info.Title = item.Issue.Title
+		row.info.ItemID = item.ID
+		row.info.ID = item.Issue.ID
+		row.info.State = item.Issue.State
+		row.info.Assignees = actorLogins(item.Issue.Assignees)
+		row.read = item.FieldValues
+		row.undo = journal.Change{Issue: localstore.Key(row.info.Owner+"/"+row.info.Repo, row.info.Number), ItemID: item.ID, IssueID: item.Issue.ID}
+	}
+
+	changes, err := moveFieldChanges(client, cfg, opts, project.ID)
+	if err != nil {
+		return err
+	}
+	assign, unassign, err := resolveMoveAssignees(client, opts)
+	if err != nil {
+		return err
+	}
+
+	descriptions := moveChangeDescriptions(opts, changes, assign, unassign)
+
+	if !opts.json {
+		if opts.dryRun {
+			cmd.Println("Dry run - no changes will be made")
+			cmd.Println()
+		}
+		cmd.Println("Changes to apply:")
+		for _, desc := range descriptions {
+			cmd.Printf("  • %s\n", desc)
+		}
+		cmd.Println()
+	}
+
+	if opts.dryRun {
+		for _, row := range rows {
+			if !row.failed {
+				row.result = "would update"
+			}
+		}
+		return outputMoveBatchTable(cmd, rows)
+	}
+
+	// Skip issues that changed since they were read
+	for _, row := range rows {
+		if row.failed {
+			continue
+		}
+		if err := checkMoveConflict(client, opts, project.ID, row.info, row.read); err != nil {
+			row.result = "✗ changed since read, use --force to update"
+			row.failed = true
+		}
+	}
+
+	// Send every field value in one batch; clears go one at a time
+	var updates []api.ItemFieldUpdate
+	var updateRows []*moveBatchRow
+	for _, row := range rows {
+		if row.failed {
+			continue
+		}
+		for _, c := range changes {
+			if c.value != "" {
+				updates = append(updates, api.ItemFieldUpdate{ItemID: row.info.ItemID, Field: c.field, Value: c.value})
+				updateRows = append(updateRows, row)
+			}
+		}
+	}
+	for i, err := range client.SetProjectItemFields(project.ID, updates) {
+		row := updateRows[i]
+		if err == nil {
+			recordMoveField(&row.undo, row.read, moveFieldChange{field: updates[i].Field, value: updates[i].Value})
+		} else if !row.failed {
+			row.result = "✗ " + err.Error()
+			row.failed = true
+		}
+	}
+
+	for _, row := range rows {
+		if row.failed {
+			continue
+		}
+		for _, c := range changes {
+			if c.value != "" {
+				continue
+			}
+			if err := client.ClearProjectItemField(project.ID, row.info.ItemID, c.field); err != nil {
+				row.result = fmt.Sprintf("✗ failed to clear %s: %v", strings.ToLower(c.field), err)
+				row.failed = true
+				break
+			}
+			recordMoveField(&row.undo, row.read, c)
+		}
+		if !row.failed {
+			if err := applyMoveAssignees(client, row.info, assign, unassign, &row.undo); err != nil {
+				row.result = fmt.Sprintf("✗ failed to update assignees: %v", err)
+				row.failed = true
+			}
+		}
+		if !row.failed && opts.comment != "" {
+			if _, err := client.AddIssueComment(row.info.ID, opts.comment); err != nil {
+				row.result = fmt.Sprintf("✗ failed to comment: %v", err)
+				row.failed = true
+			}
+		}
+		if !row.failed && opts.done {
+			if err := closeMovedIssue(client, row.info, &row.undo); err != nil {
+				row.result = fmt.Sprintf("✗ failed to close: %v", err)
+				row.failed = true
+			}
+		}
+		if !row.failed {
+			row.result = "✓ updated"
+		}
+	}
+
+	failed := 0
+	entry := journal.Entry{Summary: strings.Join(descriptions, ", ")}
+	for _, row := range rows {
+		if row.failed {
+			failed++
+		}
+		entry.Changes = append(entry.Changes, row.undo)
+	}
+	recordMove(cfg, opts, entry)
+
+	if opts.json {
+		results := make([]moveJSONResult, 0, len(rows))
+		for _, row := range rows {
+			var err error
+			if row.failed {
+				err = errors.New(strings.TrimPrefix(row.result, "✗ "))
+			}
+			results = append(results, newMoveJSONResult(row.info, descriptions, assign, unassign, err))
+		}
+		if err := outputMoveJSON(cmd, results); err != nil {
+			return err
+		}
+	} else {
+		if err := outputMoveBatchTable(cmd, rows); err != nil {
+			return err
+		}
+		cmd.Printf("\n✓ Updated %d of %d issues\n", len(rows)-failed, len(rows))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d issues failed to update", failed, len(rows))
+	}
+	return nil
+}
+
+// outputMoveBatchTable prints a row per issue with its result
+func outputMoveBatchTable(cmd *cobra.Command, rows []*moveBatchRow) error {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ISSUE\tTITLE\tRESULT")
+	for _, row := range rows {
+		fmt.Fprintf(w, "%s/%s#%d\t%s\t%s\n", row.info.Owner, row.info.Repo, row.info.Number, row.info.Title, row.result)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// setupMockWithIssues returns a move mock with a project item per issue
+func setupMockWithIssues(numbers ...int) *mockMoveClient {
+	mock := newMockMoveClient()
+	mock.project = &api.Project{ID: "proj-1", Number: 1, Title: "Test Project"}
+	for _, n := range numbers {
+		mock.projectItems = append(mock.projectItems, api.ProjectItem{
+			ID: fmt.Sprintf("item-%d", n),
+			Issue: &api.Issue{
+				ID:         fmt.Sprintf("issue-%d", n),
+				Number:     n,
+				Title:      fmt.Sprintf("Issue %d", n),
+				State:      "OPEN",
+				Repository: api.Repository{Owner: "testowner", Name: "testrepo"},
+			},
+		})
+	}
+	return mock
+}
+
+func TestRunMoveBatch_UpdatesAllIssuesInOneBatch(t *testing.T) {
+	mock := setupMockWithIssues(1, 2, 3)
+	cmd, buf := newTestCmd()
+
+	// Repository names match in any case, and repeats are dropped
+	err := runMoveBatchWithDeps(cmd, []string{"1", "2", "TestOwner/TestRepo#3", "2", "testowner/testrepo#3"}, &moveOptions{status: "done", priority: "high"}, testMoveConfig(), mock)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if mock.batchCalls != 1 {
+		t.Errorf("Expected one batched update, got %d", mock.batchCalls)
+	}
+	if len(mock.fieldUpdates) != 6 {
+		t.Errorf("Expected status and priority for 3 issues, got %v", mock.fieldUpdates)
+	}
+	for _, want := range []string{"Status → Done", "Priority → High", "ISSUE", "testowner/testrepo#2", "Issue 3", "✓ updated", "Updated 3 of 3 issues"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestRunMoveBatch_ReportsFailuresPerIssue(t *testing.T) {
+	mock := setupMockWithIssues(1, 2)
+	mock.setProjectItemErrFor["item-2"] = errors.New("forbidden")
+	cmd, buf := newTestCmd()
+
+	err := runMoveBatchWithDeps(cmd, []string{"1", "2", "9"}, &moveOptions{status: "done"}, testMoveConfig(), mock)
+	if err == nil || !strings.Contains(err.Error(), "2 of 3 issues failed") {
+		t.Errorf("Expected failure count error, got %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{"✗ forbidden", "testowner/testrepo#9", "✗ not in project", "Updated 1 of 3 issues"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if len(mock.fieldUpdates) != 1 || mock.fieldUpdates[0].itemID != "item-1" {
+		t.Errorf("Expected only item-1 updated, got %v", mock.fieldUpdates)
+	}
+}
+
+func TestRunMoveBatch_DoneClosesEachIssue(t *testing.T) {
+	mock := setupMockWithIssues(1, 2)
+	cmd, _ := newTestCmd()
+
+	err := runMoveBatchWithDeps(cmd, []string{"1", "2"}, &moveOptions{done: true, comment: "Shipped"}, testMoveConfig(), mock)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.closed) != 2 || mock.comments["issue-1"] != "Shipped" || mock.comments["issue-2"] != "Shipped" {
+		t.Errorf("Expected both issues commented and closed, got closed %v, comments %v", mock.closed, mock.comments)
+	}
+}
+
+func TestRunMoveBatch_DryRunAndConflicts(t *testing.T) {
+	mock := setupMockWithIssues(1, 2)
+	cmd, buf := newTestCmd()
+
+	if err := runMoveBatchWithDeps(cmd, []string{"1", "2"}, &moveOptions{status: "done", dryRun: true}, testMoveConfig(), mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 0 || !strings.Contains(buf.String(), "would update") {
+		t.Errorf("Expected a preview without updates, got %v:\n%s", mock.fieldUpdates, buf.String())
+	}
+
+	mock.current = map[string]*api.ProjectItem{
+		"testowner/testrepo#2": {ID: "item-2", FieldValues: []api.FieldValue{{Field: "Status", Value: "In Progress"}}},
+	}
+	cmd, buf = newTestCmd()
+	err := runMoveBatchWithDeps(cmd, []string{"1", "2"}, &moveOptions{status: "done", checkConflicts: true}, testMoveConfig(), mock)
+	if err == nil {
+		t.Error("Expected an error for the conflicting issue")
+	}
+	if len(mock.fieldUpdates) != 1 || !strings.Contains(buf.String(), "changed since read") {
+		t.Errorf("Expected the changed issue skipped, got %v:\n%s", mock.fieldUpdates, buf.String())
+	}
+}
+
+func TestRunMoveBatch_AssigneesAndJSON(t *testing.T) {
+	mock := setupMockWithIssues(1, 2)
+	cmd, buf := newTestCmd()
+
+	opts := &moveOptions{status: "in_progress", assignees: []string{"@me"}, json: true}
+	err := runMoveBatchWithDeps(cmd, []string{"1", "2", "9"}, opts, testMoveConfig(), mock)
+	if err == nil {
+		t.Error("Expected an error for the issue not in the project")
+	}
+	if len(mock.assigned["issue-1"]) != 1 || len(mock.assigned["issue-2"]) != 1 {
+		t.Errorf("Expected both issues assigned, got %v", mock.assigned)
+	}
+
+	var results []moveJSONResult
+	if err := json.Unmarshal(buf.Bytes(), &results); err != nil {
+		t.Fatalf("Expected only JSON output, got %q: %v", buf.String(), err)
+	}
+	if len(results) != 3 || !results[0].Updated || results[0].Assigned[0] != "me" || results[0].Changes[1] != "Assignees: +@me" {
+		t.Errorf("Unexpected results %+v", results)
+	}
+	if results[2].Updated || results[2].Error != "not in project" {
+		t.Errorf("Expected #9 not in project, got %+v", results[2])
+	}
+}
+
+func TestRunMove_MultipleIssuesValidation(t *testing.T) {
+	cmd, _ := newTestCmd()
+
+	err := runMove(cmd, []string{"1", "2"}, &moveOptions{status: "done", recursive: true})
+	if err == nil || !strings.Contains(err.Error(), "--recursive takes a single issue") {
+		t.Errorf("Expected recursive error, got %v", err)
+	}
+
+	err = runMove(cmd, []string{"1", "2"}, &moveOptions{status: "done", queue: true})
+	if err == nil || !strings.Contains(err.Error(), "single issue") {
+		t.Errorf("Expected queue error, got %v", err)
+	}
+}
@@ -135,7 +135,7 @@ func TestRunMove_Integration_NoFlags(t *testing.T) {
 		t.Error("expected non-zero exit code when no flags provided")
 	}
 
-	testutil.AssertContains(t, result.Stderr, "at least one of --status or --priority is required")
+	testutil.AssertContains(t, result.Stderr, "at least one of --status, --priority, --iteration, --done, --assignee, or --unassign is required")
 }
 
 // TestRunMove_Integration_DryRun tests --dry-run flag
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// runMovePickWithDeps asks for an issue's new status from a menu of the
+// status options, marking its current status, and then moves it
+func runMovePickWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *config.Config, client moveClient, reader *bufio.Reader) error {
+	options := wizardFieldOptions(cfg, "status")
+	if len(options) == 0 {
+		return fmt.Errorf("no status options to choose from; pass --status, or run 'gh pmu init' to cache the project's fields")
+	}
+
+	owner, repo, number, err := parseIssueReference(args[0])
+	if err != nil {
+		return err
+	}
+	if owner == "" || repo == "" {
+		if len(cfg.Repositories) == 0 {
+			return fmt.Errorf("no repository specified and none configured")
+		}
+		owner, repo = splitRepository(cfg.Repositories[0])
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	item, err := client.GetIssueProjectItem(owner, repo, number, project.ID)
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		return fmt.Errorf("issue #%d is not in the project", number)
+	}
+
+	statusField := cfg.GetFieldName("status")
+	current := getFieldValue(*item, statusField)
+
+	out := cmd.OutOrStdout()
+	u := ui.NewWithOptions(out, colorDisabled(cmd))
+
+	lines := make([]string, len(options))
+	defaultSelection := ""
+	for i, opt := range options {
+		lines[i] = opt
+		if strings.EqualFold(opt, current) {
+			lines[i] = opt + " (current)"
+			defaultSelection = strconv.Itoa(i + 1)
+		}
+	}
+	u.PrintMenu(lines, false)
+
+	fmt.Fprint(out, u.Prompt(fmt.Sprintf("%s for #%d (current: %s)", statusField, number, conflictValue(current)), defaultSelection))
+	input, _ := reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+	if input == "" {
+		input = defaultSelection
+	}
+	if input == "" {
+		return fmt.Errorf("no status selected")
+	}
+
+	selection, err := strconv.Atoi(input)
+	if err != nil || selection < 1 || selection > len(options) {
+		return fmt.Errorf("invalid status selection: %s", input)
+	}
+	value := options[selection-1]
+	if strings.EqualFold(value, current) {
+		cmd.Printf("#%d is already %s; nothing changed\n", number, value)
+		return nil
+	}
+
+	fmt.Fprintln(out)
+	opts.status = value
+	return runMoveWithDeps(cmd, args, opts, cfg, client)
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+func newMovePickTest(status string) (*mockMoveClient, *config.Config) {
+	mock := setupMockWithIssue(42, "Login fails", "item-42")
+	mock.projectItems[0].FieldValues = []api.FieldValue{{Field: "Status", Value: status}}
+
+	cfg := testMoveConfig()
+	cfg.Metadata = &config.Metadata{Fields: []config.FieldMetadata{{
+		Name:     "Status",
+		DataType: "SINGLE_SELECT",
+		Options:  []config.OptionMetadata{{Name: "Todo"}, {Name: "In Progress"}, {Name: "Done"}},
+	}}}
+	return mock, cfg
+}
+
+func TestRunMovePick(t *testing.T) {
+	mock, cfg := newMovePickTest("In Progress")
+
+	cmd, buf := newTestCmd()
+	cmd.Flags().Bool("no-color", true, "")
+	err := runMovePickWithDeps(cmd, []string{"42"}, &moveOptions{}, cfg, mock, bufio.NewReader(strings.NewReader("3\n")))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, want := range []string{"1. Todo", "2. In Progress (current)", "3. Done", "Status for #42 (current: In Progress) [2]:"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, buf.String())
+		}
+	}
+	if len(mock.fieldUpdates) != 1 || mock.fieldUpdates[0].value != "Done" {
+		t.Errorf("Expected Status → Done, got %+v", mock.fieldUpdates)
+	}
+}
+
+func TestRunMovePick_KeepsCurrent(t *testing.T) {
+	mock, cfg := newMovePickTest("Todo")
+
+	cmd, buf := newTestCmd()
+	cmd.Flags().Bool("no-color", true, "")
+	if err := runMovePickWithDeps(cmd, []string{"42"}, &moveOptions{}, cfg, mock, bufio.NewReader(strings.NewReader("\n"))); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 0 || !strings.Contains(buf.String(), "already Todo") {
+		t.Errorf("Expected no change, got %+v and:\n%s", mock.fieldUpdates, buf.String())
+	}
+}
+
+func TestRunMovePick_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		input  string
+		issue  string
+		want   string
+	}{
+		{"out of range", "Todo", "7\n", "42", "invalid status selection: 7"},
+		{"no current and no input", "", "\n", "42", "no status selected"},
+		{"not in project", "Todo", "1\n", "99", "not in the project"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock, cfg := newMovePickTest(tt.status)
+			cmd, _ := newTestCmd()
+			cmd.Flags().Bool("no-color", true, "")
+			err := runMovePickWithDeps(cmd, []string{tt.issue}, &moveOptions{}, cfg, mock, bufio.NewReader(strings.NewReader(tt.input)))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+
+	mock, _ := newMovePickTest("Todo")
+	cmd, _ := newTestCmd()
+	if err := runMovePickWithDeps(cmd, []string{"42"}, &moveOptions{}, &config.Config{}, mock, nil); err == nil || !strings.Contains(err.Error(), "--status") {
+		t.Errorf("Expected no-options error, got %v", err)
+	}
+}
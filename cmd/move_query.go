@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/journal"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/scooter-indie/gh-pmu/internal/triage"
+	"github.com/spf13/cobra"
+)
+
+// runMoveQueryWithDeps is the testable implementation of move --query. It
+// moves every project issue matching the query, after a preview and a
+// confirmation unless --yes is given.
+func runMoveQueryWithDeps(cmd *cobra.Command, opts *moveOptions, cfg *config.Config, client moveClient) error {
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	var matches []issueInfo
+	var readFields [][]api.FieldValue // field values as read, by match
+	for _, item := range filterItemsByQuery(cfg, items, opts.query) {
+		readFields = append(readFields, item.FieldValues)
+		matches = append(matches, issueInfo{
+			Owner:  item.Issue.Repository.Owner,
+			Repo:   item.Issue.Repository.Name,
+			Number: item.Issue.Number,
+			Title:  item.Issue.Title,
+			ItemID: item.ID,
+			ID:     item.Issue.ID,
+			State:  item.Issue.State,
+
+			Assignees: actorLogins(item.Issue.Assignees),
+		})
+	}
+
+	if len(matches) == 0 {
+		cmd.Printf("No issues match %q\n", opts.query)
+		return nil
+	}
+
+	changes, err := moveFieldChanges(client, cfg, opts, project.ID)
+	if err != nil {
+		return err
+	}
+	assign, unassign, err := resolveMoveAssignees(client, opts)
+	if err != nil {
+		return err
+	}
+
+	descriptions := moveChangeDescriptions(opts, changes, assign, unassign)
+
+	if opts.dryRun {
+		cmd.Println("Dry run - no changes will be made")
+		cmd.Println()
+	}
+	cmd.Printf("Issues matching %q (%d):\n", opts.query, len(matches))
+	for _, info := range matches {
+		cmd.Printf("  • %s/%s#%d - %s\n", info.Owner, info.Repo, info.Number, info.Title)
+	}
+	cmd.Println("\nChanges to apply:")
+	for _, desc := range descriptions {
+		cmd.Printf("  • %s\n", desc)
+	}
+
+	if opts.dryRun {
+		return nil
+	}
+
+	if !opts.yes {
+		cmd.Printf("\nProceed with updating %d issues? [y/N]: ", len(matches))
+		var response string
+		_, _ = fmt.Fscanln(cmd.InOrStdin(), &response)
+		response = strings.ToLower(strings.TrimSpace(response))
+		if response != "y" && response != "yes" {
+			cmd.Println("Aborted.")
+			return nil
+		}
+	}
+	cmd.Println()
+
+	entry := journal.Entry{Summary: strings.Join(descriptions, ", ")}
+	defer func() { recordMove(cfg, opts, entry) }()
+
+	failed := 0
+	for i, info := range matches {
+		progress := fmt.Sprintf("[%d/%d]", i+1, len(matches))
+		if err := checkMoveConflict(client, opts, project.ID, info, readFields[i]); err != nil {
+			failed++
+			cmd.Printf("%s ✗ #%d - %s\n", progress, info.Number, info.Title)
+			fmt.Fprintf(os.Stderr, "Warning: %v; skipped, use --force to update it anyway\n", err)
+			continue
+		}
+		change := entry.Add(localstore.Key(info.Owner+"/"+info.Repo, info.Number), info.ItemID, info.ID)
+		if err := applyJournaledFieldChanges(client, project.ID, info.ItemID, changes, readFields[i], change); err != nil {
+			failed++
+			cmd.Printf("%s ✗ #%d - %s\n", progress, info.Number, info.Title)
+			fmt.Fprintf(os.Stderr, "Warning: failed to update #%d: %v\n", info.Number, err)
+			continue
+		}
+		if err := applyMoveAssignees(client, info, assign, unassign, change); err != nil {
+			failed++
+			cmd.Printf("%s ✗ #%d - %s\n", progress, info.Number, info.Title)
+			fmt.Fprintf(os.Stderr, "Warning: failed to update assignees for #%d: %v\n", info.Number, err)
+			continue
+		}
+		if opts.comment != "" {
+			if _, err := client.AddIssueComment(info.ID, opts.comment); err != nil {
+				failed++
+				cmd.Printf("%s ✗ #%d - %s\n", progress, info.Number, info.Title)
+				fmt.Fprintf(os.Stderr, "Warning: failed to comment on #%d: %v\n", info.Number, err)
+				continue
+			}
+		}
+		if opts.done {
+			if err := closeMovedIssue(client, info, change); err != nil {
+				failed++
+				cmd.Printf("%s ✗ #%d - %s\n", progress, info.Number, info.Title)
+				fmt.Fprintf(os.Stderr, "Warning: failed to close #%d: %v\n", info.Number, err)
+				continue
+			}
+		}
+		cmd.Printf("%s ✓ #%d - %s\n", progress, info.Number, info.Title)
+	}
+
+	cmd.Printf("\n✓ Updated %d of %d issues\n", len(matches)-failed, len(matches))
+	if failed > 0 {
+		return fmt.Errorf("%d of %d issues failed to update", failed, len(matches))
+	}
+	return nil
+}
+
+// moveFieldChange is a project field value set by move. An empty value
+// clears the field.
+type moveFieldChange struct {
+	field string
+	value string
+}
+
+func (c moveFieldChange) String() string {
+	if c.value == "" {
+		return fmt.Sprintf("%s → (none)", c.field)
+	}
+	return fmt.Sprintf("%s → %s", c.field, c.value)
+}
+
+// moveFieldChanges resolves the --status, --priority, and --iteration
+// changes requested
+func moveFieldChanges(client moveClient, cfg *config.Config, opts *moveOptions, projectID string) ([]moveFieldChange, error) {
+	var changes []moveFieldChange
+	if status := moveStatus(opts); status != "" {
+		changes = append(changes, moveFieldChange{field: "Status", value: cfg.ResolveFieldValue("status", status)})
+	}
+	if opts.priority != "" {
+		changes = append(changes, moveFieldChange{field: "Priority", value: cfg.ResolveFieldValue("priority", opts.priority)})
+	}
+	if opts.iteration != "" {
+		change, err := resolveMoveIteration(client, cfg, projectID, opts.iteration, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// moveChangeDescriptions describes every change a move makes, for previews
+func moveChangeDescriptions(opts *moveOptions, changes []moveFieldChange, assign, unassign []string) []string {
+	var descriptions []string
+	for _, c := range changes {
+		descriptions = append(descriptions, c.String())
+	}
+	if desc := moveAssigneeChange(assign, unassign); desc != "" {
+		descriptions = append(descriptions, desc)
+	}
+	if opts.comment != "" {
+		descriptions = append(descriptions, "Add comment")
+	}
+	if opts.done {
+		descriptions = append(descriptions, "Close issue")
+	}
+	return descriptions
+}
+
+// applyMoveFieldChanges sets each field on an item, stopping at the first failure
+func applyMoveFieldChanges(client moveClient, projectID, itemID string, changes []moveFieldChange) error {
+	for _, c := range changes {
+		if c.value == "" {
+			if err := client.ClearProjectItemField(projectID, itemID, c.field); err != nil {
+				return fmt.Errorf("failed to clear %s: %w", strings.ToLower(c.field), err)
+			}
+			continue
+		}
+		if err := client.SetProjectItemField(projectID, itemID, c.field, c.value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", strings.ToLower(c.field), err)
+		}
+	}
+	return nil
+}
+
+// resolveMoveIteration resolves --iteration (current, next, a title, or
+// none) against the project's iteration field
+func resolveMoveIteration(client moveClient, cfg *config.Config, projectID, spec string, now time.Time) (moveFieldChange, error) {
+	fields, err := client.GetProjectFields(projectID)
+	if err != nil {
+		return moveFieldChange{}, fmt.Errorf("failed to get project fields: %w", err)
+	}
+
+	preferred := prIterationFieldName(cfg)
+	if strings.EqualFold(spec, "none") {
+		var name string
+		for _, f := range fields {
+			if f.DataType == "ITERATION" && (name == "" || strings.EqualFold(f.Name, preferred)) {
+				name = f.Name
+			}
+		}
+		if name == "" {
+			return moveFieldChange{}, fmt.Errorf("project has no iteration field")
+		}
+		return moveFieldChange{field: name}, nil
+	}
+
+	fieldName, iteration, err := resolveIteration(fields, preferred, spec, now)
+	if err != nil {
+		return moveFieldChange{}, err
+	}
+	return moveFieldChange{field: fieldName, value: iteration.Title}, nil
+}
+
+// filterItemsByQuery returns the issue items matching a query of project
+// field qualifiers plus the label:, -label:, and is: qualifiers. Only open
+// issues match unless the query has is:closed or is:all.
+func filterItemsByQuery(cfg *config.Config, items []api.ProjectItem, query string) []api.ProjectItem {
+	fieldFilters, issueQuery := parseItemQuery(cfg, query)
+	state := triage.StateForQuery(issueQuery)
+
+	var matches []api.ProjectItem
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		if state != "all" && !strings.EqualFold(item.Issue.State, state) {
+			continue
+		}
+		if !matchesFieldFilters(item, fieldFilters) || !triage.Matches(*item.Issue, issueQuery) {
+			continue
+		}
+		matches = append(matches, item)
+	}
+	return matches
+}
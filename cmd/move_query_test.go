@@ -0,0 +1,210 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+func setupMockWithQueryItems() *mockMoveClient {
+	mock := newMockMoveClient()
+	mock.project = &api.Project{ID: "proj-1"}
+
+	item := func(id string, number int, state, status string, labels ...string) api.ProjectItem {
+		issue := &api.Issue{
+			Number:     number,
+			Title:      "Issue " + id,
+			State:      state,
+			Repository: api.Repository{Owner: "testowner", Name: "testrepo"},
+		}
+		for _, l := range labels {
+			issue.Labels = append(issue.Labels, api.Label{Name: l})
+		}
+		return api.ProjectItem{ID: id, Issue: issue, FieldValues: []api.FieldValue{{Field: "Status", Value: status}}}
+	}
+
+	mock.projectItems = []api.ProjectItem{
+		item("item-1", 1, "OPEN", "In Review", "approved"),
+		item("item-2", 2, "OPEN", "In Review"),
+		item("item-3", 3, "OPEN", "In Progress", "approved"),
+		item("item-4", 4, "CLOSED", "In Review", "approved"),
+		item("item-5", 5, "OPEN", "In Review", "approved", "docs"),
+		{ID: "draft-1"},
+	}
+	return mock
+}
+
+func TestMoveCommand_HasQueryFlag(t *testing.T) {
+	cmd := NewRootCommand()
+	moveCmd, _, err := cmd.Find([]string{"move"})
+	if err != nil {
+		t.Fatalf("move command not found: %v", err)
+	}
+	if moveCmd.Flags().Lookup("query") == nil {
+		t.Fatal("Expected --query flag to exist")
+	}
+}
+
+func TestRunMoveQuery(t *testing.T) {
+	mock := setupMockWithQueryItems()
+	cfg := testMoveConfig()
+	cfg.Fields["status"].Values["in_review"] = "In Review"
+
+	cmd, buf := newTestCmd()
+	opts := &moveOptions{query: "status:in_review label:approved", status: "done", yes: true}
+	if err := runMoveQueryWithDeps(cmd, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(mock.fieldUpdates) != 2 {
+		t.Fatalf("Expected 2 updates, got %+v", mock.fieldUpdates)
+	}
+	for i, want := range []string{"item-1", "item-5"} {
+		u := mock.fieldUpdates[i]
+		if u.itemID != want || u.fieldName != "Status" || u.value != "Done" {
+			t.Errorf("Update %d: expected %s Status → Done, got %+v", i, want, u)
+		}
+	}
+
+	for _, want := range []string{"(2):", "[1/2] ✓ #1", "[2/2] ✓ #5", "Updated 2 of 2 issues"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestRunMoveQuery_DryRunAndConfirmation(t *testing.T) {
+	cfg := testMoveConfig()
+
+	mock := setupMockWithQueryItems()
+	cmd, buf := newTestCmd()
+	opts := &moveOptions{query: "label:approved is:all", status: "done", dryRun: true}
+	if err := runMoveQueryWithDeps(cmd, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 0 {
+		t.Errorf("Expected no updates in dry-run, got %+v", mock.fieldUpdates)
+	}
+	if !strings.Contains(buf.String(), "Dry run") || !strings.Contains(buf.String(), "(4):") {
+		t.Errorf("Expected a preview of 4 issues, got:\n%s", buf.String())
+	}
+
+	// Declining the prompt leaves everything alone
+	cmd, buf = newTestCmd()
+	cmd.SetIn(strings.NewReader("n\n"))
+	opts = &moveOptions{query: "label:approved", priority: "high"}
+	if err := runMoveQueryWithDeps(cmd, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 0 || !strings.Contains(buf.String(), "Aborted.") {
+		t.Errorf("Expected the move to be aborted, got %+v:\n%s", mock.fieldUpdates, buf.String())
+	}
+
+	cmd, _ = newTestCmd()
+	cmd.SetIn(strings.NewReader("y\n"))
+	if err := runMoveQueryWithDeps(cmd, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 3 || mock.fieldUpdates[0].fieldName != "Priority" || mock.fieldUpdates[0].value != "High" {
+		t.Errorf("Expected 3 priority updates, got %+v", mock.fieldUpdates)
+	}
+}
+
+func TestRunMoveQuery_NoMatchesAndFailures(t *testing.T) {
+	cfg := testMoveConfig()
+
+	mock := setupMockWithQueryItems()
+	cmd, buf := newTestCmd()
+	if err := runMoveQueryWithDeps(cmd, &moveOptions{query: "label:missing", status: "done", yes: true}, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No issues match") {
+		t.Errorf("Expected no-match message, got:\n%s", buf.String())
+	}
+
+	mock.setProjectItemErrFor["item-3"] = errors.New("forbidden")
+	cmd, buf = newTestCmd()
+	err := runMoveQueryWithDeps(cmd, &moveOptions{query: "label:approved", status: "done", yes: true}, cfg, mock)
+	if err == nil || !strings.Contains(err.Error(), "1 of 3 issues failed") {
+		t.Errorf("Expected a failure count, got %v", err)
+	}
+	if len(mock.fieldUpdates) != 2 || !strings.Contains(buf.String(), "✗ #3") {
+		t.Errorf("Expected the other issues to be updated, got %+v:\n%s", mock.fieldUpdates, buf.String())
+	}
+}
+
+func TestRunMoveQuery_CheckConflicts(t *testing.T) {
+	mock := setupMockWithQueryItems()
+	cfg := testMoveConfig()
+	cfg.Fields["status"].Values["in_review"] = "In Review"
+
+	// #5 was moved on after the query ran
+	mock.current = map[string]*api.ProjectItem{
+		"testowner/testrepo#5": {ID: "item-5", FieldValues: []api.FieldValue{{Field: "Status", Value: "Done"}}},
+	}
+
+	cmd, buf := newTestCmd()
+	opts := &moveOptions{query: "status:in_review label:approved", status: "todo", yes: true, checkConflicts: true}
+	err := runMoveQueryWithDeps(cmd, opts, cfg, mock)
+	if err == nil || !strings.Contains(err.Error(), "1 of 2 issues failed") {
+		t.Fatalf("Expected one conflict, got %v", err)
+	}
+	if len(mock.fieldUpdates) != 1 || mock.fieldUpdates[0].itemID != "item-1" {
+		t.Errorf("Expected only #1 updated, got %+v", mock.fieldUpdates)
+	}
+	if !strings.Contains(buf.String(), "[2/2] ✗ #5") {
+		t.Errorf("Expected #5 to be skipped, got:\n%s", buf.String())
+	}
+
+	mock.fieldUpdates = nil
+	opts.force = true
+	if err := runMoveQueryWithDeps(cmd, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 2 {
+		t.Errorf("Expected both issues updated with --force, got %+v", mock.fieldUpdates)
+	}
+}
+
+func TestRunMoveQuery_Done(t *testing.T) {
+	mock := setupMockWithQueryItems()
+	cfg := testMoveConfig()
+	cfg.Fields["status"].Values["in_review"] = "In Review"
+	mock.projectItems[0].Issue.ID = "issue-1"
+	mock.projectItems[4].Issue.ID = "issue-5"
+
+	cmd, buf := newTestCmd()
+	opts := &moveOptions{query: "status:in_review label:approved", done: true, comment: "Released", yes: true}
+	if err := runMoveQueryWithDeps(cmd, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.closed) != 2 || mock.comments["issue-5"] != "Released" {
+		t.Errorf("Expected both issues commented and closed, got %v and %v", mock.closed, mock.comments)
+	}
+	if !strings.Contains(buf.String(), "Status → Done") || !strings.Contains(buf.String(), "Close issue") {
+		t.Errorf("Expected the preview to list the close, got:\n%s", buf.String())
+	}
+}
+
+func TestRunMoveQuery_Assignee(t *testing.T) {
+	mock := setupMockWithQueryItems()
+	cfg := testMoveConfig()
+	cfg.Fields["status"].Values["in_review"] = "In Review"
+
+	cmd, buf := newTestCmd()
+	opts := &moveOptions{query: "status:in_review label:approved", unassign: []string{"@me"}, yes: true}
+	if err := runMoveQueryWithDeps(cmd, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 0 {
+		t.Errorf("Expected no field updates, got %+v", mock.fieldUpdates)
+	}
+	if got := mock.unassigned[""]; len(got) != 2 || got[0] != "me" {
+		t.Errorf("Expected @me unassigned from both matches, got %v", mock.unassigned)
+	}
+	if !strings.Contains(buf.String(), "Assignees: -@me") {
+		t.Errorf("Expected the assignee change in the preview, got:\n%s", buf.String())
+	}
+}
@@ -2,9 +2,11 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
 	"github.com/scooter-indie/gh-pmu/internal/config"
@@ -17,7 +19,22 @@ type mockMoveClient struct {
 	project      *api.Project
 	projectItems []api.ProjectItem
 	subIssues    map[string][]api.SubIssue // "owner/repo#number" -> SubIssues
-	fieldUpdates []fieldUpdate             // track field updates for verification
+	fields       []api.ProjectField
+	fieldUpdates []fieldUpdate // track field updates for verification
+	batchCalls   int           // SetProjectItemFields calls
+
+	// Items as re-read for conflict checks, "owner/repo#number" -> item;
+	// other issues are re-read unchanged from projectItems
+	current map[string]*api.ProjectItem
+
+	comments map[string]string // issueID -> comment body
+	closed   []string          // closed issue IDs
+	closeErr error
+	reopened []string // reopened issue IDs
+
+	assigned   map[string][]string // issueID -> assigned logins
+	unassigned map[string][]string // issueID -> unassigned logins
+	assignErr  error
 
 	// Error injection
 	getIssueErr          error
@@ -81,6 +98,75 @@ func (m *mockMoveClient) GetSubIssues(owner, repo string, number int) ([]api.Sub
 	return result, nil
 }
 
+func (m *mockMoveClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	key := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+	if item, ok := m.current[key]; ok {
+		return item, nil
+	}
+	for i, item := range m.projectItems {
+		if item.Issue != nil && item.Issue.Number == number && item.Issue.Repository.Owner == owner && item.Issue.Repository.Name == repo {
+			return &m.projectItems[i], nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockMoveClient) AddIssueComment(issueID, body string) (string, error) {
+	if m.comments == nil {
+		m.comments = map[string]string{}
+	}
+	m.comments[issueID] = body
+	return "https://github.com/comment", nil
+}
+
+func (m *mockMoveClient) CloseIssue(issueID string) error {
+	if m.closeErr != nil {
+		return m.closeErr
+	}
+	m.closed = append(m.closed, issueID)
+	return nil
+}
+
+func (m *mockMoveClient) ResolveOwner(owner string) (string, error) {
+	if owner == "@me" {
+		return "me", nil
+	}
+	return owner, nil
+}
+
+func (m *mockMoveClient) ReopenIssue(issueID string) error {
+	m.reopened = append(m.reopened, issueID)
+	return nil
+}
+
+func (m *mockMoveClient) AddIssueAssignees(issueID string, logins []string) error {
+	if m.assignErr != nil {
+		return m.assignErr
+	}
+	if m.assigned == nil {
+		m.assigned = map[string][]string{}
+	}
+	m.assigned[issueID] = append(m.assigned[issueID], logins...)
+	return nil
+}
+
+func (m *mockMoveClient) RemoveIssueAssignees(issueID string, logins []string) error {
+	if m.unassigned == nil {
+		m.unassigned = map[string][]string{}
+	}
+	m.unassigned[issueID] = append(m.unassigned[issueID], logins...)
+	return nil
+}
+
+func (m *mockMoveClient) GetProjectFields(projectID string) ([]api.ProjectField, error) {
+	return m.fields, nil
+}
+
+// ClearProjectItemField records the clear as an update with an empty value
+func (m *mockMoveClient) ClearProjectItemField(projectID, itemID, fieldName string) error {
+	return m.SetProjectItemField(projectID, itemID, fieldName, "")
+}
+
 func (m *mockMoveClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
 	if m.setProjectItemErr != nil {
 		return m.setProjectItemErr
@@ -97,6 +183,16 @@ func (m *mockMoveClient) SetProjectItemField(projectID, itemID, fieldName, value
 	return nil
 }
 
+// SetProjectItemFields records each update as SetProjectItemField would
+func (m *mockMoveClient) SetProjectItemFields(projectID string, updates []api.ItemFieldUpdate) []error {
+	m.batchCalls++
+	errs := make([]error, len(updates))
+	for i, u := range updates {
+		errs[i] = m.SetProjectItemField(projectID, u.ItemID, u.Field, u.Value)
+	}
+	return errs
+}
+
 // Test helpers
 
 func testMoveConfig() *config.Config {
@@ -1114,3 +1210,268 @@ func TestCollectSubIssuesRecursive_MaxDepthZero(t *testing.T) {
 		t.Errorf("Expected 0 sub-issues with maxDepth=0, got %d", len(result))
 	}
 }
+
+func TestRunMoveWithDeps_IterationUpdate(t *testing.T) {
+	mock := setupMockWithIssue(123, "Test Issue", "item-123")
+	mock.fields = []api.ProjectField{
+		{Name: "Status", DataType: "SINGLE_SELECT"},
+		{Name: "Sprint", DataType: "ITERATION", Iterations: []api.Iteration{
+			{ID: "it-1", Title: "Sprint 1", StartDate: "2020-01-06", Duration: 14},
+			{ID: "it-2", Title: "Sprint 2", StartDate: "2020-01-20", Duration: 14},
+		}},
+	}
+	cfg := testMoveConfig()
+
+	cmd := &cobra.Command{}
+	opts := &moveOptions{iteration: "sprint 2"}
+	if err := runMoveWithDeps(cmd, []string{"123"}, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 1 || mock.fieldUpdates[0].fieldName != "Sprint" || mock.fieldUpdates[0].value != "Sprint 2" {
+		t.Fatalf("Expected Sprint → Sprint 2, got %+v", mock.fieldUpdates)
+	}
+
+	// none takes the issue out of its sprint
+	mock.fieldUpdates = nil
+	opts = &moveOptions{status: "done", iteration: "none"}
+	if err := runMoveWithDeps(cmd, []string{"123"}, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 2 || mock.fieldUpdates[1].fieldName != "Sprint" || mock.fieldUpdates[1].value != "" {
+		t.Fatalf("Expected status set and Sprint cleared, got %+v", mock.fieldUpdates)
+	}
+
+	// An unknown iteration fails before anything is changed
+	mock.fieldUpdates = nil
+	opts = &moveOptions{status: "done", iteration: "Sprint 9"}
+	if err := runMoveWithDeps(cmd, []string{"123"}, opts, cfg, mock); err == nil || !strings.Contains(err.Error(), `iteration "Sprint 9" not found`) {
+		t.Errorf("Expected iteration not found error, got %v", err)
+	}
+	if len(mock.fieldUpdates) != 0 {
+		t.Errorf("Expected no updates, got %+v", mock.fieldUpdates)
+	}
+}
+
+func TestResolveMoveIteration_NoIterationField(t *testing.T) {
+	mock := newMockMoveClient()
+	mock.fields = []api.ProjectField{{Name: "Status", DataType: "SINGLE_SELECT"}}
+
+	for _, spec := range []string{"none", "next"} {
+		if _, err := resolveMoveIteration(mock, testMoveConfig(), "proj-1", spec, time.Now()); err == nil || !strings.Contains(err.Error(), "no iteration field") {
+			t.Errorf("%s: expected missing iteration field error, got %v", spec, err)
+		}
+	}
+}
+
+func TestRunMoveWithDeps_CheckConflicts(t *testing.T) {
+	cfg := testMoveConfig()
+	opts := &moveOptions{status: "done", checkConflicts: true}
+
+	// Unchanged: the move goes ahead
+	mock := setupMockWithIssue(123, "Test Issue", "item-123")
+	cmd, _ := newTestCmd()
+	if err := runMoveWithDeps(cmd, []string{"123"}, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 1 {
+		t.Errorf("Expected the update, got %+v", mock.fieldUpdates)
+	}
+
+	// Someone moved it in the meantime: nothing is changed
+	mock = setupMockWithIssue(123, "Test Issue", "item-123")
+	mock.current = map[string]*api.ProjectItem{
+		"testowner/testrepo#123": {ID: "item-123", FieldValues: []api.FieldValue{{Field: "Status", Value: "In Review"}}},
+	}
+	err := runMoveWithDeps(cmd, []string{"123"}, opts, cfg, mock)
+	if err == nil || !strings.Contains(err.Error(), "Status: (none) → In Review") || !strings.Contains(err.Error(), "--force") {
+		t.Errorf("Expected conflict error, got %v", err)
+	}
+	if len(mock.fieldUpdates) != 0 {
+		t.Errorf("Expected no updates, got %+v", mock.fieldUpdates)
+	}
+
+	// --force updates it anyway
+	force := &moveOptions{status: "done", checkConflicts: true, force: true}
+	if err := runMoveWithDeps(cmd, []string{"123"}, force, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(mock.fieldUpdates) != 1 {
+		t.Errorf("Expected the forced update, got %+v", mock.fieldUpdates)
+	}
+}
+
+func TestRunMoveWithDeps_Done(t *testing.T) {
+	mock := setupMockWithIssue(123, "Test Issue", "item-123")
+	cfg := testMoveConfig()
+
+	cmd, _ := newTestCmd()
+	opts := &moveOptions{done: true, comment: "Shipped in v1.4"}
+	if err := runMoveWithDeps(cmd, []string{"123"}, opts, cfg, mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(mock.fieldUpdates) != 1 || mock.fieldUpdates[0].fieldName != "Status" || mock.fieldUpdates[0].value != "Done" {
+		t.Errorf("Expected Status → Done, got %+v", mock.fieldUpdates)
+	}
+	if mock.comments["issue-123"] != "Shipped in v1.4" {
+		t.Errorf("Expected closing comment, got %v", mock.comments)
+	}
+	if len(mock.closed) != 1 || mock.closed[0] != "issue-123" {
+		t.Errorf("Expected the issue to be closed, got %v", mock.closed)
+	}
+}
+
+func TestRunMoveWithDeps_CommentWithStatus(t *testing.T) {
+	mock := setupMockWithIssue(123, "Test Issue", "item-123")
+
+	cmd, _ := newTestCmd()
+	opts := &moveOptions{status: "blocked", comment: "Waiting on the auth team"}
+	if err := runMoveWithDeps(cmd, []string{"123"}, opts, testMoveConfig(), mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(mock.fieldUpdates) != 1 || mock.fieldUpdates[0].value != "blocked" {
+		t.Errorf("Expected the status set, got %+v", mock.fieldUpdates)
+	}
+	if mock.comments["issue-123"] != "Waiting on the auth team" {
+		t.Errorf("Expected the reason commented, got %v", mock.comments)
+	}
+	if len(mock.closed) != 0 {
+		t.Errorf("Expected the issue left open, got %v", mock.closed)
+	}
+}
+
+func TestRunMoveWithDeps_DoneRecursive(t *testing.T) {
+	mock := setupMockWithIssue(1, "Epic", "item-1")
+	repo := api.Repository{Owner: "testowner", Name: "testrepo"}
+	mock.projectItems = append(mock.projectItems,
+		api.ProjectItem{ID: "item-2", Issue: &api.Issue{Number: 2, Repository: repo}},
+		api.ProjectItem{ID: "item-3", Issue: &api.Issue{Number: 3, Repository: repo}},
+	)
+	mock.subIssues["testowner/testrepo#1"] = []api.SubIssue{
+		{ID: "issue-2", Number: 2, Title: "Open task", State: "OPEN", Repository: repo},
+		{ID: "issue-3", Number: 3, Title: "Closed task", State: "CLOSED", Repository: repo},
+	}
+
+	cmd, _ := newTestCmd()
+	opts := &moveOptions{done: true, comment: "All done", recursive: true, depth: 10, yes: true}
+	if err := runMoveWithDeps(cmd, []string{"1"}, opts, testMoveConfig(), mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(mock.fieldUpdates) != 3 {
+		t.Errorf("Expected all three moved to Done, got %+v", mock.fieldUpdates)
+	}
+	if len(mock.closed) != 2 || mock.closed[0] != "issue-1" || mock.closed[1] != "issue-2" {
+		t.Errorf("Expected the epic and its open sub-issue closed, got %v", mock.closed)
+	}
+	if len(mock.comments) != 1 || mock.comments["issue-1"] != "All done" {
+		t.Errorf("Expected the comment on the epic only, got %v", mock.comments)
+	}
+}
+
+func TestRunMoveWithDeps_DoneCloseFails(t *testing.T) {
+	mock := setupMockWithIssue(123, "Test Issue", "item-123")
+	mock.closeErr = fmt.Errorf("forbidden")
+
+	cmd, _ := newTestCmd()
+	if err := runMoveWithDeps(cmd, []string{"123"}, &moveOptions{done: true}, testMoveConfig(), mock); err != nil {
+		t.Fatalf("Expected a warning only, got %v", err)
+	}
+	if len(mock.fieldUpdates) != 1 || len(mock.closed) != 0 {
+		t.Errorf("Expected status set but issue left open, got %+v and %v", mock.fieldUpdates, mock.closed)
+	}
+}
+
+func TestMoveCommand_DoneFlagValidation(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"move", "1", "--done", "--status", "todo"}, "--done cannot be used with --status"},
+		{[]string{"move", "1", "--status", "todo", "--comment", "x", "--queue"}, "--queue only supports"},
+	}
+	for _, tt := range tests {
+		cmd := NewRootCommand()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.SetArgs(tt.args)
+		if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: expected %q, got %v", tt.args, tt.want, err)
+		}
+	}
+}
+
+func TestRunMoveWithDeps_AssigneeAndJSON(t *testing.T) {
+	mock := setupMockWithIssue(42, "Dark mode", "item-42")
+
+	cmd, buf := newTestCmd()
+	opts := &moveOptions{status: "in_progress", assignees: []string{"@me"}, unassign: []string{"bob"}, json: true}
+	if err := runMoveWithDeps(cmd, []string{"42"}, opts, testMoveConfig(), mock); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if got := mock.assigned["issue-42"]; len(got) != 1 || got[0] != "me" {
+		t.Errorf("Expected @me resolved and assigned, got %v", mock.assigned)
+	}
+	if got := mock.unassigned["issue-42"]; len(got) != 1 || got[0] != "bob" {
+		t.Errorf("Expected bob unassigned, got %v", mock.unassigned)
+	}
+
+	var results []moveJSONResult
+	if err := json.Unmarshal(buf.Bytes(), &results); err != nil {
+		t.Fatalf("Expected JSON output, got %q: %v", buf.String(), err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("Expected one result, got %+v", results)
+	}
+	r := results[0]
+	if r.Issue != "testowner/testrepo#42" || !r.Updated || len(r.Assigned) != 1 || r.Assigned[0] != "me" || len(r.Unassigned) != 1 {
+		t.Errorf("Unexpected result %+v", r)
+	}
+	if len(r.Changes) != 2 || r.Changes[0] != "Status → In Progress" || r.Changes[1] != "Assignees: +@me -@bob" {
+		t.Errorf("Unexpected changes %v", r.Changes)
+	}
+}
+
+func TestRunMoveWithDeps_AssigneeOnlyFails(t *testing.T) {
+	mock := setupMockWithIssue(42, "Dark mode", "item-42")
+	mock.assignErr = fmt.Errorf("user not found")
+
+	cmd, buf := newTestCmd()
+	opts := &moveOptions{assignees: []string{"ghost"}, json: true}
+	if err := runMoveWithDeps(cmd, []string{"42"}, opts, testMoveConfig(), mock); err != nil {
+		t.Fatalf("Expected a warning only, got %v", err)
+	}
+	if len(mock.fieldUpdates) != 0 {
+		t.Errorf("Expected no field updates, got %+v", mock.fieldUpdates)
+	}
+
+	var results []moveJSONResult
+	if err := json.Unmarshal(buf.Bytes(), &results); err != nil {
+		t.Fatalf("Expected JSON output, got %q: %v", buf.String(), err)
+	}
+	if len(results) != 1 || results[0].Updated || !strings.Contains(results[0].Error, "failed to update assignees for #42") {
+		t.Errorf("Expected the failure in the result, got %+v", results)
+	}
+}
+
+func TestMoveCommand_AssigneeAndJSONValidation(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"move", "1", "--json"}, "at least one of"},
+		{[]string{"move", "1", "--assignee", "@me", "--json", "--recursive"}, "--json cannot be used with --recursive"},
+		{[]string{"move", "1", "--assignee", "@me", "--queue"}, "--queue only supports --status and --priority"},
+	}
+	for _, tt := range tests {
+		cmd := NewRootCommand()
+		cmd.SetOut(new(bytes.Buffer))
+		cmd.SetErr(new(bytes.Buffer))
+		cmd.SetArgs(tt.args)
+		if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: expected %q, got %v", tt.args, tt.want, err)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/journal"
+	"github.com/spf13/cobra"
+)
+
+// actorLogins returns the logins of actors
+func actorLogins(actors []api.Actor) []string {
+	var logins []string
+	for _, a := range actors {
+		logins = append(logins, a.Login)
+	}
+	return logins
+}
+
+// applyJournaledFieldChanges sets each field on an item like
+// applyMoveFieldChanges, recording in change every field set with the
+// value it replaced
+func applyJournaledFieldChanges(client moveClient, projectID, itemID string, changes []moveFieldChange, read []api.FieldValue, change *journal.Change) error {
+	for _, c := range changes {
+		if err := applyMoveFieldChanges(client, projectID, itemID, []moveFieldChange{c}); err != nil {
+			return err
+		}
+		recordMoveField(change, read, c)
+	}
+	return nil
+}
+
+// recordMoveField records a field set by a move, with its value as read
+func recordMoveField(change *journal.Change, read []api.FieldValue, c moveFieldChange) {
+	old := getFieldValue(api.ProjectItem{FieldValues: read}, c.field)
+	change.Fields = append(change.Fields, journal.FieldChange{Field: c.field, Old: old, New: c.value})
+}
+
+// recordMove adds a move to the project's undo journal. The move has been
+// made, so a journal that cannot be written is only a warning.
+func recordMove(cfg *config.Config, opts *moveOptions, entry journal.Entry) {
+	if opts.journal == "" {
+		return
+	}
+	j, err := journal.Load(opts.journal)
+	if err == nil {
+		j.Project = fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number)
+		if j.Record(entry, time.Now()) {
+			err = j.Save(opts.journal)
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: the move cannot be undone: %v\n", err)
+	}
+}
+
+// runMoveUndoWithDeps is the testable implementation of move --undo. It
+// reverts the last recorded move: fields are set back to the values they
+// replaced, assignee changes are reversed, and closed issues are reopened.
+// A field changed again since the move is left alone.
+func runMoveUndoWithDeps(cmd *cobra.Command, opts *moveOptions, cfg *config.Config, client moveClient, path string) error {
+	j, err := journal.Load(path)
+	if err != nil {
+		return err
+	}
+	entry := j.Last()
+	if entry == nil {
+		cmd.Println("Nothing to undo")
+		return nil
+	}
+
+	if opts.dryRun {
+		cmd.Println("Dry run - no changes will be made")
+		cmd.Println()
+	}
+	cmd.Printf("Undoing the move of %d issues from %s: %s\n\n", len(entry.Changes), formatHistoryTime(entry.At), entry.Summary)
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+	byItemID := make(map[string]api.ProjectItem)
+	for _, item := range items {
+		byItemID[item.ID] = item
+	}
+
+	failed := 0
+	for _, change := range entry.Changes {
+		item, ok := byItemID[change.ItemID]
+		if !ok {
+			cmd.Printf("✗ %s - no longer in the project\n", change.Issue)
+			failed++
+			continue
+		}
+
+		if opts.dryRun {
+			cmd.Printf("• %s\n", change.Issue)
+		}
+		if err := revertMoveChange(cmd, client, project.ID, item, change, opts.dryRun); err != nil {
+			cmd.Printf("✗ %s - %v\n", change.Issue, err)
+			failed++
+			continue
+		}
+		if !opts.dryRun {
+			cmd.Printf("✓ %s - reverted\n", change.Issue)
+		}
+	}
+
+	if opts.dryRun {
+		return nil
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d issues failed to revert; run 'gh pmu move --undo' again to retry", failed, len(entry.Changes))
+	}
+
+	j.Pop()
+	if err := j.Save(path); err != nil {
+		return err
+	}
+	cmd.Printf("\n✓ Undid the move of %d issues\n", len(entry.Changes))
+	return nil
+}
+
+// revertMoveChange reverts what a move changed on one issue, or with
+// dryRun only checks that it can
+func revertMoveChange(cmd *cobra.Command, client moveClient, projectID string, item api.ProjectItem, change journal.Change, dryRun bool) error {
+	for _, f := range change.Fields {
+		current := getFieldValue(item, f.Field)
+		if !strings.EqualFold(current, f.New) {
+			fmt.Fprintf(os.Stderr, "Warning: %s %s is now %s, not %s; left alone\n",
+				change.Issue, f.Field, conflictValue(current), conflictValue(f.New))
+			continue
+		}
+		if dryRun {
+			cmd.Printf("  • %s → %s\n", f.Field, conflictValue(f.Old))
+			continue
+		}
+		if err := applyMoveFieldChanges(client, projectID, item.ID, []moveFieldChange{{field: f.Field, value: f.Old}}); err != nil {
+			return err
+		}
+	}
+
+	if desc := moveAssigneeChange(change.Unassigned, change.Assigned); desc != "" && dryRun {
+		cmd.Printf("  • %s\n", desc)
+	}
+	if change.Closed && dryRun {
+		cmd.Println("  • Reopen issue")
+	}
+	if dryRun {
+		return nil
+	}
+
+	if len(change.Assigned) > 0 {
+		if err := client.RemoveIssueAssignees(change.IssueID, change.Assigned); err != nil {
+			return fmt.Errorf("failed to unassign: %w", err)
+		}
+	}
+	if len(change.Unassigned) > 0 {
+		if err := client.AddIssueAssignees(change.IssueID, change.Unassigned); err != nil {
+			return fmt.Errorf("failed to reassign: %w", err)
+		}
+	}
+	if change.Closed && item.Issue != nil && strings.EqualFold(item.Issue.State, "CLOSED") {
+		if err := client.ReopenIssue(change.IssueID); err != nil {
+			return fmt.Errorf("failed to reopen: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/journal"
+)
+
+func TestMoveUndo_RevertsSingleMove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.json")
+	mock := setupMockWithIssue(42, "Fix login", "item-42")
+	mock.issues["testowner/testrepo#42"].State = "OPEN"
+	mock.projectItems[0].Issue.ID = "issue-42"
+	mock.projectItems[0].FieldValues = []api.FieldValue{{Field: "Status", Value: "In Progress"}, {Field: "Priority", Value: "Low"}}
+	cfg := testMoveConfig()
+
+	cmd, _ := newTestCmd()
+	opts := &moveOptions{done: true, priority: "high", assignees: []string{"@me"}, journal: path}
+	if err := runMoveWithDeps(cmd, []string{"42"}, opts, cfg, mock); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+
+	j, _ := journal.Load(path)
+	last := j.Last()
+	if last == nil || len(last.Changes) != 1 {
+		t.Fatalf("Expected the move recorded, got %+v", j)
+	}
+	change := last.Changes[0]
+	wantFields := []journal.FieldChange{{Field: "Status", Old: "In Progress", New: "Done"}, {Field: "Priority", Old: "Low", New: "High"}}
+	if len(change.Fields) != 2 || change.Fields[0] != wantFields[0] || change.Fields[1] != wantFields[1] {
+		t.Errorf("Unexpected fields %+v", change.Fields)
+	}
+	if !change.Closed || len(change.Assigned) != 1 || change.Assigned[0] != "me" {
+		t.Errorf("Expected the close and assignment recorded, got %+v", change)
+	}
+
+	// The project as it is after the move
+	mock.projectItems[0].FieldValues = []api.FieldValue{{Field: "Status", Value: "Done"}, {Field: "Priority", Value: "High"}}
+	mock.projectItems[0].Issue.State = "CLOSED"
+	mock.fieldUpdates = nil
+
+	undoCmd, buf := newTestCmd()
+	if err := runMoveUndoWithDeps(undoCmd, &moveOptions{}, cfg, mock, path); err != nil {
+		t.Fatalf("undo failed: %v", err)
+	}
+	if len(mock.fieldUpdates) != 2 || mock.fieldUpdates[0].value != "In Progress" || mock.fieldUpdates[1].value != "Low" {
+		t.Errorf("Expected the fields set back, got %+v", mock.fieldUpdates)
+	}
+	if len(mock.reopened) != 1 || mock.reopened[0] != "issue-42" {
+		t.Errorf("Expected the issue reopened, got %v", mock.reopened)
+	}
+	if got := mock.unassigned["issue-42"]; len(got) != 1 || got[0] != "me" {
+		t.Errorf("Expected the assignment reversed, got %v", got)
+	}
+	if !strings.Contains(buf.String(), "✓ testowner/testrepo#42 - reverted") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+
+	j, _ = journal.Load(path)
+	if j.Last() != nil {
+		t.Error("Expected the undone move removed from the journal")
+	}
+	undoCmd, buf = newTestCmd()
+	if err := runMoveUndoWithDeps(undoCmd, &moveOptions{}, cfg, mock, path); err != nil || !strings.Contains(buf.String(), "Nothing to undo") {
+		t.Errorf("Expected nothing to undo, got %v:\n%s", err, buf.String())
+	}
+}
+
+func TestMoveUndo_LeavesFieldsChangedSince(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.json")
+	mock := setupMockWithIssues(1, 2)
+	for i := range mock.projectItems {
+		mock.projectItems[i].FieldValues = []api.FieldValue{{Field: "Status", Value: "Todo"}}
+	}
+	cfg := testMoveConfig()
+
+	cmd, _ := newTestCmd()
+	if err := runMoveBatchWithDeps(cmd, []string{"1", "2"}, &moveOptions{status: "done", journal: path}, cfg, mock); err != nil {
+		t.Fatalf("move failed: %v", err)
+	}
+
+	// #1 is still Done, but someone moved #2 on since
+	mock.projectItems[0].FieldValues = []api.FieldValue{{Field: "Status", Value: "Done"}}
+	mock.projectItems[1].FieldValues = []api.FieldValue{{Field: "Status", Value: "In Progress"}}
+	mock.fieldUpdates = nil
+
+	dryCmd, dryBuf := newTestCmd()
+	if err := runMoveUndoWithDeps(dryCmd, &moveOptions{dryRun: true}, cfg, mock, path); err != nil {
+		t.Fatalf("dry run failed: %v", err)
+	}
+	if len(mock.fieldUpdates) != 0 || !strings.Contains(dryBuf.String(), "Status → Todo") {
+		t.Errorf("Expected a preview only, got %+v:\n%s", mock.fieldUpdates, dryBuf.String())
+	}
+
+	undoCmd, _ := newTestCmd()
+	if err := runMoveUndoWithDeps(undoCmd, &moveOptions{}, cfg, mock, path); err != nil {
+		t.Fatalf("undo failed: %v", err)
+	}
+	if len(mock.fieldUpdates) != 1 || mock.fieldUpdates[0].itemID != "item-1" || mock.fieldUpdates[0].value != "Todo" {
+		t.Errorf("Expected only #1 set back, got %+v", mock.fieldUpdates)
+	}
+}
+
+func TestMoveUndo_RejectsOtherFlags(t *testing.T) {
+	cmd, _ := newTestCmd()
+	err := runMove(cmd, []string{"42"}, &moveOptions{undo: true})
+	if err == nil || !strings.Contains(err.Error(), "--undo takes no issue or changes") {
+		t.Errorf("Expected --undo validation error, got %v", err)
+	}
+}
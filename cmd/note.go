@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/notes"
+	"github.com/spf13/cobra"
+)
+
+func newNoteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "note",
+		Short: "Keep private notes on project issues",
+		Long: `Keep private working notes on project issues.
+
+Notes are stored locally, per project, in the user config directory and
+are never written to GitHub. They are shown alongside issues in 'list'
+and 'view', and included in their JSON output as "notes".`,
+	}
+
+	cmd.AddCommand(newNoteAddCommand())
+	cmd.AddCommand(newNoteListCommand())
+	cmd.AddCommand(newNoteRemoveCommand())
+
+	return cmd
+}
+
+func newNoteAddCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <issue> <text>",
+		Short: "Add a note to an issue",
+		Example: `  gh pmu note add 42 "waiting on legal"
+  gh pmu note add owner/repo#42 "follow up after the release"`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := loadNoteConfig()
+			if err != nil {
+				return err
+			}
+			return runNoteAddWithDeps(cmd, args, cfg, path, time.Now())
+		},
+	}
+
+	return cmd
+}
+
+type noteListOptions struct {
+	json bool
+}
+
+func newNoteListCommand() *cobra.Command {
+	opts := &noteListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list [issue]",
+		Short: "List notes for an issue, or all notes",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := loadNoteConfig()
+			if err != nil {
+				return err
+			}
+			return runNoteListWithDeps(cmd, args, opts, cfg, path)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+
+	return cmd
+}
+
+type noteRemoveOptions struct {
+	all bool
+}
+
+func newNoteRemoveCommand() *cobra.Command {
+	opts := &noteRemoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove <issue> [note-id]",
+		Short: "Remove a note, or all notes with --all",
+		Example: `  # Remove note 3 from issue 42
+  gh pmu note remove 42 3
+
+  # Remove every note on issue 42
+  gh pmu note remove 42 --all`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := loadNoteConfig()
+			if err != nil {
+				return err
+			}
+			return runNoteRemoveWithDeps(cmd, args, opts, cfg, path)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Remove all notes on the issue")
+
+	return cmd
+}
+
+// loadNoteConfig loads the project config and the location of its notes file
+func loadNoteConfig() (*config.Config, string, error) {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return nil, "", err
+	}
+
+	path, err := notes.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, "", err
+	}
+	return cfg, path, nil
+}
+
+// loadProjectNotes reads the notes kept for the configured project. Notes
+// are supplementary, so a missing or unreadable file yields no notes.
+func loadProjectNotes(cfg *config.Config) *notes.Store {
+	path, err := notes.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil
+	}
+	store, err := notes.Load(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		return nil
+	}
+	return store
+}
+
+// runNoteAddWithDeps is the testable implementation of note add
+func runNoteAddWithDeps(cmd *cobra.Command, args []string, cfg *config.Config, path string, now time.Time) error {
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	text := strings.TrimSpace(strings.Join(args[1:], " "))
+	if text == "" {
+		return fmt.Errorf("note text cannot be empty")
+	}
+
+	store, err := notes.Load(path)
+	if err != nil {
+		return err
+	}
+	store.Project = fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number)
+
+	note := store.Add(key, text, now)
+	if err := store.Save(path); err != nil {
+		return err
+	}
+
+	cmd.Printf("Added note %d to %s\n", note.ID, key)
+	return nil
+}
+
+// NoteJSON is a note in JSON output
+type NoteJSON struct {
+	Issue     string `json:"issue,omitempty"` // owner/repo#number, in note list output
+	ID        int    `json:"id"`
+	Text      string `json:"text"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// buildNotesJSON converts an issue's notes for JSON output
+func buildNotesJSON(list []notes.Note) []NoteJSON {
+	if len(list) == 0 {
+		return nil
+	}
+	out := make([]NoteJSON, 0, len(list))
+	for _, n := range list {
+		out = append(out, NoteJSON{ID: n.ID, Text: n.Text, CreatedAt: n.CreatedAt})
+	}
+	return out
+}
+
+// runNoteListWithDeps is the testable implementation of note list
+func runNoteListWithDeps(cmd *cobra.Command, args []string, opts *noteListOptions, cfg *config.Config, path string) error {
+	store, err := notes.Load(path)
+	if err != nil {
+		return err
+	}
+
+	keys := store.Keys()
+	if len(args) == 1 {
+		key, err := issueKey(cfg, args[0])
+		if err != nil {
+			return err
+		}
+		keys = []string{key}
+	}
+
+	var entries []NoteJSON
+	for _, key := range keys {
+		for _, n := range store.List(key) {
+			entries = append(entries, NoteJSON{Issue: key, ID: n.ID, Text: n.Text, CreatedAt: n.CreatedAt})
+		}
+	}
+
+	if opts.json {
+		if entries == nil {
+			entries = []NoteJSON{}
+		}
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(entries)
+	}
+
+	if len(entries) == 0 {
+		cmd.Println("No notes found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tISSUE\tADDED\tNOTE")
+	for _, e := range entries {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", e.ID, e.Issue, formatHistoryTime(e.CreatedAt), e.Text)
+	}
+	return w.Flush()
+}
+
+// runNoteRemoveWithDeps is the testable implementation of note remove
+func runNoteRemoveWithDeps(cmd *cobra.Command, args []string, opts *noteRemoveOptions, cfg *config.Config, path string) error {
+	if opts.all == (len(args) == 2) {
+		return fmt.Errorf("specify either a note ID or --all")
+	}
+
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	store, err := notes.Load(path)
+	if err != nil {
+		return err
+	}
+
+	if opts.all {
+		n := store.Clear(key)
+		if err := store.Save(path); err != nil {
+			return err
+		}
+		cmd.Printf("Removed %d note(s) from %s\n", n, key)
+		return nil
+	}
+
+	id, err := strconv.Atoi(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid note ID %q", args[1])
+	}
+	if !store.Remove(key, id) {
+		return fmt.Errorf("note %d not found on %s", id, key)
+	}
+	if err := store.Save(path); err != nil {
+		return err
+	}
+
+	cmd.Printf("Removed note %d from %s\n", id, key)
+	return nil
+}
@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/notes"
+)
+
+func TestNoteCommand_HasSubcommands(t *testing.T) {
+	cmd := NewRootCommand()
+	noteCmd, _, err := cmd.Find([]string{"note"})
+	if err != nil {
+		t.Fatalf("note command not found: %v", err)
+	}
+
+	for _, name := range []string{"add", "list", "remove"} {
+		if sub, _, err := noteCmd.Find([]string{name}); err != nil || sub.Name() != name {
+			t.Errorf("Expected note %s subcommand", name)
+		}
+	}
+}
+
+func TestRunNoteAdd(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.json")
+	cfg := newTestConfig()
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	cmd, buf := newTestCmd()
+	if err := runNoteAddWithDeps(cmd, []string{"42", "waiting", "on", "legal"}, cfg, path, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Added note 1 to owner/repo#42") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+
+	cmd, _ = newTestCmd()
+	if err := runNoteAddWithDeps(cmd, []string{"other/repo#7", "check with infra"}, cfg, path, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	store, err := notes.Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if got := store.List("owner/repo#42"); len(got) != 1 || got[0].Text != "waiting on legal" {
+		t.Errorf("Unexpected notes on #42: %+v", got)
+	}
+	if got := store.List("other/repo#7"); len(got) != 1 {
+		t.Errorf("Expected a note on other/repo#7, got %+v", got)
+	}
+	if store.Project != "owner/1" {
+		t.Errorf("Expected project owner/1, got %q", store.Project)
+	}
+
+	cmd, _ = newTestCmd()
+	if err := runNoteAddWithDeps(cmd, []string{"42", "  "}, cfg, path, now); err == nil {
+		t.Error("Expected error for empty note text")
+	}
+}
+
+func TestRunNoteList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.json")
+	cfg := newTestConfig()
+
+	cmd, buf := newTestCmd()
+	if err := runNoteListWithDeps(cmd, nil, &noteListOptions{}, cfg, path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No notes found") {
+		t.Errorf("Expected empty message, got: %s", buf.String())
+	}
+
+	store := &notes.Store{}
+	store.Add("owner/repo#42", "waiting on legal", time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC))
+	store.Add("owner/repo#43", "needs repro", time.Date(2025, 3, 2, 9, 0, 0, 0, time.UTC))
+	if err := store.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, buf = newTestCmd()
+	if err := runNoteListWithDeps(cmd, nil, &noteListOptions{}, cfg, path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, want := range []string{"owner/repo#42", "2025-03-01 09:00", "waiting on legal", "needs repro"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, buf.String())
+		}
+	}
+
+	cmd, buf = newTestCmd()
+	if err := runNoteListWithDeps(cmd, []string{"43"}, &noteListOptions{json: true}, cfg, path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var entries []NoteJSON
+	if err := json.Unmarshal(buf.Bytes(), &entries); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Issue != "owner/repo#43" || entries[0].ID != 2 {
+		t.Errorf("Unexpected entries: %+v", entries)
+	}
+}
+
+func TestRunNoteRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.json")
+	cfg := newTestConfig()
+
+	store := &notes.Store{}
+	store.Add("owner/repo#42", "one", time.Now())
+	store.Add("owner/repo#42", "two", time.Now())
+	store.Add("owner/repo#43", "three", time.Now())
+	if err := store.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd, buf := newTestCmd()
+	if err := runNoteRemoveWithDeps(cmd, []string{"42", "1"}, &noteRemoveOptions{}, cfg, path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Removed note 1") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+
+	cmd, _ = newTestCmd()
+	if err := runNoteRemoveWithDeps(cmd, []string{"42", "1"}, &noteRemoveOptions{}, cfg, path); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+
+	cmd, buf = newTestCmd()
+	if err := runNoteRemoveWithDeps(cmd, []string{"43"}, &noteRemoveOptions{all: true}, cfg, path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Removed 1 note(s)") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+
+	loaded, err := notes.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if keys := loaded.Keys(); len(keys) != 1 || len(loaded.List("owner/repo#42")) != 1 {
+		t.Errorf("Unexpected remaining notes: %+v", loaded.Notes)
+	}
+
+	for _, tt := range []struct {
+		args []string
+		all  bool
+	}{
+		{[]string{"42"}, false},
+		{[]string{"42", "2"}, true},
+		{[]string{"42", "x"}, false},
+	} {
+		cmd, _ = newTestCmd()
+		if err := runNoteRemoveWithDeps(cmd, tt.args, &noteRemoveOptions{all: tt.all}, cfg, path); err == nil {
+			t.Errorf("Expected error for args %v (all=%v)", tt.args, tt.all)
+		}
+	}
+}
+
+func TestFormatItemNotes(t *testing.T) {
+	if got := formatItemNotes(nil); got != "-" {
+		t.Errorf("formatItemNotes(nil) = %q", got)
+	}
+
+	list := []notes.Note{{Text: "first"}, {Text: strings.Repeat("x", 50)}}
+	if got := formatItemNotes(list); got != strings.Repeat("x", 37)+"... (+1)" {
+		t.Errorf("formatItemNotes() = %q", got)
+	}
+}
+
+func TestOutputTable_ShowsNotesColumn(t *testing.T) {
+	store := &notes.Store{}
+	store.Add("owner/repo#1", "waiting on legal", time.Now())
+
+	items := []api.ProjectItem{
+		{Issue: &api.Issue{Number: 1, Title: "Noted", Repository: api.Repository{Owner: "owner", Name: "repo"}}},
+		{Issue: &api.Issue{Number: 2, Title: "Plain", Repository: api.Repository{Owner: "owner", Name: "repo"}}},
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	cmd, _ := newTestCmd()
+	err := outputTable(cmd, items, store)
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("outputTable() error = %v", err)
+	}
+	output, _ := io.ReadAll(r)
+	if !strings.Contains(string(output), "NOTES") || !strings.Contains(string(output), "waiting on legal") {
+		t.Errorf("Expected notes column, got:\n%s", output)
+	}
+
+	jsonOut := buildListJSON(items, store)
+	if len(jsonOut.Items[0].Notes) != 1 || jsonOut.Items[1].Notes != nil {
+		t.Errorf("Unexpected JSON notes: %+v", jsonOut.Items)
+	}
+}
+
+func TestOutputViewTable_Notes(t *testing.T) {
+	issue := &api.Issue{Number: 3, Title: "Noted", State: "OPEN", Author: api.Actor{Login: "author"}}
+	list := []notes.Note{{ID: 4, Text: "waiting on legal", CreatedAt: "2025-03-01T09:00:00Z"}}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := outputViewTableWithOptions(createViewTestCmd(new(bytes.Buffer)), issue, nil, nil, nil, nil, viewTableOptions{notes: list})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("outputViewTableWithOptions() error = %v", err)
+	}
+	output, _ := io.ReadAll(r)
+	if !strings.Contains(string(output), "Notes (local):\n  [4] 2025-03-01 09:00  waiting on legal") {
+		t.Errorf("Expected local notes section, got:\n%s", output)
+	}
+}
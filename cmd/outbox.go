@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/outbox"
+	"github.com/spf13/cobra"
+)
+
+// syncPushClient defines the interface for API methods used by sync --push.
+// This allows for easier testing with mock implementations.
+type syncPushClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+	ClearProjectItemField(projectID, itemID, fieldName string) error
+}
+
+// moveQueueClient defines the interface for API methods used by move --queue.
+// This allows for easier testing with mock implementations.
+type moveQueueClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+}
+
+// runMoveQueueWithDeps is the testable implementation of move --queue. It
+// records the changes in the outbox without making them, each with the
+// field's value on GitHub as the base a push checks for conflicts. Offline,
+// the changes are queued without a base.
+func runMoveQueueWithDeps(cmd *cobra.Command, args []string, opts *moveOptions, cfg *config.Config, client moveQueueClient, path string, now time.Time) error {
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	var changes []moveFieldChange
+	if opts.status != "" {
+		changes = append(changes, moveFieldChange{field: "Status", value: cfg.ResolveFieldValue("status", opts.status)})
+	}
+	if opts.priority != "" {
+		changes = append(changes, moveFieldChange{field: "Priority", value: cfg.ResolveFieldValue("priority", opts.priority)})
+	}
+
+	box, err := outbox.Load(path)
+	if err != nil {
+		return err
+	}
+
+	item, err := readQueuedItem(client, cfg, key)
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: could not read the current values of %s (%v); the push checks for conflicts by change time instead\n", key, err)
+	}
+
+	box.Project = fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number)
+	for _, c := range changes {
+		var base *string
+		if item != nil {
+			value := queuedFieldValue(item, c.field).Value
+			base = &value
+		}
+		box.Add(key, c.field, c.value, base, now)
+	}
+	if err := box.Save(path); err != nil {
+		return err
+	}
+
+	cmd.Printf("✓ Queued %s\n", key)
+	for _, c := range changes {
+		cmd.Printf("  • %s\n", c)
+	}
+	cmd.Printf("%d change(s) waiting; push them with 'gh pmu sync --push'\n", len(box.Changes))
+	return nil
+}
+
+// readQueuedItem reads the project item of the issue key (owner/repo#number)
+func readQueuedItem(client moveQueueClient, cfg *config.Config, key string) (*api.ProjectItem, error) {
+	owner, repo, number, err := parseIssueReference(key)
+	if err != nil {
+		return nil, err
+	}
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	item, err := client.GetIssueProjectItem(owner, repo, number, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	if item == nil {
+		return nil, fmt.Errorf("not in the project")
+	}
+	return item, nil
+}
+
+// queuedFieldValue returns the item's value of a field, empty if unset
+func queuedFieldValue(item *api.ProjectItem, field string) api.FieldValue {
+	for _, fv := range item.FieldValues {
+		if strings.EqualFold(fv.Field, field) {
+			return fv
+		}
+	}
+	return api.FieldValue{}
+}
+
+// runSyncPushWithDeps pushes the queued changes in the outbox to the
+// project. A change is held back as a conflict when its field no longer
+// has the value it was queued from, unless force is set. Changes that
+// were pushed, or are already in place, leave the outbox.
+func runSyncPushWithDeps(cmd *cobra.Command, cfg *config.Config, client syncPushClient, path string, force bool) error {
+	box, err := outbox.Load(path)
+	if err != nil {
+		return err
+	}
+	if len(box.Changes) == 0 {
+		cmd.Println("Nothing to push")
+		return nil
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var remaining []outbox.Change
+	pushed := 0
+	for _, change := range box.Changes {
+		desc := fmt.Sprintf("%s %s", change.Issue, moveFieldChange{field: change.Field, value: change.Value})
+		if err := pushQueuedChange(client, project.ID, change, force); err != nil {
+			remaining = append(remaining, change)
+			cmd.Printf("✗ %s\n", desc)
+			fmt.Fprintf(os.Stderr, "Warning: %s: %v\n", change.Issue, err)
+			continue
+		}
+		pushed++
+		cmd.Printf("✓ %s\n", desc)
+	}
+
+	box.Changes = remaining
+	if err := box.Save(path); err != nil {
+		return err
+	}
+
+	cmd.Printf("\nPushed %d of %d queued change(s)\n", pushed, pushed+len(remaining))
+	if len(remaining) > 0 {
+		return fmt.Errorf("%d change(s) could not be pushed and remain queued; fix the conflicts or push with --force", len(remaining))
+	}
+	return nil
+}
+
+// pushQueuedChange applies one queued change, checking for a conflicting
+// change made on GitHub since it was queued. A change queued without a
+// base falls back to the time the field was last changed, which the API
+// reports for single-select fields only.
+func pushQueuedChange(client syncPushClient, projectID string, change outbox.Change, force bool) error {
+	owner, repo, number, err := parseIssueReference(change.Issue)
+	if err != nil {
+		return err
+	}
+
+	item, err := client.GetIssueProjectItem(owner, repo, number, projectID)
+	if err != nil {
+		return err
+	}
+	if item == nil {
+		return fmt.Errorf("not in the project")
+	}
+
+	current := queuedFieldValue(item, change.Field)
+	if current.Value == change.Value {
+		return nil
+	}
+
+	switch {
+	case force:
+	case change.Base != nil && current.Value != *change.Base:
+		return fmt.Errorf("conflict: %s was changed from %s to %s after this change was queued",
+			change.Field, conflictValue(*change.Base), conflictValue(current.Value))
+	case change.Base == nil && changedSince(current.UpdatedAt, change.QueuedAt):
+		return fmt.Errorf("conflict: %s was changed to %s at %s, after this change was queued",
+			change.Field, conflictValue(current.Value), current.UpdatedAt)
+	}
+
+	if change.Value == "" {
+		return client.ClearProjectItemField(projectID, item.ID, change.Field)
+	}
+	return client.SetProjectItemField(projectID, item.ID, change.Field, change.Value)
+}
+
+// changedSince reports whether the RFC 3339 time updatedAt is after queuedAt
+func changedSince(updatedAt, queuedAt string) bool {
+	updated, err := time.Parse(time.RFC3339, updatedAt)
+	if err != nil {
+		return false
+	}
+	queued, err := time.Parse(time.RFC3339, queuedAt)
+	if err != nil {
+		return false
+	}
+	return updated.After(queued)
+}
@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/outbox"
+)
+
+// mockSyncPushClient implements syncPushClient interface for testing
+type mockSyncPushClient struct {
+	items  map[int]*api.ProjectItem // issue number -> item
+	setErr error
+
+	sets []string // "itemID Field=value"
+}
+
+func (m *mockSyncPushClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockSyncPushClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	return m.items[number], nil
+}
+
+func (m *mockSyncPushClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	if m.setErr != nil {
+		return m.setErr
+	}
+	m.sets = append(m.sets, itemID+" "+fieldName+"="+value)
+	return nil
+}
+
+func (m *mockSyncPushClient) ClearProjectItemField(projectID, itemID, fieldName string) error {
+	return m.SetProjectItemField(projectID, itemID, fieldName, "")
+}
+
+func TestRunMoveQueue(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.json")
+	cfg := testMoveConfig()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	client := &mockSyncPushClient{items: map[int]*api.ProjectItem{
+		42: {ID: "item-42", FieldValues: []api.FieldValue{{Field: "Status", Value: "In Progress"}}},
+	}}
+
+	cmd, buf := newTestCmd()
+	if err := runMoveQueueWithDeps(cmd, []string{"42"}, &moveOptions{status: "done", priority: "high", queue: true}, cfg, client, path, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// #7 cannot be read, as when offline
+	if err := runMoveQueueWithDeps(cmd, []string{"7"}, &moveOptions{status: "todo", queue: true}, cfg, client, path, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	box, err := outbox.Load(path)
+	if err != nil {
+		t.Fatalf("Failed to load outbox: %v", err)
+	}
+	if box.Project != "testowner/1" || len(box.Changes) != 3 {
+		t.Fatalf("Unexpected outbox: %+v", box)
+	}
+	want := outbox.Change{Issue: "testowner/testrepo#42", Field: "Status", Value: "Done", QueuedAt: "2026-03-02T09:00:00Z"}
+	if got := box.Changes[0]; got.Base == nil || *got.Base != "In Progress" {
+		t.Errorf("Changes[0].Base = %v, want In Progress", got.Base)
+	} else if got.Base = nil; got != want {
+		t.Errorf("Changes[0] = %+v, want %+v", got, want)
+	}
+	// An unset field is an empty base; an unread one has none
+	if base := box.Changes[1].Base; base == nil || *base != "" {
+		t.Errorf("Expected an empty Priority base, got %v", base)
+	}
+	if box.Changes[2].Base != nil {
+		t.Errorf("Expected no base for #7, got %q", *box.Changes[2].Base)
+	}
+	if !strings.Contains(buf.String(), "Warning: could not read the current values of testowner/testrepo#7 (not in the project)") {
+		t.Errorf("Expected a warning for #7, got:\n%s", buf.String())
+	}
+	for _, s := range []string{"Queued testowner/testrepo#42", "Priority → High", "3 change(s) waiting"} {
+		if !strings.Contains(buf.String(), s) {
+			t.Errorf("Expected output to contain %q, got:\n%s", s, buf.String())
+		}
+	}
+}
+
+func TestRunSyncPush(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.json")
+	queued := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	todo, high, estimate := "Todo", "High", "3"
+
+	box := &outbox.Outbox{Project: "testowner/1"}
+	box.Add("testowner/testrepo#1", "Status", "Done", &todo, queued)    // pushed
+	box.Add("testowner/testrepo#2", "Status", "Done", &todo, queued)    // already done
+	box.Add("testowner/testrepo#3", "Status", "Done", &todo, queued)    // changed on GitHub since
+	box.Add("testowner/testrepo#4", "Status", "Done", &todo, queued)    // not in the project
+	box.Add("testowner/testrepo#5", "Priority", "", &high, queued)      // cleared; unchanged since
+	box.Add("testowner/testrepo#6", "Estimate", "5", &estimate, queued) // changed, with no change time
+	box.Add("testowner/testrepo#7", "Status", "Done", nil, queued)      // queued offline; changed before
+	if err := box.Save(path); err != nil {
+		t.Fatalf("Failed to save outbox: %v", err)
+	}
+
+	client := &mockSyncPushClient{items: map[int]*api.ProjectItem{
+		1: {ID: "item-1", FieldValues: []api.FieldValue{{Field: "Status", Value: "Todo", UpdatedAt: "2026-03-01T09:00:00Z"}}},
+		2: {ID: "item-2", FieldValues: []api.FieldValue{{Field: "Status", Value: "Done", UpdatedAt: "2026-03-02T10:00:00Z"}}},
+		3: {ID: "item-3", FieldValues: []api.FieldValue{{Field: "Status", Value: "In Review", UpdatedAt: "2026-03-02T09:30:00Z"}}},
+		5: {ID: "item-5", FieldValues: []api.FieldValue{{Field: "Priority", Value: "High", UpdatedAt: "2026-03-02T08:00:00Z"}}},
+		6: {ID: "item-6", FieldValues: []api.FieldValue{{Field: "Estimate", Value: "8"}}},
+		7: {ID: "item-7", FieldValues: []api.FieldValue{{Field: "Status", Value: "Todo", UpdatedAt: "2026-03-01T09:00:00Z"}}},
+	}}
+
+	cmd, buf := newTestCmd()
+	err := runSyncPushWithDeps(cmd, testMoveConfig(), client, path, false)
+	if err == nil || !strings.Contains(err.Error(), "3 change(s) could not be pushed") {
+		t.Fatalf("Expected 3 changes left, got %v", err)
+	}
+	if strings.Join(client.sets, ", ") != "item-1 Status=Done, item-5 Priority=, item-7 Status=Done" {
+		t.Errorf("Unexpected updates: %v", client.sets)
+	}
+	if !strings.Contains(buf.String(), "✗ testowner/testrepo#3 Status → Done") || !strings.Contains(buf.String(), "Pushed 4 of 7") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+
+	box, _ = outbox.Load(path)
+	var left []string
+	for _, c := range box.Changes {
+		left = append(left, c.Issue)
+	}
+	if strings.Join(left, " ") != "testowner/testrepo#3 testowner/testrepo#4 testowner/testrepo#6" {
+		t.Errorf("Expected the conflicts and the missing item to stay queued, got %+v", box.Changes)
+	}
+
+	// --force pushes the conflicts; the missing item still fails
+	client.sets = nil
+	cmd, _ = newTestCmd()
+	if err := runSyncPushWithDeps(cmd, testMoveConfig(), client, path, true); err == nil {
+		t.Error("Expected the missing item to fail")
+	}
+	if strings.Join(client.sets, ", ") != "item-3 Status=Done, item-6 Estimate=5" {
+		t.Errorf("Expected the forced updates, got %v", client.sets)
+	}
+}
+
+func TestRunSyncPush_EmptyAndErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox.json")
+	cmd, buf := newTestCmd()
+	if err := runSyncPushWithDeps(cmd, testMoveConfig(), &mockSyncPushClient{}, path, false); err != nil || !strings.Contains(buf.String(), "Nothing to push") {
+		t.Errorf("Expected nothing to push, got %v and %q", err, buf.String())
+	}
+
+	box := &outbox.Outbox{}
+	box.Add("testowner/testrepo#1", "Status", "Done", nil, time.Now())
+	if err := box.Save(path); err != nil {
+		t.Fatalf("Failed to save outbox: %v", err)
+	}
+	client := &mockSyncPushClient{
+		items:  map[int]*api.ProjectItem{1: {ID: "item-1"}},
+		setErr: errors.New("offline"),
+	}
+	if err := runSyncPushWithDeps(cmd, testMoveConfig(), client, path, false); err == nil {
+		t.Error("Expected push error")
+	}
+	if box, _ := outbox.Load(path); len(box.Changes) != 1 {
+		t.Errorf("Expected the change to stay queued, got %+v", box.Changes)
+	}
+}
+
+func TestMoveCommand_QueueValidation(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"move", "1", "--queue", "--done"}, "--queue only supports"},
+		{[]string{"move", "1", "--queue", "--status", "done", "--recursive"}, "--queue only supports"},
+		{[]string{"move", "1", "--queue"}, "--queue requires --status or --priority"},
+	}
+	for _, tt := range tests {
+		cmd := NewRootCommand()
+		cmd.SetArgs(tt.args)
+		cmd.SilenceUsage = true
+		cmd.SilenceErrors = true
+		if err := cmd.Execute(); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: expected %q, got %v", tt.args, tt.want, err)
+		}
+	}
+}
@@ -0,0 +1,404 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/notify"
+	"github.com/scooter-indie/gh-pmu/internal/planmail"
+	"github.com/spf13/cobra"
+)
+
+type planMailOptions struct {
+	dryRun    bool
+	send      bool
+	assignees []string
+	template  string
+}
+
+// planMailClient defines the interface for API methods used by plan-mail.
+// This allows for easier testing with mock implementations.
+type planMailClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+}
+
+// planMailSender delivers a rendered plan mail
+type planMailSender interface {
+	Send(mail notify.Mail, now time.Time) error
+}
+
+// defaultDueFieldName is the project date field read for due dates when
+// no "due" field alias is configured
+const defaultDueFieldName = "Due Date"
+
+// planMailDays is how many days, from today, count as this week
+const planMailDays = 7
+
+// defaultPlanMailTemplate renders a plan mail. A first line starting with
+// "Subject:" sets the subject; the rest, after a blank line, is the body.
+const defaultPlanMailTemplate = `Subject: Weekly plan for @{{.Assignee}}: {{len .Overdue}} overdue, {{len .DueThisWeek}} due this week
+
+Hi @{{.Assignee}},
+
+Here is your plan in {{.Project}} for {{.WeekStart}} to {{.WeekEnd}}.
+{{- if .Overdue}}
+
+Overdue:
+{{- range .Overdue}}
+  - {{.Ref}} {{.Title}} (due {{.Due}}{{if .Status}}, {{.Status}}{{end}})
+{{- end}}
+{{- end}}
+{{- if .DueThisWeek}}
+
+Due this week:
+{{- range .DueThisWeek}}
+  - {{.Ref}} {{.Title}} (due {{.Due}}{{if .Status}}, {{.Status}}{{end}})
+{{- end}}
+{{- end}}
+{{- if .NewlyAssigned}}
+
+Newly assigned since your last plan:
+{{- range .NewlyAssigned}}
+  - {{.Ref}} {{.Title}}{{if .Status}} ({{.Status}}){{end}}
+{{- end}}
+{{- end}}
+{{- if .FirstMail}}
+
+This is your first plan mail; from the next one on, it also lists newly
+assigned issues.
+{{- end}}
+`
+
+// planMailItem is an issue listed in a plan mail
+type planMailItem struct {
+	Ref    string // owner/repo#number
+	Number int
+	Title  string
+	URL    string
+	Status string
+	Due    string // YYYY-MM-DD, if set
+}
+
+// planMailDigest is what a plan mail tells one assignee; it is the data
+// the template is rendered with
+type planMailDigest struct {
+	Assignee      string
+	Address       string
+	Project       string
+	WeekStart     string // YYYY-MM-DD
+	WeekEnd       string // YYYY-MM-DD
+	FirstMail     bool   // Nothing to compare against, so nothing is new
+	NewlyAssigned []planMailItem
+	DueThisWeek   []planMailItem
+	Overdue       []planMailItem
+
+	assigned []string // Every open issue assigned, recorded once sent
+}
+
+// empty reports whether there is nothing to mail about
+func (d *planMailDigest) empty() bool {
+	return len(d.NewlyAssigned) == 0 && len(d.DueThisWeek) == 0 && len(d.Overdue) == 0
+}
+
+func newPlanMailCommand() *cobra.Command {
+	opts := &planMailOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "plan-mail",
+		Short: "Email each assignee a weekly plan",
+		Long: `Email each assignee a summary of their open project issues: what is
+overdue, what is due in the next 7 days, and what was assigned to them
+since their last plan mail.
+
+Due dates come from the project date field mapped to the "due" alias in
+.gh-pmu.yml, or "Due Date". Email is sent through the SMTP server in
+notify.email, to the addresses in notify.email.recipients:
+
+  notify:
+    email:
+      smtp: smtp.example.com:587
+      from: pm@example.com
+      password_env: PLAN_MAIL_PASSWORD
+      recipients:
+        alice: alice@example.com
+
+--dry-run prints the mails without sending them. --send delivers them and
+remembers what each assignee had, so the next mail lists only newly
+assigned issues. Assignees with nothing to report get no mail.
+
+--template renders the mails from a Go template file instead of the
+built-in one. A first line starting with "Subject:" sets the subject.
+The template sees .Assignee, .Project, .WeekStart, .WeekEnd, .FirstMail,
+and the lists .Overdue, .DueThisWeek, and .NewlyAssigned, whose issues
+have .Ref, .Number, .Title, .URL, .Status, and .Due.`,
+		Example: `  gh pmu plan-mail --dry-run
+  gh pmu plan-mail --send
+  gh pmu plan-mail --send --assignee alice --template .github/plan-mail.tmpl`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			path, err := planmail.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+			if err != nil {
+				return err
+			}
+			var sender planMailSender
+			if opts.send {
+				if sender, err = newPlanMailSender(cfg); err != nil {
+					return err
+				}
+			}
+			return runPlanMailWithDeps(cmd, opts, cfg, api.NewClient(), sender, path, time.Now())
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print the mails without sending them")
+	cmd.Flags().BoolVar(&opts.send, "send", false, "Send the mails")
+	cmd.Flags().StringArrayVar(&opts.assignees, "assignee", nil, "Only mail this assignee (can be specified multiple times)")
+	cmd.Flags().StringVar(&opts.template, "template", "", "Go template file to render the mails with")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "send")
+	cmd.MarkFlagsOneRequired("dry-run", "send")
+
+	return cmd
+}
+
+// newPlanMailSender returns a mailer for notify.email
+func newPlanMailSender(cfg *config.Config) (planMailSender, error) {
+	if cfg.Notify == nil || cfg.Notify.Email == nil {
+		return nil, fmt.Errorf("--send needs notify.email in %s", config.ConfigFileName)
+	}
+	email := cfg.Notify.Email
+	var password string
+	if email.PasswordEnv != "" {
+		password = os.Getenv(email.PasswordEnv)
+		if password == "" {
+			return nil, fmt.Errorf("notify.email.password_env: $%s is not set", email.PasswordEnv)
+		}
+	}
+	return &notify.Mailer{Addr: email.SMTP, From: email.From, Username: email.Username, Password: password}, nil
+}
+
+// runPlanMailWithDeps is the testable implementation of plan-mail
+func runPlanMailWithDeps(cmd *cobra.Command, opts *planMailOptions, cfg *config.Config, client planMailClient, sender planMailSender, statePath string, now time.Time) error {
+	tmpl, err := loadPlanMailTemplate(opts.template)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := planmail.Load(statePath)
+	if err != nil {
+		return err
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	digests := buildPlanMailDigests(cfg, project, items, snapshot, opts.assignees, now)
+	if len(digests) == 0 {
+		cmd.Println("No assigned open issues")
+		return nil
+	}
+
+	var sent, skipped, failed int
+	var noAddress []string
+	for _, d := range digests {
+		if d.empty() {
+			// Still remember what they have, so it is not new next time
+			if !opts.dryRun {
+				snapshot.Record(d.Assignee, d.assigned, now)
+			}
+			skipped++
+			continue
+		}
+
+		mail, err := renderPlanMail(tmpl, d)
+		if err != nil {
+			return err
+		}
+		if d.Address == "" {
+			noAddress = append(noAddress, "@"+d.Assignee)
+		}
+
+		if opts.dryRun {
+			to := "(no address)"
+			if d.Address != "" {
+				to = d.Address
+			}
+			cmd.Printf("To: @%s %s\nSubject: %s\n\n%s\n---\n", d.Assignee, to, mail.Subject, strings.TrimRight(mail.Body, "\n"))
+			continue
+		}
+		if d.Address == "" {
+			continue
+		}
+
+		if err := sender.Send(mail, now); err != nil {
+			cmd.PrintErrf("Failed to send plan mail to @%s: %v\n", d.Assignee, err)
+			failed++
+			continue
+		}
+		snapshot.Record(d.Assignee, d.assigned, now)
+		sent++
+		cmd.Printf("Sent plan mail to @%s (%s)\n", d.Assignee, d.Address)
+	}
+
+	if len(noAddress) > 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: no address in notify.email.recipients for %s\n", strings.Join(noAddress, ", "))
+	}
+
+	if opts.dryRun {
+		cmd.Printf("\nWould send %d plan mail(s); %d assignee(s) have nothing to report\n", len(digests)-skipped-len(noAddress), skipped)
+		return nil
+	}
+
+	snapshot.Project = fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number)
+	if err := snapshot.Save(statePath); err != nil {
+		return err
+	}
+
+	cmd.Printf("\nSent %d plan mail(s); %d assignee(s) had nothing to report\n", sent, skipped)
+	if failed > 0 {
+		return fmt.Errorf("%d plan mail(s) failed to send", failed)
+	}
+	return nil
+}
+
+// loadPlanMailTemplate parses the template file at path, or the built-in
+// template when path is empty
+func loadPlanMailTemplate(path string) (*template.Template, error) {
+	text := defaultPlanMailTemplate
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template: %w", err)
+		}
+		text = string(data)
+	}
+	tmpl, err := template.New("plan-mail").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// renderPlanMail renders a digest into a mail. A leading "Subject:" line
+// sets the subject; otherwise a default one is used.
+func renderPlanMail(tmpl *template.Template, d *planMailDigest) (notify.Mail, error) {
+	var b strings.Builder
+	if err := tmpl.Execute(&b, d); err != nil {
+		return notify.Mail{}, fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	mail := notify.Mail{To: d.Address, Subject: "Weekly plan for @" + d.Assignee, Body: b.String()}
+	first, rest, _ := strings.Cut(mail.Body, "\n")
+	if subject, ok := strings.CutPrefix(first, "Subject:"); ok {
+		mail.Subject = strings.TrimSpace(subject)
+		mail.Body = strings.TrimLeft(rest, "\n")
+	}
+	return mail, nil
+}
+
+// buildPlanMailDigests groups the open issues in the project by assignee,
+// sorted by login. only, if given, limits the assignees.
+func buildPlanMailDigests(cfg *config.Config, project *api.Project, items []api.ProjectItem, snapshot *planmail.Snapshot, only []string, now time.Time) []*planMailDigest {
+	dueField := cfg.FieldNameOr("due", defaultDueFieldName)
+	statusField := cfg.GetFieldName("status")
+	today := now.Format("2006-01-02")
+	weekEnd := now.AddDate(0, 0, planMailDays-1).Format("2006-01-02")
+
+	for i := range only {
+		only[i] = strings.TrimPrefix(only[i], "@")
+	}
+
+	var recipients map[string]string
+	if cfg.Notify != nil && cfg.Notify.Email != nil {
+		recipients = cfg.Notify.Email.Recipients
+	}
+
+	byLogin := map[string]*planMailDigest{}
+	entries := map[string]planMailItem{}
+	for _, item := range items {
+		if item.Issue == nil || item.IsArchived || !strings.EqualFold(item.Issue.State, "OPEN") {
+			continue
+		}
+		entry := planMailItem{
+			Ref:    itemKey(item),
+			Number: item.Issue.Number,
+			Title:  item.Issue.Title,
+			URL:    item.Issue.URL,
+			Status: getFieldValue(item, statusField),
+			Due:    getFieldValue(item, dueField),
+		}
+		entries[entry.Ref] = entry
+
+		for _, login := range actorLogins(item.Issue.Assignees) {
+			if len(only) > 0 && !containsFold(only, login) {
+				continue
+			}
+			d := byLogin[login]
+			if d == nil {
+				d = &planMailDigest{
+					Assignee:  login,
+					Address:   recipients[login],
+					Project:   project.Title,
+					WeekStart: today,
+					WeekEnd:   weekEnd,
+					FirstMail: !snapshot.Known(login),
+				}
+				byLogin[login] = d
+			}
+			d.assigned = append(d.assigned, entry.Ref)
+
+			switch {
+			case entry.Due != "" && entry.Due < today:
+				d.Overdue = append(d.Overdue, entry)
+			case entry.Due != "" && entry.Due <= weekEnd:
+				d.DueThisWeek = append(d.DueThisWeek, entry)
+			}
+		}
+	}
+
+	digests := make([]*planMailDigest, 0, len(byLogin))
+	for _, d := range byLogin {
+		if !d.FirstMail {
+			for _, ref := range snapshot.New(d.Assignee, d.assigned) {
+				d.NewlyAssigned = append(d.NewlyAssigned, entries[ref])
+			}
+		}
+		sortPlanMailItems(d.Overdue)
+		sortPlanMailItems(d.DueThisWeek)
+		sortPlanMailItems(d.NewlyAssigned)
+		digests = append(digests, d)
+	}
+	sort.Slice(digests, func(i, j int) bool { return digests[i].Assignee < digests[j].Assignee })
+	return digests
+}
+
+// sortPlanMailItems sorts issues by due date, those without one last, then
+// by reference
+func sortPlanMailItems(items []planMailItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Due != b.Due {
+			if a.Due == "" || b.Due == "" {
+				return a.Due != ""
+			}
+			return a.Due < b.Due
+		}
+		return a.Ref < b.Ref
+	})
+}
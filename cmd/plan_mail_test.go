@@ -0,0 +1,262 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/notify"
+	"github.com/scooter-indie/gh-pmu/internal/planmail"
+)
+
+// mockPlanMailClient implements planMailClient interface for testing
+type mockPlanMailClient struct {
+	items []api.ProjectItem
+}
+
+func (m *mockPlanMailClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1", Number: number, Title: "Roadmap"}, nil
+}
+
+func (m *mockPlanMailClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+// mockPlanMailSender records the mails it is asked to send
+type mockPlanMailSender struct {
+	sent []notify.Mail
+	fail map[string]bool // Addresses that fail
+}
+
+func (m *mockPlanMailSender) Send(mail notify.Mail, now time.Time) error {
+	if m.fail[mail.To] {
+		return errors.New("refused")
+	}
+	m.sent = append(m.sent, mail)
+	return nil
+}
+
+func newPlanMailTestItem(number int, state, due string, assignees ...string) api.ProjectItem {
+	item := api.ProjectItem{
+		ID: "item",
+		Issue: &api.Issue{
+			Number:     number,
+			Title:      "Issue " + strings.Repeat("I", number),
+			State:      state,
+			Repository: api.Repository{Owner: "owner", Name: "repo"},
+		},
+	}
+	for _, login := range assignees {
+		item.Issue.Assignees = append(item.Issue.Assignees, api.Actor{Login: login})
+	}
+	if due != "" {
+		item.FieldValues = append(item.FieldValues, api.FieldValue{Field: "Due Date", Value: due})
+	}
+	return item
+}
+
+func newPlanMailTestConfig() *config.Config {
+	cfg := newTestConfig()
+	cfg.Notify = &config.Notify{Email: &config.EmailNotify{
+		SMTP:       "smtp.example.com:587",
+		From:       "pm@example.com",
+		Recipients: map[string]string{"alice": "alice@example.com", "bob": "bob@example.com"},
+	}}
+	return cfg
+}
+
+func TestBuildPlanMailDigests(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	items := []api.ProjectItem{
+		newPlanMailTestItem(1, "OPEN", "2026-10-10", "alice"),
+		newPlanMailTestItem(2, "OPEN", "2026-10-22", "alice", "bob"),
+		newPlanMailTestItem(3, "OPEN", "2026-10-23", "alice"),
+		newPlanMailTestItem(4, "CLOSED", "2026-10-01", "alice"),
+		newPlanMailTestItem(5, "OPEN", "", "alice"),
+	}
+	snapshot := &planmail.Snapshot{}
+	snapshot.Record("alice", []string{"owner/repo#1", "owner/repo#2"}, now)
+
+	digests := buildPlanMailDigests(newPlanMailTestConfig(), &api.Project{Title: "Roadmap"}, items, snapshot, nil, now)
+	if len(digests) != 2 || digests[0].Assignee != "alice" || digests[1].Assignee != "bob" {
+		t.Fatalf("Expected digests for alice and bob, got %+v", digests)
+	}
+
+	alice := digests[0]
+	refs := func(items []planMailItem) string {
+		var out []string
+		for _, i := range items {
+			out = append(out, i.Ref)
+		}
+		return strings.Join(out, ",")
+	}
+	if refs(alice.Overdue) != "owner/repo#1" {
+		t.Errorf("Overdue = %s", refs(alice.Overdue))
+	}
+	// The week is today and the 6 days after it
+	if refs(alice.DueThisWeek) != "owner/repo#2" {
+		t.Errorf("DueThisWeek = %s", refs(alice.DueThisWeek))
+	}
+	if refs(alice.NewlyAssigned) != "owner/repo#3,owner/repo#5" || alice.FirstMail {
+		t.Errorf("NewlyAssigned = %s", refs(alice.NewlyAssigned))
+	}
+	if alice.Address != "alice@example.com" || alice.WeekStart != "2026-10-16" || alice.WeekEnd != "2026-10-22" {
+		t.Errorf("Unexpected digest: %+v", alice)
+	}
+
+	// bob has never been mailed, so nothing counts as new
+	if !digests[1].FirstMail || len(digests[1].NewlyAssigned) != 0 {
+		t.Errorf("Expected bob's first mail, got %+v", digests[1])
+	}
+
+	digests = buildPlanMailDigests(newPlanMailTestConfig(), &api.Project{}, items, snapshot, []string{"@bob"}, now)
+	if len(digests) != 1 || digests[0].Assignee != "bob" {
+		t.Errorf("Expected only bob, got %+v", digests)
+	}
+}
+
+func TestRenderPlanMail(t *testing.T) {
+	tmpl, err := loadPlanMailTemplate("")
+	if err != nil {
+		t.Fatalf("loadPlanMailTemplate() error = %v", err)
+	}
+	mail, err := renderPlanMail(tmpl, &planMailDigest{
+		Assignee:    "alice",
+		Address:     "alice@example.com",
+		Project:     "Roadmap",
+		WeekStart:   "2026-10-16",
+		WeekEnd:     "2026-10-22",
+		Overdue:     []planMailItem{{Ref: "owner/repo#1", Title: "Fix login", Due: "2026-10-10", Status: "In Progress"}},
+		DueThisWeek: []planMailItem{{Ref: "owner/repo#2", Title: "Ship docs", Due: "2026-10-20"}},
+	})
+	if err != nil {
+		t.Fatalf("renderPlanMail() error = %v", err)
+	}
+
+	if mail.Subject != "Weekly plan for @alice: 1 overdue, 1 due this week" || mail.To != "alice@example.com" {
+		t.Errorf("Unexpected mail: %+v", mail)
+	}
+	want := `Hi @alice,
+
+Here is your plan in Roadmap for 2026-10-16 to 2026-10-22.
+
+Overdue:
+  - owner/repo#1 Fix login (due 2026-10-10, In Progress)
+
+Due this week:
+  - owner/repo#2 Ship docs (due 2026-10-20)
+`
+	if mail.Body != want {
+		t.Errorf("Body =\n%s\nwant\n%s", mail.Body, want)
+	}
+
+	// A template without a subject line gets the default subject
+	path := filepath.Join(t.TempDir(), "plan.tmpl")
+	if err := os.WriteFile(path, []byte("{{len .Overdue}} late"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	tmpl, _ = loadPlanMailTemplate(path)
+	mail, _ = renderPlanMail(tmpl, &planMailDigest{Assignee: "bob"})
+	if mail.Subject != "Weekly plan for @bob" || mail.Body != "0 late" {
+		t.Errorf("Unexpected custom mail: %+v", mail)
+	}
+}
+
+func TestRunPlanMail(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	client := &mockPlanMailClient{items: []api.ProjectItem{
+		newPlanMailTestItem(1, "OPEN", "2026-10-10", "alice"),
+		newPlanMailTestItem(2, "OPEN", "", "bob"),
+		newPlanMailTestItem(3, "OPEN", "2026-10-17", "carol"),
+	}}
+
+	t.Run("dry run", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "state.json")
+		cmd, buf := newTestCmd()
+		var stderr strings.Builder
+		cmd.SetErr(&stderr)
+		if err := runPlanMailWithDeps(cmd, &planMailOptions{dryRun: true}, newPlanMailTestConfig(), client, nil, statePath, now); err != nil {
+			t.Fatalf("runPlanMailWithDeps() error = %v", err)
+		}
+		out := buf.String()
+		if !strings.Contains(out, "To: @alice alice@example.com\nSubject: Weekly plan for @alice: 1 overdue") {
+			t.Errorf("Expected alice's mail, got:\n%s", out)
+		}
+		if !strings.Contains(out, "To: @carol (no address)") || !strings.Contains(stderr.String(), "no address in notify.email.recipients for @carol") {
+			t.Errorf("Expected carol without an address, got:\n%s\n%s", out, stderr.String())
+		}
+		if !strings.Contains(out, "Would send 1 plan mail(s); 1 assignee(s) have nothing to report") {
+			t.Errorf("Unexpected summary:\n%s", out)
+		}
+		if _, err := os.Stat(statePath); !os.IsNotExist(err) {
+			t.Error("Expected a dry run not to save the snapshot")
+		}
+	})
+
+	t.Run("send", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "state.json")
+		sender := &mockPlanMailSender{}
+		cmd, buf := newTestCmd()
+		cmd.SetErr(&strings.Builder{})
+		if err := runPlanMailWithDeps(cmd, &planMailOptions{send: true}, newPlanMailTestConfig(), client, sender, statePath, now); err != nil {
+			t.Fatalf("runPlanMailWithDeps() error = %v", err)
+		}
+		if len(sender.sent) != 1 || sender.sent[0].To != "alice@example.com" {
+			t.Errorf("Expected one mail to alice, got %+v", sender.sent)
+		}
+		if !strings.Contains(buf.String(), "Sent 1 plan mail(s); 1 assignee(s) had nothing to report") {
+			t.Errorf("Unexpected output:\n%s", buf.String())
+		}
+
+		// alice was mailed and bob had nothing to report; carol has no address
+		snapshot, err := planmail.Load(statePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !snapshot.Known("alice") || !snapshot.Known("bob") || snapshot.Known("carol") || snapshot.Project != "owner/1" {
+			t.Errorf("Unexpected snapshot: %+v", snapshot)
+		}
+	})
+
+	t.Run("send failure", func(t *testing.T) {
+		statePath := filepath.Join(t.TempDir(), "state.json")
+		sender := &mockPlanMailSender{fail: map[string]bool{"alice@example.com": true}}
+		cmd, _ := newTestCmd()
+		cmd.SetErr(&strings.Builder{})
+		err := runPlanMailWithDeps(cmd, &planMailOptions{send: true}, newPlanMailTestConfig(), client, sender, statePath, now)
+		if err == nil || !strings.Contains(err.Error(), "1 plan mail(s) failed to send") {
+			t.Errorf("Expected a send failure, got %v", err)
+		}
+		// alice's issues must still count as new next time
+		if snapshot, _ := planmail.Load(statePath); snapshot.Known("alice") {
+			t.Error("Expected alice not recorded after a failed send")
+		}
+	})
+}
+
+func TestNewPlanMailSender(t *testing.T) {
+	if _, err := newPlanMailSender(newTestConfig()); err == nil || !strings.Contains(err.Error(), "--send needs notify.email") {
+		t.Errorf("Expected a missing config error, got %v", err)
+	}
+
+	cfg := newPlanMailTestConfig()
+	cfg.Notify.Email.PasswordEnv = "GH_PMU_TEST_PLAN_MAIL_PASSWORD"
+	t.Setenv("GH_PMU_TEST_PLAN_MAIL_PASSWORD", "")
+	if _, err := newPlanMailSender(cfg); err == nil || !strings.Contains(err.Error(), "$GH_PMU_TEST_PLAN_MAIL_PASSWORD is not set") {
+		t.Errorf("Expected a missing password error, got %v", err)
+	}
+
+	t.Setenv("GH_PMU_TEST_PLAN_MAIL_PASSWORD", "secret")
+	sender, err := newPlanMailSender(cfg)
+	if err != nil {
+		t.Fatalf("newPlanMailSender() error = %v", err)
+	}
+	if m := sender.(*notify.Mailer); m.Addr != "smtp.example.com:587" || m.Password != "secret" {
+		t.Errorf("Unexpected mailer: %+v", m)
+	}
+}
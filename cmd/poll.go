@@ -0,0 +1,309 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// pollMarker identifies a poll comment posted by gh-pmu
+	pollMarker = "<!-- gh-pmu:poll -->"
+
+	// defaultPollQuestion heads a poll created without --question
+	defaultPollQuestion = "Which should we prioritize?"
+)
+
+// pollReactions are the reactions that vote for each option, in order.
+// The negative reactions are left out so they cannot read as votes against.
+var pollReactions = []string{"THUMBS_UP", "HOORAY", "HEART", "ROCKET", "EYES", "LAUGH"}
+
+// pollClient defines the interface for API methods used by poll commands.
+// This allows for easier testing with mock implementations.
+type pollClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	AddIssueComment(issueID, body string) (string, error)
+	GetIssueComments(owner, repo string, number int) ([]api.Comment, error)
+	GetCommentReactions(commentID string) ([]api.ReactionCount, error)
+}
+
+func newPollCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "poll",
+		Short: "Run reaction polls on issues",
+		Long: `Run polls on issues, voted on with reactions.
+
+A poll is a comment listing each option with the reaction that votes for
+it (👍, 🎉, ❤️, 🚀, 👀, 😄, in that order; up to six options). Anyone who
+can react to the comment can vote, and may vote for more than one option.`,
+	}
+
+	cmd.AddCommand(newPollCreateCommand())
+	cmd.AddCommand(newPollTallyCommand())
+
+	return cmd
+}
+
+type pollCreateOptions struct {
+	options  string
+	question string
+}
+
+func newPollCreateCommand() *cobra.Command {
+	opts := &pollCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create <issue>",
+		Short: "Post a poll comment on an issue",
+		Example: `  gh pmu poll create 42 --options "Dark mode,Offline sync,Plugin API"
+  gh pmu poll create 42 --options "Q3,Q4" --question "When should we ship the migration?"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runPollCreateWithDeps(cmd, args, opts, cfg, api.NewClient())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.options, "options", "", "Comma-separated poll options (required)")
+	cmd.Flags().StringVarP(&opts.question, "question", "q", defaultPollQuestion, "Question heading the poll")
+	_ = cmd.MarkFlagRequired("options")
+
+	return cmd
+}
+
+type pollTallyOptions struct {
+	json bool
+}
+
+func newPollTallyCommand() *cobra.Command {
+	opts := &pollTallyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tally <issue>",
+		Short: "Count the votes on an issue's poll",
+		Long: `Count the reactions on the latest poll comment on an issue, and show the
+options from most to fewest votes.`,
+		Example: `  gh pmu poll tally 42
+  gh pmu poll tally owner/repo#42 --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runPollTallyWithDeps(cmd, args, opts, cfg, api.NewClient())
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+
+	return cmd
+}
+
+// PollOption is one choice in a poll and the reaction that votes for it
+type PollOption struct {
+	Option   string `json:"option"`
+	Reaction string `json:"reaction"`
+	Votes    int    `json:"votes"`
+}
+
+// PollTally is the result of a poll
+type PollTally struct {
+	Issue    string       `json:"issue"`
+	Question string       `json:"question"`
+	Total    int          `json:"total"`
+	Options  []PollOption `json:"options"`
+}
+
+// parsePollOptions splits --options, dropping blanks and repeats
+func parsePollOptions(value string) ([]string, error) {
+	var options []string
+	for _, o := range strings.Split(value, ",") {
+		o = strings.TrimSpace(o)
+		if o == "" || containsFold(options, o) {
+			continue
+		}
+		options = append(options, o)
+	}
+	if len(options) < 2 {
+		return nil, fmt.Errorf("a poll needs at least 2 options")
+	}
+	if len(options) > len(pollReactions) {
+		return nil, fmt.Errorf("a poll can have at most %d options, got %d", len(pollReactions), len(options))
+	}
+	return options, nil
+}
+
+// buildPollComment renders the poll comment. The option table is what
+// poll tally reads back, so options escape the table's pipe separator.
+func buildPollComment(question string, options []string) string {
+	var b strings.Builder
+	b.WriteString(pollMarker + "\n")
+	fmt.Fprintf(&b, "### 📊 %s\n\n", question)
+	b.WriteString("Vote by reacting to this comment:\n\n")
+	b.WriteString("| Vote | Option |\n|------|--------|\n")
+	for i, o := range options {
+		fmt.Fprintf(&b, "| %s | %s |\n", reactionEmoji[pollReactions[i]], strings.ReplaceAll(o, "|", `\|`))
+	}
+	return b.String()
+}
+
+// parsePollComment reads the question and options back from a poll comment
+func parsePollComment(body string) (string, []PollOption) {
+	byEmoji := make(map[string]string)
+	for _, content := range pollReactions {
+		byEmoji[reactionEmoji[content]] = content
+	}
+
+	question := ""
+	var options []PollOption
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "### ") && question == "" {
+			question = strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(line, "### "), "📊"))
+			continue
+		}
+		if !strings.HasPrefix(line, "| ") || !strings.HasSuffix(line, " |") {
+			continue
+		}
+		cells := strings.SplitN(strings.TrimSuffix(strings.TrimPrefix(line, "| "), " |"), " | ", 2)
+		if len(cells) != 2 {
+			continue
+		}
+		content, ok := byEmoji[cells[0]]
+		if !ok {
+			continue
+		}
+		options = append(options, PollOption{Option: strings.ReplaceAll(cells[1], `\|`, "|"), Reaction: content})
+	}
+	return question, options
+}
+
+// runPollCreateWithDeps is the testable implementation of poll create
+func runPollCreateWithDeps(cmd *cobra.Command, args []string, opts *pollCreateOptions, cfg *config.Config, client pollClient) error {
+	options, err := parsePollOptions(opts.options)
+	if err != nil {
+		return err
+	}
+	question := strings.TrimSpace(opts.question)
+	if question == "" {
+		question = defaultPollQuestion
+	}
+
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	owner, repo, number, err := parseIssueReference(key)
+	if err != nil {
+		return err
+	}
+	issue, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+
+	url, err := client.AddIssueComment(issue.ID, buildPollComment(question, options))
+	if err != nil {
+		return fmt.Errorf("failed to post poll: %w", err)
+	}
+
+	cmd.Printf("✓ Posted poll on #%d: %s\n", number, issue.Title)
+	for i, o := range options {
+		cmd.Printf("  %s  %s\n", reactionEmoji[pollReactions[i]], o)
+	}
+	if url != "" {
+		cmd.Printf("  %s\n", url)
+	}
+	return nil
+}
+
+// runPollTallyWithDeps is the testable implementation of poll tally
+func runPollTallyWithDeps(cmd *cobra.Command, args []string, opts *pollTallyOptions, cfg *config.Config, client pollClient) error {
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	owner, repo, number, err := parseIssueReference(key)
+	if err != nil {
+		return err
+	}
+
+	comments, err := client.GetIssueComments(owner, repo, number)
+	if err != nil {
+		return err
+	}
+	var poll *api.Comment
+	for i := len(comments) - 1; i >= 0; i-- {
+		if strings.Contains(comments[i].Body, pollMarker) {
+			poll = &comments[i]
+			break
+		}
+	}
+	if poll == nil {
+		return fmt.Errorf("no poll found on %s; create one with 'gh pmu poll create'", key)
+	}
+
+	question, options := parsePollComment(poll.Body)
+	if len(options) == 0 {
+		return fmt.Errorf("the poll on %s has no options", key)
+	}
+
+	reactions, err := client.GetCommentReactions(poll.ID)
+	if err != nil {
+		return err
+	}
+	counts := make(map[string]int)
+	for _, r := range reactions {
+		counts[r.Content] = r.Count
+	}
+
+	tally := PollTally{Issue: key, Question: question, Options: options}
+	for i := range tally.Options {
+		tally.Options[i].Votes = counts[tally.Options[i].Reaction]
+		tally.Total += tally.Options[i].Votes
+	}
+	sort.SliceStable(tally.Options, func(i, j int) bool {
+		return tally.Options[i].Votes > tally.Options[j].Votes
+	})
+
+	if opts.json {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(tally)
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Poll on #%d: %s\n\n", number, question)
+	// Emoji differ in rune count, so they stay out of the aligned columns
+	var table bytes.Buffer
+	w := tabwriter.NewWriter(&table, 0, 0, 2, ' ', 0)
+	for _, o := range tally.Options {
+		share := 0
+		if tally.Total > 0 {
+			share = o.Votes * 100 / tally.Total
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d%%\t%s\n", o.Option, o.Votes, share, strings.Repeat("█", share/5))
+	}
+	w.Flush()
+	for i, line := range strings.Split(strings.TrimSuffix(table.String(), "\n"), "\n") {
+		fmt.Fprintf(out, "%s  %s\n", reactionEmoji[tally.Options[i].Reaction], strings.TrimRight(line, " "))
+	}
+
+	noun := "votes"
+	if tally.Total == 1 {
+		noun = "vote"
+	}
+	fmt.Fprintf(out, "\n%d %s\n", tally.Total, noun)
+	return nil
+}
@@ -0,0 +1,135 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockPollClient implements pollClient interface for testing
+type mockPollClient struct {
+	comments  []api.Comment
+	reactions map[string][]api.ReactionCount
+	posted    []string
+}
+
+func (m *mockPollClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{ID: "issue-42", Number: number, Title: "Feature requests"}, nil
+}
+
+func (m *mockPollClient) AddIssueComment(issueID, body string) (string, error) {
+	m.posted = append(m.posted, body)
+	return "https://github.com/owner/repo/issues/42#issuecomment-1", nil
+}
+
+func (m *mockPollClient) GetIssueComments(owner, repo string, number int) ([]api.Comment, error) {
+	return m.comments, nil
+}
+
+func (m *mockPollClient) GetCommentReactions(commentID string) ([]api.ReactionCount, error) {
+	return m.reactions[commentID], nil
+}
+
+func TestRunPollCreate_PostsPollComment(t *testing.T) {
+	client := &mockPollClient{}
+	cmd, buf := newTestCmd()
+	opts := &pollCreateOptions{options: "Dark mode, Offline sync ,,dark mode,A|B", question: defaultPollQuestion}
+
+	if err := runPollCreateWithDeps(cmd, []string{"42"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runPollCreateWithDeps() error = %v", err)
+	}
+	if len(client.posted) != 1 {
+		t.Fatalf("Expected one comment, got %d", len(client.posted))
+	}
+	body := client.posted[0]
+	for _, want := range []string{pollMarker, "### 📊 Which should we prioritize?", "| 👍 | Dark mode |", "| 🎉 | Offline sync |", `| ❤️ | A\|B |`} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected %q in comment:\n%s", want, body)
+		}
+	}
+	if !strings.Contains(buf.String(), "Posted poll on #42") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestParsePollOptions_Limits(t *testing.T) {
+	if _, err := parsePollOptions("Only"); err == nil {
+		t.Error("Expected an error for a single option")
+	}
+	if _, err := parsePollOptions("a,b,c,d,e,f,g"); err == nil || !strings.Contains(err.Error(), "at most 6") {
+		t.Errorf("Expected a too-many-options error, got %v", err)
+	}
+}
+
+func TestParsePollComment_RoundTrip(t *testing.T) {
+	question, options := parsePollComment(buildPollComment("Next focus?", []string{"API", "A|B", "Docs"}))
+	if question != "Next focus?" {
+		t.Errorf("question = %q", question)
+	}
+	want := []PollOption{{Option: "API", Reaction: "THUMBS_UP"}, {Option: "A|B", Reaction: "HOORAY"}, {Option: "Docs", Reaction: "HEART"}}
+	if len(options) != len(want) {
+		t.Fatalf("options = %+v", options)
+	}
+	for i := range want {
+		if options[i] != want[i] {
+			t.Errorf("options[%d] = %+v, want %+v", i, options[i], want[i])
+		}
+	}
+}
+
+func newPollTestClient() *mockPollClient {
+	return &mockPollClient{
+		comments: []api.Comment{
+			{ID: "old", Body: buildPollComment("Old poll", []string{"X", "Y"})},
+			{ID: "poll", Body: buildPollComment("Next focus?", []string{"API", "Docs", "CLI"})},
+			{ID: "chatter", Body: "I vote for docs!"},
+		},
+		reactions: map[string][]api.ReactionCount{
+			"old":  {{Content: "THUMBS_UP", Count: 9}},
+			"poll": {{Content: "THUMBS_UP", Count: 1}, {Content: "HOORAY", Count: 3}, {Content: "THUMBS_DOWN", Count: 4}},
+		},
+	}
+}
+
+func TestRunPollTally_LatestPoll(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runPollTallyWithDeps(cmd, []string{"42"}, &pollTallyOptions{}, newTestConfig(), newPollTestClient()); err != nil {
+		t.Fatalf("runPollTallyWithDeps() error = %v", err)
+	}
+	want := `Poll on #42: Next focus?
+
+🎉  Docs  3  75%  ███████████████
+👍  API   1  25%  █████
+❤️  CLI   0  0%
+
+4 votes
+`
+	if buf.String() != want {
+		t.Errorf("Unexpected tally:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRunPollTally_JSON(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runPollTallyWithDeps(cmd, []string{"owner/repo#42"}, &pollTallyOptions{json: true}, newTestConfig(), newPollTestClient()); err != nil {
+		t.Fatalf("runPollTallyWithDeps() error = %v", err)
+	}
+	var tally PollTally
+	if err := json.Unmarshal(buf.Bytes(), &tally); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if tally.Issue != "owner/repo#42" || tally.Total != 4 || tally.Options[0].Option != "Docs" || tally.Options[0].Votes != 3 {
+		t.Errorf("Unexpected tally: %+v", tally)
+	}
+}
+
+func TestRunPollTally_NoPoll(t *testing.T) {
+	cmd, _ := newTestCmd()
+	client := &mockPollClient{comments: []api.Comment{{ID: "c", Body: "Just a comment"}}}
+	err := runPollTallyWithDeps(cmd, []string{"42"}, &pollTallyOptions{}, newTestConfig(), client)
+	if err == nil || !strings.Contains(err.Error(), "no poll found") {
+		t.Errorf("Expected a no-poll error, got %v", err)
+	}
+}
@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+type prCreateOptions struct {
+	title  string
+	body   string
+	base   string
+	draft  bool
+	status string
+	noMove bool
+}
+
+// prCreateClient defines the interface for API methods used by pr create.
+// This allows for easier testing with mock implementations.
+type prCreateClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	CreatePullRequest(owner, repo, base, head, title, body string, draft bool) (*api.PullRequest, error)
+	AddIssueToProject(projectID, issueID string) (string, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
+// prBranch is the pushed branch a pull request is opened from
+type prBranch struct {
+	owner string // repository the branch was pushed to
+	repo  string
+	head  string // branch name on the remote
+}
+
+func newPRCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pr",
+		Short: "Work with pull requests for project issues",
+	}
+
+	cmd.AddCommand(newPRCreateCommand())
+
+	return cmd
+}
+
+func newPRCreateCommand() *cobra.Command {
+	opts := &prCreateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create [issue]",
+		Short: "Open a pull request for the focused issue",
+		Long: `Open a pull request from the current branch for an issue, by default
+the one set with 'gh pmu focus'.
+
+The branch must already be pushed. The pull request:
+  - is titled after the issue unless --title is given
+  - closes the issue ("Closes #42" is added to the body)
+  - is added to the project with the issue's priority and iteration
+
+The issue is then moved to the in_review status, or to --status.
+Use --no-move to leave its status alone.`,
+		Example: `  gh pmu focus 42
+  git push -u origin my-branch
+  gh pmu pr create
+
+  # Open a draft against a release branch
+  gh pmu pr create --draft --base release/1.2`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			args, err = issueArgsOrFocus(args, cfg)
+			if err != nil {
+				return err
+			}
+			branch, err := currentPushedBranch()
+			if err != nil {
+				return err
+			}
+			return runPRCreateWithDeps(cmd, opts, cfg, args[0], branch, api.NewClient())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Pull request title (default: the issue title)")
+	cmd.Flags().StringVarP(&opts.body, "body", "b", "", "Pull request body")
+	cmd.Flags().StringVarP(&opts.base, "base", "B", "", "Branch to merge into (default: the repository's default branch)")
+	cmd.Flags().BoolVarP(&opts.draft, "draft", "d", false, "Open the pull request as a draft")
+	cmd.Flags().StringVar(&opts.status, "status", "in_review", "Status to move the issue to")
+	cmd.Flags().BoolVar(&opts.noMove, "no-move", false, "Do not change the issue's status")
+
+	return cmd
+}
+
+// currentPushedBranch returns the current branch and where it was pushed
+func currentPushedBranch() (prBranch, error) {
+	out, err := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD").Output()
+	if err != nil {
+		return prBranch{}, fmt.Errorf("failed to get current branch (not a git repository?): %w", err)
+	}
+	branch := strings.TrimSpace(string(out))
+	if branch == "HEAD" {
+		return prBranch{}, fmt.Errorf("not on a branch; check out the branch to open a pull request from")
+	}
+
+	out, err = exec.Command("git", "rev-parse", "--abbrev-ref", "--symbolic-full-name", "@{u}").Output()
+	if err != nil {
+		return prBranch{}, fmt.Errorf("branch %s has no upstream; push it first with 'git push -u origin %s'", branch, branch)
+	}
+	remote, head, ok := strings.Cut(strings.TrimSpace(string(out)), "/")
+	if !ok {
+		return prBranch{}, fmt.Errorf("failed to parse upstream of branch %s", branch)
+	}
+
+	out, err = exec.Command("git", "remote", "get-url", remote).Output()
+	if err != nil {
+		return prBranch{}, fmt.Errorf("failed to get URL of remote %s: %w", remote, err)
+	}
+	owner, repo := splitRepository(parseGitRemote(strings.TrimSpace(string(out))))
+
+	return prBranch{owner: owner, repo: repo, head: head}, nil
+}
+
+// runPRCreateWithDeps is the testable implementation of pr create
+func runPRCreateWithDeps(cmd *cobra.Command, opts *prCreateOptions, cfg *config.Config, issueArg string, branch prBranch, client prCreateClient) error {
+	owner, repo, number, err := parseIssueReference(issueArg)
+	if err != nil {
+		return err
+	}
+	if owner == "" || repo == "" {
+		if len(cfg.Repositories) == 0 {
+			return fmt.Errorf("no repository specified and none configured")
+		}
+		owner, repo = splitRepository(cfg.Repositories[0])
+	}
+
+	// The pull request goes to the repository the branch was pushed to
+	prOwner, prRepo := branch.owner, branch.repo
+	if prOwner == "" || prRepo == "" {
+		prOwner, prRepo = owner, repo
+	}
+
+	issue, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	title := opts.title
+	if title == "" {
+		title = issue.Title
+	}
+	body := prBodyClosingIssue(opts.body, prOwner, prRepo, owner, repo, number)
+
+	pr, err := client.CreatePullRequest(prOwner, prRepo, opts.base, branch.head, title, body, opts.draft)
+	if err != nil {
+		return err
+	}
+
+	cmd.Printf("✓ Created pull request #%d: %s\n", pr.Number, title)
+	cmd.Printf("  • Closes %s/%s#%d\n", owner, repo, number)
+
+	// Sync the project: copy fields to the pull request and move the issue
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, &api.ProjectItemsFilter{Repository: owner + "/" + repo})
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+	var issueItem *api.ProjectItem
+	for i, item := range items {
+		if item.Issue != nil && item.Issue.Number == number {
+			issueItem = &items[i]
+			break
+		}
+	}
+
+	prItemID, err := client.AddIssueToProject(project.ID, pr.ID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to add pull request to project: %v\n", err)
+	} else if issueItem != nil {
+		for _, fv := range prSyncFieldValues(cfg, issueItem.FieldValues) {
+			if err := client.SetProjectItemField(project.ID, prItemID, fv.Field, fv.Value); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to set %s on pull request: %v\n", fv.Field, err)
+				continue
+			}
+			cmd.Printf("  • %s → %s\n", fv.Field, fv.Value)
+		}
+	}
+
+	if issueItem == nil {
+		fmt.Fprintf(os.Stderr, "Warning: issue #%d is not in the project; fields not copied and status not changed\n", number)
+	} else if !opts.noMove && opts.status != "" {
+		statusValue := cfg.ResolveFieldValue("status", opts.status)
+		if err := client.SetProjectItemField(project.ID, issueItem.ID, "Status", statusValue); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to set status for #%d: %v\n", number, err)
+		} else {
+			cmd.Printf("  • #%d Status → %s\n", number, statusValue)
+		}
+	}
+
+	cmd.Printf("🔗 %s\n", pr.URL)
+	return nil
+}
+
+// prBodyClosingIssue returns body with a "Closes" reference to the issue
+// appended, unless the body already closes it
+func prBodyClosingIssue(body, prOwner, prRepo, owner, repo string, number int) string {
+	ref := fmt.Sprintf("#%d", number)
+	if !strings.EqualFold(prOwner+"/"+prRepo, owner+"/"+repo) {
+		ref = fmt.Sprintf("%s/%s#%d", owner, repo, number)
+	}
+	closes := "Closes " + ref
+
+	if strings.Contains(strings.ToLower(body), strings.ToLower(closes)) {
+		return body
+	}
+	if strings.TrimSpace(body) == "" {
+		return closes
+	}
+	return strings.TrimRight(body, "\n") + "\n\n" + closes
+}
+
+// prSyncFieldValues picks the issue's priority and iteration values to
+// copy to its pull request
+func prSyncFieldValues(cfg *config.Config, values []api.FieldValue) []api.FieldValue {
+	names := []string{cfg.GetFieldName("priority"), prIterationFieldName(cfg)}
+
+	var synced []api.FieldValue
+	for _, name := range names {
+		for _, fv := range values {
+			if strings.EqualFold(fv.Field, name) && fv.Value != "" {
+				synced = append(synced, fv)
+				break
+			}
+		}
+	}
+	return synced
+}
+
+// prIterationFieldName returns the configured iteration field, or else the
+// first iteration field in the cached metadata
+func prIterationFieldName(cfg *config.Config) string {
+	if _, ok := cfg.Fields["iteration"]; ok {
+		return cfg.GetFieldName("iteration")
+	}
+	if cfg.Metadata != nil {
+		for _, f := range cfg.Metadata.Fields {
+			if f.DataType == "ITERATION" {
+				return f.Name
+			}
+		}
+	}
+	return "Iteration"
+}
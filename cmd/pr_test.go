@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// mockPRCreateClient implements prCreateClient interface for testing
+type mockPRCreateClient struct {
+	issue     *api.Issue
+	items     []api.ProjectItem
+	createErr error
+
+	createdRepo  string
+	createdHead  string
+	createdTitle string
+	createdBody  string
+	fieldUpdates map[string]map[string]string // itemID -> field -> value
+}
+
+func (m *mockPRCreateClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return m.issue, nil
+}
+
+func (m *mockPRCreateClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockPRCreateClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func (m *mockPRCreateClient) CreatePullRequest(owner, repo, base, head, title, body string, draft bool) (*api.PullRequest, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	m.createdRepo = owner + "/" + repo
+	m.createdHead = head
+	m.createdTitle = title
+	m.createdBody = body
+	return &api.PullRequest{ID: "pr-node", Number: 12, URL: "https://github.com/" + owner + "/" + repo + "/pull/12"}, nil
+}
+
+func (m *mockPRCreateClient) AddIssueToProject(projectID, issueID string) (string, error) {
+	return "pr-item", nil
+}
+
+func (m *mockPRCreateClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	if m.fieldUpdates == nil {
+		m.fieldUpdates = map[string]map[string]string{}
+	}
+	if m.fieldUpdates[itemID] == nil {
+		m.fieldUpdates[itemID] = map[string]string{}
+	}
+	m.fieldUpdates[itemID][fieldName] = value
+	return nil
+}
+
+func newPRTestConfig() *config.Config {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{
+		"status":    {Field: "Status", Values: map[string]string{"in_review": "In Review"}},
+		"priority":  {Field: "Priority"},
+		"iteration": {Field: "Sprint"},
+	}
+	return cfg
+}
+
+func TestPRCreateCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"pr", "create"})
+	if err != nil || sub.Name() != "create" {
+		t.Fatalf("pr create command not found: %v", err)
+	}
+	for _, flag := range []string{"title", "body", "base", "draft", "status", "no-move"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag to exist", flag)
+		}
+	}
+}
+
+func TestRunPRCreate(t *testing.T) {
+	client := &mockPRCreateClient{
+		issue: &api.Issue{Number: 42, Title: "Add dark mode"},
+		items: []api.ProjectItem{
+			{ID: "item-41", Issue: &api.Issue{Number: 41}},
+			{ID: "item-42", Issue: &api.Issue{Number: 42}, FieldValues: []api.FieldValue{
+				{Field: "Status", Value: "In Progress"},
+				{Field: "Priority", Value: "P1"},
+				{Field: "Sprint", Value: "Sprint 3"},
+			}},
+		},
+	}
+
+	cmd, buf := newTestCmd()
+	branch := prBranch{owner: "owner", repo: "repo", head: "dark-mode"}
+	if err := runPRCreateWithDeps(cmd, &prCreateOptions{status: "in_review"}, newPRTestConfig(), "owner/repo#42", branch, client); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if client.createdRepo != "owner/repo" || client.createdHead != "dark-mode" || client.createdTitle != "Add dark mode" {
+		t.Errorf("Unexpected pull request: repo %s, head %s, title %q", client.createdRepo, client.createdHead, client.createdTitle)
+	}
+	if client.createdBody != "Closes #42" {
+		t.Errorf("Expected closing reference body, got %q", client.createdBody)
+	}
+
+	prFields := client.fieldUpdates["pr-item"]
+	if prFields["Priority"] != "P1" || prFields["Sprint"] != "Sprint 3" || prFields["Status"] != "" {
+		t.Errorf("Expected priority and iteration copied to the PR, got %v", prFields)
+	}
+	if got := client.fieldUpdates["item-42"]["Status"]; got != "In Review" {
+		t.Errorf("Expected issue moved to In Review, got %q", got)
+	}
+	if _, touched := client.fieldUpdates["item-41"]; touched {
+		t.Error("Expected other issues to be left alone")
+	}
+
+	for _, want := range []string{"Created pull request #12", "Priority → P1", "Sprint → Sprint 3", "#42 Status → In Review", "/pull/12"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestRunPRCreate_NoMoveAndNotInProject(t *testing.T) {
+	client := &mockPRCreateClient{
+		issue: &api.Issue{Number: 42, Title: "Add dark mode"},
+		items: []api.ProjectItem{{ID: "item-42", Issue: &api.Issue{Number: 42}}},
+	}
+
+	cmd, _ := newTestCmd()
+	opts := &prCreateOptions{title: "Custom", body: "Details", status: "in_review", noMove: true}
+	if err := runPRCreateWithDeps(cmd, opts, newPRTestConfig(), "42", prBranch{head: "feature"}, client); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.createdTitle != "Custom" || client.createdBody != "Details\n\nCloses #42" {
+		t.Errorf("Unexpected title/body: %q / %q", client.createdTitle, client.createdBody)
+	}
+	if client.createdRepo != "owner/repo" {
+		t.Errorf("Expected the issue's repository without a pushed remote, got %s", client.createdRepo)
+	}
+	if len(client.fieldUpdates) != 0 {
+		t.Errorf("Expected no field updates, got %v", client.fieldUpdates)
+	}
+
+	// The issue is not in the project: the PR is still created
+	client = &mockPRCreateClient{issue: &api.Issue{Number: 7, Title: "Untracked"}}
+	cmd, _ = newTestCmd()
+	if err := runPRCreateWithDeps(cmd, &prCreateOptions{status: "in_review"}, newPRTestConfig(), "7", prBranch{head: "feature"}, client); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.createdTitle == "" || len(client.fieldUpdates) != 0 {
+		t.Errorf("Expected the PR without field updates, got %v", client.fieldUpdates)
+	}
+
+	client = &mockPRCreateClient{issue: &api.Issue{}, createErr: errors.New("failed to create pull request: no commits")}
+	cmd, _ = newTestCmd()
+	if err := runPRCreateWithDeps(cmd, &prCreateOptions{}, newPRTestConfig(), "7", prBranch{head: "feature"}, client); err == nil {
+		t.Error("Expected create error")
+	}
+}
+
+func TestPRBodyClosingIssue(t *testing.T) {
+	tests := []struct {
+		body string
+		repo string
+		want string
+	}{
+		{"", "owner/repo", "Closes #42"},
+		{"Adds a toggle.\n", "owner/repo", "Adds a toggle.\n\nCloses #42"},
+		{"Adds a toggle.\n\ncloses #42", "owner/repo", "Adds a toggle.\n\ncloses #42"},
+		{"", "owner/fork", "Closes owner/repo#42"},
+	}
+
+	for _, tt := range tests {
+		owner, repo := splitRepository(tt.repo)
+		if got := prBodyClosingIssue(tt.body, owner, repo, "owner", "repo", 42); got != tt.want {
+			t.Errorf("prBodyClosingIssue(%q, %s) = %q, want %q", tt.body, tt.repo, got, tt.want)
+		}
+	}
+}
+
+func TestPRIterationFieldName(t *testing.T) {
+	if got := prIterationFieldName(newPRTestConfig()); got != "Sprint" {
+		t.Errorf("Expected configured iteration field, got %q", got)
+	}
+
+	cfg := &config.Config{Metadata: &config.Metadata{Fields: []config.FieldMetadata{{Name: "Cycle", DataType: "ITERATION"}}}}
+	if got := prIterationFieldName(cfg); got != "Cycle" {
+		t.Errorf("Expected metadata iteration field, got %q", got)
+	}
+
+	if got := prIterationFieldName(&config.Config{}); got != "Iteration" {
+		t.Errorf("Expected default iteration field, got %q", got)
+	}
+}
@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/segment"
+	"github.com/spf13/cobra"
+)
+
+type promptSegmentOptions struct {
+	refresh bool
+	ttl     time.Duration
+}
+
+// promptSegmentClient defines the interface for API methods used by prompt-segment.
+// This allows for easier testing with mock implementations.
+type promptSegmentClient interface {
+	GetViewerLogin() (string, error)
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+}
+
+func newPromptSegmentCommand() *cobra.Command {
+	opts := &promptSegmentOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "prompt-segment",
+		Short: "Print a compact summary of your items for shell prompts",
+		Long: `Print a compact summary of the project items assigned to you, for
+embedding in a shell prompt such as starship or powerlevel10k:
+
+  ▣3 ⚑1 P0:2
+
+  ▣  items in the in_progress status
+  ⚑  items in the blocked status, or labeled blocked
+  P0 items with the highest priority (the p0 alias)
+
+Counts of zero are left out, and outside a configured repository nothing
+is printed. The summary is read from a cache in the user cache directory,
+so the prompt never waits on the network; when the cache is older than
+--ttl, a refresh is started in the background and the next prompt shows
+the new counts. Use --refresh to update the cache immediately.`,
+		Example: `  # starship: ~/.config/starship.toml
+  [custom.pmu]
+  command = "gh pmu prompt-segment"
+  when = "test -f .gh-pmu.yml"
+
+  # Update the cache now
+  gh pmu prompt-segment --refresh`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// A prompt must never show an error, so a missing config prints nothing
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return nil
+			}
+			path, err := segment.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+			if err != nil {
+				return nil
+			}
+			if opts.refresh {
+				return runPromptSegmentRefresh(cmd, cfg, path, api.NewClient(), time.Now())
+			}
+			return runPromptSegmentWithDeps(cmd, opts, path, startBackgroundRefresh, time.Now())
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.refresh, "refresh", false, "Fetch the counts now and update the cache")
+	cmd.Flags().DurationVar(&opts.ttl, "ttl", 5*time.Minute, "Refresh the cache in the background once it is older than this")
+
+	return cmd
+}
+
+// startBackgroundRefresh runs 'prompt-segment --refresh' detached from
+// the prompt, discarding its output
+func startBackgroundRefresh() error {
+	exe, err := os.Executable()
+	if err != nil {
+		return err
+	}
+	refresh := exec.Command(exe, "prompt-segment", "--refresh")
+	if err := refresh.Start(); err != nil {
+		return err
+	}
+	return refresh.Process.Release()
+}
+
+// runPromptSegmentWithDeps prints the cached segment, starting a refresh
+// when the cache is stale
+func runPromptSegmentWithDeps(cmd *cobra.Command, opts *promptSegmentOptions, path string, refresh func() error, now time.Time) error {
+	counts, err := segment.Load(path)
+	if err != nil {
+		counts = nil
+	}
+
+	if counts.NeedsRefresh(opts.ttl, now) {
+		// Mark the refresh so prompts drawn while it runs do not start
+		// another. Best effort: the prompt shows the old counts either way.
+		marked := segment.Counts{}
+		if counts != nil {
+			marked = *counts
+		}
+		marked.RefreshingSince = now.UTC().Format(time.RFC3339)
+		if marked.Save(path) == nil {
+			_ = refresh()
+		}
+	}
+
+	if s := counts.Render(); s != "" {
+		cmd.Println(s)
+	}
+	return nil
+}
+
+// runPromptSegmentRefresh fetches the counts, saves them, and prints the segment
+func runPromptSegmentRefresh(cmd *cobra.Command, cfg *config.Config, path string, client promptSegmentClient, now time.Time) error {
+	login, err := client.GetViewerLogin()
+	if err != nil {
+		return err
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	counts := countPromptItems(cfg, items, login)
+	counts.FetchedAt = now.UTC().Format(time.RFC3339)
+	if err := counts.Save(path); err != nil {
+		return err
+	}
+
+	if s := counts.Render(); s != "" {
+		cmd.Println(s)
+	}
+	return nil
+}
+
+// countPromptItems counts the open items assigned to login that are in
+// progress, blocked, or at the highest priority
+func countPromptItems(cfg *config.Config, items []api.ProjectItem, login string) *segment.Counts {
+	statusField := cfg.GetFieldName("status")
+	priorityField := cfg.GetFieldName("priority")
+	inProgress := cfg.ResolveFieldValue("status", "in_progress")
+	blocked := cfg.ResolveFieldValue("status", "blocked")
+	urgent := cfg.ResolveFieldValue("priority", "p0")
+
+	counts := &segment.Counts{UrgentName: urgent}
+	for _, item := range items {
+		if item.Issue == nil || strings.EqualFold(item.Issue.State, "CLOSED") || !promptItemAssigned(item.Issue, login) {
+			continue
+		}
+
+		status := getFieldValue(item, statusField)
+		switch {
+		case strings.EqualFold(status, inProgress):
+			counts.InProgress++
+		case strings.EqualFold(status, blocked) || promptItemLabeled(item.Issue, "blocked"):
+			counts.Blocked++
+		}
+
+		if strings.EqualFold(getFieldValue(item, priorityField), urgent) {
+			counts.Urgent++
+		}
+	}
+	return counts
+}
+
+// promptItemAssigned reports whether login is one of the issue's assignees
+func promptItemAssigned(issue *api.Issue, login string) bool {
+	for _, a := range issue.Assignees {
+		if strings.EqualFold(a.Login, login) {
+			return true
+		}
+	}
+	return false
+}
+
+// promptItemLabeled reports whether the issue has the label
+func promptItemLabeled(issue *api.Issue, label string) bool {
+	for _, l := range issue.Labels {
+		if strings.EqualFold(l.Name, label) {
+			return true
+		}
+	}
+	return false
+}
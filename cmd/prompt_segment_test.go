@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/segment"
+)
+
+// mockPromptSegmentClient implements promptSegmentClient interface for testing
+type mockPromptSegmentClient struct {
+	items []api.ProjectItem
+}
+
+func (m *mockPromptSegmentClient) GetViewerLogin() (string, error) {
+	return "alice", nil
+}
+
+func (m *mockPromptSegmentClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockPromptSegmentClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func newPromptTestItem(number int, assignee, status, priority string, labels ...string) api.ProjectItem {
+	issue := &api.Issue{Number: number, State: "OPEN", Assignees: []api.Actor{{Login: assignee}}}
+	for _, l := range labels {
+		issue.Labels = append(issue.Labels, api.Label{Name: l})
+	}
+	return api.ProjectItem{
+		Issue:       issue,
+		FieldValues: []api.FieldValue{{Field: "Status", Value: status}, {Field: "Priority", Value: priority}},
+	}
+}
+
+func newPromptTestConfig() *config.Config {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{
+		"status":   {Field: "Status", Values: map[string]string{"in_progress": "In progress", "blocked": "Blocked"}},
+		"priority": {Field: "Priority", Values: map[string]string{"p0": "P0"}},
+	}
+	return cfg
+}
+
+func TestPromptSegmentCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"prompt-segment"})
+	if err != nil || sub.Name() != "prompt-segment" {
+		t.Fatalf("prompt-segment command not found: %v", err)
+	}
+	for _, flag := range []string{"refresh", "ttl"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag", flag)
+		}
+	}
+}
+
+func TestCountPromptItems(t *testing.T) {
+	items := []api.ProjectItem{
+		newPromptTestItem(1, "alice", "In progress", "P0"),
+		newPromptTestItem(2, "alice", "In progress", "P2"),
+		newPromptTestItem(3, "alice", "Blocked", "P1"),
+		newPromptTestItem(4, "alice", "Todo", "P0", "blocked"),
+		newPromptTestItem(5, "bob", "In progress", "P0"),
+	}
+	closed := newPromptTestItem(6, "alice", "In progress", "P0")
+	closed.Issue.State = "CLOSED"
+	items = append(items, closed)
+
+	counts := countPromptItems(newPromptTestConfig(), items, "Alice")
+	if counts.InProgress != 2 || counts.Blocked != 2 || counts.Urgent != 2 || counts.UrgentName != "P0" {
+		t.Errorf("Unexpected counts: %+v", counts)
+	}
+}
+
+func TestRunPromptSegmentRefresh(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prompt.json")
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	client := &mockPromptSegmentClient{items: []api.ProjectItem{
+		newPromptTestItem(1, "alice", "In progress", "P0"),
+	}}
+
+	cmd, buf := newTestCmd()
+	if err := runPromptSegmentRefresh(cmd, newPromptTestConfig(), path, client, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := strings.TrimSpace(buf.String()); got != "▣1 P0:1" {
+		t.Errorf("Unexpected segment: %q", got)
+	}
+
+	counts, err := segment.Load(path)
+	if err != nil || counts == nil || counts.FetchedAt != "2025-03-01T12:00:00Z" {
+		t.Errorf("Expected counts to be cached, got %+v, %v", counts, err)
+	}
+}
+
+func TestRunPromptSegment_UsesCacheAndRefreshesWhenStale(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prompt.json")
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	cached := &segment.Counts{InProgress: 3, Blocked: 1, FetchedAt: now.Add(-2 * time.Minute).Format(time.RFC3339)}
+	if err := cached.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	refreshes := 0
+	refresh := func() error {
+		refreshes++
+		return errors.New("offline")
+	}
+	opts := &promptSegmentOptions{ttl: 5 * time.Minute}
+
+	cmd, buf := newTestCmd()
+	if err := runPromptSegmentWithDeps(cmd, opts, path, refresh, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "▣3 ⚑1" || refreshes != 0 {
+		t.Errorf("Expected cached segment without refresh, got %q (%d refreshes)", buf.String(), refreshes)
+	}
+
+	// Past the TTL the old counts are still shown while a refresh starts
+	cmd, buf = newTestCmd()
+	if err := runPromptSegmentWithDeps(cmd, opts, path, refresh, now.Add(10*time.Minute)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "▣3 ⚑1" || refreshes != 1 {
+		t.Errorf("Expected cached segment with one refresh, got %q (%d refreshes)", buf.String(), refreshes)
+	}
+
+	// A prompt drawn while that refresh runs does not start another
+	cmd, _ = newTestCmd()
+	if err := runPromptSegmentWithDeps(cmd, opts, path, refresh, now.Add(10*time.Minute+5*time.Second)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if refreshes != 1 {
+		t.Errorf("Expected no second refresh, got %d", refreshes)
+	}
+}
+
+func TestRunPromptSegment_NoCachePrintsNothing(t *testing.T) {
+	refreshes := 0
+	cmd, buf := newTestCmd()
+	err := runPromptSegmentWithDeps(cmd, &promptSegmentOptions{ttl: time.Minute}, filepath.Join(t.TempDir(), "none.json"), func() error { refreshes++; return nil }, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if buf.Len() != 0 || refreshes != 1 {
+		t.Errorf("Expected empty output and a refresh, got %q (%d refreshes)", buf.String(), refreshes)
+	}
+}
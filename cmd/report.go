@@ -0,0 +1,455 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func newReportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Generate reports from project data",
+		Long:  `Generate reports that summarize activity across the configured project.`,
+	}
+
+	cmd.AddCommand(newReportResponseTimeCommand())
+	cmd.AddCommand(newReportTimeCommand())
+	cmd.AddCommand(newReportDeliveredCommand())
+
+	return cmd
+}
+
+type reportResponseTimeOptions struct {
+	team            []string
+	triageFields    []string
+	by              string
+	since           string
+	state           string
+	includeArchived bool
+	json            bool
+}
+
+// reportClient defines the interface for API methods used by report functions.
+// This allows for easier testing with mock implementations.
+type reportClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectIssueActivity(projectID string, filter *api.ProjectItemsFilter) ([]api.IssueActivity, error)
+	GetArchivedIssueActivity(projectID string, repositories []string) ([]api.IssueActivity, error)
+}
+
+func newReportResponseTimeCommand() *cobra.Command {
+	opts := &reportResponseTimeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "response-time",
+		Short: "Measure time to first team response",
+		Long: `Measure the time from issue creation to the first team response.
+
+A response is the earliest of:
+  - a comment from a team member (repository members, owners and
+    collaborators, or the logins given with --team)
+  - a triage field (Priority by default) being set on the project item
+
+GitHub records only when a project field was last changed, not when it was
+first set, so a triage field that was changed again later counts from that
+later change. Times measured through a triage field are an upper bound.
+
+Results are segmented by priority and repository so they can be compared
+against support SLAs.
+
+Open and closed issues are both measured unless --state or defaults.state
+in .gh-pmu.yml narrows them. Archived project items are excluded unless
+--include-archived is given, which also scans the configured repositories
+for archived items.`,
+		Example: `  # Response times segmented by priority and repository
+  gh pmu report response-time
+
+  # Only issues created in the last 30 days, grouped by priority
+  gh pmu report response-time --since 30d --by priority
+
+  # Only issues that are still open
+  gh pmu report response-time --state open
+
+  # Treat specific logins as the team
+  gh pmu report response-time --team alice --team bob
+
+  # Cover completed work that has been archived from the board
+  gh pmu report response-time --since 90d --include-archived`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReportResponseTime(cmd, opts)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&opts.team, "team", nil, "Login counted as a team member (can be specified multiple times)")
+	cmd.Flags().StringArrayVar(&opts.triageFields, "triage-field", nil, "Project field whose value marks an issue as triaged (default: Priority)")
+	cmd.Flags().StringVar(&opts.by, "by", "", "Segment by 'priority' or 'repo' (default: both)")
+	cmd.Flags().StringVar(&opts.since, "since", "", "Only include issues created within this period (e.g., 7d, 4w) or since a date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.state, "state", "", "Only include issues in this state: open, closed, or all (default: defaults.state, else all)")
+	cmd.Flags().BoolVar(&opts.includeArchived, "include-archived", false, "Include archived project items")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+
+	return cmd
+}
+
+func runReportResponseTime(cmd *cobra.Command, opts *reportResponseTimeOptions) error {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient()
+
+	return runReportResponseTimeWithDeps(cmd, opts, cfg, client, time.Now())
+}
+
+// runReportResponseTimeWithDeps is the testable implementation of runReportResponseTime
+func runReportResponseTimeWithDeps(cmd *cobra.Command, opts *reportResponseTimeOptions, cfg *config.Config, client reportClient, now time.Time) error {
+	var segments []string
+	switch opts.by {
+	case "":
+		segments = []string{"priority", "repo"}
+	case "priority", "repo":
+		segments = []string{opts.by}
+	default:
+		return fmt.Errorf("invalid --by value %q: expected 'priority' or 'repo'", opts.by)
+	}
+
+	var since time.Time
+	if opts.since != "" {
+		t, err := parseSince(opts.since, now)
+		if err != nil {
+			return err
+		}
+		since = t
+	}
+
+	state, err := resolveStateFilter(opts.state, cfg)
+	if err != nil {
+		return err
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	activities, err := client.GetProjectIssueActivity(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get issue activity: %w", err)
+	}
+
+	if opts.includeArchived {
+		archived, err := client.GetArchivedIssueActivity(project.ID, cfg.Repositories)
+		if err != nil {
+			return fmt.Errorf("failed to get archived issue activity: %w", err)
+		}
+		activities = append(activities, archived...)
+	}
+
+	if state != "ALL" {
+		var filtered []api.IssueActivity
+		for _, a := range activities {
+			if matchesState(a.Issue.State, state) {
+				filtered = append(filtered, a)
+			}
+		}
+		activities = filtered
+	}
+
+	triageFields := opts.triageFields
+	if len(triageFields) == 0 {
+		triageFields = []string{cfg.GetFieldName("priority")}
+	}
+
+	records := computeResponseTimes(activities, opts.team, triageFields, cfg.GetFieldName("priority"), since)
+
+	report := responseTimeReport{
+		Total:    len(records),
+		Segments: make(map[string][]responseTimeSegment),
+	}
+	for _, r := range records {
+		if r.Responded {
+			report.Responded++
+		}
+	}
+	for _, by := range segments {
+		report.Segments[by] = summarizeResponseTimes(records, by)
+	}
+
+	if opts.json {
+		return outputResponseTimeJSON(cmd, report)
+	}
+
+	return outputResponseTimeTable(cmd, report, segments)
+}
+
+// parseSince parses a relative period (7d, 2w) or an absolute date (YYYY-MM-DD)
+func parseSince(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+
+	if len(s) >= 2 {
+		var n int
+		if _, err := fmt.Sscanf(s[:len(s)-1], "%d", &n); err == nil && n > 0 {
+			switch s[len(s)-1] {
+			case 'd':
+				return now.AddDate(0, 0, -n), nil
+			case 'w':
+				return now.AddDate(0, 0, -7*n), nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid --since value %q: expected a period like 7d or 4w, or a date (YYYY-MM-DD)", s)
+}
+
+// responseTimeRecord holds the measured response time for a single issue
+type responseTimeRecord struct {
+	Number    int
+	Repo      string
+	Priority  string
+	Responded bool
+	Duration  time.Duration
+}
+
+// isTeamComment reports whether a comment was written by a team member
+func isTeamComment(c api.Comment, issueAuthor string, team []string) bool {
+	if c.Author == "" || strings.EqualFold(c.Author, issueAuthor) {
+		return false
+	}
+
+	if len(team) > 0 {
+		for _, login := range team {
+			if strings.EqualFold(login, c.Author) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch c.AuthorAssociation {
+	case "MEMBER", "OWNER", "COLLABORATOR":
+		return true
+	}
+	return false
+}
+
+// computeResponseTimes measures the first-response time of each issue,
+// segmented by the value of priorityField. Issues created before since are
+// skipped when since is non-zero.
+func computeResponseTimes(activities []api.IssueActivity, team, triageFields []string, priorityField string, since time.Time) []responseTimeRecord {
+	var records []responseTimeRecord
+
+	for _, a := range activities {
+		created, err := time.Parse(time.RFC3339, a.CreatedAt)
+		if err != nil {
+			continue
+		}
+		if !since.IsZero() && created.Before(since) {
+			continue
+		}
+
+		record := responseTimeRecord{
+			Number:   a.Issue.Number,
+			Repo:     a.Issue.Repository.Owner + "/" + a.Issue.Repository.Name,
+			Priority: "(none)",
+		}
+
+		var first time.Time
+		consider := func(ts string) {
+			t, err := time.Parse(time.RFC3339, ts)
+			if err != nil || t.Before(created) {
+				return
+			}
+			if first.IsZero() || t.Before(first) {
+				first = t
+			}
+		}
+
+		for _, c := range a.Comments {
+			if isTeamComment(c, a.Issue.Author.Login, team) {
+				consider(c.CreatedAt)
+			}
+		}
+
+		// FieldUpdate.UpdatedAt is when the field last changed; GitHub keeps
+		// no history of when it was first set
+		for _, fu := range a.FieldUpdates {
+			for _, name := range triageFields {
+				if strings.EqualFold(fu.Field, name) {
+					consider(fu.UpdatedAt)
+				}
+			}
+		}
+
+		for _, fv := range a.FieldValues {
+			if strings.EqualFold(fv.Field, priorityField) && fv.Value != "" {
+				record.Priority = fv.Value
+			}
+		}
+
+		if !first.IsZero() {
+			record.Responded = true
+			record.Duration = first.Sub(created)
+		}
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// responseTimeReport is the aggregated result of a response-time report
+type responseTimeReport struct {
+	Total     int                              `json:"total"`
+	Responded int                              `json:"responded"`
+	Segments  map[string][]responseTimeSegment `json:"segments"`
+}
+
+// responseTimeSegment summarizes response times for one priority or repository
+type responseTimeSegment struct {
+	Name          string  `json:"name"`
+	Issues        int     `json:"issues"`
+	Responded     int     `json:"responded"`
+	Pending       int     `json:"pending"`
+	MedianHours   float64 `json:"medianHours"`
+	P90Hours      float64 `json:"p90Hours"`
+	MaxHours      float64 `json:"maxHours"`
+	medianDisplay string
+	p90Display    string
+	maxDisplay    string
+}
+
+// summarizeResponseTimes groups records by "priority" or "repo" and computes percentiles
+func summarizeResponseTimes(records []responseTimeRecord, by string) []responseTimeSegment {
+	groups := make(map[string][]responseTimeRecord)
+	var names []string
+
+	for _, r := range records {
+		key := r.Priority
+		if by == "repo" {
+			key = r.Repo
+		}
+		if _, ok := groups[key]; !ok {
+			names = append(names, key)
+		}
+		groups[key] = append(groups[key], r)
+	}
+
+	sort.Strings(names)
+
+	segments := make([]responseTimeSegment, 0, len(names))
+	for _, name := range names {
+		seg := responseTimeSegment{Name: name}
+
+		var durations []time.Duration
+		for _, r := range groups[name] {
+			seg.Issues++
+			if r.Responded {
+				seg.Responded++
+				durations = append(durations, r.Duration)
+			} else {
+				seg.Pending++
+			}
+		}
+
+		sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+
+		median := percentileDuration(durations, 50)
+		p90 := percentileDuration(durations, 90)
+		var maxDur time.Duration
+		if len(durations) > 0 {
+			maxDur = durations[len(durations)-1]
+		}
+
+		seg.MedianHours = roundHours(median)
+		seg.P90Hours = roundHours(p90)
+		seg.MaxHours = roundHours(maxDur)
+		seg.medianDisplay = formatResponseDuration(median, len(durations) > 0)
+		seg.p90Display = formatResponseDuration(p90, len(durations) > 0)
+		seg.maxDisplay = formatResponseDuration(maxDur, len(durations) > 0)
+
+		segments = append(segments, seg)
+	}
+
+	return segments
+}
+
+// percentileDuration returns the nearest-rank percentile of sorted durations
+func percentileDuration(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// roundHours converts a duration to hours rounded to one decimal place
+func roundHours(d time.Duration) float64 {
+	return float64(int64(d.Hours()*10+0.5)) / 10
+}
+
+// formatResponseDuration renders a duration compactly (e.g., 45m, 3.5h, 2.1d)
+func formatResponseDuration(d time.Duration, ok bool) string {
+	if !ok {
+		return "-"
+	}
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%.1fh", d.Hours())
+	default:
+		return fmt.Sprintf("%.1fd", d.Hours()/24)
+	}
+}
+
+func outputResponseTimeTable(cmd *cobra.Command, report responseTimeReport, segments []string) error {
+	out := cmd.OutOrStdout()
+
+	if report.Total == 0 {
+		fmt.Fprintln(out, "No issues found")
+		return nil
+	}
+
+	fmt.Fprintf(out, "First response: %d of %d issues responded\n", report.Responded, report.Total)
+
+	for _, by := range segments {
+		header := "PRIORITY"
+		if by == "repo" {
+			header = "REPOSITORY"
+		}
+
+		fmt.Fprintln(out)
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "%s\tISSUES\tRESPONDED\tPENDING\tMEDIAN\tP90\tMAX\n", header)
+		for _, seg := range report.Segments[by] {
+			fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\t%s\t%s\n",
+				seg.Name, seg.Issues, seg.Responded, seg.Pending,
+				seg.medianDisplay, seg.p90Display, seg.maxDisplay)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func outputResponseTimeJSON(cmd *cobra.Command, report responseTimeReport) error {
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/scooter-indie/gh-pmu/internal/annotate"
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/spf13/cobra"
+)
+
+type reportDeliveredOptions struct {
+	from string
+	to   string
+	json bool
+}
+
+// reportDeliveredClient defines the interface for API methods used by report delivered.
+// This allows for easier testing with mock implementations.
+type reportDeliveredClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	GetPullRequestClosingIssues(owner, repo string, number int) ([]api.Issue, error)
+}
+
+func newReportDeliveredCommand() *cobra.Command {
+	opts := &reportDeliveredOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delivered",
+		Short: "List the project items delivered between two git tags",
+		Long: `List the project items delivered between two git refs, usually release
+tags, as a changelog scoped to the board.
+
+Each commit in from..to is mapped to project items through the issue
+references in its message (#123, owner/repo#123, an issue URL, or a
+Refs:/Fixes: trailer). A reference to a pull request, such as the one in a
+merge or squash commit, counts toward the issues the pull request closes.
+A bare #123 refers to the first configured repository.
+
+Run it in a clone of the repository, with both refs fetched. Commits that
+reference no project item are listed separately, so nothing shipped goes
+unnoticed.`,
+		Example: `  # What shipped in v1.5.0
+  gh pmu report delivered --from v1.4.0 --to v1.5.0
+
+  # What has landed since the last release
+  gh pmu report delivered --from v1.5.0
+
+  # For release tooling
+  gh pmu report delivered --from v1.4.0 --to v1.5.0 --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+
+			commits, err := gitCommitsBetween(opts.from, opts.to)
+			if err != nil {
+				return err
+			}
+			return runReportDeliveredWithDeps(cmd, opts, cfg, api.NewClient(), commits)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.from, "from", "", "The earlier tag or ref (exclusive)")
+	cmd.Flags().StringVar(&opts.to, "to", "HEAD", "The later tag or ref (inclusive)")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+	_ = cmd.MarkFlagRequired("from")
+
+	return cmd
+}
+
+// deliveredCommit is a commit between the two refs
+type deliveredCommit struct {
+	Hash    string `json:"hash"`
+	Subject string `json:"subject"`
+	Body    string `json:"-"`
+}
+
+// deliveredItem is a project item with the commits that delivered it
+type deliveredItem struct {
+	Issue        string   `json:"issue"` // owner/repo#number
+	Title        string   `json:"title"`
+	Status       string   `json:"status"`
+	State        string   `json:"state"`
+	Commits      []string `json:"commits"`
+	PullRequests []string `json:"pullRequests"` // owner/repo#number
+}
+
+// deliveredReport is the result of a delivered report
+type deliveredReport struct {
+	From     string            `json:"from"`
+	To       string            `json:"to"`
+	Commits  int               `json:"commits"`
+	Items    []deliveredItem   `json:"items"`
+	Unlinked []deliveredCommit `json:"unlinked"` // commits that reference no project item
+}
+
+// gitCommitsBetween lists the commits in from..to, newest first
+func gitCommitsBetween(from, to string) ([]deliveredCommit, error) {
+	out, err := exec.Command("git", "log", "--format=%H%x1f%s%x1f%b%x1e", from+".."+to).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			err = errors.New(strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("failed to list commits in %s..%s: %w", from, to, err)
+	}
+	return parseGitLog(string(out)), nil
+}
+
+// parseGitLog parses git log output with fields separated by \x1f and
+// commits terminated by \x1e
+func parseGitLog(out string) []deliveredCommit {
+	var commits []deliveredCommit
+	for _, record := range strings.Split(out, "\x1e") {
+		fields := strings.SplitN(strings.TrimLeft(record, "\n"), "\x1f", 3)
+		if len(fields) < 3 || fields[0] == "" {
+			continue
+		}
+		commits = append(commits, deliveredCommit{Hash: fields[0], Subject: fields[1], Body: strings.TrimSpace(fields[2])})
+	}
+	return commits
+}
+
+// runReportDeliveredWithDeps is the testable implementation of report delivered
+func runReportDeliveredWithDeps(cmd *cobra.Command, opts *reportDeliveredOptions, cfg *config.Config, client reportDeliveredClient, commits []deliveredCommit) error {
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+	byKey := make(map[string]api.ProjectItem)
+	for _, item := range items {
+		if item.Issue != nil {
+			byKey[itemKey(item)] = item
+		}
+	}
+
+	defaultRepo := ""
+	if len(cfg.Repositories) > 0 {
+		defaultRepo = cfg.Repositories[0]
+	}
+
+	report := deliveredReport{From: opts.from, To: opts.to, Commits: len(commits), Items: []deliveredItem{}, Unlinked: []deliveredCommit{}}
+	delivered := make(map[string]*deliveredItem)
+	closes := make(map[string][]api.Issue) // pull request lookups, by key
+	deliver := func(item api.ProjectItem, commit, pr string) {
+		key := itemKey(item)
+		d, ok := delivered[key]
+		if !ok {
+			d = &deliveredItem{
+				Issue:        key,
+				Title:        item.Issue.Title,
+				Status:       getFieldValue(item, "Status"),
+				State:        item.Issue.State,
+				Commits:      []string{},
+				PullRequests: []string{},
+			}
+			delivered[key] = d
+		}
+		if !containsFold(d.Commits, commit) {
+			d.Commits = append(d.Commits, commit)
+		}
+		if pr != "" && !containsFold(d.PullRequests, pr) {
+			d.PullRequests = append(d.PullRequests, pr)
+		}
+	}
+
+	for _, c := range commits {
+		short := c.Hash
+		if len(short) > 7 {
+			short = short[:7]
+		}
+		linked := false
+		for _, ref := range annotate.Refs(c.Subject+"\n"+c.Body, defaultRepo) {
+			key := localstore.Key(ref.Owner+"/"+ref.Repo, ref.Number)
+			if item, ok := byKey[key]; ok {
+				deliver(item, short, "")
+				linked = true
+				continue
+			}
+
+			// Not a project item; it may be a pull request that closes some
+			issues, ok := closes[key]
+			if !ok {
+				issues, err = client.GetPullRequestClosingIssues(ref.Owner, ref.Repo, ref.Number)
+				if err != nil {
+					return err
+				}
+				closes[key] = issues
+			}
+			for _, issue := range issues {
+				if item, ok := byKey[localstore.Key(issue.Repository.Owner+"/"+issue.Repository.Name, issue.Number)]; ok {
+					deliver(item, short, key)
+					linked = true
+				}
+			}
+		}
+		if !linked {
+			report.Unlinked = append(report.Unlinked, deliveredCommit{Hash: short, Subject: c.Subject})
+		}
+	}
+
+	for _, d := range delivered {
+		report.Items = append(report.Items, *d)
+	}
+	sort.Slice(report.Items, func(i, j int) bool {
+		return compareIssueKeys(report.Items[i].Issue, report.Items[j].Issue)
+	})
+
+	if opts.json {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Delivered in %s..%s: %d items from %d commits\n", opts.from, opts.to, len(report.Items), len(commits))
+	if len(report.Items) > 0 {
+		fmt.Fprintln(out)
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "ISSUE\tTITLE\tSTATUS\tCOMMITS")
+		for _, d := range report.Items {
+			status := d.Status
+			if status == "" {
+				status = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", d.Issue, d.Title, status, strings.Join(d.Commits, ", "))
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(report.Unlinked) > 0 {
+		fmt.Fprintf(out, "\nNot linked to project items (%d):\n", len(report.Unlinked))
+		for _, c := range report.Unlinked {
+			fmt.Fprintf(out, "  %s %s\n", c.Hash, c.Subject)
+		}
+	}
+	return nil
+}
+
+// compareIssueKeys orders owner/repo#number keys by repository, then number
+func compareIssueKeys(a, b string) bool {
+	repoA, numA, _ := strings.Cut(a, "#")
+	repoB, numB, _ := strings.Cut(b, "#")
+	if repoA != repoB {
+		return repoA < repoB
+	}
+	if len(numA) != len(numB) {
+		return len(numA) < len(numB)
+	}
+	return numA < numB
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// mockReportDeliveredClient implements reportDeliveredClient interface for testing
+type mockReportDeliveredClient struct {
+	items   []api.ProjectItem
+	closes  map[int][]api.Issue
+	lookups []int
+}
+
+func (m *mockReportDeliveredClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockReportDeliveredClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func (m *mockReportDeliveredClient) GetPullRequestClosingIssues(owner, repo string, number int) ([]api.Issue, error) {
+	m.lookups = append(m.lookups, number)
+	return m.closes[number], nil
+}
+
+func newReportDeliveredTestClient() *mockReportDeliveredClient {
+	item := func(number int, title, status string) api.ProjectItem {
+		return api.ProjectItem{
+			ID: "item-" + title,
+			Issue: &api.Issue{
+				Number:     number,
+				Title:      title,
+				State:      "CLOSED",
+				Repository: api.Repository{Owner: "owner", Name: "repo"},
+			},
+			FieldValues: []api.FieldValue{{Field: "Status", Value: status}},
+		}
+	}
+	return &mockReportDeliveredClient{
+		items: []api.ProjectItem{
+			item(4, "Fix login", "Done"),
+			item(12, "Add SSO", "Done"),
+			item(30, "Not shipped", "Todo"),
+		},
+		closes: map[int][]api.Issue{
+			20: {{Number: 12, Repository: api.Repository{Owner: "owner", Name: "repo"}}},
+		},
+	}
+}
+
+func runDeliveredTest(t *testing.T, client *mockReportDeliveredClient, opts *reportDeliveredOptions, commits []deliveredCommit) string {
+	t.Helper()
+	cmd := &cobra.Command{}
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cfg := &config.Config{
+		Project:      config.Project{Owner: "owner", Number: 1},
+		Repositories: []string{"owner/repo"},
+	}
+	if err := runReportDeliveredWithDeps(cmd, opts, cfg, client, commits); err != nil {
+		t.Fatalf("runReportDeliveredWithDeps() error = %v", err)
+	}
+	return buf.String()
+}
+
+var deliveredTestCommits = []deliveredCommit{
+	{Hash: "aaaaaaa111", Subject: "Add SSO (#20)"},
+	{Hash: "bbbbbbb222", Subject: "fix: login redirect", Body: "Refs: #4"},
+	{Hash: "ccccccc333", Subject: "Merge pull request #20 from owner/sso"},
+	{Hash: "ddddddd444", Subject: "Bump dependencies"},
+}
+
+func TestParseGitLog(t *testing.T) {
+	out := "abc\x1ffix: one\x1fRefs: #4\n\x1e\ndef\x1fchore: two\x1f\x1e\n"
+	commits := parseGitLog(out)
+	if len(commits) != 2 {
+		t.Fatalf("Expected 2 commits, got %d: %+v", len(commits), commits)
+	}
+	if commits[0].Hash != "abc" || commits[0].Subject != "fix: one" || commits[0].Body != "Refs: #4" {
+		t.Errorf("Unexpected first commit: %+v", commits[0])
+	}
+	if commits[1].Hash != "def" || commits[1].Body != "" {
+		t.Errorf("Unexpected second commit: %+v", commits[1])
+	}
+}
+
+func TestRunReportDelivered_MapsCommitsAndPullRequests(t *testing.T) {
+	client := newReportDeliveredTestClient()
+	out := runDeliveredTest(t, client, &reportDeliveredOptions{from: "v1.4.0", to: "v1.5.0"}, deliveredTestCommits)
+
+	if !strings.Contains(out, "Delivered in v1.4.0..v1.5.0: 2 items from 4 commits") {
+		t.Errorf("Expected summary, got:\n%s", out)
+	}
+	if !strings.Contains(out, "owner/repo#4") || !strings.Contains(out, "Fix login") {
+		t.Errorf("Expected #4 delivered by a direct reference, got:\n%s", out)
+	}
+	if !strings.Contains(out, "aaaaaaa, ccccccc") {
+		t.Errorf("Expected #12 delivered through PR #20 by both commits, got:\n%s", out)
+	}
+	if strings.Contains(out, "Not shipped") {
+		t.Errorf("Expected only referenced items, got:\n%s", out)
+	}
+	if !strings.Contains(out, "Not linked to project items (1):\n  ddddddd Bump dependencies") {
+		t.Errorf("Expected the unlinked commit, got:\n%s", out)
+	}
+	if strings.Index(out, "owner/repo#4") > strings.Index(out, "owner/repo#12") {
+		t.Errorf("Expected items in issue order, got:\n%s", out)
+	}
+	if len(client.lookups) != 1 || client.lookups[0] != 20 {
+		t.Errorf("Expected one cached lookup of PR #20, got %v", client.lookups)
+	}
+}
+
+func TestRunReportDelivered_JSON(t *testing.T) {
+	client := newReportDeliveredTestClient()
+	out := runDeliveredTest(t, client, &reportDeliveredOptions{from: "v1.4.0", to: "v1.5.0", json: true}, deliveredTestCommits)
+
+	var report deliveredReport
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("Invalid JSON: %v\n%s", err, out)
+	}
+	if report.Commits != 4 || len(report.Items) != 2 || len(report.Unlinked) != 1 {
+		t.Fatalf("Unexpected report: %+v", report)
+	}
+	sso := report.Items[1]
+	if sso.Issue != "owner/repo#12" || sso.Status != "Done" || len(sso.PullRequests) != 1 || sso.PullRequests[0] != "owner/repo#20" {
+		t.Errorf("Unexpected item: %+v", sso)
+	}
+}
+
+func TestRunReportDelivered_NoCommits(t *testing.T) {
+	out := runDeliveredTest(t, newReportDeliveredTestClient(), &reportDeliveredOptions{from: "v1.5.0", to: "HEAD"}, nil)
+	if out != "Delivered in v1.5.0..HEAD: 0 items from 0 commits\n" {
+		t.Errorf("Unexpected output: %q", out)
+	}
+}
@@ -0,0 +1,420 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// mockReportClient implements reportClient interface for testing
+type mockReportClient struct {
+	project       *api.Project
+	projectError  error
+	activities    []api.IssueActivity
+	activityError error
+	archived      []api.IssueActivity
+	archivedError error
+	archivedRepos []string
+}
+
+func (m *mockReportClient) GetProject(owner string, number int) (*api.Project, error) {
+	return m.project, m.projectError
+}
+
+func (m *mockReportClient) GetProjectIssueActivity(projectID string, filter *api.ProjectItemsFilter) ([]api.IssueActivity, error) {
+	return m.activities, m.activityError
+}
+
+func (m *mockReportClient) GetArchivedIssueActivity(projectID string, repositories []string) ([]api.IssueActivity, error) {
+	m.archivedRepos = repositories
+	return m.archived, m.archivedError
+}
+
+func newTestActivity(number int, repo, priority, created string) api.IssueActivity {
+	parts := strings.SplitN(repo, "/", 2)
+	a := api.IssueActivity{
+		Issue: api.Issue{
+			Number:     number,
+			Author:     api.Actor{Login: "reporter"},
+			Repository: api.Repository{Owner: parts[0], Name: parts[1]},
+		},
+		CreatedAt: created,
+	}
+	if priority != "" {
+		a.FieldValues = []api.FieldValue{{Field: "Priority", Value: priority}}
+	}
+	return a
+}
+
+func TestReportCommand_HasResponseTimeSubcommand(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"report", "response-time"})
+	if err != nil {
+		t.Fatalf("report response-time command not found: %v", err)
+	}
+
+	for _, name := range []string{"team", "triage-field", "by", "since", "json"} {
+		if sub.Flags().Lookup(name) == nil {
+			t.Errorf("Expected --%s flag to exist", name)
+		}
+	}
+}
+
+func TestIsTeamComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment api.Comment
+		team    []string
+		want    bool
+	}{
+		{"member association", api.Comment{Author: "alice", AuthorAssociation: "MEMBER"}, nil, true},
+		{"owner association", api.Comment{Author: "alice", AuthorAssociation: "OWNER"}, nil, true},
+		{"collaborator association", api.Comment{Author: "alice", AuthorAssociation: "COLLABORATOR"}, nil, true},
+		{"contributor is not team", api.Comment{Author: "alice", AuthorAssociation: "CONTRIBUTOR"}, nil, false},
+		{"issue author is never a response", api.Comment{Author: "reporter", AuthorAssociation: "MEMBER"}, nil, false},
+		{"explicit team list matches", api.Comment{Author: "Bob", AuthorAssociation: "NONE"}, []string{"bob"}, true},
+		{"explicit team list overrides association", api.Comment{Author: "alice", AuthorAssociation: "MEMBER"}, []string{"bob"}, false},
+		{"deleted user", api.Comment{Author: "", AuthorAssociation: "MEMBER"}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTeamComment(tt.comment, "reporter", tt.team); got != tt.want {
+				t.Errorf("isTeamComment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeResponseTimes(t *testing.T) {
+	commented := newTestActivity(1, "owner/repo", "P1", "2024-01-01T10:00:00Z")
+	commented.Comments = []api.Comment{
+		{Author: "reporter", AuthorAssociation: "NONE", CreatedAt: "2024-01-01T10:30:00Z"},
+		{Author: "alice", AuthorAssociation: "MEMBER", CreatedAt: "2024-01-01T12:00:00Z"},
+	}
+
+	triaged := newTestActivity(2, "owner/repo", "P0", "2024-01-01T10:00:00Z")
+	triaged.Comments = []api.Comment{
+		{Author: "alice", AuthorAssociation: "MEMBER", CreatedAt: "2024-01-02T10:00:00Z"},
+	}
+	triaged.FieldUpdates = []api.FieldUpdate{
+		{Field: "Priority", Value: "P0", UpdatedAt: "2024-01-01T11:00:00Z"},
+		{Field: "Status", Value: "Backlog", UpdatedAt: "2024-01-01T10:05:00Z"},
+	}
+
+	pending := newTestActivity(3, "owner/other", "", "2024-01-01T10:00:00Z")
+
+	records := computeResponseTimes([]api.IssueActivity{commented, triaged, pending}, nil, []string{"Priority"}, "Priority", time.Time{})
+
+	if len(records) != 3 {
+		t.Fatalf("Expected 3 records, got %d", len(records))
+	}
+
+	if !records[0].Responded || records[0].Duration != 2*time.Hour {
+		t.Errorf("Expected #1 responded in 2h via comment, got %+v", records[0])
+	}
+	if !records[1].Responded || records[1].Duration != time.Hour {
+		t.Errorf("Expected #2 responded in 1h via Priority field (Status ignored), got %+v", records[1])
+	}
+	if records[2].Responded {
+		t.Errorf("Expected #3 to be pending, got %+v", records[2])
+	}
+	if records[2].Priority != "(none)" {
+		t.Errorf("Expected missing priority to be '(none)', got %q", records[2].Priority)
+	}
+	if records[2].Repo != "owner/other" {
+		t.Errorf("Expected repo owner/other, got %q", records[2].Repo)
+	}
+}
+
+func TestComputeResponseTimes_SinceFilter(t *testing.T) {
+	old := newTestActivity(1, "owner/repo", "P1", "2023-12-01T00:00:00Z")
+	recent := newTestActivity(2, "owner/repo", "P1", "2024-01-10T00:00:00Z")
+	invalid := newTestActivity(3, "owner/repo", "P1", "not-a-date")
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := computeResponseTimes([]api.IssueActivity{old, recent, invalid}, nil, []string{"Priority"}, "Priority", since)
+
+	if len(records) != 1 || records[0].Number != 2 {
+		t.Errorf("Expected only #2 to be included, got %+v", records)
+	}
+}
+
+func TestComputeResponseTimes_PriorityField(t *testing.T) {
+	a := newTestActivity(1, "owner/repo", "P1", "2024-01-01T10:00:00Z")
+	a.FieldValues = append(a.FieldValues, api.FieldValue{Field: "Severity", Value: "S1"})
+
+	records := computeResponseTimes([]api.IssueActivity{a}, nil, []string{"Severity"}, "Severity", time.Time{})
+	if len(records) != 1 || records[0].Priority != "S1" {
+		t.Errorf("Expected segmentation by the configured priority field, got %+v", records)
+	}
+}
+
+func TestSummarizeResponseTimes(t *testing.T) {
+	records := []responseTimeRecord{
+		{Number: 1, Repo: "owner/repo", Priority: "P1", Responded: true, Duration: 1 * time.Hour},
+		{Number: 2, Repo: "owner/repo", Priority: "P1", Responded: true, Duration: 3 * time.Hour},
+		{Number: 3, Repo: "owner/other", Priority: "P1", Responded: true, Duration: 10 * time.Hour},
+		{Number: 4, Repo: "owner/other", Priority: "P0", Responded: false},
+	}
+
+	byPriority := summarizeResponseTimes(records, "priority")
+	if len(byPriority) != 2 {
+		t.Fatalf("Expected 2 priority segments, got %d", len(byPriority))
+	}
+
+	p0, p1 := byPriority[0], byPriority[1]
+	if p0.Name != "P0" || p0.Pending != 1 || p0.Responded != 0 || p0.medianDisplay != "-" {
+		t.Errorf("Unexpected P0 segment: %+v", p0)
+	}
+	if p1.Name != "P1" || p1.Issues != 3 || p1.Responded != 3 {
+		t.Errorf("Unexpected P1 segment: %+v", p1)
+	}
+	if p1.MedianHours != 3 || p1.P90Hours != 10 || p1.MaxHours != 10 {
+		t.Errorf("Expected median 3h, p90 10h, max 10h, got %v/%v/%v", p1.MedianHours, p1.P90Hours, p1.MaxHours)
+	}
+
+	byRepo := summarizeResponseTimes(records, "repo")
+	if len(byRepo) != 2 || byRepo[0].Name != "owner/other" || byRepo[1].Name != "owner/repo" {
+		t.Errorf("Expected repo segments sorted by name, got %+v", byRepo)
+	}
+}
+
+func TestFormatResponseDuration(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		ok   bool
+		want string
+	}{
+		{0, false, "-"},
+		{45 * time.Minute, true, "45m"},
+		{210 * time.Minute, true, "3.5h"},
+		{72 * time.Hour, true, "3.0d"},
+	}
+
+	for _, tt := range tests {
+		if got := formatResponseDuration(tt.d, tt.ok); got != tt.want {
+			t.Errorf("formatResponseDuration(%v, %v) = %q, want %q", tt.d, tt.ok, got, tt.want)
+		}
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 2, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"7d", now.AddDate(0, 0, -7), false},
+		{"2w", now.AddDate(0, 0, -14), false},
+		{"2024-01-01", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"0d", time.Time{}, true},
+		{"3m", time.Time{}, true},
+		{"soon", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseSince(tt.input, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSince(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("parseSince(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunReportResponseTime_Table(t *testing.T) {
+	a := newTestActivity(1, "owner/repo", "P1", "2024-01-01T10:00:00Z")
+	a.Comments = []api.Comment{{Author: "alice", AuthorAssociation: "MEMBER", CreatedAt: "2024-01-01T10:30:00Z"}}
+
+	client := &mockReportClient{
+		project:    &api.Project{ID: "proj-1"},
+		activities: []api.IssueActivity{a},
+	}
+
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+
+	err := runReportResponseTimeWithDeps(cmd, &reportResponseTimeOptions{}, newTestConfig(), client, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output := buf.String()
+	for _, want := range []string{"1 of 1 issues responded", "PRIORITY", "REPOSITORY", "P1", "owner/repo", "30m"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestRunReportResponseTime_JSON(t *testing.T) {
+	a := newTestActivity(1, "owner/repo", "P1", "2024-01-01T10:00:00Z")
+	a.FieldUpdates = []api.FieldUpdate{{Field: "Priority", Value: "P1", UpdatedAt: "2024-01-01T12:00:00Z"}}
+
+	client := &mockReportClient{
+		project:    &api.Project{ID: "proj-1"},
+		activities: []api.IssueActivity{a},
+	}
+
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+
+	opts := &reportResponseTimeOptions{by: "priority", json: true}
+	if err := runReportResponseTimeWithDeps(cmd, opts, newTestConfig(), client, time.Now()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var report struct {
+		Total     int `json:"total"`
+		Responded int `json:"responded"`
+		Segments  map[string][]struct {
+			Name        string  `json:"name"`
+			MedianHours float64 `json:"medianHours"`
+		} `json:"segments"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\n%s", err, buf.String())
+	}
+
+	if report.Total != 1 || report.Responded != 1 {
+		t.Errorf("Expected 1 total / 1 responded, got %d / %d", report.Total, report.Responded)
+	}
+	if _, ok := report.Segments["repo"]; ok {
+		t.Error("Expected only priority segment with --by priority")
+	}
+	if len(report.Segments["priority"]) != 1 || report.Segments["priority"][0].MedianHours != 2 {
+		t.Errorf("Expected P1 median of 2h, got %+v", report.Segments["priority"])
+	}
+}
+
+func TestRunReportResponseTime_IncludeArchived(t *testing.T) {
+	active := newTestActivity(1, "owner/repo", "P1", "2024-01-01T10:00:00Z")
+	archived := newTestActivity(2, "owner/repo", "P1", "2024-01-01T10:00:00Z")
+	archived.Comments = []api.Comment{{Author: "alice", AuthorAssociation: "MEMBER", CreatedAt: "2024-01-01T11:00:00Z"}}
+
+	for _, include := range []bool{false, true} {
+		client := &mockReportClient{
+			project:    &api.Project{ID: "proj-1"},
+			activities: []api.IssueActivity{active},
+			archived:   []api.IssueActivity{archived},
+		}
+
+		buf := new(bytes.Buffer)
+		cmd := &cobra.Command{}
+		cmd.SetOut(buf)
+
+		opts := &reportResponseTimeOptions{includeArchived: include}
+		if err := runReportResponseTimeWithDeps(cmd, opts, newTestConfig(), client, time.Now()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		want := "0 of 1 issues responded"
+		if include {
+			want = "1 of 2 issues responded"
+			if len(client.archivedRepos) != 1 || client.archivedRepos[0] != "owner/repo" {
+				t.Errorf("Expected configured repositories to be scanned, got %v", client.archivedRepos)
+			}
+		}
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("includeArchived=%v: expected %q, got:\n%s", include, want, buf.String())
+		}
+	}
+
+	client := &mockReportClient{project: &api.Project{ID: "proj-1"}, archivedError: errors.New("boom")}
+	cmd := &cobra.Command{}
+	cmd.SetOut(new(bytes.Buffer))
+	err := runReportResponseTimeWithDeps(cmd, &reportResponseTimeOptions{includeArchived: true}, newTestConfig(), client, time.Now())
+	if err == nil || !strings.Contains(err.Error(), "archived issue activity") {
+		t.Errorf("Expected archived activity error, got %v", err)
+	}
+}
+
+func TestRunReportResponseTime_State(t *testing.T) {
+	open := newTestActivity(1, "owner/repo", "P1", "2024-01-01T10:00:00Z")
+	open.Issue.State = "OPEN"
+	closed := newTestActivity(2, "owner/repo", "P1", "2024-01-01T10:00:00Z")
+	closed.Issue.State = "CLOSED"
+
+	tests := []struct {
+		name         string
+		state        string
+		defaultState string
+		want         string
+	}{
+		{"all by default", "", "", "0 of 2 issues responded"},
+		{"flag", "closed", "", "0 of 1 issues responded"},
+		{"config default", "", "open", "0 of 1 issues responded"},
+		{"flag overrides config", "all", "open", "0 of 2 issues responded"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &mockReportClient{
+				project:    &api.Project{ID: "proj-1"},
+				activities: []api.IssueActivity{open, closed},
+			}
+			cfg := newTestConfig()
+			cfg.Defaults.State = tt.defaultState
+
+			buf := new(bytes.Buffer)
+			cmd := &cobra.Command{}
+			cmd.SetOut(buf)
+
+			if err := runReportResponseTimeWithDeps(cmd, &reportResponseTimeOptions{state: tt.state}, cfg, client, time.Now()); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !strings.Contains(buf.String(), tt.want) {
+				t.Errorf("Expected %q, got:\n%s", tt.want, buf.String())
+			}
+		})
+	}
+
+	cmd := &cobra.Command{}
+	cmd.SetOut(new(bytes.Buffer))
+	err := runReportResponseTimeWithDeps(cmd, &reportResponseTimeOptions{state: "merged"}, newTestConfig(), &mockReportClient{}, time.Now())
+	if err == nil || !strings.Contains(err.Error(), "invalid --state") {
+		t.Errorf("Expected invalid state error, got %v", err)
+	}
+}
+
+func TestRunReportResponseTime_Errors(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.SetOut(new(bytes.Buffer))
+
+	t.Run("invalid --by", func(t *testing.T) {
+		err := runReportResponseTimeWithDeps(cmd, &reportResponseTimeOptions{by: "team"}, newTestConfig(), &mockReportClient{}, time.Now())
+		if err == nil || !strings.Contains(err.Error(), "invalid --by") {
+			t.Errorf("Expected invalid --by error, got %v", err)
+		}
+	})
+
+	t.Run("project error", func(t *testing.T) {
+		client := &mockReportClient{projectError: errors.New("boom")}
+		err := runReportResponseTimeWithDeps(cmd, &reportResponseTimeOptions{}, newTestConfig(), client, time.Now())
+		if err == nil || !strings.Contains(err.Error(), "failed to get project") {
+			t.Errorf("Expected project error, got %v", err)
+		}
+	})
+
+	t.Run("activity error", func(t *testing.T) {
+		client := &mockReportClient{project: &api.Project{ID: "p"}, activityError: errors.New("boom")}
+		err := runReportResponseTimeWithDeps(cmd, &reportResponseTimeOptions{}, newTestConfig(), client, time.Now())
+		if err == nil || !strings.Contains(err.Error(), "failed to get issue activity") {
+			t.Errorf("Expected activity error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/worklog"
+	"github.com/spf13/cobra"
+)
+
+type reportTimeOptions struct {
+	by    string
+	since string
+	json  bool
+}
+
+// reportTimeClient defines the interface for API methods used by report time.
+// This allows for easier testing with mock implementations.
+type reportTimeClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+}
+
+func newReportTimeCommand() *cobra.Command {
+	opts := &reportTimeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "time",
+		Short: "Summarize time logged with 'gh pmu log'",
+		Long: `Summarize the time logged with 'gh pmu log', by issue or by assignee.
+
+Grouping by assignee looks up each issue's current assignees in the
+project. Time on an issue with several assignees counts toward each of
+them; issues with no assignees, or no longer in the project, are
+grouped as (unassigned).`,
+		Example: `  # Time per issue over the last two weeks
+  gh pmu report time --since 2w
+
+  # Time per assignee since the start of the month
+  gh pmu report time --by assignee --since 2025-03-01`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := loadWorklogConfig()
+			if err != nil {
+				return err
+			}
+			return runReportTimeWithDeps(cmd, opts, cfg, path, api.NewClient(), time.Now())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.by, "by", "issue", "Group by 'issue' or 'assignee'")
+	cmd.Flags().StringVar(&opts.since, "since", "", "Only include time logged within this period (e.g., 7d, 2w) or since a date (YYYY-MM-DD)")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+
+	return cmd
+}
+
+// timeReportRow is the time logged for one issue or assignee
+type timeReportRow struct {
+	Name    string  `json:"name"`
+	Entries int     `json:"entries"`
+	Minutes int     `json:"minutes"`
+	Hours   float64 `json:"hours"`
+}
+
+// timeReport is the result of a time report
+type timeReport struct {
+	By           string          `json:"by"`
+	TotalMinutes int             `json:"totalMinutes"`
+	TotalHours   float64         `json:"totalHours"`
+	Rows         []timeReportRow `json:"rows"`
+}
+
+// runReportTimeWithDeps is the testable implementation of report time
+func runReportTimeWithDeps(cmd *cobra.Command, opts *reportTimeOptions, cfg *config.Config, path string, client reportTimeClient, now time.Time) error {
+	if opts.by != "issue" && opts.by != "assignee" {
+		return fmt.Errorf("invalid --by value %q: expected 'issue' or 'assignee'", opts.by)
+	}
+
+	var since time.Time
+	if opts.since != "" {
+		t, err := parseSince(opts.since, now)
+		if err != nil {
+			return err
+		}
+		since = t
+	}
+
+	log, err := worklog.Load(path)
+	if err != nil {
+		return err
+	}
+	entries := log.Since(since)
+
+	groupsOf := func(e worklog.Entry) []string { return []string{e.Issue} }
+	if opts.by == "assignee" && len(entries) > 0 {
+		assignees, err := issueAssignees(cfg, client)
+		if err != nil {
+			return err
+		}
+		groupsOf = func(e worklog.Entry) []string {
+			if logins := assignees[e.Issue]; len(logins) > 0 {
+				return logins
+			}
+			return []string{"(unassigned)"}
+		}
+	}
+
+	report := summarizeWorklog(entries, groupsOf)
+	report.By = opts.by
+
+	if opts.json {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(report)
+	}
+
+	out := cmd.OutOrStdout()
+	if len(report.Rows) == 0 {
+		fmt.Fprintln(out, "No time logged")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "%s\tENTRIES\tTIME\tHOURS\n", strings.ToUpper(opts.by))
+	for _, row := range report.Rows {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%.2f\n", row.Name, row.Entries, worklog.FormatMinutes(row.Minutes), row.Hours)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "\nTotal: %s (%.2fh)\n", worklog.FormatMinutes(report.TotalMinutes), report.TotalHours)
+	return nil
+}
+
+// issueAssignees maps the project's issues, by worklog key, to their assignees
+func issueAssignees(cfg *config.Config, client reportTimeClient) (map[string][]string, error) {
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	assignees := make(map[string][]string)
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		key := itemKey(item)
+		for _, a := range item.Issue.Assignees {
+			assignees[key] = append(assignees[key], a.Login)
+		}
+	}
+	return assignees, nil
+}
+
+// summarizeWorklog totals entries into rows, largest first. groupsOf
+// returns the rows an entry counts toward.
+func summarizeWorklog(entries []worklog.Entry, groupsOf func(worklog.Entry) []string) timeReport {
+	report := timeReport{Rows: []timeReportRow{}}
+	rows := make(map[string]*timeReportRow)
+
+	for _, e := range entries {
+		report.TotalMinutes += e.Minutes
+		for _, name := range groupsOf(e) {
+			row, ok := rows[name]
+			if !ok {
+				row = &timeReportRow{Name: name}
+				rows[name] = row
+			}
+			row.Entries++
+			row.Minutes += e.Minutes
+		}
+	}
+
+	for _, row := range rows {
+		row.Hours = minutesToHours(row.Minutes)
+		report.Rows = append(report.Rows, *row)
+	}
+	sort.Slice(report.Rows, func(i, j int) bool {
+		if report.Rows[i].Minutes != report.Rows[j].Minutes {
+			return report.Rows[i].Minutes > report.Rows[j].Minutes
+		}
+		return report.Rows[i].Name < report.Rows[j].Name
+	})
+	report.TotalHours = minutesToHours(report.TotalMinutes)
+	return report
+}
+
+// minutesToHours converts minutes to hours rounded to two decimal places
+func minutesToHours(minutes int) float64 {
+	return float64(minutes*100/60) / 100
+}
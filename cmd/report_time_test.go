@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/worklog"
+)
+
+// mockReportTimeClient implements reportTimeClient interface for testing
+type mockReportTimeClient struct {
+	items []api.ProjectItem
+	calls int
+}
+
+func (m *mockReportTimeClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockReportTimeClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	m.calls++
+	return m.items, nil
+}
+
+func writeTestWorklog(t *testing.T, now time.Time) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "worklog.json")
+	log := &worklog.Log{Project: "owner/1"}
+	log.Add("owner/repo#1", 120, "debugging", now.AddDate(0, 0, -30))
+	log.Add("owner/repo#1", 90, "", now.AddDate(0, 0, -3))
+	log.Add("owner/repo#2", 45, "review", now.AddDate(0, 0, -1))
+	log.Add("owner/repo#3", 30, "", now)
+	if err := log.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	return path
+}
+
+func TestReportTimeCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"report", "time"})
+	if err != nil || sub.Name() != "time" {
+		t.Fatalf("report time command not found: %v", err)
+	}
+	for _, flag := range []string{"by", "since", "json"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag", flag)
+		}
+	}
+}
+
+func TestRunReportTime_ByIssue(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	path := writeTestWorklog(t, now)
+	client := &mockReportTimeClient{}
+
+	cmd, buf := newTestCmd()
+	opts := &reportTimeOptions{by: "issue", since: "2w"}
+	if err := runReportTimeWithDeps(cmd, opts, newTestConfig(), path, client, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "owner/repo#1  1        1h30m") || !strings.Contains(out, "Total: 2h45m (2.75h)") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+	if client.calls != 0 {
+		t.Error("Expected no API calls when grouping by issue")
+	}
+}
+
+func TestRunReportTime_ByAssigneeJSON(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	path := writeTestWorklog(t, now)
+	client := &mockReportTimeClient{items: []api.ProjectItem{
+		{Issue: &api.Issue{Number: 1, Repository: api.Repository{Owner: "owner", Name: "repo"}, Assignees: []api.Actor{{Login: "alice"}, {Login: "bob"}}}},
+		{Issue: &api.Issue{Number: 2, Repository: api.Repository{Owner: "owner", Name: "repo"}, Assignees: []api.Actor{{Login: "bob"}}}},
+	}}
+
+	cmd, buf := newTestCmd()
+	opts := &reportTimeOptions{by: "assignee", json: true}
+	if err := runReportTimeWithDeps(cmd, opts, newTestConfig(), path, client, now); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var report timeReport
+	if err := json.Unmarshal(buf.Bytes(), &report); err != nil {
+		t.Fatalf("Invalid JSON: %v\n%s", err, buf.String())
+	}
+	if report.TotalMinutes != 285 || report.By != "assignee" {
+		t.Errorf("Unexpected totals: %+v", report)
+	}
+
+	got := map[string]int{}
+	for _, row := range report.Rows {
+		got[row.Name] = row.Minutes
+	}
+	if got["bob"] != 255 || got["alice"] != 210 || got["(unassigned)"] != 30 {
+		t.Errorf("Unexpected rows: %+v", report.Rows)
+	}
+	if report.Rows[0].Name != "bob" {
+		t.Errorf("Expected rows ordered by time, got %+v", report.Rows)
+	}
+}
+
+func TestRunReportTime_InvalidBy(t *testing.T) {
+	cmd, _ := newTestCmd()
+	err := runReportTimeWithDeps(cmd, &reportTimeOptions{by: "repo"}, newTestConfig(), filepath.Join(t.TempDir(), "w.json"), &mockReportTimeClient{}, time.Now())
+	if err == nil || !strings.Contains(err.Error(), "invalid --by") {
+		t.Errorf("Expected invalid --by error, got %v", err)
+	}
+}
+
+func TestRunReportTime_Empty(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runReportTimeWithDeps(cmd, &reportTimeOptions{by: "issue"}, newTestConfig(), filepath.Join(t.TempDir(), "w.json"), &mockReportTimeClient{}, time.Now()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No time logged") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+}
@@ -0,0 +1,557 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// riskLabel marks an issue as a risk
+	riskLabel = "risk"
+
+	// Project fields of a risk when no likelihood, impact, or review
+	// field is configured
+	defaultLikelihoodFieldName = "Likelihood"
+	defaultImpactFieldName     = "Impact"
+	defaultReviewFieldName     = "Review Date"
+
+	// defaultRiskReview is when a new or reviewed risk is next reviewed
+	defaultRiskReview = "30d"
+)
+
+// riskLevels scores likelihood and impact values that are not numbers
+var riskLevels = map[string]int{
+	"very low":  1,
+	"rare":      1,
+	"low":       2,
+	"unlikely":  2,
+	"medium":    3,
+	"moderate":  3,
+	"possible":  3,
+	"high":      4,
+	"likely":    4,
+	"very high": 5,
+	"critical":  5,
+	"certain":   5,
+}
+
+// riskClient defines the interface for API methods used by risk commands.
+// This allows for easier testing with mock implementations.
+type riskClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error)
+	AddIssueToProject(projectID, issueID string) (string, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+	AddIssueComment(issueID, body string) (string, error)
+}
+
+func newRiskCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "risk",
+		Short: "Maintain a risk register in the project",
+		Long: `Maintain a risk register as a slice of the project.
+
+A risk is an issue labeled "risk" (create the label in the repository
+once), or any project item with a likelihood or impact set. Its
+likelihood and impact are the project's "Likelihood" and "Impact"
+fields, single-select or number; its owner is the issue's assignee;
+and its next review is the "Review Date" date field. Map other fields
+with fields.likelihood, fields.impact, and fields.review in .gh-pmu.yml;
+value aliases under those fields apply as well.
+
+A risk's score is likelihood times impact. Numbers count as they are;
+named levels count from 1 (very low, rare) to 5 (very high, critical,
+certain).`,
+	}
+
+	cmd.AddCommand(newRiskAddCommand())
+	cmd.AddCommand(newRiskListCommand())
+	cmd.AddCommand(newRiskReviewCommand())
+
+	return cmd
+}
+
+type riskAddOptions struct {
+	likelihood string
+	impact     string
+	owner      string
+	review     string
+	body       string
+	repo       string
+}
+
+func newRiskAddCommand() *cobra.Command {
+	opts := &riskAddOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add <title>",
+		Short: "Record a new risk",
+		Long: `Create an issue for a risk, labeled "risk", add it to the project, and
+set its likelihood, impact, and first review date.
+
+--review takes a date (YYYY-MM-DD) or a period from today (14d, 4w).`,
+		Example: `  gh pmu risk add "Payment vendor deprecates v1 API" --likelihood high --impact high --owner alice
+  gh pmu risk add "Key engineer on leave in Q3" --likelihood medium --impact low --review 2w`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runRiskAddWithDeps(cmd, args, opts, cfg, api.NewClient(), time.Now())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.likelihood, "likelihood", "", "How likely the risk is (required)")
+	cmd.Flags().StringVar(&opts.impact, "impact", "", "How severe the risk would be (required)")
+	cmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the risk owner (@me for yourself)")
+	cmd.Flags().StringVar(&opts.review, "review", defaultRiskReview, "First review: a date (YYYY-MM-DD) or a period from today (e.g., 14d, 4w)")
+	cmd.Flags().StringVarP(&opts.body, "body", "b", "", "Description, mitigation, and contingency")
+	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository for the issue (owner/repo); defaults to the first configured")
+	_ = cmd.MarkFlagRequired("likelihood")
+	_ = cmd.MarkFlagRequired("impact")
+
+	return cmd
+}
+
+type riskListOptions struct {
+	owner string
+	state string
+	json  bool
+}
+
+func newRiskListCommand() *cobra.Command {
+	opts := &riskListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Show the risk register",
+		Long: `Show the risk register: every risk with its likelihood, impact, score,
+owner, and next review, highest score first. Risks whose review is due
+are marked with "!".`,
+		Example: `  gh pmu risk list
+  gh pmu risk list --owner alice
+  gh pmu risk list --state all --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runRiskListWithDeps(cmd, opts, cfg, api.NewClient(), time.Now())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.owner, "owner", "", "Only risks owned by this login")
+	cmd.Flags().StringVarP(&opts.state, "state", "s", "open", "Filter by state: open, closed, all")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+
+	return cmd
+}
+
+type riskReviewOptions struct {
+	likelihood string
+	impact     string
+	next       string
+	note       string
+	json       bool
+}
+
+func newRiskReviewCommand() *cobra.Command {
+	opts := &riskReviewOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "review [issue]",
+		Short: "List risks due for review, or record a review",
+		Long: `Without an issue, list the open risks whose review date has passed or
+was never set.
+
+With an issue, record a review of it: update its likelihood and impact
+if they changed, move its review date to --next, and comment on the
+issue with what changed and any --note.`,
+		Example: `  # What needs reviewing
+  gh pmu risk review
+
+  # Reviewed; impact went down, look again in two weeks
+  gh pmu risk review 42 --impact low --next 2w --note "Vendor extended the v1 sunset"`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runRiskReviewWithDeps(cmd, args, opts, cfg, api.NewClient(), time.Now())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.likelihood, "likelihood", "", "New likelihood")
+	cmd.Flags().StringVar(&opts.impact, "impact", "", "New impact")
+	cmd.Flags().StringVar(&opts.next, "next", defaultRiskReview, "Next review: a date (YYYY-MM-DD) or a period from today (e.g., 14d, 4w)")
+	cmd.Flags().StringVar(&opts.note, "note", "", "What the review found")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output the risks due for review in JSON format")
+
+	return cmd
+}
+
+// riskFields returns the likelihood, impact, and review field names
+func riskFields(cfg *config.Config) (likelihood, impact, review string) {
+	return cfg.FieldNameOr("likelihood", defaultLikelihoodFieldName),
+		cfg.FieldNameOr("impact", defaultImpactFieldName),
+		cfg.FieldNameOr("review", defaultReviewFieldName)
+}
+
+// parseReviewDate returns the date a review falls on: a date as given,
+// or a period (Nd, Nw) from now
+func parseReviewDate(s string, now time.Time) (string, error) {
+	if _, err := time.Parse("2006-01-02", s); err == nil {
+		return s, nil
+	}
+	if len(s) >= 2 {
+		if n, err := strconv.Atoi(s[:len(s)-1]); err == nil && n > 0 {
+			switch s[len(s)-1] {
+			case 'd':
+				return now.AddDate(0, 0, n).Format("2006-01-02"), nil
+			case 'w':
+				return now.AddDate(0, 0, 7*n).Format("2006-01-02"), nil
+			}
+		}
+	}
+	return "", fmt.Errorf("invalid review date %q: expected a date (YYYY-MM-DD) or a period like 14d or 4w", s)
+}
+
+// riskLevel scores a likelihood or impact value, or 0 when it is unknown
+func riskLevel(value string) int {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		return int(n)
+	}
+	return riskLevels[strings.NewReplacer("_", " ", "-", " ").Replace(value)]
+}
+
+// riskRow is a risk in the register
+type riskRow struct {
+	Issue      string   `json:"issue"` // owner/repo#number
+	Title      string   `json:"title"`
+	State      string   `json:"state"`
+	Likelihood string   `json:"likelihood"`
+	Impact     string   `json:"impact"`
+	Score      int      `json:"score"`
+	Owners     []string `json:"owners"`
+	Review     string   `json:"review"` // YYYY-MM-DD; empty when unset
+	Due        bool     `json:"due"`    // the review date has passed or is unset
+}
+
+// riskItem pairs a risk with its project item
+type riskItem struct {
+	riskRow
+	item api.ProjectItem
+}
+
+// loadRisks returns the project's risks, highest score first, then by
+// review date
+func loadRisks(cfg *config.Config, client riskClient, now time.Time) (string, []riskItem, error) {
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	likelihoodField, impactField, reviewField := riskFields(cfg)
+	today := now.Format("2006-01-02")
+
+	var risks []riskItem
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		likelihood := getFieldValue(item, likelihoodField)
+		impact := getFieldValue(item, impactField)
+		if likelihood == "" && impact == "" && !hasLabel(item.Issue.Labels, riskLabel) {
+			continue
+		}
+
+		review := getFieldValue(item, reviewField)
+		risks = append(risks, riskItem{
+			riskRow: riskRow{
+				Issue:      itemKey(item),
+				Title:      item.Issue.Title,
+				State:      item.Issue.State,
+				Likelihood: likelihood,
+				Impact:     impact,
+				Score:      riskLevel(likelihood) * riskLevel(impact),
+				Owners:     append([]string{}, actorLogins(item.Issue.Assignees)...),
+				Review:     review,
+				Due:        review == "" || review <= today,
+			},
+			item: item,
+		})
+	}
+
+	sort.SliceStable(risks, func(i, j int) bool {
+		if risks[i].Score != risks[j].Score {
+			return risks[i].Score > risks[j].Score
+		}
+		return risks[i].Review < risks[j].Review
+	})
+	return project.ID, risks, nil
+}
+
+// hasLabel reports whether labels include name, ignoring case
+func hasLabel(labels []api.Label, name string) bool {
+	for _, l := range labels {
+		if strings.EqualFold(l.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// runRiskAddWithDeps is the testable implementation of risk add
+func runRiskAddWithDeps(cmd *cobra.Command, args []string, opts *riskAddOptions, cfg *config.Config, client riskClient, now time.Time) error {
+	title := strings.TrimSpace(args[0])
+	if title == "" {
+		return fmt.Errorf("risk title cannot be empty")
+	}
+
+	likelihoodField, impactField, reviewField := riskFields(cfg)
+	likelihood, err := resolveCreateFieldValue(cfg, "likelihood", likelihoodField, opts.likelihood, now)
+	if err != nil {
+		return err
+	}
+	impact, err := resolveCreateFieldValue(cfg, "impact", impactField, opts.impact, now)
+	if err != nil {
+		return err
+	}
+	review, err := parseReviewDate(opts.review, now)
+	if err != nil {
+		return err
+	}
+
+	repository := opts.repo
+	if repository == "" {
+		repository = cfg.Repositories[0]
+	}
+	owner, repo := splitRepository(repository)
+	if owner == "" || repo == "" {
+		return fmt.Errorf("invalid repository %q: expected owner/repo", repository)
+	}
+
+	var assignees []string
+	if opts.owner != "" {
+		assignees = []string{opts.owner}
+	}
+
+	// Look up the project first so a bad config fails before anything is created
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	issue, err := client.CreateIssueWithOptions(owner, repo, title, opts.body, []string{riskLabel}, assignees, "")
+	if err != nil {
+		return fmt.Errorf("failed to create issue: %w", err)
+	}
+	itemID, err := client.AddIssueToProject(project.ID, issue.ID)
+	if err != nil {
+		return fmt.Errorf("failed to add issue to project: %w", err)
+	}
+
+	cmd.Printf("Recorded risk %s/%s#%d: %s\n", owner, repo, issue.Number, title)
+	for _, f := range []moveFieldChange{
+		{field: likelihoodField, value: likelihood},
+		{field: impactField, value: impact},
+		{field: reviewField, value: review},
+	} {
+		if err := client.SetProjectItemField(project.ID, itemID, f.field, f.value); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to set %s: %v\n", f.field, err)
+			continue
+		}
+		cmd.Printf("  • %s → %s\n", f.field, f.value)
+	}
+	if score := riskLevel(likelihood) * riskLevel(impact); score > 0 {
+		cmd.Printf("  • Score %d\n", score)
+	}
+	cmd.Printf("🔗 %s\n", issue.URL)
+	return nil
+}
+
+// runRiskListWithDeps is the testable implementation of risk list
+func runRiskListWithDeps(cmd *cobra.Command, opts *riskListOptions, cfg *config.Config, client riskClient, now time.Time) error {
+	state := strings.ToLower(opts.state)
+	if !config.IsValidState(state) {
+		return fmt.Errorf("invalid state: %s (must be open, closed, or all)", opts.state)
+	}
+
+	_, risks, err := loadRisks(cfg, client, now)
+	if err != nil {
+		return err
+	}
+
+	var rows []riskRow
+	for _, r := range risks {
+		if state != "all" && !strings.EqualFold(r.State, state) {
+			continue
+		}
+		if opts.owner != "" && !containsFold(r.Owners, strings.TrimPrefix(opts.owner, "@")) {
+			continue
+		}
+		rows = append(rows, r.riskRow)
+	}
+	return writeRiskRows(cmd, rows, opts.json, "No risks found")
+}
+
+// runRiskReviewWithDeps is the testable implementation of risk review
+func runRiskReviewWithDeps(cmd *cobra.Command, args []string, opts *riskReviewOptions, cfg *config.Config, client riskClient, now time.Time) error {
+	if len(args) == 0 {
+		if opts.likelihood != "" || opts.impact != "" || opts.note != "" || cmd.Flags().Changed("next") {
+			return fmt.Errorf("--likelihood, --impact, --next, and --note record a review and require an issue")
+		}
+		_, risks, err := loadRisks(cfg, client, now)
+		if err != nil {
+			return err
+		}
+		var rows []riskRow
+		for _, r := range risks {
+			if r.Due && strings.EqualFold(r.State, "OPEN") {
+				rows = append(rows, r.riskRow)
+			}
+		}
+		return writeRiskRows(cmd, rows, opts.json, "No risks are due for review")
+	}
+	if opts.json {
+		return fmt.Errorf("--json only applies when listing risks due for review")
+	}
+
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	next, err := parseReviewDate(opts.next, now)
+	if err != nil {
+		return err
+	}
+
+	projectID, risks, err := loadRisks(cfg, client, now)
+	if err != nil {
+		return err
+	}
+	var risk *riskItem
+	for i := range risks {
+		if risks[i].Issue == key {
+			risk = &risks[i]
+			break
+		}
+	}
+	if risk == nil {
+		return fmt.Errorf("%s is not a risk in the project; record it with 'gh pmu risk add'", key)
+	}
+
+	likelihoodField, impactField, reviewField := riskFields(cfg)
+	changes := []moveFieldChange{}
+	var lines []string
+	for _, c := range []struct {
+		key, field, old, value string
+	}{
+		{"likelihood", likelihoodField, risk.Likelihood, opts.likelihood},
+		{"impact", impactField, risk.Impact, opts.impact},
+	} {
+		if c.value == "" {
+			lines = append(lines, fmt.Sprintf("- %s: %s (unchanged)", c.field, conflictValue(c.old)))
+			continue
+		}
+		value, err := resolveCreateFieldValue(cfg, c.key, c.field, c.value, now)
+		if err != nil {
+			return err
+		}
+		if strings.EqualFold(value, c.old) {
+			lines = append(lines, fmt.Sprintf("- %s: %s (unchanged)", c.field, value))
+			continue
+		}
+		changes = append(changes, moveFieldChange{field: c.field, value: value})
+		lines = append(lines, fmt.Sprintf("- %s: %s → %s", c.field, conflictValue(c.old), value))
+	}
+	changes = append(changes, moveFieldChange{field: reviewField, value: next})
+	lines = append(lines, fmt.Sprintf("- Next review: %s", next))
+
+	for _, c := range changes {
+		if err := client.SetProjectItemField(projectID, risk.item.ID, c.field, c.value); err != nil {
+			return fmt.Errorf("failed to set %s: %w", c.field, err)
+		}
+	}
+
+	comment := fmt.Sprintf("**Risk reviewed** %s\n\n%s", now.Format("2006-01-02"), strings.Join(lines, "\n"))
+	if note := strings.TrimSpace(opts.note); note != "" {
+		comment += "\n\n" + note
+	}
+	if _, err := client.AddIssueComment(risk.item.Issue.ID, comment); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to comment on %s: %v\n", key, err)
+	}
+
+	cmd.Printf("Reviewed %s: %s\n", key, risk.Title)
+	for _, line := range lines {
+		cmd.Printf("  • %s\n", strings.TrimPrefix(line, "- "))
+	}
+	return nil
+}
+
+// writeRiskRows writes risks as a table or JSON
+func writeRiskRows(cmd *cobra.Command, rows []riskRow, asJSON bool, empty string) error {
+	if asJSON {
+		if rows == nil {
+			rows = []riskRow{}
+		}
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(rows)
+	}
+
+	out := cmd.OutOrStdout()
+	if len(rows) == 0 {
+		fmt.Fprintln(out, empty)
+		return nil
+	}
+
+	orDash := func(v string) string {
+		if v == "" {
+			return "-"
+		}
+		return v
+	}
+
+	due := 0
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ISSUE\tTITLE\tLIKELIHOOD\tIMPACT\tSCORE\tOWNER\tREVIEW")
+	for _, r := range rows {
+		score := ""
+		if r.Score > 0 {
+			score = strconv.Itoa(r.Score)
+		}
+		review := orDash(r.Review)
+		if r.Due && strings.EqualFold(r.State, "OPEN") {
+			review += " !"
+			due++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Issue, r.Title, orDash(r.Likelihood), orDash(r.Impact), orDash(score), orDash(strings.Join(r.Owners, ", ")), review)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\n%d risks, %d due for review\n", len(rows), due)
+	return nil
+}
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// mockRiskClient implements riskClient interface for testing
+type mockRiskClient struct {
+	items       []api.ProjectItem
+	created     *api.Issue
+	labels      []string
+	assignees   []string
+	fieldsSet   map[string]string
+	comments    []string
+	createCalls int
+}
+
+func (m *mockRiskClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockRiskClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func (m *mockRiskClient) CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error) {
+	m.createCalls++
+	m.labels = labels
+	m.assignees = assignees
+	m.created = &api.Issue{ID: "issue-new", Number: 50, Title: title, URL: "https://github.com/owner/repo/issues/50"}
+	return m.created, nil
+}
+
+func (m *mockRiskClient) AddIssueToProject(projectID, issueID string) (string, error) {
+	return "item-new", nil
+}
+
+func (m *mockRiskClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	if m.fieldsSet == nil {
+		m.fieldsSet = make(map[string]string)
+	}
+	m.fieldsSet[itemID+"/"+fieldName] = value
+	return nil
+}
+
+func (m *mockRiskClient) AddIssueComment(issueID, body string) (string, error) {
+	m.comments = append(m.comments, issueID+": "+body)
+	return "comment-1", nil
+}
+
+var riskTestNow = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+func newRiskTestClient() *mockRiskClient {
+	risk := func(number int, title, likelihood, impact, review, owner string, labels ...string) api.ProjectItem {
+		issue := &api.Issue{
+			ID:         "issue-" + title,
+			Number:     number,
+			Title:      title,
+			State:      "OPEN",
+			Repository: api.Repository{Owner: "owner", Name: "repo"},
+		}
+		if owner != "" {
+			issue.Assignees = []api.Actor{{Login: owner}}
+		}
+		for _, l := range labels {
+			issue.Labels = append(issue.Labels, api.Label{Name: l})
+		}
+		var values []api.FieldValue
+		for field, value := range map[string]string{"Likelihood": likelihood, "Impact": impact, "Review Date": review} {
+			if value != "" {
+				values = append(values, api.FieldValue{Field: field, Value: value})
+			}
+		}
+		return api.ProjectItem{ID: "item-" + title, Issue: issue, FieldValues: values}
+	}
+	return &mockRiskClient{items: []api.ProjectItem{
+		risk(1, "Vendor sunset", "High", "High", "2026-04-01", "alice"),
+		risk(2, "Key person leave", "Low", "Medium", "2026-03-01", "bob", "risk"),
+		risk(3, "Untriaged risk", "", "", "", "", "Risk"),
+		risk(4, "Ordinary bug", "", "", "", "alice", "bug"),
+	}}
+}
+
+func TestRiskLevel(t *testing.T) {
+	tests := map[string]int{"High": 4, "very-high": 5, "3": 3, "2.0": 2, "rare": 1, "": 0, "unknown": 0}
+	for value, want := range tests {
+		if got := riskLevel(value); got != want {
+			t.Errorf("riskLevel(%q) = %d, want %d", value, got, want)
+		}
+	}
+}
+
+func TestParseReviewDate(t *testing.T) {
+	tests := map[string]string{"2026-05-01": "2026-05-01", "14d": "2026-03-24", "2w": "2026-03-24"}
+	for value, want := range tests {
+		got, err := parseReviewDate(value, riskTestNow)
+		if err != nil || got != want {
+			t.Errorf("parseReviewDate(%q) = %q, %v; want %q", value, got, err, want)
+		}
+	}
+	if _, err := parseReviewDate("soon", riskTestNow); err == nil {
+		t.Error("Expected an error for an invalid review date")
+	}
+}
+
+func TestRunRiskAdd_CreatesLabeledIssueWithFields(t *testing.T) {
+	client := newRiskTestClient()
+	cmd, buf := newTestCmd()
+	opts := &riskAddOptions{likelihood: "high", impact: "medium", owner: "alice", review: "2w"}
+
+	if err := runRiskAddWithDeps(cmd, []string{"Vendor sunset"}, opts, newTestConfig(), client, riskTestNow); err != nil {
+		t.Fatalf("runRiskAddWithDeps() error = %v", err)
+	}
+
+	if len(client.labels) != 1 || client.labels[0] != "risk" || len(client.assignees) != 1 || client.assignees[0] != "alice" {
+		t.Errorf("Expected a risk-labeled issue assigned to alice, got labels %v assignees %v", client.labels, client.assignees)
+	}
+	want := map[string]string{
+		"item-new/Likelihood":  "high",
+		"item-new/Impact":      "medium",
+		"item-new/Review Date": "2026-03-24",
+	}
+	for k, v := range want {
+		if client.fieldsSet[k] != v {
+			t.Errorf("Expected %s = %q, got %q", k, v, client.fieldsSet[k])
+		}
+	}
+	if !strings.Contains(buf.String(), "Score 12") {
+		t.Errorf("Expected the score, got:\n%s", buf.String())
+	}
+}
+
+func TestRunRiskAdd_ConfiguredFields(t *testing.T) {
+	client := newRiskTestClient()
+	cmd, _ := newTestCmd()
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{
+		"likelihood": {Field: "Probability", Values: map[string]string{"hi": "High"}},
+	}
+	opts := &riskAddOptions{likelihood: "hi", impact: "Low", review: "2026-04-01"}
+
+	if err := runRiskAddWithDeps(cmd, []string{"Risk"}, opts, cfg, client, riskTestNow); err != nil {
+		t.Fatalf("runRiskAddWithDeps() error = %v", err)
+	}
+	if client.fieldsSet["item-new/Probability"] != "High" {
+		t.Errorf("Expected the configured field and alias, got %v", client.fieldsSet)
+	}
+}
+
+func TestRunRiskAdd_InvalidReviewCreatesNothing(t *testing.T) {
+	client := newRiskTestClient()
+	cmd, _ := newTestCmd()
+	opts := &riskAddOptions{likelihood: "high", impact: "high", review: "someday"}
+
+	if err := runRiskAddWithDeps(cmd, []string{"Risk"}, opts, newTestConfig(), client, riskTestNow); err == nil {
+		t.Fatal("Expected an error for an invalid review date")
+	}
+	if client.createCalls != 0 {
+		t.Error("Expected no issue to be created")
+	}
+}
+
+func TestRunRiskList_Register(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runRiskListWithDeps(cmd, &riskListOptions{state: "open"}, newTestConfig(), newRiskTestClient(), riskTestNow); err != nil {
+		t.Fatalf("runRiskListWithDeps() error = %v", err)
+	}
+	out := buf.String()
+
+	if strings.Contains(out, "Ordinary bug") {
+		t.Errorf("Expected only risks, got:\n%s", out)
+	}
+	first, second, third := strings.Index(out, "Vendor sunset"), strings.Index(out, "Key person leave"), strings.Index(out, "Untriaged risk")
+	if first < 0 || second < first || third < second {
+		t.Errorf("Expected risks by score, got:\n%s", out)
+	}
+	if !strings.Contains(out, "2026-03-01 !") || strings.Contains(out, "2026-04-01 !") {
+		t.Errorf("Expected only the past review marked due, got:\n%s", out)
+	}
+	if !strings.Contains(out, "3 risks, 2 due for review") {
+		t.Errorf("Expected the summary, got:\n%s", out)
+	}
+}
+
+func TestRunRiskList_OwnerJSON(t *testing.T) {
+	cmd, buf := newTestCmd()
+	opts := &riskListOptions{state: "open", owner: "@alice", json: true}
+	if err := runRiskListWithDeps(cmd, opts, newTestConfig(), newRiskTestClient(), riskTestNow); err != nil {
+		t.Fatalf("runRiskListWithDeps() error = %v", err)
+	}
+
+	var rows []riskRow
+	if err := json.Unmarshal(buf.Bytes(), &rows); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(rows) != 1 || rows[0].Issue != "owner/repo#1" || rows[0].Score != 16 || rows[0].Due {
+		t.Errorf("Unexpected rows: %+v", rows)
+	}
+}
+
+func TestRunRiskReview_ListsDue(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runRiskReviewWithDeps(cmd, nil, &riskReviewOptions{next: "30d"}, newTestConfig(), newRiskTestClient(), riskTestNow); err != nil {
+		t.Fatalf("runRiskReviewWithDeps() error = %v", err)
+	}
+	out := buf.String()
+	if strings.Contains(out, "Vendor sunset") || !strings.Contains(out, "Key person leave") || !strings.Contains(out, "Untriaged risk") {
+		t.Errorf("Expected only risks due for review, got:\n%s", out)
+	}
+}
+
+func TestRunRiskReview_RecordsReview(t *testing.T) {
+	client := newRiskTestClient()
+	cmd, buf := newTestCmd()
+	opts := &riskReviewOptions{impact: "low", next: "2w", note: "Vendor extended the sunset"}
+
+	if err := runRiskReviewWithDeps(cmd, []string{"1"}, opts, newTestConfig(), client, riskTestNow); err != nil {
+		t.Fatalf("runRiskReviewWithDeps() error = %v", err)
+	}
+
+	if client.fieldsSet["item-Vendor sunset/Impact"] != "low" || client.fieldsSet["item-Vendor sunset/Review Date"] != "2026-03-24" {
+		t.Errorf("Unexpected fields set: %v", client.fieldsSet)
+	}
+	if _, ok := client.fieldsSet["item-Vendor sunset/Likelihood"]; ok {
+		t.Error("Expected an unchanged likelihood not to be set")
+	}
+	if len(client.comments) != 1 || !strings.Contains(client.comments[0], "Impact: High → low") || !strings.Contains(client.comments[0], "Vendor extended the sunset") {
+		t.Errorf("Unexpected comment: %v", client.comments)
+	}
+	if !strings.Contains(buf.String(), "Next review: 2026-03-24") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunRiskReview_NotARisk(t *testing.T) {
+	cmd, _ := newTestCmd()
+	err := runRiskReviewWithDeps(cmd, []string{"4"}, &riskReviewOptions{next: "30d"}, newTestConfig(), newRiskTestClient(), riskTestNow)
+	if err == nil || !strings.Contains(err.Error(), "is not a risk") {
+		t.Errorf("Expected a not-a-risk error, got %v", err)
+	}
+}
@@ -1,6 +1,13 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/remedy"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +27,24 @@ Use 'gh pmu <command> --help' for more information about a command.`,
 		Version: version,
 	}
 
+	stopTimeout := func() {}
+	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := applyScript(cmd); err != nil {
+			return err
+		}
+		stopTimeout = applyLimits(cmd)
+		return nil
+	}
+	cmd.PersistentPostRun = func(cmd *cobra.Command, args []string) {
+		stopTimeout()
+		warnUnreadScript(cmd)
+	}
+
+	cmd.PersistentFlags().Bool("no-color", false, "Disable colored output")
+	cmd.PersistentFlags().Duration("timeout", 0, "Stop the command after this long, e.g. 5m (default: limits.timeout, or none)")
+	cmd.PersistentFlags().Duration("request-timeout", 0, "Limit each API request, e.g. 30s (default: limits.request_timeout, or none)")
+	cmd.PersistentFlags().String("script", "", "Answer prompts from a keystroke script file, one line per Enter")
+
 	cmd.AddCommand(newInitCommand())
 	cmd.AddCommand(newListCommand())
 	cmd.AddCommand(newViewCommand())
@@ -29,10 +54,87 @@ Use 'gh pmu <command> --help' for more information about a command.`,
 	cmd.AddCommand(newIntakeCommand())
 	cmd.AddCommand(newTriageCommand())
 	cmd.AddCommand(newSplitCommand())
+	cmd.AddCommand(newReportCommand())
+	cmd.AddCommand(newAPICommand())
+	cmd.AddCommand(newMCPCommand())
+	cmd.AddCommand(newSummarizeCommand())
+	cmd.AddCommand(newSyncCommand())
+	cmd.AddCommand(newSimilarCommand())
+	cmd.AddCommand(newSuggestPriorityCommand())
+	cmd.AddCommand(newNoteCommand())
+	cmd.AddCommand(newFavCommand())
+	cmd.AddCommand(newFocusCommand())
+	cmd.AddCommand(newCommitMsgCommand())
+	cmd.AddCommand(newPRCommand())
+	cmd.AddCommand(newLogCommand())
+	cmd.AddCommand(newTimerCommand())
+	cmd.AddCommand(newPromptSegmentCommand())
+	cmd.AddCommand(newWatchCommand())
+	cmd.AddCommand(newSubscribeCommand())
+	cmd.AddCommand(newUnsubscribeCommand())
+	cmd.AddCommand(newBoardCommand())
+	cmd.AddCommand(newEditCommand())
+	cmd.AddCommand(newExportCommand())
+	cmd.AddCommand(newDaemonCommand())
+	cmd.AddCommand(newServeCommand())
+	cmd.AddCommand(newAnnotateCommand())
+	cmd.AddCommand(newRiskCommand())
+	cmd.AddCommand(newDecisionCommand())
+	cmd.AddCommand(newAgendaCommand())
+	cmd.AddCommand(newPollCommand())
+	cmd.AddCommand(newMirrorCommand())
+	cmd.AddCommand(newExplainCommand())
+	cmd.AddCommand(newTourCommand())
+	cmd.AddCommand(newSortCommand())
+	cmd.AddCommand(newPlanMailCommand())
+	cmd.AddCommand(newBlameCommand())
+	cmd.AddCommand(newConfigCommand())
 
 	return cmd
 }
 
+// colorDisabled reports whether styled output is turned off, either by
+// --no-color or by the environment (NO_COLOR, or stdout not a terminal)
+func colorDisabled(cmd *cobra.Command) bool {
+	if noColor, err := cmd.Flags().GetBool("no-color"); err == nil && noColor {
+		return true
+	}
+	return !term.FromEnv().IsColorEnabled()
+}
+
 func Execute() error {
-	return NewRootCommand().Execute()
+	root := NewRootCommand()
+	c, err := root.ExecuteC()
+	if timeout := commandTimeout(c); timeout != nil {
+		fmt.Fprintf(root.ErrOrStderr(), "Error: %v\n", timeout)
+		return timeout
+	}
+	if s := remedy.Suggest(err, remedyContext()); s != nil {
+		fmt.Fprint(root.ErrOrStderr(), s)
+	}
+	return err
+}
+
+// ExitCode returns the exit status for an error returned by Execute:
+// timeoutExitCode once --timeout expired, else 1
+func ExitCode(err error) int {
+	var timeout *commandTimeoutError
+	if errors.As(err, &timeout) {
+		return timeoutExitCode
+	}
+	return 1
+}
+
+// remedyContext returns the project named in .gh-pmu.yml, if any, for the
+// commands suggested after an error
+func remedyContext() remedy.Context {
+	cwd, err := os.Getwd()
+	if err != nil {
+		return remedy.Context{}
+	}
+	cfg, err := config.LoadFromDirectory(cwd)
+	if err != nil {
+		return remedy.Context{}
+	}
+	return remedy.Context{ProjectOwner: cfg.Project.Owner, ProjectNumber: cfg.Project.Number}
 }
@@ -3,6 +3,8 @@ package cmd
 import (
 	"bytes"
 	"testing"
+
+	"github.com/spf13/cobra"
 )
 
 func TestRootCommandHelp(t *testing.T) {
@@ -46,3 +48,18 @@ func TestRootCommandVersion(t *testing.T) {
 		t.Errorf("Expected version output to contain 'gh-pm', got: %s", output)
 	}
 }
+
+func TestColorDisabled(t *testing.T) {
+	cmd := NewRootCommand()
+	if err := cmd.PersistentFlags().Set("no-color", "true"); err != nil {
+		t.Fatalf("failed to set --no-color: %v", err)
+	}
+	if !colorDisabled(cmd) {
+		t.Error("Expected --no-color to disable color")
+	}
+
+	t.Setenv("NO_COLOR", "1")
+	if !colorDisabled(&cobra.Command{}) {
+		t.Error("Expected NO_COLOR to disable color")
+	}
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"github.com/scooter-indie/gh-pmu/internal/keyscript"
+	"github.com/spf13/cobra"
+)
+
+// applyScript makes the --script file the command's input: each line is
+// typed as if at a terminal and echoed as it is read, so a recorded
+// session replays the same way every time
+func applyScript(cmd *cobra.Command) error {
+	path, _ := cmd.Flags().GetString("script")
+	if path == "" {
+		return nil
+	}
+	lines, err := keyscript.Load(path)
+	if err != nil {
+		return err
+	}
+	cmd.SetIn(keyscript.NewReader(lines, cmd.OutOrStdout()))
+	return nil
+}
+
+// isScripted reports whether the command's input is a --script
+func isScripted(cmd *cobra.Command) bool {
+	_, ok := cmd.InOrStdin().(*keyscript.Reader)
+	return ok
+}
+
+// warnUnreadScript warns when a command finished before reading its whole
+// --script, which usually means the script no longer matches the prompts
+func warnUnreadScript(cmd *cobra.Command) {
+	if r, ok := cmd.InOrStdin().(*keyscript.Reader); ok && r.Remaining() > 0 {
+		cmd.PrintErrf("Warning: %d line(s) of --script were not read\n", r.Remaining())
+	}
+}
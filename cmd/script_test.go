@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/ptytest"
+	"github.com/spf13/cobra"
+)
+
+// newScriptTestCmd returns a command with the --script flag set to a
+// script file with the given content
+func newScriptTestCmd(t *testing.T, script string) (*cobra.Command, *strings.Builder) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "demo.keys")
+	if err := os.WriteFile(path, []byte(script), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().String("script", "", "")
+	cmd.Flags().Bool("no-color", true, "")
+	_ = cmd.Flags().Set("script", path)
+	out := &strings.Builder{}
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	return cmd, out
+}
+
+// openTestPTY opens a pseudo-terminal, skipping the test where there is none
+func openTestPTY(t *testing.T) *ptytest.PTY {
+	t.Helper()
+	p, err := ptytest.Open()
+	if errors.Is(err, ptytest.ErrUnsupported) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatalf("ptytest.Open() error = %v", err)
+	}
+	t.Cleanup(func() { p.Close() })
+	return p
+}
+
+func TestApplyScript(t *testing.T) {
+	cmd, out := newScriptTestCmd(t, "# pick Done\n3\nleft over\n")
+	if err := applyScript(cmd); err != nil {
+		t.Fatalf("applyScript() error = %v", err)
+	}
+	if !isScripted(cmd) || !isInteractiveTerminal(cmd) {
+		t.Error("Expected scripted input to count as interactive")
+	}
+
+	line, _ := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if line != "3\n" || out.String() != "3\n" {
+		t.Errorf("Read %q and echoed %q, want the first line both times", line, out.String())
+	}
+
+	warnUnreadScript(cmd)
+	if !strings.Contains(out.String(), "Warning: 1 line(s) of --script were not read") {
+		t.Errorf("Expected an unread script warning, got %q", out.String())
+	}
+
+	_ = cmd.Flags().Set("script", filepath.Join(t.TempDir(), "missing.keys"))
+	if err := applyScript(cmd); err == nil || !strings.Contains(err.Error(), "failed to read script") {
+		t.Errorf("Expected a missing script error, got %v", err)
+	}
+}
+
+func TestIsInteractiveTerminal(t *testing.T) {
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader(""))
+	if isInteractiveTerminal(cmd) {
+		t.Error("Expected piped input not to be interactive")
+	}
+
+	p := openTestPTY(t)
+	cmd.SetIn(p.TTY)
+	cmd.SetOut(p.TTY)
+	if !isInteractiveTerminal(cmd) {
+		t.Error("Expected a terminal to be interactive")
+	}
+}
+
+func TestRunMovePick_Script(t *testing.T) {
+	mock, cfg := newMovePickTest("In Progress")
+	cmd, out := newScriptTestCmd(t, "# move to Done\n3\n")
+	if err := applyScript(cmd); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := runMovePickWithDeps(cmd, []string{"42"}, &moveOptions{}, cfg, mock, bufio.NewReader(cmd.InOrStdin())); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// The answer is echoed after its prompt, as typed on a terminal
+	if !strings.Contains(out.String(), "Status for #42 (current: In Progress) [2]: 3\n") {
+		t.Errorf("Expected the scripted answer after the prompt, got:\n%s", out.String())
+	}
+	if len(mock.fieldUpdates) != 1 || mock.fieldUpdates[0].value != "Done" {
+		t.Errorf("Expected Status → Done, got %+v", mock.fieldUpdates)
+	}
+}
+
+func TestRunMovePick_PTY(t *testing.T) {
+	mock, cfg := newMovePickTest("In Progress")
+	p := openTestPTY(t)
+
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool("no-color", true, "")
+	cmd.SetIn(p.TTY)
+	cmd.SetOut(p.TTY)
+	cmd.SetErr(p.TTY)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- runMovePickWithDeps(cmd, []string{"42"}, &moveOptions{}, cfg, mock, bufio.NewReader(cmd.InOrStdin()))
+	}()
+
+	if err := p.Expect("[2]: ", 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Type("3"); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for the pick; screen:\n%s", p.Screen())
+	}
+
+	if err := p.Expect("Status for #42 (current: In Progress) [2]: 3\n", 5*time.Second); err != nil {
+		t.Error(err)
+	}
+	if len(mock.fieldUpdates) != 1 || mock.fieldUpdates[0].value != "Done" {
+		t.Errorf("Expected Status → Done, got %+v", mock.fieldUpdates)
+	}
+}
+
+func TestRunInit_PTY(t *testing.T) {
+	dir := t.TempDir()
+	existing := []byte("project:\n  owner: owner\n  number: 1\n")
+	if err := os.WriteFile(filepath.Join(dir, ".gh-pmu.yml"), existing, 0644); err != nil {
+		t.Fatal(err)
+	}
+	originalDir, _ := os.Getwd()
+	defer func() { _ = os.Chdir(originalDir) }()
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Failed to chdir: %v", err)
+	}
+
+	p := openTestPTY(t)
+	root := NewRootCommand()
+	root.SetArgs([]string{"init", "--no-color"})
+	root.SetIn(p.TTY)
+	root.SetOut(p.TTY)
+	root.SetErr(p.TTY)
+
+	done := make(chan error, 1)
+	go func() { done <- root.Execute() }()
+
+	// The wizard asks before replacing an existing config
+	if err := p.Expect("Overwrite? [y/N]: ", 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Type("n"); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Timed out waiting for init; screen:\n%s", p.Screen())
+	}
+
+	if err := p.Expect("Overwrite? [y/N]: n\n", 5*time.Second); err != nil {
+		t.Error(err)
+	}
+	if err := p.Expect("Aborted", 5*time.Second); err != nil {
+		t.Error(err)
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, ".gh-pmu.yml")); string(data) != string(existing) {
+		t.Errorf("Expected the config left alone, got:\n%s", data)
+	}
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/daemon"
+	"github.com/scooter-indie/gh-pmu/internal/focus"
+	"github.com/spf13/cobra"
+)
+
+type serveOptions struct {
+	lspLike bool
+	ttl     time.Duration
+}
+
+func newServeCommand() *cobra.Command {
+	opts := &serveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the project to an editor over JSON-RPC on stdio",
+		Long: `Serve the project over JSON-RPC 2.0 on stdin/stdout, for editor extensions
+such as an IDE sidebar. The methods drive the same engine as the CLI and
+the daemon: the same configuration, field aliases, item cache, and focus.
+
+Messages are newline-delimited. With --lsp-like every message is instead
+preceded by a "Content-Length: N" header and a blank line, as in the
+Language Server Protocol, so existing LSP client libraries can talk to it.
+A running daemon serves the same methods at POST /v1/rpc.
+
+Methods:
+  initialize   {} -> {"serverInfo", "project", "methods"}
+  status       {} -> {"project", "startedAt", "items", "cachedAt"}
+  items/list   {"query", "repo"} -> [item]; query takes the same
+               qualifiers as move --query, repo limits to a repository
+  items/view   {"issue": "42"} -> {"issue", "itemId", "fieldValues"}
+  items/move   {"issues": ["42"], "status", "priority"} -> {"results"},
+               with an issue, title, and error (if any) per issue
+  items/refresh {} -> {"refreshed": true}; drop the cached items
+  focus/get    {} -> {"project", "issue", "setAt"}; issue is empty
+               when nothing is focused
+  focus/set    {"issue": "42"} -> {"project", "issue", "setAt"}
+  focus/clear  {} -> {"cleared": true|false}
+  shutdown     {} -> {}
+
+Issues are given as they are on the command line: 42, #42, or owner/repo#42.
+An item is {"id", "issue", "fieldValues", "archived"}, and an issue is
+{"number", "title", "state", "url", "repository", "assignees", "labels", ...}
+with camelCase keys, as from the daemon's /v1/items.`,
+		Example: `  # Start from an editor extension (command + args)
+  gh pmu serve --lsp-like
+
+  # Try it by hand
+  echo '{"jsonrpc":"2.0","id":1,"method":"items/list","params":{"query":"status:in_progress"}}' | gh pmu serve`,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{longRunningAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, focusPath, err := loadFocusConfig()
+			if err != nil {
+				return err
+			}
+			return runUntilDone(cmd.Context(), func() error {
+				return runServeWithDeps(cmd, opts, cfg, api.NewClient(), focusPath)
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.lspLike, "lsp-like", false, "Frame messages with Content-Length headers, as in the Language Server Protocol")
+	cmd.Flags().DurationVar(&opts.ttl, "ttl", time.Minute, "How long to serve project items from the cache")
+
+	return cmd
+}
+
+// runServeWithDeps is the testable implementation of serve. It serves
+// until stdin is exhausted.
+func runServeWithDeps(cmd *cobra.Command, opts *serveOptions, cfg *config.Config, client daemonClient, focusPath string) error {
+	service := newDaemonService(cfg, client, opts.ttl, focusPath)
+	// Keep a running daemon's cache in step with moves made here
+	service.onMove = func() { refreshDaemon(cfg) }
+	return service.rpc().ServeStream(cmd.InOrStdin(), cmd.OutOrStdout(), opts.lspLike)
+}
+
+// serveIssueParams are the params of methods that take one issue
+type serveIssueParams struct {
+	Issue string `json:"issue"`
+}
+
+// serveView is the result of items/view
+type serveView struct {
+	Issue       *daemonIssueJSON       `json:"issue"`
+	ItemID      string                 `json:"itemId,omitempty"` // empty when the issue is not in the project
+	FieldValues []daemonFieldValueJSON `json:"fieldValues"`
+}
+
+// rpc returns the JSON-RPC methods for editors, backed by the service
+func (s *daemonService) rpc() *daemon.RPC {
+	r := daemon.NewRPC()
+
+	r.Register("initialize", func(params json.RawMessage) (interface{}, error) {
+		return map[string]interface{}{
+			"serverInfo": map[string]string{"name": "gh-pmu", "version": version},
+			"project":    fmt.Sprintf("%s/%d", s.cfg.Project.Owner, s.cfg.Project.Number),
+			"methods":    r.Methods(),
+		}, nil
+	})
+	r.Register("shutdown", func(params json.RawMessage) (interface{}, error) {
+		return nil, nil
+	})
+	r.Register("status", func(params json.RawMessage) (interface{}, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.status(), nil
+	})
+
+	r.Register("items/list", func(params json.RawMessage) (interface{}, error) {
+		var p struct {
+			Query string `json:"query"`
+			Repo  string `json:"repo"`
+		}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, daemon.InvalidParams("invalid params: %v", err)
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		items, err := s.listItems(p.Query, p.Repo)
+		if err != nil {
+			return nil, err
+		}
+		return toDaemonItemsJSON(items), nil
+	})
+	r.Register("items/view", func(params json.RawMessage) (interface{}, error) {
+		key, err := s.issueParam(params)
+		if err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return s.view(key)
+	})
+	r.Register("items/move", func(params json.RawMessage) (interface{}, error) {
+		var req daemonMoveRequest
+		if err := json.Unmarshal(params, &req); err != nil {
+			return nil, daemon.InvalidParams("invalid params: %v", err)
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		results, err := s.move(req)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]interface{}{"results": results}, nil
+	})
+	r.Register("items/refresh", func(params json.RawMessage) (interface{}, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.cachedAt = time.Time{}
+		return map[string]bool{"refreshed": true}, nil
+	})
+
+	r.Register("focus/get", func(params json.RawMessage) (interface{}, error) {
+		return focus.Load(s.focusPath)
+	})
+	r.Register("focus/set", func(params json.RawMessage) (interface{}, error) {
+		key, err := s.issueParam(params)
+		if err != nil {
+			return nil, err
+		}
+		state, err := focus.Load(s.focusPath)
+		if err != nil {
+			return nil, err
+		}
+		state.Project = fmt.Sprintf("%s/%d", s.cfg.Project.Owner, s.cfg.Project.Number)
+		state.Set(key, s.now())
+		if err := state.Save(s.focusPath); err != nil {
+			return nil, err
+		}
+		return state, nil
+	})
+	r.Register("focus/clear", func(params json.RawMessage) (interface{}, error) {
+		cleared, err := focus.Clear(s.focusPath)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]bool{"cleared": cleared}, nil
+	})
+
+	return r
+}
+
+// issueParam reads {"issue": ref} and returns the issue as owner/repo#number
+func (s *daemonService) issueParam(params json.RawMessage) (string, error) {
+	var p serveIssueParams
+	if err := json.Unmarshal(params, &p); err != nil {
+		return "", daemon.InvalidParams("invalid params: %v", err)
+	}
+	if strings.TrimSpace(p.Issue) == "" {
+		return "", daemon.InvalidParams("issue is required")
+	}
+	key, err := issueKey(s.cfg, p.Issue)
+	if err != nil {
+		return "", daemon.InvalidParams("%v", err)
+	}
+	return key, nil
+}
+
+// view fetches an issue with its project field values from the cached
+// items. The caller holds s.mu.
+func (s *daemonService) view(key string) (*serveView, error) {
+	owner, repo, number, err := parseIssueReference(key)
+	if err != nil {
+		return nil, err
+	}
+	issue, err := s.client.GetIssue(owner, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	items, err := s.getItems()
+	if err != nil {
+		return nil, err
+	}
+	view := &serveView{Issue: toDaemonIssueJSON(issue), FieldValues: []daemonFieldValueJSON{}}
+	for _, item := range items {
+		if item.Issue != nil && item.Issue.Number == number &&
+			strings.EqualFold(item.Issue.Repository.Owner, owner) && strings.EqualFold(item.Issue.Repository.Name, repo) {
+			view.ItemID = item.ID
+			view.FieldValues = toDaemonFieldValuesJSON(item.FieldValues)
+			break
+		}
+	}
+	return view, nil
+}
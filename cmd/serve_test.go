@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// readFramedResponses splits Content-Length framed output into messages
+func readFramedResponses(t *testing.T, out string) []map[string]json.RawMessage {
+	t.Helper()
+	reader := bufio.NewReader(strings.NewReader(out))
+	var msgs []map[string]json.RawMessage
+	for {
+		header, err := textproto.NewReader(reader).ReadMIMEHeader()
+		if err == io.EOF {
+			return msgs
+		}
+		if err != nil {
+			t.Fatalf("Failed to read header in %q: %v", out, err)
+		}
+		length, _ := strconv.Atoi(header.Get("Content-Length"))
+		body := make([]byte, length)
+		if _, err := io.ReadFull(reader, body); err != nil {
+			t.Fatalf("Failed to read body: %v", err)
+		}
+		var msg map[string]json.RawMessage
+		if err := json.Unmarshal(body, &msg); err != nil {
+			t.Fatalf("Failed to parse %q: %v", body, err)
+		}
+		msgs = append(msgs, msg)
+	}
+}
+
+func TestRunServe_LSPLike(t *testing.T) {
+	focusPath := filepath.Join(t.TempDir(), "focus.json")
+	client := newDaemonTestClient()
+	cfg := newTestConfig()
+
+	var in strings.Builder
+	for i, req := range []string{
+		`"method":"initialize"`,
+		`"method":"items/list","params":{"repo":"owner/other"}`,
+		`"method":"items/view","params":{"issue":"#1"}`,
+		`"method":"focus/set","params":{"issue":"2"}`,
+		`"method":"focus/get"`,
+		`"method":"items/move","params":{"issues":["1"],"status":"Done"}`,
+		`"method":"items/view","params":{}`,
+		`"method":"focus/clear"`,
+	} {
+		msg := fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,%s}`, i+1, req)
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(msg), msg)
+	}
+
+	cmd, buf := newTestCmd()
+	cmd.SetIn(strings.NewReader(in.String()))
+	if err := runServeWithDeps(cmd, &serveOptions{lspLike: true, ttl: time.Minute}, cfg, client, focusPath); err != nil {
+		t.Fatalf("serve failed: %v", err)
+	}
+
+	msgs := readFramedResponses(t, buf.String())
+	if len(msgs) != 8 {
+		t.Fatalf("Expected 8 responses, got %d:\n%s", len(msgs), buf.String())
+	}
+
+	var init struct {
+		Project string   `json:"project"`
+		Methods []string `json:"methods"`
+	}
+	_ = json.Unmarshal(msgs[0]["result"], &init)
+	if init.Project != "owner/1" || !strings.Contains(strings.Join(init.Methods, ","), "items/move") {
+		t.Errorf("Unexpected initialize result %+v", init)
+	}
+
+	var items []daemonItemJSON
+	_ = json.Unmarshal(msgs[1]["result"], &items)
+	if len(items) != 1 || items[0].ID != "item-3" {
+		t.Errorf("Expected the other repository's item, got %v", items)
+	}
+
+	var view serveView
+	_ = json.Unmarshal(msgs[2]["result"], &view)
+	if view.Issue == nil || view.Issue.Body != "Details" || view.ItemID != "item-1" || len(view.FieldValues) != 1 {
+		t.Errorf("Unexpected view %+v", view)
+	}
+
+	var state struct {
+		Issue string `json:"issue"`
+	}
+	_ = json.Unmarshal(msgs[4]["result"], &state)
+	if state.Issue != "owner/repo#2" {
+		t.Errorf("Expected #2 focused, got %s", msgs[4]["result"])
+	}
+
+	if len(client.updates) != 1 || client.updates[0].ItemID != "item-1" || client.updates[0].Value != "Done" {
+		t.Errorf("Unexpected updates %v", client.updates)
+	}
+
+	var rpcErr struct {
+		Code int `json:"code"`
+	}
+	if err := json.Unmarshal(msgs[6]["error"], &rpcErr); err != nil || rpcErr.Code != -32602 {
+		t.Errorf("Expected invalid params without an issue, got %s", msgs[6]["error"])
+	}
+
+	if string(msgs[7]["result"]) != `{"cleared":true}` {
+		t.Errorf("Expected the focus cleared, got %s", msgs[7]["result"])
+	}
+}
+
+func TestDaemonService_RPC(t *testing.T) {
+	now := time.Now()
+	s := newDaemonTestService(newDaemonTestClient(), &now)
+
+	var resp struct {
+		Result []daemonItemJSON `json:"result"`
+	}
+	code := serveDaemonRequest(t, s, "POST", "/v1/rpc", `{"jsonrpc":"2.0","id":1,"method":"items/list","params":{"query":"status:in_progress"}}`, &resp)
+	if code != http.StatusOK || len(resp.Result) != 2 {
+		t.Errorf("Expected 2 in-progress items, got %d %+v", code, resp)
+	}
+}
@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/llm"
+	"github.com/scooter-indie/gh-pmu/internal/similar"
+	"github.com/spf13/cobra"
+)
+
+type similarOptions struct {
+	limit int
+	state string
+	json  bool
+}
+
+// similarClient defines the interface for API methods used by similar functions.
+// This allows for easier testing with mock implementations.
+type similarClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+}
+
+func newSimilarCommand() *cobra.Command {
+	opts := &similarOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "similar <issue | \"text\">",
+		Short: "Find existing issues semantically similar to an issue or text",
+		Long: `Find project issues related to an issue or a free-text description,
+to spot duplicates before filing or triaging.
+
+Results come from the local embedding index built by 'gh pmu sync', ranked
+by cosine similarity. When an issue reference is given, the issue itself
+is excluded from the results.
+
+Requires an 'ai' section in .gh-pmu.yml (see 'gh pmu sync').`,
+		Example: `  # Find issues similar to #42
+  gh pmu similar 42
+
+  # Check for duplicates before filing a new issue
+  gh pmu similar "login page crashes after password reset"
+
+  # Show the top 10 open matches as JSON
+  gh pmu similar 42 --limit 10 --state open --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSimilar(cmd, args, opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.limit, "limit", "n", 5, "Maximum number of results")
+	cmd.Flags().StringVar(&opts.state, "state", "", "Filter results by state: open, closed, or all (default: defaults.state, else all)")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+
+	return cmd
+}
+
+func runSimilar(cmd *cobra.Command, args []string, opts *similarOptions) error {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return err
+	}
+
+	embedder, err := llm.NewEmbedder(cfg.AI)
+	if err != nil {
+		return err
+	}
+
+	path, err := similar.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient()
+
+	return runSimilarWithDeps(cmd, args, opts, cfg, client, embedder, path)
+}
+
+// runSimilarWithDeps is the testable implementation of runSimilar
+func runSimilarWithDeps(cmd *cobra.Command, args []string, opts *similarOptions, cfg *config.Config, client similarClient, embedder llm.Embedder, indexPath string) error {
+	state, err := resolveStateFilter(opts.state, cfg)
+	if err != nil {
+		return err
+	}
+	if opts.limit < 1 {
+		return fmt.Errorf("--limit must be a positive number")
+	}
+
+	index, err := similar.Load(indexPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("no similar-issue index found\nRun 'gh pmu sync' to build it")
+	}
+	if err != nil {
+		return err
+	}
+	if index.Model != embedder.Model() {
+		return fmt.Errorf("index was built with model %q but %q is configured\nRun 'gh pmu sync --full' to rebuild it", index.Model, embedder.Model())
+	}
+
+	// Resolve the query: an issue reference, or free text
+	text := args[0]
+	selfKey := ""
+	if owner, repo, number, err := parseIssueReference(args[0]); err == nil {
+		if owner == "" || repo == "" {
+			if len(cfg.Repositories) == 0 {
+				return fmt.Errorf("no repository specified and none configured")
+			}
+			owner, repo = splitRepository(cfg.Repositories[0])
+		}
+
+		issue, err := client.GetIssue(owner, repo, number)
+		if err != nil {
+			return fmt.Errorf("failed to get issue: %w", err)
+		}
+		text = similar.Text(issue.Title, issue.Body)
+		selfKey = similar.Key(owner+"/"+repo, number)
+	}
+
+	vectors, err := embedder.Embed([]string{text})
+	if err != nil {
+		return fmt.Errorf("failed to embed query: %w", err)
+	}
+
+	matches := index.Search(vectors[0], opts.limit, func(e similar.Entry) bool {
+		if strings.EqualFold(e.Key(), selfKey) {
+			return true
+		}
+		return !matchesState(e.State, state)
+	})
+
+	if opts.json {
+		return outputSimilarJSON(cmd, matches)
+	}
+	return outputSimilarTable(cmd, matches)
+}
+
+// SimilarJSONOutput represents a match in the similar command's JSON output
+type SimilarJSONOutput struct {
+	Repository string  `json:"repository"`
+	Number     int     `json:"number"`
+	Title      string  `json:"title"`
+	State      string  `json:"state"`
+	URL        string  `json:"url"`
+	Score      float64 `json:"score"`
+}
+
+func outputSimilarJSON(cmd *cobra.Command, matches []similar.Match) error {
+	output := make([]SimilarJSONOutput, 0, len(matches))
+	for _, m := range matches {
+		output = append(output, SimilarJSONOutput{
+			Repository: m.Repository,
+			Number:     m.Number,
+			Title:      m.Title,
+			State:      m.State,
+			URL:        m.URL,
+			Score:      m.Score,
+		})
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
+
+func outputSimilarTable(cmd *cobra.Command, matches []similar.Match) error {
+	if len(matches) == 0 {
+		cmd.Println("No similar issues found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SCORE\tISSUE\tTITLE\tSTATE")
+	for _, m := range matches {
+		fmt.Fprintf(w, "%.2f\t%s#%d\t%s\t%s\n", m.Score, m.Repository, m.Number, m.Title, m.State)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/similar"
+	"github.com/spf13/cobra"
+)
+
+// writeSimilarTestIndex writes an index whose vectors match mockEmbedder's
+// output for texts of the given lengths
+func writeSimilarTestIndex(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "index.json")
+	index := &similar.Index{Model: "m1", Entries: []similar.Entry{
+		{Repository: "owner/repo", Number: 1, Title: "Login fails", State: "OPEN", Vector: []float32{11, 1}},
+		{Repository: "owner/repo", Number: 2, Title: "Sign-in broken", State: "CLOSED", Vector: []float32{12, 1}},
+		{Repository: "owner/repo", Number: 3, Title: "Unrelated", State: "OPEN", Vector: []float32{1, 20}},
+	}}
+	if err := index.Save(path); err != nil {
+		t.Fatalf("Failed to save index: %v", err)
+	}
+	return path
+}
+
+func runTestSimilar(t *testing.T, args []string, opts *similarOptions, client *mockSummarizeClient, embedder *mockEmbedder, path string) (string, error) {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+	err := runSimilarWithDeps(cmd, args, opts, newSummarizeTestConfig(), client, embedder, path)
+	return buf.String(), err
+}
+
+func TestSimilarCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"similar"})
+	if err != nil || sub.Name() != "similar" {
+		t.Fatalf("similar command not found: %v", err)
+	}
+	for _, flag := range []string{"limit", "state", "json"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag to exist", flag)
+		}
+	}
+}
+
+func TestRunSimilar_IssueExcludesItself(t *testing.T) {
+	path := writeSimilarTestIndex(t)
+	client := &mockSummarizeClient{issue: &api.Issue{Number: 1, Title: "Login fails"}}
+	embedder := &mockEmbedder{model: "m1"}
+
+	out, err := runTestSimilar(t, []string{"1"}, &similarOptions{limit: 5, state: "all"}, client, embedder, path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if strings.Contains(out, "owner/repo#1\t") || strings.Contains(out, "owner/repo#1 ") {
+		t.Errorf("Expected the issue itself to be excluded, got:\n%s", out)
+	}
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	if len(lines) != 3 || !strings.Contains(lines[1], "owner/repo#2") {
+		t.Errorf("Expected #2 as the best match, got:\n%s", out)
+	}
+	if embedder.inputs[0] != "Login fails" {
+		t.Errorf("Expected issue text to be embedded, got %q", embedder.inputs)
+	}
+}
+
+func TestRunSimilar_TextQueryJSON(t *testing.T) {
+	path := writeSimilarTestIndex(t)
+	embedder := &mockEmbedder{model: "m1"}
+
+	out, err := runTestSimilar(t, []string{"login broken"}, &similarOptions{limit: 1, state: "open", json: true}, &mockSummarizeClient{}, embedder, path)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var results []SimilarJSONOutput
+	if err := json.Unmarshal([]byte(out), &results); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	if len(results) != 1 || results[0].Number != 1 || results[0].Score <= 0.9 {
+		t.Errorf("Expected open #1 as the only match, got %+v", results)
+	}
+	if embedder.inputs[0] != "login broken" {
+		t.Errorf("Expected free text to be embedded, got %q", embedder.inputs)
+	}
+}
+
+func TestRunSimilar_Errors(t *testing.T) {
+	path := writeSimilarTestIndex(t)
+
+	tests := []struct {
+		name    string
+		opts    *similarOptions
+		model   string
+		path    string
+		wantErr string
+	}{
+		{"invalid state", &similarOptions{limit: 5, state: "merged"}, "m1", path, "invalid --state"},
+		{"invalid limit", &similarOptions{limit: 0, state: "all"}, "m1", path, "--limit must be a positive number"},
+		{"missing index", &similarOptions{limit: 5, state: "all"}, "m1", filepath.Join(t.TempDir(), "none.json"), "gh pmu sync"},
+		{"model mismatch", &similarOptions{limit: 5, state: "all"}, "m2", path, "sync --full"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runTestSimilar(t, []string{"text"}, tt.opts, &mockSummarizeClient{}, &mockEmbedder{model: tt.model}, tt.path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
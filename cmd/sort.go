@@ -0,0 +1,452 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+type sortSaveOptions struct {
+	view   string
+	by     string
+	dryRun bool
+	yes    bool
+}
+
+// sortClient defines the interface for API methods used by sort save.
+// This allows for easier testing with mock implementations.
+type sortClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectFields(projectID string) ([]api.ProjectField, error)
+	GetProjectViews(projectID string) ([]api.ProjectView, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	UpdateProjectItemPosition(projectID, itemID, afterID string) error
+}
+
+// itemSortKey is one key of a --by spec. Field is the project field to sort
+// by, or empty for a built-in column.
+type itemSortKey struct {
+	Name       string // As written in --by
+	Column     string // Built-in column: number, title, state or repository
+	Field      *api.ProjectField
+	Descending bool
+}
+
+// sortBuiltinColumns are the --by keys that are issue properties rather
+// than project fields
+var sortBuiltinColumns = map[string]string{
+	"number":     "number",
+	"title":      "title",
+	"state":      "state",
+	"repository": "repository",
+	"repo":       "repository",
+}
+
+func newSortCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sort",
+		Short: "Manage the saved order of project items",
+		Long: `Manage the order of items in the project.
+
+Project items have a manual position, which is the order shown by web
+views that have no sort of their own. 'sort save' writes an ordering
+computed from field values, so a ranking made with the CLI is visible
+to people who only use the web UI.`,
+	}
+
+	cmd.AddCommand(newSortSaveCommand())
+
+	return cmd
+}
+
+func newSortSaveCommand() *cobra.Command {
+	opts := &sortSaveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "save",
+		Short: "Write item positions sorted by field values",
+		Long: `Sort the project's items by one or more keys and write the result as
+their manual positions.
+
+--by is a comma-separated list of keys, each optionally followed by asc
+(the default) or desc. A key is a field alias from .gh-pmu.yml, a project
+field name, or one of number, title, state and repository. Single-select
+fields sort in the order of their options, iterations by start date,
+number fields numerically, and other fields alphabetically. Items without
+a value sort last; ties keep their current order.
+
+Only items that are out of place are moved. Positions are shared by all
+views of the project; --view checks that the view exists and warns if it
+has a sort of its own, which hides manual positions.`,
+		Example: `  gh pmu sort save --view "Priority board" --by "priority desc, estimate asc"
+  gh pmu sort save --by "status, number" --dry-run`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runSortSaveWithDeps(cmd, opts, cfg, api.NewClient())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.by, "by", "", "Sort keys, as \"key [asc|desc], ...\"")
+	cmd.Flags().StringVar(&opts.view, "view", "", "Project view the order is for")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show the order without writing it")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt")
+	_ = cmd.MarkFlagRequired("by")
+
+	return cmd
+}
+
+// runSortSaveWithDeps is the testable implementation of sort save
+func runSortSaveWithDeps(cmd *cobra.Command, opts *sortSaveOptions, cfg *config.Config, client sortClient) error {
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	fields, err := client.GetProjectFields(project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get project fields: %w", err)
+	}
+	keys, err := parseSortKeys(opts.by, cfg, fields)
+	if err != nil {
+		return err
+	}
+
+	if opts.view != "" {
+		view, err := findProjectView(client, project.ID, opts.view)
+		if err != nil {
+			return err
+		}
+		if len(view.SortBy) > 0 {
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: view %q sorts by %s, which hides manual positions; remove its sort to see this order\n",
+				view.Name, strings.Join(view.SortBy, ", "))
+		}
+	}
+
+	all, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+	var items []api.ProjectItem
+	for _, item := range all {
+		if !item.IsArchived {
+			items = append(items, item)
+		}
+	}
+	if len(items) == 0 {
+		cmd.Println("No items in the project")
+		return nil
+	}
+
+	sorted := make([]api.ProjectItem, len(items))
+	copy(sorted, items)
+	sortProjectItems(sorted, keys)
+	moves := positionMoves(items, sorted)
+
+	printSortOrder(cmd, sorted, keys, moves)
+
+	if len(moves) == 0 {
+		cmd.Printf("\nAll %d items are already in order\n", len(items))
+		return nil
+	}
+	if opts.dryRun {
+		cmd.Printf("\nWould move %d of %d items\n", len(moves), len(items))
+		return nil
+	}
+
+	if !opts.yes {
+		cmd.Printf("\nMove %d of %d items? [y/N]: ", len(moves), len(items))
+		var response string
+		_, _ = fmt.Fscanln(cmd.InOrStdin(), &response)
+		response = strings.ToLower(strings.TrimSpace(response))
+		if response != "y" && response != "yes" {
+			cmd.Println("Aborted.")
+			return nil
+		}
+	}
+
+	for i, move := range moves {
+		if err := client.UpdateProjectItemPosition(project.ID, move.ItemID, move.AfterID); err != nil {
+			return fmt.Errorf("failed to move %s after %d of %d moves: %w", sortItemLabel(sorted[move.Index]), i, len(moves), err)
+		}
+	}
+
+	cmd.Printf("\nMoved %d of %d items\n", len(moves), len(items))
+	return nil
+}
+
+// parseSortKeys parses a --by spec such as "priority desc, estimate asc"
+func parseSortKeys(spec string, cfg *config.Config, fields []api.ProjectField) ([]itemSortKey, error) {
+	var keys []itemSortKey
+	for _, part := range strings.Split(spec, ",") {
+		words := strings.Fields(part)
+		if len(words) == 0 {
+			continue
+		}
+
+		key := itemSortKey{}
+		switch strings.ToLower(words[len(words)-1]) {
+		case "desc":
+			key.Descending = true
+			words = words[:len(words)-1]
+		case "asc":
+			words = words[:len(words)-1]
+		}
+		if len(words) == 0 {
+			return nil, fmt.Errorf("sort key %q has no field", strings.TrimSpace(part))
+		}
+		key.Name = strings.Join(words, " ")
+
+		if column, ok := sortBuiltinColumns[strings.ToLower(key.Name)]; ok {
+			key.Column = column
+		} else {
+			name := cfg.GetFieldName(key.Name)
+			for i := range fields {
+				if strings.EqualFold(fields[i].Name, name) {
+					key.Field = &fields[i]
+					break
+				}
+			}
+			if key.Field == nil {
+				return nil, fmt.Errorf("unknown sort key %q: not a project field or one of number, title, state, repository", key.Name)
+			}
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("--by needs at least one sort key")
+	}
+	return keys, nil
+}
+
+// findProjectView returns the project view with the given name
+func findProjectView(client sortClient, projectID, name string) (*api.ProjectView, error) {
+	views, err := client.GetProjectViews(projectID)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for i := range views {
+		if strings.EqualFold(views[i].Name, name) {
+			return &views[i], nil
+		}
+		names = append(names, fmt.Sprintf("%q", views[i].Name))
+	}
+	return nil, fmt.Errorf("view %q not found; the project has %s", name, strings.Join(names, ", "))
+}
+
+// sortProjectItems sorts items in place by keys. Items missing a value for
+// a key sort after those that have one, whatever the direction.
+func sortProjectItems(items []api.ProjectItem, keys []itemSortKey) {
+	sort.SliceStable(items, func(i, j int) bool {
+		for _, key := range keys {
+			if c := compareSortKey(items[i], items[j], key); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+}
+
+// compareSortKey compares two items by one key, returning -1, 0 or 1
+func compareSortKey(a, b api.ProjectItem, key itemSortKey) int {
+	va, oka := sortKeyValue(a, key)
+	vb, okb := sortKeyValue(b, key)
+	if !oka || !okb {
+		switch {
+		case oka:
+			return -1
+		case okb:
+			return 1
+		}
+		return 0
+	}
+
+	var c int
+	switch {
+	case key.Column == "number" || (key.Field != nil && key.Field.DataType == "NUMBER"):
+		fa, _ := strconv.ParseFloat(va, 64)
+		fb, _ := strconv.ParseFloat(vb, 64)
+		switch {
+		case fa < fb:
+			c = -1
+		case fa > fb:
+			c = 1
+		}
+	case key.Field != nil && len(key.Field.Options) > 0:
+		c = sortRank(fieldOptionNames(key.Field), va) - sortRank(fieldOptionNames(key.Field), vb)
+	case key.Field != nil && len(key.Field.Iterations) > 0:
+		c = strings.Compare(iterationStart(key.Field, va), iterationStart(key.Field, vb))
+	default:
+		c = strings.Compare(strings.ToLower(va), strings.ToLower(vb))
+	}
+
+	if key.Descending {
+		return -c
+	}
+	return c
+}
+
+// sortKeyValue returns an item's value for a key, and whether it has one
+func sortKeyValue(item api.ProjectItem, key itemSortKey) (string, bool) {
+	if key.Field != nil {
+		value := getFieldValue(item, key.Field.Name)
+		return value, value != ""
+	}
+	if item.Issue == nil {
+		return "", false
+	}
+	switch key.Column {
+	case "number":
+		return strconv.Itoa(item.Issue.Number), true
+	case "title":
+		return item.Issue.Title, true
+	case "state":
+		return item.Issue.State, item.Issue.State != ""
+	default:
+		repo := item.Issue.Repository
+		return repo.Owner + "/" + repo.Name, repo.Name != ""
+	}
+}
+
+// fieldOptionNames returns a single-select field's option names in order
+func fieldOptionNames(field *api.ProjectField) []string {
+	names := make([]string, len(field.Options))
+	for i, opt := range field.Options {
+		names[i] = opt.Name
+	}
+	return names
+}
+
+// sortRank returns the index of value in order, or len(order) if absent
+func sortRank(order []string, value string) int {
+	for i, v := range order {
+		if strings.EqualFold(v, value) {
+			return i
+		}
+	}
+	return len(order)
+}
+
+// iterationStart returns the start date of the named iteration, or the
+// name itself for an iteration the field no longer lists
+func iterationStart(field *api.ProjectField, title string) string {
+	for _, it := range field.Iterations {
+		if strings.EqualFold(it.Title, title) {
+			return it.StartDate
+		}
+	}
+	return title
+}
+
+// positionMove moves the item at Index of the sorted order to directly
+// after AfterID, or to the top when AfterID is empty
+type positionMove struct {
+	Index   int
+	ItemID  string
+	AfterID string
+}
+
+// positionMoves returns the moves that turn the current order into the
+// sorted one. The longest run of items already in sorted order stays put;
+// every other item is moved after its predecessor in the sorted order.
+func positionMoves(current, sorted []api.ProjectItem) []positionMove {
+	position := make(map[string]int, len(current))
+	for i, item := range current {
+		position[item.ID] = i
+	}
+
+	// Longest increasing subsequence of current positions, in sorted order
+	var tails []int // Index into sorted of the smallest tail of each length
+	prev := make([]int, len(sorted))
+	for i, item := range sorted {
+		p := position[item.ID]
+		n := sort.Search(len(tails), func(k int) bool { return position[sorted[tails[k]].ID] >= p })
+		prev[i] = -1
+		if n > 0 {
+			prev[i] = tails[n-1]
+		}
+		if n == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[n] = i
+		}
+	}
+	keep := make(map[int]bool, len(tails))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			keep[i] = true
+		}
+	}
+
+	var moves []positionMove
+	for i, item := range sorted {
+		if keep[i] {
+			continue
+		}
+		move := positionMove{Index: i, ItemID: item.ID}
+		if i > 0 {
+			move.AfterID = sorted[i-1].ID
+		}
+		moves = append(moves, move)
+	}
+	return moves
+}
+
+// printSortOrder prints the sorted items with their key values, marking
+// the ones that move
+func printSortOrder(cmd *cobra.Command, sorted []api.ProjectItem, keys []itemSortKey, moves []positionMove) {
+	moved := make(map[int]bool, len(moves))
+	for _, m := range moves {
+		moved[m.Index] = true
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	header := []string{"", "ITEM"}
+	for _, key := range keys {
+		header = append(header, strings.ToUpper(key.Name))
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+
+	for i, item := range sorted {
+		mark := ""
+		if moved[i] {
+			mark = "*"
+		}
+		row := []string{mark, sortItemLabel(item)}
+		for _, key := range keys {
+			value, ok := sortKeyValue(item, key)
+			if !ok {
+				value = "-"
+			}
+			row = append(row, value)
+		}
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	_ = w.Flush()
+}
+
+// sortItemLabel names an item for output
+func sortItemLabel(item api.ProjectItem) string {
+	if item.Issue == nil {
+		return item.ID
+	}
+	title := item.Issue.Title
+	if len(title) > 50 {
+		title = title[:47] + "..."
+	}
+	if item.Issue.Number == 0 {
+		return title
+	}
+	return fmt.Sprintf("#%d %s", item.Issue.Number, title)
+}
@@ -0,0 +1,257 @@
+package cmd
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// mockSortClient implements sortClient interface for testing
+type mockSortClient struct {
+	items   []api.ProjectItem
+	views   []api.ProjectView
+	moveErr error
+	moves   []string
+}
+
+func (m *mockSortClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1", Number: number}, nil
+}
+
+func (m *mockSortClient) GetProjectFields(projectID string) ([]api.ProjectField, error) {
+	return []api.ProjectField{
+		{Name: "Priority", DataType: "SINGLE_SELECT", Options: []api.FieldOption{{Name: "P0"}, {Name: "P1"}, {Name: "P2"}}},
+		{Name: "Estimate", DataType: "NUMBER"},
+	}, nil
+}
+
+func (m *mockSortClient) GetProjectViews(projectID string) ([]api.ProjectView, error) {
+	return m.views, nil
+}
+
+func (m *mockSortClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func (m *mockSortClient) UpdateProjectItemPosition(projectID, itemID, afterID string) error {
+	if m.moveErr != nil {
+		return m.moveErr
+	}
+	m.moves = append(m.moves, itemID+">"+afterID)
+	return nil
+}
+
+func newSortTestItem(number int, priority, estimate string) api.ProjectItem {
+	item := api.ProjectItem{
+		ID:    "item-" + strings.Repeat("x", number),
+		Issue: &api.Issue{Number: number, Title: "Issue"},
+	}
+	if priority != "" {
+		item.FieldValues = append(item.FieldValues, api.FieldValue{Field: "Priority", Value: priority})
+	}
+	if estimate != "" {
+		item.FieldValues = append(item.FieldValues, api.FieldValue{Field: "Estimate", Value: estimate})
+	}
+	return item
+}
+
+func sortedNumbers(items []api.ProjectItem) []int {
+	var numbers []int
+	for _, item := range items {
+		numbers = append(numbers, item.Issue.Number)
+	}
+	return numbers
+}
+
+func TestParseSortKeys(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{"priority": {Field: "Priority"}}
+	fields, _ := (&mockSortClient{}).GetProjectFields("proj-1")
+
+	keys, err := parseSortKeys("priority desc, Estimate asc,number", cfg, fields)
+	if err != nil {
+		t.Fatalf("parseSortKeys() error = %v", err)
+	}
+	if len(keys) != 3 {
+		t.Fatalf("Expected 3 keys, got %+v", keys)
+	}
+	if keys[0].Field == nil || keys[0].Field.Name != "Priority" || !keys[0].Descending {
+		t.Errorf("Unexpected first key: %+v", keys[0])
+	}
+	if keys[1].Field == nil || keys[1].Field.Name != "Estimate" || keys[1].Descending {
+		t.Errorf("Unexpected second key: %+v", keys[1])
+	}
+	if keys[2].Column != "number" || keys[2].Field != nil {
+		t.Errorf("Unexpected third key: %+v", keys[2])
+	}
+
+	for spec, want := range map[string]string{
+		"size desc": `unknown sort key "size"`,
+		"desc":      `sort key "desc" has no field`,
+		" , ":       "at least one sort key",
+	} {
+		if _, err := parseSortKeys(spec, cfg, fields); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("parseSortKeys(%q) error = %v, want %q", spec, err, want)
+		}
+	}
+}
+
+func TestSortProjectItems(t *testing.T) {
+	fields, _ := (&mockSortClient{}).GetProjectFields("proj-1")
+	keys, _ := parseSortKeys("priority, estimate desc", newTestConfig(), fields)
+
+	items := []api.ProjectItem{
+		newSortTestItem(1, "P2", "3"),
+		newSortTestItem(2, "", "8"),
+		newSortTestItem(3, "P0", "2"),
+		newSortTestItem(4, "P0", "10"),
+		newSortTestItem(5, "P0", ""),
+		newSortTestItem(6, "P1", "1"),
+	}
+	sortProjectItems(items, keys)
+
+	// Options in project order, numbers numerically, missing values last
+	want := []int{4, 3, 5, 6, 1, 2}
+	if got := sortedNumbers(items); !slices.Equal(got, want) {
+		t.Errorf("sortProjectItems() = %v, want %v", got, want)
+	}
+}
+
+func TestPositionMoves(t *testing.T) {
+	items := func(numbers ...int) []api.ProjectItem {
+		var out []api.ProjectItem
+		for _, n := range numbers {
+			out = append(out, newSortTestItem(n, "", ""))
+		}
+		return out
+	}
+
+	if moves := positionMoves(items(1, 2, 3), items(1, 2, 3)); len(moves) != 0 {
+		t.Errorf("Expected no moves for a sorted order, got %+v", moves)
+	}
+
+	// Only 4 is out of place; it moves to the top
+	moves := positionMoves(items(1, 2, 3, 4), items(4, 1, 2, 3))
+	if len(moves) != 1 || moves[0].ItemID != "item-xxxx" || moves[0].AfterID != "" {
+		t.Errorf("Expected 4 moved to the top, got %+v", moves)
+	}
+
+	// Reversing three items keeps one and moves two
+	moves = positionMoves(items(1, 2, 3), items(3, 2, 1))
+	if len(moves) != 2 {
+		t.Fatalf("Expected 2 moves, got %+v", moves)
+	}
+	current := items(1, 2, 3)
+	for _, m := range moves {
+		current = applyPositionMove(current, m)
+	}
+	if got := sortedNumbers(current); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Errorf("Applying the moves gave %v, want [3 2 1]", got)
+	}
+}
+
+// applyPositionMove simulates UpdateProjectItemPosition on an order
+func applyPositionMove(order []api.ProjectItem, m positionMove) []api.ProjectItem {
+	var moved api.ProjectItem
+	var rest []api.ProjectItem
+	for _, item := range order {
+		if item.ID == m.ItemID {
+			moved = item
+			continue
+		}
+		rest = append(rest, item)
+	}
+	if m.AfterID == "" {
+		return append([]api.ProjectItem{moved}, rest...)
+	}
+	var out []api.ProjectItem
+	for _, item := range rest {
+		out = append(out, item)
+		if item.ID == m.AfterID {
+			out = append(out, moved)
+		}
+	}
+	return out
+}
+
+func TestRunSortSave(t *testing.T) {
+	newClient := func() *mockSortClient {
+		archived := newSortTestItem(9, "P0", "")
+		archived.IsArchived = true
+		return &mockSortClient{
+			items: []api.ProjectItem{newSortTestItem(1, "P2", ""), newSortTestItem(2, "P0", ""), archived},
+			views: []api.ProjectView{{Name: "Priority board"}, {Name: "Backlog", SortBy: []string{"Priority DESC"}}},
+		}
+	}
+
+	t.Run("dry run", func(t *testing.T) {
+		client := newClient()
+		cmd, buf := newTestCmd()
+		err := runSortSaveWithDeps(cmd, &sortSaveOptions{by: "priority", dryRun: true}, newTestConfig(), client)
+		if err != nil {
+			t.Fatalf("runSortSaveWithDeps() error = %v", err)
+		}
+		if len(client.moves) != 0 || !strings.Contains(buf.String(), "Would move 1 of 2 items") {
+			t.Errorf("Unexpected dry run: moves %v, output:\n%s", client.moves, buf.String())
+		}
+	})
+
+	t.Run("writes positions", func(t *testing.T) {
+		client := newClient()
+		cmd, buf := newTestCmd()
+		err := runSortSaveWithDeps(cmd, &sortSaveOptions{by: "priority", view: "priority board", yes: true}, newTestConfig(), client)
+		if err != nil {
+			t.Fatalf("runSortSaveWithDeps() error = %v", err)
+		}
+		if strings.Join(client.moves, "|") != "item-xx>" || !strings.Contains(buf.String(), "Moved 1 of 2 items") {
+			t.Errorf("Unexpected moves %v, output:\n%s", client.moves, buf.String())
+		}
+	})
+
+	t.Run("asks before moving", func(t *testing.T) {
+		client := newClient()
+		cmd, buf := newTestCmd()
+		cmd.SetIn(strings.NewReader("n\n"))
+		if err := runSortSaveWithDeps(cmd, &sortSaveOptions{by: "priority"}, newTestConfig(), client); err != nil {
+			t.Fatalf("runSortSaveWithDeps() error = %v", err)
+		}
+		if len(client.moves) != 0 || !strings.Contains(buf.String(), "Aborted.") {
+			t.Errorf("Expected the moves declined, got %v", client.moves)
+		}
+	})
+
+	t.Run("warns about a sorted view", func(t *testing.T) {
+		client := newClient()
+		cmd, _ := newTestCmd()
+		var stderr strings.Builder
+		cmd.SetErr(&stderr)
+		if err := runSortSaveWithDeps(cmd, &sortSaveOptions{by: "priority", view: "Backlog", dryRun: true}, newTestConfig(), client); err != nil {
+			t.Fatalf("runSortSaveWithDeps() error = %v", err)
+		}
+		if !strings.Contains(stderr.String(), `view "Backlog" sorts by Priority DESC`) {
+			t.Errorf("Expected a sorted view warning, got %q", stderr.String())
+		}
+	})
+
+	t.Run("unknown view", func(t *testing.T) {
+		cmd, _ := newTestCmd()
+		err := runSortSaveWithDeps(cmd, &sortSaveOptions{by: "priority", view: "Roadmap"}, newTestConfig(), newClient())
+		if err == nil || !strings.Contains(err.Error(), `view "Roadmap" not found`) {
+			t.Errorf("Expected a view not found error, got %v", err)
+		}
+	})
+
+	t.Run("move failure", func(t *testing.T) {
+		client := newClient()
+		client.moveErr = errors.New("forbidden")
+		cmd, _ := newTestCmd()
+		err := runSortSaveWithDeps(cmd, &sortSaveOptions{by: "priority", yes: true}, newTestConfig(), client)
+		if err == nil || !strings.Contains(err.Error(), "failed to move #2 Issue after 0 of 1 moves") {
+			t.Errorf("Expected a move error, got %v", err)
+		}
+	})
+}
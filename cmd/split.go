@@ -1,22 +1,42 @@
 package cmd
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
 	"os"
+	"os/exec"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
 	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/llm"
+	"github.com/scooter-indie/gh-pmu/internal/splitstate"
 	"github.com/spf13/cobra"
 )
 
 type splitOptions struct {
-	from   string
-	dryRun bool
-	json   bool
+	from        string
+	fromFile    string
+	dryRun      bool
+	json        bool
+	suggest     bool
+	interactive bool
+	byHeading   bool
+
+	distributeEstimate bool
+	parentEstimate     string
+
+	inherit   bool
+	noInherit bool
+
+	linkBody string
+	resume   bool
+	repo     string
 }
 
 func newSplitCommand() *cobra.Command {
@@ -29,22 +49,100 @@ func newSplitCommand() *cobra.Command {
 
 The checklist can come from:
 - The issue body (--from=body)
-- An external file (--from=path/to/file.md)
+- A local plan file (--from-file=tasks.md, or --from=tasks.md)
 - Command line arguments (gh pmu split 123 "Task 1" "Task 2")
 
 Only unchecked items (- [ ]) are converted to sub-issues.
-Completed items (- [x]) are skipped.`,
+Completed items (- [x]) are skipped.
+
+With --suggest, the AI backend configured in the 'ai' section of
+.gh-pmu.yml proposes a breakdown from the issue body. The suggestions are
+shown for review and can be edited in $EDITOR; nothing is created until
+you confirm. With --json or --dry-run the suggestions are only printed.
+
+With --interactive, the tasks from any source are listed with checkboxes:
+toggle them by number or range, check all or none, and edit titles
+before confirming. Only the checked tasks become sub-issues.
+
+With --by-heading, the body (or the plan file) is split at its
+headings instead: each section heading, at the shallowest level used,
+becomes a sub-issue title and the section's content its body. Text
+before the first heading is skipped.
+
+With --distribute-estimate, the parent's estimate is shared evenly across
+the new sub-issues, less any estimates set by annotation. Whole estimates
+are split into whole shares. --parent-estimate then keeps the parent's
+estimate, zeroes it, or turns it into a rollup of its sub-issues'
+estimates.
+
+With --inherit, the sub-issues copy the parent's status, priority,
+iteration, labels, and assignees. Configure what is copied with
+defaults.split_inherit in .gh-pmu.yml (labels, milestone, assignees, or
+project fields by alias or name), which also makes inheriting the
+default; --no-inherit then turns it off. Annotations on a task take
+precedence over what it inherits.
+
+With --link-body, the parent's body is rewritten to link the new
+sub-issues as "- [ ] #123 Task" items, which GitHub renders with each
+sub-issue's live state. The unchecked item a task came from is rewritten
+in place; tasks without one are listed in a "Sub-issues" section between
+` + subIssuesStartMarker + ` and ` + subIssuesEndMarker + ` markers. With
+--link-body=section, every sub-issue goes in the section and the
+checklist is left as it is.
+
+With --repo, the sub-issues are created in another repository configured
+in .gh-pmu.yml, for example tasks in the code repository under an epic
+in a planning repository. They are still linked as sub-issues, and are
+always added to the project. Labels and milestones are matched by name
+in that repository, and skipped where it has none.
+
+Progress is saved as each sub-issue is created and linked. If some fail,
+for example on rate limits or a flaky network, the split reports them
+and fails; run it again with --resume to create only the ones that are
+missing, with the fields, labels, and options the split started with,
+and to link those created but not linked.
+
+Tasks may carry inline annotations, which are removed from the title and
+applied to the sub-issue:
+- [label:name] adds a label (comma-separate several)
+- [assignee:@login] assigns a user (@me for yourself)
+- [key:value] sets a project field, by config alias or field name,
+  e.g. [estimate:3] or [priority:p1]`,
 		Example: `  # Split from issue body checklist
   gh pmu split 123 --from=body
 
-  # Split from external file
-  gh pmu split 123 --from=tasks.md
+  # Split from a plan written offline
+  gh pmu split 123 --from-file=tasks.md
 
   # Split from command line arguments
   gh pmu split 123 "Implement feature A" "Implement feature B" "Write tests"
 
   # Preview without creating
-  gh pmu split 123 --from=body --dry-run`,
+  gh pmu split 123 --from=body --dry-run
+
+  # Ask the AI backend for a breakdown, then review and confirm
+  gh pmu split 123 --suggest
+
+  # One sub-issue per "## Section" of the body, with the section as its body
+  gh pmu split 123 --by-heading
+
+  # Pick which checklist items become sub-issues, editing titles first
+  gh pmu split 123 --from=body --interactive
+
+  # Set fields, labels, and assignees per task
+  gh pmu split 123 "Build API client [estimate:3] [label:backend] [assignee:@alice]"
+
+  # Copy the parent's status, priority, iteration, labels, and assignees
+  gh pmu split 123 --from=body --inherit
+
+  # Replace the body's "- [ ] Task" items with links to the sub-issues
+  gh pmu split 123 --from=body --link-body
+
+  # Create the sub-issues in the code repository
+  gh pmu split 123 --from=body --repo acme/api
+
+  # Retry the sub-issues a split failed to create
+  gh pmu split 123 --resume`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runSplit(cmd, args, opts)
@@ -52,8 +150,20 @@ Completed items (- [x]) are skipped.`,
 	}
 
 	cmd.Flags().StringVar(&opts.from, "from", "", "Source for tasks: 'body' (issue body) or file path")
+	cmd.Flags().StringVar(&opts.fromFile, "from-file", "", "Read the checklist or headings from a local file")
 	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show what would be created without making changes")
 	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+	cmd.Flags().BoolVar(&opts.suggest, "suggest", false, "Propose sub-tasks with the configured AI backend for review")
+	cmd.Flags().BoolVarP(&opts.interactive, "interactive", "i", false, "Choose which tasks to create and edit their titles first")
+	cmd.Flags().BoolVar(&opts.byHeading, "by-heading", false, "Create a sub-issue per heading section of the body or plan file")
+	cmd.Flags().BoolVar(&opts.distributeEstimate, "distribute-estimate", false, "Share the parent's estimate across the new sub-issues")
+	cmd.Flags().StringVar(&opts.parentEstimate, "parent-estimate", "keep", "After distributing: keep, zero, or rollup the parent's estimate")
+	cmd.Flags().BoolVar(&opts.inherit, "inherit", false, "Copy the parent's fields, labels, and assignees to the sub-issues")
+	cmd.Flags().BoolVar(&opts.noInherit, "no-inherit", false, "Inherit nothing, even with defaults.split_inherit configured")
+	cmd.Flags().StringVar(&opts.linkBody, "link-body", "", "Link the sub-issues in the parent's body: checklist or section")
+	cmd.Flags().Lookup("link-body").NoOptDefVal = "checklist"
+	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Create the sub-issues in another configured repository (owner/repo)")
+	cmd.Flags().BoolVar(&opts.resume, "resume", false, "Create the sub-issues an earlier split of the issue failed to create")
 
 	return cmd
 }
@@ -65,6 +175,53 @@ func runSplit(cmd *cobra.Command, args []string, opts *splitOptions) error {
 		return fmt.Errorf("invalid issue number: %s", args[0])
 	}
 
+	if opts.resume {
+		if err := checkSplitResume(cmd, args); err != nil {
+			return err
+		}
+	}
+
+	if opts.interactive && opts.json {
+		return fmt.Errorf("--interactive cannot be used with --json")
+	}
+
+	if opts.byHeading && (opts.suggest || len(args) > 1) {
+		return fmt.Errorf("--by-heading cannot be combined with --suggest or task arguments")
+	}
+
+	if opts.parentEstimate == "" {
+		opts.parentEstimate = "keep"
+	}
+	if !strings.EqualFold(opts.parentEstimate, "keep") && !opts.distributeEstimate {
+		return fmt.Errorf("--parent-estimate requires --distribute-estimate")
+	}
+	opts.parentEstimate = strings.ToLower(opts.parentEstimate)
+	if !containsFold(splitParentEstimateModes, opts.parentEstimate) {
+		return fmt.Errorf("invalid --parent-estimate %q: use %s", opts.parentEstimate, strings.Join(splitParentEstimateModes, ", "))
+	}
+
+	if opts.linkBody != "" {
+		opts.linkBody = strings.ToLower(opts.linkBody)
+		if !containsFold(splitLinkModes, opts.linkBody) {
+			return fmt.Errorf("invalid --link-body %q: use %s", opts.linkBody, strings.Join(splitLinkModes, ", "))
+		}
+	}
+
+	if opts.fromFile != "" && (opts.from != "" || len(args) > 1) {
+		return fmt.Errorf("--from-file cannot be combined with --from or task arguments")
+	}
+
+	// Read a plan file before any API calls so a bad path fails fast
+	planFile := splitPlanFile(opts)
+	var planText string
+	if planFile != "" {
+		content, err := os.ReadFile(planFile)
+		if err != nil {
+			return fmt.Errorf("failed to read file %s: %w", planFile, err)
+		}
+		planText = string(content)
+	}
+
 	// Load configuration
 	cwd, err := os.Getwd()
 	if err != nil {
@@ -84,6 +241,23 @@ func runSplit(cmd *cobra.Command, args []string, opts *splitOptions) error {
 		return fmt.Errorf("no repositories configured in .gh-pmu.yml")
 	}
 
+	inheritKeys, err := splitInheritKeys(opts, cfg)
+	if err != nil {
+		return err
+	}
+
+	// Resolve the AI backend before any API calls so misconfiguration fails fast
+	var provider llm.Provider
+	if opts.suggest {
+		if opts.from != "" || opts.fromFile != "" || len(args) > 1 {
+			return fmt.Errorf("--suggest cannot be combined with --from, --from-file, or task arguments")
+		}
+		provider, err = llm.New(cfg.AI)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Parse repository
 	repoParts := strings.SplitN(cfg.Repositories[0], "/", 2)
 	if len(repoParts) != 2 {
@@ -91,6 +265,11 @@ func runSplit(cmd *cobra.Command, args []string, opts *splitOptions) error {
 	}
 	owner, repo := repoParts[0], repoParts[1]
 
+	target, err := splitTargetRepo(cfg, opts.repo, owner, repo)
+	if err != nil {
+		return err
+	}
+
 	// Create API client
 	client := api.NewClient()
 
@@ -100,26 +279,48 @@ func runSplit(cmd *cobra.Command, args []string, opts *splitOptions) error {
 		return fmt.Errorf("failed to get issue #%d: %w", issueNum, err)
 	}
 
-	// Determine tasks to create
-	var tasks []string
+	statePath, err := splitstate.DefaultPath(owner, repo, issueNum)
+	if err != nil {
+		return err
+	}
+	if opts.resume {
+		return resumeSplit(cmd, opts, cfg, client, parentIssue, owner, repo, statePath)
+	}
+	if !opts.dryRun {
+		// Starting over would create the sub-issues already created again
+		if state, err := splitstate.Load(statePath); err == nil && !state.Complete() {
+			return fmt.Errorf("an earlier split of #%d has %d sub-issue(s) left to create or link\nRun 'gh pmu split %d --resume' to finish them, or remove %s to start over", issueNum, len(state.Pending())+len(state.Unlinked()), issueNum, statePath)
+		}
+	}
 
-	if opts.from != "" {
-		if opts.from == "body" {
-			// Parse from issue body
-			tasks = parseChecklist(parentIssue.Body)
+	// Determine tasks to create; bodies is only set for sections
+	var tasks, bodies []string
+	var source string // The body or plan file the tasks were parsed from
+
+	if opts.suggest {
+		maxChars := 0
+		if cfg.AI != nil {
+			maxChars = cfg.AI.MaxInputChars
+		}
+		tasks, err = llm.SuggestSplit(provider, parentIssue, maxChars)
+		if err != nil {
+			return err
+		}
+	} else if opts.from != "" || opts.fromFile != "" || opts.byHeading {
+		source = parentIssue.Body
+		if planFile != "" {
+			source = planText
+		}
+		if opts.byHeading {
+			tasks, bodies = parseHeadingSections(source)
 		} else {
-			// Parse from file
-			content, err := os.ReadFile(opts.from)
-			if err != nil {
-				return fmt.Errorf("failed to read file %s: %w", opts.from, err)
-			}
-			tasks = parseChecklist(string(content))
+			tasks = parseChecklist(source)
 		}
 	} else if len(args) > 1 {
 		// Tasks from command line arguments
 		tasks = args[1:]
 	} else {
-		return fmt.Errorf("no tasks specified\nUse --from=body, --from=<file>, or provide tasks as arguments")
+		return fmt.Errorf("no tasks specified\nUse --from=body, --from-file=<file>, or provide tasks as arguments")
 	}
 
 	if len(tasks) == 0 {
@@ -127,60 +328,423 @@ func runSplit(cmd *cobra.Command, args []string, opts *splitOptions) error {
 			return outputSplitJSON(cmd, parentIssue, nil, "no-tasks")
 		}
 		cmd.Println("No tasks found to create as sub-issues")
+		if !opts.byHeading {
+			if titles, _ := parseHeadingSections(source); len(titles) > 0 {
+				cmd.Printf("Found %d heading section(s); use --by-heading to create a sub-issue per section\n", len(titles))
+			}
+		}
 		return nil
 	}
 
+	// Suggestions are only printed in machine-readable mode; creating them
+	// always requires interactive confirmation
+	if opts.suggest && opts.json {
+		splitTasks, err := parseSplitTasks(tasks, nil, cfg, time.Now())
+		if err != nil {
+			return err
+		}
+		return outputSplitJSON(cmd, parentIssue, splitTasks, "suggested")
+	}
+
+	// Pick the tasks before anything is shown as would-be-created
+	if opts.interactive {
+		var selected []int
+		var confirmed bool
+		tasks, selected, confirmed, err = selectSplitTasks(cmd, parentIssue, tasks, bufio.NewReader(cmd.InOrStdin()))
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			cmd.Println("Aborted. No sub-issues were created.")
+			return nil
+		}
+		if bodies != nil {
+			picked := make([]string, 0, len(selected))
+			for _, i := range selected {
+				picked = append(picked, bodies[i])
+			}
+			bodies = picked
+		}
+	}
+
+	var estimate *splitEstimate
+	if opts.distributeEstimate {
+		if parentIssue.Repository.Owner == "" || parentIssue.Repository.Name == "" {
+			parentIssue.Repository = api.Repository{Owner: owner, Name: repo}
+		}
+		estimate, err = loadSplitEstimate(client, cfg, parentIssue)
+		if err != nil {
+			return err
+		}
+	}
+
+	inherited := &splitInherited{}
+	if inheritKeys != nil {
+		inherited, err = loadSplitInherit(client, cfg, parentIssue, owner, repo, inheritKeys)
+		if err != nil {
+			cmd.PrintErrf("Warning: %v; the sub-issues will not inherit project fields\n", err)
+		}
+	}
+
 	// Dry run - just show what would be created
 	if opts.dryRun {
+		splitTasks, err := parseSplitTasks(tasks, bodies, cfg, time.Now())
+		if err != nil {
+			return err
+		}
+		if estimate != nil {
+			if err := distributeEstimate(splitTasks, estimate); err != nil {
+				return err
+			}
+		}
+		applySplitInherit(splitTasks, inherited)
 		if opts.json {
-			return outputSplitJSON(cmd, parentIssue, tasks, "dry-run")
+			return outputSplitJSON(cmd, parentIssue, splitTasks, "dry-run")
 		}
-		cmd.Printf("Would create %d sub-issue(s) under #%d: %s\n\n", len(tasks), parentIssue.Number, parentIssue.Title)
-		for i, task := range tasks {
+		cmd.Printf("Would create %d sub-issue(s) under #%d: %s\n\n", len(splitTasks), parentIssue.Number, parentIssue.Title)
+		for i, task := range splitTasks {
 			cmd.Printf("  %d. %s\n", i+1, task)
+			if task.Body != "" {
+				cmd.Printf("     body: %d line(s)\n", strings.Count(task.Body, "\n")+1)
+			}
+		}
+		if target != "" {
+			cmd.Printf("\nThe sub-issues would be created in %s and added to the project\n", target)
+		}
+		if inherited.Milestone != "" {
+			cmd.Printf("\nThe sub-issues would inherit milestone %s\n", inherited.Milestone)
+		}
+		if estimate != nil && opts.parentEstimate != "keep" {
+			cmd.Printf("\nThe parent's %s of %s would be set by --parent-estimate=%s\n", estimate.Field, formatPoints(estimate.Total), opts.parentEstimate)
+		}
+		if opts.linkBody != "" {
+			cmd.Printf("\n#%d's body would link the sub-issues (--link-body=%s)\n", parentIssue.Number, opts.linkBody)
 		}
 		return nil
 	}
 
-	// Create sub-issues
-	var created []api.Issue
-	var failed []string
-
-	for _, task := range tasks {
-		// Create the issue
-		newIssue, err := client.CreateIssue(owner, repo, task, "", nil)
+	if opts.suggest && !opts.interactive {
+		var confirmed bool
+		tasks, confirmed, err = reviewSuggestedTasks(cmd, parentIssue, tasks, bufio.NewReader(cmd.InOrStdin()), editTasksInEditor)
 		if err != nil {
-			cmd.PrintErrf("Failed to create sub-issue %q: %v\n", task, err)
-			failed = append(failed, task)
-			continue
+			return err
+		}
+		if !confirmed {
+			cmd.Println("Aborted. No sub-issues were created.")
+			return nil
+		}
+	}
+
+	splitTasks, err := parseSplitTasks(tasks, bodies, cfg, time.Now())
+	if err != nil {
+		return err
+	}
+	if estimate != nil {
+		if err := distributeEstimate(splitTasks, estimate); err != nil {
+			return err
+		}
+	}
+	applySplitInherit(splitTasks, inherited)
+
+	project := inherited.project
+	if estimate != nil {
+		project = estimate.project
+	}
+
+	state := newSplitState(fmt.Sprintf("%s/%s#%d", owner, repo, parentIssue.Number), splitTasks, tasks, time.Now())
+	state.Repo = target
+	state.Milestone = inherited.Milestone
+	state.LinkBody = opts.linkBody
+	if estimate != nil && opts.parentEstimate != "keep" {
+		state.ParentEstimate = opts.parentEstimate
+	}
+	return createSplitSubIssues(cmd, client, cfg, parentIssue, owner, repo, state, statePath, project, estimate, opts.json)
+}
+
+// resumeSplit creates the sub-issues an earlier split of parent did not,
+// and links those it created but failed to link
+func resumeSplit(cmd *cobra.Command, opts *splitOptions, cfg *config.Config, client *api.Client, parent *api.Issue, owner, repo, statePath string) error {
+	state, err := splitstate.Load(statePath)
+	if err != nil {
+		return err
+	}
+	if len(state.Tasks) == 0 {
+		return fmt.Errorf("no unfinished split of #%d to resume", parent.Number)
+	}
+	if state.Complete() {
+		cmd.Printf("Every sub-issue of the split of #%d was already created\n", parent.Number)
+		return splitstate.Clear(statePath)
+	}
+	tasks := pendingSplitTasks(state)
+
+	if opts.dryRun {
+		if opts.json {
+			return outputSplitJSON(cmd, parent, tasks, "dry-run")
 		}
+		cmd.Printf("Would create the %d remaining sub-issue(s) of the split of #%d started %s: %s\n\n", len(tasks), parent.Number, formatHistoryTime(state.StartedAt), parent.Title)
+		for i, task := range tasks {
+			cmd.Printf("  %d. %s\n", i+1, task)
+		}
+		for _, i := range state.Unlinked() {
+			cmd.Printf("  Link #%d %s\n", state.Tasks[i].Issue, state.Tasks[i].Title)
+		}
+		if state.Repo != "" {
+			cmd.Printf("\nThe sub-issues would be created in %s\n", state.Repo)
+		}
+		return nil
+	}
 
-		// Link as sub-issue
-		err = client.AddSubIssue(parentIssue.ID, newIssue.ID)
+	var estimate *splitEstimate
+	if state.ParentEstimate != "" {
+		if parent.Repository.Owner == "" || parent.Repository.Name == "" {
+			parent.Repository = api.Repository{Owner: owner, Name: repo}
+		}
+		estimate, err = loadSplitEstimate(client, cfg, parent)
 		if err != nil {
-			cmd.PrintErrf("Created #%d but failed to link as sub-issue: %v\n", newIssue.Number, err)
-			// Still count as created since issue exists
+			cmd.PrintErrf("Warning: %v; --parent-estimate=%s will not be applied\n", err, state.ParentEstimate)
+			estimate = nil
 		}
+	}
 
-		created = append(created, *newIssue)
-		cmd.Printf("Created sub-issue #%d: %s\n", newIssue.Number, newIssue.Title)
+	var project *api.Project
+	if estimate != nil {
+		project = estimate.project
 	}
+	return createSplitSubIssues(cmd, client, cfg, parent, owner, repo, state, statePath, project, estimate, opts.json)
+}
 
-	// Summary
-	if opts.json {
-		return outputSplitJSONCreated(cmd, parentIssue, created, failed)
+// splitTargetRepo returns the repository given by --repo, which must be
+// configured, as the configuration spells it. It is empty without --repo
+// or when it names the parent's repository, owner/repo.
+func splitTargetRepo(cfg *config.Config, flag, owner, repo string) (string, error) {
+	if flag == "" || strings.EqualFold(flag, owner+"/"+repo) {
+		return "", nil
+	}
+	for _, r := range cfg.Repositories {
+		if strings.EqualFold(r, flag) {
+			return r, nil
+		}
 	}
+	return "", fmt.Errorf("--repo %s is not a configured repository; use one of: %s", flag, strings.Join(cfg.Repositories, ", "))
+}
 
-	cmd.Printf("\nSplit complete: %d sub-issue(s) created under #%d", len(created), parentIssue.Number)
-	if len(failed) > 0 {
-		cmd.Printf(" (%d failed)", len(failed))
+// splitPlanFile returns the local file tasks are read from, if any
+func splitPlanFile(opts *splitOptions) string {
+	if opts.fromFile != "" {
+		return opts.fromFile
 	}
-	cmd.Println()
+	if opts.from != "" && opts.from != "body" {
+		return opts.from
+	}
+	return ""
+}
 
-	return nil
+// reviewSuggestedTasks shows suggested tasks and asks the user to create,
+// edit, or abort. Returns the final task list and whether it was confirmed.
+func reviewSuggestedTasks(cmd *cobra.Command, parent *api.Issue, tasks []string, in *bufio.Reader, edit func([]string) ([]string, error)) ([]string, bool, error) {
+	for {
+		cmd.Printf("Suggested sub-issues for #%d: %s\n\n", parent.Number, parent.Title)
+		for i, task := range tasks {
+			cmd.Printf("  %d. %s\n", i+1, task)
+		}
+
+		if len(tasks) == 0 {
+			cmd.Println("  (none)")
+		}
+
+		cmd.Printf("\nCreate %d sub-issue(s)? [y/N/e(dit)]: ", len(tasks))
+		response, err := in.ReadString('\n')
+		response = strings.ToLower(strings.TrimSpace(response))
+		if err != nil && response == "" {
+			// No input available (e.g. stdin closed) - never create without confirmation
+			cmd.Println()
+			return tasks, false, nil
+		}
+
+		switch response {
+		case "y", "yes":
+			return tasks, len(tasks) > 0, nil
+		case "e", "edit":
+			edited, err := edit(tasks)
+			if err != nil {
+				return nil, false, err
+			}
+			tasks = edited
+			cmd.Println()
+		default:
+			return tasks, false, nil
+		}
+	}
+}
+
+// selectSplitTasks lists tasks with checkboxes, all checked, and lets the
+// user toggle them by number or range, check all or none, and edit titles.
+// Returns the checked tasks and whether they were confirmed.
+func selectSplitTasks(cmd *cobra.Command, parent *api.Issue, tasks []string, in *bufio.Reader) ([]string, []int, bool, error) {
+	tasks = append([]string{}, tasks...)
+	checked := make([]bool, len(tasks))
+	for i := range checked {
+		checked[i] = true
+	}
+
+	for {
+		cmd.Printf("Sub-issues for #%d: %s\n\n", parent.Number, parent.Title)
+		count := 0
+		for i, task := range tasks {
+			box := "[ ]"
+			if checked[i] {
+				box = "[x]"
+				count++
+			}
+			cmd.Printf("  %2d. %s %s\n", i+1, box, task)
+		}
+
+		cmd.Println("\nToggle with numbers or ranges (1 3 5-7), a(ll), n(one), e <number> to edit a title,")
+		cmd.Printf("y to create %d sub-issue(s), q to abort: ", count)
+		response, err := in.ReadString('\n')
+		response = strings.TrimSpace(response)
+		if err != nil && response == "" {
+			// No input available - never create without confirmation
+			cmd.Println()
+			return nil, nil, false, nil
+		}
+		cmd.Println()
+
+		switch lower := strings.ToLower(response); {
+		case lower == "y" || lower == "yes":
+			var selected []string
+			var indexes []int
+			for i, task := range tasks {
+				if checked[i] {
+					selected = append(selected, task)
+					indexes = append(indexes, i)
+				}
+			}
+			if len(selected) == 0 {
+				cmd.Println("No tasks are checked.")
+				continue
+			}
+			return selected, indexes, true, nil
+		case lower == "q" || lower == "quit":
+			return nil, nil, false, nil
+		case lower == "a" || lower == "all":
+			for i := range checked {
+				checked[i] = true
+			}
+		case lower == "n" || lower == "none":
+			for i := range checked {
+				checked[i] = false
+			}
+		case strings.HasPrefix(lower, "e"):
+			arg := strings.TrimPrefix(strings.TrimPrefix(lower, "edit"), "e")
+			n, err := strconv.Atoi(strings.TrimSpace(arg))
+			if err != nil || n < 1 || n > len(tasks) {
+				cmd.Printf("Give the number of the task to edit, such as e 2\n\n")
+				continue
+			}
+			cmd.Printf("Title for %d [%s]: ", n, tasks[n-1])
+			title, _ := in.ReadString('\n')
+			if title = strings.TrimSpace(title); title != "" {
+				tasks[n-1] = title
+				checked[n-1] = true
+			}
+			cmd.Println()
+		default:
+			toggle, err := parseTaskSelection(response, len(tasks))
+			if err != nil {
+				cmd.Printf("%v\n\n", err)
+				continue
+			}
+			for _, i := range toggle {
+				checked[i] = !checked[i]
+			}
+		}
+	}
+}
+
+// parseTaskSelection parses space- or comma-separated task numbers and
+// ranges such as "1 3 5-7" into zero-based indexes
+func parseTaskSelection(s string, count int) ([]int, error) {
+	var indexes []int
+	for _, part := range strings.FieldsFunc(s, func(r rune) bool { return r == ' ' || r == ',' }) {
+		from, to, isRange := strings.Cut(part, "-")
+		first, err := strconv.Atoi(from)
+		last := first
+		if err == nil && isRange {
+			last, err = strconv.Atoi(to)
+		}
+		if err != nil || first < 1 || last > count || first > last {
+			return nil, fmt.Errorf("%q is not a task number or range from 1 to %d", part, count)
+		}
+		for n := first; n <= last; n++ {
+			indexes = append(indexes, n-1)
+		}
+	}
+	return indexes, nil
+}
+
+// editTasksInEditor opens the tasks as a checklist in $VISUAL or $EDITOR
+// and returns the unchecked items from the saved file
+func editTasksInEditor(tasks []string) ([]string, error) {
+	var b strings.Builder
+	b.WriteString("<!-- Edit the sub-issues to create. Only unchecked items (- [ ]) are used. -->\n")
+	for _, task := range tasks {
+		b.WriteString("- [ ] " + task + "\n")
+	}
+
+	content, err := editTextInEditor(b.String(), "gh-pmu-split-*.md")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseChecklist(content), nil
+}
+
+// editTextInEditor writes text to a temp file named by pattern, opens it in
+// $VISUAL or $EDITOR, and returns the saved contents
+func editTextInEditor(text, pattern string) (string, error) {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		return "", fmt.Errorf("no editor configured: set $EDITOR")
+	}
+
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.WriteString(text); err != nil {
+		f.Close()
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+	f.Close()
+
+	parts := strings.Fields(editor)
+	editCmd := exec.Command(parts[0], append(parts[1:], f.Name())...)
+	editCmd.Stdin = os.Stdin
+	editCmd.Stdout = os.Stdout
+	editCmd.Stderr = os.Stderr
+	if err := editCmd.Run(); err != nil {
+		return "", fmt.Errorf("editor failed: %w", err)
+	}
+
+	content, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited file: %w", err)
+	}
+	return string(content), nil
 }
 
-// parseChecklist extracts unchecked checklist items from markdown text
+// linkedItemPattern matches a checklist item that already links an issue,
+// as written by split --link-body
+var linkedItemPattern = regexp.MustCompile(`^([\w.-]+/[\w.-]+)?#\d+(\s|$)`)
+
+// parseChecklist extracts unchecked checklist items from markdown text.
+// Items that already link an issue ("- [ ] #123 Task") are skipped.
 func parseChecklist(text string) []string {
 	var tasks []string
 
@@ -192,7 +756,7 @@ func parseChecklist(text string) []string {
 	for _, match := range matches {
 		if len(match) > 1 {
 			task := strings.TrimSpace(match[1])
-			if task != "" {
+			if task != "" && !linkedItemPattern.MatchString(task) {
 				tasks = append(tasks, task)
 			}
 		}
@@ -201,7 +765,223 @@ func parseChecklist(text string) []string {
 	return tasks
 }
 
-func outputSplitJSON(cmd *cobra.Command, parent *api.Issue, tasks []string, status string) error {
+// headingPattern matches an ATX markdown heading, capturing its level and
+// text without any closing #s
+var headingPattern = regexp.MustCompile(`^(#{1,6})\s+(.*?)(?:\s+#+)?\s*$`)
+
+// parseHeadingSections splits markdown text into sections at its
+// shallowest heading level, returning each heading's text and the content
+// up to the next heading of that level. Text before the first heading and
+// headings inside fenced code blocks are ignored.
+func parseHeadingSections(text string) (titles, bodies []string) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+
+	// Find the heading lines, skipping fenced code
+	levels := make([]int, len(lines))
+	level := 0
+	fenced := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fenced = !fenced
+			continue
+		}
+		if fenced {
+			continue
+		}
+		if m := headingPattern.FindStringSubmatch(line); m != nil && m[2] != "" {
+			levels[i] = len(m[1])
+			if level == 0 || levels[i] < level {
+				level = levels[i]
+			}
+		}
+	}
+	if level == 0 {
+		return nil, nil
+	}
+
+	start := -1
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		title := headingPattern.FindStringSubmatch(lines[start])[2]
+		body := strings.Join(lines[start+1:end], "\n")
+		titles = append(titles, title)
+		bodies = append(bodies, strings.TrimRight(strings.TrimLeft(body, "\n"), " \t\n"))
+	}
+	for i := range lines {
+		if levels[i] == level {
+			flush(i)
+			start = i
+		}
+	}
+	flush(len(lines))
+
+	return titles, bodies
+}
+
+// splitTask is a task with the metadata from its inline annotations
+type splitTask struct {
+	Title     string            `json:"title"`
+	Body      string            `json:"body,omitempty"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	Labels    []string          `json:"labels,omitempty"`
+	Assignees []string          `json:"assignees,omitempty"`
+
+	fields []createFieldValue // Fields resolved to project field names
+}
+
+// splitAnnotationPattern matches an inline annotation such as [estimate:3]
+var splitAnnotationPattern = regexp.MustCompile(`\[\s*([A-Za-z][\w-]*)\s*:\s*([^\]]*?)\s*\]`)
+
+// parseSplitTask removes the [key:value] annotations from a task and
+// collects them: label and assignee (or their plurals) take
+// comma-separated values, and any other key is a project field, where a
+// later value replaces an earlier one. Other bracketed text is kept.
+func parseSplitTask(text string) splitTask {
+	var task splitTask
+	title := splitAnnotationPattern.ReplaceAllStringFunc(text, func(m string) string {
+		parts := splitAnnotationPattern.FindStringSubmatch(m)
+		key, value := strings.ToLower(parts[1]), parts[2]
+		if value == "" {
+			return m
+		}
+		switch key {
+		case "label", "labels":
+			task.Labels = append(task.Labels, splitAnnotationValues(value)...)
+		case "assignee", "assignees":
+			for _, login := range splitAnnotationValues(value) {
+				if login != api.ViewerOwner {
+					login = strings.TrimPrefix(login, "@")
+				}
+				task.Assignees = append(task.Assignees, login)
+			}
+		default:
+			if task.Fields == nil {
+				task.Fields = map[string]string{}
+			}
+			task.Fields[key] = value
+		}
+		return ""
+	})
+	task.Title = strings.Join(strings.Fields(title), " ")
+	return task
+}
+
+// splitAnnotationValues splits a comma-separated annotation value
+func splitAnnotationValues(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// parseSplitTasks parses the annotations of each task and resolves their
+// fields, so that an unknown field or value fails before anything is
+// created. bodies, if given, holds the body of each task.
+func parseSplitTasks(tasks, bodies []string, cfg *config.Config, now time.Time) ([]splitTask, error) {
+	parsed := make([]splitTask, 0, len(tasks))
+	for i, text := range tasks {
+		task := parseSplitTask(text)
+		if i < len(bodies) {
+			task.Body = bodies[i]
+		}
+		if task.Title == "" {
+			return nil, fmt.Errorf("task %q has no title", text)
+		}
+		fields, err := resolveCreateFields(task.fieldPairs(), cfg, now)
+		if err != nil {
+			return nil, fmt.Errorf("task %q: %w", task.Title, err)
+		}
+		task.fields = fields
+		parsed = append(parsed, task)
+	}
+	return parsed, nil
+}
+
+// fieldPairs returns the field annotations as key=value pairs, sorted by key
+func (t splitTask) fieldPairs() []string {
+	keys := make([]string, 0, len(t.Fields))
+	for key := range t.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+t.Fields[key])
+	}
+	return pairs
+}
+
+// String renders the task title followed by its metadata
+func (t splitTask) String() string {
+	var meta []string
+	for _, pair := range t.fieldPairs() {
+		key, value, _ := strings.Cut(pair, "=")
+		meta = append(meta, key+": "+value)
+	}
+	if len(t.Labels) > 0 {
+		meta = append(meta, "labels: "+strings.Join(t.Labels, ", "))
+	}
+	if len(t.Assignees) > 0 {
+		logins := make([]string, 0, len(t.Assignees))
+		for _, login := range t.Assignees {
+			if login != api.ViewerOwner {
+				login = "@" + login
+			}
+			logins = append(logins, login)
+		}
+		meta = append(meta, "assignees: "+strings.Join(logins, ", "))
+	}
+	if len(meta) == 0 {
+		return t.Title
+	}
+	return fmt.Sprintf("%s (%s)", t.Title, strings.Join(meta, "; "))
+}
+
+// setSplitTaskFields adds a created sub-issue to the project and sets the
+// fields from its annotations. Failures are warnings, since the issue
+// exists. The project is fetched on first use and returned for reuse.
+func setSplitTaskFields(cmd *cobra.Command, client *api.Client, cfg *config.Config, project *api.Project, issue *api.Issue, task splitTask) *api.Project {
+	if project == nil {
+		p, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+		if err != nil {
+			cmd.PrintErrf("Warning: failed to get project to set fields on #%d: %v\n", issue.Number, err)
+			return nil
+		}
+		project = p
+	}
+
+	itemID, err := client.AddIssueToProject(project.ID, issue.ID)
+	if err != nil {
+		cmd.PrintErrf("Warning: failed to add #%d to project: %v\n", issue.Number, err)
+		return project
+	}
+	for _, f := range task.fields {
+		if err := client.SetProjectItemField(project.ID, itemID, f.Field, f.Value); err != nil {
+			cmd.PrintErrf("Warning: failed to set %s on #%d: %v\n", f.Field, issue.Number, err)
+		}
+	}
+	return project
+}
+
+// outputSplitJSON prints the tasks that would be created. tasks holds the
+// titles without annotations; taskDetails adds the body and metadata of
+// each task.
+func outputSplitJSON(cmd *cobra.Command, parent *api.Issue, tasks []splitTask, status string) error {
+	titles := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		titles = append(titles, task.Title)
+	}
+	if tasks == nil {
+		tasks = []splitTask{}
+	}
+
 	output := map[string]interface{}{
 		"status": status,
 		"parent": map[string]interface{}{
@@ -209,8 +989,9 @@ func outputSplitJSON(cmd *cobra.Command, parent *api.Issue, tasks []string, stat
 			"title":  parent.Title,
 			"url":    parent.URL,
 		},
-		"taskCount": len(tasks),
-		"tasks":     tasks,
+		"taskCount":   len(tasks),
+		"tasks":       titles,
+		"taskDetails": tasks,
 	}
 
 	encoder := json.NewEncoder(os.Stdout)
@@ -218,10 +999,36 @@ func outputSplitJSON(cmd *cobra.Command, parent *api.Issue, tasks []string, stat
 	return encoder.Encode(output)
 }
 
-func outputSplitJSONCreated(cmd *cobra.Command, parent *api.Issue, created []api.Issue, failed []string) error {
+// outputSplitJSONCreated prints the created sub-issues, and those created
+// but not linked. createdTasks, when given, holds the task each issue was
+// created from, whose metadata is included.
+func outputSplitJSONCreated(cmd *cobra.Command, parent *api.Issue, created []api.Issue, createdTasks []splitTask, unlinked []api.Issue, failed []string) error {
 	createdJSON := make([]map[string]interface{}, 0, len(created))
-	for _, issue := range created {
-		createdJSON = append(createdJSON, map[string]interface{}{
+	for i, issue := range created {
+		entry := map[string]interface{}{
+			"number": issue.Number,
+			"title":  issue.Title,
+			"url":    issue.URL,
+		}
+		if i < len(createdTasks) {
+			task := createdTasks[i]
+			if len(task.Fields) > 0 {
+				entry["fields"] = task.Fields
+			}
+			if len(task.Labels) > 0 {
+				entry["labels"] = task.Labels
+			}
+			if len(task.Assignees) > 0 {
+				entry["assignees"] = task.Assignees
+			}
+		}
+		createdJSON = append(createdJSON, entry)
+	}
+
+	// Created, but not linked under the parent
+	unlinkedJSON := make([]map[string]interface{}, 0, len(unlinked))
+	for _, issue := range unlinked {
+		unlinkedJSON = append(unlinkedJSON, map[string]interface{}{
 			"number": issue.Number,
 			"title":  issue.Title,
 			"url":    issue.URL,
@@ -235,10 +1042,12 @@ func outputSplitJSONCreated(cmd *cobra.Command, parent *api.Issue, created []api
 			"title":  parent.Title,
 			"url":    parent.URL,
 		},
-		"createdCount": len(created),
-		"failedCount":  len(failed),
-		"created":      createdJSON,
-		"failed":       failed,
+		"createdCount":  len(created),
+		"unlinkedCount": len(unlinked),
+		"failedCount":   len(failed),
+		"created":       createdJSON,
+		"unlinked":      unlinkedJSON,
+		"failed":        failed,
 	}
 
 	encoder := json.NewEncoder(os.Stdout)
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// splitEstimateClient defines the interface for API methods used by split --distribute-estimate.
+// This allows for easier testing with mock implementations.
+type splitEstimateClient interface {
+	subProgressClient
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
+// splitParentEstimateModes are what --parent-estimate can do with the
+// parent's estimate once it has been distributed
+var splitParentEstimateModes = []string{"keep", "zero", "rollup"}
+
+// splitEstimate is the parent's estimate being distributed across the
+// sub-issues created by split
+type splitEstimate struct {
+	Field   string // Project field name
+	Total   float64
+	project *api.Project
+	itemID  string // The parent's project item
+}
+
+// loadSplitEstimate reads the parent's estimate from its project item
+func loadSplitEstimate(client splitEstimateClient, cfg *config.Config, parent *api.Issue) (*splitEstimate, error) {
+	field := cfg.FieldNameOr("estimate", defaultEstimateFieldName)
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	item, err := client.GetIssueProjectItem(parent.Repository.Owner, parent.Repository.Name, parent.Number, project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get #%d's project item: %w", parent.Number, err)
+	}
+	if item == nil {
+		return nil, fmt.Errorf("#%d is not in the project, so it has no %s to distribute", parent.Number, field)
+	}
+
+	value := getFieldValue(*item, field)
+	if value == "" {
+		return nil, fmt.Errorf("#%d has no %s to distribute", parent.Number, field)
+	}
+	total, err := strconv.ParseFloat(value, 64)
+	if err != nil || total < 0 {
+		return nil, fmt.Errorf("#%d's %s %q is not a number", parent.Number, field, value)
+	}
+
+	return &splitEstimate{Field: field, Total: total, project: project, itemID: item.ID}, nil
+}
+
+// distributeEstimate sets the estimate of each task without one from an
+// [estimate:n] annotation to an even share of what the annotated tasks
+// leave of the total. Whole totals are split into whole shares, with the
+// remainder going to the first tasks.
+func distributeEstimate(tasks []splitTask, est *splitEstimate) error {
+	remaining := est.Total
+	var open []int
+	for i, task := range tasks {
+		value, ok, err := taskEstimate(task, est.Field)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			open = append(open, i)
+			continue
+		}
+		remaining -= value
+	}
+	if remaining < -1e-9 {
+		return fmt.Errorf("the annotated estimates add up to more than the parent's %s of %s", est.Field, formatPoints(est.Total))
+	}
+	if len(open) == 0 {
+		return nil
+	}
+
+	shares := make([]float64, len(open))
+	if remaining == math.Trunc(remaining) {
+		base := math.Floor(remaining / float64(len(open)))
+		extra := int(remaining - base*float64(len(open)))
+		for i := range shares {
+			shares[i] = base
+			if i < extra {
+				shares[i]++
+			}
+		}
+	} else {
+		share := math.Floor(remaining/float64(len(open))*100) / 100
+		for i := range shares {
+			shares[i] = share
+		}
+		// The last share takes the rounding, so the shares add up
+		last := remaining - share*float64(len(open)-1)
+		shares[len(shares)-1] = math.Round(last*100) / 100
+	}
+
+	key := strings.ToLower(est.Field)
+	for i, idx := range open {
+		value := formatPoints(shares[i])
+		if tasks[idx].Fields == nil {
+			tasks[idx].Fields = map[string]string{}
+		}
+		tasks[idx].Fields[key] = value
+		tasks[idx].fields = append(tasks[idx].fields, createFieldValue{Field: est.Field, Value: value})
+	}
+	return nil
+}
+
+// taskEstimate returns the estimate a task's annotations set, if any
+func taskEstimate(task splitTask, field string) (float64, bool, error) {
+	for _, f := range task.fields {
+		if !strings.EqualFold(f.Field, field) {
+			continue
+		}
+		value, err := strconv.ParseFloat(f.Value, 64)
+		if err != nil {
+			return 0, false, fmt.Errorf("task %q: %s %q is not a number", task.Title, field, f.Value)
+		}
+		return value, true, nil
+	}
+	return 0, false, nil
+}
+
+// finishSplitEstimate applies --parent-estimate once the sub-issues are
+// created: zero clears the parent's estimate, and rollup sets it to the sum
+// of its direct sub-issues' estimates
+func finishSplitEstimate(client splitEstimateClient, cfg *config.Config, est *splitEstimate, parent *api.Issue, mode string) (string, error) {
+	var value string
+	switch mode {
+	case "zero":
+		value = "0"
+	case "rollup":
+		root, err := buildSubTree(client, parent, 1)
+		if err != nil {
+			return "", err
+		}
+		points, err := subProgressPoints(client, cfg, root)
+		if err != nil {
+			return "", err
+		}
+		if points == nil {
+			return "", fmt.Errorf("the project has no %s field", est.Field)
+		}
+		value = formatPoints(points.Total)
+	default:
+		return "", nil
+	}
+
+	if err := client.SetProjectItemField(est.project.ID, est.itemID, est.Field, value); err != nil {
+		return "", fmt.Errorf("failed to set %s on #%d: %w", est.Field, parent.Number, err)
+	}
+	return value, nil
+}
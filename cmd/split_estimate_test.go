@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSplitEstimateClient implements splitEstimateClient interface for testing
+type mockSplitEstimateClient struct {
+	mockSubProgressClient
+	parentItem *api.ProjectItem
+	set        []string
+}
+
+func (m *mockSplitEstimateClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	return m.parentItem, nil
+}
+
+func (m *mockSplitEstimateClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	m.set = append(m.set, itemID+" "+fieldName+"="+value)
+	return nil
+}
+
+func TestDistributeEstimate(t *testing.T) {
+	tests := []struct {
+		name  string
+		total float64
+		tasks []splitTask
+		want  []string
+	}{
+		{
+			name:  "whole shares with the remainder first",
+			total: 8,
+			tasks: []splitTask{{Title: "A"}, {Title: "B"}, {Title: "C"}},
+			want:  []string{"3", "3", "2"},
+		},
+		{
+			name:  "annotated estimates are kept",
+			total: 10,
+			tasks: []splitTask{{Title: "A", fields: []createFieldValue{{Field: "Estimate", Value: "4"}}}, {Title: "B"}, {Title: "C"}},
+			want:  []string{"4", "3", "3"},
+		},
+		{
+			name:  "fractional total",
+			total: 2.5,
+			tasks: []splitTask{{Title: "A"}, {Title: "B"}, {Title: "C"}},
+			want:  []string{"0.83", "0.83", "0.84"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := distributeEstimate(tt.tasks, &splitEstimate{Field: "Estimate", Total: tt.total}); err != nil {
+				t.Fatalf("distributeEstimate() error = %v", err)
+			}
+			for i, task := range tt.tasks {
+				value, ok, _ := taskEstimate(task, "estimate")
+				if !ok || formatPoints(value) != tt.want[i] {
+					t.Errorf("task %s estimate = %v (set %v), want %s", task.Title, value, ok, tt.want[i])
+				}
+			}
+		})
+	}
+
+	t.Run("annotations over the total fail", func(t *testing.T) {
+		tasks := []splitTask{{Title: "A", fields: []createFieldValue{{Field: "Estimate", Value: "9"}}}, {Title: "B"}}
+		err := distributeEstimate(tasks, &splitEstimate{Field: "Estimate", Total: 8})
+		if err == nil || !strings.Contains(err.Error(), "more than the parent's Estimate of 8") {
+			t.Errorf("Expected an over-total error, got %v", err)
+		}
+	})
+}
+
+func TestLoadSplitEstimate(t *testing.T) {
+	cfg := newTestConfig()
+	parent := &api.Issue{Number: 1, Repository: api.Repository{Owner: "owner", Name: "repo"}}
+
+	client := &mockSplitEstimateClient{parentItem: &api.ProjectItem{ID: "item-1", FieldValues: []api.FieldValue{{Field: "Estimate", Value: "13"}}}}
+	est, err := loadSplitEstimate(client, cfg, parent)
+	if err != nil {
+		t.Fatalf("loadSplitEstimate() error = %v", err)
+	}
+	if est.Field != "Estimate" || est.Total != 13 || est.itemID != "item-1" {
+		t.Errorf("Unexpected estimate: %+v", est)
+	}
+
+	client.parentItem = &api.ProjectItem{ID: "item-1"}
+	if _, err := loadSplitEstimate(client, cfg, parent); err == nil || !strings.Contains(err.Error(), "#1 has no Estimate to distribute") {
+		t.Errorf("Expected a missing estimate error, got %v", err)
+	}
+
+	client.parentItem = nil
+	if _, err := loadSplitEstimate(client, cfg, parent); err == nil || !strings.Contains(err.Error(), "not in the project") {
+		t.Errorf("Expected a not-in-project error, got %v", err)
+	}
+}
+
+func TestFinishSplitEstimate(t *testing.T) {
+	cfg := newTestConfig()
+	parent := &api.Issue{Number: 1, Repository: api.Repository{Owner: "owner", Name: "repo"}}
+	est := &splitEstimate{Field: "Estimate", Total: 8, project: &api.Project{ID: "proj-1"}, itemID: "item-1"}
+
+	client := &mockSplitEstimateClient{mockSubProgressClient: mockSubProgressClient{
+		children:  map[int][]int{1: {2, 3}, 2: {4}},
+		estimates: map[int]string{2: "5", 3: "3", 4: "2"},
+		fields:    []api.ProjectField{{Name: "Estimate"}},
+	}}
+
+	if value, err := finishSplitEstimate(client, cfg, est, parent, "keep"); err != nil || value != "" || len(client.set) != 0 {
+		t.Errorf("keep: value = %q, err = %v, set = %v", value, err, client.set)
+	}
+	if value, err := finishSplitEstimate(client, cfg, est, parent, "zero"); err != nil || value != "0" {
+		t.Errorf("zero: value = %q, err = %v", value, err)
+	}
+	// Only direct sub-issues count toward the rollup
+	if value, err := finishSplitEstimate(client, cfg, est, parent, "rollup"); err != nil || value != "8" {
+		t.Errorf("rollup: value = %q, err = %v", value, err)
+	}
+	if strings.Join(client.set, "|") != "item-1 Estimate=0|item-1 Estimate=8" {
+		t.Errorf("Unexpected field updates: %v", client.set)
+	}
+}
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// splitInheritClient defines the interface for API methods used by split --inherit.
+// This allows for easier testing with mock implementations.
+type splitInheritClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+}
+
+// defaultSplitInherit is what split --inherit copies from the parent when
+// defaults.split_inherit is not configured
+var defaultSplitInherit = []string{"status", "priority", "iteration", "labels", "assignees"}
+
+// splitInherited is what the sub-issues created by split copy from the parent
+type splitInherited struct {
+	Labels    []string
+	Assignees []string
+	Milestone string
+	Fields    []createFieldValue
+	project   *api.Project // Set when the parent's fields were read
+}
+
+// splitInheritKeys returns what split inherits, lowercased: nothing with
+// --no-inherit; otherwise defaults.split_inherit when configured, or the
+// default set with --inherit
+func splitInheritKeys(opts *splitOptions, cfg *config.Config) (map[string]bool, error) {
+	if opts.inherit && opts.noInherit {
+		return nil, fmt.Errorf("--inherit cannot be combined with --no-inherit")
+	}
+
+	keys := cfg.Defaults.SplitInherit
+	if keys == nil && opts.inherit {
+		keys = defaultSplitInherit
+	}
+	if opts.noInherit || len(keys) == 0 {
+		return nil, nil
+	}
+
+	inherit := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		inherit[strings.ToLower(strings.TrimSpace(k))] = true
+	}
+	return inherit, nil
+}
+
+// loadSplitInherit collects what the parent passes on. Project fields are
+// read from the parent's project item; a parent outside the project
+// passes on none.
+func loadSplitInherit(client splitInheritClient, cfg *config.Config, parent *api.Issue, owner, repo string, inherit map[string]bool) (*splitInherited, error) {
+	inh := &splitInherited{}
+	if inherit["labels"] {
+		inh.Labels = inheritParentLabels(nil, parent)
+	}
+	if inherit["assignees"] {
+		inh.Assignees = actorLogins(parent.Assignees)
+	}
+	if inherit["milestone"] && parent.Milestone != nil {
+		inh.Milestone = parent.Milestone.Title
+	}
+
+	names := inheritFieldNames(cfg, inherit, nil, cfg.Defaults.SplitInherit != nil)
+	if len(names) == 0 {
+		return inh, nil
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return inh, fmt.Errorf("failed to get project: %w", err)
+	}
+	inh.project = project
+	item, err := client.GetIssueProjectItem(owner, repo, parent.Number, project.ID)
+	if err != nil {
+		return inh, fmt.Errorf("failed to read #%d's project fields: %w", parent.Number, err)
+	}
+	if item == nil {
+		return inh, nil
+	}
+	// Keep the project's spelling of each field name
+	for _, fv := range item.FieldValues {
+		if containsFold(names, fv.Field) && fv.Value != "" {
+			inh.Fields = append(inh.Fields, createFieldValue{Field: fv.Field, Value: fv.Value})
+		}
+	}
+	return inh, nil
+}
+
+// applySplitInherit adds what the parent passes on to each task. Labels
+// are merged; assignees and fields only fill in what a task's annotations
+// leave unset.
+func applySplitInherit(tasks []splitTask, inh *splitInherited) {
+	for i := range tasks {
+		task := &tasks[i]
+		for _, label := range inh.Labels {
+			if !containsFold(task.Labels, label) {
+				task.Labels = append(task.Labels, label)
+			}
+		}
+		if len(task.Assignees) == 0 && len(inh.Assignees) > 0 {
+			task.Assignees = append([]string{}, inh.Assignees...)
+		}
+		for _, f := range inh.Fields {
+			if taskHasField(*task, f.Field) {
+				continue
+			}
+			if task.Fields == nil {
+				task.Fields = map[string]string{}
+			}
+			task.Fields[strings.ToLower(f.Field)] = f.Value
+			task.fields = append(task.fields, f)
+		}
+	}
+}
+
+// taskHasField reports whether a task's annotations set a project field
+func taskHasField(task splitTask, field string) bool {
+	for _, f := range task.fields {
+		if strings.EqualFold(f.Field, field) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// mockSplitInheritClient implements splitInheritClient interface for testing
+type mockSplitInheritClient struct {
+	item    *api.ProjectItem
+	itemErr error
+}
+
+func (m *mockSplitInheritClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1", Number: number}, nil
+}
+
+func (m *mockSplitInheritClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	return m.item, m.itemErr
+}
+
+func TestSplitInheritKeys(t *testing.T) {
+	cfg := newTestConfig()
+
+	if keys, err := splitInheritKeys(&splitOptions{}, cfg); err != nil || keys != nil {
+		t.Errorf("Expected nothing inherited by default, got %v, %v", keys, err)
+	}
+	keys, err := splitInheritKeys(&splitOptions{inherit: true}, cfg)
+	if err != nil || len(keys) != len(defaultSplitInherit) || !keys["status"] || !keys["assignees"] {
+		t.Errorf("Expected the default set with --inherit, got %v, %v", keys, err)
+	}
+
+	cfg.Defaults.SplitInherit = []string{"Labels", " priority "}
+	keys, _ = splitInheritKeys(&splitOptions{}, cfg)
+	if len(keys) != 2 || !keys["labels"] || !keys["priority"] {
+		t.Errorf("Expected the configured set by default, got %v", keys)
+	}
+	if keys, _ := splitInheritKeys(&splitOptions{noInherit: true}, cfg); keys != nil {
+		t.Errorf("Expected --no-inherit to inherit nothing, got %v", keys)
+	}
+	if _, err := splitInheritKeys(&splitOptions{inherit: true, noInherit: true}, cfg); err == nil {
+		t.Error("Expected an error for --inherit with --no-inherit")
+	}
+}
+
+func TestLoadSplitInherit(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{"status": {Field: "Status"}, "priority": {Field: "Priority"}}
+	parent := &api.Issue{
+		Number:    10,
+		Labels:    []api.Label{{Name: "backend"}},
+		Assignees: []api.Actor{{Login: "alice"}},
+		Milestone: &api.Milestone{Title: "v2"},
+	}
+	inherit := map[string]bool{"labels": true, "assignees": true, "milestone": true, "status": true, "priority": true}
+
+	client := &mockSplitInheritClient{item: &api.ProjectItem{FieldValues: []api.FieldValue{
+		{Field: "Status", Value: "In Progress"},
+		{Field: "Priority", Value: ""},
+		{Field: "Estimate", Value: "8"},
+	}}}
+	inh, err := loadSplitInherit(client, cfg, parent, "owner", "repo", inherit)
+	if err != nil {
+		t.Fatalf("loadSplitInherit() error = %v", err)
+	}
+	if !slices.Equal(inh.Labels, []string{"backend"}) || !slices.Equal(inh.Assignees, []string{"alice"}) || inh.Milestone != "v2" {
+		t.Errorf("Unexpected issue attributes: %+v", inh)
+	}
+	// Empty and uninherited fields are skipped
+	if len(inh.Fields) != 1 || inh.Fields[0] != (createFieldValue{Field: "Status", Value: "In Progress"}) || inh.project == nil {
+		t.Errorf("Unexpected fields: %+v", inh.Fields)
+	}
+
+	client.itemErr = errors.New("boom")
+	inh, err = loadSplitInherit(client, cfg, parent, "owner", "repo", inherit)
+	if err == nil || !strings.Contains(err.Error(), "#10's project fields") {
+		t.Errorf("Expected a project item error, got %v", err)
+	}
+	if len(inh.Labels) != 1 {
+		t.Errorf("Expected labels kept when fields fail, got %+v", inh)
+	}
+}
+
+func TestApplySplitInherit(t *testing.T) {
+	tasks := []splitTask{
+		{Title: "A"},
+		{
+			Title:     "B",
+			Labels:    []string{"Backend", "docs"},
+			Assignees: []string{"bob"},
+			Fields:    map[string]string{"status": "Todo"},
+			fields:    []createFieldValue{{Field: "Status", Value: "Todo"}},
+		},
+	}
+	applySplitInherit(tasks, &splitInherited{
+		Labels:    []string{"backend"},
+		Assignees: []string{"alice"},
+		Fields:    []createFieldValue{{Field: "Status", Value: "In Progress"}, {Field: "Priority", Value: "P1"}},
+	})
+
+	if !slices.Equal(tasks[0].Labels, []string{"backend"}) || !slices.Equal(tasks[0].Assignees, []string{"alice"}) {
+		t.Errorf("Unexpected task A: %+v", tasks[0])
+	}
+	if tasks[0].Fields["status"] != "In Progress" || len(tasks[0].fields) != 2 {
+		t.Errorf("Expected task A to inherit both fields, got %+v", tasks[0].fields)
+	}
+
+	// Annotations take precedence; labels are merged without duplicates
+	if !slices.Equal(tasks[1].Labels, []string{"Backend", "docs"}) || !slices.Equal(tasks[1].Assignees, []string{"bob"}) {
+		t.Errorf("Unexpected task B: %+v", tasks[1])
+	}
+	if tasks[1].Fields["status"] != "Todo" || tasks[1].Fields["priority"] != "P1" || len(tasks[1].fields) != 2 {
+		t.Errorf("Expected task B to keep its status, got %+v", tasks[1].fields)
+	}
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+const (
+	// subIssuesStartMarker and subIssuesEndMarker enclose the list of
+	// sub-issues that split --link-body keeps in the parent's body
+	subIssuesStartMarker = "<!-- gh-pmu:sub-issues -->"
+	subIssuesEndMarker   = "<!-- /gh-pmu:sub-issues -->"
+)
+
+// splitLinkModes are the ways split --link-body rewrites the parent's body
+var splitLinkModes = []string{"checklist", "section"}
+
+// uncheckedItemPattern matches an unchecked checklist item, capturing the
+// text before the checkbox and the item
+var uncheckedItemPattern = regexp.MustCompile(`^(\s*-\s*)\[\s*\]\s*(.+)$`)
+
+// splitLink is a created sub-issue and the task text it was created from
+type splitLink struct {
+	Task  string
+	Issue api.Issue
+	Repo  string // owner/repo, when not the parent's repository
+}
+
+// ref returns the reference to the sub-issue from the parent's body
+func (l splitLink) ref() string {
+	return fmt.Sprintf("%s#%d", l.Repo, l.Issue.Number)
+}
+
+// linkSplitBody returns the parent's body with each created sub-issue
+// linked as "- [ ] #123 Title", or "owner/repo#123" from another
+// repository. In checklist mode the unchecked item a
+// sub-issue was created from is rewritten in place; sub-issues without
+// one, and all of them in section mode, are listed between the
+// sub-issues markers, which are added the first time.
+func linkSplitBody(body, mode string, links []splitLink) string {
+	var unlinked []splitLink
+	if mode == "checklist" {
+		body, unlinked = linkSplitChecklist(body, links)
+	} else {
+		unlinked = links
+	}
+	if len(unlinked) == 0 {
+		return body
+	}
+
+	var items []string
+	for _, l := range unlinked {
+		items = append(items, splitLinkItem(l))
+	}
+	return addSubIssuesSection(body, items)
+}
+
+// linkSplitChecklist rewrites the unchecked item each sub-issue was
+// created from and returns the links whose item was not found, such as
+// tasks whose title was edited before creating them
+func linkSplitChecklist(body string, links []splitLink) (string, []splitLink) {
+	linked := make([]bool, len(links))
+	lines := strings.Split(body, "\n")
+	for i, line := range lines {
+		text, cr := strings.CutSuffix(line, "\r")
+		m := uncheckedItemPattern.FindStringSubmatch(text)
+		if m == nil {
+			continue
+		}
+		for j, l := range links {
+			if !linked[j] && strings.TrimSpace(m[2]) == l.Task {
+				linked[j] = true
+				// Keep the item's indentation and line ending
+				lines[i] = fmt.Sprintf("%s[ ] %s %s", m[1], l.ref(), l.Issue.Title)
+				if cr {
+					lines[i] += "\r"
+				}
+				break
+			}
+		}
+	}
+
+	var unlinked []splitLink
+	for j, l := range links {
+		if !linked[j] {
+			unlinked = append(unlinked, l)
+		}
+	}
+	return strings.Join(lines, "\n"), unlinked
+}
+
+// splitLinkItem renders a sub-issue as an unchecked checklist item, which
+// GitHub renders with the issue's live state
+func splitLinkItem(l splitLink) string {
+	return fmt.Sprintf("- [ ] %s %s", l.ref(), l.Issue.Title)
+}
+
+// addSubIssuesSection adds items to the list between the sub-issues
+// markers in body, appending the section when the body has none
+func addSubIssuesSection(body string, items []string) string {
+	start := strings.Index(body, subIssuesStartMarker)
+	if start >= 0 {
+		if end := strings.Index(body[start:], subIssuesEndMarker); end >= 0 {
+			end += start
+			section := strings.TrimRight(body[:end], "\n")
+			return section + "\n" + strings.Join(items, "\n") + "\n" + body[end:]
+		}
+	}
+
+	section := subIssuesStartMarker + "\n### Sub-issues\n\n" + strings.Join(items, "\n") + "\n" + subIssuesEndMarker
+	body = strings.TrimRight(body, "\n")
+	if body == "" {
+		return section + "\n"
+	}
+	return body + "\n\n" + section + "\n"
+}
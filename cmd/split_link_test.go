@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+func newSplitLinks() []splitLink {
+	return []splitLink{
+		{Task: "Build API client [estimate:3]", Issue: api.Issue{Number: 123, Title: "Build API client"}},
+		{Task: "Write docs", Issue: api.Issue{Number: 124, Title: "Write docs"}},
+	}
+}
+
+func TestLinkSplitBody_Checklist(t *testing.T) {
+	body := "## Plan\r\n\r\n- [x] Design\r\n- [ ] Build API client [estimate:3]\r\n  - [ ] Write docs\r\n"
+
+	got := linkSplitBody(body, "checklist", newSplitLinks())
+	want := "## Plan\r\n\r\n- [x] Design\r\n- [ ] #123 Build API client\r\n  - [ ] #124 Write docs\r\n"
+	if got != want {
+		t.Errorf("linkSplitBody() =\n%q\nwant\n%q", got, want)
+	}
+
+	// The rewritten items are no longer tasks for a later split
+	if tasks := parseChecklist(got); len(tasks) != 0 {
+		t.Errorf("Expected no unchecked tasks left, got %v", tasks)
+	}
+}
+
+func TestLinkSplitBody_UnmatchedGoToSection(t *testing.T) {
+	body := "Context\n\n- [ ] Write docs\n"
+
+	got := linkSplitBody(body, "checklist", newSplitLinks())
+	want := "Context\n\n- [ ] #124 Write docs\n\n" +
+		subIssuesStartMarker + "\n### Sub-issues\n\n- [ ] #123 Build API client\n" + subIssuesEndMarker + "\n"
+	if got != want {
+		t.Errorf("linkSplitBody() =\n%s\nwant\n%s", got, want)
+	}
+
+	// A later split adds to the existing section
+	got = linkSplitBody(got, "section", []splitLink{{Task: "Ship", Issue: api.Issue{Number: 130, Title: "Ship"}}})
+	want = "Context\n\n- [ ] #124 Write docs\n\n" +
+		subIssuesStartMarker + "\n### Sub-issues\n\n- [ ] #123 Build API client\n- [ ] #130 Ship\n" + subIssuesEndMarker + "\n"
+	if got != want {
+		t.Errorf("linkSplitBody() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestLinkSplitBody_Section(t *testing.T) {
+	body := "- [ ] Write docs"
+
+	got := linkSplitBody(body, "section", newSplitLinks())
+	want := "- [ ] Write docs\n\n" +
+		subIssuesStartMarker + "\n### Sub-issues\n\n- [ ] #123 Build API client\n- [ ] #124 Write docs\n" + subIssuesEndMarker + "\n"
+	if got != want {
+		t.Errorf("linkSplitBody() =\n%s\nwant\n%s", got, want)
+	}
+
+	if got := linkSplitBody("", "section", newSplitLinks()[1:]); got != subIssuesStartMarker+"\n### Sub-issues\n\n- [ ] #124 Write docs\n"+subIssuesEndMarker+"\n" {
+		t.Errorf("Unexpected section for an empty body: %q", got)
+	}
+}
+
+func TestLinkSplitBody_OtherRepository(t *testing.T) {
+	links := newSplitLinks()
+	for i := range links {
+		links[i].Repo = "acme/api"
+	}
+
+	got := linkSplitBody("- [ ] Write docs\n", "checklist", links)
+	want := "- [ ] acme/api#124 Write docs\n\n" +
+		subIssuesStartMarker + "\n### Sub-issues\n\n- [ ] acme/api#123 Build API client\n" + subIssuesEndMarker + "\n"
+	if got != want {
+		t.Errorf("linkSplitBody() =\n%s\nwant\n%s", got, want)
+	}
+	if tasks := parseChecklist(got); len(tasks) != 0 {
+		t.Errorf("Expected no unchecked tasks left, got %v", tasks)
+	}
+}
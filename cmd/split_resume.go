@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/splitstate"
+	"github.com/spf13/cobra"
+)
+
+// splitResumeConflicts are the flags that choose tasks or how they are
+// created, which a resumed split takes from its state instead
+var splitResumeConflicts = []string{"from", "from-file", "suggest", "interactive", "by-heading", "distribute-estimate", "parent-estimate", "inherit", "no-inherit", "link-body", "repo"}
+
+// checkSplitResume rejects --resume combined with task arguments or flags
+// the first run already settled
+func checkSplitResume(cmd *cobra.Command, args []string) error {
+	var set []string
+	for _, name := range splitResumeConflicts {
+		if cmd.Flags().Changed(name) {
+			set = append(set, "--"+name)
+		}
+	}
+	if len(args) > 1 {
+		set = append(set, "task arguments")
+	}
+	if len(set) > 0 {
+		return fmt.Errorf("--resume uses the tasks and options the split started with; it cannot be combined with %s", strings.Join(set, ", "))
+	}
+	return nil
+}
+
+// newSplitState records the tasks a split is about to create. texts holds
+// each task as given, for --link-body.
+func newSplitState(parent string, tasks []splitTask, texts []string, now time.Time) *splitstate.State {
+	stateTasks := make([]splitstate.Task, 0, len(tasks))
+	for i, task := range tasks {
+		st := splitstate.Task{
+			Title:     task.Title,
+			Body:      task.Body,
+			Labels:    task.Labels,
+			Assignees: task.Assignees,
+		}
+		if i < len(texts) {
+			st.Text = strings.TrimSpace(texts[i])
+		}
+		for _, f := range task.fields {
+			st.Fields = append(st.Fields, splitstate.Field{Name: f.Field, Value: f.Value})
+		}
+		stateTasks = append(stateTasks, st)
+	}
+	return splitstate.New(parent, stateTasks, now)
+}
+
+// splitTaskFromState returns a recorded task ready to be created
+func splitTaskFromState(st splitstate.Task) splitTask {
+	task := splitTask{
+		Title:     st.Title,
+		Body:      st.Body,
+		Labels:    st.Labels,
+		Assignees: st.Assignees,
+	}
+	for _, f := range st.Fields {
+		if task.Fields == nil {
+			task.Fields = map[string]string{}
+		}
+		task.Fields[strings.ToLower(f.Name)] = f.Value
+		task.fields = append(task.fields, createFieldValue{Field: f.Name, Value: f.Value})
+	}
+	return task
+}
+
+// pendingSplitTasks returns the recorded tasks not created yet
+func pendingSplitTasks(state *splitstate.State) []splitTask {
+	var tasks []splitTask
+	for _, i := range state.Pending() {
+		tasks = append(tasks, splitTaskFromState(state.Tasks[i]))
+	}
+	return tasks
+}
+
+// splitLinkClient defines the interface for API methods used to link the
+// sub-issues of a resumed split. This allows for easier testing with mock
+// implementations.
+type splitLinkClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	AddSubIssue(parentIssueID, childIssueID string) error
+}
+
+// relinkSplitSubIssues links under parent the sub-issues an earlier run
+// created but failed to link, from owner/repo. It returns the issues now
+// linked, and those still not linked.
+func relinkSplitSubIssues(cmd *cobra.Command, client splitLinkClient, parent *api.Issue, owner, repo string, state *splitstate.State, save func()) (linked []splitLink, unlinked []api.Issue) {
+	for _, i := range state.Unlinked() {
+		number := state.Tasks[i].Issue
+		issue, err := client.GetIssue(owner, repo, number)
+		if err == nil {
+			err = client.AddSubIssue(parent.ID, issue.ID)
+		}
+		if err != nil {
+			cmd.PrintErrf("Failed to link #%d as sub-issue: %v\n", number, err)
+			unlinked = append(unlinked, api.Issue{Number: number, Title: state.Tasks[i].Title})
+			continue
+		}
+		state.Linked(i)
+		save()
+
+		link := splitLinkFromState(state, i, *issue)
+		linked = append(linked, link)
+		cmd.Printf("Linked sub-issue %s: %s\n", link.ref(), issue.Title)
+	}
+	return linked, unlinked
+}
+
+// splitLinkFromState returns the link to the sub-issue of the task at
+// index i
+func splitLinkFromState(state *splitstate.State, i int, issue api.Issue) splitLink {
+	return splitLink{Task: state.Tasks[i].Text, Issue: issue, Repo: state.Repo}
+}
+
+// createSplitSubIssues creates the pending tasks of a split as sub-issues
+// of parent, in owner/repo or the repository the state names, and links
+// those an earlier run created but failed to link. The state is saved
+// after each sub-issue, so a split that fails part way can be resumed,
+// and removed once every task is created and linked.
+func createSplitSubIssues(cmd *cobra.Command, client *api.Client, cfg *config.Config, parent *api.Issue, owner, repo string, state *splitstate.State, statePath string, project *api.Project, estimate *splitEstimate, jsonOut bool) error {
+	targetOwner, targetRepo := owner, repo
+	if state.Repo != "" {
+		targetOwner, targetRepo, _ = strings.Cut(state.Repo, "/")
+	}
+
+	saveWarned := false
+	save := func() {
+		if err := state.Save(statePath); err != nil && !saveWarned {
+			cmd.PrintErrf("Warning: %v; a failed split cannot be resumed\n", err)
+			saveWarned = true
+		}
+	}
+	save()
+
+	var created []api.Issue
+	var createdTasks []splitTask
+	var failed []string
+	var links []splitLink
+
+	relinked, unlinked := relinkSplitSubIssues(cmd, client, parent, targetOwner, targetRepo, state, save)
+	for _, link := range relinked {
+		i := splitTaskIndex(state, link.Issue.Number)
+		created = append(created, link.Issue)
+		createdTasks = append(createdTasks, splitTaskFromState(state.Tasks[i]))
+		links = append(links, link)
+	}
+
+	for _, i := range state.Pending() {
+		task := splitTaskFromState(state.Tasks[i])
+
+		assignees, err := resolveEditLogins(client, task.Assignees)
+		if err != nil {
+			cmd.PrintErrf("Failed to create sub-issue %q: %v\n", task.Title, err)
+			failed = append(failed, task.Title)
+			continue
+		}
+
+		// Create the issue
+		newIssue, err := client.CreateIssueWithOptions(targetOwner, targetRepo, task.Title, task.Body, task.Labels, assignees, state.Milestone)
+		if err != nil {
+			cmd.PrintErrf("Failed to create sub-issue %q: %v\n", task.Title, err)
+			failed = append(failed, task.Title)
+			continue
+		}
+		state.Created(i, newIssue.Number)
+		save()
+
+		// The project may only add issues from the parent's repository by itself
+		if len(task.fields) > 0 || state.Repo != "" {
+			project = setSplitTaskFields(cmd, client, cfg, project, newIssue, task)
+		}
+
+		// Link as sub-issue; one left unlinked is linked by --resume
+		if err := client.AddSubIssue(parent.ID, newIssue.ID); err != nil {
+			cmd.PrintErrf("Created #%d but failed to link as sub-issue: %v\n", newIssue.Number, err)
+			unlinked = append(unlinked, *newIssue)
+			continue
+		}
+		state.Linked(i)
+		save()
+
+		created = append(created, *newIssue)
+		createdTasks = append(createdTasks, task)
+		link := splitLinkFromState(state, i, *newIssue)
+		links = append(links, link)
+		cmd.Printf("Created sub-issue %s: %s\n", link.ref(), newIssue.Title)
+	}
+
+	if estimate != nil && len(created) > 0 {
+		value, err := finishSplitEstimate(client, cfg, estimate, parent, state.ParentEstimate)
+		if err != nil {
+			cmd.PrintErrf("Warning: failed to update #%d's %s: %v\n", parent.Number, estimate.Field, err)
+		} else if value != "" {
+			cmd.Printf("Set #%d's %s to %s\n", parent.Number, estimate.Field, value)
+		}
+	}
+
+	if state.LinkBody != "" && len(links) > 0 {
+		body := linkSplitBody(parent.Body, state.LinkBody, links)
+		if err := client.UpdateIssue(owner, repo, parent.ID, api.IssueUpdate{Body: &body}); err != nil {
+			cmd.PrintErrf("Warning: failed to link the sub-issues in #%d's body: %v\n", parent.Number, err)
+		} else if !jsonOut {
+			cmd.Printf("Linked %d sub-issue(s) in #%d's body\n", len(links), parent.Number)
+		}
+	}
+
+	if state.Complete() {
+		if err := splitstate.Clear(statePath); err != nil {
+			cmd.PrintErrf("Warning: %v\n", err)
+		}
+	} else if !saveWarned {
+		cmd.PrintErrf("Run 'gh pmu split %d --resume' to retry the %d sub-issue(s) that failed\n", parent.Number, len(failed)+len(unlinked))
+	}
+
+	// Summary
+	if jsonOut {
+		if err := outputSplitJSONCreated(cmd, parent, created, createdTasks, unlinked, failed); err != nil {
+			return err
+		}
+	} else {
+		cmd.Printf("\nSplit complete: %d sub-issue(s) created under #%d\n", len(created), parent.Number)
+		for _, issue := range unlinked {
+			cmd.Printf("  ! #%d %s: created, not linked\n", issue.Number, issue.Title)
+		}
+		for _, title := range failed {
+			cmd.Printf("  ✗ %s: not created\n", title)
+		}
+	}
+
+	if len(unlinked) > 0 || len(failed) > 0 {
+		msg := fmt.Sprintf("split %d of %d sub-issues", len(created), len(created)+len(unlinked)+len(failed))
+		if len(unlinked) > 0 {
+			msg += fmt.Sprintf("; %d created, not linked", len(unlinked))
+		}
+		if len(failed) > 0 {
+			msg += fmt.Sprintf("; %d failed", len(failed))
+		}
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// splitTaskIndex returns the index of the task that created issue
+func splitTaskIndex(state *splitstate.State, issue int) int {
+	for i, task := range state.Tasks {
+		if task.Issue == issue {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/splitstate"
+)
+
+func TestSplitState_RoundTrip(t *testing.T) {
+	tasks, err := parseSplitTasks([]string{"Build API client [estimate:3] [label:backend] [assignee:@alice]", "Write docs"}, nil, newTestConfig(), time.Now())
+	if err != nil {
+		t.Fatalf("parseSplitTasks() error = %v", err)
+	}
+	applySplitInherit(tasks, &splitInherited{Fields: []createFieldValue{{Field: "Priority", Value: "P1"}}})
+
+	state := newSplitState("owner/repo#42", tasks, []string{" Build API client [estimate:3] [label:backend] [assignee:@alice] ", "Write docs"}, time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC))
+	if state.Parent != "owner/repo#42" || state.Tasks[0].Text != "Build API client [estimate:3] [label:backend] [assignee:@alice]" {
+		t.Errorf("Unexpected state: %+v", state)
+	}
+
+	// A task read back from the state is the task that was recorded
+	for i, task := range tasks {
+		if got := splitTaskFromState(state.Tasks[i]); !reflect.DeepEqual(got, task) {
+			t.Errorf("Task %d = %+v, want %+v", i, got, task)
+		}
+	}
+
+	state.Created(0, 101)
+	pending := pendingSplitTasks(state)
+	if len(pending) != 1 || pending[0].Title != "Write docs" {
+		t.Errorf("Expected only Write docs pending, got %+v", pending)
+	}
+}
+
+func TestCheckSplitResume(t *testing.T) {
+	cmd := newSplitCommand()
+	if err := checkSplitResume(cmd, []string{"42"}); err != nil {
+		t.Errorf("checkSplitResume() error = %v", err)
+	}
+
+	_ = cmd.Flags().Set("from", "body")
+	_ = cmd.Flags().Set("inherit", "true")
+	err := checkSplitResume(cmd, []string{"42", "Another task"})
+	if err == nil || !strings.Contains(err.Error(), "cannot be combined with --from, --inherit, task arguments") {
+		t.Errorf("Expected a conflict error, got %v", err)
+	}
+}
+
+func TestSplitCommand_HasResumeFlag(t *testing.T) {
+	cmd := newSplitCommand()
+	if cmd.Flags().Lookup("resume") == nil {
+		t.Fatal("Expected --resume flag")
+	}
+	if flag := cmd.Flags().Lookup("link-body"); flag == nil || flag.NoOptDefVal != "checklist" {
+		t.Errorf("Expected bare --link-body to default to checklist, got %+v", flag)
+	}
+}
+
+// mockSplitLinkClient implements splitLinkClient interface for testing
+type mockSplitLinkClient struct {
+	failLink map[string]bool // issue IDs whose link fails
+	linked   []string
+}
+
+func (m *mockSplitLinkClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{ID: fmt.Sprintf("issue-%d", number), Number: number, Title: fmt.Sprintf("Issue %d", number)}, nil
+}
+
+func (m *mockSplitLinkClient) AddSubIssue(parentIssueID, childIssueID string) error {
+	if m.failLink[childIssueID] {
+		return errors.New("rate limited")
+	}
+	m.linked = append(m.linked, childIssueID+"<"+parentIssueID)
+	return nil
+}
+
+func TestRelinkSplitSubIssues(t *testing.T) {
+	state := splitstate.New("owner/repo#42", []splitstate.Task{
+		{Text: "Build", Title: "Build"},
+		{Text: "Test", Title: "Test"},
+		{Text: "Ship", Title: "Ship"},
+		{Text: "Docs", Title: "Docs"},
+	}, time.Now())
+	// #100 was linked; #101 and #102 were created but not linked; Docs
+	// was not created
+	state.Created(0, 100)
+	state.Linked(0)
+	state.Created(1, 101)
+	state.Created(2, 102)
+
+	client := &mockSplitLinkClient{failLink: map[string]bool{"issue-102": true}}
+	cmd, buf := newTestCmd()
+	saves := 0
+	linked, unlinked := relinkSplitSubIssues(cmd, client, &api.Issue{ID: "issue-42", Number: 42}, "owner", "repo", state, func() { saves++ })
+
+	if len(linked) != 1 || linked[0].Issue.Number != 101 || linked[0].Task != "Test" {
+		t.Errorf("Expected #101 linked, got %+v", linked)
+	}
+	if len(unlinked) != 1 || unlinked[0].Number != 102 || unlinked[0].Title != "Ship" {
+		t.Errorf("Expected #102 still unlinked, got %+v", unlinked)
+	}
+	if strings.Join(client.linked, " ") != "issue-101<issue-42" || saves != 1 {
+		t.Errorf("Unexpected links %v after %d save(s)", client.linked, saves)
+	}
+	if got := state.Unlinked(); len(got) != 1 || got[0] != 2 {
+		t.Errorf("Unlinked() = %v, want [2]", got)
+	}
+	if state.Complete() {
+		t.Error("Expected the split to stay incomplete, to resume again")
+	}
+	out := buf.String()
+	if !strings.Contains(out, "Linked sub-issue #101: Issue 101") || !strings.Contains(out, "Failed to link #102 as sub-issue: rate limited") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+}
@@ -1,11 +1,16 @@
 package cmd
 
 import (
+	"bufio"
 	"bytes"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
 )
 
 func TestSplitCommand(t *testing.T) {
@@ -55,6 +60,29 @@ func TestSplitCommand(t *testing.T) {
 	})
 }
 
+func TestSplitTargetRepo(t *testing.T) {
+	cfg := &config.Config{Repositories: []string{"acme/planning", "Acme/API"}}
+
+	for _, tt := range []struct {
+		flag string
+		want string
+	}{
+		{"", ""},
+		{"acme/planning", ""}, // The parent's repository
+		{"acme/api", "Acme/API"},
+	} {
+		got, err := splitTargetRepo(cfg, tt.flag, "acme", "planning")
+		if err != nil || got != tt.want {
+			t.Errorf("splitTargetRepo(%q) = %q, %v; want %q", tt.flag, got, err, tt.want)
+		}
+	}
+
+	_, err := splitTargetRepo(cfg, "acme/web", "acme", "planning")
+	if err == nil || !strings.Contains(err.Error(), "--repo acme/web is not a configured repository; use one of: acme/planning, Acme/API") {
+		t.Errorf("Expected an unconfigured repository error, got %v", err)
+	}
+}
+
 func TestSplitOptions(t *testing.T) {
 	t.Run("default options", func(t *testing.T) {
 		opts := &splitOptions{}
@@ -98,6 +126,16 @@ Some description here.
 `,
 			expected: []string{"Pending task", "Another pending"},
 		},
+		{
+			name: "items already linked to sub-issues",
+			input: `- [ ] #123 Linked task
+- [ ] #45
+- [ ] Pending task
+- [ ] #2fa support
+- [ ] acme/api#7 Cross-repo task
+`,
+			expected: []string{"Pending task", "#2fa support"},
+		},
 		{
 			name: "with nested content",
 			input: `- [ ] Main task
@@ -151,7 +189,7 @@ func TestOutputSplitJSON(t *testing.T) {
 			Title:  "Parent Epic",
 			URL:    "https://github.com/owner/repo/issues/123",
 		}
-		tasks := []string{"Task 1", "Task 2", "Task 3"}
+		tasks := []splitTask{{Title: "Task 1"}, {Title: "Task 2"}, {Title: "Task 3", Body: "Details"}}
 
 		// Note: outputSplitJSON writes to os.Stdout
 		err := outputSplitJSON(cmd, parent, tasks, "dry-run")
@@ -181,7 +219,7 @@ func TestOutputSplitJSON(t *testing.T) {
 
 		statuses := []string{"dry-run", "no-tasks", "completed"}
 		for _, status := range statuses {
-			err := outputSplitJSON(cmd, parent, []string{}, status)
+			err := outputSplitJSON(cmd, parent, []splitTask{}, status)
 			if err != nil {
 				t.Fatalf("outputSplitJSON failed with status %q: %v", status, err)
 			}
@@ -206,7 +244,7 @@ func TestOutputSplitJSONCreated(t *testing.T) {
 		}
 		failed := []string{"Failed task 1"}
 
-		err := outputSplitJSONCreated(cmd, parent, created, failed)
+		err := outputSplitJSONCreated(cmd, parent, created, nil, nil, failed)
 		if err != nil {
 			t.Fatalf("outputSplitJSONCreated failed: %v", err)
 		}
@@ -216,7 +254,7 @@ func TestOutputSplitJSONCreated(t *testing.T) {
 		cmd := newSplitCommand()
 		parent := &api.Issue{Number: 1, Title: "Parent"}
 
-		err := outputSplitJSONCreated(cmd, parent, []api.Issue{}, []string{"all", "failed"})
+		err := outputSplitJSONCreated(cmd, parent, []api.Issue{}, nil, nil, []string{"all", "failed"})
 		if err != nil {
 			t.Fatalf("outputSplitJSONCreated failed with empty created: %v", err)
 		}
@@ -230,7 +268,7 @@ func TestOutputSplitJSONCreated(t *testing.T) {
 			{Number: 2, Title: "Sub", URL: "url"},
 		}
 
-		err := outputSplitJSONCreated(cmd, parent, created, []string{})
+		err := outputSplitJSONCreated(cmd, parent, created, nil, nil, []string{})
 		if err != nil {
 			t.Fatalf("outputSplitJSONCreated failed with empty failed: %v", err)
 		}
@@ -329,3 +367,297 @@ func TestSplitJSONOutput_Structure(t *testing.T) {
 		}
 	})
 }
+
+func TestSplitCommand_HasSuggestFlag(t *testing.T) {
+	cmd := newSplitCommand()
+	if cmd.Flags().Lookup("suggest") == nil {
+		t.Fatal("Expected --suggest flag to exist")
+	}
+}
+
+func TestReviewSuggestedTasks(t *testing.T) {
+	parent := &api.Issue{Number: 10, Title: "Epic"}
+	suggested := []string{"Design API", "Write tests"}
+
+	noEdit := func([]string) ([]string, error) {
+		t.Fatal("editor should not be opened")
+		return nil, nil
+	}
+
+	tests := []struct {
+		name          string
+		input         string
+		tasks         []string
+		edit          func([]string) ([]string, error)
+		wantConfirmed bool
+		wantTasks     []string
+		wantErr       bool
+	}{
+		{name: "yes confirms", input: "y\n", tasks: suggested, edit: noEdit, wantConfirmed: true, wantTasks: suggested},
+		{name: "default aborts", input: "\n", tasks: suggested, edit: noEdit, wantConfirmed: false, wantTasks: suggested},
+		{name: "closed stdin aborts", input: "", tasks: suggested, edit: noEdit, wantConfirmed: false, wantTasks: suggested},
+		{name: "no tasks cannot be confirmed", input: "y\n", tasks: nil, edit: noEdit, wantConfirmed: false},
+		{
+			name:  "edit then confirm",
+			input: "e\ny\n",
+			tasks: suggested,
+			edit: func(tasks []string) ([]string, error) {
+				return []string{"Design API", "Document API"}, nil
+			},
+			wantConfirmed: true,
+			wantTasks:     []string{"Design API", "Document API"},
+		},
+		{
+			name:  "edit error",
+			input: "e\n",
+			tasks: suggested,
+			edit: func([]string) ([]string, error) {
+				return nil, fmt.Errorf("no editor configured")
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newSplitCommand()
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+
+			tasks, confirmed, err := reviewSuggestedTasks(cmd, parent, tt.tasks, bufio.NewReader(strings.NewReader(tt.input)), tt.edit)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if confirmed != tt.wantConfirmed {
+				t.Errorf("confirmed = %v, want %v", confirmed, tt.wantConfirmed)
+			}
+			if tt.wantTasks != nil && strings.Join(tasks, "|") != strings.Join(tt.wantTasks, "|") {
+				t.Errorf("tasks = %v, want %v", tasks, tt.wantTasks)
+			}
+			if !strings.Contains(buf.String(), "Suggested sub-issues for #10") {
+				t.Errorf("Expected suggestions to be shown, got:\n%s", buf.String())
+			}
+		})
+	}
+}
+
+func TestSelectSplitTasks(t *testing.T) {
+	parent := &api.Issue{Number: 10, Title: "Epic"}
+	tasks := []string{"Design", "Build", "Test", "Docs"}
+
+	tests := []struct {
+		name          string
+		input         string
+		wantConfirmed bool
+		wantTasks     []string
+	}{
+		{name: "all checked by default", input: "y\n", wantConfirmed: true, wantTasks: tasks},
+		{name: "toggle numbers and ranges", input: "1 3-4\ny\n", wantConfirmed: true, wantTasks: []string{"Build"}},
+		{name: "none then pick", input: "n\n2,4\ny\n", wantConfirmed: true, wantTasks: []string{"Build", "Docs"}},
+		{name: "edit a title", input: "e 2\nBuild the API\ny\n", wantConfirmed: true, wantTasks: []string{"Design", "Build the API", "Test", "Docs"}},
+		{name: "invalid selection is asked again", input: "9\ny\n", wantConfirmed: true, wantTasks: tasks},
+		{name: "nothing checked cannot be confirmed", input: "n\ny\nq\n", wantConfirmed: false},
+		{name: "quit aborts", input: "q\n", wantConfirmed: false},
+		{name: "closed stdin aborts", input: "", wantConfirmed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newSplitCommand()
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+
+			got, indexes, confirmed, err := selectSplitTasks(cmd, parent, tasks, bufio.NewReader(strings.NewReader(tt.input)))
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if confirmed != tt.wantConfirmed {
+				t.Errorf("confirmed = %v, want %v", confirmed, tt.wantConfirmed)
+			}
+			if strings.Join(got, "|") != strings.Join(tt.wantTasks, "|") {
+				t.Errorf("tasks = %v, want %v", got, tt.wantTasks)
+			}
+			if len(indexes) != len(got) {
+				t.Errorf("indexes = %v, want one per selected task", indexes)
+			}
+			if !strings.Contains(buf.String(), "   1. [x] Design") {
+				t.Errorf("Expected checkboxes to be shown, got:\n%s", buf.String())
+			}
+		})
+	}
+	if tasks[1] != "Build" {
+		t.Error("Expected the caller's tasks to be left unchanged")
+	}
+}
+
+func TestParseSplitTask(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  splitTask
+	}{
+		{
+			name:  "no annotations",
+			input: "Build API client",
+			want:  splitTask{Title: "Build API client"},
+		},
+		{
+			name:  "field, label, and assignee",
+			input: "Build API client [estimate:3] [label:backend] [assignee:@alice]",
+			want: splitTask{
+				Title:     "Build API client",
+				Fields:    map[string]string{"estimate": "3"},
+				Labels:    []string{"backend"},
+				Assignees: []string{"alice"},
+			},
+		},
+		{
+			name:  "comma-separated values and @me",
+			input: "[labels:api, docs] Write guide [assignees:@me,bob] [Priority: p1 ]",
+			want: splitTask{
+				Title:     "Write guide",
+				Fields:    map[string]string{"priority": "p1"},
+				Labels:    []string{"api", "docs"},
+				Assignees: []string{"@me", "bob"},
+			},
+		},
+		{
+			name:  "other brackets are kept",
+			input: "Fix [WIP] parser [see docs](https://example.com) [note:]",
+			want:  splitTask{Title: "Fix [WIP] parser [see docs](https://example.com) [note:]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseSplitTask(tt.input)
+			if got.Title != tt.want.Title {
+				t.Errorf("Title = %q, want %q", got.Title, tt.want.Title)
+			}
+			if fmt.Sprint(got.Fields) != fmt.Sprint(tt.want.Fields) {
+				t.Errorf("Fields = %v, want %v", got.Fields, tt.want.Fields)
+			}
+			if strings.Join(got.Labels, "|") != strings.Join(tt.want.Labels, "|") {
+				t.Errorf("Labels = %v, want %v", got.Labels, tt.want.Labels)
+			}
+			if strings.Join(got.Assignees, "|") != strings.Join(tt.want.Assignees, "|") {
+				t.Errorf("Assignees = %v, want %v", got.Assignees, tt.want.Assignees)
+			}
+		})
+	}
+}
+
+func TestParseSplitTasks(t *testing.T) {
+	cfg := newTestConfig()
+	cfg.Metadata = &config.Metadata{Fields: []config.FieldMetadata{{Name: "Estimate", DataType: "NUMBER"}}}
+	now := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+
+	tasks, err := parseSplitTasks([]string{"Build [estimate:3] [label:backend] [assignee:@me]", "Docs"}, []string{"", "Write the guide"}, cfg, now)
+	if err != nil {
+		t.Fatalf("parseSplitTasks() error = %v", err)
+	}
+	if len(tasks[0].fields) != 1 || tasks[0].fields[0] != (createFieldValue{Field: "Estimate", Value: "3"}) {
+		t.Errorf("Expected the estimate to resolve to the Estimate field, got %+v", tasks[0].fields)
+	}
+	if got := tasks[0].String(); got != "Build (estimate: 3; labels: backend; assignees: @me)" {
+		t.Errorf("String() = %q", got)
+	}
+	if tasks[1].Body != "Write the guide" {
+		t.Errorf("Body = %q, want %q", tasks[1].Body, "Write the guide")
+	}
+	if got := tasks[1].String(); got != "Docs" {
+		t.Errorf("String() = %q, want %q", got, "Docs")
+	}
+
+	if _, err := parseSplitTasks([]string{"Build [estimate:lots]"}, nil, cfg, now); err == nil || !strings.Contains(err.Error(), `task "Build"`) {
+		t.Errorf("Expected an invalid estimate to fail with the task title, got %v", err)
+	}
+	if _, err := parseSplitTasks([]string{"Build [size:xl]"}, nil, cfg, now); err == nil {
+		t.Error("Expected an unknown field to fail")
+	}
+	if _, err := parseSplitTasks([]string{"[label:backend]"}, nil, cfg, now); err == nil {
+		t.Error("Expected a task with only annotations to fail")
+	}
+}
+
+func TestParseHeadingSections(t *testing.T) {
+	text := "Epic overview, not a task.\r\n\n" +
+		"## Task A [estimate:2]\n\nBuild the thing.\n\n### Notes\n- detail\n\n" +
+		"```sh\n## not a heading\n```\n" +
+		"## Task B ##\n" +
+		"## Task C\n\nLast section.\n"
+
+	titles, bodies := parseHeadingSections(text)
+
+	wantTitles := []string{"Task A [estimate:2]", "Task B", "Task C"}
+	wantBodies := []string{
+		"Build the thing.\n\n### Notes\n- detail\n\n```sh\n## not a heading\n```",
+		"",
+		"Last section.",
+	}
+	if strings.Join(titles, "|") != strings.Join(wantTitles, "|") {
+		t.Errorf("titles = %q, want %q", titles, wantTitles)
+	}
+	if len(bodies) != len(wantBodies) {
+		t.Fatalf("bodies = %q, want %q", bodies, wantBodies)
+	}
+	for i := range wantBodies {
+		if bodies[i] != wantBodies[i] {
+			t.Errorf("body %d = %q, want %q", i, bodies[i], wantBodies[i])
+		}
+	}
+
+	t.Run("uses the shallowest level", func(t *testing.T) {
+		titles, _ := parseHeadingSections("### One\ntext\n#### Sub\n### Two\n")
+		if strings.Join(titles, "|") != "One|Two" {
+			t.Errorf("titles = %q, want [One Two]", titles)
+		}
+	})
+
+	t.Run("no headings", func(t *testing.T) {
+		if titles, bodies := parseHeadingSections("- [ ] Just a checklist\n#hashtag"); titles != nil || bodies != nil {
+			t.Errorf("Expected no sections, got %q %q", titles, bodies)
+		}
+	})
+}
+
+func TestSplitFromFile(t *testing.T) {
+	if newSplitCommand().Flags().Lookup("from-file") == nil {
+		t.Fatal("Expected --from-file flag to exist")
+	}
+
+	tests := []struct {
+		opts splitOptions
+		want string
+	}{
+		{opts: splitOptions{fromFile: "plan.md"}, want: "plan.md"},
+		{opts: splitOptions{from: "tasks.md"}, want: "tasks.md"},
+		{opts: splitOptions{from: "body"}, want: ""},
+		{opts: splitOptions{}, want: ""},
+	}
+	for _, tt := range tests {
+		if got := splitPlanFile(&tt.opts); got != tt.want {
+			t.Errorf("splitPlanFile(%+v) = %q, want %q", tt.opts, got, tt.want)
+		}
+	}
+
+	t.Run("conflicts with --from", func(t *testing.T) {
+		err := runSplit(newSplitCommand(), []string{"1"}, &splitOptions{fromFile: "plan.md", from: "body"})
+		if err == nil || !strings.Contains(err.Error(), "--from-file cannot be combined") {
+			t.Errorf("Expected a conflict error, got %v", err)
+		}
+	})
+
+	t.Run("missing file fails before any API call", func(t *testing.T) {
+		err := runSplit(newSplitCommand(), []string{"1"}, &splitOptions{fromFile: "does-not-exist.md"})
+		if err == nil || !strings.Contains(err.Error(), "failed to read file does-not-exist.md") {
+			t.Errorf("Expected a read error, got %v", err)
+		}
+	})
+}
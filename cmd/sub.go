@@ -3,11 +3,17 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
 	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
 	"github.com/spf13/cobra"
 )
 
@@ -22,43 +28,71 @@ useful for breaking down epics into smaller tasks.`,
 	}
 
 	cmd.AddCommand(newSubAddCommand())
+	cmd.AddCommand(newSubCloseCommand())
 	cmd.AddCommand(newSubCreateCommand())
+	cmd.AddCommand(newSubGraphCommand())
+	cmd.AddCommand(newSubImportCommand())
 	cmd.AddCommand(newSubListCommand())
+	cmd.AddCommand(newSubMoveCommand())
+	cmd.AddCommand(newSubProgressCommand())
+	cmd.AddCommand(newSubPromoteCommand())
 	cmd.AddCommand(newSubRemoveCommand())
+	cmd.AddCommand(newSubReorderCommand())
 
 	return cmd
 }
 
 type subAddOptions struct {
-	repo string
+	repo     string
+	fromFile string
+	json     bool
+}
+
+// subAddClient defines the interface for API methods used by sub add.
+// This allows for easier testing with mock implementations.
+type subAddClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	AddSubIssue(parentIssueID, childIssueID string) error
 }
 
 func newSubAddCommand() *cobra.Command {
 	opts := &subAddOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "add <parent-issue> <child-issue>",
-		Short: "Link an issue as a sub-issue of another",
-		Long: `Link an existing issue as a sub-issue of a parent issue.
+		Use:   "add <parent-issue> <child-issue>...",
+		Short: "Link issues as sub-issues of another",
+		Long: `Link existing issues as sub-issues of a parent issue.
 
-Both issues must already exist. The child issue will appear as a
-sub-issue under the parent issue in GitHub's UI.
+All issues must already exist. The child issues will appear as
+sub-issues under the parent issue in GitHub's UI.
 
 Accepts issue numbers, references (owner/repo#123), or full GitHub URLs.
 
+Multiple child issues can be given to link in batch, or read from a file
+with --from-file (- for stdin): one or more references per line, with
+blank lines and # comments ignored. Each child is reported on its own;
+one failure does not stop the rest.
+
 Examples:
   gh pmu sub add 10 15        # Link issue #15 as sub-issue of #10
   gh pmu sub add #10 #15      # Same, with # prefix
+  gh pmu sub add 10 15 16 17  # Link multiple sub-issues at once
+  gh pmu sub add 10 --from-file children.txt  # Link issues listed in a file
   gh pmu sub add owner/repo#10 owner/repo#15  # Full references
   gh pmu sub add https://github.com/owner/repo/issues/10 15  # URL for parent
   gh pmu sub add 10 15 --repo owner/repo  # Specify default repository`,
-		Args: cobra.ExactArgs(2),
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 && opts.fromFile == "" {
+				return fmt.Errorf("at least one child issue is required (or use --from-file)")
+			}
 			return runSubAdd(cmd, args, opts)
 		},
 	}
 
 	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Default repository for issues (owner/repo format)")
+	cmd.Flags().StringVarP(&opts.fromFile, "from-file", "f", "", "Read child issues from a file, one or more per line (- for stdin)")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output results as JSON")
 
 	return cmd
 }
@@ -79,16 +113,51 @@ func runSubAdd(cmd *cobra.Command, args []string, opts *subAddOptions) error {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	return runSubAddWithDeps(cmd, args, opts, cfg, api.NewClient())
+}
+
+// subAddChild is a resolved child issue reference
+type subAddChild struct {
+	owner  string
+	repo   string
+	number int
+}
+
+// SubAddResult is the outcome of linking one child issue
+type SubAddResult struct {
+	Issue  string `json:"issue"`
+	Title  string `json:"title,omitempty"`
+	Linked bool   `json:"linked"`
+	Error  string `json:"error,omitempty"`
+}
+
+// SubAddJSONOutput is the JSON output of sub add
+type SubAddJSONOutput struct {
+	Parent  string         `json:"parent"`
+	Linked  int            `json:"linked"`
+	Failed  int            `json:"failed"`
+	Results []SubAddResult `json:"results"`
+}
+
+// runSubAddWithDeps is the testable implementation of sub add
+func runSubAddWithDeps(cmd *cobra.Command, args []string, opts *subAddOptions, cfg *config.Config, client subAddClient) error {
 	// Parse parent issue reference
 	parentOwner, parentRepo, parentNumber, err := parseIssueReference(args[0])
 	if err != nil {
 		return fmt.Errorf("invalid parent issue: %w", err)
 	}
 
-	// Parse child issue reference
-	childOwner, childRepo, childNumber, err := parseIssueReference(args[1])
-	if err != nil {
-		return fmt.Errorf("invalid child issue: %w", err)
+	// Collect child references from arguments and --from-file
+	refs := args[1:]
+	if opts.fromFile != "" {
+		fileRefs, err := readSubAddFile(cmd, opts.fromFile)
+		if err != nil {
+			return err
+		}
+		refs = append(append([]string{}, refs...), fileRefs...)
+	}
+	if len(refs) == 0 {
+		return fmt.Errorf("no child issues given")
 	}
 
 	// Determine default repository (--repo flag takes precedence over config)
@@ -115,16 +184,29 @@ func runSubAdd(cmd *cobra.Command, args []string, opts *subAddOptions) error {
 		parentRepo = defaultRepo
 	}
 
-	if childOwner == "" || childRepo == "" {
-		if defaultOwner == "" || defaultRepo == "" {
-			return fmt.Errorf("no repository specified and none configured (use --repo or configure in .gh-pmu.yml)")
+	// Parse all child references up front, skipping repeats
+	var children []subAddChild
+	seen := make(map[string]bool)
+	for _, ref := range refs {
+		childOwner, childRepo, childNumber, err := parseIssueReference(ref)
+		if err != nil {
+			return fmt.Errorf("invalid child issue %s: %w", ref, err)
+		}
+		if childOwner == "" || childRepo == "" {
+			if defaultOwner == "" || defaultRepo == "" {
+				return fmt.Errorf("no repository specified and none configured (use --repo or configure in .gh-pmu.yml)")
+			}
+			childOwner = defaultOwner
+			childRepo = defaultRepo
 		}
-		childOwner = defaultOwner
-		childRepo = defaultRepo
-	}
 
-	// Create API client
-	client := api.NewClient()
+		key := localstore.Key(childOwner+"/"+childRepo, childNumber)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		children = append(children, subAddChild{owner: childOwner, repo: childRepo, number: childNumber})
+	}
 
 	// Validate parent issue exists
 	parentIssue, err := client.GetIssue(parentOwner, parentRepo, parentNumber)
@@ -132,40 +214,150 @@ func runSubAdd(cmd *cobra.Command, args []string, opts *subAddOptions) error {
 		return fmt.Errorf("failed to get parent issue #%d: %w", parentNumber, err)
 	}
 
+	if len(children) == 1 && !opts.json {
+		return addSingleSubIssue(cmd, client, parentIssue, parentOwner, parentRepo, parentNumber, children[0])
+	}
+
+	// Link each child, reporting failures per item
+	output := SubAddJSONOutput{
+		Parent:  localstore.Key(parentOwner+"/"+parentRepo, parentNumber),
+		Results: []SubAddResult{},
+	}
+	for _, child := range children {
+		result := SubAddResult{Issue: localstore.Key(child.owner+"/"+child.repo, child.number)}
+
+		childIssue, err := client.GetIssue(child.owner, child.repo, child.number)
+		if err != nil {
+			result.Error = fmt.Sprintf("failed to get issue: %v", err)
+		} else {
+			result.Title = childIssue.Title
+			if err := client.AddSubIssue(parentIssue.ID, childIssue.ID); err != nil {
+				result.Error = subAddErrorMessage(err)
+			} else {
+				result.Linked = true
+			}
+		}
+
+		if result.Linked {
+			output.Linked++
+		} else {
+			output.Failed++
+		}
+		output.Results = append(output.Results, result)
+	}
+
+	out := cmd.OutOrStdout()
+	if opts.json {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(output); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(out, "Adding sub-issues to parent #%d: %s\n\n", parentNumber, parentIssue.Title)
+		for i, result := range output.Results {
+			ref := fmt.Sprintf("#%d", children[i].number)
+			if children[i].owner != parentOwner || children[i].repo != parentRepo {
+				ref = result.Issue
+			}
+			if result.Linked {
+				fmt.Fprintf(out, "  ✓ %s: %s\n", ref, result.Title)
+			} else {
+				fmt.Fprintf(out, "  ✗ %s: %s\n", ref, result.Error)
+			}
+		}
+		fmt.Fprintf(out, "\nSummary: %d succeeded, %d failed\n", output.Linked, output.Failed)
+	}
+
+	if output.Failed > 0 && output.Linked == 0 {
+		return fmt.Errorf("all links failed")
+	}
+	return nil
+}
+
+// addSingleSubIssue links one child issue, with detailed output and errors
+func addSingleSubIssue(cmd *cobra.Command, client subAddClient, parentIssue *api.Issue, parentOwner, parentRepo string, parentNumber int, child subAddChild) error {
 	// Validate child issue exists
-	childIssue, err := client.GetIssue(childOwner, childRepo, childNumber)
+	childIssue, err := client.GetIssue(child.owner, child.repo, child.number)
 	if err != nil {
-		return fmt.Errorf("failed to get child issue #%d: %w", childNumber, err)
+		return fmt.Errorf("failed to get child issue #%d: %w", child.number, err)
 	}
 
 	// Add sub-issue link
 	err = client.AddSubIssue(parentIssue.ID, childIssue.ID)
 	if err != nil {
-		// Check if already linked (GitHub returns "duplicate" or "only have one parent" messages)
-		errMsg := strings.ToLower(err.Error())
-		if strings.Contains(errMsg, "duplicate") || strings.Contains(errMsg, "only have one parent") {
-			return fmt.Errorf("issue #%d is already a sub-issue (issues can only have one parent)", childNumber)
+		if isAlreadySubIssueError(err) {
+			return fmt.Errorf("issue #%d is already a sub-issue (issues can only have one parent)", child.number)
 		}
 		return fmt.Errorf("failed to add sub-issue link: %w", err)
 	}
 
 	// Output confirmation - show repo info if cross-repo
-	isCrossRepo := (parentOwner != childOwner || parentRepo != childRepo)
+	out := cmd.OutOrStdout()
+	isCrossRepo := (parentOwner != child.owner || parentRepo != child.repo)
 	if isCrossRepo {
-		fmt.Printf("✓ Linked %s/%s#%d as sub-issue of %s/%s#%d\n",
-			childOwner, childRepo, childNumber,
+		fmt.Fprintf(out, "✓ Linked %s/%s#%d as sub-issue of %s/%s#%d\n",
+			child.owner, child.repo, child.number,
 			parentOwner, parentRepo, parentNumber)
-		fmt.Printf("  Parent: %s (%s/%s)\n", parentIssue.Title, parentOwner, parentRepo)
-		fmt.Printf("  Child:  %s (%s/%s)\n", childIssue.Title, childOwner, childRepo)
+		fmt.Fprintf(out, "  Parent: %s (%s/%s)\n", parentIssue.Title, parentOwner, parentRepo)
+		fmt.Fprintf(out, "  Child:  %s (%s/%s)\n", childIssue.Title, child.owner, child.repo)
 	} else {
-		fmt.Printf("✓ Linked issue #%d as sub-issue of #%d\n", childNumber, parentNumber)
-		fmt.Printf("  Parent: %s\n", parentIssue.Title)
-		fmt.Printf("  Child:  %s\n", childIssue.Title)
+		fmt.Fprintf(out, "✓ Linked issue #%d as sub-issue of #%d\n", child.number, parentNumber)
+		fmt.Fprintf(out, "  Parent: %s\n", parentIssue.Title)
+		fmt.Fprintf(out, "  Child:  %s\n", childIssue.Title)
 	}
 
 	return nil
 }
 
+// isAlreadySubIssueError reports whether GitHub rejected a link because the
+// issue already has a parent ("duplicate" or "only have one parent")
+func isAlreadySubIssueError(err error) bool {
+	errMsg := strings.ToLower(err.Error())
+	return strings.Contains(errMsg, "duplicate") || strings.Contains(errMsg, "only have one parent")
+}
+
+// subAddErrorMessage returns the per-item message for a failed link
+func subAddErrorMessage(err error) string {
+	if isAlreadySubIssueError(err) {
+		return "already a sub-issue (issues can only have one parent)"
+	}
+	return fmt.Sprintf("failed to add sub-issue link: %v", err)
+}
+
+// readSubAddFile reads child issue references from a file, or stdin for
+// "-". References are separated by whitespace or commas; blank lines and
+// comments (a # not followed by an issue number) are ignored.
+func readSubAddFile(cmd *cobra.Command, path string) ([]string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read child issues from stdin: %w", err)
+		}
+	} else {
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file %s: %w", path, err)
+		}
+	}
+
+	var refs []string
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.FieldsFunc(line, func(r rune) bool { return r == ',' || unicode.IsSpace(r) })
+		for _, f := range fields {
+			if strings.HasPrefix(f, "#") {
+				if _, err := strconv.Atoi(f[1:]); err != nil {
+					break
+				}
+			}
+			refs = append(refs, f)
+		}
+	}
+	return refs, nil
+}
+
 type subCreateOptions struct {
 	parent           string
 	title            string
@@ -175,11 +367,29 @@ type subCreateOptions struct {
 	assignees        []string
 	milestone        string
 	project          int
+	fields           []string
+	noInherit        bool
 	inheritLabels    bool
 	inheritAssign    bool
 	inheritMilestone bool
 }
 
+// defaultSubCreateInherit is what sub create copies from the parent when
+// defaults.inherit is not configured
+var defaultSubCreateInherit = []string{"labels", "milestone", "iteration", "priority"}
+
+// subCreateClient defines the interface for API methods used by sub create.
+// This allows for easier testing with mock implementations.
+type subCreateClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error)
+	AddSubIssue(parentIssueID, childIssueID string) error
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	AddIssueToProject(projectID, issueID string) (string, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
 func newSubCreateCommand() *cobra.Command {
 	opts := &subCreateOptions{
 		inheritLabels:    true,
@@ -195,13 +405,20 @@ func newSubCreateCommand() *cobra.Command {
 By default, the new issue is created in the same repository as the parent.
 Use --repo to create the sub-issue in a different repository.
 
-By default, the new issue inherits labels and milestone from the parent
-(only when created in the same repository).
+By default, the new issue inherits the parent's labels and milestone (only
+when created in the same repository) and its iteration and priority in the
+project. Configure what is inherited with defaults.inherit in .gh-pmu.yml:
+labels, milestone, assignees, or project fields by alias or name. Use
+--no-inherit to inherit nothing, the --inherit-* flags to switch labels,
+milestone, or assignees on or off, and --field to set a project field
+instead of inheriting it.
 
 Examples:
   gh pmu sub create --parent 10 --title "Implement feature X"
   gh pmu sub create --parent #10 --title "Task" --body "Description"
   gh pmu sub create -p 10 -t "Task" --no-inherit-labels
+  gh pmu sub create -p 10 -t "Spike" --field priority=p0 --field iteration=next
+  gh pmu sub create -p 10 -t "Task" --no-inherit
   gh pmu sub create --parent owner/repo1#10 --repo owner/repo2 --title "Cross-repo task"`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runSubCreate(cmd, opts)
@@ -216,6 +433,8 @@ Examples:
 	cmd.Flags().StringArrayVarP(&opts.assignees, "assignee", "a", nil, "Assign users to the sub-issue (can be specified multiple times)")
 	cmd.Flags().StringVarP(&opts.milestone, "milestone", "m", "", "Set milestone (title or number)")
 	cmd.Flags().IntVar(&opts.project, "project", 0, "Add to project (project number)")
+	cmd.Flags().StringArrayVar(&opts.fields, "field", nil, "Set a project field as key=value instead of inheriting it (can be specified multiple times)")
+	cmd.Flags().BoolVar(&opts.noInherit, "no-inherit", false, "Inherit nothing from the parent")
 	cmd.Flags().BoolVar(&opts.inheritLabels, "inherit-labels", true, "Inherit labels from parent (same repo only)")
 	cmd.Flags().BoolVar(&opts.inheritAssign, "inherit-assignees", false, "Inherit assignees from parent (same repo only)")
 	cmd.Flags().BoolVar(&opts.inheritMilestone, "inherit-milestone", true, "Inherit milestone from parent (same repo only)")
@@ -242,6 +461,35 @@ func runSubCreate(cmd *cobra.Command, opts *subCreateOptions) error {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
+	return runSubCreateWithDeps(cmd, opts, cfg, api.NewClient(), time.Now())
+}
+
+// subCreateInherit returns what the new sub-issue inherits, lowercased:
+// defaults.inherit or the default set, less everything with --no-inherit,
+// then adjusted by the --inherit-* flags given explicitly
+func subCreateInherit(cmd *cobra.Command, opts *subCreateOptions, cfg *config.Config) map[string]bool {
+	inherit := make(map[string]bool)
+	if !opts.noInherit {
+		keys := defaultSubCreateInherit
+		if cfg.Defaults.Inherit != nil {
+			keys = cfg.Defaults.Inherit
+		}
+		for _, k := range keys {
+			inherit[strings.ToLower(strings.TrimSpace(k))] = true
+		}
+	}
+
+	for flag, key := range map[string]string{"inherit-labels": "labels", "inherit-assignees": "assignees", "inherit-milestone": "milestone"} {
+		if cmd.Flags().Changed(flag) {
+			value, _ := cmd.Flags().GetBool(flag)
+			inherit[key] = value
+		}
+	}
+	return inherit
+}
+
+// runSubCreateWithDeps is the testable implementation of sub create
+func runSubCreateWithDeps(cmd *cobra.Command, opts *subCreateOptions, cfg *config.Config, client subCreateClient, now time.Time) error {
 	// Parse parent issue reference
 	parentOwner, parentRepo, parentNumber, err := parseIssueReference(opts.parent)
 	if err != nil {
@@ -277,8 +525,12 @@ func runSubCreate(cmd *cobra.Command, opts *subCreateOptions) error {
 		isCrossRepo = (targetOwner != parentOwner || targetRepo != parentRepo)
 	}
 
-	// Create API client
-	client := api.NewClient()
+	// Explicit field values replace inherited ones
+	fields, err := resolveCreateFields(opts.fields, cfg, now)
+	if err != nil {
+		return err
+	}
+	inherit := subCreateInherit(cmd, opts, cfg)
 
 	// Get parent issue to validate and optionally inherit from
 	parentIssue, err := client.GetIssue(parentOwner, parentRepo, parentNumber)
@@ -286,98 +538,173 @@ func runSubCreate(cmd *cobra.Command, opts *subCreateOptions) error {
 		return fmt.Errorf("failed to get parent issue #%d: %w", parentNumber, err)
 	}
 
-	// Build labels list
-	var labels []string
-	// Add explicitly specified labels first
-	labels = append(labels, opts.labels...)
-	// Then add inherited labels if same repo
-	if !isCrossRepo && opts.inheritLabels && len(parentIssue.Labels) > 0 {
-		for _, l := range parentIssue.Labels {
-			// Avoid duplicates
-			isDupe := false
-			for _, existing := range labels {
-				if existing == l.Name {
-					isDupe = true
-					break
+	// Build labels list: explicitly specified labels first, then inherited
+	// labels if same repo
+	labels := append([]string{}, opts.labels...)
+	if !isCrossRepo && inherit["labels"] {
+		labels = inheritParentLabels(labels, parentIssue)
+	}
+	milestone := opts.milestone
+	if !isCrossRepo && inherit["milestone"] && milestone == "" && parentIssue.Milestone != nil {
+		milestone = parentIssue.Milestone.Title
+	}
+	assignees := opts.assignees
+	if !isCrossRepo && inherit["assignees"] && len(assignees) == 0 {
+		assignees = actorLogins(parentIssue.Assignees)
+	}
+
+	// Project fields to inherit; the rest of inherit is issue attributes
+	projectNumber := opts.project
+	if projectNumber == 0 {
+		projectNumber = cfg.Project.Number
+	}
+	var project *api.Project
+	var inherited []createFieldValue
+	if inheritFields := subCreateInheritFields(cfg, inherit, fields); len(inheritFields) > 0 || len(fields) > 0 || opts.project > 0 {
+		project, err = client.GetProject(cfg.Project.Owner, projectNumber)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to find project %d: %v\n", projectNumber, err)
+		} else if len(inheritFields) > 0 {
+			item, err := client.GetIssueProjectItem(parentOwner, parentRepo, parentNumber, project.ID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: failed to read the parent's project fields: %v\n", err)
+			} else if item != nil {
+				// Keep the project's spelling of each field name
+				for _, fv := range item.FieldValues {
+					if containsFold(inheritFields, fv.Field) && fv.Value != "" {
+						inherited = append(inherited, createFieldValue{Field: fv.Field, Value: fv.Value})
+					}
 				}
 			}
-			if !isDupe {
-				labels = append(labels, l.Name)
-			}
 		}
 	}
 
 	// Create the new issue in target repository with extended options
-	newIssue, err := client.CreateIssueWithOptions(targetOwner, targetRepo, opts.title, opts.body, labels, opts.assignees, opts.milestone)
+	newIssue, err := client.CreateIssueWithOptions(targetOwner, targetRepo, opts.title, opts.body, labels, assignees, milestone)
 	if err != nil {
 		return fmt.Errorf("failed to create issue in %s/%s: %w", targetOwner, targetRepo, err)
 	}
 
+	out := cmd.OutOrStdout()
+
 	// Link as sub-issue
 	err = client.AddSubIssue(parentIssue.ID, newIssue.ID)
 	if err != nil {
 		// Issue was created but linking failed - inform user
 		fmt.Fprintf(os.Stderr, "Warning: Issue created but failed to link as sub-issue: %v\n", err)
-		fmt.Printf("Created issue #%d: %s\n", newIssue.Number, newIssue.Title)
-		fmt.Printf("%s\n", newIssue.URL)
+		fmt.Fprintf(out, "Created issue #%d: %s\n", newIssue.Number, newIssue.Title)
+		fmt.Fprintf(out, "%s\n", newIssue.URL)
 		return nil
 	}
 
-	// Add to project if specified
-	if opts.project > 0 {
-		project, err := client.GetProject(cfg.Project.Owner, opts.project)
+	// Add to project when asked, or to set inherited and explicit fields
+	var applied []createFieldValue
+	if project != nil && (opts.project > 0 || len(inherited) > 0 || len(fields) > 0) {
+		itemID, err := client.AddIssueToProject(project.ID, newIssue.ID)
 		if err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: failed to find project %d: %v\n", opts.project, err)
+			fmt.Fprintf(os.Stderr, "Warning: failed to add issue to project: %v\n", err)
 		} else {
-			_, err := client.AddIssueToProject(project.ID, newIssue.ID)
-			if err != nil {
-				fmt.Fprintf(os.Stderr, "Warning: failed to add issue to project: %v\n", err)
+			for _, f := range append(inherited, fields...) {
+				if err := client.SetProjectItemField(project.ID, itemID, f.Field, f.Value); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to set %s: %v\n", f.Field, err)
+					continue
+				}
+				applied = append(applied, f)
 			}
 		}
 	}
 
 	// Output confirmation
 	if isCrossRepo {
-		fmt.Printf("✓ Created cross-repo sub-issue %s/%s#%d under parent %s/%s#%d\n",
+		fmt.Fprintf(out, "✓ Created cross-repo sub-issue %s/%s#%d under parent %s/%s#%d\n",
 			targetOwner, targetRepo, newIssue.Number,
 			parentOwner, parentRepo, parentNumber)
 	} else {
-		fmt.Printf("✓ Created sub-issue #%d under parent #%d\n", newIssue.Number, parentNumber)
+		fmt.Fprintf(out, "✓ Created sub-issue #%d under parent #%d\n", newIssue.Number, parentNumber)
 	}
-	fmt.Printf("  Title:  %s\n", newIssue.Title)
-	fmt.Printf("  Parent: %s\n", parentIssue.Title)
+	fmt.Fprintf(out, "  Title:  %s\n", newIssue.Title)
+	fmt.Fprintf(out, "  Parent: %s\n", parentIssue.Title)
 	if isCrossRepo {
-		fmt.Printf("  Repo:   %s/%s\n", targetOwner, targetRepo)
+		fmt.Fprintf(out, "  Repo:   %s/%s\n", targetOwner, targetRepo)
 	}
 	if len(labels) > 0 {
-		fmt.Printf("  Labels: %s\n", strings.Join(labels, ", "))
+		fmt.Fprintf(out, "  Labels: %s\n", strings.Join(labels, ", "))
+	}
+	if len(assignees) > 0 {
+		fmt.Fprintf(out, "  Assignees: @%s\n", strings.Join(assignees, ", @"))
 	}
-	if len(opts.assignees) > 0 {
-		fmt.Printf("  Assignees: @%s\n", strings.Join(opts.assignees, ", @"))
+	if milestone != "" {
+		fmt.Fprintf(out, "  Milestone: %s\n", milestone)
 	}
-	if opts.milestone != "" {
-		fmt.Printf("  Milestone: %s\n", opts.milestone)
+	for _, f := range applied {
+		fmt.Fprintf(out, "  %s: %s\n", f.Field, f.Value)
 	}
 	if opts.project > 0 {
-		fmt.Printf("  Project: #%d\n", opts.project)
+		fmt.Fprintf(out, "  Project: #%d\n", opts.project)
 	}
-	fmt.Printf("🔗 %s\n", newIssue.URL)
+	fmt.Fprintf(out, "🔗 %s\n", newIssue.URL)
 
 	return nil
 }
 
+// subCreateInheritFields returns the project fields to copy from the parent:
+// the inherited keys that are not issue attributes, resolved to field names,
+// less the fields set explicitly with --field
+func subCreateInheritFields(cfg *config.Config, inherit map[string]bool, explicit []createFieldValue) []string {
+	return inheritFieldNames(cfg, inherit, explicit, cfg.Defaults.Inherit != nil)
+}
+
+// inheritFieldNames resolves the inherited keys that are not issue
+// attributes to project field names, less the explicit fields. warn
+// reports keys that name no field, which only matters for a configured
+// set; the default sets name fields a project may not have.
+func inheritFieldNames(cfg *config.Config, inherit map[string]bool, explicit []createFieldValue, warn bool) []string {
+	keys := make([]string, 0, len(inherit))
+	for key, on := range inherit {
+		if on && key != "labels" && key != "milestone" && key != "assignees" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var names []string
+	for _, key := range keys {
+		name, ok := resolveProjectFieldName(cfg, key)
+		if !ok {
+			if warn {
+				fmt.Fprintf(os.Stderr, "Warning: cannot inherit %q: not a configured alias or a field in the project metadata\n", key)
+			}
+			continue
+		}
+		overridden := false
+		for _, f := range explicit {
+			if strings.EqualFold(f.Field, name) {
+				overridden = true
+				break
+			}
+		}
+		if !overridden && !containsFold(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 type subListOptions struct {
 	json     bool
 	state    string
 	limit    int
 	web      bool
 	relation string
+	tree     bool
+	depth    int
 }
 
 func newSubListCommand() *cobra.Command {
 	opts := &subListOptions{
 		state:    "all",
 		relation: "children",
+		depth:    10,
 	}
 
 	cmd := &cobra.Command{
@@ -391,6 +718,13 @@ Use --relation to show parent, siblings, or all related issues.
 Displays the title, state, and assignee for each sub-issue,
 along with a completion count.
 
+With --tree, the whole hierarchy below the issue is shown as an indented
+tree: grandchildren and deeper, down to --depth levels. Each issue has a
+glyph for its state (✓ closed, ○ open) and each branch the percentage of
+its descendants that are closed. --json then nests each issue's children
+and progress. --state hides issues that neither match nor have a
+descendant that does, without changing the percentages.
+
 Examples:
   gh pmu sub list 10              # List sub-issues of issue #10
   gh pmu sub list #10             # Same, with # prefix
@@ -400,7 +734,9 @@ Examples:
   gh pmu sub list 10 --web        # Open parent issue in browser
   gh pmu sub list 10 --relation parent    # Show parent issue
   gh pmu sub list 10 --relation siblings  # Show sibling issues
-  gh pmu sub list 10 --relation all       # Show parent, siblings, and children`,
+  gh pmu sub list 10 --relation all       # Show parent, siblings, and children
+  gh pmu sub list 10 --tree               # Show the full hierarchy
+  gh pmu sub list 10 --tree --depth 2     # Children and grandchildren only`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runSubList(cmd, args, opts)
@@ -412,6 +748,8 @@ Examples:
 	cmd.Flags().IntVarP(&opts.limit, "limit", "n", 0, "Maximum number of items to display (0 for no limit)")
 	cmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open issue in browser")
 	cmd.Flags().StringVar(&opts.relation, "relation", "children", "Relation to show: children, parent, siblings, all")
+	cmd.Flags().BoolVar(&opts.tree, "tree", false, "Show the full hierarchy as a tree with branch completion")
+	cmd.Flags().IntVar(&opts.depth, "depth", 10, "Maximum depth for --tree")
 
 	return cmd
 }
@@ -428,6 +766,12 @@ func runSubList(cmd *cobra.Command, args []string, opts *subListOptions) error {
 	if opts.relation != "children" && opts.relation != "parent" && opts.relation != "siblings" && opts.relation != "all" {
 		return fmt.Errorf("invalid relation: %s (must be children, parent, siblings, or all)", opts.relation)
 	}
+	if opts.tree && opts.relation != "children" {
+		return fmt.Errorf("--tree cannot be used with --relation %s", opts.relation)
+	}
+	if opts.tree && opts.depth < 1 {
+		return fmt.Errorf("--depth must be at least 1")
+	}
 
 	// Load configuration
 	cwd, err := os.Getwd()
@@ -477,6 +821,25 @@ func runSubList(cmd *cobra.Command, args []string, opts *subListOptions) error {
 		return openViewInBrowser(issue.URL)
 	}
 
+	if opts.tree {
+		if issue.Repository.Owner == "" || issue.Repository.Name == "" {
+			issue.Repository = api.Repository{Owner: issueOwner, Name: issueRepo}
+		}
+		root, err := buildSubTree(client, issue, opts.depth)
+		if err != nil {
+			return err
+		}
+		filterSubTree(root, opts.state)
+		if opts.limit > 0 && len(root.Children) > opts.limit {
+			root.Children = root.Children[:opts.limit]
+		}
+		if opts.json {
+			return writeSubTreeJSON(os.Stdout, root)
+		}
+		writeSubTree(os.Stdout, root)
+		return nil
+	}
+
 	// Build the result based on relation
 	var result SubListResult
 	result.Issue = issue
@@ -853,176 +1216,3 @@ func printSubIssueList(subIssues []api.SubIssue, referenceIssue *api.Issue) {
 		}
 	}
 }
-
-type subRemoveOptions struct {
-	force bool
-}
-
-func newSubRemoveCommand() *cobra.Command {
-	opts := &subRemoveOptions{}
-
-	cmd := &cobra.Command{
-		Use:   "remove <parent-issue> <child-issue>...",
-		Short: "Remove sub-issue links from a parent issue",
-		Long: `Remove the sub-issue relationship between a parent and one or more child issues.
-
-This does NOT delete the child issues, only removes the parent-child links.
-The child issues will become standalone issues again.
-
-Multiple child issues can be specified to remove in batch.
-
-Examples:
-  gh pmu sub remove 10 15           # Unlink issue #15 from parent #10
-  gh pmu sub remove #10 #15         # Same, with # prefix
-  gh pmu sub remove 10 15 16 17     # Unlink multiple sub-issues at once
-  gh pmu sub remove 10 15 --force   # Skip any confirmation prompts
-  gh pmu sub remove owner/repo#10 owner/repo#15  # Full references`,
-		Args: cobra.MinimumNArgs(2),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			return runSubRemove(cmd, args, opts)
-		},
-	}
-
-	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "Skip confirmation prompts")
-
-	return cmd
-}
-
-func runSubRemove(cmd *cobra.Command, args []string, opts *subRemoveOptions) error {
-	// Load configuration
-	cwd, err := os.Getwd()
-	if err != nil {
-		return fmt.Errorf("failed to get current directory: %w", err)
-	}
-
-	cfg, err := config.LoadFromDirectory(cwd)
-	if err != nil {
-		return fmt.Errorf("failed to load configuration: %w\nRun 'gh pmu init' to create a configuration file", err)
-	}
-
-	if err := cfg.Validate(); err != nil {
-		return fmt.Errorf("invalid configuration: %w", err)
-	}
-
-	// Parse parent issue reference
-	parentOwner, parentRepo, parentNumber, err := parseIssueReference(args[0])
-	if err != nil {
-		return fmt.Errorf("invalid parent issue: %w", err)
-	}
-
-	// Default to configured repo if not specified for parent
-	defaultOwner, defaultRepo := "", ""
-	if len(cfg.Repositories) > 0 {
-		parts := strings.Split(cfg.Repositories[0], "/")
-		if len(parts) == 2 {
-			defaultOwner, defaultRepo = parts[0], parts[1]
-		}
-	}
-
-	if parentOwner == "" || parentRepo == "" {
-		if defaultOwner == "" || defaultRepo == "" {
-			return fmt.Errorf("no repository specified and none configured")
-		}
-		parentOwner = defaultOwner
-		parentRepo = defaultRepo
-	}
-
-	// Create API client
-	client := api.NewClient()
-
-	// Validate parent issue exists
-	parentIssue, err := client.GetIssue(parentOwner, parentRepo, parentNumber)
-	if err != nil {
-		return fmt.Errorf("failed to get parent issue #%d: %w", parentNumber, err)
-	}
-
-	// Parse all child issue references (args[1:])
-	type childRef struct {
-		owner  string
-		repo   string
-		number int
-	}
-	var children []childRef
-
-	for i := 1; i < len(args); i++ {
-		childOwner, childRepo, childNumber, err := parseIssueReference(args[i])
-		if err != nil {
-			return fmt.Errorf("invalid child issue %s: %w", args[i], err)
-		}
-
-		// Default to configured repo if not specified
-		if childOwner == "" || childRepo == "" {
-			if defaultOwner == "" || defaultRepo == "" {
-				return fmt.Errorf("no repository specified for issue %s and none configured", args[i])
-			}
-			childOwner = defaultOwner
-			childRepo = defaultRepo
-		}
-
-		children = append(children, childRef{
-			owner:  childOwner,
-			repo:   childRepo,
-			number: childNumber,
-		})
-	}
-
-	// Track results for batch operations
-	var successCount, failCount int
-	var results []string
-
-	// Process each child issue
-	for _, child := range children {
-		// Get child issue
-		childIssue, err := client.GetIssue(child.owner, child.repo, child.number)
-		if err != nil {
-			failCount++
-			results = append(results, fmt.Sprintf("✗ #%d: failed to get issue: %v", child.number, err))
-			continue
-		}
-
-		// Remove sub-issue link
-		err = client.RemoveSubIssue(parentIssue.ID, childIssue.ID)
-		if err != nil {
-			failCount++
-			// Check if not linked
-			errMsg := strings.ToLower(err.Error())
-			if strings.Contains(errMsg, "not a sub-issue") || strings.Contains(errMsg, "not found") {
-				results = append(results, fmt.Sprintf("✗ #%d: not a sub-issue of #%d", child.number, parentNumber))
-			} else {
-				results = append(results, fmt.Sprintf("✗ #%d: %v", child.number, err))
-			}
-			continue
-		}
-
-		successCount++
-		results = append(results, fmt.Sprintf("✓ #%d: %s", child.number, childIssue.Title))
-	}
-
-	// Output results
-	if len(children) == 1 {
-		// Single child - use simple output format
-		if successCount == 1 {
-			fmt.Printf("✓ Removed sub-issue link: #%d is no longer a sub-issue of #%d\n", children[0].number, parentNumber)
-			fmt.Printf("  Former parent: %s\n", parentIssue.Title)
-		} else {
-			// Print the failure message
-			for _, r := range results {
-				fmt.Println(r)
-			}
-			return fmt.Errorf("failed to remove sub-issue")
-		}
-	} else {
-		// Multiple children - use batch output format
-		fmt.Printf("Removing sub-issues from parent #%d: %s\n\n", parentNumber, parentIssue.Title)
-		for _, r := range results {
-			fmt.Println("  " + r)
-		}
-		fmt.Printf("\nSummary: %d succeeded, %d failed\n", successCount, failCount)
-
-		if failCount > 0 && successCount == 0 {
-			return fmt.Errorf("all removals failed")
-		}
-	}
-
-	return nil
-}
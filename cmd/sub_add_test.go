@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubAddClient implements subAddClient interface for testing
+type mockSubAddClient struct {
+	missing map[int]bool   // issues that do not exist
+	linkErr map[int]string // AddSubIssue errors by child number
+	linked  []string
+}
+
+func (m *mockSubAddClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	if m.missing[number] {
+		return nil, errors.New("not found")
+	}
+	return &api.Issue{ID: fmt.Sprintf("%s/%s#%d", owner, repo, number), Number: number, Title: fmt.Sprintf("Issue %d", number)}, nil
+}
+
+func (m *mockSubAddClient) AddSubIssue(parentIssueID, childIssueID string) error {
+	var number int
+	fmt.Sscanf(childIssueID[strings.Index(childIssueID, "#")+1:], "%d", &number)
+	if msg, ok := m.linkErr[number]; ok {
+		return errors.New(msg)
+	}
+	m.linked = append(m.linked, childIssueID)
+	return nil
+}
+
+func TestRunSubAdd_Single(t *testing.T) {
+	client := &mockSubAddClient{}
+	cmd, buf := newTestCmd()
+	if err := runSubAddWithDeps(cmd, []string{"10", "15"}, &subAddOptions{}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubAddWithDeps() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "✓ Linked issue #15 as sub-issue of #10") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunSubAdd_SingleAlreadyLinked(t *testing.T) {
+	client := &mockSubAddClient{linkErr: map[int]string{15: "Issue may only have one parent"}}
+	cmd, _ := newTestCmd()
+	err := runSubAddWithDeps(cmd, []string{"10", "15"}, &subAddOptions{}, newTestConfig(), client)
+	if err == nil || !strings.Contains(err.Error(), "already a sub-issue") {
+		t.Errorf("Expected an already-linked error, got %v", err)
+	}
+}
+
+func TestRunSubAdd_BatchReportsEachItem(t *testing.T) {
+	client := &mockSubAddClient{
+		missing: map[int]bool{16: true},
+		linkErr: map[int]string{17: "duplicate sub-issue"},
+	}
+	cmd, buf := newTestCmd()
+	err := runSubAddWithDeps(cmd, []string{"10", "15", "16", "17", "other/lib#5", "#15"}, &subAddOptions{}, newTestConfig(), client)
+	if err != nil {
+		t.Fatalf("Expected partial failure not to be an error, got %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"  ✓ #15: Issue 15",
+		"  ✗ #16: failed to get issue",
+		"  ✗ #17: already a sub-issue",
+		"  ✓ other/lib#5: Issue 5",
+		"Summary: 2 succeeded, 2 failed",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+	if len(client.linked) != 2 {
+		t.Errorf("Expected the repeated #15 to be linked once, got %v", client.linked)
+	}
+}
+
+func TestRunSubAdd_BatchAllFailed(t *testing.T) {
+	client := &mockSubAddClient{missing: map[int]bool{15: true, 16: true}}
+	cmd, _ := newTestCmd()
+	if err := runSubAddWithDeps(cmd, []string{"10", "15", "16"}, &subAddOptions{}, newTestConfig(), client); err == nil {
+		t.Error("Expected an error when every link fails")
+	}
+}
+
+func TestRunSubAdd_FromFileJSON(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "children.txt")
+	content := "# children of the epic\n15\n\n#16, owner/repo#17  # follow-up\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	client := &mockSubAddClient{linkErr: map[int]string{17: "boom"}}
+	cmd, buf := newTestCmd()
+	if err := runSubAddWithDeps(cmd, []string{"10"}, &subAddOptions{fromFile: path, json: true}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubAddWithDeps() error = %v", err)
+	}
+
+	var output SubAddJSONOutput
+	if err := json.Unmarshal(buf.Bytes(), &output); err != nil {
+		t.Fatalf("Invalid JSON: %v\n%s", err, buf.String())
+	}
+	if output.Parent != "owner/repo#10" || output.Linked != 2 || output.Failed != 1 || len(output.Results) != 3 {
+		t.Fatalf("Unexpected output: %+v", output)
+	}
+	if output.Results[2].Issue != "owner/repo#17" || output.Results[2].Linked || !strings.Contains(output.Results[2].Error, "boom") {
+		t.Errorf("Unexpected failed result: %+v", output.Results[2])
+	}
+}
+
+func TestRunSubAdd_FromStdin(t *testing.T) {
+	client := &mockSubAddClient{}
+	cmd, _ := newTestCmd()
+	cmd.SetIn(strings.NewReader("15 16\n"))
+	if err := runSubAddWithDeps(cmd, []string{"10"}, &subAddOptions{fromFile: "-"}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubAddWithDeps() error = %v", err)
+	}
+	if len(client.linked) != 2 {
+		t.Errorf("Expected 2 links, got %v", client.linked)
+	}
+}
+
+func TestRunSubAdd_InvalidChildLinksNothing(t *testing.T) {
+	client := &mockSubAddClient{}
+	cmd, _ := newTestCmd()
+	err := runSubAddWithDeps(cmd, []string{"10", "15", "abc"}, &subAddOptions{}, newTestConfig(), client)
+	if err == nil || !strings.Contains(err.Error(), "invalid child issue abc") {
+		t.Errorf("Expected an invalid child error, got %v", err)
+	}
+	if len(client.linked) != 0 {
+		t.Errorf("Expected nothing linked, got %v", client.linked)
+	}
+}
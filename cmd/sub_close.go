@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/spf13/cobra"
+)
+
+type subCloseOptions struct {
+	cascade  bool
+	statuses []string
+	comment  string
+	depth    int
+	dryRun   bool
+	yes      bool
+}
+
+// subCloseClient defines the interface for API methods used by sub close.
+// This allows for easier testing with mock implementations.
+type subCloseClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+	AddIssueComment(issueID, body string) (string, error)
+	CloseIssue(issueID string) error
+}
+
+func newSubCloseCommand() *cobra.Command {
+	opts := &subCloseOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "close <parent-issue>",
+		Short: "Close a parent issue and its open sub-issues",
+		Long: `Close a parent issue, moving it to the done status.
+
+With --cascade, its open sub-issues at every depth are closed and moved
+to done as well, deepest first, so an abandoned epic is closed in one
+step. --status closes only the sub-issues in the given statuses and
+leaves the others open. Without --cascade, a parent with open sub-issues
+is not closed.
+
+--comment is posted on each issue before it is closed. If a sub-issue
+cannot be closed, every issue above it is left open, so no open
+sub-issue ends up under a closed parent. You are asked to confirm
+before sub-issues are closed; --yes skips the prompt.`,
+		Example: `  gh pmu sub close 10 --cascade
+  gh pmu sub close 10 --cascade --comment "Superseded by #42" --yes
+
+  # Close only the sub-issues that were never started
+  gh pmu sub close 10 --cascade --status backlog,ready --dry-run`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			err = runSubCloseWithDeps(cmd, args, opts, cfg, api.NewClient())
+			refreshDaemon(cfg)
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.cascade, "cascade", false, "Also close the parent's open sub-issues at every depth")
+	cmd.Flags().StringSliceVar(&opts.statuses, "status", nil, "With --cascade, close only sub-issues in these statuses")
+	cmd.Flags().StringVar(&opts.comment, "comment", "", "Comment to post on each issue before it is closed")
+	cmd.Flags().IntVar(&opts.depth, "depth", 10, "Maximum depth of sub-issues to close")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show the issues that would be closed without closing them")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt")
+
+	return cmd
+}
+
+// runSubCloseWithDeps is the testable implementation of sub close
+func runSubCloseWithDeps(cmd *cobra.Command, args []string, opts *subCloseOptions, cfg *config.Config, client subCloseClient) error {
+	if len(opts.statuses) > 0 && !opts.cascade {
+		return fmt.Errorf("--status requires --cascade")
+	}
+	if opts.depth < 1 {
+		return fmt.Errorf("--depth must be at least 1")
+	}
+
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	owner, repo, number, _ := parseIssueReference(key)
+
+	parent, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue #%d: %w", number, err)
+	}
+	if parent.Repository.Owner != "" && parent.Repository.Name != "" {
+		// As the project spells it, to match its items
+		owner, repo = parent.Repository.Owner, parent.Repository.Name
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	itemIDMap := make(map[string]string) // "owner/repo#number" -> itemID
+	statuses := make(map[string]string)  // "owner/repo#number" -> status
+	statusField := cfg.GetFieldName("status")
+	for _, item := range items {
+		if item.Issue != nil {
+			k := itemKey(item)
+			itemIDMap[k] = item.ID
+			statuses[k] = getFieldValue(item, statusField)
+		}
+	}
+
+	descendants, err := collectSubIssuesRecursive(client, owner, repo, number, itemIDMap, 1, opts.depth)
+	if err != nil {
+		return fmt.Errorf("failed to collect sub-issues: %w", err)
+	}
+
+	// The parent of each sub-issue, by key, from the depth-first order
+	rootKey := localstore.Key(owner+"/"+repo, number)
+	parents := make(map[string]string)
+	path := []string{rootKey}
+	for _, info := range descendants {
+		path = append(path[:info.Depth], localstore.Key(info.Owner+"/"+info.Repo, info.Number))
+		parents[path[info.Depth]] = path[info.Depth-1]
+	}
+
+	var targets []issueInfo
+	left := 0 // open sub-issues not closed because of --status
+	for _, info := range descendants {
+		if strings.EqualFold(info.State, "CLOSED") {
+			continue
+		}
+		if !opts.cascade {
+			left++
+			continue
+		}
+		if len(opts.statuses) > 0 && !subCloseStatusMatches(cfg, opts.statuses, statuses[localstore.Key(info.Owner+"/"+info.Repo, info.Number)]) {
+			left++
+			continue
+		}
+		targets = append(targets, info)
+	}
+	if !opts.cascade && left > 0 {
+		return fmt.Errorf("#%d has %d open sub-issue(s); use --cascade to close them too", number, left)
+	}
+
+	// Sub-issues are closed deepest first, and the parent last
+	for i, j := 0, len(targets)-1; i < j; i, j = i+1, j-1 {
+		targets[i], targets[j] = targets[j], targets[i]
+	}
+	if !strings.EqualFold(parent.State, "CLOSED") {
+		targets = append(targets, issueInfo{
+			Owner:  owner,
+			Repo:   repo,
+			Number: number,
+			Title:  parent.Title,
+			ItemID: itemIDMap[localstore.Key(owner+"/"+repo, number)],
+			ID:     parent.ID,
+			State:  parent.State,
+		})
+	}
+	if len(targets) == 0 {
+		cmd.Printf("#%d and its sub-issues are already closed\n", number)
+		return nil
+	}
+
+	if opts.dryRun {
+		cmd.Println("Dry run - no changes will be made")
+		cmd.Println()
+	}
+	cmd.Printf("Issues to close (%d):\n", len(targets))
+	for i := len(targets) - 1; i >= 0; i-- {
+		info := targets[i]
+		cmd.Printf("%s• #%d - %s\n", strings.Repeat("  ", info.Depth), info.Number, info.Title)
+	}
+	if left > 0 {
+		cmd.Printf("\n%d open sub-issue(s) not matching --status are left open\n", left)
+	}
+
+	if opts.dryRun {
+		return nil
+	}
+
+	if len(targets) > 1 && !opts.yes {
+		cmd.Printf("\nProceed with closing %d issues? [y/N]: ", len(targets))
+		var response string
+		_, _ = fmt.Fscanln(cmd.InOrStdin(), &response)
+		response = strings.ToLower(strings.TrimSpace(response))
+		if response != "y" && response != "yes" {
+			cmd.Println("Aborted.")
+			return nil
+		}
+	}
+	cmd.Println()
+
+	// An issue is left open while any issue below it is, so no open
+	// sub-issue is left under a closed parent
+	doneStatus := cfg.ResolveFieldValue("status", "done")
+	closed, failed, keptOpen := 0, 0, 0
+	openBelow := make(map[string]int) // key -> open sub-issues at any depth below
+	leftOpen := func(key string) {
+		for p, ok := parents[key]; ok; p, ok = parents[p] {
+			openBelow[p]++
+		}
+	}
+	for _, info := range targets {
+		key := localstore.Key(info.Owner+"/"+info.Repo, info.Number)
+		if n := openBelow[key]; n > 0 {
+			fmt.Fprintf(os.Stderr, "Warning: #%d left open because %d sub-issue(s) could not be closed\n", info.Number, n)
+			keptOpen++
+			leftOpen(key)
+			continue
+		}
+		if err := closeSubIssue(client, project.ID, info, statusField, doneStatus, opts.comment); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			failed++
+			leftOpen(key)
+			continue
+		}
+		closed++
+		cmd.Printf("✓ Closed #%d - %s\n", info.Number, info.Title)
+	}
+
+	if closed < len(targets) {
+		msg := fmt.Sprintf("closed %d of %d issues; %d failed", closed, len(targets), failed)
+		if keptOpen > 0 {
+			msg += fmt.Sprintf(", %d left open above them", keptOpen)
+		}
+		return errors.New(msg)
+	}
+	cmd.Printf("\n✓ Closed %d issue(s)\n", closed)
+	return nil
+}
+
+// subCloseStatusMatches reports whether status is one of the --status
+// values, which may be aliases
+func subCloseStatusMatches(cfg *config.Config, wanted []string, status string) bool {
+	for _, w := range wanted {
+		if strings.EqualFold(cfg.ResolveFieldValue("status", w), status) {
+			return true
+		}
+	}
+	return false
+}
+
+// closeSubIssue moves an issue to the done status, comments on it, and
+// closes it. An issue not in the project is closed without a status.
+func closeSubIssue(client subCloseClient, projectID string, info issueInfo, statusField, doneStatus, comment string) error {
+	if info.ItemID != "" {
+		if err := client.SetProjectItemField(projectID, info.ItemID, statusField, doneStatus); err != nil {
+			return fmt.Errorf("failed to set status for #%d: %w", info.Number, err)
+		}
+	}
+	if comment != "" {
+		if _, err := client.AddIssueComment(info.ID, comment); err != nil {
+			return fmt.Errorf("failed to comment on #%d: %w", info.Number, err)
+		}
+	}
+	if err := client.CloseIssue(info.ID); err != nil {
+		return fmt.Errorf("failed to close #%d: %w", info.Number, err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubCloseClient implements subCloseClient interface for testing.
+// #10 has sub-issues #11 (Backlog), #12 (closed), and #13 (In Progress),
+// and #13 has #14 (Backlog).
+type mockSubCloseClient struct {
+	failClose int
+	fieldsSet []string
+	comments  []string
+	closed    []string
+}
+
+func (m *mockSubCloseClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{ID: fmt.Sprintf("issue-%d", number), Number: number, Title: "Epic", State: "OPEN"}, nil
+}
+
+func (m *mockSubCloseClient) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	sub := func(n int, state string) api.SubIssue {
+		return api.SubIssue{ID: fmt.Sprintf("issue-%d", n), Number: n, Title: fmt.Sprintf("Task %d", n), State: state}
+	}
+	switch number {
+	case 10:
+		return []api.SubIssue{sub(11, "OPEN"), sub(12, "CLOSED"), sub(13, "OPEN")}, nil
+	case 13:
+		return []api.SubIssue{sub(14, "OPEN")}, nil
+	}
+	return nil, nil
+}
+
+func (m *mockSubCloseClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockSubCloseClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	item := func(n int, status string) api.ProjectItem {
+		return api.ProjectItem{
+			ID:          fmt.Sprintf("item-%d", n),
+			Issue:       &api.Issue{Number: n, Repository: api.Repository{Owner: "owner", Name: "repo"}},
+			FieldValues: []api.FieldValue{{Field: "Status", Value: status}},
+		}
+	}
+	return []api.ProjectItem{item(10, "In Progress"), item(11, "Backlog"), item(13, "In Progress"), item(14, "Backlog")}, nil
+}
+
+func (m *mockSubCloseClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	m.fieldsSet = append(m.fieldsSet, itemID+":"+fieldName+"="+value)
+	return nil
+}
+
+func (m *mockSubCloseClient) AddIssueComment(issueID, body string) (string, error) {
+	m.comments = append(m.comments, issueID)
+	return "comment-1", nil
+}
+
+func (m *mockSubCloseClient) CloseIssue(issueID string) error {
+	if issueID == fmt.Sprintf("issue-%d", m.failClose) {
+		return fmt.Errorf("forbidden")
+	}
+	m.closed = append(m.closed, issueID)
+	return nil
+}
+
+func TestRunSubClose_Cascade(t *testing.T) {
+	client := &mockSubCloseClient{}
+	cmd, buf := newTestCmd()
+	opts := &subCloseOptions{cascade: true, comment: "Abandoned", depth: 10, yes: true}
+	if err := runSubCloseWithDeps(cmd, []string{"10"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubCloseWithDeps() error = %v", err)
+	}
+
+	// Deepest first, the parent last; #12 is already closed
+	if got := strings.Join(client.closed, " "); got != "issue-14 issue-13 issue-11 issue-10" {
+		t.Errorf("closed = %s", got)
+	}
+	if len(client.comments) != 4 {
+		t.Errorf("Expected a comment on each closed issue, got %v", client.comments)
+	}
+	if client.fieldsSet[0] != "item-14:status=done" || len(client.fieldsSet) != 4 {
+		t.Errorf("Unexpected fields: %v", client.fieldsSet)
+	}
+	if !strings.Contains(buf.String(), "Issues to close (4):\n• #10 - Epic\n  • #11 - Task 11\n  • #13 - Task 13\n    • #14 - Task 14\n") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunSubClose_StatusFilter(t *testing.T) {
+	client := &mockSubCloseClient{}
+	cmd, buf := newTestCmd()
+	opts := &subCloseOptions{cascade: true, statuses: []string{"backlog"}, depth: 10, yes: true}
+	if err := runSubCloseWithDeps(cmd, []string{"10"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubCloseWithDeps() error = %v", err)
+	}
+	if got := strings.Join(client.closed, " "); got != "issue-14 issue-11 issue-10" {
+		t.Errorf("closed = %s", got)
+	}
+	if !strings.Contains(buf.String(), "1 open sub-issue(s) not matching --status are left open") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunSubClose_OpenSubIssuesNeedCascade(t *testing.T) {
+	client := &mockSubCloseClient{}
+	cmd, _ := newTestCmd()
+	err := runSubCloseWithDeps(cmd, []string{"10"}, &subCloseOptions{depth: 10}, newTestConfig(), client)
+	if err == nil || !strings.Contains(err.Error(), "#10 has 3 open sub-issue(s); use --cascade") {
+		t.Errorf("Expected an error asking for --cascade, got %v", err)
+	}
+	if len(client.closed) != 0 {
+		t.Errorf("Expected nothing closed, got %v", client.closed)
+	}
+}
+
+func TestRunSubClose_ConfirmAndDryRun(t *testing.T) {
+	client := &mockSubCloseClient{}
+	cmd, buf := newTestCmd()
+	cmd.SetIn(strings.NewReader("n\n"))
+	if err := runSubCloseWithDeps(cmd, []string{"10"}, &subCloseOptions{cascade: true, depth: 10}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubCloseWithDeps() error = %v", err)
+	}
+	if len(client.closed) != 0 || !strings.Contains(buf.String(), "Aborted.") {
+		t.Errorf("Expected the close to be aborted, closed %v", client.closed)
+	}
+
+	opts := &subCloseOptions{cascade: true, depth: 10, dryRun: true}
+	if err := runSubCloseWithDeps(cmd, []string{"10"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubCloseWithDeps() error = %v", err)
+	}
+	if len(client.closed) != 0 || len(client.fieldsSet) != 0 {
+		t.Errorf("Expected no changes in a dry run")
+	}
+}
+
+func TestRunSubClose_PartialFailure(t *testing.T) {
+	client := &mockSubCloseClient{failClose: 14}
+	cmd, _ := newTestCmd()
+	opts := &subCloseOptions{cascade: true, depth: 10, yes: true}
+	err := runSubCloseWithDeps(cmd, []string{"10"}, opts, newTestConfig(), client)
+	if err == nil || !strings.Contains(err.Error(), "closed 1 of 4 issues; 1 failed, 2 left open above them") {
+		t.Errorf("Expected a partial failure, got %v", err)
+	}
+	// #14 failed, so #13 above it and the epic stay open
+	if got := strings.Join(client.closed, " "); got != "issue-11" {
+		t.Errorf("Expected every issue above #14 left open, closed = %s", got)
+	}
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// mockSubCreateClient implements subCreateClient interface for testing
+type mockSubCreateClient struct {
+	parent     *api.Issue
+	parentItem *api.ProjectItem
+
+	createdLabels    []string
+	createdAssignees []string
+	createdMilestone string
+	addedToProject   bool
+	fieldsSet        map[string]string
+}
+
+func (m *mockSubCreateClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return m.parent, nil
+}
+
+func (m *mockSubCreateClient) CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error) {
+	m.createdLabels, m.createdAssignees, m.createdMilestone = labels, assignees, milestone
+	return &api.Issue{ID: "issue-new", Number: 20, Title: title, URL: "https://github.com/owner/repo/issues/20"}, nil
+}
+
+func (m *mockSubCreateClient) AddSubIssue(parentIssueID, childIssueID string) error {
+	return nil
+}
+
+func (m *mockSubCreateClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockSubCreateClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	return m.parentItem, nil
+}
+
+func (m *mockSubCreateClient) AddIssueToProject(projectID, issueID string) (string, error) {
+	m.addedToProject = true
+	return "item-new", nil
+}
+
+func (m *mockSubCreateClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	if m.fieldsSet == nil {
+		m.fieldsSet = make(map[string]string)
+	}
+	m.fieldsSet[fieldName] = value
+	return nil
+}
+
+func newSubCreateTestClient() *mockSubCreateClient {
+	return &mockSubCreateClient{
+		parent: &api.Issue{
+			ID: "issue-10", Number: 10, Title: "Epic",
+			Labels:    []api.Label{{Name: "feature"}},
+			Assignees: []api.Actor{{Login: "alice"}},
+			Milestone: &api.Milestone{Title: "v2.0"},
+		},
+		parentItem: &api.ProjectItem{ID: "item-10", FieldValues: []api.FieldValue{
+			{Field: "Status", Value: "In progress"},
+			{Field: "Priority", Value: "P1"},
+			{Field: "Iteration", Value: "Sprint 4"},
+		}},
+	}
+}
+
+// runSubCreateTest runs sub create with flags parsed as on the command line
+func runSubCreateTest(t *testing.T, cfg *config.Config, client *mockSubCreateClient, flags ...string) string {
+	t.Helper()
+	cmd := newSubCreateCommand()
+	buf := &bytes.Buffer{}
+	cmd.SetOut(buf)
+	if err := cmd.ParseFlags(append([]string{"--parent", "10", "--title", "Task"}, flags...)); err != nil {
+		t.Fatal(err)
+	}
+	opts := &subCreateOptions{}
+	opts.parent, _ = cmd.Flags().GetString("parent")
+	opts.title, _ = cmd.Flags().GetString("title")
+	opts.fields, _ = cmd.Flags().GetStringArray("field")
+	opts.noInherit, _ = cmd.Flags().GetBool("no-inherit")
+	if err := runSubCreateWithDeps(cmd, opts, cfg, client, time.Now()); err != nil {
+		t.Fatalf("runSubCreateWithDeps() error = %v", err)
+	}
+	return buf.String()
+}
+
+func TestRunSubCreate_InheritsByDefault(t *testing.T) {
+	client := newSubCreateTestClient()
+	out := runSubCreateTest(t, newTestConfig(), client)
+
+	if len(client.createdLabels) != 1 || client.createdLabels[0] != "feature" || client.createdMilestone != "v2.0" {
+		t.Errorf("Expected the parent's labels and milestone, got %v, %q", client.createdLabels, client.createdMilestone)
+	}
+	if len(client.createdAssignees) != 0 {
+		t.Errorf("Expected assignees not inherited by default, got %v", client.createdAssignees)
+	}
+	if client.fieldsSet["Iteration"] != "Sprint 4" || client.fieldsSet["Priority"] != "P1" || client.fieldsSet["Status"] != "" {
+		t.Errorf("Expected iteration and priority inherited, got %v", client.fieldsSet)
+	}
+	if !strings.Contains(out, "Iteration: Sprint 4") || !strings.Contains(out, "Milestone: v2.0") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+}
+
+func TestRunSubCreate_NoInherit(t *testing.T) {
+	client := newSubCreateTestClient()
+	runSubCreateTest(t, newTestConfig(), client, "--no-inherit")
+
+	if len(client.createdLabels) != 0 || client.createdMilestone != "" || client.addedToProject {
+		t.Errorf("Expected nothing inherited, got %v, %q, project %v", client.createdLabels, client.createdMilestone, client.addedToProject)
+	}
+}
+
+func TestRunSubCreate_FieldOverridesInherited(t *testing.T) {
+	client := newSubCreateTestClient()
+	runSubCreateTest(t, newTestConfig(), client, "--field", "priority=P0", "--inherit-milestone=false", "--inherit-assignees")
+
+	if client.fieldsSet["priority"] != "P0" {
+		t.Errorf("Expected --field to replace the parent's priority, got %v", client.fieldsSet)
+	}
+	if client.fieldsSet["Priority"] == "P1" {
+		t.Errorf("Expected the parent's priority not to be copied, got %v", client.fieldsSet)
+	}
+	if client.createdMilestone != "" || len(client.createdAssignees) != 1 || client.createdAssignees[0] != "alice" {
+		t.Errorf("Expected the flags to adjust inheritance, got %q, %v", client.createdMilestone, client.createdAssignees)
+	}
+}
+
+func TestRunSubCreate_ConfiguredInherit(t *testing.T) {
+	client := newSubCreateTestClient()
+	cfg := newTestConfig()
+	cfg.Defaults.Inherit = []string{"status", "assignees"}
+	runSubCreateTest(t, cfg, client)
+
+	if len(client.createdLabels) != 0 || client.createdMilestone != "" {
+		t.Errorf("Expected only the configured attributes inherited, got %v, %q", client.createdLabels, client.createdMilestone)
+	}
+	if len(client.createdAssignees) != 1 || len(client.fieldsSet) != 1 || client.fieldsSet["Status"] != "In progress" {
+		t.Errorf("Expected assignees and status inherited, got %v, %v", client.createdAssignees, client.fieldsSet)
+	}
+}
@@ -0,0 +1,286 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/spf13/cobra"
+)
+
+type subGraphOptions struct {
+	format string
+	all    bool
+	depth  int
+}
+
+// subGraphClient defines the interface for API methods used by sub graph.
+// This allows for easier testing with mock implementations.
+type subGraphClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+}
+
+func newSubGraphCommand() *cobra.Command {
+	opts := &subGraphOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "graph [issue]",
+		Short: "Export a sub-issue tree as a Mermaid or DOT graph",
+		Long: `Write an issue's sub-issue tree as a graph, labeled with each issue's
+project status, for embedding in docs (Mermaid) or rendering with
+Graphviz (DOT). Closed issues are shaded.
+
+With --all, every top-level issue in the project (one that is not a
+sub-issue of another project issue) is graphed with its tree.`,
+		Example: `  gh pmu sub graph 10
+  gh pmu sub graph 10 --format dot | dot -Tsvg -o epic.svg
+
+  # The whole project
+  gh pmu sub graph --all --format mermaid > docs/roadmap.mmd`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runSubGraphWithDeps(cmd, args, opts, cfg, api.NewClient())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.format, "format", "mermaid", "Graph format: mermaid or dot")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Graph every top-level issue in the project")
+	cmd.Flags().IntVar(&opts.depth, "depth", 10, "Maximum depth of the tree to include")
+
+	return cmd
+}
+
+// runSubGraphWithDeps is the testable implementation of sub graph
+func runSubGraphWithDeps(cmd *cobra.Command, args []string, opts *subGraphOptions, cfg *config.Config, client subGraphClient) error {
+	if opts.format != "mermaid" && opts.format != "dot" {
+		return fmt.Errorf("invalid --format %q: use mermaid or dot", opts.format)
+	}
+	if opts.depth < 1 {
+		return fmt.Errorf("--depth must be at least 1")
+	}
+	if opts.all == (len(args) == 1) {
+		return fmt.Errorf("give an issue or --all, but not both")
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+	statusField := cfg.GetFieldName("status")
+	statuses := make(map[string]string)
+	for _, item := range items {
+		if item.Issue != nil {
+			statuses[itemKey(item)] = getFieldValue(item, statusField)
+		}
+	}
+
+	// Sub-issues are looked up once, however often an issue is reached
+	subs := &cachedSubIssues{client: client, cache: make(map[string][]api.SubIssue)}
+
+	var roots []*SubTreeNode
+	if opts.all {
+		roots, err = subGraphProjectRoots(subs, items, opts.depth)
+	} else {
+		var root *SubTreeNode
+		root, err = subGraphIssueRoot(client, subs, cfg, args[0], opts.depth)
+		roots = []*SubTreeNode{root}
+	}
+	if err != nil {
+		return err
+	}
+
+	if opts.format == "dot" {
+		writeSubGraphDOT(cmd.OutOrStdout(), roots, statuses)
+	} else {
+		writeSubGraphMermaid(cmd.OutOrStdout(), roots, statuses)
+	}
+	return nil
+}
+
+// subGraphIssueRoot returns the tree of one issue
+func subGraphIssueRoot(client subGraphClient, subs subTreeClient, cfg *config.Config, arg string, depth int) (*SubTreeNode, error) {
+	key, err := issueKey(cfg, arg)
+	if err != nil {
+		return nil, err
+	}
+	owner, repo, number, _ := parseIssueReference(key)
+
+	issue, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+	if issue.Repository.Owner == "" || issue.Repository.Name == "" {
+		issue.Repository = api.Repository{Owner: owner, Name: repo}
+	}
+	return buildSubTree(subs, issue, depth)
+}
+
+// subGraphProjectRoots returns the trees of the project's issues that are
+// not sub-issues of another project issue, in project order
+func subGraphProjectRoots(subs subTreeClient, items []api.ProjectItem, depth int) ([]*SubTreeNode, error) {
+	children := make(map[string]bool)
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		repo := item.Issue.Repository
+		subIssues, err := subs.GetSubIssues(repo.Owner, repo.Name, item.Issue.Number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get sub-issues of %s/%s#%d: %w", repo.Owner, repo.Name, item.Issue.Number, err)
+		}
+		for _, sub := range subIssues {
+			subRepo := repo
+			if sub.Repository.Owner != "" && sub.Repository.Name != "" {
+				subRepo = sub.Repository
+			}
+			children[localstore.Key(subRepo.Owner+"/"+subRepo.Name, sub.Number)] = true
+		}
+	}
+
+	var roots []*SubTreeNode
+	for _, item := range items {
+		if item.Issue == nil || children[itemKey(item)] {
+			continue
+		}
+		root, err := buildSubTree(subs, item.Issue, depth)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, root)
+	}
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("no issues in the project")
+	}
+	return roots, nil
+}
+
+// cachedSubIssues remembers the sub-issues of each issue looked up
+type cachedSubIssues struct {
+	client subTreeClient
+	cache  map[string][]api.SubIssue
+}
+
+func (c *cachedSubIssues) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	key := localstore.Key(owner+"/"+repo, number)
+	if subs, ok := c.cache[key]; ok {
+		return subs, nil
+	}
+	subs, err := c.client.GetSubIssues(owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	c.cache[key] = subs
+	return subs, nil
+}
+
+// subGraphStatus returns the label line for an issue's status: its project
+// status, or else its state
+func subGraphStatus(node *SubTreeNode, statuses map[string]string) string {
+	if status := statuses[localstore.Key(node.Repository, node.Number)]; status != "" {
+		return status
+	}
+	if node.State == "CLOSED" {
+		return "Closed"
+	}
+	return "Open"
+}
+
+// subGraphVisit calls fn for each root and each parent-child edge, parents
+// before children. A node reached twice is descended into only once.
+func subGraphVisit(roots []*SubTreeNode, fn func(node *SubTreeNode, parent *SubTreeNode)) {
+	seen := make(map[string]bool)
+	var walk func(node, parent *SubTreeNode)
+	walk = func(node, parent *SubTreeNode) {
+		key := localstore.Key(node.Repository, node.Number)
+		fn(node, parent)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		for _, child := range node.Children {
+			walk(child, node)
+		}
+	}
+	for _, root := range roots {
+		walk(root, nil)
+	}
+}
+
+var mermaidIDPattern = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// mermaidID returns a Mermaid node ID for an issue
+func mermaidID(node *SubTreeNode) string {
+	return mermaidIDPattern.ReplaceAllString(fmt.Sprintf("%s_%d", node.Repository, node.Number), "_")
+}
+
+// mermaidText escapes text for a quoted Mermaid label
+func mermaidText(s string) string {
+	return strings.NewReplacer(`"`, "#quot;", "<", "#lt;", ">", "#gt;").Replace(s)
+}
+
+// writeSubGraphMermaid writes the trees as a Mermaid flowchart
+func writeSubGraphMermaid(w io.Writer, roots []*SubTreeNode, statuses map[string]string) {
+	fmt.Fprintln(w, "graph TD")
+	defined := make(map[string]bool)
+	var closed []string
+	subGraphVisit(roots, func(node, parent *SubTreeNode) {
+		id := mermaidID(node)
+		if !defined[id] {
+			defined[id] = true
+			fmt.Fprintf(w, "  %s[\"#%d %s<br/>%s\"]\n", id, node.Number, mermaidText(node.Title), mermaidText(subGraphStatus(node, statuses)))
+			if node.State == "CLOSED" {
+				closed = append(closed, id)
+			}
+		}
+		if parent != nil {
+			fmt.Fprintf(w, "  %s --> %s\n", mermaidID(parent), id)
+		}
+	})
+	if len(closed) > 0 {
+		fmt.Fprintln(w, "  classDef closed fill:#eeeeee,stroke:#999999,color:#666666")
+		fmt.Fprintf(w, "  class %s closed\n", strings.Join(closed, ","))
+	}
+}
+
+// dotText escapes text for a quoted DOT string
+func dotText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+// writeSubGraphDOT writes the trees as a Graphviz digraph
+func writeSubGraphDOT(w io.Writer, roots []*SubTreeNode, statuses map[string]string) {
+	fmt.Fprintln(w, "digraph subissues {")
+	fmt.Fprintln(w, "  rankdir=TB;")
+	fmt.Fprintln(w, `  node [shape=box, style=rounded];`)
+	defined := make(map[string]bool)
+	subGraphVisit(roots, func(node, parent *SubTreeNode) {
+		id := dotText(node.Repository + fmt.Sprintf("#%d", node.Number))
+		if !defined[id] {
+			defined[id] = true
+			style := ""
+			if node.State == "CLOSED" {
+				style = `, style="rounded,filled", fillcolor="#eeeeee", fontcolor="#666666"`
+			}
+			fmt.Fprintf(w, "  \"%s\" [label=\"#%d %s\\n%s\"%s];\n", id, node.Number, dotText(node.Title), dotText(subGraphStatus(node, statuses)), style)
+		}
+		if parent != nil {
+			fmt.Fprintf(w, "  \"%s\" -> \"%s\";\n", dotText(parent.Repository+fmt.Sprintf("#%d", parent.Number)), id)
+		}
+	})
+	fmt.Fprintln(w, "}")
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubGraphClient implements subGraphClient interface for testing, with
+// issues in owner/repo: #10 has #11 (closed) and #12, and #20 stands alone
+type mockSubGraphClient struct {
+	subCalls int
+}
+
+var subGraphTestChildren = map[int][]int{10: {11, 12}}
+
+func (m *mockSubGraphClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{Number: number, Title: fmt.Sprintf("Issue %d", number), State: "OPEN"}, nil
+}
+
+func (m *mockSubGraphClient) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	m.subCalls++
+	var subs []api.SubIssue
+	for _, n := range subGraphTestChildren[number] {
+		state := "OPEN"
+		if n == 11 {
+			state = "CLOSED"
+		}
+		subs = append(subs, api.SubIssue{Number: n, Title: fmt.Sprintf("Issue %d", n), State: state})
+	}
+	return subs, nil
+}
+
+func (m *mockSubGraphClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockSubGraphClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	repo := api.Repository{Owner: "owner", Name: "repo"}
+	var items []api.ProjectItem
+	for _, n := range []int{10, 11, 12, 20} {
+		item := api.ProjectItem{Issue: &api.Issue{Number: n, Title: fmt.Sprintf("Issue %d", n), State: "OPEN", Repository: repo}}
+		if n == 12 {
+			item.FieldValues = []api.FieldValue{{Field: "Status", Value: "In progress"}}
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+func TestRunSubGraph_Mermaid(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runSubGraphWithDeps(cmd, []string{"10"}, &subGraphOptions{format: "mermaid", depth: 10}, newTestConfig(), &mockSubGraphClient{}); err != nil {
+		t.Fatalf("runSubGraphWithDeps() error = %v", err)
+	}
+	want := `graph TD
+  owner_repo_10["#10 Issue 10<br/>Open"]
+  owner_repo_11["#11 Issue 11<br/>Closed"]
+  owner_repo_10 --> owner_repo_11
+  owner_repo_12["#12 Issue 12<br/>In progress"]
+  owner_repo_10 --> owner_repo_12
+  classDef closed fill:#eeeeee,stroke:#999999,color:#666666
+  class owner_repo_11 closed
+`
+	if buf.String() != want {
+		t.Errorf("Unexpected graph:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRunSubGraph_DOTAll(t *testing.T) {
+	client := &mockSubGraphClient{}
+	cmd, buf := newTestCmd()
+	if err := runSubGraphWithDeps(cmd, nil, &subGraphOptions{format: "dot", all: true, depth: 10}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubGraphWithDeps() error = %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"digraph subissues {",
+		`"owner/repo#10" [label="#10 Issue 10\nOpen"];`,
+		`"owner/repo#10" -> "owner/repo#12";`,
+		`"owner/repo#11" [label="#11 Issue 11\nClosed", style="rounded,filled"`,
+		`"owner/repo#20" [label="#20 Issue 20\nOpen"];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+	// #11 and #12 are sub-issues of #10, so only #10 and #20 are roots
+	if strings.Count(out, `"owner/repo#11" [`) != 1 {
+		t.Errorf("Expected #11 defined once:\n%s", out)
+	}
+	if client.subCalls != 4 {
+		t.Errorf("Expected each issue's sub-issues fetched once, got %d calls", client.subCalls)
+	}
+}
+
+func TestRunSubGraph_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		opts    subGraphOptions
+		wantErr string
+	}{
+		{"bad format", []string{"10"}, subGraphOptions{format: "svg", depth: 10}, "invalid --format"},
+		{"issue and all", []string{"10"}, subGraphOptions{format: "dot", all: true, depth: 10}, "not both"},
+		{"neither", nil, subGraphOptions{format: "dot", depth: 10}, "not both"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, _ := newTestCmd()
+			err := runSubGraphWithDeps(cmd, tt.args, &tt.opts, newTestConfig(), &mockSubGraphClient{})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestMermaidText(t *testing.T) {
+	if got := mermaidText(`Fix "<b>" tag`); got != "Fix #quot;#lt;b#gt;#quot; tag" {
+		t.Errorf("mermaidText() = %q", got)
+	}
+}
@@ -0,0 +1,340 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type subImportOptions struct {
+	file   string
+	repo   string
+	dryRun bool
+	json   bool
+}
+
+// subImportClient defines the interface for API methods used by sub import.
+// This allows for easier testing with mock implementations.
+type subImportClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error)
+	AddSubIssue(parentIssueID, childIssueID string) error
+	GetProject(owner string, number int) (*api.Project, error)
+	AddIssueToProject(projectID, issueID string) (string, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
+// subImportNode is an issue in a sub import plan, with the issues to
+// create under it
+type subImportNode struct {
+	issueFromFile `yaml:",inline"`
+	Children      []subImportNode `yaml:"children"`
+}
+
+// subImportFailure is an issue of the plan that was not created, or was
+// created but not linked under its parent (Number is then set)
+type subImportFailure struct {
+	Number int
+	Title  string
+	Error  string
+}
+
+func newSubImportCommand() *cobra.Command {
+	opts := &subImportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "import <parent-issue>",
+		Short: "Create a sub-issue hierarchy from a YAML plan",
+		Long: `Create a tree of sub-issues under a parent issue from a YAML file.
+
+The file is a list of issues. Each has a title and optionally a body,
+labels, assignees, milestone, status, priority, and fields, like
+create --from-file, plus children: the issues to create under it, to
+any depth.
+
+Issues are created in the parent's repository (or --repo), added to the
+project, and linked under their parent in the plan. When an issue cannot
+be created, or is created but cannot be linked, the issues under it are
+skipped and the rest of the plan is still imported. Issues created but not
+linked are listed apart, to link with 'gh pmu sub add'.`,
+		Example: `  gh pmu sub import 10 --file plan.yml
+  gh pmu sub import 10 --file plan.yml --dry-run
+
+  # plan.yml
+  - title: Design
+    priority: p1
+    children:
+      - title: Mockups
+      - title: Review with users
+  - title: Build
+    fields:
+      estimate: 8`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runSubImportWithDeps(cmd, args, opts, cfg, api.NewClient(), time.Now())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "YAML file with the issue tree (- for stdin)")
+	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Repository for the new issues (owner/repo, default: the parent's)")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show the tree that would be created without creating it")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output the created tree in JSON format")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+// runSubImportWithDeps is the testable implementation of sub import
+func runSubImportWithDeps(cmd *cobra.Command, args []string, opts *subImportOptions, cfg *config.Config, client subImportClient, now time.Time) error {
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	owner, repo, number, _ := parseIssueReference(key)
+
+	targetOwner, targetRepo := owner, repo
+	if opts.repo != "" {
+		targetOwner, targetRepo = splitRepository(opts.repo)
+		if targetOwner == "" || targetRepo == "" {
+			return fmt.Errorf("invalid repository format: %s (expected owner/repo)", opts.repo)
+		}
+	}
+
+	var data []byte
+	if opts.file == "-" {
+		data, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		data, err = os.ReadFile(opts.file)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read plan: %w", err)
+	}
+	plan, err := parseSubImportPlan(data)
+	if err != nil {
+		return err
+	}
+
+	// Check the whole plan before anything is created
+	if err := validateSubImportPlan(plan, cfg, now, ""); err != nil {
+		return err
+	}
+
+	parent, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get parent issue #%d: %w", number, err)
+	}
+	root := &SubTreeNode{
+		Number:     parent.Number,
+		Title:      parent.Title,
+		State:      parent.State,
+		URL:        parent.URL,
+		Repository: owner + "/" + repo,
+	}
+
+	out := cmd.OutOrStdout()
+	if opts.dryRun {
+		fmt.Fprintf(out, "Would create %d issue(s) under #%d - %s:\n", countSubImportNodes(plan), number, parent.Title)
+		writeSubImportPlan(out, plan, "")
+		return nil
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	importer := &subImporter{
+		client:  client,
+		cfg:     cfg,
+		now:     now,
+		owner:   targetOwner,
+		repo:    targetRepo,
+		project: project,
+	}
+	importer.importNodes(plan, parent, root)
+
+	if opts.json {
+		if err := writeSubTreeJSON(out, root); err != nil {
+			return err
+		}
+	} else {
+		fmt.Fprintf(out, "✓ Imported %d issue(s) under #%d\n\n", importer.created, number)
+		writeSubTree(out, root)
+		for _, f := range importer.unlinked {
+			fmt.Fprintf(out, "  ! #%d %s: %s\n", f.Number, f.Title, f.Error)
+		}
+		for _, f := range importer.failed {
+			fmt.Fprintf(out, "  ✗ %s: %s\n", f.Title, f.Error)
+		}
+	}
+
+	if len(importer.unlinked) > 0 || len(importer.failed) > 0 {
+		msg := fmt.Sprintf("imported %d of %d issues", importer.created, countSubImportNodes(plan))
+		if len(importer.unlinked) > 0 {
+			msg += fmt.Sprintf("; %d created, not linked", len(importer.unlinked))
+		}
+		if len(importer.failed) > 0 {
+			msg += fmt.Sprintf("; %d failed", len(importer.failed))
+		}
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// parseSubImportPlan parses a YAML list of issues
+func parseSubImportPlan(data []byte) ([]subImportNode, error) {
+	var plan []subImportNode
+	if err := yaml.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("failed to parse plan: %w", err)
+	}
+	if len(plan) == 0 {
+		return nil, fmt.Errorf("plan has no issues")
+	}
+	return plan, nil
+}
+
+// validateSubImportPlan checks titles and field values throughout the plan.
+// path names the enclosing issue in errors.
+func validateSubImportPlan(nodes []subImportNode, cfg *config.Config, now time.Time, path string) error {
+	for i, node := range nodes {
+		title := strings.TrimSpace(node.Title)
+		where := fmt.Sprintf("issue %d", i+1)
+		if path != "" {
+			where = fmt.Sprintf("issue %d under %q", i+1, path)
+		}
+		if title == "" {
+			return fmt.Errorf("invalid plan: %s has no title", where)
+		}
+		if _, err := resolveCreateFields(subImportFieldPairs(node), cfg, now); err != nil {
+			return fmt.Errorf("invalid plan: %q: %w", title, err)
+		}
+		if err := validateSubImportPlan(node.Children, cfg, now, title); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// subImportFieldPairs returns a node's status, priority, and fields as
+// key=value pairs
+func subImportFieldPairs(node subImportNode) []string {
+	var pairs []string
+	if node.Status != "" {
+		pairs = append(pairs, "status="+node.Status)
+	}
+	if node.Priority != "" {
+		pairs = append(pairs, "priority="+node.Priority)
+	}
+	return append(pairs, fieldPairs(node.Fields)...)
+}
+
+// countSubImportNodes counts the issues in a plan
+func countSubImportNodes(nodes []subImportNode) int {
+	count := 0
+	for _, node := range nodes {
+		count += 1 + countSubImportNodes(node.Children)
+	}
+	return count
+}
+
+// writeSubImportPlan renders the titles of a plan as a tree
+func writeSubImportPlan(w io.Writer, nodes []subImportNode, prefix string) {
+	for i, node := range nodes {
+		branch, indent := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintf(w, "%s%s%s\n", prefix, branch, strings.TrimSpace(node.Title))
+		writeSubImportPlan(w, node.Children, prefix+indent)
+	}
+}
+
+// subImporter creates the issues of a plan, depth first
+type subImporter struct {
+	client  subImportClient
+	cfg     *config.Config
+	now     time.Time
+	owner   string
+	repo    string
+	project *api.Project
+
+	created  int                // created and linked
+	unlinked []subImportFailure // created, not linked
+	failed   []subImportFailure // not created
+}
+
+// importNodes creates nodes under parent and adds them to the tree under
+// node. An issue that cannot be created, or is created but cannot be
+// linked, is reported with the issues under it skipped.
+func (im *subImporter) importNodes(nodes []subImportNode, parent *api.Issue, node *SubTreeNode) {
+	for _, n := range nodes {
+		issue, err := im.importNode(n, parent)
+		if err != nil {
+			f := subImportFailure{Title: n.Title, Error: err.Error()}
+			if skipped := countSubImportNodes(n.Children); skipped > 0 {
+				f.Error += fmt.Sprintf(" (%d issue(s) under it skipped)", skipped)
+			}
+			if issue != nil {
+				// Created but not linked; its children would be misplaced
+				f.Number = issue.Number
+				im.unlinked = append(im.unlinked, f)
+			} else {
+				im.failed = append(im.failed, f)
+			}
+			continue
+		}
+		im.created++
+
+		child := &SubTreeNode{
+			Number:     issue.Number,
+			Title:      issue.Title,
+			State:      "OPEN",
+			URL:        issue.URL,
+			Repository: im.owner + "/" + im.repo,
+			Children:   []*SubTreeNode{},
+		}
+		node.Children = append(node.Children, child)
+		im.importNodes(n.Children, issue, child)
+	}
+}
+
+// importNode creates one issue, links it under parent, and sets its
+// project fields. Field failures are warnings; a failure to link returns
+// the created issue with the error.
+func (im *subImporter) importNode(n subImportNode, parent *api.Issue) (*api.Issue, error) {
+	labels := append([]string{}, im.cfg.Defaults.Labels...)
+	labels = append(labels, n.Labels...)
+
+	issue, err := im.client.CreateIssueWithOptions(im.owner, im.repo, strings.TrimSpace(n.Title), n.Body, labels, n.Assignees, n.Milestone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create issue: %w", err)
+	}
+	if err := im.client.AddSubIssue(parent.ID, issue.ID); err != nil {
+		return issue, fmt.Errorf("created #%d but failed to link it under #%d: %w", issue.Number, parent.Number, err)
+	}
+
+	itemID, err := im.client.AddIssueToProject(im.project.ID, issue.ID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: failed to add #%d to project: %v\n", issue.Number, err)
+		return issue, nil
+	}
+	fields, _ := resolveCreateFields(subImportFieldPairs(n), im.cfg, im.now)
+	for _, f := range fields {
+		if err := im.client.SetProjectItemField(im.project.ID, itemID, f.Field, f.Value); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to set %s on #%d: %v\n", f.Field, issue.Number, err)
+		}
+	}
+	return issue, nil
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubImportClient implements subImportClient interface for testing.
+// Created issues are numbered from 20.
+type mockSubImportClient struct {
+	failTitle string
+	failLink  string // title of an issue that cannot be linked
+	next      int
+	created   map[string]string // issue ID > title
+	links     []string          // parent issue ID > child issue ID
+	fieldsSet []string
+}
+
+func (m *mockSubImportClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{ID: fmt.Sprintf("issue-%d", number), Number: number, Title: "Epic", State: "OPEN"}, nil
+}
+
+func (m *mockSubImportClient) CreateIssueWithOptions(owner, repo, title, body string, labels, assignees []string, milestone string) (*api.Issue, error) {
+	if title == m.failTitle {
+		return nil, fmt.Errorf("validation failed")
+	}
+	number := 20 + m.next
+	m.next++
+	if m.created == nil {
+		m.created = map[string]string{}
+	}
+	m.created[fmt.Sprintf("issue-%d", number)] = title
+	return &api.Issue{ID: fmt.Sprintf("issue-%d", number), Number: number, Title: title}, nil
+}
+
+func (m *mockSubImportClient) AddSubIssue(parentIssueID, childIssueID string) error {
+	if m.failLink != "" && m.created[childIssueID] == m.failLink {
+		return fmt.Errorf("link refused")
+	}
+	m.links = append(m.links, parentIssueID+">"+childIssueID)
+	return nil
+}
+
+func (m *mockSubImportClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockSubImportClient) AddIssueToProject(projectID, issueID string) (string, error) {
+	return "item-" + issueID, nil
+}
+
+func (m *mockSubImportClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	m.fieldsSet = append(m.fieldsSet, itemID+":"+fieldName+"="+value)
+	return nil
+}
+
+const subImportTestPlan = `- title: Design
+  priority: P1
+  children:
+    - title: Mockups
+    - title: Review
+- title: Build
+  fields:
+    Estimate: "8"
+`
+
+func writeSubImportPlanFile(t *testing.T, plan string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "plan.yml")
+	if err := os.WriteFile(path, []byte(plan), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRunSubImport_CreatesTree(t *testing.T) {
+	client := &mockSubImportClient{}
+	cmd, buf := newTestCmd()
+	opts := &subImportOptions{file: writeSubImportPlanFile(t, subImportTestPlan)}
+	if err := runSubImportWithDeps(cmd, []string{"10"}, opts, newTestConfig(), client, time.Now()); err != nil {
+		t.Fatalf("runSubImportWithDeps() error = %v", err)
+	}
+
+	wantLinks := []string{"issue-10>issue-20", "issue-20>issue-21", "issue-20>issue-22", "issue-10>issue-23"}
+	if strings.Join(client.links, " ") != strings.Join(wantLinks, " ") {
+		t.Errorf("links = %v, want %v", client.links, wantLinks)
+	}
+	if strings.Join(client.fieldsSet, " ") != "item-issue-20:priority=P1 item-issue-23:Estimate=8" {
+		t.Errorf("Unexpected fields: %v", client.fieldsSet)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"✓ Imported 4 issue(s) under #10",
+		"├── ○ #20 - Design",
+		"│   └── ○ #22 - Review",
+		"└── ○ #23 - Build",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunSubImport_FailureSkipsChildren(t *testing.T) {
+	client := &mockSubImportClient{failTitle: "Design"}
+	cmd, buf := newTestCmd()
+	opts := &subImportOptions{file: writeSubImportPlanFile(t, subImportTestPlan), json: true}
+	err := runSubImportWithDeps(cmd, []string{"10"}, opts, newTestConfig(), client, time.Now())
+	if err == nil || !strings.Contains(err.Error(), "imported 1 of 4 issues") {
+		t.Errorf("Expected a partial import error, got %v", err)
+	}
+
+	var root SubTreeNode
+	if err := json.Unmarshal(buf.Bytes(), &root); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(root.Children) != 1 || root.Children[0].Title != "Build" {
+		t.Errorf("Expected only Build imported, got %+v", root.Children)
+	}
+}
+
+func TestRunSubImport_UnlinkedCountedOnce(t *testing.T) {
+	client := &mockSubImportClient{failLink: "Design"}
+	cmd, buf := newTestCmd()
+	opts := &subImportOptions{file: writeSubImportPlanFile(t, subImportTestPlan)}
+	err := runSubImportWithDeps(cmd, []string{"10"}, opts, newTestConfig(), client, time.Now())
+	if err == nil || err.Error() != "imported 1 of 4 issues; 1 created, not linked" {
+		t.Errorf("Expected the unlinked issue counted once, got %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "✓ Imported 1 issue(s) under #10") ||
+		!strings.Contains(out, "! #20 Design: created #20 but failed to link it under #10: link refused (2 issue(s) under it skipped)") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+	if strings.Contains(out, "✗") {
+		t.Errorf("Expected no failures, got:\n%s", out)
+	}
+}
+
+func TestRunSubImport_DryRun(t *testing.T) {
+	client := &mockSubImportClient{}
+	cmd, buf := newTestCmd()
+	opts := &subImportOptions{file: writeSubImportPlanFile(t, subImportTestPlan), dryRun: true}
+	if err := runSubImportWithDeps(cmd, []string{"10"}, opts, newTestConfig(), client, time.Now()); err != nil {
+		t.Fatalf("runSubImportWithDeps() error = %v", err)
+	}
+	if client.next != 0 {
+		t.Error("Expected nothing created in a dry run")
+	}
+	if !strings.Contains(buf.String(), "Would create 4 issue(s) under #10 - Epic:\n├── Design\n│   ├── Mockups\n│   └── Review\n└── Build\n") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunSubImport_InvalidPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		plan    string
+		wantErr string
+	}{
+		{"empty", "[]", "plan has no issues"},
+		{"not a list", "title: Design", "failed to parse plan"},
+		{"missing nested title", "- title: Design\n  children:\n    - body: text\n", `issue 1 under "Design" has no title`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &mockSubImportClient{}
+			cmd, _ := newTestCmd()
+			opts := &subImportOptions{file: writeSubImportPlanFile(t, tt.plan)}
+			err := runSubImportWithDeps(cmd, []string{"10"}, opts, newTestConfig(), client, time.Now())
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if client.next != 0 {
+				t.Error("Expected nothing created for an invalid plan")
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// maxParentChain bounds the walk up a new parent's ancestors when checking
+// a move for cycles
+const maxParentChain = 50
+
+type subMoveOptions struct {
+	to    string
+	force bool
+}
+
+// subMoveClient defines the interface for API methods used by sub move.
+// This allows for easier testing with mock implementations.
+type subMoveClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetParentIssue(owner, repo string, number int) (*api.Issue, error)
+	MoveSubIssue(newParentIssueID, subIssueID string) error
+}
+
+func newSubMoveCommand() *cobra.Command {
+	opts := &subMoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "move <child-issue> --to <new-parent>",
+		Short: "Move a sub-issue to a different parent",
+		Long: `Move a sub-issue to a different parent issue.
+
+The old parent link is replaced by the new one in a single change, so
+the issue is never left without a parent or with two. An issue with no
+parent yet is simply linked.
+
+A move that would make an issue its own ancestor (the new parent is the
+issue or one of its sub-issues) is refused; use --force to send it to
+GitHub anyway.`,
+		Example: `  gh pmu sub move 15 --to 20
+  gh pmu sub move owner/repo#15 --to owner/other#3`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runSubMoveWithDeps(cmd, args, opts, cfg, api.NewClient())
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.to, "to", "", "New parent issue (required)")
+	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "Skip the cycle check")
+	_ = cmd.MarkFlagRequired("to")
+
+	return cmd
+}
+
+// runSubMoveWithDeps is the testable implementation of sub move
+func runSubMoveWithDeps(cmd *cobra.Command, args []string, opts *subMoveOptions, cfg *config.Config, client subMoveClient) error {
+	childKey, err := issueKey(cfg, args[0])
+	if err != nil {
+		return fmt.Errorf("invalid child issue: %w", err)
+	}
+	parentKey, err := issueKey(cfg, opts.to)
+	if err != nil {
+		return fmt.Errorf("invalid --to: %w", err)
+	}
+	if childKey == parentKey {
+		return fmt.Errorf("cannot move %s under itself", childKey)
+	}
+
+	childOwner, childRepo, childNumber, _ := parseIssueReference(childKey)
+	parentOwner, parentRepo, parentNumber, _ := parseIssueReference(parentKey)
+
+	child, err := client.GetIssue(childOwner, childRepo, childNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get issue %s: %w", childKey, err)
+	}
+	newParent, err := client.GetIssue(parentOwner, parentRepo, parentNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get new parent %s: %w", parentKey, err)
+	}
+
+	oldParent, err := client.GetParentIssue(childOwner, childRepo, childNumber)
+	if err != nil {
+		return err
+	}
+	if oldParent != nil && oldParent.ID == newParent.ID {
+		cmd.Printf("#%d is already a sub-issue of #%d\n", childNumber, parentNumber)
+		return nil
+	}
+
+	if !opts.force {
+		if err := checkSubMoveCycle(client, child, newParent, parentOwner, parentRepo, parentNumber); err != nil {
+			return err
+		}
+	}
+
+	if err := client.MoveSubIssue(newParent.ID, child.ID); err != nil {
+		return err
+	}
+
+	childRef, newRef := subMoveRef(childOwner, childRepo, childNumber, cfg), subMoveRef(parentOwner, parentRepo, parentNumber, cfg)
+	if oldParent == nil {
+		cmd.Printf("✓ Linked %s as sub-issue of %s (it had no parent)\n", childRef, newRef)
+	} else {
+		oldOwner, oldRepo := oldParent.Repository.Owner, oldParent.Repository.Name
+		if oldOwner == "" || oldRepo == "" {
+			oldOwner, oldRepo = childOwner, childRepo
+		}
+		cmd.Printf("✓ Moved %s from %s to %s\n", childRef, subMoveRef(oldOwner, oldRepo, oldParent.Number, cfg), newRef)
+		cmd.Printf("  Old parent: %s\n", oldParent.Title)
+	}
+	cmd.Printf("  New parent: %s\n", newParent.Title)
+	cmd.Printf("  Child:      %s\n", child.Title)
+	return nil
+}
+
+// checkSubMoveCycle walks up from the new parent and fails if the child is
+// among its ancestors, which would make the child its own ancestor
+func checkSubMoveCycle(client subMoveClient, child, newParent *api.Issue, owner, repo string, number int) error {
+	for i := 0; i < maxParentChain; i++ {
+		ancestor, err := client.GetParentIssue(owner, repo, number)
+		if err != nil {
+			return fmt.Errorf("failed to check for a cycle: %w", err)
+		}
+		if ancestor == nil {
+			return nil
+		}
+		if ancestor.ID == child.ID {
+			return fmt.Errorf("#%d is beneath #%d; moving #%d under it would create a cycle (use --force to try anyway)",
+				newParent.Number, child.Number, child.Number)
+		}
+		if ancestor.Repository.Owner != "" && ancestor.Repository.Name != "" {
+			owner, repo = ancestor.Repository.Owner, ancestor.Repository.Name
+		}
+		number = ancestor.Number
+	}
+	return nil
+}
+
+// subMoveRef returns #number for an issue in the default repository, and
+// owner/repo#number otherwise
+func subMoveRef(owner, repo string, number int, cfg *config.Config) string {
+	if len(cfg.Repositories) > 0 && strings.EqualFold(cfg.Repositories[0], owner+"/"+repo) {
+		return fmt.Sprintf("#%d", number)
+	}
+	return fmt.Sprintf("%s/%s#%d", owner, repo, number)
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubMoveClient implements subMoveClient interface for testing, with
+// parent links by issue number in owner/repo
+type mockSubMoveClient struct {
+	parents map[int]int
+	moves   []string
+}
+
+func (m *mockSubMoveClient) issue(number int) *api.Issue {
+	return &api.Issue{ID: fmt.Sprintf("issue-%d", number), Number: number, Title: fmt.Sprintf("Issue %d", number),
+		Repository: api.Repository{Owner: "owner", Name: "repo"}}
+}
+
+func (m *mockSubMoveClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return m.issue(number), nil
+}
+
+func (m *mockSubMoveClient) GetParentIssue(owner, repo string, number int) (*api.Issue, error) {
+	parent, ok := m.parents[number]
+	if !ok {
+		return nil, nil
+	}
+	return m.issue(parent), nil
+}
+
+func (m *mockSubMoveClient) MoveSubIssue(newParentIssueID, subIssueID string) error {
+	m.moves = append(m.moves, subIssueID+" -> "+newParentIssueID)
+	return nil
+}
+
+// newSubMoveTestClient returns #10 with sub-issues #11 and #12; #12 has #13
+func newSubMoveTestClient() *mockSubMoveClient {
+	return &mockSubMoveClient{parents: map[int]int{11: 10, 12: 10, 13: 12}}
+}
+
+func TestRunSubMove_Reparents(t *testing.T) {
+	client := newSubMoveTestClient()
+	cmd, buf := newTestCmd()
+	if err := runSubMoveWithDeps(cmd, []string{"13"}, &subMoveOptions{to: "#11"}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubMoveWithDeps() error = %v", err)
+	}
+	if len(client.moves) != 1 || client.moves[0] != "issue-13 -> issue-11" {
+		t.Errorf("Unexpected moves: %v", client.moves)
+	}
+	if !strings.Contains(buf.String(), "✓ Moved #13 from #12 to #11") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunSubMove_NoParentYet(t *testing.T) {
+	client := newSubMoveTestClient()
+	cmd, buf := newTestCmd()
+	if err := runSubMoveWithDeps(cmd, []string{"20"}, &subMoveOptions{to: "10"}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubMoveWithDeps() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "it had no parent") {
+		t.Errorf("Unexpected output:\n%s", buf.String())
+	}
+}
+
+func TestRunSubMove_AlreadyUnderParent(t *testing.T) {
+	client := newSubMoveTestClient()
+	cmd, buf := newTestCmd()
+	if err := runSubMoveWithDeps(cmd, []string{"11"}, &subMoveOptions{to: "10"}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubMoveWithDeps() error = %v", err)
+	}
+	if len(client.moves) != 0 || !strings.Contains(buf.String(), "already a sub-issue of #10") {
+		t.Errorf("Expected no move, got %v\n%s", client.moves, buf.String())
+	}
+}
+
+func TestRunSubMove_RefusesCycle(t *testing.T) {
+	client := newSubMoveTestClient()
+	cmd, _ := newTestCmd()
+
+	// #13 is a grandchild of #10
+	err := runSubMoveWithDeps(cmd, []string{"10"}, &subMoveOptions{to: "13"}, newTestConfig(), client)
+	if err == nil || !strings.Contains(err.Error(), "would create a cycle") {
+		t.Errorf("Expected a cycle error, got %v", err)
+	}
+	if len(client.moves) != 0 {
+		t.Errorf("Expected no move, got %v", client.moves)
+	}
+
+	if err := runSubMoveWithDeps(cmd, []string{"10"}, &subMoveOptions{to: "13", force: true}, newTestConfig(), client); err != nil {
+		t.Fatalf("Expected --force to skip the cycle check, got %v", err)
+	}
+	if len(client.moves) != 1 {
+		t.Errorf("Expected the forced move, got %v", client.moves)
+	}
+}
+
+func TestRunSubMove_UnderItself(t *testing.T) {
+	cmd, _ := newTestCmd()
+	err := runSubMoveWithDeps(cmd, []string{"11"}, &subMoveOptions{to: "owner/repo#11"}, newTestConfig(), newSubMoveTestClient())
+	if err == nil || !strings.Contains(err.Error(), "under itself") {
+		t.Errorf("Expected an error, got %v", err)
+	}
+}
@@ -0,0 +1,276 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// progressStartMarker and progressEndMarker enclose the summary that
+	// sub progress --write keeps in the parent's body
+	progressStartMarker = "<!-- gh-pmu:progress -->"
+	progressEndMarker   = "<!-- /gh-pmu:progress -->"
+
+	// defaultEstimateFieldName is the project field with story points when
+	// no estimate field is configured
+	defaultEstimateFieldName = "Estimate"
+)
+
+type subProgressOptions struct {
+	depth int
+	json  bool
+	write bool
+}
+
+// subProgressClient defines the interface for API methods used by sub progress.
+// This allows for easier testing with mock implementations.
+type subProgressClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectFields(projectID string) ([]api.ProjectField, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	UpdateIssue(owner, repo, issueID string, update api.IssueUpdate) error
+}
+
+func newSubProgressCommand() *cobra.Command {
+	opts := &subProgressOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "progress <issue>",
+		Short: "Show completion across an issue's whole sub-issue tree",
+		Long: `Show how much of an issue's sub-issue tree is complete: every
+descendant down to --depth levels, not only direct sub-issues.
+
+When the project has an estimate field (fields.estimate in .gh-pmu.yml,
+or a field named "Estimate"), story points of closed and open issues
+are summed as well.
+
+With --write, the summary is also kept in the issue's body between
+` + progressStartMarker + ` and ` + progressEndMarker + ` markers,
+replacing the previous summary or appended the first time.`,
+		Example: `  gh pmu sub progress 10
+  gh pmu sub progress 10 --json
+
+  # Keep the summary in the epic's description
+  gh pmu sub progress 10 --write`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runSubProgressWithDeps(cmd, args, opts, cfg, api.NewClient(), time.Now())
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.depth, "depth", 10, "Maximum depth of the tree to include")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+	cmd.Flags().BoolVarP(&opts.write, "write", "w", false, "Write the summary into the issue's body")
+
+	return cmd
+}
+
+// SubProgressPoints is the story points of a tree
+type SubProgressPoints struct {
+	Field       string  `json:"field"`
+	Total       float64 `json:"total"`
+	Done        float64 `json:"done"`        // points of closed issues
+	Percent     int     `json:"percent"`     // done as a whole percentage of total
+	Unestimated int     `json:"unestimated"` // issues without an estimate
+}
+
+// SubProgress is the completion of an issue's sub-issue tree
+type SubProgress struct {
+	Issue    string             `json:"issue"`
+	Title    string             `json:"title"`
+	Total    int                `json:"total"`
+	Closed   int                `json:"closed"`
+	Open     int                `json:"open"`
+	Percent  int                `json:"percent"`
+	Points   *SubProgressPoints `json:"points,omitempty"`
+	Branches []*SubTreeNode     `json:"branches"`
+}
+
+// runSubProgressWithDeps is the testable implementation of sub progress
+func runSubProgressWithDeps(cmd *cobra.Command, args []string, opts *subProgressOptions, cfg *config.Config, client subProgressClient, now time.Time) error {
+	if opts.depth < 1 {
+		return fmt.Errorf("--depth must be at least 1")
+	}
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	owner, repo, number, _ := parseIssueReference(key)
+
+	issue, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+	if issue.Repository.Owner == "" || issue.Repository.Name == "" {
+		issue.Repository = api.Repository{Owner: owner, Name: repo}
+	}
+
+	root, err := buildSubTree(client, issue, opts.depth)
+	if err != nil {
+		return err
+	}
+	if len(root.Children) == 0 {
+		return fmt.Errorf("#%d has no sub-issues", number)
+	}
+
+	progress := &SubProgress{
+		Issue:    key,
+		Title:    issue.Title,
+		Total:    root.Progress.Total,
+		Closed:   root.Progress.Closed,
+		Open:     root.Progress.Total - root.Progress.Closed,
+		Percent:  root.Progress.Percent,
+		Branches: root.Children,
+	}
+	progress.Points, err = subProgressPoints(client, cfg, root)
+	if err != nil {
+		return err
+	}
+
+	if opts.write {
+		body := replaceProgressSummary(issue.Body, buildProgressSummary(progress, now))
+		if body != issue.Body {
+			if err := client.UpdateIssue(owner, repo, issue.ID, api.IssueUpdate{Body: &body}); err != nil {
+				return fmt.Errorf("failed to update #%d: %w", number, err)
+			}
+		}
+	}
+
+	if opts.json {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(progress)
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Progress of #%d: %s\n\n", number, issue.Title)
+	fmt.Fprintf(out, "  %s %d%%  %d of %d issues closed, %d open\n",
+		renderProgressBar(progress.Closed, progress.Total, 20), progress.Percent, progress.Closed, progress.Total, progress.Open)
+	if p := progress.Points; p != nil {
+		fmt.Fprintf(out, "  %s %d%%  %s of %s %s done",
+			renderProgressBar(p.Percent, 100, 20), p.Percent, formatPoints(p.Done), formatPoints(p.Total), strings.ToLower(p.Field))
+		if p.Unestimated > 0 {
+			fmt.Fprintf(out, ", %d without an estimate", p.Unestimated)
+		}
+		fmt.Fprintln(out)
+	}
+	fmt.Fprintln(out)
+	for _, child := range root.Children {
+		ref := fmt.Sprintf("#%d", child.Number)
+		if child.Repository != root.Repository {
+			ref = child.Repository + ref
+		}
+		fmt.Fprintf(out, "  %s %s - %s%s\n", subTreeGlyph(child.State), ref, child.Title, subTreeProgress(child))
+	}
+	if opts.write {
+		fmt.Fprintf(out, "\n✓ Updated the progress summary in #%d\n", number)
+	}
+	return nil
+}
+
+// subProgressPoints sums the estimates of the tree's issues, or returns nil
+// when the project has no estimate field
+func subProgressPoints(client subProgressClient, cfg *config.Config, root *SubTreeNode) (*SubProgressPoints, error) {
+	fieldName := cfg.FieldNameOr("estimate", defaultEstimateFieldName)
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	fields, err := client.GetProjectFields(project.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project fields: %w", err)
+	}
+	found := false
+	for _, f := range fields {
+		if strings.EqualFold(f.Name, fieldName) {
+			fieldName, found = f.Name, true
+			break
+		}
+	}
+	if !found {
+		return nil, nil
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project items: %w", err)
+	}
+	estimates := make(map[string]string)
+	for _, item := range items {
+		if item.Issue != nil {
+			estimates[itemKey(item)] = getFieldValue(item, fieldName)
+		}
+	}
+
+	points := &SubProgressPoints{Field: fieldName}
+	var walk func(node *SubTreeNode)
+	walk = func(node *SubTreeNode) {
+		for _, child := range node.Children {
+			value, err := strconv.ParseFloat(estimates[localstore.Key(child.Repository, child.Number)], 64)
+			if err != nil {
+				points.Unestimated++
+			} else {
+				points.Total += value
+				if child.State == "CLOSED" {
+					points.Done += value
+				}
+			}
+			walk(child)
+		}
+	}
+	walk(root)
+	if points.Total > 0 {
+		points.Percent = int(points.Done * 100 / points.Total)
+	}
+	return points, nil
+}
+
+// buildProgressSummary renders the summary kept in the issue's body
+func buildProgressSummary(p *SubProgress, now time.Time) string {
+	var b strings.Builder
+	b.WriteString(progressStartMarker + "\n")
+	fmt.Fprintf(&b, "**Progress:** %d%% complete, %d of %d sub-issues closed", p.Percent, p.Closed, p.Total)
+	if p.Points != nil {
+		fmt.Fprintf(&b, " · %s of %s %s done (%d%%)",
+			formatPoints(p.Points.Done), formatPoints(p.Points.Total), strings.ToLower(p.Points.Field), p.Points.Percent)
+	}
+	fmt.Fprintf(&b, "\n<sub>Updated %s by gh pmu sub progress</sub>\n", now.Format("2006-01-02"))
+	b.WriteString(progressEndMarker)
+	return b.String()
+}
+
+// replaceProgressSummary puts the summary between the markers in body,
+// appending it when the body has none
+func replaceProgressSummary(body, summary string) string {
+	start := strings.Index(body, progressStartMarker)
+	if start >= 0 {
+		if end := strings.Index(body[start:], progressEndMarker); end >= 0 {
+			return body[:start] + summary + body[start+end+len(progressEndMarker):]
+		}
+	}
+	body = strings.TrimRight(body, "\n")
+	if body == "" {
+		return summary + "\n"
+	}
+	return body + "\n\n" + summary + "\n"
+}
+
+// formatPoints formats story points without trailing zeros
+func formatPoints(points float64) string {
+	return strconv.FormatFloat(points, 'f', -1, 64)
+}
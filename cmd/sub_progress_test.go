@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubProgressClient implements subProgressClient interface for testing,
+// with sub-issues, closed issues, and estimates by issue number in owner/repo
+type mockSubProgressClient struct {
+	children  map[int][]int
+	closed    map[int]bool
+	estimates map[int]string
+	fields    []api.ProjectField
+	body      string
+	updated   *string
+}
+
+func (m *mockSubProgressClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{ID: fmt.Sprintf("issue-%d", number), Number: number, Title: fmt.Sprintf("Issue %d", number),
+		Body: m.body, Repository: api.Repository{Owner: owner, Name: repo}}, nil
+}
+
+func (m *mockSubProgressClient) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	var subs []api.SubIssue
+	for _, n := range m.children[number] {
+		state := "OPEN"
+		if m.closed[n] {
+			state = "CLOSED"
+		}
+		subs = append(subs, api.SubIssue{Number: n, Title: fmt.Sprintf("Issue %d", n), State: state,
+			Repository: api.Repository{Owner: owner, Name: repo}})
+	}
+	return subs, nil
+}
+
+func (m *mockSubProgressClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockSubProgressClient) GetProjectFields(projectID string) ([]api.ProjectField, error) {
+	return m.fields, nil
+}
+
+func (m *mockSubProgressClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	var items []api.ProjectItem
+	for n, estimate := range m.estimates {
+		items = append(items, api.ProjectItem{
+			Issue:       &api.Issue{Number: n, Repository: api.Repository{Owner: "owner", Name: "repo"}},
+			FieldValues: []api.FieldValue{{Field: "Estimate", Value: estimate}},
+		})
+	}
+	return items, nil
+}
+
+func (m *mockSubProgressClient) UpdateIssue(owner, repo, issueID string, update api.IssueUpdate) error {
+	m.updated = update.Body
+	return nil
+}
+
+// newSubProgressTestClient returns #10 with #11 (closed) and #12, which
+// has #13 (closed) and #14; all but #14 are estimated
+func newSubProgressTestClient() *mockSubProgressClient {
+	return &mockSubProgressClient{
+		children:  map[int][]int{10: {11, 12}, 12: {13, 14}},
+		closed:    map[int]bool{11: true, 13: true},
+		estimates: map[int]string{11: "3", 12: "5", 13: "2"},
+		fields:    []api.ProjectField{{Name: "Status"}, {Name: "Estimate"}},
+		body:      "Epic description",
+	}
+}
+
+func TestRunSubProgress_Counts(t *testing.T) {
+	cmd, buf := newTestCmd()
+	if err := runSubProgressWithDeps(cmd, []string{"10"}, &subProgressOptions{depth: 10}, newTestConfig(), newSubProgressTestClient(), time.Now()); err != nil {
+		t.Fatalf("runSubProgressWithDeps() error = %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"50%  2 of 4 issues closed, 2 open",
+		"50%  5 of 10 estimate done, 1 without an estimate",
+		"✓ #11 - Issue 11",
+		"○ #12 - Issue 12  [50%, 1/2]",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunSubProgress_NoEstimateField(t *testing.T) {
+	client := newSubProgressTestClient()
+	client.fields = []api.ProjectField{{Name: "Status"}}
+	cmd, buf := newTestCmd()
+	if err := runSubProgressWithDeps(cmd, []string{"10"}, &subProgressOptions{depth: 10, json: true}, newTestConfig(), client, time.Now()); err != nil {
+		t.Fatalf("runSubProgressWithDeps() error = %v", err)
+	}
+	var progress SubProgress
+	if err := json.Unmarshal(buf.Bytes(), &progress); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if progress.Total != 4 || progress.Closed != 2 || progress.Points != nil || len(progress.Branches) != 2 {
+		t.Errorf("Unexpected progress: %+v", progress)
+	}
+}
+
+func TestRunSubProgress_Write(t *testing.T) {
+	client := newSubProgressTestClient()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	cmd, _ := newTestCmd()
+	if err := runSubProgressWithDeps(cmd, []string{"10"}, &subProgressOptions{depth: 10, write: true}, newTestConfig(), client, now); err != nil {
+		t.Fatalf("runSubProgressWithDeps() error = %v", err)
+	}
+	if client.updated == nil {
+		t.Fatal("Expected the body to be updated")
+	}
+	body := *client.updated
+	if !strings.HasPrefix(body, "Epic description\n\n"+progressStartMarker) || !strings.Contains(body, "2 of 4 sub-issues closed") ||
+		!strings.Contains(body, "Updated 2026-03-02") {
+		t.Errorf("Unexpected body:\n%s", body)
+	}
+
+	// A second run replaces the summary, and changes nothing when it is current
+	client.closed[14] = true
+	client.body = body
+	client.updated = nil
+	if err := runSubProgressWithDeps(cmd, []string{"10"}, &subProgressOptions{depth: 10, write: true}, newTestConfig(), client, now); err != nil {
+		t.Fatal(err)
+	}
+	if client.updated == nil || strings.Count(*client.updated, progressStartMarker) != 1 || !strings.Contains(*client.updated, "3 of 4") {
+		t.Errorf("Expected the summary replaced, got %v", client.updated)
+	}
+	client.body = *client.updated
+	client.updated = nil
+	if err := runSubProgressWithDeps(cmd, []string{"10"}, &subProgressOptions{depth: 10, write: true}, newTestConfig(), client, now); err != nil {
+		t.Fatal(err)
+	}
+	if client.updated != nil {
+		t.Error("Expected no update when the summary is current")
+	}
+}
+
+func TestRunSubProgress_NoSubIssues(t *testing.T) {
+	cmd, _ := newTestCmd()
+	err := runSubProgressWithDeps(cmd, []string{"11"}, &subProgressOptions{depth: 10}, newTestConfig(), newSubProgressTestClient(), time.Now())
+	if err == nil || !strings.Contains(err.Error(), "has no sub-issues") {
+		t.Errorf("Expected a no sub-issues error, got %v", err)
+	}
+}
+
+func TestReplaceProgressSummary(t *testing.T) {
+	summary := progressStartMarker + "\nnew\n" + progressEndMarker
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"empty body", "", summary + "\n"},
+		{"appended", "Text\n", "Text\n\n" + summary + "\n"},
+		{"replaced in place", "A\n" + progressStartMarker + "\nold\n" + progressEndMarker + "\nB", "A\n" + summary + "\nB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := replaceProgressSummary(tt.body, summary); got != tt.want {
+				t.Errorf("replaceProgressSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+type subPromoteOptions struct {
+	copyFields bool
+	fields     []string
+}
+
+// subPromoteClient defines the interface for API methods used by sub promote.
+// This allows for easier testing with mock implementations.
+type subPromoteClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetParentIssue(owner, repo string, number int) (*api.Issue, error)
+	RemoveSubIssue(parentIssueID, childIssueID string) error
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	AddIssueToProject(projectID, issueID string) (string, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
+func newSubPromoteCommand() *cobra.Command {
+	opts := &subPromoteOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "promote <issue>",
+		Short: "Promote a sub-issue to a standalone issue",
+		Long: `Promote a sub-issue to a standalone issue by removing its parent link.
+
+Use this when a task turns out to be a full story. With --copy-fields,
+the parent's project fields (status, priority, iteration, ...) are
+copied to the promoted issue, adding it to the project if needed;
+--fields copies only the named fields.
+
+The promoted issue can then be broken down itself with 'gh pmu split'.`,
+		Example: `  gh pmu sub promote 15
+  gh pmu sub promote 15 --copy-fields
+  gh pmu sub promote 15 --fields Priority,Iteration`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runSubPromoteWithDeps(cmd, args, opts, cfg, api.NewClient())
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.copyFields, "copy-fields", false, "Copy the parent's project fields to the promoted issue")
+	cmd.Flags().StringSliceVar(&opts.fields, "fields", nil, "Copy only these fields from the parent (implies --copy-fields)")
+
+	return cmd
+}
+
+// runSubPromoteWithDeps is the testable implementation of sub promote
+func runSubPromoteWithDeps(cmd *cobra.Command, args []string, opts *subPromoteOptions, cfg *config.Config, client subPromoteClient) error {
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+	owner, repo, number, _ := parseIssueReference(key)
+
+	issue, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue %s: %w", key, err)
+	}
+	parent, err := client.GetParentIssue(owner, repo, number)
+	if err != nil {
+		return err
+	}
+	if parent == nil {
+		return fmt.Errorf("#%d is not a sub-issue; it is already standalone", number)
+	}
+	parentOwner, parentRepo := parent.Repository.Owner, parent.Repository.Name
+	if parentOwner == "" || parentRepo == "" {
+		parentOwner, parentRepo = owner, repo
+	}
+
+	// Read the parent's fields before unlinking, so a failure leaves nothing changed
+	var copied []api.FieldValue
+	var projectID string
+	if opts.copyFields || len(opts.fields) > 0 {
+		project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+		if err != nil {
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+		projectID = project.ID
+		parentItem, err := client.GetIssueProjectItem(parentOwner, parentRepo, parent.Number, projectID)
+		if err != nil {
+			return fmt.Errorf("failed to read the parent's project fields: %w", err)
+		}
+		if parentItem == nil {
+			return fmt.Errorf("parent #%d is not in the project; there are no fields to copy", parent.Number)
+		}
+		copied = promotedFieldValues(parentItem.FieldValues, opts.fields)
+	}
+
+	if err := client.RemoveSubIssue(parent.ID, issue.ID); err != nil {
+		return fmt.Errorf("failed to remove parent link: %w", err)
+	}
+
+	parentRef := subMoveRef(parentOwner, parentRepo, parent.Number, cfg)
+	cmd.Printf("✓ Promoted %s to a standalone issue (was a sub-issue of %s)\n", subMoveRef(owner, repo, number, cfg), parentRef)
+	cmd.Printf("  Issue:  %s\n", issue.Title)
+	cmd.Printf("  Parent: %s\n", parent.Title)
+
+	if len(copied) > 0 {
+		// Adding an issue that is already in the project returns its item
+		itemID, err := client.AddIssueToProject(projectID, issue.ID)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: failed to add #%d to the project; fields not copied: %v\n", number, err)
+		} else {
+			for _, fv := range copied {
+				if err := client.SetProjectItemField(projectID, itemID, fv.Field, fv.Value); err != nil {
+					fmt.Fprintf(os.Stderr, "Warning: failed to copy %s: %v\n", fv.Field, err)
+					continue
+				}
+				cmd.Printf("  %s: %s\n", fv.Field, fv.Value)
+			}
+		}
+	} else if opts.copyFields || len(opts.fields) > 0 {
+		cmd.Printf("  No fields to copy from %s\n", parentRef)
+	}
+
+	cmd.Printf("\nBreak it down with: gh pmu split %s\n", subMoveRef(owner, repo, number, cfg))
+	return nil
+}
+
+// promotedFieldValues returns the parent's field values to copy: all but
+// the title, or only the named fields. Unknown names are reported.
+func promotedFieldValues(values []api.FieldValue, only []string) []api.FieldValue {
+	var copied []api.FieldValue
+	for _, fv := range values {
+		if strings.EqualFold(fv.Field, "Title") || fv.Value == "" {
+			continue
+		}
+		if len(only) > 0 && !containsFold(only, fv.Field) {
+			continue
+		}
+		copied = append(copied, fv)
+	}
+	for _, name := range only {
+		found := false
+		for _, fv := range copied {
+			if strings.EqualFold(fv.Field, name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			fmt.Fprintf(os.Stderr, "Warning: the parent has no value for %s\n", name)
+		}
+	}
+	return copied
+}
@@ -0,0 +1,159 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubPromoteClient implements subPromoteClient interface for testing,
+// with parent links and project fields by issue number in owner/repo
+type mockSubPromoteClient struct {
+	parents   map[int]int
+	fields    map[int][]api.FieldValue
+	removed   []string
+	fieldsSet map[string]string
+}
+
+func (m *mockSubPromoteClient) issue(number int) *api.Issue {
+	return &api.Issue{ID: fmt.Sprintf("issue-%d", number), Number: number, Title: fmt.Sprintf("Issue %d", number),
+		Repository: api.Repository{Owner: "owner", Name: "repo"}}
+}
+
+func (m *mockSubPromoteClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return m.issue(number), nil
+}
+
+func (m *mockSubPromoteClient) GetParentIssue(owner, repo string, number int) (*api.Issue, error) {
+	parent, ok := m.parents[number]
+	if !ok {
+		return nil, nil
+	}
+	return m.issue(parent), nil
+}
+
+func (m *mockSubPromoteClient) RemoveSubIssue(parentIssueID, childIssueID string) error {
+	m.removed = append(m.removed, childIssueID+" from "+parentIssueID)
+	return nil
+}
+
+func (m *mockSubPromoteClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockSubPromoteClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	values, ok := m.fields[number]
+	if !ok {
+		return nil, nil
+	}
+	return &api.ProjectItem{ID: fmt.Sprintf("item-%d", number), FieldValues: values}, nil
+}
+
+func (m *mockSubPromoteClient) AddIssueToProject(projectID, issueID string) (string, error) {
+	return "item-" + issueID, nil
+}
+
+func (m *mockSubPromoteClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	if m.fieldsSet == nil {
+		m.fieldsSet = make(map[string]string)
+	}
+	m.fieldsSet[fieldName] = value
+	return nil
+}
+
+// newSubPromoteTestClient returns #10 with sub-issue #11; #10 is in the project
+func newSubPromoteTestClient() *mockSubPromoteClient {
+	return &mockSubPromoteClient{
+		parents: map[int]int{11: 10},
+		fields: map[int][]api.FieldValue{10: {
+			{Field: "Title", Value: "Issue 10"},
+			{Field: "Status", Value: "In progress"},
+			{Field: "Priority", Value: "P1"},
+			{Field: "Iteration", Value: "Sprint 4"},
+		}},
+	}
+}
+
+func TestRunSubPromote_RemovesParentLink(t *testing.T) {
+	client := newSubPromoteTestClient()
+	cmd, buf := newTestCmd()
+	if err := runSubPromoteWithDeps(cmd, []string{"11"}, &subPromoteOptions{}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubPromoteWithDeps() error = %v", err)
+	}
+	if len(client.removed) != 1 || client.removed[0] != "issue-11 from issue-10" {
+		t.Errorf("Unexpected removals: %v", client.removed)
+	}
+	if len(client.fieldsSet) != 0 {
+		t.Errorf("Expected no fields copied without --copy-fields, got %v", client.fieldsSet)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "✓ Promoted #11 to a standalone issue (was a sub-issue of #10)") || !strings.Contains(out, "gh pmu split #11") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+}
+
+func TestRunSubPromote_CopyFields(t *testing.T) {
+	client := newSubPromoteTestClient()
+	cmd, _ := newTestCmd()
+	if err := runSubPromoteWithDeps(cmd, []string{"11"}, &subPromoteOptions{copyFields: true}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubPromoteWithDeps() error = %v", err)
+	}
+	want := map[string]string{"Status": "In progress", "Priority": "P1", "Iteration": "Sprint 4"}
+	if fmt.Sprint(client.fieldsSet) != fmt.Sprint(want) {
+		t.Errorf("fieldsSet = %v, want %v", client.fieldsSet, want)
+	}
+}
+
+func TestRunSubPromote_OnlyNamedFields(t *testing.T) {
+	client := newSubPromoteTestClient()
+	cmd, _ := newTestCmd()
+	if err := runSubPromoteWithDeps(cmd, []string{"11"}, &subPromoteOptions{fields: []string{"priority"}}, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubPromoteWithDeps() error = %v", err)
+	}
+	if len(client.fieldsSet) != 1 || client.fieldsSet["Priority"] != "P1" {
+		t.Errorf("Expected only Priority copied, got %v", client.fieldsSet)
+	}
+}
+
+func TestRunSubPromote_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		opts    *subPromoteOptions
+		setup   func(*mockSubPromoteClient)
+		wantErr string
+	}{
+		{
+			name:    "not a sub-issue",
+			args:    []string{"10"},
+			opts:    &subPromoteOptions{},
+			wantErr: "already standalone",
+		},
+		{
+			name:    "parent not in project",
+			args:    []string{"11"},
+			opts:    &subPromoteOptions{copyFields: true},
+			setup:   func(m *mockSubPromoteClient) { delete(m.fields, 10) },
+			wantErr: "not in the project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newSubPromoteTestClient()
+			if tt.setup != nil {
+				tt.setup(client)
+			}
+			cmd, _ := newTestCmd()
+			err := runSubPromoteWithDeps(cmd, tt.args, tt.opts, newTestConfig(), client)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if len(client.removed) != 0 {
+				t.Errorf("Expected the link to be kept on error, got %v", client.removed)
+			}
+		})
+	}
+}
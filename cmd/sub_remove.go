@@ -0,0 +1,405 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+type subRemoveOptions struct {
+	force             bool
+	cascade           bool
+	closeOrphans      bool
+	removeFromProject bool
+	depth             int
+	dryRun            bool
+}
+
+// subRemoveClient defines the interface for API methods used by sub remove.
+// This allows for easier testing with mock implementations.
+type subRemoveClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetParentIssue(owner, repo string, number int) (*api.Issue, error)
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+	RemoveSubIssue(parentIssueID, childIssueID string) error
+	CloseIssue(issueID string) error
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	DeleteProjectItem(projectID, itemID string) error
+}
+
+// subRemoveBranch is a child to unlink and the sub-issues below it
+type subRemoveBranch struct {
+	issue       issueInfo
+	descendants []issueInfo // depth first, as collected
+	err         string      // why the child is not unlinked
+}
+
+func newSubRemoveCommand() *cobra.Command {
+	opts := &subRemoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove <parent-issue> <child-issue>...",
+		Short: "Remove sub-issue links from a parent issue",
+		Long: `Remove the sub-issue relationship between a parent and one or more child issues.
+
+This does NOT delete the child issues, only removes the parent-child links.
+The child issues will become standalone issues again, keeping their own
+sub-issues.
+
+--cascade also removes the links below each child at every depth, so no
+issue of the subtree is left under another. --close-orphans closes the
+issues left without a parent, and --remove-from-project removes them from
+the project; otherwise they stay in it. With these options, a child must
+be a sub-issue of the parent, and you are asked to confirm; --force skips
+the prompt. With --cascade or --close-orphans, the sub-issues below each
+child are listed before anything is changed, so you can see what leaves
+the hierarchy with it.
+
+Multiple child issues can be specified to remove in batch.
+
+Examples:
+  gh pmu sub remove 10 15           # Unlink issue #15 from parent #10
+  gh pmu sub remove #10 #15         # Same, with # prefix
+  gh pmu sub remove 10 15 16 17     # Unlink multiple sub-issues at once
+  gh pmu sub remove 10 15 --force   # Skip any confirmation prompts
+  gh pmu sub remove owner/repo#10 owner/repo#15  # Full references
+
+  # Take #15 and everything below it apart, closing the pieces
+  gh pmu sub remove 10 15 --cascade --close-orphans --dry-run`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			err = runSubRemoveWithDeps(cmd, args, opts, cfg, api.NewClient())
+			if opts.closeOrphans || opts.removeFromProject {
+				refreshDaemon(cfg)
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.force, "force", "f", false, "Skip confirmation prompts")
+	cmd.Flags().BoolVar(&opts.cascade, "cascade", false, "Also remove the sub-issue links below each child at every depth")
+	cmd.Flags().BoolVar(&opts.closeOrphans, "close-orphans", false, "Close the issues left without a parent")
+	cmd.Flags().BoolVar(&opts.removeFromProject, "remove-from-project", false, "Remove the issues left without a parent from the project")
+	cmd.Flags().IntVar(&opts.depth, "depth", 10, "Maximum depth of sub-issues to preview and remove")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show the affected issues without changing them")
+
+	return cmd
+}
+
+// runSubRemoveWithDeps is the testable implementation of sub remove
+func runSubRemoveWithDeps(cmd *cobra.Command, args []string, opts *subRemoveOptions, cfg *config.Config, client subRemoveClient) error {
+	if opts.depth < 1 {
+		return fmt.Errorf("--depth must be at least 1")
+	}
+	out := cmd.OutOrStdout()
+
+	// Parse parent issue reference
+	parentOwner, parentRepo, parentNumber, err := parseIssueReference(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid parent issue: %w", err)
+	}
+
+	// Default to configured repo if not specified for parent
+	defaultOwner, defaultRepo := "", ""
+	if len(cfg.Repositories) > 0 {
+		parts := strings.Split(cfg.Repositories[0], "/")
+		if len(parts) == 2 {
+			defaultOwner, defaultRepo = parts[0], parts[1]
+		}
+	}
+
+	if parentOwner == "" || parentRepo == "" {
+		if defaultOwner == "" || defaultRepo == "" {
+			return fmt.Errorf("no repository specified and none configured")
+		}
+		parentOwner = defaultOwner
+		parentRepo = defaultRepo
+	}
+
+	// Validate parent issue exists
+	parentIssue, err := client.GetIssue(parentOwner, parentRepo, parentNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get parent issue #%d: %w", parentNumber, err)
+	}
+
+	// Parse all child issue references (args[1:])
+	var branches []subRemoveBranch
+	for i := 1; i < len(args); i++ {
+		childOwner, childRepo, childNumber, err := parseIssueReference(args[i])
+		if err != nil {
+			return fmt.Errorf("invalid child issue %s: %w", args[i], err)
+		}
+
+		// Default to configured repo if not specified
+		if childOwner == "" || childRepo == "" {
+			if defaultOwner == "" || defaultRepo == "" {
+				return fmt.Errorf("no repository specified for issue %s and none configured", args[i])
+			}
+			childOwner = defaultOwner
+			childRepo = defaultRepo
+		}
+
+		branches = append(branches, subRemoveBranch{
+			issue: issueInfo{Owner: childOwner, Repo: childRepo, Number: childNumber},
+		})
+	}
+
+	extra := opts.cascade || opts.closeOrphans || opts.removeFromProject
+	links, below := 0, 0
+	for i := range branches {
+		resolveSubRemoveBranch(cmd, client, parentIssue, parentNumber, &branches[i], opts)
+		if branches[i].err == "" {
+			links++
+			below += len(branches[i].descendants)
+		}
+	}
+	if opts.cascade {
+		links += below
+	}
+
+	// Preview the issues affected beyond the links given
+	if opts.dryRun || extra {
+		if opts.dryRun {
+			fmt.Fprintln(out, "Dry run - no changes will be made")
+			fmt.Fprintln(out)
+		}
+		fmt.Fprintf(out, "Sub-issues of #%d - %s:\n", parentNumber, parentIssue.Title)
+		for _, b := range branches {
+			writeSubRemoveBranch(out, b, parentNumber, opts)
+		}
+		fmt.Fprintln(out)
+	}
+	if opts.dryRun {
+		return nil
+	}
+
+	if extra && links > 0 && !opts.force {
+		fmt.Fprintf(out, "Proceed with removing %d sub-issue link(s)? [y/N]: ", links)
+		var response string
+		_, _ = fmt.Fscanln(cmd.InOrStdin(), &response)
+		response = strings.ToLower(strings.TrimSpace(response))
+		if response != "y" && response != "yes" {
+			fmt.Fprintln(out, "Aborted.")
+			return nil
+		}
+		fmt.Fprintln(out)
+	}
+
+	projectID := ""
+	if opts.removeFromProject && links > 0 {
+		project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+		if err != nil {
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+		projectID = project.ID
+	}
+
+	// Track results for batch operations
+	var successCount, failCount, problems int
+	var results, details []string
+	warn := func(format string, a ...interface{}) {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: "+format+"\n", a...)
+		problems++
+	}
+
+	// orphaned closes an issue left without a parent and removes it from
+	// the project, as asked
+	orphaned := func(info issueInfo) {
+		if opts.closeOrphans && !strings.EqualFold(info.State, "CLOSED") {
+			if err := client.CloseIssue(info.ID); err != nil {
+				warn("failed to close #%d: %v", info.Number, err)
+			} else {
+				details = append(details, fmt.Sprintf("✓ Closed #%d", info.Number))
+			}
+		}
+		if projectID == "" {
+			return
+		}
+		item, err := client.GetIssueProjectItem(info.Owner, info.Repo, info.Number, projectID)
+		if err != nil {
+			warn("failed to find #%d in the project: %v", info.Number, err)
+			return
+		}
+		if item == nil {
+			return
+		}
+		if err := client.DeleteProjectItem(projectID, item.ID); err != nil {
+			warn("failed to remove #%d from the project: %v", info.Number, err)
+			return
+		}
+		details = append(details, fmt.Sprintf("✓ Removed #%d from the project", info.Number))
+	}
+
+	// Process each child issue
+	for _, b := range branches {
+		if b.err != "" {
+			failCount++
+			results = append(results, fmt.Sprintf("✗ #%d: %s", b.issue.Number, b.err))
+			continue
+		}
+
+		// The links below the child are removed deepest first
+		if opts.cascade {
+			for i := len(b.descendants) - 1; i >= 0; i-- {
+				info, up := b.descendants[i], subRemoveParentOf(b, i)
+				if err := client.RemoveSubIssue(up.ID, info.ID); err != nil {
+					warn("failed to unlink #%d from #%d: %v", info.Number, up.Number, err)
+					continue
+				}
+				details = append(details, fmt.Sprintf("✓ Unlinked #%d from #%d", info.Number, up.Number))
+				orphaned(info)
+			}
+		}
+
+		// Remove sub-issue link
+		err = client.RemoveSubIssue(parentIssue.ID, b.issue.ID)
+		if err != nil {
+			failCount++
+			// Check if not linked
+			errMsg := strings.ToLower(err.Error())
+			if strings.Contains(errMsg, "not a sub-issue") || strings.Contains(errMsg, "not found") {
+				results = append(results, fmt.Sprintf("✗ #%d: not a sub-issue of #%d", b.issue.Number, parentNumber))
+			} else {
+				results = append(results, fmt.Sprintf("✗ #%d: %v", b.issue.Number, err))
+			}
+			continue
+		}
+
+		successCount++
+		results = append(results, fmt.Sprintf("✓ #%d: %s", b.issue.Number, b.issue.Title))
+		orphaned(b.issue)
+	}
+
+	// Output results
+	if len(branches) == 1 {
+		// Single child - use simple output format
+		if successCount == 1 {
+			fmt.Fprintf(out, "✓ Removed sub-issue link: #%d is no longer a sub-issue of #%d\n", branches[0].issue.Number, parentNumber)
+			fmt.Fprintf(out, "  Former parent: %s\n", parentIssue.Title)
+		} else {
+			// Print the failure message
+			for _, r := range results {
+				fmt.Fprintln(out, r)
+			}
+		}
+		for _, d := range details {
+			fmt.Fprintln(out, "  "+d)
+		}
+		if successCount == 0 {
+			return fmt.Errorf("failed to remove sub-issue")
+		}
+	} else {
+		// Multiple children - use batch output format
+		fmt.Fprintf(out, "Removing sub-issues from parent #%d: %s\n\n", parentNumber, parentIssue.Title)
+		for _, r := range results {
+			fmt.Fprintln(out, "  "+r)
+		}
+		if len(details) > 0 {
+			fmt.Fprintln(out)
+			for _, d := range details {
+				fmt.Fprintln(out, "  "+d)
+			}
+		}
+		fmt.Fprintf(out, "\nSummary: %d succeeded, %d failed\n", successCount, failCount)
+
+		if failCount > 0 && successCount == 0 {
+			return fmt.Errorf("all removals failed")
+		}
+	}
+
+	if problems > 0 {
+		return fmt.Errorf("%d follow-up change(s) failed", problems)
+	}
+	return nil
+}
+
+// resolveSubRemoveBranch reads a child and, with --cascade or
+// --close-orphans, the sub-issues below it. With options beyond unlinking,
+// the child must be a sub-issue of the parent, so they never act on the
+// subtree of an issue named by mistake.
+func resolveSubRemoveBranch(cmd *cobra.Command, client subRemoveClient, parent *api.Issue, parentNumber int, b *subRemoveBranch, opts *subRemoveOptions) {
+	issue, err := client.GetIssue(b.issue.Owner, b.issue.Repo, b.issue.Number)
+	if err != nil {
+		b.err = fmt.Sprintf("failed to get issue: %v", err)
+		return
+	}
+	b.issue.Title, b.issue.ID, b.issue.State = issue.Title, issue.ID, issue.State
+
+	if opts.cascade || opts.closeOrphans || opts.removeFromProject {
+		current, err := client.GetParentIssue(b.issue.Owner, b.issue.Repo, b.issue.Number)
+		if err != nil {
+			b.err = err.Error()
+			return
+		}
+		if current == nil || current.ID != parent.ID {
+			b.err = fmt.Sprintf("not a sub-issue of #%d", parentNumber)
+			return
+		}
+	}
+
+	if !opts.cascade && !opts.closeOrphans {
+		return
+	}
+	descendants, err := collectSubIssuesRecursive(client, b.issue.Owner, b.issue.Repo, b.issue.Number, nil, 1, opts.depth)
+	if err != nil {
+		if opts.cascade {
+			b.err = fmt.Sprintf("failed to get sub-issues: %v", err)
+			return
+		}
+		// Only the preview is missing them
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to get sub-issues for #%d: %v\n", b.issue.Number, err)
+	}
+	b.descendants = descendants
+}
+
+// writeSubRemoveBranch previews what happens to a child and to each issue
+// below it
+func writeSubRemoveBranch(w io.Writer, b subRemoveBranch, parentNumber int, opts *subRemoveOptions) {
+	if b.err != "" {
+		fmt.Fprintf(w, "  ✗ #%d: %s\n", b.issue.Number, b.err)
+		return
+	}
+	fmt.Fprintf(w, "  #%d - %s: %s\n", b.issue.Number, b.issue.Title, strings.Join(subRemoveActions(b.issue, parentNumber, opts), ", "))
+	for i, info := range b.descendants {
+		up := subRemoveParentOf(b, i)
+		actions := []string{fmt.Sprintf("stays under #%d", up.Number)}
+		if opts.cascade {
+			actions = subRemoveActions(info, up.Number, opts)
+		}
+		fmt.Fprintf(w, "  %s#%d - %s: %s\n", strings.Repeat("  ", info.Depth), info.Number, info.Title, strings.Join(actions, ", "))
+	}
+}
+
+// subRemoveActions describes what happens to an issue unlinked from its
+// parent
+func subRemoveActions(info issueInfo, parentNumber int, opts *subRemoveOptions) []string {
+	actions := []string{fmt.Sprintf("unlink from #%d", parentNumber)}
+	if opts.closeOrphans && !strings.EqualFold(info.State, "CLOSED") {
+		actions = append(actions, "close")
+	}
+	if opts.removeFromProject {
+		actions = append(actions, "remove from project")
+	}
+	return actions
+}
+
+// subRemoveParentOf returns the direct parent of the i'th descendant of a
+// branch: the nearest issue before it one level up, or the child
+func subRemoveParentOf(b subRemoveBranch, i int) issueInfo {
+	depth := b.descendants[i].Depth
+	for j := i - 1; j >= 0; j-- {
+		if b.descendants[j].Depth == depth-1 {
+			return b.descendants[j]
+		}
+	}
+	return b.issue
+}
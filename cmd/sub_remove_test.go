@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubRemoveClient implements subRemoveClient interface for testing.
+// #10 has sub-issue #15, which has #16 (closed), which has #17; #20 has
+// no parent, and #18 is not in the project.
+type mockSubRemoveClient struct {
+	unlinked []string
+	closed   []string
+	deleted  []string
+	walked   int // GetSubIssues calls
+}
+
+func (m *mockSubRemoveClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	state := "OPEN"
+	if number == 16 {
+		state = "CLOSED"
+	}
+	return &api.Issue{ID: fmt.Sprintf("issue-%d", number), Number: number, Title: fmt.Sprintf("Issue %d", number), State: state}, nil
+}
+
+func (m *mockSubRemoveClient) GetParentIssue(owner, repo string, number int) (*api.Issue, error) {
+	if number == 15 {
+		return &api.Issue{ID: "issue-10", Number: 10}, nil
+	}
+	return nil, nil
+}
+
+func (m *mockSubRemoveClient) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	m.walked++
+	switch number {
+	case 15:
+		return []api.SubIssue{{ID: "issue-16", Number: 16, Title: "Issue 16", State: "CLOSED"}, {ID: "issue-18", Number: 18, Title: "Issue 18", State: "OPEN"}}, nil
+	case 16:
+		return []api.SubIssue{{ID: "issue-17", Number: 17, Title: "Issue 17", State: "OPEN"}}, nil
+	}
+	return nil, nil
+}
+
+func (m *mockSubRemoveClient) RemoveSubIssue(parentIssueID, childIssueID string) error {
+	m.unlinked = append(m.unlinked, childIssueID+"<"+parentIssueID)
+	return nil
+}
+
+func (m *mockSubRemoveClient) CloseIssue(issueID string) error {
+	m.closed = append(m.closed, issueID)
+	return nil
+}
+
+func (m *mockSubRemoveClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockSubRemoveClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	if number == 18 {
+		return nil, nil
+	}
+	return &api.ProjectItem{ID: fmt.Sprintf("item-%d", number)}, nil
+}
+
+func (m *mockSubRemoveClient) DeleteProjectItem(projectID, itemID string) error {
+	m.deleted = append(m.deleted, itemID)
+	return nil
+}
+
+func TestRunSubRemove_PreviewsDescendants(t *testing.T) {
+	client := &mockSubRemoveClient{}
+	cmd, buf := newTestCmd()
+	opts := &subRemoveOptions{closeOrphans: true, depth: 10, force: true}
+	if err := runSubRemoveWithDeps(cmd, []string{"10", "15"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubRemoveWithDeps() error = %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"#15 - Issue 15: unlink from #10, close\n",
+		"    #16 - Issue 16: stays under #15\n",
+		"      #17 - Issue 17: stays under #16\n",
+		"✓ Removed sub-issue link: #15 is no longer a sub-issue of #10",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+	if got := strings.Join(client.unlinked, " "); got != "issue-15<issue-10" {
+		t.Errorf("Unlinked %s, want only #15", got)
+	}
+	if got := strings.Join(client.closed, " "); got != "issue-15" {
+		t.Errorf("Closed %s, want only #15", got)
+	}
+}
+
+func TestRunSubRemove_PlainUnlink(t *testing.T) {
+	client := &mockSubRemoveClient{}
+	cmd, buf := newTestCmd()
+	opts := &subRemoveOptions{depth: 10}
+	if err := runSubRemoveWithDeps(cmd, []string{"10", "15"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubRemoveWithDeps() error = %v", err)
+	}
+
+	if client.walked != 0 {
+		t.Errorf("Expected no sub-issues read, got %d call(s)", client.walked)
+	}
+	out := buf.String()
+	if strings.Contains(out, "#16") || !strings.Contains(out, "✓ Removed sub-issue link: #15 is no longer a sub-issue of #10") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+	if got := strings.Join(client.unlinked, " "); got != "issue-15<issue-10" {
+		t.Errorf("Unlinked %s, want only #15", got)
+	}
+}
+
+func TestRunSubRemove_Cascade(t *testing.T) {
+	client := &mockSubRemoveClient{}
+	cmd, buf := newTestCmd()
+	opts := &subRemoveOptions{cascade: true, closeOrphans: true, removeFromProject: true, depth: 10, force: true}
+	if err := runSubRemoveWithDeps(cmd, []string{"10", "15"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubRemoveWithDeps() error = %v", err)
+	}
+
+	// Deepest first, the child last
+	if got := strings.Join(client.unlinked, " "); got != "issue-18<issue-15 issue-17<issue-16 issue-16<issue-15 issue-15<issue-10" {
+		t.Errorf("Unlinked %s", got)
+	}
+	// #16 is already closed, and #18 is not in the project
+	if got := strings.Join(client.closed, " "); got != "issue-18 issue-17 issue-15" {
+		t.Errorf("Closed %s", got)
+	}
+	if got := strings.Join(client.deleted, " "); got != "item-17 item-16 item-15" {
+		t.Errorf("Removed from project %s", got)
+	}
+	if out := buf.String(); !strings.Contains(out, "#17 - Issue 17: unlink from #16, close, remove from project") ||
+		!strings.Contains(out, "✓ Unlinked #17 from #16") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+}
+
+func TestRunSubRemove_Confirm(t *testing.T) {
+	client := &mockSubRemoveClient{}
+	cmd, buf := newTestCmd()
+	cmd.SetIn(strings.NewReader("n\n"))
+	opts := &subRemoveOptions{cascade: true, depth: 10}
+	if err := runSubRemoveWithDeps(cmd, []string{"10", "15"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubRemoveWithDeps() error = %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "Proceed with removing 4 sub-issue link(s)? [y/N]: Aborted.") {
+		t.Errorf("Expected an aborted prompt, got:\n%s", buf.String())
+	}
+	if len(client.unlinked) != 0 {
+		t.Errorf("Expected nothing unlinked, got %v", client.unlinked)
+	}
+}
+
+func TestRunSubRemove_NotASubIssue(t *testing.T) {
+	client := &mockSubRemoveClient{}
+	cmd, buf := newTestCmd()
+	opts := &subRemoveOptions{cascade: true, closeOrphans: true, depth: 10, force: true}
+	err := runSubRemoveWithDeps(cmd, []string{"10", "20"}, opts, newTestConfig(), client)
+	if err == nil || err.Error() != "failed to remove sub-issue" {
+		t.Fatalf("Expected a failed removal, got %v", err)
+	}
+
+	if !strings.Contains(buf.String(), "✗ #20: not a sub-issue of #10") {
+		t.Errorf("Expected the child to be refused, got:\n%s", buf.String())
+	}
+	if len(client.unlinked) != 0 || len(client.closed) != 0 {
+		t.Errorf("Expected no changes, got unlinked %v, closed %v", client.unlinked, client.closed)
+	}
+}
+
+func TestRunSubRemove_DryRun(t *testing.T) {
+	client := &mockSubRemoveClient{}
+	cmd, buf := newTestCmd()
+	opts := &subRemoveOptions{cascade: true, removeFromProject: true, depth: 1, dryRun: true}
+	if err := runSubRemoveWithDeps(cmd, []string{"10", "15"}, opts, newTestConfig(), client); err != nil {
+		t.Fatalf("runSubRemoveWithDeps() error = %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "Dry run - no changes will be made") || !strings.Contains(out, "#16 - Issue 16: unlink from #15, remove from project") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+	// --depth 1 stops above #17
+	if strings.Contains(out, "#17") {
+		t.Errorf("Expected #17 beyond --depth, got:\n%s", out)
+	}
+	if len(client.unlinked) != 0 || len(client.deleted) != 0 {
+		t.Errorf("Expected no changes, got unlinked %v, deleted %v", client.unlinked, client.deleted)
+	}
+}
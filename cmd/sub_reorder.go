@@ -0,0 +1,280 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/spf13/cobra"
+)
+
+type subReorderOptions struct {
+	move        string
+	before      string
+	after       string
+	interactive bool
+}
+
+// subReorderClient defines the interface for API methods used by sub reorder.
+// This allows for easier testing with mock implementations.
+type subReorderClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+	ReprioritizeSubIssue(parentIssueID, subIssueID, afterID, beforeID string) error
+}
+
+func newSubReorderCommand() *cobra.Command {
+	opts := &subReorderOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "reorder <parent-issue>",
+		Short: "Change the order of an issue's sub-issues",
+		Long: `Change the order of an issue's sub-issues, as shown in GitHub's UI.
+
+Move one sub-issue with --move and either --before or --after another.
+With --interactive, the sub-issues are listed and you type their new
+order by number; any left out keep their relative order after the ones
+given.
+
+Sub-issues are given as on the command line (15, #15, owner/repo#15); a
+bare number refers to the parent's repository.`,
+		Example: `  # Make #15 the first child of #10
+  gh pmu sub reorder 10 --move 15 --before 12
+
+  gh pmu sub reorder 10 --move 15 --after 13
+
+  # Reprioritize all children of #10
+  gh pmu sub reorder 10 --interactive`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			return runSubReorderWithDeps(cmd, args, opts, cfg, api.NewClient(), bufio.NewReader(cmd.InOrStdin()))
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.move, "move", "", "Sub-issue to move")
+	cmd.Flags().StringVar(&opts.before, "before", "", "Place the sub-issue directly before this one")
+	cmd.Flags().StringVar(&opts.after, "after", "", "Place the sub-issue directly after this one")
+	cmd.Flags().BoolVarP(&opts.interactive, "interactive", "i", false, "Choose the new order from a numbered list")
+
+	return cmd
+}
+
+// runSubReorderWithDeps is the testable implementation of sub reorder
+func runSubReorderWithDeps(cmd *cobra.Command, args []string, opts *subReorderOptions, cfg *config.Config, client subReorderClient, reader *bufio.Reader) error {
+	if opts.interactive && (opts.move != "" || opts.before != "" || opts.after != "") {
+		return fmt.Errorf("--interactive cannot be combined with --move, --before, or --after")
+	}
+	if !opts.interactive {
+		if opts.move == "" {
+			return fmt.Errorf("--move is required unless --interactive is used")
+		}
+		if (opts.before == "") == (opts.after == "") {
+			return fmt.Errorf("exactly one of --before and --after is required with --move")
+		}
+	}
+
+	owner, repo, number, err := parseIssueReference(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid parent issue: %w", err)
+	}
+	if owner == "" || repo == "" {
+		if len(cfg.Repositories) == 0 {
+			return fmt.Errorf("no repository specified and none configured")
+		}
+		owner, repo = splitRepository(cfg.Repositories[0])
+	}
+
+	parent, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get parent issue #%d: %w", number, err)
+	}
+	children, err := client.GetSubIssues(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get sub-issues: %w", err)
+	}
+	if len(children) < 2 {
+		return fmt.Errorf("#%d has %d sub-issues; there is nothing to reorder", number, len(children))
+	}
+	if parent.Repository.Owner == "" || parent.Repository.Name == "" {
+		parent.Repository = api.Repository{Owner: owner, Name: repo}
+	}
+
+	var desired []api.SubIssue
+	if opts.interactive {
+		desired, err = promptSubIssueOrder(cmd, parent, children, reader)
+		if err != nil {
+			return err
+		}
+	} else {
+		desired, err = movedSubIssueOrder(children, opts, owner, repo)
+		if err != nil {
+			return err
+		}
+	}
+
+	moves, err := applySubIssueOrder(client, parent.ID, children, desired)
+	if err != nil {
+		return err
+	}
+	if moves == 0 {
+		cmd.Println("Order unchanged")
+		return nil
+	}
+
+	cmd.Printf("✓ Reordered %d sub-issues of #%d: %s\n", moves, number, parent.Title)
+	for i, sub := range desired {
+		cmd.Printf("  %d. %s - %s\n", i+1, subIssueRef(sub, parent), sub.Title)
+	}
+	return nil
+}
+
+// findSubIssue returns the index of the sub-issue a reference names. A
+// bare number refers to the parent's repository.
+func findSubIssue(children []api.SubIssue, ref, owner, repo string) (int, error) {
+	refOwner, refRepo, number, err := parseIssueReference(ref)
+	if err != nil {
+		return -1, err
+	}
+	if refOwner == "" || refRepo == "" {
+		refOwner, refRepo = owner, repo
+	}
+	for i, sub := range children {
+		subOwner, subRepo := sub.Repository.Owner, sub.Repository.Name
+		if subOwner == "" || subRepo == "" {
+			subOwner, subRepo = owner, repo
+		}
+		if sub.Number == number && strings.EqualFold(subOwner, refOwner) && strings.EqualFold(subRepo, refRepo) {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("%s is not a sub-issue of %s/%s", ref, owner, repo)
+}
+
+// movedSubIssueOrder returns the order after moving --move before or after
+// another sub-issue
+func movedSubIssueOrder(children []api.SubIssue, opts *subReorderOptions, owner, repo string) ([]api.SubIssue, error) {
+	from, err := findSubIssue(children, opts.move, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	anchorRef := opts.before
+	if anchorRef == "" {
+		anchorRef = opts.after
+	}
+	anchor, err := findSubIssue(children, anchorRef, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+	if anchor == from {
+		return nil, fmt.Errorf("cannot move %s relative to itself", opts.move)
+	}
+
+	moved := children[from]
+	order := make([]api.SubIssue, 0, len(children))
+	for i, sub := range children {
+		if i == from {
+			continue
+		}
+		if i == anchor && opts.before != "" {
+			order = append(order, moved)
+		}
+		order = append(order, sub)
+		if i == anchor && opts.after != "" {
+			order = append(order, moved)
+		}
+	}
+	return order, nil
+}
+
+// promptSubIssueOrder lists the sub-issues and reads their new order as
+// numbers; sub-issues left out keep their relative order after the rest
+func promptSubIssueOrder(cmd *cobra.Command, parent *api.Issue, children []api.SubIssue, reader *bufio.Reader) ([]api.SubIssue, error) {
+	cmd.Printf("Sub-issues of #%d: %s\n\n", parent.Number, parent.Title)
+	for i, sub := range children {
+		state := "[ ]"
+		if sub.State == "CLOSED" {
+			state = "[x]"
+		}
+		cmd.Printf("  %d. %s %s - %s\n", i+1, state, subIssueRef(sub, parent), sub.Title)
+	}
+	cmd.Print("\nNew order (e.g. 3 1 2; blank keeps the current order): ")
+
+	input, _ := reader.ReadString('\n')
+	fields := strings.FieldsFunc(input, func(r rune) bool { return r == ' ' || r == ',' || r == '\t' || r == '\n' || r == '\r' })
+	if len(fields) == 0 {
+		return children, nil
+	}
+
+	used := make([]bool, len(children))
+	order := make([]api.SubIssue, 0, len(children))
+	for _, f := range fields {
+		n, err := strconv.Atoi(f)
+		if err != nil || n < 1 || n > len(children) {
+			return nil, fmt.Errorf("invalid position: %s (must be 1-%d)", f, len(children))
+		}
+		if used[n-1] {
+			return nil, fmt.Errorf("position %d is given more than once", n)
+		}
+		used[n-1] = true
+		order = append(order, children[n-1])
+	}
+	for i, sub := range children {
+		if !used[i] {
+			order = append(order, sub)
+		}
+	}
+	cmd.Println()
+	return order, nil
+}
+
+// applySubIssueOrder reorders the sub-issues from current to desired,
+// placing each out-of-place sub-issue after its new predecessor (or before
+// the first), and returns how many were moved
+func applySubIssueOrder(client subReorderClient, parentID string, current, desired []api.SubIssue) (int, error) {
+	order := append([]api.SubIssue{}, current...)
+	moves := 0
+	for i, sub := range desired {
+		if order[i].ID == sub.ID {
+			continue
+		}
+
+		var err error
+		if i == 0 {
+			err = client.ReprioritizeSubIssue(parentID, sub.ID, "", order[0].ID)
+		} else {
+			err = client.ReprioritizeSubIssue(parentID, sub.ID, desired[i-1].ID, "")
+		}
+		if err != nil {
+			return moves, fmt.Errorf("failed to move #%d: %w", sub.Number, err)
+		}
+		moves++
+
+		// Mirror the move in the local order
+		for j := i + 1; j < len(order); j++ {
+			if order[j].ID == sub.ID {
+				copy(order[i+1:j+1], order[i:j])
+				order[i] = sub
+				break
+			}
+		}
+	}
+	return moves, nil
+}
+
+// subIssueRef returns #number, or owner/repo#number for a sub-issue in
+// another repository than the parent
+func subIssueRef(sub api.SubIssue, parent *api.Issue) string {
+	if sub.Repository.Owner != "" && sub.Repository.Name != "" &&
+		!(strings.EqualFold(sub.Repository.Owner, parent.Repository.Owner) && strings.EqualFold(sub.Repository.Name, parent.Repository.Name)) {
+		return localstore.Key(sub.Repository.Owner+"/"+sub.Repository.Name, sub.Number)
+	}
+	return fmt.Sprintf("#%d", sub.Number)
+}
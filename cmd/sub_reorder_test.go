@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubReorderClient implements subReorderClient interface for testing,
+// applying moves to its list of sub-issues
+type mockSubReorderClient struct {
+	children []api.SubIssue
+	calls    []string
+}
+
+func (m *mockSubReorderClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{ID: "parent", Number: number, Title: "Epic", Repository: api.Repository{Owner: owner, Name: repo}}, nil
+}
+
+func (m *mockSubReorderClient) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	return append([]api.SubIssue{}, m.children...), nil
+}
+
+func (m *mockSubReorderClient) ReprioritizeSubIssue(parentIssueID, subIssueID, afterID, beforeID string) error {
+	m.calls = append(m.calls, fmt.Sprintf("%s after=%s before=%s", subIssueID, afterID, beforeID))
+
+	var moved api.SubIssue
+	rest := []api.SubIssue{}
+	for _, sub := range m.children {
+		if sub.ID == subIssueID {
+			moved = sub
+		} else {
+			rest = append(rest, sub)
+		}
+	}
+	m.children = []api.SubIssue{}
+	for _, sub := range rest {
+		if sub.ID == beforeID {
+			m.children = append(m.children, moved)
+		}
+		m.children = append(m.children, sub)
+		if sub.ID == afterID {
+			m.children = append(m.children, moved)
+		}
+	}
+	return nil
+}
+
+func (m *mockSubReorderClient) order() string {
+	var numbers []string
+	for _, sub := range m.children {
+		numbers = append(numbers, fmt.Sprint(sub.Number))
+	}
+	return strings.Join(numbers, " ")
+}
+
+func newSubReorderTestClient() *mockSubReorderClient {
+	client := &mockSubReorderClient{}
+	for _, n := range []int{11, 12, 13, 14} {
+		client.children = append(client.children, api.SubIssue{ID: fmt.Sprintf("sub-%d", n), Number: n, Title: fmt.Sprintf("Task %d", n), State: "OPEN"})
+	}
+	return client
+}
+
+func runSubReorderTest(t *testing.T, client *mockSubReorderClient, opts *subReorderOptions, input string) (string, error) {
+	t.Helper()
+	cmd, buf := newTestCmd()
+	err := runSubReorderWithDeps(cmd, []string{"10"}, opts, newTestConfig(), client, bufio.NewReader(strings.NewReader(input)))
+	return buf.String(), err
+}
+
+func TestRunSubReorder_MoveBefore(t *testing.T) {
+	client := newSubReorderTestClient()
+	out, err := runSubReorderTest(t, client, &subReorderOptions{move: "14", before: "#12"}, "")
+	if err != nil {
+		t.Fatalf("runSubReorderWithDeps() error = %v", err)
+	}
+	if client.order() != "11 14 12 13" {
+		t.Errorf("Expected order 11 14 12 13, got %s", client.order())
+	}
+	if len(client.calls) != 1 || client.calls[0] != "sub-14 after=sub-11 before=" {
+		t.Errorf("Expected one move, got %v", client.calls)
+	}
+	if !strings.Contains(out, "2. #14 - Task 14") {
+		t.Errorf("Expected the new order, got:\n%s", out)
+	}
+}
+
+func TestRunSubReorder_MoveToTop(t *testing.T) {
+	client := newSubReorderTestClient()
+	if _, err := runSubReorderTest(t, client, &subReorderOptions{move: "13", before: "11"}, ""); err != nil {
+		t.Fatalf("runSubReorderWithDeps() error = %v", err)
+	}
+	if client.order() != "13 11 12 14" || client.calls[0] != "sub-13 after= before=sub-11" {
+		t.Errorf("Unexpected order %s from %v", client.order(), client.calls)
+	}
+}
+
+func TestRunSubReorder_MoveAfter(t *testing.T) {
+	client := newSubReorderTestClient()
+	if _, err := runSubReorderTest(t, client, &subReorderOptions{move: "11", after: "14"}, ""); err != nil {
+		t.Fatalf("runSubReorderWithDeps() error = %v", err)
+	}
+	if client.order() != "12 13 14 11" {
+		t.Errorf("Expected order 12 13 14 11, got %s", client.order())
+	}
+}
+
+func TestRunSubReorder_Interactive(t *testing.T) {
+	client := newSubReorderTestClient()
+	out, err := runSubReorderTest(t, client, &subReorderOptions{interactive: true}, "4 2\n")
+	if err != nil {
+		t.Fatalf("runSubReorderWithDeps() error = %v", err)
+	}
+	if client.order() != "14 12 11 13" {
+		t.Errorf("Expected order 14 12 11 13, got %s", client.order())
+	}
+	if !strings.Contains(out, "1. [ ] #11 - Task 11") || !strings.Contains(out, "Reordered 2 sub-issues") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+}
+
+func TestRunSubReorder_InteractiveBlankKeepsOrder(t *testing.T) {
+	client := newSubReorderTestClient()
+	out, err := runSubReorderTest(t, client, &subReorderOptions{interactive: true}, "\n")
+	if err != nil {
+		t.Fatalf("runSubReorderWithDeps() error = %v", err)
+	}
+	if len(client.calls) != 0 || !strings.Contains(out, "Order unchanged") {
+		t.Errorf("Expected no moves, got %v\n%s", client.calls, out)
+	}
+}
+
+func TestRunSubReorder_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  *subReorderOptions
+		input string
+		want  string
+	}{
+		{"no move", &subReorderOptions{}, "", "--move is required"},
+		{"both anchors", &subReorderOptions{move: "11", before: "12", after: "13"}, "", "exactly one of --before and --after"},
+		{"interactive with move", &subReorderOptions{interactive: true, move: "11"}, "", "cannot be combined"},
+		{"not a child", &subReorderOptions{move: "99", before: "11"}, "", "is not a sub-issue"},
+		{"itself", &subReorderOptions{move: "11", after: "11"}, "", "relative to itself"},
+		{"bad position", &subReorderOptions{interactive: true}, "5 1\n", "invalid position"},
+		{"repeated position", &subReorderOptions{interactive: true}, "1 1\n", "more than once"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newSubReorderTestClient()
+			_, err := runSubReorderTest(t, client, tt.opts, tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+			if len(client.calls) != 0 {
+				t.Errorf("Expected no moves, got %v", client.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// subTreeClient defines the interface for API methods used by sub list --tree.
+// This allows for easier testing with mock implementations.
+type subTreeClient interface {
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+}
+
+// SubTreeProgress is how much of a branch is complete
+type SubTreeProgress struct {
+	Total   int `json:"total"`   // all descendants
+	Closed  int `json:"closed"`  // closed descendants
+	Percent int `json:"percent"` // closed as a whole percentage of total
+}
+
+// SubTreeNode is an issue in a sub-issue hierarchy
+type SubTreeNode struct {
+	Number     int              `json:"number"`
+	Title      string           `json:"title"`
+	State      string           `json:"state"`
+	URL        string           `json:"url"`
+	Repository string           `json:"repository"` // owner/repo format
+	Progress   *SubTreeProgress `json:"progress,omitempty"`
+	Children   []*SubTreeNode   `json:"children"`
+}
+
+// buildSubTree walks the sub-issues of an issue down to maxDepth levels,
+// computing the completion of each branch. An issue already seen on the
+// walk is not descended into again.
+func buildSubTree(client subTreeClient, issue *api.Issue, maxDepth int) (*SubTreeNode, error) {
+	root := &SubTreeNode{
+		Number:     issue.Number,
+		Title:      issue.Title,
+		State:      issue.State,
+		URL:        issue.URL,
+		Repository: issue.Repository.Owner + "/" + issue.Repository.Name,
+	}
+	seen := map[string]bool{localstore.Key(root.Repository, root.Number): true}
+	if err := growSubTree(client, root, 1, maxDepth, seen); err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func growSubTree(client subTreeClient, node *SubTreeNode, depth, maxDepth int, seen map[string]bool) error {
+	node.Children = []*SubTreeNode{}
+	if depth > maxDepth {
+		return nil
+	}
+
+	owner, repo, _ := strings.Cut(node.Repository, "/")
+	subIssues, err := client.GetSubIssues(owner, repo, node.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get sub-issues of %s#%d: %w", node.Repository, node.Number, err)
+	}
+
+	for _, sub := range subIssues {
+		child := &SubTreeNode{
+			Number:     sub.Number,
+			Title:      sub.Title,
+			State:      sub.State,
+			URL:        sub.URL,
+			Repository: node.Repository,
+		}
+		if sub.Repository.Owner != "" && sub.Repository.Name != "" {
+			child.Repository = sub.Repository.Owner + "/" + sub.Repository.Name
+		}
+		node.Children = append(node.Children, child)
+
+		key := localstore.Key(child.Repository, child.Number)
+		if seen[key] {
+			child.Children = []*SubTreeNode{}
+			continue
+		}
+		seen[key] = true
+		if err := growSubTree(client, child, depth+1, maxDepth, seen); err != nil {
+			return err
+		}
+	}
+
+	if len(node.Children) > 0 {
+		progress := &SubTreeProgress{}
+		for _, child := range node.Children {
+			progress.Total++
+			if child.State == "CLOSED" {
+				progress.Closed++
+			}
+			if child.Progress != nil {
+				progress.Total += child.Progress.Total
+				progress.Closed += child.Progress.Closed
+			}
+		}
+		progress.Percent = progress.Closed * 100 / progress.Total
+		node.Progress = progress
+	}
+	return nil
+}
+
+// filterSubTree drops the nodes that do not match state (open, closed, or
+// all) and have no descendant that does. Progress is left as it was.
+func filterSubTree(node *SubTreeNode, state string) {
+	if state == "all" {
+		return
+	}
+	kept := []*SubTreeNode{}
+	for _, child := range node.Children {
+		filterSubTree(child, state)
+		if strings.EqualFold(child.State, state) || len(child.Children) > 0 {
+			kept = append(kept, child)
+		}
+	}
+	node.Children = kept
+}
+
+// writeSubTreeJSON writes the tree as nested JSON
+func writeSubTreeJSON(w io.Writer, root *SubTreeNode) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(root)
+}
+
+// writeSubTree renders the tree with box-drawing branches, a glyph for
+// each issue's state, and the completion of each branch
+func writeSubTree(w io.Writer, root *SubTreeNode) {
+	fmt.Fprintf(w, "%s #%d - %s%s\n", subTreeGlyph(root.State), root.Number, root.Title, subTreeProgress(root))
+	if len(root.Children) == 0 {
+		fmt.Fprintln(w, "  No sub-issues found.")
+		return
+	}
+	writeSubTreeChildren(w, root, "")
+}
+
+func writeSubTreeChildren(w io.Writer, node *SubTreeNode, prefix string) {
+	for i, child := range node.Children {
+		branch, indent := "├── ", "│   "
+		if i == len(node.Children)-1 {
+			branch, indent = "└── ", "    "
+		}
+
+		ref := fmt.Sprintf("#%d", child.Number)
+		if child.Repository != node.Repository {
+			ref = child.Repository + ref
+		}
+		fmt.Fprintf(w, "%s%s%s %s - %s%s\n", prefix, branch, subTreeGlyph(child.State), ref, child.Title, subTreeProgress(child))
+		writeSubTreeChildren(w, child, prefix+indent)
+	}
+}
+
+// subTreeGlyph returns the glyph shown for an issue state
+func subTreeGlyph(state string) string {
+	if state == "CLOSED" {
+		return "✓"
+	}
+	return "○"
+}
+
+// subTreeProgress returns the completion shown after a branch, or "" for a leaf
+func subTreeProgress(node *SubTreeNode) string {
+	if node.Progress == nil {
+		return ""
+	}
+	return fmt.Sprintf("  [%d%%, %d/%d]", node.Progress.Percent, node.Progress.Closed, node.Progress.Total)
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubTreeClient implements subTreeClient interface for testing
+type mockSubTreeClient struct {
+	subIssues map[string][]api.SubIssue // by owner/repo#number
+	calls     int
+}
+
+func (m *mockSubTreeClient) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	m.calls++
+	return m.subIssues[fmt.Sprintf("%s/%s#%d", owner, repo, number)], nil
+}
+
+func testSubIssue(number int, state string) api.SubIssue {
+	return api.SubIssue{Number: number, Title: fmt.Sprintf("Issue %d", number), State: state}
+}
+
+// newSubTreeTestClient returns #10 with children #11 (closed) and #12,
+// which has #13 (closed) and #14; #14 has other/lib#5 (closed)
+func newSubTreeTestClient() *mockSubTreeClient {
+	lib := api.SubIssue{Number: 5, Title: "Library change", State: "CLOSED", Repository: api.Repository{Owner: "other", Name: "lib"}}
+	return &mockSubTreeClient{subIssues: map[string][]api.SubIssue{
+		"owner/repo#10": {testSubIssue(11, "CLOSED"), testSubIssue(12, "OPEN")},
+		"owner/repo#12": {testSubIssue(13, "CLOSED"), testSubIssue(14, "OPEN")},
+		"owner/repo#14": {lib},
+	}}
+}
+
+var subTreeTestRoot = &api.Issue{Number: 10, Title: "Epic", State: "OPEN", Repository: api.Repository{Owner: "owner", Name: "repo"}}
+
+func TestBuildSubTree_Progress(t *testing.T) {
+	root, err := buildSubTree(newSubTreeTestClient(), subTreeTestRoot, 10)
+	if err != nil {
+		t.Fatalf("buildSubTree() error = %v", err)
+	}
+
+	if root.Progress == nil || root.Progress.Total != 5 || root.Progress.Closed != 3 || root.Progress.Percent != 60 {
+		t.Errorf("Unexpected root progress: %+v", root.Progress)
+	}
+	branch := root.Children[1]
+	if branch.Progress == nil || branch.Progress.Total != 3 || branch.Progress.Closed != 2 || branch.Progress.Percent != 66 {
+		t.Errorf("Unexpected #12 progress: %+v", branch.Progress)
+	}
+	if root.Children[0].Progress != nil {
+		t.Errorf("Expected no progress on a leaf, got %+v", root.Children[0].Progress)
+	}
+	if lib := branch.Children[1].Children[0]; lib.Repository != "other/lib" {
+		t.Errorf("Expected cross-repo sub-issue, got %+v", lib)
+	}
+}
+
+func TestBuildSubTree_Depth(t *testing.T) {
+	client := newSubTreeTestClient()
+	root, err := buildSubTree(client, subTreeTestRoot, 1)
+	if err != nil {
+		t.Fatalf("buildSubTree() error = %v", err)
+	}
+	if len(root.Children) != 2 || len(root.Children[1].Children) != 0 {
+		t.Errorf("Expected only direct children, got %+v", root.Children)
+	}
+	if client.calls != 1 {
+		t.Errorf("Expected 1 API call, got %d", client.calls)
+	}
+}
+
+func TestBuildSubTree_Cycle(t *testing.T) {
+	client := &mockSubTreeClient{subIssues: map[string][]api.SubIssue{
+		"owner/repo#10": {testSubIssue(11, "OPEN")},
+		"owner/repo#11": {testSubIssue(10, "OPEN")},
+	}}
+	root, err := buildSubTree(client, subTreeTestRoot, 10)
+	if err != nil {
+		t.Fatalf("buildSubTree() error = %v", err)
+	}
+	if client.calls != 2 || len(root.Children[0].Children[0].Children) != 0 {
+		t.Errorf("Expected the walk to stop at a repeated issue, got %d calls", client.calls)
+	}
+}
+
+func TestWriteSubTree(t *testing.T) {
+	root, err := buildSubTree(newSubTreeTestClient(), subTreeTestRoot, 10)
+	if err != nil {
+		t.Fatalf("buildSubTree() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	writeSubTree(&buf, root)
+	want := `○ #10 - Epic  [60%, 3/5]
+├── ✓ #11 - Issue 11
+└── ○ #12 - Issue 12  [66%, 2/3]
+    ├── ✓ #13 - Issue 13
+    └── ○ #14 - Issue 14  [100%, 1/1]
+        └── ✓ other/lib#5 - Library change
+`
+	if buf.String() != want {
+		t.Errorf("writeSubTree() =\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestFilterSubTree_KeepsAncestorsOfMatches(t *testing.T) {
+	root, err := buildSubTree(newSubTreeTestClient(), subTreeTestRoot, 10)
+	if err != nil {
+		t.Fatalf("buildSubTree() error = %v", err)
+	}
+	filterSubTree(root, "open")
+
+	if len(root.Children) != 1 || root.Children[0].Number != 12 {
+		t.Fatalf("Expected only #12 at the top level, got %+v", root.Children)
+	}
+	// #14 is open, and its closed child is dropped
+	if len(root.Children[0].Children) != 1 || len(root.Children[0].Children[0].Children) != 0 {
+		t.Errorf("Expected only #14 under #12, got %+v", root.Children[0].Children)
+	}
+	if root.Progress.Percent != 60 {
+		t.Errorf("Expected progress unchanged by the filter, got %+v", root.Progress)
+	}
+}
+
+func TestWriteSubTreeJSON_Nested(t *testing.T) {
+	root, err := buildSubTree(newSubTreeTestClient(), subTreeTestRoot, 10)
+	if err != nil {
+		t.Fatalf("buildSubTree() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := writeSubTreeJSON(&buf, root); err != nil {
+		t.Fatalf("writeSubTreeJSON() error = %v", err)
+	}
+	var decoded SubTreeNode
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if decoded.Children[1].Children[1].Children[0].Number != 5 || decoded.Progress.Percent != 60 {
+		t.Errorf("Unexpected nested JSON: %s", buf.String())
+	}
+}
@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/spf13/cobra"
+)
+
+type subscribeOptions struct {
+	query     string
+	subIssues bool
+	ignore    bool
+	dryRun    bool
+}
+
+// subscribeClient defines the interface for API methods used by subscribe
+// and unsubscribe. This allows for easier testing with mock implementations.
+type subscribeClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+	UpdateSubscription(subscribableID, state string) error
+}
+
+// subscriptionTarget is an issue whose subscription is changed
+type subscriptionTarget struct {
+	key   string // owner/repo#number
+	id    string
+	title string
+}
+
+func newSubscribeCommand() *cobra.Command {
+	opts := &subscribeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "subscribe [issue]...",
+		Short: "Subscribe to notifications for issues",
+		Long: `Subscribe to notifications for issues, by default the focused one.
+
+Select issues by reference, with --query (project field qualifiers such
+as priority:p0, plus label:, -label:, and is:), and with --sub-issues to
+include every sub-issue of the given issues.
+
+Subscriptions belong to the authenticated user; to subscribe a bot
+account, run the command with that account's token (GH_TOKEN).`,
+		Example: `  gh pmu subscribe 42 57
+
+  # Subscribe to every open P0
+  gh pmu subscribe --query priority:p0
+
+  # Follow an epic and all of its sub-issues
+  gh pmu subscribe 10 --sub-issues`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSubscribe(cmd, args, opts, "SUBSCRIBED")
+		},
+	}
+
+	addSubscribeFlags(cmd, opts)
+
+	return cmd
+}
+
+func newUnsubscribeCommand() *cobra.Command {
+	opts := &subscribeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "unsubscribe [issue]...",
+		Short: "Unsubscribe from notifications for issues",
+		Long: `Unsubscribe from notifications for issues, by default the focused one.
+
+Issues are selected as for 'gh pmu subscribe'. With --ignore, you are not
+notified even when mentioned or assigned.`,
+		Example: `  # Mute a noisy epic's children
+  gh pmu unsubscribe 10 --sub-issues
+
+  # Preview, then unsubscribe from everything labeled chore
+  gh pmu unsubscribe --query "label:chore" --dry-run
+  gh pmu unsubscribe --query "label:chore"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			state := "UNSUBSCRIBED"
+			if opts.ignore {
+				state = "IGNORED"
+			}
+			return runSubscribe(cmd, args, opts, state)
+		},
+	}
+
+	addSubscribeFlags(cmd, opts)
+	cmd.Flags().BoolVar(&opts.ignore, "ignore", false, "Ignore all notifications, including mentions")
+
+	return cmd
+}
+
+func addSubscribeFlags(cmd *cobra.Command, opts *subscribeOptions) {
+	cmd.Flags().StringVarP(&opts.query, "query", "q", "", "Select project issues matching a query")
+	cmd.Flags().BoolVar(&opts.subIssues, "sub-issues", false, "Include all sub-issues of the given issues")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "List the issues without changing subscriptions")
+}
+
+func runSubscribe(cmd *cobra.Command, args []string, opts *subscribeOptions, state string) error {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return err
+	}
+	if opts.query == "" {
+		args, err = issueArgsOrFocus(args, cfg)
+		if err != nil {
+			return err
+		}
+	}
+	return runSubscribeWithDeps(cmd, args, opts, cfg, api.NewClient(), state)
+}
+
+// runSubscribeWithDeps is the testable implementation of subscribe and
+// unsubscribe. state is SUBSCRIBED, UNSUBSCRIBED, or IGNORED.
+func runSubscribeWithDeps(cmd *cobra.Command, args []string, opts *subscribeOptions, cfg *config.Config, client subscribeClient, state string) error {
+	targets, err := collectSubscriptionTargets(args, opts, cfg, client)
+	if err != nil {
+		return err
+	}
+	if len(targets) == 0 {
+		cmd.Println("No matching issues")
+		return nil
+	}
+
+	verb := map[string]string{
+		"SUBSCRIBED":   "Subscribed to",
+		"UNSUBSCRIBED": "Unsubscribed from",
+		"IGNORED":      "Ignoring",
+	}[state]
+
+	if opts.dryRun {
+		cmd.Printf("Dry run - %d issue(s) would be updated (%s):\n", len(targets), strings.ToLower(verb))
+		for _, t := range targets {
+			cmd.Printf("  • %s - %s\n", t.key, t.title)
+		}
+		return nil
+	}
+
+	failed := 0
+	for _, t := range targets {
+		if err := client.UpdateSubscription(t.id, state); err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "Warning: %s: %v\n", t.key, err)
+			continue
+		}
+		cmd.Printf("✓ %s %s - %s\n", verb, t.key, t.title)
+	}
+
+	if len(targets) > 1 {
+		cmd.Printf("\n%s %d of %d issues\n", verb, len(targets)-failed, len(targets))
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d subscriptions failed to update", failed, len(targets))
+	}
+	return nil
+}
+
+// collectSubscriptionTargets resolves the given issues, their sub-issues
+// with --sub-issues, and the --query matches, without duplicates
+func collectSubscriptionTargets(args []string, opts *subscribeOptions, cfg *config.Config, client subscribeClient) ([]subscriptionTarget, error) {
+	var targets []subscriptionTarget
+	seen := map[string]bool{}
+	add := func(owner, repo string, number int, id, title string) bool {
+		key := localstore.Key(owner+"/"+repo, number)
+		if seen[key] {
+			return false
+		}
+		seen[key] = true
+		targets = append(targets, subscriptionTarget{key: key, id: id, title: title})
+		return true
+	}
+
+	for _, arg := range args {
+		owner, repo, number, err := parseIssueReference(arg)
+		if err != nil {
+			return nil, err
+		}
+		if owner == "" || repo == "" {
+			if len(cfg.Repositories) == 0 {
+				return nil, fmt.Errorf("no repository specified and none configured")
+			}
+			owner, repo = splitRepository(cfg.Repositories[0])
+		}
+
+		issue, err := client.GetIssue(owner, repo, number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issue: %w", err)
+		}
+		add(owner, repo, number, issue.ID, issue.Title)
+
+		if opts.subIssues {
+			if err := addSubIssueTargets(client, owner, repo, number, add); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if opts.query != "" {
+		project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project: %w", err)
+		}
+		items, err := client.GetProjectItems(project.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get project items: %w", err)
+		}
+		for _, item := range filterItemsByQuery(cfg, items, opts.query) {
+			issue := item.Issue
+			add(issue.Repository.Owner, issue.Repository.Name, issue.Number, issue.ID, issue.Title)
+			if opts.subIssues {
+				if err := addSubIssueTargets(client, issue.Repository.Owner, issue.Repository.Name, issue.Number, add); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return targets, nil
+}
+
+// addSubIssueTargets adds every sub-issue below an issue, depth first.
+// add reports whether the issue was new, so shared subtrees are walked once.
+func addSubIssueTargets(client subscribeClient, owner, repo string, number int, add func(owner, repo string, number int, id, title string) bool) error {
+	subIssues, err := client.GetSubIssues(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get sub-issues of #%d: %w", number, err)
+	}
+	for _, sub := range subIssues {
+		subOwner, subRepo := sub.Repository.Owner, sub.Repository.Name
+		if subOwner == "" || subRepo == "" {
+			subOwner, subRepo = owner, repo
+		}
+		if !add(subOwner, subRepo, sub.Number, sub.ID, sub.Title) {
+			continue
+		}
+		if err := addSubIssueTargets(client, subOwner, subRepo, sub.Number, add); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockSubscribeClient implements subscribeClient interface for testing
+type mockSubscribeClient struct {
+	issues    map[string]*api.Issue     // "owner/repo#number" -> Issue
+	subIssues map[string][]api.SubIssue // "owner/repo#number" -> SubIssues
+	items     []api.ProjectItem
+	updateErr map[string]error // issue ID -> error
+
+	updates []string // "id=state"
+}
+
+func (m *mockSubscribeClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	key := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+	if issue, ok := m.issues[key]; ok {
+		return issue, nil
+	}
+	return nil, fmt.Errorf("issue not found: %s", key)
+}
+
+func (m *mockSubscribeClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockSubscribeClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func (m *mockSubscribeClient) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	return m.subIssues[fmt.Sprintf("%s/%s#%d", owner, repo, number)], nil
+}
+
+func (m *mockSubscribeClient) UpdateSubscription(subscribableID, state string) error {
+	if err := m.updateErr[subscribableID]; err != nil {
+		return err
+	}
+	m.updates = append(m.updates, subscribableID+"="+state)
+	return nil
+}
+
+func newMockSubscribeClient() *mockSubscribeClient {
+	repo := api.Repository{Owner: "owner", Name: "repo"}
+	return &mockSubscribeClient{
+		issues: map[string]*api.Issue{
+			"owner/repo#10": {ID: "I10", Number: 10, Title: "Epic"},
+			"owner/repo#42": {ID: "I42", Number: 42, Title: "Dark mode"},
+		},
+		subIssues: map[string][]api.SubIssue{
+			"owner/repo#10": {{ID: "I11", Number: 11, Title: "Child"}, {ID: "I12", Number: 12, Title: "Other", Repository: api.Repository{Owner: "owner", Name: "docs"}}},
+			"owner/repo#11": {{ID: "I13", Number: 13, Title: "Grandchild"}},
+		},
+		items: []api.ProjectItem{
+			{ID: "item-42", Issue: &api.Issue{ID: "I42", Number: 42, Title: "Dark mode", State: "OPEN", Repository: repo},
+				FieldValues: []api.FieldValue{{Field: "Priority", Value: "P0"}}},
+			{ID: "item-50", Issue: &api.Issue{ID: "I50", Number: 50, Title: "Outage", State: "OPEN", Repository: repo},
+				FieldValues: []api.FieldValue{{Field: "Priority", Value: "P0"}}},
+			{ID: "item-51", Issue: &api.Issue{ID: "I51", Number: 51, Title: "Old", State: "CLOSED", Repository: repo},
+				FieldValues: []api.FieldValue{{Field: "Priority", Value: "P0"}}},
+			{ID: "item-52", Issue: &api.Issue{ID: "I52", Number: 52, Title: "Later", State: "OPEN", Repository: repo},
+				FieldValues: []api.FieldValue{{Field: "Priority", Value: "P2"}}},
+		},
+	}
+}
+
+func TestSubscribeCommands_Exist(t *testing.T) {
+	cmd := NewRootCommand()
+	for _, name := range []string{"subscribe", "unsubscribe"} {
+		sub, _, err := cmd.Find([]string{name})
+		if err != nil || sub.Name() != name {
+			t.Fatalf("%s command not found: %v", name, err)
+		}
+		for _, flag := range []string{"query", "sub-issues", "dry-run"} {
+			if sub.Flags().Lookup(flag) == nil {
+				t.Errorf("Expected %s --%s flag to exist", name, flag)
+			}
+		}
+	}
+
+	unsub, _, _ := cmd.Find([]string{"unsubscribe"})
+	if unsub.Flags().Lookup("ignore") == nil {
+		t.Error("Expected unsubscribe --ignore flag to exist")
+	}
+}
+
+func TestRunSubscribe_SubIssues(t *testing.T) {
+	client := newMockSubscribeClient()
+	cmd, buf := newTestCmd()
+
+	if err := runSubscribeWithDeps(cmd, []string{"10"}, &subscribeOptions{subIssues: true}, newTestConfig(), client, "UNSUBSCRIBED"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := "I10=UNSUBSCRIBED I11=UNSUBSCRIBED I13=UNSUBSCRIBED I12=UNSUBSCRIBED"
+	if got := strings.Join(client.updates, " "); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+	for _, line := range []string{"✓ Unsubscribed from owner/repo#13 - Grandchild", "✓ Unsubscribed from owner/docs#12 - Other", "Unsubscribed from 4 of 4 issues"} {
+		if !strings.Contains(buf.String(), line) {
+			t.Errorf("Expected output to contain %q, got:\n%s", line, buf.String())
+		}
+	}
+}
+
+func TestRunSubscribe_QueryAndDryRun(t *testing.T) {
+	client := newMockSubscribeClient()
+	cfg := newTestConfig()
+
+	// Issues given directly and matched by the query are only updated once
+	cmd, _ := newTestCmd()
+	if err := runSubscribeWithDeps(cmd, []string{"42"}, &subscribeOptions{query: "priority:P0"}, cfg, client, "SUBSCRIBED"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := strings.Join(client.updates, " "); got != "I42=SUBSCRIBED I50=SUBSCRIBED" {
+		t.Errorf("Expected the open P0s once each, got %s", got)
+	}
+
+	client.updates = nil
+	cmd, buf := newTestCmd()
+	if err := runSubscribeWithDeps(cmd, nil, &subscribeOptions{query: "priority:P0 is:all", dryRun: true}, cfg, client, "IGNORED"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(client.updates) != 0 {
+		t.Errorf("Expected no updates in dry-run, got %v", client.updates)
+	}
+	if !strings.Contains(buf.String(), "3 issue(s) would be updated (ignoring)") {
+		t.Errorf("Expected dry-run summary, got:\n%s", buf.String())
+	}
+
+	cmd, buf = newTestCmd()
+	if err := runSubscribeWithDeps(cmd, nil, &subscribeOptions{query: "priority:P1"}, cfg, client, "SUBSCRIBED"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "No matching issues") {
+		t.Errorf("Expected no-match message, got:\n%s", buf.String())
+	}
+}
+
+func TestRunSubscribe_Errors(t *testing.T) {
+	client := newMockSubscribeClient()
+	client.updateErr = map[string]error{"I11": errors.New("forbidden")}
+
+	cmd, _ := newTestCmd()
+	err := runSubscribeWithDeps(cmd, []string{"10"}, &subscribeOptions{subIssues: true}, newTestConfig(), client, "SUBSCRIBED")
+	if err == nil || !strings.Contains(err.Error(), "1 of 4 subscriptions failed") {
+		t.Errorf("Expected a failure count, got %v", err)
+	}
+	if len(client.updates) != 3 {
+		t.Errorf("Expected the other subscriptions to be updated, got %v", client.updates)
+	}
+
+	cmd, _ = newTestCmd()
+	if err := runSubscribeWithDeps(cmd, []string{"99"}, &subscribeOptions{}, newTestConfig(), client, "SUBSCRIBED"); err == nil {
+		t.Error("Expected an error for a missing issue")
+	}
+}
@@ -0,0 +1,371 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/priority"
+	"github.com/scooter-indie/gh-pmu/internal/triage"
+	"github.com/spf13/cobra"
+)
+
+type suggestPriorityOptions struct {
+	query       string
+	interactive bool
+	apply       bool
+	json        bool
+}
+
+// suggestPriorityClient defines the interface for API methods used by suggest-priority functions.
+// This allows for easier testing with mock implementations.
+type suggestPriorityClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	GetIssueSignals(owner, repo string, number int) (*api.IssueSignals, error)
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
+// prioritySuggestion is a proposed priority for one project item
+type prioritySuggestion struct {
+	item      api.ProjectItem
+	current   string
+	suggested string
+	score     priority.Score
+}
+
+func (s prioritySuggestion) changed() bool {
+	return !strings.EqualFold(s.current, s.suggested)
+}
+
+func newSuggestPriorityCommand() *cobra.Command {
+	opts := &suggestPriorityOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "suggest-priority",
+		Short: "Suggest priorities from configured signals, with explanations",
+		Long: `Score project issues against the signals in the 'prioritization' section
+of .gh-pmu.yml and propose a priority for each, explaining every suggestion.
+
+Signals: reactions, age, blocking (open issues this issue blocks), SLA
+(bonus once an issue is open longer than the SLA), and keywords found in
+the title, body, or labels. The total score is mapped to a priority alias
+through 'levels'.
+
+Example configuration:
+
+  prioritization:
+    query: status:backlog
+    reactions: 2        # points per reaction
+    age: 1              # points per week open
+    age_cap: 10
+    blocking: 5         # points per blocked issue
+    sla: {days: 30, points: 20}
+    keywords: {crash: 15, security: 25, typo: -5}
+    levels:
+      - {priority: p0, min_score: 50}
+      - {priority: p1, min_score: 20}
+      - {priority: p2, min_score: 0}
+
+The query selects items by project field (status:backlog, priority:p2)
+and supports label:, -label:, is:open, is:closed, and is:all. Open issues
+are considered unless is:closed or is:all is given.
+
+Suggestions are only previewed unless --interactive or --apply is used.`,
+		Example: `  # Preview suggestions for the backlog
+  gh pmu suggest-priority --query status:backlog
+
+  # Review each changed suggestion and accept or skip it
+  gh pmu suggest-priority --query status:backlog --interactive
+
+  # Apply every changed suggestion
+  gh pmu suggest-priority --apply`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSuggestPriority(cmd, opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.query, "query", "q", "", "Item query (default: prioritization.query)")
+	cmd.Flags().BoolVarP(&opts.interactive, "interactive", "i", false, "Prompt to accept each changed suggestion")
+	cmd.Flags().BoolVar(&opts.apply, "apply", false, "Apply all changed suggestions without prompting")
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output suggestions in JSON format")
+
+	return cmd
+}
+
+func runSuggestPriority(cmd *cobra.Command, opts *suggestPriorityOptions) error {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient()
+
+	return runSuggestPriorityWithDeps(cmd, opts, cfg, client, cmd.InOrStdin(), time.Now())
+}
+
+// runSuggestPriorityWithDeps is the testable implementation of runSuggestPriority
+func runSuggestPriorityWithDeps(cmd *cobra.Command, opts *suggestPriorityOptions, cfg *config.Config, client suggestPriorityClient, stdin io.Reader, now time.Time) error {
+	if cfg.Prioritization == nil || len(cfg.Prioritization.Levels) == 0 {
+		return fmt.Errorf("no prioritization levels configured\nAdd a 'prioritization' section with 'levels' to %s (see 'gh pmu suggest-priority --help')", config.ConfigFileName)
+	}
+	if opts.interactive && opts.apply {
+		return fmt.Errorf("--interactive and --apply cannot be used together")
+	}
+	if opts.interactive && opts.json {
+		return fmt.Errorf("--interactive and --json cannot be used together")
+	}
+
+	query := opts.query
+	if query == "" {
+		query = cfg.Prioritization.Query
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	priorityField := priorityFieldName(cfg)
+	suggestions, err := buildPrioritySuggestions(cfg, client, items, query, priorityField, now)
+	if err != nil {
+		return err
+	}
+
+	if opts.json {
+		if err := outputPrioritySuggestionsJSON(cmd, suggestions); err != nil {
+			return err
+		}
+	} else {
+		outputPrioritySuggestions(cmd, suggestions)
+	}
+
+	if !opts.interactive && !opts.apply {
+		return nil
+	}
+
+	var applied, skipped, failed int
+	reader := bufio.NewReader(stdin)
+
+	for _, s := range suggestions {
+		if !s.changed() {
+			continue
+		}
+
+		if opts.interactive {
+			cmd.Printf("\nSet #%d to %s? [y/N/q] ", s.item.Issue.Number, s.suggested)
+			response, _ := reader.ReadString('\n')
+			response = strings.TrimSpace(strings.ToLower(response))
+
+			if response == "q" {
+				cmd.Println("Aborted.")
+				break
+			}
+			if response != "y" && response != "yes" {
+				skipped++
+				continue
+			}
+		}
+
+		if err := client.SetProjectItemField(project.ID, s.item.ID, priorityField, s.suggested); err != nil {
+			cmd.PrintErrf("Failed to update #%d: %v\n", s.item.Issue.Number, err)
+			failed++
+			continue
+		}
+		applied++
+	}
+
+	if opts.json {
+		return nil
+	}
+
+	cmd.Printf("\nApplied %d priority change(s)", applied)
+	if skipped > 0 {
+		cmd.Printf(", %d skipped", skipped)
+	}
+	if failed > 0 {
+		cmd.Printf(", %d failed", failed)
+	}
+	cmd.Println()
+
+	return nil
+}
+
+// priorityFieldName returns the project field that holds priority
+func priorityFieldName(cfg *config.Config) string {
+	if field, ok := cfg.Fields["priority"]; ok && field.Field != "" {
+		return field.Field
+	}
+	return "Priority"
+}
+
+// buildPrioritySuggestions scores every issue matching query
+func buildPrioritySuggestions(cfg *config.Config, client suggestPriorityClient, items []api.ProjectItem, query, priorityField string, now time.Time) ([]prioritySuggestion, error) {
+	fieldFilters, issueQuery := parseItemQuery(cfg, query)
+	state := triage.StateForQuery(issueQuery)
+
+	var suggestions []prioritySuggestion
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		if state != "all" && !strings.EqualFold(item.Issue.State, state) {
+			continue
+		}
+		if !matchesFieldFilters(item, fieldFilters) {
+			continue
+		}
+
+		issue := *item.Issue
+		signals, err := client.GetIssueSignals(issue.Repository.Owner, issue.Repository.Name, issue.Number)
+		if err != nil {
+			return nil, err
+		}
+
+		issue.Body = signals.Body
+		issue.Labels = nil
+		for _, l := range signals.Labels {
+			issue.Labels = append(issue.Labels, api.Label{Name: l})
+		}
+		if !triage.Matches(issue, issueQuery) {
+			continue
+		}
+
+		createdAt, _ := time.Parse(time.RFC3339, signals.CreatedAt)
+		score := priority.Evaluate(cfg.Prioritization, priority.Signals{
+			Title:     issue.Title,
+			Body:      signals.Body,
+			Labels:    signals.Labels,
+			Reactions: signals.Reactions,
+			CreatedAt: createdAt,
+			Blocking:  signals.Blocking,
+		}, now)
+
+		alias := priority.Level(cfg.Prioritization, score.Total)
+		suggestions = append(suggestions, prioritySuggestion{
+			item:      item,
+			current:   getFieldValue(item, priorityField),
+			suggested: cfg.ResolveFieldValue("priority", alias),
+			score:     score,
+		})
+	}
+
+	return suggestions, nil
+}
+
+// parseItemQuery splits a query into project field filters (field name to
+// value) and the remaining issue qualifiers understood by triage.Matches
+func parseItemQuery(cfg *config.Config, query string) (map[string]string, string) {
+	fields := make(map[string]string)
+	var rest []string
+
+	for _, token := range strings.Fields(query) {
+		key, value, ok := strings.Cut(token, ":")
+		if !ok || key == "label" || key == "-label" || key == "is" {
+			rest = append(rest, token)
+			continue
+		}
+		fields[cfg.GetFieldName(key)] = cfg.ResolveFieldValue(key, value)
+	}
+
+	return fields, strings.Join(rest, " ")
+}
+
+// matchesFieldFilters reports whether an item has every filtered field value
+func matchesFieldFilters(item api.ProjectItem, filters map[string]string) bool {
+	for field, value := range filters {
+		if !strings.EqualFold(getFieldValue(item, field), value) {
+			return false
+		}
+	}
+	return true
+}
+
+func outputPrioritySuggestions(cmd *cobra.Command, suggestions []prioritySuggestion) {
+	if len(suggestions) == 0 {
+		cmd.Println("No matching issues found")
+		return
+	}
+
+	changed := 0
+	for _, s := range suggestions {
+		current := s.current
+		if current == "" {
+			current = "(none)"
+		}
+
+		marker := ""
+		if s.changed() {
+			changed++
+		} else {
+			marker = " (unchanged)"
+		}
+
+		cmd.Printf("#%d %s\n", s.item.Issue.Number, s.item.Issue.Title)
+		cmd.Printf("  %s → %s%s, score %s\n", current, s.suggested, marker, formatScore(s.score.Total))
+		for _, c := range s.score.Contributions {
+			cmd.Printf("    %s %s: %s\n", priority.FormatPoints(c.Points), c.Signal, c.Reason)
+		}
+	}
+
+	cmd.Printf("\n%d of %d issue(s) would change priority\n", changed, len(suggestions))
+}
+
+// formatScore renders a total score without a sign
+func formatScore(total float64) string {
+	return strings.TrimPrefix(priority.FormatPoints(total), "+")
+}
+
+// PrioritySuggestionJSON represents a suggestion in JSON output
+type PrioritySuggestionJSON struct {
+	Number     int                  `json:"number"`
+	Title      string               `json:"title"`
+	Repository string               `json:"repository"`
+	Current    string               `json:"current"`
+	Suggested  string               `json:"suggested"`
+	Changed    bool                 `json:"changed"`
+	Score      float64              `json:"score"`
+	Reasons    []PriorityReasonJSON `json:"reasons"`
+}
+
+// PriorityReasonJSON represents one signal's contribution in JSON output
+type PriorityReasonJSON struct {
+	Signal string  `json:"signal"`
+	Points float64 `json:"points"`
+	Reason string  `json:"reason"`
+}
+
+func outputPrioritySuggestionsJSON(cmd *cobra.Command, suggestions []prioritySuggestion) error {
+	output := make([]PrioritySuggestionJSON, 0, len(suggestions))
+	for _, s := range suggestions {
+		reasons := make([]PriorityReasonJSON, 0, len(s.score.Contributions))
+		for _, c := range s.score.Contributions {
+			reasons = append(reasons, PriorityReasonJSON{Signal: c.Signal, Points: c.Points, Reason: c.Reason})
+		}
+		output = append(output, PrioritySuggestionJSON{
+			Number:     s.item.Issue.Number,
+			Title:      s.item.Issue.Title,
+			Repository: s.item.Issue.Repository.Owner + "/" + s.item.Issue.Repository.Name,
+			Current:    s.current,
+			Suggested:  s.suggested,
+			Changed:    s.changed(),
+			Score:      s.score.Total,
+			Reasons:    reasons,
+		})
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(output)
+}
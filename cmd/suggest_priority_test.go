@@ -0,0 +1,272 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// mockSuggestPriorityClient implements suggestPriorityClient interface for testing
+type mockSuggestPriorityClient struct {
+	items     []api.ProjectItem
+	signals   map[int]*api.IssueSignals
+	setErr    error
+	setCalls  []string
+	signalErr error
+}
+
+func (m *mockSuggestPriorityClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "PVT_1"}, nil
+}
+
+func (m *mockSuggestPriorityClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return m.items, nil
+}
+
+func (m *mockSuggestPriorityClient) GetIssueSignals(owner, repo string, number int) (*api.IssueSignals, error) {
+	if m.signalErr != nil {
+		return nil, m.signalErr
+	}
+	if s, ok := m.signals[number]; ok {
+		return s, nil
+	}
+	return &api.IssueSignals{CreatedAt: "2024-05-30T00:00:00Z"}, nil
+}
+
+func (m *mockSuggestPriorityClient) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	m.setCalls = append(m.setCalls, itemID+":"+fieldName+"="+value)
+	return m.setErr
+}
+
+var suggestTestNow = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func newSuggestPriorityTestConfig() *config.Config {
+	cfg := newTestConfig()
+	cfg.Fields = map[string]config.Field{
+		"status":   {Field: "Status", Values: map[string]string{"backlog": "Backlog"}},
+		"priority": {Field: "Priority", Values: map[string]string{"p0": "P0", "p1": "P1", "p2": "P2"}},
+	}
+	cfg.Prioritization = &config.Prioritization{
+		Query:     "status:backlog",
+		Reactions: 2,
+		SLA:       &config.PrioritySLA{Days: 30, Points: 20},
+		Keywords:  map[string]float64{"crash": 30},
+		Levels: []config.PriorityLevel{
+			{Priority: "p0", MinScore: 50},
+			{Priority: "p1", MinScore: 20},
+			{Priority: "p2", MinScore: 0},
+		},
+	}
+	return cfg
+}
+
+func newSuggestPriorityTestClient() *mockSuggestPriorityClient {
+	item := func(id string, number int, title, status, priority, state string) api.ProjectItem {
+		fvs := []api.FieldValue{{Field: "Status", Value: status}}
+		if priority != "" {
+			fvs = append(fvs, api.FieldValue{Field: "Priority", Value: priority})
+		}
+		return api.ProjectItem{
+			ID: id,
+			Issue: &api.Issue{
+				Number:     number,
+				Title:      title,
+				State:      state,
+				Repository: api.Repository{Owner: "owner", Name: "repo"},
+			},
+			FieldValues: fvs,
+		}
+	}
+
+	return &mockSuggestPriorityClient{
+		items: []api.ProjectItem{
+			item("PVTI_1", 1, "App crash on login", "Backlog", "P2", "OPEN"),
+			item("PVTI_2", 2, "Dark mode", "Backlog", "", "OPEN"),
+			item("PVTI_3", 3, "Tweak footer", "Backlog", "P2", "OPEN"),
+			item("PVTI_4", 4, "In flight", "In progress", "P2", "OPEN"),
+			item("PVTI_5", 5, "Old crash", "Backlog", "P2", "CLOSED"),
+		},
+		signals: map[int]*api.IssueSignals{
+			1: {Reactions: 5, CreatedAt: "2024-01-01T00:00:00Z", Labels: []string{"bug"}},
+			2: {Reactions: 10, CreatedAt: "2024-05-30T00:00:00Z"},
+		},
+	}
+}
+
+func runTestSuggestPriority(opts *suggestPriorityOptions, cfg *config.Config, client *mockSuggestPriorityClient, stdin string) (string, error) {
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	err := runSuggestPriorityWithDeps(cmd, opts, cfg, client, strings.NewReader(stdin), suggestTestNow)
+	return buf.String(), err
+}
+
+func TestSuggestPriorityCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"suggest-priority"})
+	if err != nil || sub.Name() != "suggest-priority" {
+		t.Fatalf("suggest-priority command not found: %v", err)
+	}
+	for _, flag := range []string{"query", "interactive", "apply", "json"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag to exist", flag)
+		}
+	}
+}
+
+func TestRunSuggestPriority_Preview(t *testing.T) {
+	client := newSuggestPriorityTestClient()
+
+	out, err := runTestSuggestPriority(&suggestPriorityOptions{}, newSuggestPriorityTestConfig(), client, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"#1 App crash on login\n  P2 → P0, score 60\n",
+		"    +10 reactions: 5 reactions\n",
+		"    +20 sla: open 152 days, SLA is 30 days\n",
+		`    +30 keyword: mentions "crash"`,
+		"#2 Dark mode\n  (none) → P1, score 20\n",
+		"#3 Tweak footer\n  P2 → P2 (unchanged), score 0\n",
+		"2 of 3 issue(s) would change priority",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "In flight") || strings.Contains(out, "Old crash") {
+		t.Errorf("Expected query to exclude non-backlog and closed issues, got:\n%s", out)
+	}
+	if len(client.setCalls) != 0 {
+		t.Errorf("Expected preview not to apply changes, got %v", client.setCalls)
+	}
+}
+
+func TestRunSuggestPriority_QueryFlagOverridesConfig(t *testing.T) {
+	client := newSuggestPriorityTestClient()
+
+	out, err := runTestSuggestPriority(&suggestPriorityOptions{query: "label:bug is:all"}, newSuggestPriorityTestConfig(), client, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "#1 App crash") || strings.Contains(out, "#2 Dark mode") || !strings.Contains(out, "1 of 1 issue(s)") {
+		t.Errorf("Expected only the bug-labelled issue, got:\n%s", out)
+	}
+}
+
+func TestRunSuggestPriority_Apply(t *testing.T) {
+	client := newSuggestPriorityTestClient()
+
+	out, err := runTestSuggestPriority(&suggestPriorityOptions{apply: true}, newSuggestPriorityTestConfig(), client, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []string{"PVTI_1:Priority=P0", "PVTI_2:Priority=P1"}
+	if strings.Join(client.setCalls, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected %v, got %v", want, client.setCalls)
+	}
+	if !strings.Contains(out, "Applied 2 priority change(s)") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+}
+
+func TestRunSuggestPriority_Interactive(t *testing.T) {
+	client := newSuggestPriorityTestClient()
+
+	out, err := runTestSuggestPriority(&suggestPriorityOptions{interactive: true}, newSuggestPriorityTestConfig(), client, "n\ny\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(client.setCalls) != 1 || client.setCalls[0] != "PVTI_2:Priority=P1" {
+		t.Errorf("Expected only the accepted suggestion to be applied, got %v", client.setCalls)
+	}
+	if !strings.Contains(out, "Applied 1 priority change(s), 1 skipped") {
+		t.Errorf("Unexpected output:\n%s", out)
+	}
+}
+
+func TestRunSuggestPriority_InteractiveQuit(t *testing.T) {
+	client := newSuggestPriorityTestClient()
+
+	out, err := runTestSuggestPriority(&suggestPriorityOptions{interactive: true}, newSuggestPriorityTestConfig(), client, "q\n")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(client.setCalls) != 0 || !strings.Contains(out, "Aborted.") {
+		t.Errorf("Expected abort without changes, got %v:\n%s", client.setCalls, out)
+	}
+}
+
+func TestRunSuggestPriority_JSON(t *testing.T) {
+	client := newSuggestPriorityTestClient()
+
+	out, err := runTestSuggestPriority(&suggestPriorityOptions{json: true}, newSuggestPriorityTestConfig(), client, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var results []PrioritySuggestionJSON
+	if err := json.Unmarshal([]byte(out), &results); err != nil {
+		t.Fatalf("invalid JSON output: %v\n%s", err, out)
+	}
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 suggestions, got %d", len(results))
+	}
+	first := results[0]
+	if first.Suggested != "P0" || !first.Changed || first.Score != 60 || len(first.Reasons) != 3 || first.Reasons[2].Signal != "keyword" {
+		t.Errorf("Unexpected suggestion: %+v", first)
+	}
+	if results[2].Changed {
+		t.Errorf("Expected unchanged suggestion, got %+v", results[2])
+	}
+}
+
+func TestRunSuggestPriority_Errors(t *testing.T) {
+	noLevels := newSuggestPriorityTestConfig()
+	noLevels.Prioritization.Levels = nil
+
+	tests := []struct {
+		name    string
+		opts    *suggestPriorityOptions
+		cfg     *config.Config
+		client  *mockSuggestPriorityClient
+		wantErr string
+	}{
+		{"no prioritization", &suggestPriorityOptions{}, &config.Config{}, newSuggestPriorityTestClient(), "no prioritization levels configured"},
+		{"no levels", &suggestPriorityOptions{}, noLevels, newSuggestPriorityTestClient(), "no prioritization levels configured"},
+		{"interactive and apply", &suggestPriorityOptions{interactive: true, apply: true}, newSuggestPriorityTestConfig(), newSuggestPriorityTestClient(), "cannot be used together"},
+		{"interactive and json", &suggestPriorityOptions{interactive: true, json: true}, newSuggestPriorityTestConfig(), newSuggestPriorityTestClient(), "cannot be used together"},
+		{"signal error", &suggestPriorityOptions{}, newSuggestPriorityTestConfig(), &mockSuggestPriorityClient{items: newSuggestPriorityTestClient().items, signalErr: errors.New("rate limited")}, "rate limited"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runTestSuggestPriority(tt.opts, tt.cfg, tt.client, "")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseItemQuery(t *testing.T) {
+	fields, rest := parseItemQuery(newSuggestPriorityTestConfig(), "status:backlog label:bug priority:p1 is:open")
+
+	if fields["Status"] != "Backlog" || fields["Priority"] != "P1" || len(fields) != 2 {
+		t.Errorf("Unexpected field filters: %v", fields)
+	}
+	if rest != "label:bug is:open" {
+		t.Errorf("Unexpected remaining query: %q", rest)
+	}
+}
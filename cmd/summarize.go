@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/llm"
+	"github.com/spf13/cobra"
+)
+
+type summarizeOptions struct {
+	json bool
+}
+
+// summarizeClient defines the interface for API methods used by summarize functions.
+// This allows for easier testing with mock implementations.
+type summarizeClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetIssueComments(owner, repo string, number int) ([]api.Comment, error)
+}
+
+func newSummarizeCommand() *cobra.Command {
+	opts := &summarizeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "summarize <issue>",
+		Short: "Summarize an issue and its comments using the configured AI backend",
+		Long: `Condense a long issue and its comment thread into a triage-ready summary.
+
+Requires an 'ai' section in .gh-pmu.yml selecting a provider (openai,
+azure, or ollama). No AI requests are made unless it is configured.
+
+Example configuration:
+
+  ai:
+    provider: ollama
+    model: llama3.1
+
+The same summary is available in 'gh pmu view --summary' and
+'gh pmu triage --interactive --summary'.`,
+		Example: `  # Summarize an issue
+  gh pmu summarize 42
+
+  # Summarize an issue in another repository as JSON
+  gh pmu summarize owner/repo#42 --json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSummarize(cmd, args, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
+
+	return cmd
+}
+
+func runSummarize(cmd *cobra.Command, args []string, opts *summarizeOptions) error {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return err
+	}
+
+	provider, err := llm.New(cfg.AI)
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient()
+
+	return runSummarizeWithDeps(cmd, args, opts, cfg, client, provider)
+}
+
+// runSummarizeWithDeps is the testable implementation of runSummarize
+func runSummarizeWithDeps(cmd *cobra.Command, args []string, opts *summarizeOptions, cfg *config.Config, client summarizeClient, provider llm.Provider) error {
+	owner, repo, number, err := parseIssueReference(args[0])
+	if err != nil {
+		return err
+	}
+
+	if owner == "" || repo == "" {
+		if len(cfg.Repositories) == 0 {
+			return fmt.Errorf("no repository specified and none configured")
+		}
+		owner, repo = splitRepository(cfg.Repositories[0])
+	}
+
+	issue, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	summary, err := summarizeIssue(client, cfg, provider, issue, owner, repo)
+	if err != nil {
+		return err
+	}
+
+	if opts.json {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(map[string]interface{}{
+			"number":  issue.Number,
+			"title":   issue.Title,
+			"url":     issue.URL,
+			"summary": summary,
+		})
+	}
+
+	cmd.Printf("%s #%d\n\n%s\n", issue.Title, issue.Number, summary)
+	return nil
+}
+
+// summarizeIssue fetches an issue's comments and asks the provider for a summary
+func summarizeIssue(client summarizeClient, cfg *config.Config, provider llm.Provider, issue *api.Issue, owner, repo string) (string, error) {
+	comments, err := client.GetIssueComments(owner, repo, issue.Number)
+	if err != nil {
+		return "", fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	maxChars := 0
+	if cfg.AI != nil {
+		maxChars = cfg.AI.MaxInputChars
+	}
+
+	return llm.SummarizeIssue(provider, issue, comments, maxChars)
+}
@@ -0,0 +1,161 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// mockSummarizeClient implements summarizeClient interface for testing
+type mockSummarizeClient struct {
+	issue       *api.Issue
+	issueErr    error
+	comments    []api.Comment
+	commentsErr error
+	issueRepo   string
+}
+
+func (m *mockSummarizeClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	m.issueRepo = owner + "/" + repo
+	return m.issue, m.issueErr
+}
+
+func (m *mockSummarizeClient) GetIssueComments(owner, repo string, number int) ([]api.Comment, error) {
+	return m.comments, m.commentsErr
+}
+
+// mockProvider implements llm.Provider for testing
+type mockProvider struct {
+	prompt string
+	reply  string
+	err    error
+}
+
+func (m *mockProvider) Complete(system, prompt string) (string, error) {
+	m.prompt = prompt
+	return m.reply, m.err
+}
+
+func newSummarizeTestConfig() *config.Config {
+	cfg := newTestConfig()
+	cfg.AI = &config.AI{Provider: "ollama"}
+	return cfg
+}
+
+func TestSummarizeCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"summarize"})
+	if err != nil {
+		t.Fatalf("summarize command not found: %v", err)
+	}
+	if sub.Flags().Lookup("json") == nil {
+		t.Error("Expected --json flag to exist")
+	}
+}
+
+func TestViewCommand_HasSummaryFlag(t *testing.T) {
+	cmd := NewRootCommand()
+	viewCmd, _, err := cmd.Find([]string{"view"})
+	if err != nil {
+		t.Fatalf("view command not found: %v", err)
+	}
+	if viewCmd.Flags().Lookup("summary") == nil {
+		t.Error("Expected --summary flag to exist")
+	}
+}
+
+func TestRunSummarize_PrintsSummary(t *testing.T) {
+	client := &mockSummarizeClient{
+		issue:    &api.Issue{Number: 42, Title: "Crash", Body: "It crashes on start"},
+		comments: []api.Comment{{Author: "dev", Body: "Reproduced on 1.2"}},
+	}
+	provider := &mockProvider{reply: "- Startup crash, reproduced on 1.2"}
+
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+
+	if err := runSummarizeWithDeps(cmd, []string{"42"}, &summarizeOptions{}, newSummarizeTestConfig(), client, provider); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if client.issueRepo != "owner/repo" {
+		t.Errorf("Expected default repository, got %s", client.issueRepo)
+	}
+	if !strings.Contains(provider.prompt, "Reproduced on 1.2") {
+		t.Errorf("Expected comments in prompt, got:\n%s", provider.prompt)
+	}
+	if !strings.Contains(buf.String(), "Startup crash") {
+		t.Errorf("Expected summary in output, got:\n%s", buf.String())
+	}
+}
+
+func TestRunSummarize_JSON(t *testing.T) {
+	client := &mockSummarizeClient{issue: &api.Issue{Number: 42, Title: "Crash"}}
+	provider := &mockProvider{reply: "summary text"}
+
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+
+	if err := runSummarizeWithDeps(cmd, []string{"other/repo#42"}, &summarizeOptions{json: true}, newSummarizeTestConfig(), client, provider); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("invalid JSON output: %v", err)
+	}
+	if out["summary"] != "summary text" || out["number"] != float64(42) {
+		t.Errorf("Unexpected JSON output: %v", out)
+	}
+	if client.issueRepo != "other/repo" {
+		t.Errorf("Expected explicit repository, got %s", client.issueRepo)
+	}
+}
+
+func TestRunSummarize_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		client   *mockSummarizeClient
+		provider *mockProvider
+		wantErr  string
+	}{
+		{
+			name:     "issue error",
+			client:   &mockSummarizeClient{issueErr: errors.New("boom")},
+			provider: &mockProvider{reply: "x"},
+			wantErr:  "failed to get issue",
+		},
+		{
+			name:     "comments error",
+			client:   &mockSummarizeClient{issue: &api.Issue{Number: 1}, commentsErr: errors.New("boom")},
+			provider: &mockProvider{reply: "x"},
+			wantErr:  "failed to get comments",
+		},
+		{
+			name:     "provider error",
+			client:   &mockSummarizeClient{issue: &api.Issue{Number: 1}},
+			provider: &mockProvider{err: errors.New("rate limited")},
+			wantErr:  "rate limited",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cmd.SetOut(new(bytes.Buffer))
+
+			err := runSummarizeWithDeps(cmd, []string{"1"}, &summarizeOptions{}, newSummarizeTestConfig(), tt.client, tt.provider)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
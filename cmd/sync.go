@@ -0,0 +1,369 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/llm"
+	"github.com/scooter-indie/gh-pmu/internal/outbox"
+	"github.com/scooter-indie/gh-pmu/internal/similar"
+	"github.com/spf13/cobra"
+)
+
+// embedBatchSize limits the number of texts sent per embedding request
+const embedBatchSize = 64
+
+// syncCursorOverlap is how far back a saved sync cursor is moved
+const syncCursorOverlap = 5 * time.Minute
+
+type syncOptions struct {
+	full  bool
+	push  bool          // push the outbox instead of building the index
+	every time.Duration // with push, keep pushing at this interval
+	force bool          // with push, override conflicts
+}
+
+// syncClient defines the interface for API methods used by sync functions.
+// This allows for easier testing with mock implementations.
+type syncClient interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetChangedIssues(projectID, owner, repo, since string) ([]api.IssueChange, error)
+}
+
+func newSyncCommand() *cobra.Command {
+	opts := &syncOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Build the local similar-issue index",
+		Long: `Build or refresh the local embedding index used by 'gh pmu similar'.
+
+Every issue in the project is embedded using the configured AI backend
+(see the 'ai' section of .gh-pmu.yml and its embedding_model setting).
+The index is stored in your user cache directory, never in the repository.
+
+The first run indexes every project issue. Later runs only fetch issues
+of the configured repositories updated since the last sync, re-embedding
+those whose title or body changed and dropping those removed from the
+project, so frequent syncs (for example from cron) stay cheap on large
+projects. Use --full to rescan every project item and re-embed everything;
+this also picks up issues added to the project without being edited.
+
+With --push, the changes queued with 'gh pmu move --queue' are pushed to
+GitHub instead. A queued change whose field was changed on GitHub after
+it was queued is reported as a conflict and stays queued; --force pushes
+it anyway. Add --every to keep running and push at an interval, as a
+background process.`,
+		Example: `  # Build or refresh the index
+  gh pmu sync
+
+  # Rebuild the index from scratch
+  gh pmu sync --full
+
+  # Push changes queued with 'gh pmu move --queue'
+  gh pmu sync --push
+
+  # Keep pushing queued changes in the background
+  gh pmu sync --push --every 1m &`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSync(cmd, opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.full, "full", false, "Re-embed all issues instead of only changed ones")
+	cmd.Flags().BoolVar(&opts.push, "push", false, "Push changes queued with 'move --queue' instead of building the index")
+	cmd.Flags().DurationVar(&opts.every, "every", 0, "With --push, keep running and push at this interval (e.g., 1m)")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "With --push, push changes even if they conflict")
+
+	return cmd
+}
+
+func runSync(cmd *cobra.Command, opts *syncOptions) error {
+	cfg, err := loadProjectConfig()
+	if err != nil {
+		return err
+	}
+
+	if opts.push {
+		return runSyncPush(cmd, opts, cfg)
+	}
+	if opts.every != 0 || opts.force {
+		return fmt.Errorf("--every and --force require --push")
+	}
+
+	embedder, err := llm.NewEmbedder(cfg.AI)
+	if err != nil {
+		return err
+	}
+
+	path, err := similar.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return err
+	}
+
+	client := api.NewClient()
+
+	return runSyncWithDeps(cmd, opts, cfg, client, embedder, path)
+}
+
+// runSyncPush pushes the outbox once, or at every interval with --every
+func runSyncPush(cmd *cobra.Command, opts *syncOptions, cfg *config.Config) error {
+	path, err := outbox.DefaultPath(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return err
+	}
+	client := api.NewClient()
+
+	if opts.every == 0 {
+		return runSyncPushWithDeps(cmd, cfg, client, path, opts.force)
+	}
+	if opts.every < 10*time.Second {
+		return fmt.Errorf("--every must be at least 10s")
+	}
+
+	ticker := time.NewTicker(opts.every)
+	defer ticker.Stop()
+	for {
+		box, err := outbox.Load(path)
+		if err != nil {
+			return err
+		}
+		if len(box.Changes) > 0 {
+			if err := runSyncPushWithDeps(cmd, cfg, client, path, opts.force); err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-cmd.Context().Done():
+			return context.Cause(cmd.Context())
+		}
+	}
+}
+
+// runSyncWithDeps is the testable implementation of runSync
+func runSyncWithDeps(cmd *cobra.Command, opts *syncOptions, cfg *config.Config, client syncClient, embedder llm.Embedder, indexPath string) error {
+	started := time.Now()
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	// Reuse the existing index unless rebuilding or the model changed
+	previous := &similar.Index{}
+	if !opts.full {
+		loaded, err := similar.Load(indexPath)
+		switch {
+		case err == nil && loaded.Model == embedder.Model():
+			previous = loaded
+		case err != nil && !errors.Is(err, os.ErrNotExist):
+			return err
+		}
+	}
+
+	if hasSyncCursors(previous, cfg.Repositories) {
+		return runSyncChanges(cmd, cfg, client, embedder, indexPath, project.ID, previous, started)
+	}
+
+	items, err := client.GetProjectItems(project.ID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	var entries []similar.Entry
+	var pending []int // indexes into entries that need embedding
+	var texts []string
+
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+		issue := item.Issue
+		entry := newSyncEntry(issue)
+
+		// Skip fetching the body when the title is unchanged; --full picks up body-only edits
+		old, found := previous.Lookup(entry.Key())
+		if found && old.Title == issue.Title {
+			entry.Hash = old.Hash
+			entry.Vector = old.Vector
+			entries = append(entries, entry)
+			continue
+		}
+
+		full, err := client.GetIssue(issue.Repository.Owner, issue.Repository.Name, issue.Number)
+		if err != nil {
+			return fmt.Errorf("failed to get issue %s: %w", entry.Key(), err)
+		}
+
+		text := similar.Text(full.Title, full.Body)
+		entry.Hash = similar.Hash(text)
+		if found && old.Hash == entry.Hash {
+			entry.Vector = old.Vector
+		} else {
+			pending = append(pending, len(entries))
+			texts = append(texts, text)
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := embedSyncEntries(embedder, entries, pending, texts); err != nil {
+		return err
+	}
+
+	cursors := make(map[string]string, len(cfg.Repositories))
+	for _, repo := range cfg.Repositories {
+		cursors[repo] = syncCursor(started)
+	}
+	if err := saveSyncIndex(cfg, embedder, indexPath, entries, cursors); err != nil {
+		return err
+	}
+
+	cmd.Printf("Indexed %d issues (%d embedded, %d unchanged)\n", len(entries), len(texts), len(entries)-len(texts))
+	return nil
+}
+
+// runSyncChanges updates the index with only the issues changed since each
+// repository's cursor, instead of listing every project item
+func runSyncChanges(cmd *cobra.Command, cfg *config.Config, client syncClient, embedder llm.Embedder, indexPath, projectID string, previous *similar.Index, started time.Time) error {
+	entries := append([]similar.Entry(nil), previous.Entries...)
+	positions := make(map[string]int, len(entries))
+	for i, e := range entries {
+		positions[e.Key()] = i
+	}
+	removed := make(map[string]bool)
+
+	var pending []int // indexes into entries that need embedding
+	var texts []string
+	changed := 0
+
+	cursors := make(map[string]string, len(cfg.Repositories))
+	for _, repo := range cfg.Repositories {
+		owner, name := splitRepository(repo)
+		changes, err := client.GetChangedIssues(projectID, owner, name, previous.Cursors[repo])
+		if err != nil {
+			return err
+		}
+		changed += len(changes)
+
+		for _, change := range changes {
+			entry := newSyncEntry(&change.Issue)
+			key := entry.Key()
+			pos, found := positions[key]
+
+			// Issues that left the project are dropped from the index
+			if change.Item == nil {
+				if found {
+					removed[key] = true
+				}
+				continue
+			}
+			delete(removed, key)
+
+			text := similar.Text(change.Issue.Title, change.Issue.Body)
+			entry.Hash = similar.Hash(text)
+			if found && entries[pos].Hash == entry.Hash {
+				entry.Vector = entries[pos].Vector
+			} else {
+				if !found {
+					pos = len(entries)
+					positions[key] = pos
+					entries = append(entries, similar.Entry{})
+				}
+				pending = append(pending, pos)
+				texts = append(texts, text)
+			}
+			entries[pos] = entry
+		}
+		cursors[repo] = syncCursor(started)
+	}
+
+	if err := embedSyncEntries(embedder, entries, pending, texts); err != nil {
+		return err
+	}
+
+	kept := entries[:0]
+	for _, e := range entries {
+		if !removed[e.Key()] {
+			kept = append(kept, e)
+		}
+	}
+	if err := saveSyncIndex(cfg, embedder, indexPath, kept, cursors); err != nil {
+		return err
+	}
+
+	cmd.Printf("Indexed %d issues (%d changed since last sync: %d embedded, %d removed)\n", len(kept), changed, len(texts), len(removed))
+	return nil
+}
+
+// hasSyncCursors reports whether the index has a cursor for every
+// repository, so only changes need to be fetched
+func hasSyncCursors(index *similar.Index, repositories []string) bool {
+	if len(repositories) == 0 {
+		return false
+	}
+	for _, repo := range repositories {
+		if index.Cursors[repo] == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// syncCursor returns the cursor to save for a sync that started at
+// started. It is moved back by syncCursorOverlap so issues changed during
+// the sync, or hidden by clock skew, are fetched again next time.
+func syncCursor(started time.Time) string {
+	return started.Add(-syncCursorOverlap).UTC().Format(time.RFC3339)
+}
+
+// newSyncEntry returns the index entry for an issue, without its embedding
+func newSyncEntry(issue *api.Issue) similar.Entry {
+	return similar.Entry{
+		Repository: issue.Repository.Owner + "/" + issue.Repository.Name,
+		Number:     issue.Number,
+		Title:      issue.Title,
+		State:      issue.State,
+		URL:        issue.URL,
+	}
+}
+
+// embedSyncEntries embeds texts in batches and stores each vector in the
+// entry at the matching index in pending
+func embedSyncEntries(embedder llm.Embedder, entries []similar.Entry, pending []int, texts []string) error {
+	for start := 0; start < len(texts); start += embedBatchSize {
+		end := start + embedBatchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+
+		vectors, err := embedder.Embed(texts[start:end])
+		if err != nil {
+			return fmt.Errorf("failed to embed issues: %w", err)
+		}
+		for i, v := range vectors {
+			entries[pending[start+i]].Vector = v
+		}
+	}
+	return nil
+}
+
+// saveSyncIndex writes the synced entries and cursors to the index
+func saveSyncIndex(cfg *config.Config, embedder llm.Embedder, indexPath string, entries []similar.Entry, cursors map[string]string) error {
+	index := &similar.Index{
+		Model:     embedder.Model(),
+		Project:   fmt.Sprintf("%s/%d", cfg.Project.Owner, cfg.Project.Number),
+		UpdatedAt: time.Now().UTC().Format(time.RFC3339),
+		Entries:   entries,
+		Cursors:   cursors,
+	}
+	return index.Save(indexPath)
+}
@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/similar"
+	"github.com/spf13/cobra"
+)
+
+// mockSyncClient implements syncClient interface for testing
+type mockSyncClient struct {
+	items      []api.ProjectItem
+	bodies     map[int]string
+	issueCalls []int
+
+	changes      []api.IssueChange
+	itemsCalls   int
+	changedSince []string
+}
+
+func (m *mockSyncClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "PVT_1"}, nil
+}
+
+func (m *mockSyncClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	m.itemsCalls++
+	return m.items, nil
+}
+
+func (m *mockSyncClient) GetChangedIssues(projectID, owner, repo, since string) ([]api.IssueChange, error) {
+	m.changedSince = append(m.changedSince, since)
+	return m.changes, nil
+}
+
+func (m *mockSyncClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	m.issueCalls = append(m.issueCalls, number)
+	for _, item := range m.items {
+		if item.Issue != nil && item.Issue.Number == number {
+			issue := *item.Issue
+			issue.Body = m.bodies[number]
+			return &issue, nil
+		}
+	}
+	return nil, fmt.Errorf("issue #%d not found", number)
+}
+
+// mockEmbedder returns a vector derived from the text length
+type mockEmbedder struct {
+	model  string
+	inputs []string
+	err    error
+}
+
+func (m *mockEmbedder) Model() string {
+	return m.model
+}
+
+func (m *mockEmbedder) Embed(texts []string) ([][]float32, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.inputs = append(m.inputs, texts...)
+	vectors := make([][]float32, len(texts))
+	for i, t := range texts {
+		vectors[i] = []float32{float32(len(t)), 1}
+	}
+	return vectors, nil
+}
+
+func newSyncTestItems(titles ...string) []api.ProjectItem {
+	var items []api.ProjectItem
+	for i, title := range titles {
+		items = append(items, api.ProjectItem{Issue: &api.Issue{
+			Number:     i + 1,
+			Title:      title,
+			State:      "OPEN",
+			Repository: api.Repository{Owner: "owner", Name: "repo"},
+		}})
+	}
+	// Draft items have no issue and are skipped
+	return append(items, api.ProjectItem{ID: "draft"})
+}
+
+func runTestSync(t *testing.T, opts *syncOptions, client *mockSyncClient, embedder *mockEmbedder, path string) string {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	cmd := &cobra.Command{}
+	cmd.SetOut(buf)
+	if err := runSyncWithDeps(cmd, opts, newSummarizeTestConfig(), client, embedder, path); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return buf.String()
+}
+
+func TestSyncCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"sync"})
+	if err != nil || sub.Name() != "sync" {
+		t.Fatalf("sync command not found: %v", err)
+	}
+	if sub.Flags().Lookup("full") == nil {
+		t.Error("Expected --full flag to exist")
+	}
+}
+
+func TestRunSync_BuildsIndex(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.json")
+	client := &mockSyncClient{
+		items:  newSyncTestItems("Login fails", "Add dark mode"),
+		bodies: map[int]string{1: "Crash after reset"},
+	}
+	embedder := &mockEmbedder{model: "m1"}
+
+	out := runTestSync(t, &syncOptions{}, client, embedder, path)
+
+	if !strings.Contains(out, "Indexed 2 issues (2 embedded, 0 unchanged)") {
+		t.Errorf("Unexpected output: %s", out)
+	}
+	if len(embedder.inputs) != 2 || embedder.inputs[0] != "Login fails\n\nCrash after reset" {
+		t.Errorf("Expected title and body to be embedded, got %q", embedder.inputs)
+	}
+
+	index, err := similar.Load(path)
+	if err != nil {
+		t.Fatalf("Failed to load index: %v", err)
+	}
+	if index.Model != "m1" || index.Project != "owner/1" || len(index.Entries) != 2 {
+		t.Errorf("Unexpected index: %+v", index)
+	}
+	if e, ok := index.Lookup("owner/repo#1"); !ok || len(e.Vector) != 2 || e.Hash == "" {
+		t.Errorf("Unexpected entry: %+v", e)
+	}
+}
+
+func TestRunSync_Incremental(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.json")
+	client := &mockSyncClient{items: newSyncTestItems("Login fails", "Add dark mode")}
+	runTestSync(t, &syncOptions{}, client, &mockEmbedder{model: "m1"}, path)
+
+	// Without cursors, titles are compared against a full item listing
+	index, _ := similar.Load(path)
+	index.Cursors = nil
+	if err := index.Save(path); err != nil {
+		t.Fatalf("Failed to save index: %v", err)
+	}
+
+	// Rename #2 and add #3; #1 is unchanged
+	client = &mockSyncClient{items: newSyncTestItems("Login fails", "Add a dark theme", "Export CSV")}
+	embedder := &mockEmbedder{model: "m1"}
+	out := runTestSync(t, &syncOptions{}, client, embedder, path)
+
+	if !strings.Contains(out, "Indexed 3 issues (2 embedded, 1 unchanged)") {
+		t.Errorf("Unexpected output: %s", out)
+	}
+	if len(client.issueCalls) != 2 || client.issueCalls[0] != 2 {
+		t.Errorf("Expected only changed issues to be fetched, got %v", client.issueCalls)
+	}
+
+	// A different model forces a full re-embed
+	embedder = &mockEmbedder{model: "m2"}
+	out = runTestSync(t, &syncOptions{}, &mockSyncClient{items: newSyncTestItems("Login fails")}, embedder, path)
+	if !strings.Contains(out, "Indexed 1 issues (1 embedded, 0 unchanged)") {
+		t.Errorf("Expected model change to re-embed, got: %s", out)
+	}
+}
+
+func TestRunSync_ChangesSinceCursor(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.json")
+	runTestSync(t, &syncOptions{}, &mockSyncClient{items: newSyncTestItems("Login fails", "Add dark mode", "Export CSV")}, &mockEmbedder{model: "m1"}, path)
+
+	index, err := similar.Load(path)
+	if err != nil {
+		t.Fatalf("Failed to load index: %v", err)
+	}
+	cursor := index.Cursors["owner/repo"]
+	if _, err := time.Parse(time.RFC3339, cursor); err != nil {
+		t.Fatalf("Expected an RFC 3339 cursor, got %q", cursor)
+	}
+
+	// #1 is touched without a text change, #2 is edited, #3 left the
+	// project, and #4 is new
+	repo := api.Repository{Owner: "owner", Name: "repo"}
+	inProject := &api.ProjectItem{ID: "item"}
+	client := &mockSyncClient{changes: []api.IssueChange{
+		{Issue: api.Issue{Number: 4, Title: "Import CSV", Repository: repo}, Item: inProject},
+		{Issue: api.Issue{Number: 2, Title: "Add dark mode", Body: "Also for the docs", Repository: repo}, Item: inProject},
+		{Issue: api.Issue{Number: 1, Title: "Login fails", State: "CLOSED", Repository: repo}, Item: inProject},
+		{Issue: api.Issue{Number: 3, Title: "Export CSV", Repository: repo}},
+	}}
+	embedder := &mockEmbedder{model: "m1"}
+	out := runTestSync(t, &syncOptions{}, client, embedder, path)
+
+	if !strings.Contains(out, "Indexed 3 issues (4 changed since last sync: 2 embedded, 1 removed)") {
+		t.Errorf("Unexpected output: %s", out)
+	}
+	if client.itemsCalls != 0 || len(client.issueCalls) != 0 {
+		t.Errorf("Expected no full listing or issue fetches, got %d listings and %v", client.itemsCalls, client.issueCalls)
+	}
+	if len(client.changedSince) != 1 || client.changedSince[0] != cursor {
+		t.Errorf("Expected changes since %s, got %v", cursor, client.changedSince)
+	}
+	if len(embedder.inputs) != 2 || embedder.inputs[0] != "Import CSV" || embedder.inputs[1] != "Add dark mode\n\nAlso for the docs" {
+		t.Errorf("Unexpected embedded texts: %q", embedder.inputs)
+	}
+
+	index, err = similar.Load(path)
+	if err != nil {
+		t.Fatalf("Failed to load index: %v", err)
+	}
+	if _, ok := index.Lookup("owner/repo#3"); ok {
+		t.Error("Expected #3 to be removed")
+	}
+	if e, ok := index.Lookup("owner/repo#1"); !ok || e.State != "CLOSED" || len(e.Vector) == 0 {
+		t.Errorf("Expected #1 updated with its vector kept, got %+v", e)
+	}
+	if e, ok := index.Lookup("owner/repo#4"); !ok || len(e.Vector) == 0 {
+		t.Errorf("Expected #4 to be indexed, got %+v", e)
+	}
+	if index.Cursors["owner/repo"] < cursor {
+		t.Errorf("Expected the cursor to move forward, got %s", index.Cursors["owner/repo"])
+	}
+
+	// --full ignores the cursors
+	client = &mockSyncClient{items: newSyncTestItems("Login fails")}
+	runTestSync(t, &syncOptions{full: true}, client, &mockEmbedder{model: "m1"}, path)
+	if client.itemsCalls != 1 || len(client.changedSince) != 0 {
+		t.Errorf("Expected a full listing, got %d listings and %v", client.itemsCalls, client.changedSince)
+	}
+}
+
+func TestRunSync_Full(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.json")
+	items := newSyncTestItems("Login fails")
+	runTestSync(t, &syncOptions{}, &mockSyncClient{items: items}, &mockEmbedder{model: "m1"}, path)
+
+	client := &mockSyncClient{items: items, bodies: map[int]string{1: "new body"}}
+	embedder := &mockEmbedder{model: "m1"}
+	runTestSync(t, &syncOptions{full: true}, client, embedder, path)
+
+	if len(embedder.inputs) != 1 || !strings.Contains(embedder.inputs[0], "new body") {
+		t.Errorf("Expected --full to re-embed edited body, got %q", embedder.inputs)
+	}
+}
+
+func TestRunSync_EmbedError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "index.json")
+	cmd := &cobra.Command{}
+	cmd.SetOut(new(bytes.Buffer))
+
+	err := runSyncWithDeps(cmd, &syncOptions{}, &config.Config{Project: config.Project{Owner: "o", Number: 1}},
+		&mockSyncClient{items: newSyncTestItems("A")}, &mockEmbedder{model: "m", err: errors.New("quota")}, path)
+	if err == nil || !strings.Contains(err.Error(), "quota") {
+		t.Errorf("Expected embed error, got %v", err)
+	}
+}
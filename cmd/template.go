@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// parseOutputTemplate parses a --template value. The template is executed
+// against the same payload --json prints, decoded into maps and slices so
+// fields are addressed by their JSON names (e.g. {{.title}}).
+func parseOutputTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(outputTemplateFuncs()).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// executeOutputTemplate renders payload through tmpl as its JSON form
+func executeOutputTemplate(w io.Writer, tmpl *template.Template, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode template data: %w", err)
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return fmt.Errorf("failed to decode template data: %w", err)
+	}
+
+	if err := tmpl.Execute(w, decoded); err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+	return nil
+}
+
+// outputTemplateFuncs returns helpers available to --template, modelled on gh's
+func outputTemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"join": func(sep string, list interface{}) string {
+			items, _ := list.([]interface{})
+			parts := make([]string, 0, len(items))
+			for _, item := range items {
+				parts = append(parts, fmt.Sprint(item))
+			}
+			return strings.Join(parts, sep)
+		},
+		"pluck": func(key string, list interface{}) []interface{} {
+			items, _ := list.([]interface{})
+			values := make([]interface{}, 0, len(items))
+			for _, item := range items {
+				if m, ok := item.(map[string]interface{}); ok {
+					values = append(values, m[key])
+				}
+			}
+			return values
+		},
+		"truncate": func(length int, input interface{}) string {
+			if input == nil {
+				return ""
+			}
+			runes := []rune(fmt.Sprint(input))
+			if len(runes) <= length {
+				return string(runes)
+			}
+			if length <= 3 {
+				return string(runes[:length])
+			}
+			return string(runes[:length-3]) + "..."
+		},
+		"timefmt": func(format, input string) (string, error) {
+			t, err := time.Parse(time.RFC3339, input)
+			if err != nil {
+				return "", err
+			}
+			return t.Format(format), nil
+		},
+		"timeago": func(input string) (string, error) {
+			t, err := time.Parse(time.RFC3339, input)
+			if err != nil {
+				return "", err
+			}
+			return formatTimeAgo(time.Since(t)), nil
+		},
+	}
+}
+
+// formatTimeAgo renders a duration as a coarse relative time
+func formatTimeAgo(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return "less than a minute ago"
+	case d < time.Hour:
+		return fmt.Sprintf("about %d minutes ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("about %d hours ago", int(d.Hours()))
+	case d < 30*24*time.Hour:
+		return fmt.Sprintf("about %d days ago", int(d.Hours()/24))
+	case d < 365*24*time.Hour:
+		return fmt.Sprintf("about %d months ago", int(d.Hours()/24/30))
+	default:
+		return fmt.Sprintf("about %d years ago", int(d.Hours()/24/365))
+	}
+}
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+func TestParseOutputTemplate_Invalid(t *testing.T) {
+	_, err := parseOutputTemplate("{{.title")
+	if err == nil || !strings.Contains(err.Error(), "invalid template") {
+		t.Errorf("Expected invalid template error, got %v", err)
+	}
+}
+
+func TestExecuteOutputTemplate_ListPayload(t *testing.T) {
+	items := []api.ProjectItem{
+		{
+			Issue: &api.Issue{
+				Number:     12,
+				Title:      "Fix login",
+				State:      "OPEN",
+				Repository: api.Repository{Owner: "owner", Name: "repo"},
+				Assignees:  []api.Actor{{Login: "alice"}, {Login: "bob"}},
+			},
+			FieldValues: []api.FieldValue{{Field: "Status", Value: "In Progress"}},
+		},
+		{
+			Issue: &api.Issue{
+				Number:     14,
+				Title:      "Add export",
+				State:      "OPEN",
+				Repository: api.Repository{Owner: "owner", Name: "repo"},
+			},
+		},
+	}
+
+	tmpl, err := parseOutputTemplate(`{{range .items}}#{{.number}} {{.title}} [{{join ", " .assignees}}] {{.fieldValues.Status}}{{"\n"}}{{end}}`)
+	if err != nil {
+		t.Fatalf("parseOutputTemplate() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := executeOutputTemplate(&buf, tmpl, buildListJSON(items, nil)); err != nil {
+		t.Fatalf("executeOutputTemplate() error = %v", err)
+	}
+
+	want := "#12 Fix login [alice, bob] In Progress\n#14 Add export [] <no value>\n"
+	if buf.String() != want {
+		t.Errorf("Output = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestExecuteOutputTemplate_ViewPayload(t *testing.T) {
+	output := buildViewJSON(&api.Issue{
+		Number: 7,
+		Title:  "A rather long issue title",
+		Labels: []api.Label{{Name: "bug"}, {Name: "ui"}},
+	}, nil, nil, nil, nil)
+
+	tmpl, err := parseOutputTemplate(`{{truncate 10 .title}}|{{join "," .labels}}`)
+	if err != nil {
+		t.Fatalf("parseOutputTemplate() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := executeOutputTemplate(&buf, tmpl, output); err != nil {
+		t.Fatalf("executeOutputTemplate() error = %v", err)
+	}
+
+	if buf.String() != "A rathe...|bug,ui" {
+		t.Errorf("Output = %q", buf.String())
+	}
+}
+
+func TestOutputTemplateFuncs(t *testing.T) {
+	tmpl, err := parseOutputTemplate(`{{join "," (pluck "number" .)}} {{timefmt "2006-01-02" "2025-03-04T05:06:07Z"}}`)
+	if err != nil {
+		t.Fatalf("parseOutputTemplate() error = %v", err)
+	}
+
+	var buf bytes.Buffer
+	payload := []ViewJSONOutput{{Number: 1}, {Number: 2}}
+	if err := executeOutputTemplate(&buf, tmpl, payload); err != nil {
+		t.Fatalf("executeOutputTemplate() error = %v", err)
+	}
+	if buf.String() != "1,2 2025-03-04" {
+		t.Errorf("Output = %q", buf.String())
+	}
+}
+
+func TestExecuteOutputTemplate_ExecutionError(t *testing.T) {
+	tmpl, _ := parseOutputTemplate(`{{timefmt "2006" .title}}`)
+
+	var buf bytes.Buffer
+	err := executeOutputTemplate(&buf, tmpl, ViewJSONOutput{Title: "not a time"})
+	if err == nil || !strings.Contains(err.Error(), "failed to execute template") {
+		t.Errorf("Expected execution error, got %v", err)
+	}
+}
+
+func TestFormatTimeAgo(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{30 * time.Second, "less than a minute ago"},
+		{5 * time.Minute, "about 5 minutes ago"},
+		{3 * time.Hour, "about 3 hours ago"},
+		{4 * 24 * time.Hour, "about 4 days ago"},
+		{90 * 24 * time.Hour, "about 3 months ago"},
+		{800 * 24 * time.Hour, "about 2 years ago"},
+	}
+
+	for _, tt := range tests {
+		if got := formatTimeAgo(tt.d); got != tt.want {
+			t.Errorf("formatTimeAgo(%v) = %q, want %q", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestTemplateFlags(t *testing.T) {
+	for _, name := range []string{"list", "view"} {
+		cmd := NewRootCommand()
+		sub, _, err := cmd.Find([]string{name})
+		if err != nil {
+			t.Fatalf("%s command not found: %v", name, err)
+		}
+		if sub.Flags().Lookup("template") == nil {
+			t.Errorf("Expected --template flag on %s", name)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/cli/go-gh/v2/pkg/term"
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/worklog"
+	"github.com/spf13/cobra"
+)
+
+type timerStartOptions struct {
+	duration string
+	note     string
+	comment  bool
+	local    bool
+}
+
+// timerClient defines the interface for API methods used by timer start.
+// This allows for easier testing with mock implementations.
+type timerClient interface {
+	logClient
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	AddIssueComment(issueID, body string) (string, error)
+}
+
+// timerWait blocks for up to d, returning how long it actually ran
+type timerWait func(d time.Duration) time.Duration
+
+func newTimerCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timer",
+		Short: "Run a focus timer that logs time to an issue",
+	}
+
+	cmd.AddCommand(newTimerStartCommand())
+
+	return cmd
+}
+
+func newTimerStartCommand() *cobra.Command {
+	opts := &timerStartOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "start [issue]",
+		Short: "Start a focus timer on an issue",
+		Long: `Run a countdown timer in the terminal for an issue, by default the one
+set with 'gh pmu focus'.
+
+When the timer completes, the interval is logged as with 'gh pmu log':
+to the local worklog and to the issue's time field. Press Ctrl+C to stop
+early; the time spent so far is logged if it is at least a minute.
+
+With --comment, a progress comment with the time worked and the --note
+text is also posted on the issue.`,
+		Example: `  # A 25 minute pomodoro on the focused issue
+  gh pmu timer start
+
+  # 50 minutes on #42, then post a progress comment
+  gh pmu timer start 42 --duration 50m --note "profiling the importer" --comment`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, path, err := loadWorklogConfig()
+			if err != nil {
+				return err
+			}
+			args, err = issueArgsOrFocus(args, cfg)
+			if err != nil {
+				return err
+			}
+			return runTimerStartWithDeps(cmd, args, opts, cfg, path, api.NewClient(), waitWithCountdown(cmd), time.Now)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.duration, "duration", "d", "25m", "Length of the timer (e.g., 25m, 1h)")
+	cmd.Flags().StringVarP(&opts.note, "note", "n", "", "Note for the worklog entry and progress comment")
+	cmd.Flags().BoolVar(&opts.comment, "comment", false, "Post a progress comment on the issue when the timer ends")
+	cmd.Flags().BoolVar(&opts.local, "local", false, "Only record the entry locally; do not update the project field")
+
+	return cmd
+}
+
+// waitWithCountdown returns a timerWait that shows the remaining time on a
+// terminal and stops early on Ctrl+C or when the command's --timeout expires
+func waitWithCountdown(cmd *cobra.Command) timerWait {
+	return func(d time.Duration) time.Duration {
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt)
+		defer signal.Stop(interrupt)
+
+		showCountdown := term.FromEnv().IsTerminalOutput()
+		start := time.Now()
+		deadline := time.NewTimer(d)
+		defer deadline.Stop()
+		ticker := time.NewTicker(time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-deadline.C:
+				if showCountdown {
+					cmd.Print("\r\033[K")
+				}
+				return d
+			case <-interrupt:
+				if showCountdown {
+					cmd.Print("\r\033[K")
+				}
+				return time.Since(start)
+			case <-cmd.Context().Done():
+				if showCountdown {
+					cmd.Print("\r\033[K")
+				}
+				return time.Since(start)
+			case <-ticker.C:
+				if showCountdown {
+					remaining := (d - time.Since(start)).Round(time.Second)
+					cmd.Printf("\r\033[K⏱  %s remaining", formatCountdown(remaining))
+				}
+			}
+		}
+	}
+}
+
+// formatCountdown renders a duration as mm:ss, or h:mm:ss from an hour up
+func formatCountdown(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	total := int(d.Seconds())
+	h, m, s := total/3600, total/60%60, total%60
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%02d:%02d", m, s)
+}
+
+// runTimerStartWithDeps is the testable implementation of timer start
+func runTimerStartWithDeps(cmd *cobra.Command, args []string, opts *timerStartOptions, cfg *config.Config, path string, client timerClient, wait timerWait, now func() time.Time) error {
+	key, err := issueKey(cfg, args[0])
+	if err != nil {
+		return err
+	}
+
+	minutes, err := worklog.ParseDuration(opts.duration)
+	if err != nil {
+		return err
+	}
+	duration := time.Duration(minutes) * time.Minute
+
+	cmd.Printf("Timer started: %s on %s (Ctrl+C to stop early)\n", worklog.FormatMinutes(minutes), key)
+	elapsed := wait(duration)
+
+	worked := int(elapsed.Round(time.Minute) / time.Minute)
+	if worked < 1 {
+		cmd.Println("Timer stopped before a minute had passed; nothing logged")
+		return nil
+	}
+	if elapsed < duration {
+		cmd.Printf("Timer stopped early after %s\n", worklog.FormatMinutes(worked))
+	} else {
+		cmd.Println("Timer complete")
+	}
+
+	if err := recordWorklog(cmd, cfg, path, client, key, worked, opts.note, opts.local, now()); err != nil {
+		return err
+	}
+
+	if !opts.comment {
+		return nil
+	}
+
+	owner, repo, number, err := parseIssueReference(key)
+	if err != nil {
+		return err
+	}
+	issue, err := client.GetIssue(owner, repo, number)
+	if err != nil {
+		return fmt.Errorf("failed to get issue: %w", err)
+	}
+	url, err := client.AddIssueComment(issue.ID, timerComment(worked, opts.note))
+	if err != nil {
+		return err
+	}
+	cmd.Printf("  • Posted progress comment: %s\n", url)
+	return nil
+}
+
+// timerComment is the progress comment posted when a timer ends
+func timerComment(minutes int, note string) string {
+	body := fmt.Sprintf("⏱️ Worked %s on this issue.", worklog.FormatMinutes(minutes))
+	if note != "" {
+		body += "\n\n" + note
+	}
+	return body
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/worklog"
+)
+
+// mockTimerClient implements timerClient interface for testing
+type mockTimerClient struct {
+	mockLogClient
+	commentIssue string
+	commentBody  string
+}
+
+func (m *mockTimerClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	return &api.Issue{ID: "issue-node", Number: number}, nil
+}
+
+func (m *mockTimerClient) AddIssueComment(issueID, body string) (string, error) {
+	m.commentIssue = issueID
+	m.commentBody = body
+	return "https://github.com/owner/repo/issues/42#issuecomment-1", nil
+}
+
+// fixedWait is a timerWait that reports running for elapsed
+func fixedWait(elapsed time.Duration) timerWait {
+	return func(d time.Duration) time.Duration {
+		if elapsed > d {
+			return d
+		}
+		return elapsed
+	}
+}
+
+func TestTimerStartCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"timer", "start"})
+	if err != nil || sub.Name() != "start" {
+		t.Fatalf("timer start command not found: %v", err)
+	}
+	for _, flag := range []string{"duration", "note", "comment", "local"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag", flag)
+		}
+	}
+}
+
+func TestRunTimerStart_CompleteLogsAndComments(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "worklog.json")
+	client := &mockTimerClient{mockLogClient: mockLogClient{item: &api.ProjectItem{ID: "item-42"}}}
+	opts := &timerStartOptions{duration: "25m", note: "profiling", comment: true}
+
+	cmd, buf := newTestCmd()
+	err := runTimerStartWithDeps(cmd, []string{"42"}, opts, newTestConfig(), path, client, fixedWait(time.Hour), time.Now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "Timer complete") || !strings.Contains(out, "Logged 25m on owner/repo#42") {
+		t.Errorf("Unexpected output: %s", out)
+	}
+	if client.setValue != "0.42" {
+		t.Errorf("Expected time field 0.42, got %q", client.setValue)
+	}
+	if client.commentIssue != "issue-node" || client.commentBody != "⏱️ Worked 25m on this issue.\n\nprofiling" {
+		t.Errorf("Unexpected comment on %s: %q", client.commentIssue, client.commentBody)
+	}
+
+	log, err := worklog.Load(path)
+	if err != nil || len(log.Entries) != 1 || log.Entries[0].Minutes != 25 || log.Entries[0].Note != "profiling" {
+		t.Errorf("Unexpected worklog: %+v, %v", log, err)
+	}
+}
+
+func TestRunTimerStart_StoppedEarly(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "worklog.json")
+	client := &mockTimerClient{}
+	opts := &timerStartOptions{duration: "25m", local: true}
+
+	cmd, buf := newTestCmd()
+	err := runTimerStartWithDeps(cmd, []string{"42"}, opts, newTestConfig(), path, client, fixedWait(12*time.Minute+20*time.Second), time.Now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Timer stopped early after 12m") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+	if client.commentBody != "" || client.setField != "" {
+		t.Error("Expected no comment or field update")
+	}
+
+	log, _ := worklog.Load(path)
+	if log.Total("owner/repo#42") != 12 {
+		t.Errorf("Expected 12 minutes logged, got %d", log.Total("owner/repo#42"))
+	}
+}
+
+func TestRunTimerStart_UnderAMinuteLogsNothing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "worklog.json")
+
+	cmd, buf := newTestCmd()
+	err := runTimerStartWithDeps(cmd, []string{"42"}, &timerStartOptions{duration: "25m", comment: true}, newTestConfig(), path, &mockTimerClient{}, fixedWait(10*time.Second), time.Now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "nothing logged") {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+	if log, _ := worklog.Load(path); len(log.Entries) != 0 {
+		t.Errorf("Expected no entries, got %+v", log.Entries)
+	}
+}
+
+func TestFormatCountdown(t *testing.T) {
+	tests := map[time.Duration]string{
+		25 * time.Minute:                        "25:00",
+		59*time.Second + 4*time.Minute:          "04:59",
+		time.Hour + 2*time.Minute + time.Second: "1:02:01",
+		-time.Second:                            "00:00",
+	}
+	for d, want := range tests {
+		if got := formatCountdown(d); got != want {
+			t.Errorf("formatCountdown(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,355 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+type tourOptions struct {
+	mock bool
+}
+
+// tourClient defines the interface for API methods used by tour.
+// This allows for easier testing with mock implementations.
+type tourClient interface {
+	GetViewerLogin() (string, error)
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+}
+
+// tourStep is one stage of the core workflow. check verifies the part of
+// the setup the stage depends on.
+type tourStep struct {
+	title    string
+	explain  string
+	commands []string
+	check    func(s *tourSession)
+}
+
+// tourSession is the state checked as the tour goes
+type tourSession struct {
+	u       *ui.UI
+	cfg     *config.Config
+	cfgErr  error
+	client  tourClient
+	project *api.Project
+
+	problems int
+}
+
+func newTourCommand() *cobra.Command {
+	opts := &tourOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tour",
+		Short: "Walk through the core workflow, checking your setup",
+		Long: `Walk through the core gh pmu workflow one step at a time:
+init, intake, triage, create, split, move, and done.
+
+Each step explains what the command is for, shows commands to try, and
+checks the part of your setup it relies on: authentication, .gh-pmu.yml,
+the project, triage rules, field aliases, and the status workflow. The
+tour itself changes nothing, so it is safe to run against any project;
+point .gh-pmu.yml at a sandbox project to try the commands as well.
+
+With --mock, the tour uses a built-in sample project and needs neither a
+configuration nor a network connection.`,
+		Example: `  gh pmu tour
+  gh pmu tour --mock`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.mock {
+				return runTourWithDeps(cmd, tourMockConfig(), nil, tourMockClient{}, bufio.NewReader(cmd.InOrStdin()))
+			}
+			cwd, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get current directory: %w", err)
+			}
+			cfg, err := config.LoadFromDirectory(cwd)
+			if err == nil {
+				err = cfg.Validate()
+			}
+			return runTourWithDeps(cmd, cfg, err, api.NewClient(), bufio.NewReader(cmd.InOrStdin()))
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.mock, "mock", false, "Use a built-in sample project instead of your configuration")
+
+	return cmd
+}
+
+// runTourWithDeps is the testable implementation of tour. cfgErr is why
+// the configuration could not be loaded, if it could not.
+func runTourWithDeps(cmd *cobra.Command, cfg *config.Config, cfgErr error, client tourClient, reader *bufio.Reader) error {
+	out := cmd.OutOrStdout()
+	if cfgErr != nil {
+		cfg = nil
+	}
+	s := &tourSession{
+		u:      ui.NewWithOptions(out, colorDisabled(cmd)),
+		cfg:    cfg,
+		cfgErr: cfgErr,
+		client: client,
+	}
+
+	s.u.Header("gh pmu tour", "From a new issue to done, one step at a time")
+
+	steps := tourSteps()
+	for i, step := range steps {
+		s.u.Step(i+1, len(steps), step.title)
+		fmt.Fprintln(out, step.explain)
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, "Try:")
+		for _, c := range step.commands {
+			fmt.Fprintf(out, "  %s\n", c)
+		}
+		fmt.Fprintln(out)
+		step.check(s)
+
+		if i < len(steps)-1 {
+			fmt.Fprint(out, "\nPress Enter to continue, or q to quit: ")
+			response, err := reader.ReadString('\n')
+			if strings.EqualFold(strings.TrimSpace(response), "q") {
+				fmt.Fprintln(out, "Tour ended. Run 'gh pmu tour' to start again.")
+				return nil
+			}
+			if err != nil {
+				// Not interactive: keep going
+				fmt.Fprintln(out)
+			}
+		}
+	}
+
+	fmt.Fprintln(out)
+	if s.problems > 0 {
+		s.u.Warning(fmt.Sprintf("Tour complete; %d check(s) above need attention", s.problems))
+	} else {
+		s.u.Success("Tour complete; your setup is ready")
+	}
+	return nil
+}
+
+// tourSteps returns the stages of the core workflow in order
+func tourSteps() []tourStep {
+	return []tourStep{
+		{
+			title:    "init: connect a project",
+			explain:  "gh pmu works against one GitHub project, set up in .gh-pmu.yml by\n'gh pmu init'. It records the project, its repositories, field aliases\nsuch as p1 for Priority, and the project's fields and statuses.",
+			commands: []string{"gh pmu init"},
+			check:    checkTourInit,
+		},
+		{
+			title:    "intake: bring issues into the project",
+			explain:  "Issues opened in your repositories are not on the board until they\nare added. intake finds them and adds them, optionally setting fields.",
+			commands: []string{"gh pmu intake --dry-run", "gh pmu intake --apply status:backlog"},
+			check:    checkTourIntake,
+		},
+		{
+			title:    "triage: apply rules in bulk",
+			explain:  "Triage rules in .gh-pmu.yml pair a query with labels and fields to\napply, so recurring sorting is one command.",
+			commands: []string{"gh pmu triage --list", "gh pmu triage <rule> --dry-run"},
+			check:    checkTourTriage,
+		},
+		{
+			title:    "create: add an issue with fields",
+			explain:  "create opens an issue, adds it to the project, and sets its fields in\none step. Field values can be the aliases from .gh-pmu.yml.",
+			commands: []string{`gh pmu create --title "Try gh pmu" --status backlog --priority p2`},
+			check:    checkTourCreate,
+		},
+		{
+			title:    "split: break work into sub-issues",
+			explain:  "Large issues become epics: split creates sub-issues from a checklist\nor a list of titles and links them to the parent.",
+			commands: []string{`gh pmu split 42 "Design" "Build" "Test"`, "gh pmu sub list 42 --tree"},
+			check:    checkTourSplit,
+		},
+		{
+			title:    "move: track progress",
+			explain:  "move changes an issue's status and other fields as work goes on;\nthe board shows where everything stands.",
+			commands: []string{"gh pmu move 43 --status in_progress", "gh pmu board"},
+			check:    checkTourMove,
+		},
+		{
+			title:    "done: finish the work",
+			explain:  "--done moves an issue to the done status and closes it; with\n--recursive its sub-issues are finished too.",
+			commands: []string{"gh pmu move 42 --done --recursive"},
+			check:    checkTourDone,
+		},
+	}
+}
+
+// fail reports a problem with the setup
+func (s *tourSession) fail(msg string) {
+	s.problems++
+	s.u.Error(msg)
+}
+
+// warn reports something worth fixing that does not stop the workflow
+func (s *tourSession) warn(msg string) {
+	s.problems++
+	s.u.Warning(msg)
+}
+
+func checkTourInit(s *tourSession) {
+	if login, err := s.client.GetViewerLogin(); err != nil {
+		s.fail(fmt.Sprintf("Not authenticated: %v; run 'gh auth login'", err))
+	} else {
+		s.u.Success(fmt.Sprintf("Authenticated as @%s", login))
+	}
+
+	if s.cfgErr != nil {
+		s.fail(fmt.Sprintf("No usable .gh-pmu.yml: %v; run 'gh pmu init'", s.cfgErr))
+		return
+	}
+	s.u.Success(fmt.Sprintf("Configured for project %s/%d with %d repository(ies)", s.cfg.Project.Owner, s.cfg.Project.Number, len(s.cfg.Repositories)))
+
+	project, err := s.client.GetProject(s.cfg.Project.Owner, s.cfg.Project.Number)
+	if err != nil {
+		s.fail(fmt.Sprintf("Cannot open the project: %v", err))
+		return
+	}
+	s.project = project
+	s.u.Success(fmt.Sprintf("Project %q is reachable", project.Title))
+}
+
+func checkTourIntake(s *tourSession) {
+	if s.project == nil {
+		s.u.Info("Skipped until the project is reachable")
+		return
+	}
+	items, err := s.client.GetProjectItems(s.project.ID, nil)
+	if err != nil {
+		s.fail(fmt.Sprintf("Cannot read the project's items: %v", err))
+		return
+	}
+	s.u.Success(fmt.Sprintf("The project has %d item(s)", len(items)))
+}
+
+func checkTourTriage(s *tourSession) {
+	if s.cfg == nil {
+		s.u.Info("Skipped until .gh-pmu.yml is set up")
+		return
+	}
+	if len(s.cfg.Triage) == 0 {
+		s.warn("No triage rules yet; add one under triage: in .gh-pmu.yml")
+		return
+	}
+	names := make([]string, 0, len(s.cfg.Triage))
+	for name := range s.cfg.Triage {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	s.u.Success(fmt.Sprintf("Triage rules: %s", strings.Join(names, ", ")))
+}
+
+func checkTourCreate(s *tourSession) {
+	if s.cfg == nil {
+		s.u.Info("Skipped until .gh-pmu.yml is set up")
+		return
+	}
+	for _, key := range []string{"status", "priority"} {
+		field, ok := s.cfg.Fields[key]
+		if !ok || len(field.Values) == 0 {
+			s.warn(fmt.Sprintf("No %s aliases; values must be given as the project spells them", key))
+			continue
+		}
+		aliases := make([]string, 0, len(field.Values))
+		for alias := range field.Values {
+			aliases = append(aliases, alias)
+		}
+		sort.Strings(aliases)
+		s.u.Success(fmt.Sprintf("%s aliases: %s", field.Field, strings.Join(aliases, ", ")))
+	}
+}
+
+func checkTourSplit(s *tourSession) {
+	if s.cfg == nil {
+		s.u.Info("Skipped until .gh-pmu.yml is set up")
+		return
+	}
+	if len(s.cfg.Repositories) == 0 {
+		s.fail("No repositories configured; sub-issues need one to be created in")
+		return
+	}
+	s.u.Success(fmt.Sprintf("Sub-issues are created in %s unless --repo says otherwise", s.cfg.Repositories[0]))
+}
+
+func checkTourMove(s *tourSession) {
+	if s.cfg == nil {
+		s.u.Info("Skipped until .gh-pmu.yml is set up")
+		return
+	}
+	workflow := fieldOptionOrder(s.cfg, s.cfg.GetFieldName("status"))
+	if len(workflow) == 0 {
+		s.warn("The project's statuses are not cached; run 'gh pmu init' again")
+		return
+	}
+	s.u.Success(fmt.Sprintf("Workflow: %s", strings.Join(workflow, " → ")))
+}
+
+func checkTourDone(s *tourSession) {
+	if s.cfg == nil {
+		s.u.Info("Skipped until .gh-pmu.yml is set up")
+		return
+	}
+	done := s.cfg.ResolveFieldValue("status", "done")
+	for _, status := range fieldOptionOrder(s.cfg, s.cfg.GetFieldName("status")) {
+		if strings.EqualFold(status, done) {
+			s.u.Success(fmt.Sprintf("--done moves issues to %q", status))
+			return
+		}
+	}
+	s.warn(fmt.Sprintf("--done would set status %q, which the project does not have; map done under fields.status.values", done))
+}
+
+// tourMockConfig is the configuration of the --mock sample project
+func tourMockConfig() *config.Config {
+	return &config.Config{
+		Project:      config.Project{Name: "Tour sandbox", Owner: "octo-org", Number: 1},
+		Repositories: []string{"octo-org/sandbox"},
+		Fields: map[string]config.Field{
+			"status": {Field: "Status", Values: map[string]string{
+				"backlog":     "Backlog",
+				"in_progress": "In progress",
+				"done":        "Done",
+			}},
+			"priority": {Field: "Priority", Values: map[string]string{"p0": "P0", "p1": "P1", "p2": "P2"}},
+		},
+		Triage: map[string]config.Triage{
+			"untracked": {
+				Query: "is:issue is:open -label:pm-tracked",
+				Apply: config.TriageApply{Labels: []string{"pm-tracked"}, Fields: map[string]string{"status": "backlog"}},
+			},
+		},
+		Metadata: &config.Metadata{Fields: []config.FieldMetadata{{
+			Name:    "Status",
+			Options: []config.OptionMetadata{{Name: "Backlog"}, {Name: "In progress"}, {Name: "Done"}},
+		}}},
+	}
+}
+
+// tourMockClient serves the --mock sample project
+type tourMockClient struct{}
+
+func (tourMockClient) GetViewerLogin() (string, error) {
+	return "octocat", nil
+}
+
+func (tourMockClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "tour-project", Number: number, Title: "Tour sandbox"}, nil
+}
+
+func (tourMockClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	repo := api.Repository{Owner: "octo-org", Name: "sandbox"}
+	return []api.ProjectItem{
+		{ID: "tour-1", Issue: &api.Issue{Number: 42, Title: "Onboarding epic", Repository: repo}},
+		{ID: "tour-2", Issue: &api.Issue{Number: 43, Title: "Write the first guide", Repository: repo}},
+	}, nil
+}
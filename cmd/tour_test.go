@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockTourClient implements tourClient interface for testing
+type mockTourClient struct{}
+
+func (mockTourClient) GetViewerLogin() (string, error) {
+	return "", fmt.Errorf("not logged in")
+}
+
+func (mockTourClient) GetProject(owner string, number int) (*api.Project, error) {
+	return nil, fmt.Errorf("unexpected call")
+}
+
+func (mockTourClient) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	return nil, fmt.Errorf("unexpected call")
+}
+
+func TestRunTour_Mock(t *testing.T) {
+	cmd, buf := newTestCmd()
+	reader := bufio.NewReader(strings.NewReader("\n\n"))
+	if err := runTourWithDeps(cmd, tourMockConfig(), nil, tourMockClient{}, reader); err != nil {
+		t.Fatalf("runTourWithDeps() error = %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"Step 1 of 7: init: connect a project",
+		"✓ Authenticated as @octocat",
+		`✓ Project "Tour sandbox" is reachable`,
+		"✓ The project has 2 item(s)",
+		"✓ Triage rules: untracked",
+		"✓ Priority aliases: p0, p1, p2",
+		"Step 7 of 7: done: finish the work",
+		"✓ Workflow: Backlog → In progress → Done",
+		`✓ --done moves issues to "Done"`,
+		"✓ Tour complete; your setup is ready",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunTour_Unconfigured(t *testing.T) {
+	cmd, buf := newTestCmd()
+	reader := bufio.NewReader(strings.NewReader(""))
+	if err := runTourWithDeps(cmd, nil, fmt.Errorf("no .gh-pmu.yml found"), mockTourClient{}, reader); err != nil {
+		t.Fatalf("runTourWithDeps() error = %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"✗ Not authenticated: not logged in; run 'gh auth login'",
+		"✗ No usable .gh-pmu.yml: no .gh-pmu.yml found; run 'gh pmu init'",
+		"Skipped until the project is reachable",
+		"Tour complete; 2 check(s) above need attention",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestRunTour_Quit(t *testing.T) {
+	cmd, buf := newTestCmd()
+	reader := bufio.NewReader(strings.NewReader("\nq\n"))
+	if err := runTourWithDeps(cmd, tourMockConfig(), nil, tourMockClient{}, reader); err != nil {
+		t.Fatalf("runTourWithDeps() error = %v", err)
+	}
+
+	out := buf.String()
+	if !strings.Contains(out, "Tour ended.") || strings.Contains(out, "Step 3 of 7") {
+		t.Errorf("Expected the tour to end after step 2:\n%s", out)
+	}
+}
@@ -4,12 +4,17 @@ import (
 	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
 	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/fanout"
+	"github.com/scooter-indie/gh-pmu/internal/llm"
+	"github.com/scooter-indie/gh-pmu/internal/prefetch"
+	"github.com/scooter-indie/gh-pmu/internal/triage"
 	"github.com/spf13/cobra"
 )
 
@@ -21,6 +26,16 @@ type triageOptions struct {
 	repo        string
 	query       string
 	apply       string
+	summary     bool
+	prefetch    int
+
+	// fetchDetails fetches an issue with its comments; set by runTriage
+	// for interactive mode
+	fetchDetails prefetch.FetchFunc
+
+	// summarizer returns an AI summary for an issue; set by runTriage
+	// when --summary is used
+	summarizer func(details *prefetch.Details) (string, error)
 }
 
 // triageClient defines the interface for API methods used by triage functions.
@@ -56,6 +71,12 @@ Each triage config has a query to match issues and rules to apply.`,
   # Run interactively (prompt for each issue)
   gh pmu triage tracked --interactive
 
+  # Show an AI summary of each issue before prompting (requires ai config)
+  gh pmu triage tracked --interactive --summary
+
+  # Load issue details further ahead while you decide
+  gh pmu triage tracked --interactive --prefetch 20
+
   # Target a specific repository
   gh pmu triage tracked --repo owner/repo
 
@@ -76,6 +97,8 @@ Each triage config has a query to match issues and rules to apply.`,
 	cmd.Flags().StringVarP(&opts.repo, "repo", "R", "", "Target specific repository (owner/repo format)")
 	cmd.Flags().StringVarP(&opts.query, "query", "q", "", "Ad-hoc query (e.g., \"is:open -label:triaged\")")
 	cmd.Flags().StringVarP(&opts.apply, "apply", "a", "", "Ad-hoc field updates (e.g., \"status:backlog,priority:p1\")")
+	cmd.Flags().BoolVar(&opts.summary, "summary", false, "Show an AI-generated summary of each issue in interactive mode (requires ai config)")
+	cmd.Flags().IntVar(&opts.prefetch, "prefetch", 10, "Issues to load in the background ahead of the current one in interactive mode (0 to disable)")
 
 	return cmd
 }
@@ -99,11 +122,42 @@ func runTriage(cmd *cobra.Command, args []string, opts *triageOptions) error {
 	// Create API client
 	client := api.NewClient()
 
-	return runTriageWithDeps(cmd, args, opts, cfg, client, os.Stdin)
+	if opts.interactive {
+		opts.fetchDetails = func(key prefetch.Key) (*prefetch.Details, error) {
+			issue, err := client.GetIssue(key.Owner, key.Repo, key.Number)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get issue: %w", err)
+			}
+			comments, err := client.GetIssueComments(key.Owner, key.Repo, key.Number)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get comments: %w", err)
+			}
+			return &prefetch.Details{Issue: issue, Comments: comments}, nil
+		}
+	}
+
+	if opts.summary {
+		if !opts.interactive {
+			return fmt.Errorf("--summary requires --interactive")
+		}
+		provider, err := llm.New(cfg.AI)
+		if err != nil {
+			return err
+		}
+		maxChars := 0
+		if cfg.AI != nil {
+			maxChars = cfg.AI.MaxInputChars
+		}
+		opts.summarizer = func(details *prefetch.Details) (string, error) {
+			return llm.SummarizeIssue(provider, details.Issue, details.Comments, maxChars)
+		}
+	}
+
+	return runTriageWithDeps(cmd, args, opts, cfg, client, cmd.InOrStdin())
 }
 
 // runTriageWithDeps is the testable implementation of runTriage
-func runTriageWithDeps(cmd *cobra.Command, args []string, opts *triageOptions, cfg *config.Config, client triageClient, stdin *os.File) error {
+func runTriageWithDeps(cmd *cobra.Command, args []string, opts *triageOptions, cfg *config.Config, client triageClient, stdin io.Reader) error {
 	// List mode
 	if opts.list {
 		return listTriageConfigs(cmd, cfg, opts.json)
@@ -160,19 +214,20 @@ func runTriageWithDeps(cmd *cobra.Command, args []string, opts *triageOptions, c
 	// Process issues
 	var processed, skipped, failed int
 	reader := bufio.NewReader(stdin)
+	details := startTriagePrefetch(opts, matchingIssues)
+	if details != nil {
+		defer details.Stop()
+	}
 
 	for _, issue := range matchingIssues {
 		// Interactive mode - prompt for each issue
 		if opts.interactive {
-			cmd.Printf("\nProcess #%d: %s? [y/n/q] ", issue.Number, issue.Title)
-			response, _ := reader.ReadString('\n')
-			response = strings.TrimSpace(strings.ToLower(response))
-
+			response := promptTriageIssue(cmd, reader, opts, details, &issue)
 			if response == "q" {
 				cmd.Println("Aborted.")
 				break
 			}
-			if response != "y" && response != "yes" {
+			if response != "y" {
 				skipped++
 				continue
 			}
@@ -209,6 +264,89 @@ func runTriageWithDeps(cmd *cobra.Command, args []string, opts *triageOptions, c
 	return nil
 }
 
+// startTriagePrefetch starts loading the details of issues ahead of the
+// one being prompted for, or returns nil outside interactive mode
+func startTriagePrefetch(opts *triageOptions, issues []api.Issue) *prefetch.Prefetcher {
+	if !opts.interactive || opts.fetchDetails == nil {
+		return nil
+	}
+	keys := make([]prefetch.Key, len(issues))
+	for i, issue := range issues {
+		keys[i] = triagePrefetchKey(&issue)
+	}
+	p := prefetch.New(opts.fetchDetails, keys, prefetch.Options{Window: opts.prefetch, Budget: prefetch.DefaultBudget})
+	p.Start()
+	return p
+}
+
+func triagePrefetchKey(issue *api.Issue) prefetch.Key {
+	return prefetch.Key{Owner: issue.Repository.Owner, Repo: issue.Repository.Name, Number: issue.Number}
+}
+
+// promptTriageIssue asks whether to process an issue and returns "y", "n",
+// or "q". When details are available, "v" shows the issue's body and
+// comments and asks again.
+func promptTriageIssue(cmd *cobra.Command, reader *bufio.Reader, opts *triageOptions, details *prefetch.Prefetcher, issue *api.Issue) string {
+	if opts.summarizer != nil && details != nil {
+		printTriageSummary(cmd, opts.summarizer, details, issue)
+	}
+
+	choices := "y/n/q"
+	if details != nil {
+		choices = "y/n/v/q"
+	}
+	for {
+		cmd.Printf("\nProcess #%d: %s? [%s] ", issue.Number, issue.Title, choices)
+		response, _ := reader.ReadString('\n')
+		response = strings.TrimSpace(strings.ToLower(response))
+
+		switch {
+		case response == "q":
+			return "q"
+		case response == "y" || response == "yes":
+			return "y"
+		case (response == "v" || response == "view") && details != nil:
+			printTriageDetails(cmd, details, issue)
+		default:
+			return "n"
+		}
+	}
+}
+
+// printTriageDetails shows an issue's body and comments
+func printTriageDetails(cmd *cobra.Command, details *prefetch.Prefetcher, issue *api.Issue) {
+	d, err := details.Get(triagePrefetchKey(issue))
+	if err != nil {
+		cmd.PrintErrf("\nCould not load #%d: %v\n", issue.Number, err)
+		return
+	}
+
+	cmd.Printf("\n#%d %s\n\n", d.Issue.Number, d.Issue.Title)
+	body := strings.TrimSpace(d.Issue.Body)
+	if body == "" {
+		body = "(no description)"
+	}
+	cmd.Println(body)
+	for _, c := range d.Comments {
+		cmd.Printf("\n--- @%s, %s\n%s\n", c.Author, c.CreatedAt, strings.TrimSpace(c.Body))
+	}
+}
+
+// printTriageSummary shows an AI summary of an issue before the interactive prompt.
+// Failures are reported but do not stop triage.
+func printTriageSummary(cmd *cobra.Command, summarizer func(details *prefetch.Details) (string, error), details *prefetch.Prefetcher, issue *api.Issue) {
+	d, err := details.Get(triagePrefetchKey(issue))
+	var summary string
+	if err == nil {
+		summary, err = summarizer(d)
+	}
+	if err != nil {
+		cmd.PrintErrf("\nCould not summarize #%d: %v\n", issue.Number, err)
+		return
+	}
+	cmd.Printf("\n#%d summary (AI-generated):\n%s\n", issue.Number, summary)
+}
+
 func listTriageConfigs(cmd *cobra.Command, cfg *config.Config, jsonOutput bool) error {
 	if len(cfg.Triage) == 0 {
 		if jsonOutput {
@@ -314,88 +452,47 @@ func searchIssuesForTriage(client triageClient, cfg *config.Config, query string
 		repos = []string{targetRepo}
 	}
 
-	var allIssues []api.Issue
-
-	for _, repoFullName := range repos {
-		parts := strings.SplitN(repoFullName, "/", 2)
-		if len(parts) != 2 {
-			continue
-		}
-		owner, repo := parts[0], parts[1]
-
-		// Determine state from query
-		state := "open"
-		if strings.Contains(query, "is:closed") {
-			state = "closed"
-		} else if strings.Contains(query, "is:all") {
-			state = "all"
-		}
+	// Determine state from query
+	state := triage.StateForQuery(query)
 
+	// Search the repositories concurrently; one that fails is reported
+	// and skipped rather than failing the search
+	results := fanout.Run(repos, cfg.Concurrency(), func(owner, repo string) ([]api.Issue, error) {
 		issues, err := client.GetRepositoryIssues(owner, repo, state)
 		if err != nil {
-			continue
+			return nil, err
 		}
 
 		// Filter based on query components
+		var matches []api.Issue
 		for _, issue := range issues {
 			if matchesTriageQuery(issue, query) {
-				allIssues = append(allIssues, issue)
+				if issue.Repository.Owner == "" {
+					issue.Repository = api.Repository{Owner: owner, Name: repo}
+				}
+				matches = append(matches, issue)
 			}
 		}
+		return matches, nil
+	})
+
+	var allIssues []api.Issue
+	for _, r := range results {
+		allIssues = append(allIssues, r.Value...)
+	}
+	if fanout.Failed(results) > 0 {
+		fmt.Fprintln(os.Stderr, "Warning: some repositories could not be searched:")
+		_ = fanout.Summary(os.Stderr, results, func(issues []api.Issue) string {
+			return fmt.Sprintf("%d matching", len(issues))
+		})
 	}
 
 	return allIssues, nil
 }
 
+// matchesTriageQuery reports whether an issue satisfies a triage query
 func matchesTriageQuery(issue api.Issue, query string) bool {
-	// Basic query matching - supports common GitHub search qualifiers
-	// This is a simplified version; full implementation would parse the query properly
-
-	// Check for label requirements
-	if strings.Contains(query, "-label:") {
-		// Extract label name after -label:
-		parts := strings.Split(query, "-label:")
-		for _, part := range parts[1:] {
-			labelName := strings.Fields(part)[0]
-			// Check if issue has this label
-			for _, label := range issue.Labels {
-				if label.Name == labelName {
-					return false // Has excluded label
-				}
-			}
-		}
-	}
-
-	if strings.Contains(query, "label:") && !strings.Contains(query, "-label:") {
-		// Must have specified label
-		parts := strings.Split(query, "label:")
-		for _, part := range parts[1:] {
-			if strings.HasPrefix(part, "-") {
-				continue // Skip negations
-			}
-			labelName := strings.Fields(part)[0]
-			found := false
-			for _, label := range issue.Labels {
-				if label.Name == labelName {
-					found = true
-					break
-				}
-			}
-			if !found {
-				return false
-			}
-		}
-	}
-
-	// Check state
-	if strings.Contains(query, "is:open") && issue.State != "OPEN" {
-		return false
-	}
-	if strings.Contains(query, "is:closed") && issue.State != "CLOSED" {
-		return false
-	}
-
-	return true
+	return triage.Matches(issue, query)
 }
 
 func applyTriageRules(client triageClient, cfg *config.Config, project *api.Project, issue *api.Issue, tc *config.Triage) error {
@@ -511,7 +608,7 @@ func outputTriageJSON(cmd *cobra.Command, issues []api.Issue, status, configName
 }
 
 // runAdHocTriage runs a triage operation using --query and --apply flags instead of a config file entry
-func runAdHocTriage(cmd *cobra.Command, opts *triageOptions, cfg *config.Config, client triageClient, stdin *os.File) error {
+func runAdHocTriage(cmd *cobra.Command, opts *triageOptions, cfg *config.Config, client triageClient, stdin io.Reader) error {
 	// Get project
 	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
 	if err != nil {
@@ -556,19 +653,20 @@ func runAdHocTriage(cmd *cobra.Command, opts *triageOptions, cfg *config.Config,
 	// Process issues
 	var processed, skipped, failed int
 	reader := bufio.NewReader(stdin)
+	details := startTriagePrefetch(opts, matchingIssues)
+	if details != nil {
+		defer details.Stop()
+	}
 
 	for _, issue := range matchingIssues {
 		// Interactive mode - prompt for each issue
 		if opts.interactive {
-			cmd.Printf("\nProcess #%d: %s? [y/n/q] ", issue.Number, issue.Title)
-			response, _ := reader.ReadString('\n')
-			response = strings.TrimSpace(strings.ToLower(response))
-
+			response := promptTriageIssue(cmd, reader, opts, details, &issue)
 			if response == "q" {
 				cmd.Println("Aborted.")
 				break
 			}
-			if response != "y" && response != "yes" {
+			if response != "y" {
 				skipped++
 				continue
 			}
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
 	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/prefetch"
 )
 
 // mockTriageClient implements triageClient interface for testing
@@ -26,9 +29,13 @@ type mockTriageClient struct {
 	addToProjectCalled bool
 	addLabelCalls      []string
 	setFieldCalls      []struct{ field, value string }
+
+	mu sync.Mutex // Repositories are searched concurrently
 }
 
 func (m *mockTriageClient) GetRepositoryIssues(owner, repo, state string) ([]api.Issue, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
 	m.getIssuesCalled = true
 	return m.issues, m.issuesError
 }
@@ -53,6 +60,14 @@ func (m *mockTriageClient) SetProjectItemField(projectID, itemID, fieldName, val
 	return m.setFieldError
 }
 
+// fakeTriageDetails returns an issue with one comment
+func fakeTriageDetails(key prefetch.Key) (*prefetch.Details, error) {
+	return &prefetch.Details{
+		Issue:    &api.Issue{Number: key.Number, Title: "Test Issue", Body: "Steps to reproduce"},
+		Comments: []api.Comment{{Author: "alice", Body: "Seen on v2 too", CreatedAt: "2026-03-02"}},
+	}, nil
+}
+
 func TestTriageCommand(t *testing.T) {
 	t.Run("has correct command structure", func(t *testing.T) {
 		cmd := newTriageCommand()
@@ -1276,6 +1291,52 @@ func TestRunTriageWithDeps(t *testing.T) {
 		}
 	})
 
+	t.Run("interactive mode on a terminal", func(t *testing.T) {
+		cfg := makeConfig()
+		mock := &mockTriageClient{
+			project:            &api.Project{ID: "proj-1"},
+			addToProjectItemID: "item-123",
+			issues: []api.Issue{
+				{ID: "issue-1", Number: 1, Title: "Test Issue", State: "OPEN", Labels: []api.Label{}},
+				{ID: "issue-2", Number: 2, Title: "Other Issue", State: "OPEN", Labels: []api.Label{}},
+			},
+		}
+		p := openTestPTY(t)
+		cmd := newTriageCommand()
+		cmd.SetIn(p.TTY)
+		cmd.SetOut(p.TTY)
+
+		done := make(chan error, 1)
+		go func() {
+			done <- runTriageWithDeps(cmd, []string{"tracked"}, &triageOptions{interactive: true}, cfg, mock, cmd.InOrStdin())
+		}()
+
+		// Answer each prompt as it appears
+		for _, step := range []struct{ prompt, answer string }{
+			{"Process #1: Test Issue? ", "n"},
+			{"Process #2: Other Issue? ", "y"},
+		} {
+			if err := p.Expect(step.prompt, 5*time.Second); err != nil {
+				t.Fatal(err)
+			}
+			if err := p.Type(step.answer); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Fatalf("runTriageWithDeps() error = %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for triage; screen:\n%s", p.Screen())
+		}
+		if err := p.Expect("1 processed", 5*time.Second); err != nil {
+			t.Error(err)
+		}
+	})
+
 	t.Run("interactive mode with no response skips issue", func(t *testing.T) {
 		cfg := makeConfig()
 		mock := &mockTriageClient{
@@ -1363,4 +1424,145 @@ func TestRunTriageWithDeps(t *testing.T) {
 			t.Errorf("expected 'Aborted' message, got:\n%s", output)
 		}
 	})
+
+	t.Run("interactive mode shows summary before prompt", func(t *testing.T) {
+		cfg := makeConfig()
+		mock := &mockTriageClient{
+			project: &api.Project{ID: "proj-1"},
+			issues: []api.Issue{
+				{ID: "issue-1", Number: 1, Title: "Test Issue", State: "OPEN", Labels: []api.Label{}},
+			},
+		}
+		var summarized []int
+		opts := &triageOptions{
+			interactive:  true,
+			fetchDetails: fakeTriageDetails,
+			summarizer: func(details *prefetch.Details) (string, error) {
+				summarized = append(summarized, details.Issue.Number)
+				return "- Crash on startup", nil
+			},
+		}
+
+		tmpFile, err := os.CreateTemp("", "stdin")
+		if err != nil {
+			t.Fatalf("failed to create temp file: %v", err)
+		}
+		defer os.Remove(tmpFile.Name())
+
+		if _, err := tmpFile.WriteString("n\n"); err != nil {
+			t.Fatalf("failed to write to temp file: %v", err)
+		}
+		if _, err := tmpFile.Seek(0, 0); err != nil {
+			t.Fatalf("failed to seek temp file: %v", err)
+		}
+
+		buf := new(bytes.Buffer)
+		cmd := newTriageCommand()
+		cmd.SetOut(buf)
+
+		if err := runTriageWithDeps(cmd, []string{"tracked"}, opts, cfg, mock, tmpFile); err != nil {
+			t.Fatalf("runTriageWithDeps() error = %v", err)
+		}
+
+		if len(summarized) != 1 || summarized[0] != 1 {
+			t.Errorf("expected issue #1 to be summarized, got %v", summarized)
+		}
+		output := buf.String()
+		summaryIdx := strings.Index(output, "- Crash on startup")
+		promptIdx := strings.Index(output, "Process #1")
+		if summaryIdx < 0 || promptIdx < 0 || summaryIdx > promptIdx {
+			t.Errorf("expected summary before prompt, got:\n%s", output)
+		}
+	})
+
+	t.Run("summary failure does not stop triage", func(t *testing.T) {
+		cfg := makeConfig()
+		mock := &mockTriageClient{
+			project:            &api.Project{ID: "proj-1"},
+			addToProjectItemID: "item-123",
+			issues: []api.Issue{
+				{ID: "issue-1", Number: 1, Title: "Test Issue", State: "OPEN", Labels: []api.Label{}},
+			},
+		}
+		opts := &triageOptions{
+			interactive:  true,
+			fetchDetails: fakeTriageDetails,
+			summarizer: func(details *prefetch.Details) (string, error) {
+				return "", fmt.Errorf("model unavailable")
+			},
+		}
+
+		tmpFile, err := os.CreateTemp("", "stdin")
+		if err != nil {
+			t.Fatalf("failed to create temp file: %v", err)
+		}
+		defer os.Remove(tmpFile.Name())
+
+		if _, err := tmpFile.WriteString("y\n"); err != nil {
+			t.Fatalf("failed to write to temp file: %v", err)
+		}
+		if _, err := tmpFile.Seek(0, 0); err != nil {
+			t.Fatalf("failed to seek temp file: %v", err)
+		}
+
+		buf := new(bytes.Buffer)
+		errBuf := new(bytes.Buffer)
+		cmd := newTriageCommand()
+		cmd.SetOut(buf)
+		cmd.SetErr(errBuf)
+
+		if err := runTriageWithDeps(cmd, []string{"tracked"}, opts, cfg, mock, tmpFile); err != nil {
+			t.Fatalf("runTriageWithDeps() error = %v", err)
+		}
+
+		if !strings.Contains(errBuf.String(), "model unavailable") {
+			t.Errorf("expected summary error to be reported, got:\n%s", errBuf.String())
+		}
+		if !strings.Contains(buf.String(), "1 processed") {
+			t.Errorf("expected issue to be processed, got:\n%s", buf.String())
+		}
+	})
+	t.Run("interactive mode shows details on request", func(t *testing.T) {
+		cfg := makeConfig()
+		mock := &mockTriageClient{
+			project:            &api.Project{ID: "proj-1"},
+			addToProjectItemID: "item-123",
+			issues: []api.Issue{
+				{ID: "issue-1", Number: 1, Title: "Test Issue", State: "OPEN", Labels: []api.Label{}},
+			},
+		}
+		opts := &triageOptions{interactive: true, fetchDetails: fakeTriageDetails}
+
+		tmpFile, err := os.CreateTemp("", "stdin")
+		if err != nil {
+			t.Fatalf("failed to create temp file: %v", err)
+		}
+		defer os.Remove(tmpFile.Name())
+
+		if _, err := tmpFile.WriteString("v\ny\n"); err != nil {
+			t.Fatalf("failed to write to temp file: %v", err)
+		}
+		if _, err := tmpFile.Seek(0, 0); err != nil {
+			t.Fatalf("failed to seek temp file: %v", err)
+		}
+
+		buf := new(bytes.Buffer)
+		cmd := newTriageCommand()
+		cmd.SetOut(buf)
+
+		if err := runTriageWithDeps(cmd, []string{"tracked"}, opts, cfg, mock, tmpFile); err != nil {
+			t.Fatalf("runTriageWithDeps() error = %v", err)
+		}
+
+		output := buf.String()
+		if strings.Count(output, "Process #1: Test Issue? [y/n/v/q]") != 2 {
+			t.Errorf("expected the prompt repeated after viewing, got:\n%s", output)
+		}
+		if !strings.Contains(output, "Steps to reproduce") || !strings.Contains(output, "--- @alice, 2026-03-02\nSeen on v2 too") {
+			t.Errorf("expected body and comments, got:\n%s", output)
+		}
+		if !strings.Contains(output, "1 processed") {
+			t.Errorf("expected issue to be processed, got:\n%s", output)
+		}
+	})
 }
@@ -8,39 +8,102 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"text/template"
+	"time"
 
+	"github.com/cli/go-gh/v2/pkg/term"
 	"github.com/scooter-indie/gh-pmu/internal/api"
 	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/llm"
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"github.com/scooter-indie/gh-pmu/internal/notes"
+	"github.com/scooter-indie/gh-pmu/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 type viewOptions struct {
-	json     bool
-	web      bool
-	comments bool
+	json      bool
+	web       string
+	comments  bool
+	last      int
+	summary   bool
+	history   bool
+	recursive bool
+	raw       bool
+	depth     int
+	template  string
+	fields    []string
 }
 
 func newViewCommand() *cobra.Command {
 	opts := &viewOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "view <issue-number>",
+		Use:   "view <issue-number>...",
 		Short: "View an issue with project metadata",
 		Long: `View an issue with all its project field values.
 
 Displays issue details including title, body, state, labels, assignees,
 and all project-specific fields like Status and Priority.
 
-Also shows sub-issues if any exist, and parent issue if this is a sub-issue.`,
-		Args: cobra.ExactArgs(1),
+Several issues can be given to display them back to back; with --json
+they are written as an array.
+
+Use --template to format the JSON output with a Go template, for example
+--template '{{.title}} ({{.fieldValues.Status}})'.
+
+Use --fields to output only some JSON keys, e.g. --fields number,title,status.
+Project fields such as status are kept under fieldValues. Data that is not
+selected is not fetched, and selecting comments or history fetches them.
+
+Also shows sub-issues if any exist, and parent issue if this is a sub-issue.
+Use --recursive to render the full hierarchy (epic → story → task) as an
+indented tree with progress at each level.
+
+Archived project items are included; their fields are marked as archived.
+
+Reaction counts (a lightweight priority signal during triage) and the
+issue's participants are shown with the issue details.
+
+Private notes added with 'gh pmu note add' are shown as local notes;
+they are stored on this machine only.
+
+Pull requests that close or reference the issue are listed with their
+review decision and check status.
+
+The body and comments are rendered as terminal markdown; use --raw to
+print the original markdown text. Colors follow --no-color and NO_COLOR.
+
+Use --comments to render the comment thread below the issue details, and
+--last N to show only the N most recent comments (implies --comments).
+
+Use --web to open the issue in the browser, or --web=project to open the
+issue's item in the project board.
+
+Use --history to show a timeline of project changes: when the issue was
+added to the project, each status transition, and the last update of
+other fields such as Priority (GitHub does not record earlier values).
+
+Use --summary to add an AI-generated summary of the issue and its comments.
+This requires an 'ai' section in .gh-pmu.yml (see 'gh pmu summarize').`,
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runView(cmd, args, opts)
 		},
 	}
 
 	cmd.Flags().BoolVar(&opts.json, "json", false, "Output in JSON format")
-	cmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open issue in browser")
+	cmd.Flags().StringVarP(&opts.web, "web", "w", "", "Open in browser: issue (default) or project")
+	cmd.Flags().Lookup("web").NoOptDefVal = "issue"
+	cmd.Flags().BoolVar(&opts.summary, "summary", false, "Show an AI-generated summary (requires ai config)")
+	cmd.Flags().BoolVar(&opts.history, "history", false, "Show a timeline of project field changes")
 	cmd.Flags().BoolVarP(&opts.comments, "comments", "c", false, "Show issue comments")
+	cmd.Flags().IntVar(&opts.last, "last", 0, "Show only the last N comments (implies --comments)")
+	cmd.Flags().BoolVarP(&opts.recursive, "recursive", "r", false, "Show sub-issues recursively as a tree")
+	cmd.Flags().IntVar(&opts.depth, "depth", 10, "Maximum depth for --recursive")
+	cmd.Flags().BoolVar(&opts.raw, "raw", false, "Print body and comments as raw markdown")
+	cmd.Flags().StringVarP(&opts.template, "template", "t", "", "Format the JSON output using a Go template")
+	cmd.Flags().StringSliceVar(&opts.fields, "fields", nil, "JSON fields to output (e.g., number,title,status); implies --json")
 
 	return cmd
 }
@@ -61,89 +124,567 @@ func runView(cmd *cobra.Command, args []string, opts *viewOptions) error {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
-	// Parse issue reference
-	owner, repo, number, err := parseIssueReference(args[0])
+	if opts.last < 0 {
+		return fmt.Errorf("--last must be a positive number")
+	}
+	if opts.last > 0 {
+		opts.comments = true
+	}
+	if opts.recursive && opts.depth < 1 {
+		return fmt.Errorf("--depth must be at least 1")
+	}
+	if opts.web != "" && opts.web != "issue" && opts.web != "project" {
+		return fmt.Errorf("invalid --web target %q: must be issue or project", opts.web)
+	}
+
+	// A template is applied to the JSON payload
+	var tmpl *template.Template
+	if opts.template != "" {
+		tmpl, err = parseOutputTemplate(opts.template)
+		if err != nil {
+			return err
+		}
+		opts.json = true
+	}
+
+	// Selecting comments, history, or summary implies fetching them
+	fieldSel, err := parseJSONFields(opts.fields, viewJSONFields, cfg)
 	if err != nil {
 		return err
 	}
+	if fieldSel != nil {
+		opts.json = true
+		opts.comments = opts.comments || fieldSel.wants("comments")
+		opts.history = opts.history || fieldSel.wants("history")
+		opts.summary = opts.summary || fieldSel.wants("summary")
+	}
 
-	// If owner/repo not specified, use first repo from config
-	if owner == "" || repo == "" {
-		if len(cfg.Repositories) == 0 {
-			return fmt.Errorf("no repository specified and none configured")
+	// Fail fast if a summary is requested without an AI backend
+	var provider llm.Provider
+	if opts.summary {
+		provider, err = llm.New(cfg.AI)
+		if err != nil {
+			return err
 		}
-		parts := strings.Split(cfg.Repositories[0], "/")
-		if len(parts) != 2 {
-			return fmt.Errorf("invalid repository format in config: %s", cfg.Repositories[0])
+	}
+
+	// Parse issue references up front so a typo fails before any API calls
+	refs := make([]issueRef, 0, len(args))
+	for _, arg := range args {
+		owner, repo, number, err := parseIssueReference(arg)
+		if err != nil {
+			return err
+		}
+
+		// If owner/repo not specified, use first repo from config
+		if owner == "" || repo == "" {
+			if len(cfg.Repositories) == 0 {
+				return fmt.Errorf("no repository specified and none configured")
+			}
+			parts := strings.Split(cfg.Repositories[0], "/")
+			if len(parts) != 2 {
+				return fmt.Errorf("invalid repository format in config: %s", cfg.Repositories[0])
+			}
+			owner = parts[0]
+			repo = parts[1]
 		}
-		owner = parts[0]
-		repo = parts[1]
+		refs = append(refs, issueRef{owner: owner, repo: repo, number: number})
+	}
+
+	if opts.web != "" && len(refs) > 1 {
+		return fmt.Errorf("--web accepts a single issue")
 	}
 
 	// Create API client
 	client := api.NewClient()
 
-	// Fetch issue
-	issue, err := client.GetIssue(owner, repo, number)
-	if err != nil {
-		return fmt.Errorf("failed to get issue: %w", err)
-	}
-
 	// Handle --web flag: open issue in browser
-	if opts.web {
+	if opts.web == "issue" {
+		issue, err := client.GetIssue(refs[0].owner, refs[0].repo, refs[0].number)
+		if err != nil {
+			return fmt.Errorf("failed to get issue: %w", err)
+		}
 		return openViewInBrowser(issue.URL)
 	}
 
-	// Fetch project items to get field values for this issue
-	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
-	if err != nil {
-		return fmt.Errorf("failed to get project: %w", err)
+	// Fetch project items once to get field values for every issue
+	var project *api.Project
+	var items []api.ProjectItem
+	if fieldSel.wants("fieldValues") || opts.history || opts.web == "project" {
+		project, err = client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+		if err != nil {
+			return fmt.Errorf("failed to get project: %w", err)
+		}
+	}
+	if fieldSel.wants("fieldValues") || opts.web == "project" {
+		items, err = client.GetProjectItems(project.ID, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get project items: %w", err)
+		}
+	}
+
+	// Local notes are shown alongside the issue
+	var store *notes.Store
+	if fieldSel.wants("notes") {
+		store = loadProjectNotes(cfg)
+	}
+
+	outputs := make([]ViewJSONOutput, 0, len(refs))
+	for i, ref := range refs {
+		if i > 0 && !opts.json {
+			fmt.Println()
+			fmt.Println(strings.Repeat("─", 60))
+			fmt.Println()
+		}
+
+		output, err := viewIssue(cmd, client, cfg, opts, fieldSel, provider, project, items, store, ref)
+		if err != nil {
+			if len(refs) > 1 {
+				return fmt.Errorf("%s/%s#%d: %w", ref.owner, ref.repo, ref.number, err)
+			}
+			return err
+		}
+		if output != nil {
+			outputs = append(outputs, *output)
+		}
+	}
+
+	if opts.json {
+		if tmpl == nil && fieldSel == nil {
+			if len(refs) == 1 {
+				return encodeViewJSON(outputs[0])
+			}
+			return encodeViewJSONList(outputs)
+		}
+
+		payloads := make([]interface{}, 0, len(outputs))
+		for _, output := range outputs {
+			if fieldSel == nil {
+				payloads = append(payloads, output)
+				continue
+			}
+			selected, err := fieldSel.apply(output)
+			if err != nil {
+				return err
+			}
+			payloads = append(payloads, selected)
+		}
+
+		var payload interface{} = payloads
+		if len(refs) == 1 {
+			payload = payloads[0]
+		}
+		if tmpl != nil {
+			return executeOutputTemplate(cmd.OutOrStdout(), tmpl, payload)
+		}
+		return encodeJSONPayload(cmd.OutOrStdout(), payload)
 	}
 
-	items, err := client.GetProjectItems(project.ID, nil)
+	return nil
+}
+
+// issueRef identifies an issue given on the command line
+type issueRef struct {
+	owner  string
+	repo   string
+	number int
+}
+
+// viewIssue fetches and displays a single issue. With --json the output is
+// returned instead of printed so several issues can be encoded as an array;
+// parts not in fieldSel are not fetched.
+func viewIssue(cmd *cobra.Command, client *api.Client, cfg *config.Config, opts *viewOptions, fieldSel *jsonFieldSelection, provider llm.Provider, project *api.Project, items []api.ProjectItem, store *notes.Store, ref issueRef) (*ViewJSONOutput, error) {
+	owner, repo, number := ref.owner, ref.repo, ref.number
+	issueNotes := store.List(localstore.Key(owner+"/"+repo, number))
+
+	// Fetch issue
+	issue, err := client.GetIssue(owner, repo, number)
 	if err != nil {
-		return fmt.Errorf("failed to get project items: %w", err)
+		return nil, fmt.Errorf("failed to get issue: %w", err)
 	}
 
 	// Find this issue in project items to get field values
 	var fieldValues []api.FieldValue
-	for _, item := range items {
-		if item.Issue != nil && item.Issue.Number == number {
+	var projectItem *api.ProjectItem
+	for i, item := range items {
+		if item.Issue != nil && item.Issue.Number == number &&
+			strings.EqualFold(item.Issue.Repository.Owner, owner) && strings.EqualFold(item.Issue.Repository.Name, repo) {
 			fieldValues = item.FieldValues
+			projectItem = &items[i]
 			break
 		}
 	}
 
+	// Archived items are missing from the project's item list; look the
+	// issue's item up directly so their field values are still shown
+	if projectItem == nil && project != nil {
+		item, err := client.GetIssueProjectItem(owner, repo, number, project.ID)
+		if err == nil && item != nil {
+			fieldValues = item.FieldValues
+			projectItem = item
+		}
+	}
+
+	// Handle --web=project: open the item in the project board
+	if opts.web == "project" {
+		if projectItem == nil {
+			return nil, fmt.Errorf("issue #%d is not in the project", number)
+		}
+		url, err := projectItemURL(project.URL, projectItem.DatabaseID)
+		if err != nil {
+			return nil, err
+		}
+		return nil, openViewInBrowser(url)
+	}
+
 	// Fetch sub-issues (if any)
-	subIssues, err := client.GetSubIssues(owner, repo, number)
-	if err != nil {
-		// Non-fatal - issue might not have sub-issues or API might not support it
-		subIssues = nil
+	var subIssues []api.SubIssue
+	if fieldSel.wants("subIssues") || fieldSel.wants("subProgress") {
+		subIssues, err = client.GetSubIssues(owner, repo, number)
+		if err != nil {
+			// Non-fatal - issue might not have sub-issues or API might not support it
+			subIssues = nil
+		}
+	}
+
+	// Expand the full hierarchy if requested
+	var subTree []subIssueNode
+	if opts.recursive {
+		subTree = buildSubIssueTree(client, owner, repo, subIssues, 1, opts.depth)
 	}
 
 	// Fetch parent issue (if this is a sub-issue)
-	parentIssue, err := client.GetParentIssue(owner, repo, number)
-	if err != nil {
-		// Non-fatal - issue might not be a sub-issue
-		parentIssue = nil
+	var parentIssue *api.Issue
+	if fieldSel.wants("parentIssue") {
+		parentIssue, err = client.GetParentIssue(owner, repo, number)
+		if err != nil {
+			// Non-fatal - issue might not be a sub-issue
+			parentIssue = nil
+		}
+	}
+
+	// Fetch reactions and participants
+	var engagement *api.IssueEngagement
+	if fieldSel.wants("reactions") || fieldSel.wants("participants") {
+		engagement, err = client.GetIssueEngagement(owner, repo, number)
+		if err != nil {
+			// Non-fatal - continue without reactions and participants
+			engagement = nil
+		}
+	}
+
+	// Fetch linked pull requests
+	var linkedPRs []api.LinkedPullRequest
+	if fieldSel.wants("linkedPullRequests") {
+		linkedPRs, err = client.GetLinkedPullRequests(owner, repo, number)
+		if err != nil {
+			// Non-fatal - continue without linked pull requests
+			linkedPRs = nil
+		}
 	}
 
 	// Fetch comments if requested
 	var comments []api.Comment
-	if opts.comments {
-		comments, err = client.GetIssueComments(owner, repo, number)
+	var summary string
+	if opts.comments || opts.summary {
+		allComments, err := client.GetIssueComments(owner, repo, number)
 		if err != nil {
 			// Non-fatal - continue without comments
-			comments = nil
+			allComments = nil
+		}
+
+		if opts.summary {
+			maxChars := 0
+			if cfg.AI != nil {
+				maxChars = cfg.AI.MaxInputChars
+			}
+			summary, err = llm.SummarizeIssue(provider, issue, allComments, maxChars)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if opts.comments {
+			comments = lastComments(allComments, opts.last)
 		}
 	}
 
+	// Fetch project history if requested
+	var history []api.ProjectEvent
+	if opts.history {
+		events, err := client.GetIssueProjectHistory(owner, repo, number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get history: %w", err)
+		}
+		history = filterProjectHistory(events, project.Number)
+	}
+
 	// Output
 	if opts.json {
-		return outputViewJSON(cmd, issue, fieldValues, subIssues, parentIssue, comments)
+		output := buildViewJSON(issue, fieldValues, subIssues, parentIssue, comments)
+		output.Summary = summary
+		output.Archived = projectItem != nil && projectItem.IsArchived
+		output.Reactions, output.Participants = buildEngagementJSON(engagement)
+		output.Notes = buildNotesJSON(issueNotes)
+		output.LinkedPullRequests = buildLinkedPullRequestsJSON(linkedPRs)
+		if opts.recursive {
+			output.SubIssues = buildSubIssueTreeJSON(subTree)
+		}
+		if opts.history {
+			output.History = buildHistoryJSON(history)
+		}
+		return &output, nil
+	}
+
+	tableOpts := viewTableOptions{
+		subTree:    subTree,
+		archived:   projectItem != nil && projectItem.IsArchived,
+		engagement: engagement,
+		notes:      issueNotes,
+	}
+	if !opts.raw {
+		tableOpts.markdown = newViewMarkdownRenderer(cmd).Render
+	}
+
+	if err := outputViewTableWithOptions(cmd, issue, fieldValues, subIssues, parentIssue, comments, tableOpts); err != nil {
+		return nil, err
+	}
+
+	if len(linkedPRs) > 0 {
+		outputViewLinkedPullRequests(linkedPRs)
+	}
+
+	if opts.history {
+		outputViewHistory(history)
+	}
+
+	if summary != "" {
+		outputViewSummary(summary)
+	}
+
+	return nil, nil
+}
+
+// filterProjectHistory keeps events for the configured project
+func filterProjectHistory(events []api.ProjectEvent, projectNumber int) []api.ProjectEvent {
+	var filtered []api.ProjectEvent
+	for _, e := range events {
+		if e.ProjectNumber == projectNumber {
+			filtered = append(filtered, e)
+		}
+	}
+	return filtered
+}
+
+// formatHistoryTime renders an ISO 8601 timestamp as "2006-01-02 15:04" in UTC
+func formatHistoryTime(ts string) string {
+	t, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return ts
 	}
+	return t.UTC().Format("2006-01-02 15:04")
+}
+
+// describeHistoryEvent renders a project event as a single line
+func describeHistoryEvent(e api.ProjectEvent) string {
+	var desc string
+	switch e.Type {
+	case api.ProjectEventAdded:
+		desc = "Added to project"
+	case api.ProjectEventRemoved:
+		desc = "Removed from project"
+	case api.ProjectEventStatusChanged:
+		from := e.From
+		if from == "" {
+			from = "(none)"
+		}
+		desc = fmt.Sprintf("%s: %s → %s", e.Field, from, e.To)
+	case api.ProjectEventFieldUpdated:
+		desc = fmt.Sprintf("%s last set to %s", e.Field, e.To)
+	default:
+		desc = e.Type
+	}
+
+	if e.Actor != "" {
+		desc += " by @" + e.Actor
+	}
+	return desc
+}
+
+// outputViewHistory prints the project history section
+func outputViewHistory(history []api.ProjectEvent) {
+	fmt.Println()
+	fmt.Println("History:")
+	if len(history) == 0 {
+		fmt.Println("  No project changes recorded")
+		return
+	}
+	for _, e := range history {
+		fmt.Printf("  %s  %s\n", formatHistoryTime(e.CreatedAt), describeHistoryEvent(e))
+	}
+}
 
-	return outputViewTable(cmd, issue, fieldValues, subIssues, parentIssue, comments)
+// buildHistoryJSON converts project events for JSON output
+func buildHistoryJSON(history []api.ProjectEvent) []HistoryEventJSON {
+	out := make([]HistoryEventJSON, 0, len(history))
+	for _, e := range history {
+		out = append(out, HistoryEventJSON{
+			Type:      e.Type,
+			Field:     e.Field,
+			From:      e.From,
+			To:        e.To,
+			Actor:     e.Actor,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+	return out
+}
+
+// describePullRequestStatus summarizes a pull request's state, review, and checks
+func describePullRequestStatus(pr api.LinkedPullRequest) string {
+	state := strings.ToLower(pr.State)
+	if pr.IsDraft && pr.State == "OPEN" {
+		state = "draft"
+	}
+	parts := []string{state}
+
+	switch pr.ReviewDecision {
+	case "APPROVED":
+		parts = append(parts, "approved")
+	case "CHANGES_REQUESTED":
+		parts = append(parts, "changes requested")
+	case "REVIEW_REQUIRED":
+		parts = append(parts, "review required")
+	}
+
+	if pr.ChecksState != "" {
+		parts = append(parts, "checks "+strings.ToLower(pr.ChecksState))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// outputViewLinkedPullRequests prints the linked pull requests section
+func outputViewLinkedPullRequests(prs []api.LinkedPullRequest) {
+	fmt.Println()
+	fmt.Println("Linked Pull Requests:")
+	for _, pr := range prs {
+		ref := fmt.Sprintf("#%d", pr.Number)
+		if pr.Repository.Owner != "" {
+			ref = fmt.Sprintf("%s/%s#%d", pr.Repository.Owner, pr.Repository.Name, pr.Number)
+		}
+		link := "references"
+		if pr.Closes {
+			link = "closes"
+		}
+		fmt.Printf("  %s %s (%s) [%s]\n", ref, pr.Title, link, describePullRequestStatus(pr))
+	}
+}
+
+// buildLinkedPullRequestsJSON converts linked pull requests for JSON output
+func buildLinkedPullRequestsJSON(prs []api.LinkedPullRequest) []LinkedPullRequestJSON {
+	if len(prs) == 0 {
+		return nil
+	}
+	out := make([]LinkedPullRequestJSON, 0, len(prs))
+	for _, pr := range prs {
+		repo := ""
+		if pr.Repository.Owner != "" {
+			repo = pr.Repository.Owner + "/" + pr.Repository.Name
+		}
+		out = append(out, LinkedPullRequestJSON{
+			Number:         pr.Number,
+			Title:          pr.Title,
+			State:          pr.State,
+			URL:            pr.URL,
+			Repository:     repo,
+			IsDraft:        pr.IsDraft,
+			ReviewDecision: pr.ReviewDecision,
+			ChecksState:    pr.ChecksState,
+			Closes:         pr.Closes,
+		})
+	}
+	return out
+}
+
+// reactionEmoji maps GraphQL reaction contents to the emoji GitHub shows
+var reactionEmoji = map[string]string{
+	"THUMBS_UP":   "👍",
+	"THUMBS_DOWN": "👎",
+	"LAUGH":       "😄",
+	"HOORAY":      "🎉",
+	"CONFUSED":    "😕",
+	"HEART":       "❤️",
+	"ROCKET":      "🚀",
+	"EYES":        "👀",
+}
+
+// formatReactions renders reaction counts as "👍 3  👎 1"
+func formatReactions(reactions []api.ReactionCount) string {
+	parts := make([]string, 0, len(reactions))
+	for _, r := range reactions {
+		emoji, ok := reactionEmoji[r.Content]
+		if !ok {
+			emoji = strings.ToLower(r.Content)
+		}
+		parts = append(parts, fmt.Sprintf("%s %d", emoji, r.Count))
+	}
+	return strings.Join(parts, "  ")
+}
+
+// formatParticipants renders participant logins, noting any beyond those fetched
+func formatParticipants(logins []string, total int) string {
+	names := make([]string, 0, len(logins))
+	for _, l := range logins {
+		names = append(names, "@"+l)
+	}
+	out := strings.Join(names, ", ")
+	if total > len(logins) {
+		out += fmt.Sprintf(" (+%d more)", total-len(logins))
+	}
+	return out
+}
+
+// buildEngagementJSON converts reactions to a map keyed by lowercase
+// content (e.g. thumbs_up) and returns the participant logins
+func buildEngagementJSON(e *api.IssueEngagement) (map[string]int, []string) {
+	if e == nil {
+		return nil, nil
+	}
+	var reactions map[string]int
+	if len(e.Reactions) > 0 {
+		reactions = make(map[string]int, len(e.Reactions))
+		for _, r := range e.Reactions {
+			reactions[strings.ToLower(r.Content)] = r.Count
+		}
+	}
+	return reactions, e.Participants
+}
+
+// outputViewSummary prints the AI-generated summary section
+func outputViewSummary(summary string) {
+	fmt.Println()
+	fmt.Println("Summary (AI-generated):")
+	fmt.Println(summary)
+}
+
+// lastComments returns the last n comments, or all of them when n is zero
+func lastComments(comments []api.Comment, n int) []api.Comment {
+	if n <= 0 || n >= len(comments) {
+		return comments
+	}
+	return comments[len(comments)-n:]
+}
+
+// projectItemURL builds the board URL that opens an item's side panel
+func projectItemURL(projectURL string, itemDatabaseID int) (string, error) {
+	if projectURL == "" {
+		return "", fmt.Errorf("project URL is unavailable")
+	}
+	if itemDatabaseID == 0 {
+		return "", fmt.Errorf("project item ID is unavailable")
+	}
+	return fmt.Sprintf("%s?pane=issue&itemId=%d", strings.TrimSuffix(projectURL, "/"), itemDatabaseID), nil
 }
 
 // openViewInBrowser opens the given URL in the default browser
@@ -162,20 +703,52 @@ func openViewInBrowser(url string) error {
 
 // ViewJSONOutput represents the JSON output for view command
 type ViewJSONOutput struct {
-	Number      int               `json:"number"`
-	Title       string            `json:"title"`
-	State       string            `json:"state"`
-	Body        string            `json:"body"`
-	URL         string            `json:"url"`
-	Author      string            `json:"author"`
-	Assignees   []string          `json:"assignees"`
-	Labels      []string          `json:"labels"`
-	Milestone   string            `json:"milestone,omitempty"`
-	FieldValues map[string]string `json:"fieldValues"`
-	SubIssues   []SubIssueJSON    `json:"subIssues,omitempty"`
-	SubProgress *SubProgressJSON  `json:"subProgress,omitempty"`
-	ParentIssue *ParentIssueJSON  `json:"parentIssue,omitempty"`
-	Comments    []CommentJSON     `json:"comments,omitempty"`
+	Number      int                `json:"number"`
+	Title       string             `json:"title"`
+	State       string             `json:"state"`
+	Body        string             `json:"body"`
+	URL         string             `json:"url"`
+	Author      string             `json:"author"`
+	Assignees   []string           `json:"assignees"`
+	Labels      []string           `json:"labels"`
+	Milestone   string             `json:"milestone,omitempty"`
+	FieldValues map[string]string  `json:"fieldValues"`
+	SubIssues   []SubIssueJSON     `json:"subIssues,omitempty"`
+	SubProgress *SubProgressJSON   `json:"subProgress,omitempty"`
+	ParentIssue *ParentIssueJSON   `json:"parentIssue,omitempty"`
+	Comments    []CommentJSON      `json:"comments,omitempty"`
+	Summary     string             `json:"summary,omitempty"`
+	History     []HistoryEventJSON `json:"history,omitempty"`
+	Archived    bool               `json:"archived,omitempty"`
+
+	Reactions    map[string]int `json:"reactions,omitempty"`
+	Participants []string       `json:"participants,omitempty"`
+	Notes        []NoteJSON     `json:"notes,omitempty"` // Local notes, never sent to GitHub
+
+	LinkedPullRequests []LinkedPullRequestJSON `json:"linkedPullRequests,omitempty"`
+}
+
+// LinkedPullRequestJSON represents a linked pull request in JSON output
+type LinkedPullRequestJSON struct {
+	Number         int    `json:"number"`
+	Title          string `json:"title"`
+	State          string `json:"state"`
+	URL            string `json:"url"`
+	Repository     string `json:"repository,omitempty"`
+	IsDraft        bool   `json:"isDraft"`
+	ReviewDecision string `json:"reviewDecision,omitempty"`
+	ChecksState    string `json:"checksState,omitempty"`
+	Closes         bool   `json:"closes"`
+}
+
+// HistoryEventJSON represents a project history event in JSON output
+type HistoryEventJSON struct {
+	Type      string `json:"type"`
+	Field     string `json:"field,omitempty"`
+	From      string `json:"from,omitempty"`
+	To        string `json:"to,omitempty"`
+	Actor     string `json:"actor,omitempty"`
+	CreatedAt string `json:"createdAt"`
 }
 
 // CommentJSON represents a comment in JSON output
@@ -194,10 +767,12 @@ type SubProgressJSON struct {
 
 // SubIssueJSON represents a sub-issue in JSON output
 type SubIssueJSON struct {
-	Number int    `json:"number"`
-	Title  string `json:"title"`
-	State  string `json:"state"`
-	URL    string `json:"url"`
+	Number      int              `json:"number"`
+	Title       string           `json:"title"`
+	State       string           `json:"state"`
+	URL         string           `json:"url"`
+	SubIssues   []SubIssueJSON   `json:"subIssues,omitempty"`
+	SubProgress *SubProgressJSON `json:"subProgress,omitempty"`
 }
 
 // ParentIssueJSON represents the parent issue in JSON output
@@ -208,6 +783,11 @@ type ParentIssueJSON struct {
 }
 
 func outputViewJSON(cmd *cobra.Command, issue *api.Issue, fieldValues []api.FieldValue, subIssues []api.SubIssue, parentIssue *api.Issue, comments []api.Comment) error {
+	return encodeViewJSON(buildViewJSON(issue, fieldValues, subIssues, parentIssue, comments))
+}
+
+// buildViewJSON assembles the JSON output for an issue
+func buildViewJSON(issue *api.Issue, fieldValues []api.FieldValue, subIssues []api.SubIssue, parentIssue *api.Issue, comments []api.Comment) ViewJSONOutput {
 	output := ViewJSONOutput{
 		Number:      issue.Number,
 		Title:       issue.Title,
@@ -283,12 +863,45 @@ func outputViewJSON(cmd *cobra.Command, issue *api.Issue, fieldValues []api.Fiel
 		}
 	}
 
+	return output
+}
+
+// encodeViewJSON writes the view JSON output to stdout
+func encodeViewJSON(output ViewJSONOutput) error {
 	encoder := json.NewEncoder(os.Stdout)
 	encoder.SetIndent("", "  ")
 	return encoder.Encode(output)
 }
 
+// encodeViewJSONList writes several issues as a JSON array to stdout
+func encodeViewJSONList(outputs []ViewJSONOutput) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(outputs)
+}
+
 func outputViewTable(cmd *cobra.Command, issue *api.Issue, fieldValues []api.FieldValue, subIssues []api.SubIssue, parentIssue *api.Issue, comments []api.Comment) error {
+	return outputViewTableWithOptions(cmd, issue, fieldValues, subIssues, parentIssue, comments, viewTableOptions{})
+}
+
+// viewTableOptions controls optional parts of the table output
+type viewTableOptions struct {
+	subTree  []subIssueNode      // Render sub-issues as a tree when set
+	markdown func(string) string // Render body and comment markdown; nil prints raw text
+	archived bool                // The issue's project item is archived
+
+	engagement *api.IssueEngagement // Reactions and participants, if fetched
+	notes      []notes.Note         // Local notes on the issue
+}
+
+// outputViewTableWithOptions renders the issue with optional sub-issue tree and markdown rendering
+func outputViewTableWithOptions(cmd *cobra.Command, issue *api.Issue, fieldValues []api.FieldValue, subIssues []api.SubIssue, parentIssue *api.Issue, comments []api.Comment, tableOpts viewTableOptions) error {
+	subTree := tableOpts.subTree
+	render := tableOpts.markdown
+	if render == nil {
+		render = func(s string) string { return s }
+	}
+
 	// Title and state
 	fmt.Printf("%s #%d\n", issue.Title, issue.Number)
 	fmt.Printf("State: %s\n", issue.State)
@@ -321,10 +934,33 @@ func outputViewTable(cmd *cobra.Command, issue *api.Issue, fieldValues []api.Fie
 		fmt.Printf("Milestone: %s\n", issue.Milestone.Title)
 	}
 
+	// Reactions and participants
+	if e := tableOpts.engagement; e != nil {
+		if len(e.Reactions) > 0 {
+			fmt.Printf("Reactions: %s\n", formatReactions(e.Reactions))
+		}
+		if len(e.Participants) > 0 {
+			fmt.Printf("Participants: %s\n", formatParticipants(e.Participants, e.TotalParticipants))
+		}
+	}
+
+	// Local notes
+	if len(tableOpts.notes) > 0 {
+		fmt.Println()
+		fmt.Println("Notes (local):")
+		for _, n := range tableOpts.notes {
+			fmt.Printf("  [%d] %s  %s\n", n.ID, formatHistoryTime(n.CreatedAt), n.Text)
+		}
+	}
+
 	// Project field values
 	if len(fieldValues) > 0 {
 		fmt.Println()
-		fmt.Println("Project Fields:")
+		if tableOpts.archived {
+			fmt.Println("Project Fields (archived):")
+		} else {
+			fmt.Println("Project Fields:")
+		}
 		for _, fv := range fieldValues {
 			fmt.Printf("  %s: %s\n", fv.Field, fv.Value)
 		}
@@ -340,6 +976,9 @@ func outputViewTable(cmd *cobra.Command, issue *api.Issue, fieldValues []api.Fie
 	if len(subIssues) > 0 {
 		fmt.Println()
 		fmt.Println("Sub-Issues:")
+		if subTree != nil {
+			printSubIssueTree(issue.Repository, subTree, 1)
+		}
 		closedCount := 0
 		for _, sub := range subIssues {
 			state := "[ ]"
@@ -347,6 +986,9 @@ func outputViewTable(cmd *cobra.Command, issue *api.Issue, fieldValues []api.Fie
 				state = "[x]"
 				closedCount++
 			}
+			if subTree != nil {
+				continue
+			}
 			// Show repo info if cross-repo
 			if sub.Repository.Owner != "" && sub.Repository.Name != "" {
 				parentRepo := issue.Repository.Owner + "/" + issue.Repository.Name
@@ -373,7 +1015,7 @@ func outputViewTable(cmd *cobra.Command, issue *api.Issue, fieldValues []api.Fie
 	if issue.Body != "" {
 		fmt.Println()
 		fmt.Println("---")
-		fmt.Println(issue.Body)
+		fmt.Println(render(issue.Body))
 	}
 
 	// Comments
@@ -383,13 +1025,132 @@ func outputViewTable(cmd *cobra.Command, issue *api.Issue, fieldValues []api.Fie
 		for _, c := range comments {
 			fmt.Println()
 			fmt.Printf("@%s commented on %s:\n", c.Author, c.CreatedAt)
-			fmt.Println(c.Body)
+			fmt.Println(render(c.Body))
 		}
 	}
 
 	return nil
 }
 
+// subIssueNode is a sub-issue with its own sub-issues expanded
+type subIssueNode struct {
+	api.SubIssue
+	Children []subIssueNode
+}
+
+// subIssueTreeClient defines the API method needed to expand a sub-issue tree
+type subIssueTreeClient interface {
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+}
+
+// buildSubIssueTree expands each sub-issue's children up to maxDepth levels.
+// Failures to fetch a level are non-fatal and leave that branch unexpanded.
+func buildSubIssueTree(client subIssueTreeClient, owner, repo string, subIssues []api.SubIssue, currentDepth, maxDepth int) []subIssueNode {
+	nodes := make([]subIssueNode, 0, len(subIssues))
+	for _, sub := range subIssues {
+		node := subIssueNode{SubIssue: sub}
+
+		if currentDepth < maxDepth {
+			subOwner, subRepo := sub.Repository.Owner, sub.Repository.Name
+			if subOwner == "" || subRepo == "" {
+				subOwner, subRepo = owner, repo
+			}
+			children, err := client.GetSubIssues(subOwner, subRepo, sub.Number)
+			if err == nil && len(children) > 0 {
+				node.Children = buildSubIssueTree(client, subOwner, subRepo, children, currentDepth+1, maxDepth)
+			}
+		}
+
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// subIssueProgress counts the closed and total direct children of a level
+func subIssueProgress(nodes []subIssueNode) (closed, total int) {
+	for _, n := range nodes {
+		if n.State == "CLOSED" {
+			closed++
+		}
+	}
+	return closed, len(nodes)
+}
+
+// printSubIssueTree prints sub-issues indented by level, with progress for nodes that have children
+func printSubIssueTree(parentRepo api.Repository, nodes []subIssueNode, level int) {
+	indent := strings.Repeat("  ", level)
+	for _, n := range nodes {
+		state := "[ ]"
+		if n.State == "CLOSED" {
+			state = "[x]"
+		}
+
+		ref := fmt.Sprintf("#%d", n.Number)
+		repo := parentRepo
+		if n.Repository.Owner != "" && n.Repository.Name != "" {
+			if n.Repository.Owner != parentRepo.Owner || n.Repository.Name != parentRepo.Name {
+				ref = fmt.Sprintf("%s/%s#%d", n.Repository.Owner, n.Repository.Name, n.Number)
+			}
+			repo = n.Repository
+		}
+
+		line := fmt.Sprintf("%s%s %s - %s", indent, state, ref, n.Title)
+		if len(n.Children) > 0 {
+			closed, total := subIssueProgress(n.Children)
+			line += fmt.Sprintf(" (%d/%d)", closed, total)
+		}
+		fmt.Println(line)
+
+		printSubIssueTree(repo, n.Children, level+1)
+	}
+}
+
+// buildSubIssueTreeJSON converts a sub-issue tree for JSON output
+func buildSubIssueTreeJSON(nodes []subIssueNode) []SubIssueJSON {
+	if len(nodes) == 0 {
+		return nil
+	}
+	out := make([]SubIssueJSON, 0, len(nodes))
+	for _, n := range nodes {
+		sub := SubIssueJSON{
+			Number:    n.Number,
+			Title:     n.Title,
+			State:     n.State,
+			URL:       n.URL,
+			SubIssues: buildSubIssueTreeJSON(n.Children),
+		}
+		if len(n.Children) > 0 {
+			closed, total := subIssueProgress(n.Children)
+			sub.SubProgress = &SubProgressJSON{
+				Total:      total,
+				Completed:  closed,
+				Percentage: (closed * 100) / total,
+			}
+		}
+		out = append(out, sub)
+	}
+	return out
+}
+
+// maxMarkdownWidth caps the wrap width of rendered markdown on wide terminals
+const maxMarkdownWidth = 120
+
+// newViewMarkdownRenderer creates a markdown renderer sized to the terminal.
+// Output that is not a terminal is not wrapped.
+func newViewMarkdownRenderer(cmd *cobra.Command) *ui.MarkdownRenderer {
+	width := 0
+	t := term.FromEnv()
+	if t.IsTerminalOutput() {
+		if w, _, err := t.Size(); err == nil && w > 0 {
+			width = w
+			if width > maxMarkdownWidth {
+				width = maxMarkdownWidth
+			}
+		}
+	}
+	return ui.NewMarkdownRenderer(width, colorDisabled(cmd))
+}
+
 // renderProgressBar creates a visual progress bar
 // Example: [████████░░░░░░░░░░░░] for 40% complete
 func renderProgressBar(completed, total, width int) string {
@@ -466,6 +1227,31 @@ func parseIssueReference(s string) (owner, repo string, number int, err error) {
 	return "", "", number, nil
 }
 
+// issueKey resolves an issue argument to its "owner/repo#number" key,
+// defaulting to the first configured repository
+func issueKey(cfg *config.Config, arg string) (string, error) {
+	owner, repo, number, err := parseIssueReference(arg)
+	if err != nil {
+		return "", err
+	}
+	if owner == "" || repo == "" {
+		if len(cfg.Repositories) == 0 {
+			return "", fmt.Errorf("no repository specified and none configured")
+		}
+		owner, repo = splitRepository(cfg.Repositories[0])
+	}
+	return localstore.Key(owner+"/"+repo, number), nil
+}
+
+// itemKey returns the "owner/repo#number" key for a project item's issue,
+// or "" for an item without one
+func itemKey(item api.ProjectItem) string {
+	if item.Issue == nil {
+		return ""
+	}
+	return localstore.Key(item.Issue.Repository.Owner+"/"+item.Issue.Repository.Name, item.Issue.Number)
+}
+
 // parseIssueURL parses a GitHub issue URL and extracts owner, repo, and number
 // Supports formats:
 //   - https://github.com/owner/repo/issues/123
@@ -3,9 +3,16 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/ui"
 	"github.com/spf13/cobra"
 )
 
@@ -71,6 +78,54 @@ func TestViewCommand_HasWebFlag(t *testing.T) {
 	if flag.Shorthand != "w" {
 		t.Errorf("Expected --web shorthand to be 'w', got %s", flag.Shorthand)
 	}
+
+	// Bare --web opens the issue
+	if flag.NoOptDefVal != "issue" {
+		t.Errorf("Expected bare --web to default to 'issue', got %q", flag.NoOptDefVal)
+	}
+}
+
+func TestProjectItemURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		projectURL string
+		databaseID int
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "org project",
+			projectURL: "https://github.com/orgs/acme/projects/3",
+			databaseID: 1234,
+			want:       "https://github.com/orgs/acme/projects/3?pane=issue&itemId=1234",
+		},
+		{
+			name:       "trailing slash",
+			projectURL: "https://github.com/users/me/projects/1/",
+			databaseID: 5,
+			want:       "https://github.com/users/me/projects/1?pane=issue&itemId=5",
+		},
+		{name: "missing project URL", databaseID: 5, wantErr: true},
+		{name: "missing item ID", projectURL: "https://github.com/orgs/acme/projects/3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := projectItemURL(tt.projectURL, tt.databaseID)
+			if tt.wantErr {
+				if err == nil {
+					t.Error("Expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("projectItemURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
 }
 
 func TestViewCommand_HasCommentsFlag(t *testing.T) {
@@ -98,9 +153,15 @@ func TestViewCommand_AcceptsIssueNumber(t *testing.T) {
 		t.Fatalf("view command not found: %v", err)
 	}
 
-	// Verify the command accepts exactly 1 argument
+	// Verify the command accepts one or more arguments
 	if viewCmd.Args == nil {
-		t.Error("Expected Args validator to be set")
+		t.Fatal("Expected Args validator to be set")
+	}
+	if err := viewCmd.Args(viewCmd, []string{"12", "14", "27"}); err != nil {
+		t.Errorf("Expected multiple issues to be accepted, got %v", err)
+	}
+	if err := viewCmd.Args(viewCmd, []string{}); err == nil {
+		t.Error("Expected error with no issues")
 	}
 }
 
@@ -170,6 +231,23 @@ func TestViewCommand_ParsesIssueReference(t *testing.T) {
 	}
 }
 
+func TestIssueKey(t *testing.T) {
+	cfg := &config.Config{Repositories: []string{"Owner/Repo"}}
+	for arg, want := range map[string]string{
+		"42":            "owner/repo#42",
+		"#42":           "owner/repo#42",
+		"Other/Thing#7": "other/thing#7",
+	} {
+		if got, err := issueKey(cfg, arg); err != nil || got != want {
+			t.Errorf("issueKey(%q) = %q, %v, want %q", arg, got, err, want)
+		}
+	}
+
+	if _, err := issueKey(&config.Config{}, "42"); err == nil {
+		t.Error("Expected an error without a configured repository")
+	}
+}
+
 // Progress bar tests
 
 func TestRenderProgressBar(t *testing.T) {
@@ -615,6 +693,62 @@ func TestOpenViewInBrowser(t *testing.T) {
 	_ = openViewInBrowser
 }
 
+func TestViewCommand_HasLastFlag(t *testing.T) {
+	cmd := NewRootCommand()
+	viewCmd, _, err := cmd.Find([]string{"view"})
+	if err != nil {
+		t.Fatalf("view command not found: %v", err)
+	}
+
+	if viewCmd.Flags().Lookup("last") == nil {
+		t.Fatal("Expected --last flag to exist")
+	}
+}
+
+func TestLastComments(t *testing.T) {
+	comments := []api.Comment{
+		{Author: "user1", Body: "one"},
+		{Author: "user2", Body: "two"},
+		{Author: "user3", Body: "three"},
+	}
+
+	tests := []struct {
+		name      string
+		n         int
+		wantFirst string
+		wantLen   int
+	}{
+		{"zero returns all", 0, "one", 3},
+		{"last two", 2, "two", 2},
+		{"more than available", 10, "one", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lastComments(comments, tt.n)
+			if len(got) != tt.wantLen {
+				t.Fatalf("Expected %d comments, got %d", tt.wantLen, len(got))
+			}
+			if got[0].Body != tt.wantFirst {
+				t.Errorf("Expected first comment %q, got %q", tt.wantFirst, got[0].Body)
+			}
+		})
+	}
+}
+
+func TestLastComments_LongDiscussion(t *testing.T) {
+	// More comments than one page of the API
+	var comments []api.Comment
+	for i := 1; i <= 120; i++ {
+		comments = append(comments, api.Comment{Body: fmt.Sprintf("comment %d", i)})
+	}
+
+	got := lastComments(comments, 10)
+	if len(got) != 10 || got[0].Body != "comment 111" || got[9].Body != "comment 120" {
+		t.Errorf("Expected comments 111-120, got %d from %q", len(got), got[0].Body)
+	}
+}
+
 func TestOutputViewTable_WithComments(t *testing.T) {
 	buf := new(bytes.Buffer)
 	cmd := createViewTestCmd(buf)
@@ -823,3 +957,458 @@ func TestSubProgressJSON_Structure(t *testing.T) {
 		t.Errorf("Expected Percentage 60, got %d", parsed.Percentage)
 	}
 }
+
+// ============================================================================
+// History Tests
+// ============================================================================
+
+func TestViewCommand_HasHistoryFlag(t *testing.T) {
+	cmd := NewRootCommand()
+	viewCmd, _, err := cmd.Find([]string{"view"})
+	if err != nil {
+		t.Fatalf("view command not found: %v", err)
+	}
+
+	if viewCmd.Flags().Lookup("history") == nil {
+		t.Fatal("Expected --history flag to exist")
+	}
+}
+
+func TestFilterProjectHistory(t *testing.T) {
+	events := []api.ProjectEvent{
+		{Type: api.ProjectEventAdded, ProjectNumber: 1},
+		{Type: api.ProjectEventAdded, ProjectNumber: 2},
+		{Type: api.ProjectEventStatusChanged, ProjectNumber: 1},
+	}
+
+	got := filterProjectHistory(events, 1)
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 events for project 1, got %d", len(got))
+	}
+	if got[1].Type != api.ProjectEventStatusChanged {
+		t.Errorf("Expected order to be preserved, got %+v", got)
+	}
+}
+
+func TestDescribeHistoryEvent(t *testing.T) {
+	tests := []struct {
+		event api.ProjectEvent
+		want  string
+	}{
+		{api.ProjectEvent{Type: api.ProjectEventAdded, Actor: "bot"}, "Added to project by @bot"},
+		{api.ProjectEvent{Type: api.ProjectEventRemoved}, "Removed from project"},
+		{api.ProjectEvent{Type: api.ProjectEventStatusChanged, Field: "Status", From: "Backlog", To: "In review", Actor: "alice"}, "Status: Backlog → In review by @alice"},
+		{api.ProjectEvent{Type: api.ProjectEventStatusChanged, Field: "Status", To: "Backlog"}, "Status: (none) → Backlog"},
+		{api.ProjectEvent{Type: api.ProjectEventFieldUpdated, Field: "Priority", To: "P1"}, "Priority last set to P1"},
+	}
+
+	for _, tt := range tests {
+		if got := describeHistoryEvent(tt.event); got != tt.want {
+			t.Errorf("describeHistoryEvent(%+v) = %q, want %q", tt.event, got, tt.want)
+		}
+	}
+}
+
+func TestFormatHistoryTime(t *testing.T) {
+	if got := formatHistoryTime("2024-03-05T14:07:00Z"); got != "2024-03-05 14:07" {
+		t.Errorf("Expected formatted time, got %q", got)
+	}
+	if got := formatHistoryTime("not-a-time"); got != "not-a-time" {
+		t.Errorf("Expected unparseable value to pass through, got %q", got)
+	}
+}
+
+func TestBuildHistoryJSON(t *testing.T) {
+	history := []api.ProjectEvent{
+		{Type: api.ProjectEventStatusChanged, Field: "Status", From: "Backlog", To: "Done", Actor: "alice", CreatedAt: "2024-01-01T00:00:00Z"},
+	}
+
+	got := buildHistoryJSON(history)
+	if len(got) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(got))
+	}
+	want := HistoryEventJSON{Type: "status_changed", Field: "Status", From: "Backlog", To: "Done", Actor: "alice", CreatedAt: "2024-01-01T00:00:00Z"}
+	if got[0] != want {
+		t.Errorf("buildHistoryJSON() = %+v, want %+v", got[0], want)
+	}
+
+	if empty := buildHistoryJSON(nil); empty == nil || len(empty) != 0 {
+		t.Errorf("Expected empty non-nil slice, got %v", empty)
+	}
+}
+
+func TestDescribePullRequestStatus(t *testing.T) {
+	tests := []struct {
+		pr   api.LinkedPullRequest
+		want string
+	}{
+		{api.LinkedPullRequest{State: "OPEN", ReviewDecision: "APPROVED", ChecksState: "SUCCESS"}, "open, approved, checks success"},
+		{api.LinkedPullRequest{State: "OPEN", IsDraft: true, ReviewDecision: "REVIEW_REQUIRED"}, "draft, review required"},
+		{api.LinkedPullRequest{State: "OPEN", ReviewDecision: "CHANGES_REQUESTED", ChecksState: "FAILURE"}, "open, changes requested, checks failure"},
+		{api.LinkedPullRequest{State: "MERGED"}, "merged"},
+	}
+
+	for _, tt := range tests {
+		if got := describePullRequestStatus(tt.pr); got != tt.want {
+			t.Errorf("describePullRequestStatus(%+v) = %q, want %q", tt.pr, got, tt.want)
+		}
+	}
+}
+
+func TestBuildLinkedPullRequestsJSON(t *testing.T) {
+	prs := []api.LinkedPullRequest{
+		{
+			Number:         10,
+			Title:          "Fix login",
+			State:          "OPEN",
+			URL:            "https://github.com/owner/repo/pull/10",
+			Repository:     api.Repository{Owner: "owner", Name: "repo"},
+			ReviewDecision: "APPROVED",
+			ChecksState:    "SUCCESS",
+			Closes:         true,
+		},
+	}
+
+	got := buildLinkedPullRequestsJSON(prs)
+	if len(got) != 1 {
+		t.Fatalf("Expected 1 pull request, got %d", len(got))
+	}
+	want := LinkedPullRequestJSON{
+		Number:         10,
+		Title:          "Fix login",
+		State:          "OPEN",
+		URL:            "https://github.com/owner/repo/pull/10",
+		Repository:     "owner/repo",
+		ReviewDecision: "APPROVED",
+		ChecksState:    "SUCCESS",
+		Closes:         true,
+	}
+	if got[0] != want {
+		t.Errorf("buildLinkedPullRequestsJSON() = %+v, want %+v", got[0], want)
+	}
+
+	if empty := buildLinkedPullRequestsJSON(nil); empty != nil {
+		t.Errorf("Expected nil for no pull requests, got %v", empty)
+	}
+}
+
+// mockSubIssueTreeClient returns sub-issues keyed by "owner/repo#number"
+type mockSubIssueTreeClient struct {
+	children map[string][]api.SubIssue
+	calls    []string
+}
+
+func (m *mockSubIssueTreeClient) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	key := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+	m.calls = append(m.calls, key)
+	if key == "owner/repo#99" {
+		return nil, errors.New("boom")
+	}
+	return m.children[key], nil
+}
+
+func TestViewCommand_HasRecursiveFlags(t *testing.T) {
+	cmd := NewRootCommand()
+	viewCmd, _, err := cmd.Find([]string{"view"})
+	if err != nil {
+		t.Fatalf("view command not found: %v", err)
+	}
+	if viewCmd.Flags().Lookup("recursive") == nil {
+		t.Error("Expected --recursive flag to exist")
+	}
+	if f := viewCmd.Flags().Lookup("depth"); f == nil || f.DefValue != "10" {
+		t.Error("Expected --depth flag with default 10")
+	}
+}
+
+func newTestSubIssueTreeClient() *mockSubIssueTreeClient {
+	return &mockSubIssueTreeClient{
+		children: map[string][]api.SubIssue{
+			"owner/repo#2": {
+				{Number: 5, Title: "Task A", State: "CLOSED"},
+				{Number: 6, Title: "Task B", State: "OPEN", Repository: api.Repository{Owner: "other", Name: "lib"}},
+			},
+			"other/lib#6": {
+				{Number: 7, Title: "Subtask", State: "OPEN"},
+			},
+		},
+	}
+}
+
+func TestBuildSubIssueTree(t *testing.T) {
+	client := newTestSubIssueTreeClient()
+	top := []api.SubIssue{
+		{Number: 2, Title: "Story", State: "OPEN"},
+		{Number: 99, Title: "Broken", State: "OPEN"},
+	}
+
+	tree := buildSubIssueTree(client, "owner", "repo", top, 1, 10)
+
+	if len(tree) != 2 {
+		t.Fatalf("Expected 2 top-level nodes, got %d", len(tree))
+	}
+	if len(tree[0].Children) != 2 {
+		t.Fatalf("Expected 2 children of #2, got %d", len(tree[0].Children))
+	}
+	if len(tree[0].Children[1].Children) != 1 || tree[0].Children[1].Children[0].Number != 7 {
+		t.Errorf("Expected cross-repo child to be expanded in its own repo, got %+v", tree[0].Children[1])
+	}
+	if len(tree[1].Children) != 0 {
+		t.Errorf("Expected failed branch to be left unexpanded, got %+v", tree[1].Children)
+	}
+}
+
+func TestBuildSubIssueTree_RespectsDepth(t *testing.T) {
+	client := newTestSubIssueTreeClient()
+	top := []api.SubIssue{{Number: 2, Title: "Story", State: "OPEN"}}
+
+	tree := buildSubIssueTree(client, "owner", "repo", top, 1, 1)
+
+	if len(tree) != 1 || len(tree[0].Children) != 0 {
+		t.Errorf("Expected no expansion at depth 1, got %+v", tree)
+	}
+	if len(client.calls) != 0 {
+		t.Errorf("Expected no API calls at depth 1, got %v", client.calls)
+	}
+}
+
+func TestOutputViewTableWithOptions_Tree(t *testing.T) {
+	client := newTestSubIssueTreeClient()
+	issue := &api.Issue{
+		Number:     1,
+		Title:      "Epic",
+		State:      "OPEN",
+		Author:     api.Actor{Login: "author"},
+		Repository: api.Repository{Owner: "owner", Name: "repo"},
+	}
+	subIssues := []api.SubIssue{{Number: 2, Title: "Story", State: "OPEN"}}
+	tree := buildSubIssueTree(client, "owner", "repo", subIssues, 1, 10)
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := outputViewTableWithOptions(createViewTestCmd(new(bytes.Buffer)), issue, nil, subIssues, nil, nil, viewTableOptions{subTree: tree})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("outputViewTableWithOptions() error = %v", err)
+	}
+
+	output, _ := io.ReadAll(r)
+	for _, want := range []string{
+		"  [ ] #2 - Story (1/2)\n",
+		"    [x] #5 - Task A\n",
+		"    [ ] other/lib#6 - Task B (0/1)\n",
+		"      [ ] #7 - Subtask\n",
+		"0 of 1 sub-issues complete",
+	} {
+		if !strings.Contains(string(output), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestBuildSubIssueTreeJSON(t *testing.T) {
+	client := newTestSubIssueTreeClient()
+	tree := buildSubIssueTree(client, "owner", "repo", []api.SubIssue{{Number: 2, Title: "Story", State: "OPEN"}}, 1, 10)
+
+	got := buildSubIssueTreeJSON(tree)
+
+	if len(got) != 1 || len(got[0].SubIssues) != 2 {
+		t.Fatalf("Expected nested sub-issues, got %+v", got)
+	}
+	if got[0].SubProgress == nil || got[0].SubProgress.Completed != 1 || got[0].SubProgress.Percentage != 50 {
+		t.Errorf("Unexpected progress for #2: %+v", got[0].SubProgress)
+	}
+	leaf := got[0].SubIssues[0]
+	if leaf.SubIssues != nil || leaf.SubProgress != nil {
+		t.Errorf("Expected leaf without nested fields, got %+v", leaf)
+	}
+	if got[0].SubIssues[1].SubIssues[0].Number != 7 {
+		t.Errorf("Expected third level to be nested, got %+v", got[0].SubIssues[1])
+	}
+}
+
+func TestViewCommand_HasRawFlag(t *testing.T) {
+	cmd := NewRootCommand()
+	viewCmd, _, err := cmd.Find([]string{"view"})
+	if err != nil {
+		t.Fatalf("view command not found: %v", err)
+	}
+	if viewCmd.Flags().Lookup("raw") == nil {
+		t.Error("Expected --raw flag to exist")
+	}
+}
+
+func TestOutputViewTableWithOptions_Markdown(t *testing.T) {
+	issue := &api.Issue{
+		Number: 1,
+		Title:  "Rendered",
+		State:  "OPEN",
+		Author: api.Actor{Login: "author"},
+		Body:   "## Steps\n- [x] **done**",
+	}
+	comments := []api.Comment{{Author: "dev", Body: "See `config.go`", CreatedAt: "2024-01-01"}}
+	renderer := ui.NewMarkdownRenderer(0, true)
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := outputViewTableWithOptions(createViewTestCmd(new(bytes.Buffer)), issue, nil, nil, nil, comments, viewTableOptions{markdown: renderer.Render})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("outputViewTableWithOptions() error = %v", err)
+	}
+
+	data, _ := io.ReadAll(r)
+	output := string(data)
+	for _, want := range []string{"---\nSteps\n[" + ui.SymbolCheck + "] done\n", "See config.go\n"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "**done**") {
+		t.Errorf("Expected markdown markers to be rendered, got:\n%s", output)
+	}
+}
+
+func TestEncodeViewJSONList(t *testing.T) {
+	outputs := []ViewJSONOutput{
+		buildViewJSON(&api.Issue{Number: 12, Title: "First", State: "OPEN"}, nil, nil, nil, nil),
+		buildViewJSON(&api.Issue{Number: 14, Title: "Second", State: "CLOSED"}, nil, nil, nil, nil),
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := encodeViewJSONList(outputs)
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("encodeViewJSONList() error = %v", err)
+	}
+
+	var decoded []ViewJSONOutput
+	data, _ := io.ReadAll(r)
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Expected a JSON array, got %v:\n%s", err, data)
+	}
+	if len(decoded) != 2 || decoded[0].Number != 12 || decoded[1].Number != 14 {
+		t.Errorf("Unexpected decoded output: %+v", decoded)
+	}
+}
+
+func TestRunView_WebRequiresSingleIssue(t *testing.T) {
+	dir := createTempConfig(t, `
+project:
+  owner: owner
+  number: 1
+repositories:
+  - owner/repo
+`)
+	originalDir, _ := os.Getwd()
+	defer func() { _ = os.Chdir(originalDir) }()
+
+	if err := os.Chdir(dir); err != nil {
+		t.Fatalf("Failed to chdir: %v", err)
+	}
+
+	err := runView(createViewTestCmd(new(bytes.Buffer)), []string{"12", "14"}, &viewOptions{web: "issue", depth: 10})
+	if err == nil || !strings.Contains(err.Error(), "single issue") {
+		t.Errorf("Expected single issue error, got %v", err)
+	}
+}
+
+func TestOutputViewTable_ArchivedItem(t *testing.T) {
+	issue := &api.Issue{Number: 3, Title: "Old work", State: "CLOSED", Author: api.Actor{Login: "author"}}
+	fieldValues := []api.FieldValue{{Field: "Status", Value: "Done"}}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := outputViewTableWithOptions(createViewTestCmd(new(bytes.Buffer)), issue, fieldValues, nil, nil, nil, viewTableOptions{archived: true})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("outputViewTableWithOptions() error = %v", err)
+	}
+
+	output, _ := io.ReadAll(r)
+	if !strings.Contains(string(output), "Project Fields (archived):\n  Status: Done") {
+		t.Errorf("Expected archived field header, got:\n%s", output)
+	}
+}
+
+func TestFormatReactionsAndParticipants(t *testing.T) {
+	reactions := []api.ReactionCount{{Content: "THUMBS_UP", Count: 3}, {Content: "THUMBS_DOWN", Count: 1}, {Content: "NEW_KIND", Count: 2}}
+	if got := formatReactions(reactions); got != "👍 3  👎 1  new_kind 2" {
+		t.Errorf("formatReactions() = %q", got)
+	}
+
+	if got := formatParticipants([]string{"alice", "bob"}, 2); got != "@alice, @bob" {
+		t.Errorf("formatParticipants() = %q", got)
+	}
+	if got := formatParticipants([]string{"alice"}, 4); got != "@alice (+3 more)" {
+		t.Errorf("formatParticipants() = %q", got)
+	}
+}
+
+func TestBuildEngagementJSON(t *testing.T) {
+	reactions, participants := buildEngagementJSON(nil)
+	if reactions != nil || participants != nil {
+		t.Errorf("Expected nil output for nil engagement")
+	}
+
+	reactions, participants = buildEngagementJSON(&api.IssueEngagement{
+		Reactions:    []api.ReactionCount{{Content: "THUMBS_UP", Count: 5}},
+		Participants: []string{"alice"},
+	})
+	if reactions["thumbs_up"] != 5 || len(reactions) != 1 {
+		t.Errorf("Unexpected reactions: %v", reactions)
+	}
+	if len(participants) != 1 || participants[0] != "alice" {
+		t.Errorf("Unexpected participants: %v", participants)
+	}
+}
+
+func TestOutputViewTable_Engagement(t *testing.T) {
+	issue := &api.Issue{Number: 3, Title: "Popular", State: "OPEN", Author: api.Actor{Login: "author"}}
+	engagement := &api.IssueEngagement{
+		Reactions:         []api.ReactionCount{{Content: "THUMBS_UP", Count: 12}},
+		Participants:      []string{"author", "alice"},
+		TotalParticipants: 2,
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := outputViewTableWithOptions(createViewTestCmd(new(bytes.Buffer)), issue, nil, nil, nil, nil, viewTableOptions{engagement: engagement})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("outputViewTableWithOptions() error = %v", err)
+	}
+
+	output, _ := io.ReadAll(r)
+	for _, want := range []string{"Reactions: 👍 12\n", "Participants: @author, @alice\n"} {
+		if !strings.Contains(string(output), want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+	"github.com/scooter-indie/gh-pmu/internal/notify"
+	"github.com/spf13/cobra"
+)
+
+type watchOptions struct {
+	notify   bool
+	interval time.Duration
+}
+
+// watchClient defines the interface for API methods used by watch.
+// This allows for easier testing with mock implementations.
+type watchClient interface {
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetProject(owner string, number int) (*api.Project, error)
+	GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error)
+	GetIssueComments(owner, repo string, number int) ([]api.Comment, error)
+	GetLinkedPullRequests(owner, repo string, number int) ([]api.LinkedPullRequest, error)
+}
+
+// watchTarget is an issue being watched
+type watchTarget struct {
+	key    string // owner/repo#number
+	owner  string
+	repo   string
+	number int
+}
+
+// watchSnapshot is the state of a watched issue at one poll
+type watchSnapshot struct {
+	title       string
+	state       string
+	status      string
+	comments    int
+	lastComment string // author of the newest comment
+	mergedPRs   map[int]string
+}
+
+func newWatchCommand() *cobra.Command {
+	opts := &watchOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "watch [issue]...",
+		Short: "Watch issues for status changes, comments, and merged pull requests",
+		Long: `Poll issues, by default the focused one, and report when they change:
+
+  - the project status changes
+  - the issue is closed or reopened
+  - new comments are posted
+  - a linked pull request is merged
+
+Changes are printed as they are seen; with --notify each one also shows
+a desktop notification (notify-send on Linux, Notification Center on
+macOS, a balloon tip on Windows). Press Ctrl+C to stop watching.`,
+		Example: `  # Watch two issues and get desktop notifications
+  gh pmu watch 42 57 --notify
+
+  # Poll every five minutes
+  gh pmu watch 42 --interval 5m`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadProjectConfig()
+			if err != nil {
+				return err
+			}
+			args, err = issueArgsOrFocus(args, cfg)
+			if err != nil {
+				return err
+			}
+
+			if opts.interval < 10*time.Second {
+				return fmt.Errorf("--interval must be at least 10s")
+			}
+
+			ticker := time.NewTicker(opts.interval)
+			defer ticker.Stop()
+			if err := runWatchWithDeps(cmd, args, opts, cfg, api.NewClient(), notify.Send, ticksUntilDone(cmd.Context(), ticker.C)); err != nil {
+				return err
+			}
+			return context.Cause(cmd.Context())
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.notify, "notify", false, "Show a desktop notification for each change")
+	cmd.Flags().DurationVar(&opts.interval, "interval", time.Minute, "How often to poll (e.g., 30s, 5m)")
+
+	return cmd
+}
+
+// runWatchWithDeps is the testable implementation of watch. It polls once
+// for a baseline, then again on every tick until ticks is closed.
+func runWatchWithDeps(cmd *cobra.Command, args []string, opts *watchOptions, cfg *config.Config, client watchClient, send func(title, body string) error, ticks <-chan time.Time) error {
+	var targets []watchTarget
+	for _, arg := range args {
+		key, err := issueKey(cfg, arg)
+		if err != nil {
+			return err
+		}
+		owner, repo, number, err := parseIssueReference(key)
+		if err != nil {
+			return err
+		}
+		targets = append(targets, watchTarget{key: key, owner: owner, repo: repo, number: number})
+	}
+
+	project, err := client.GetProject(cfg.Project.Owner, cfg.Project.Number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+	statusField := cfg.GetFieldName("status")
+
+	snapshots := make(map[string]*watchSnapshot, len(targets))
+	for _, t := range targets {
+		snap, err := pollWatchTarget(client, project.ID, statusField, t)
+		if err != nil {
+			return err
+		}
+		snapshots[t.key] = snap
+	}
+	cmd.Printf("Watching %d issue(s); press Ctrl+C to stop\n", len(targets))
+
+	for now := range ticks {
+		for _, t := range targets {
+			snap, err := pollWatchTarget(client, project.ID, statusField, t)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+				continue
+			}
+
+			for _, change := range diffWatchSnapshots(snapshots[t.key], snap) {
+				cmd.Printf("[%s] %s: %s\n", now.Format("15:04"), t.key, change)
+				if opts.notify {
+					if err := send(fmt.Sprintf("%s %s", t.key, snap.title), change); err != nil {
+						fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+					}
+				}
+			}
+			snapshots[t.key] = snap
+		}
+	}
+	return nil
+}
+
+// pollWatchTarget fetches the current state of a watched issue
+func pollWatchTarget(client watchClient, projectID, statusField string, t watchTarget) (*watchSnapshot, error) {
+	issue, err := client.GetIssue(t.owner, t.repo, t.number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", t.key, err)
+	}
+	snap := &watchSnapshot{title: issue.Title, state: issue.State, mergedPRs: map[int]string{}}
+
+	item, err := client.GetIssueProjectItem(t.owner, t.repo, t.number, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if item != nil {
+		snap.status = getFieldValue(*item, statusField)
+	}
+
+	comments, err := client.GetIssueComments(t.owner, t.repo, t.number)
+	if err != nil {
+		return nil, err
+	}
+	snap.comments = len(comments)
+	if len(comments) > 0 {
+		snap.lastComment = comments[len(comments)-1].Author
+	}
+
+	prs, err := client.GetLinkedPullRequests(t.owner, t.repo, t.number)
+	if err != nil {
+		return nil, err
+	}
+	for _, pr := range prs {
+		if pr.State == "MERGED" {
+			snap.mergedPRs[pr.Number] = pr.Title
+		}
+	}
+	return snap, nil
+}
+
+// diffWatchSnapshots describes what changed between two polls of an issue
+func diffWatchSnapshots(prev, next *watchSnapshot) []string {
+	var changes []string
+
+	if !strings.EqualFold(prev.status, next.status) {
+		from, to := prev.status, next.status
+		if from == "" {
+			from = "(none)"
+		}
+		if to == "" {
+			to = "(none)"
+		}
+		changes = append(changes, fmt.Sprintf("Status: %s → %s", from, to))
+	}
+
+	if !strings.EqualFold(prev.state, next.state) {
+		if strings.EqualFold(next.state, "CLOSED") {
+			changes = append(changes, "Issue closed")
+		} else {
+			changes = append(changes, "Issue reopened")
+		}
+	}
+
+	if n := next.comments - prev.comments; n > 0 {
+		changes = append(changes, fmt.Sprintf("%d new comment(s), latest from @%s", n, next.lastComment))
+	}
+
+	for number, title := range next.mergedPRs {
+		if _, ok := prev.mergedPRs[number]; !ok {
+			changes = append(changes, fmt.Sprintf("Pull request #%d merged: %s", number, title))
+		}
+	}
+
+	return changes
+}
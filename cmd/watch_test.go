@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// watchPoll is what mockWatchClient returns for one poll of an issue
+type watchPoll struct {
+	state    string
+	status   string
+	comments []api.Comment
+	prs      []api.LinkedPullRequest
+	err      error
+}
+
+// mockWatchClient implements watchClient interface for testing. Each call
+// to GetIssue advances to the next poll.
+type mockWatchClient struct {
+	polls []watchPoll
+	calls int
+}
+
+func (m *mockWatchClient) current() watchPoll {
+	return m.polls[m.calls-1]
+}
+
+func (m *mockWatchClient) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	if m.calls < len(m.polls) {
+		m.calls++
+	}
+	if err := m.current().err; err != nil {
+		return nil, err
+	}
+	return &api.Issue{Number: number, Title: "Add dark mode", State: m.current().state}, nil
+}
+
+func (m *mockWatchClient) GetProject(owner string, number int) (*api.Project, error) {
+	return &api.Project{ID: "proj-1"}, nil
+}
+
+func (m *mockWatchClient) GetIssueProjectItem(owner, repo string, number int, projectID string) (*api.ProjectItem, error) {
+	return &api.ProjectItem{ID: "item-1", FieldValues: []api.FieldValue{{Field: "Status", Value: m.current().status}}}, nil
+}
+
+func (m *mockWatchClient) GetIssueComments(owner, repo string, number int) ([]api.Comment, error) {
+	return m.current().comments, nil
+}
+
+func (m *mockWatchClient) GetLinkedPullRequests(owner, repo string, number int) ([]api.LinkedPullRequest, error) {
+	return m.current().prs, nil
+}
+
+func TestWatchCommand_Exists(t *testing.T) {
+	cmd := NewRootCommand()
+	sub, _, err := cmd.Find([]string{"watch"})
+	if err != nil || sub.Name() != "watch" {
+		t.Fatalf("watch command not found: %v", err)
+	}
+	for _, flag := range []string{"notify", "interval"} {
+		if sub.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected --%s flag to exist", flag)
+		}
+	}
+}
+
+func TestRunWatch(t *testing.T) {
+	comment := api.Comment{Author: "alice", Body: "Looks good"}
+	client := &mockWatchClient{polls: []watchPoll{
+		{state: "OPEN", status: "In Progress"},
+		{state: "OPEN", status: "In Progress"},
+		{err: errors.New("rate limited")},
+		{state: "OPEN", status: "In Review", comments: []api.Comment{comment, {Author: "bob"}}},
+		{state: "CLOSED", status: "Done", comments: []api.Comment{comment, {Author: "bob"}},
+			prs: []api.LinkedPullRequest{{Number: 12, Title: "Dark mode", State: "MERGED"}, {Number: 13, State: "OPEN"}}},
+	}}
+
+	ticks := make(chan time.Time, 4)
+	for i := 0; i < 4; i++ {
+		ticks <- time.Date(2026, 3, 2, 10, i, 0, 0, time.UTC)
+	}
+	close(ticks)
+
+	var sent []string
+	send := func(title, body string) error {
+		sent = append(sent, title+": "+body)
+		return nil
+	}
+
+	cmd, buf := newTestCmd()
+	if err := runWatchWithDeps(cmd, []string{"42"}, &watchOptions{notify: true}, newPRTestConfig(), client, send, ticks); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		"Watching 1 issue(s)",
+		"[10:02] owner/repo#42: Status: In Progress → In Review",
+		"[10:02] owner/repo#42: 2 new comment(s), latest from @bob",
+		"[10:03] owner/repo#42: Status: In Review → Done",
+		"[10:03] owner/repo#42: Issue closed",
+		"[10:03] owner/repo#42: Pull request #12 merged: Dark mode",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "#13") {
+		t.Errorf("Expected open pull requests to be ignored, got:\n%s", out)
+	}
+
+	// A failed poll keeps the previous snapshot rather than reporting changes
+	if strings.Contains(out, "[10:00]") || strings.Contains(out, "[10:01]") {
+		t.Errorf("Expected no changes from the unchanged and failed polls, got:\n%s", out)
+	}
+
+	if len(sent) != 5 || sent[0] != "owner/repo#42 Add dark mode: Status: In Progress → In Review" {
+		t.Errorf("Expected a notification per change, got %v", sent)
+	}
+}
+
+func TestRunWatch_WithoutNotify(t *testing.T) {
+	client := &mockWatchClient{polls: []watchPoll{
+		{state: "OPEN", status: "Backlog"},
+		{state: "OPEN", status: "Ready"},
+	}}
+	ticks := make(chan time.Time, 1)
+	ticks <- time.Now()
+	close(ticks)
+
+	send := func(title, body string) error {
+		t.Errorf("Expected no notification without --notify, got %q", body)
+		return nil
+	}
+
+	cmd, buf := newTestCmd()
+	if err := runWatchWithDeps(cmd, []string{"42"}, &watchOptions{}, newPRTestConfig(), client, send, ticks); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "Status: Backlog → Ready") {
+		t.Errorf("Expected the status change to be printed, got:\n%s", buf.String())
+	}
+}
+
+func TestRunWatch_BaselineError(t *testing.T) {
+	client := &mockWatchClient{polls: []watchPoll{{err: errors.New("not found")}}}
+	cmd, _ := newTestCmd()
+	err := runWatchWithDeps(cmd, []string{"42"}, &watchOptions{}, newPRTestConfig(), client, nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "owner/repo#42") {
+		t.Errorf("Expected an error naming the issue, got %v", err)
+	}
+}
+
+func TestDiffWatchSnapshots(t *testing.T) {
+	prev := &watchSnapshot{state: "CLOSED", comments: 3, mergedPRs: map[int]string{}}
+	next := &watchSnapshot{state: "OPEN", status: "Ready", comments: 2, mergedPRs: map[int]string{}}
+
+	changes := diffWatchSnapshots(prev, next)
+	want := []string{"Status: (none) → Ready", "Issue reopened"}
+	if len(changes) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("Change %d: expected %q, got %q", i, want[i], changes[i])
+		}
+	}
+
+	if changes := diffWatchSnapshots(next, next); len(changes) != 0 {
+		t.Errorf("Expected no changes for identical snapshots, got %v", changes)
+	}
+}
@@ -0,0 +1,297 @@
+// Package annotate finds issue references in free text and rewrites them
+// with the issue's status and title, so documents that mention issues stay
+// readable and current.
+package annotate
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Issue is what an annotation shows about a referenced issue
+type Issue struct {
+	Title  string
+	Status string // project status; empty when unset
+}
+
+// Lookup returns the issue for a reference, or false to leave it alone
+type Lookup func(owner, repo string, number int) (Issue, bool)
+
+// Result summarizes an annotation pass
+type Result struct {
+	Text    string
+	Refs    int // references resolved by the lookup
+	Changed int // references whose annotation was added or updated
+}
+
+// refPattern matches issue URLs, owner/repo#N, and #N
+var refPattern = regexp.MustCompile(`https://github\.com/([\w.-]+)/([\w.-]+)/(?:issues|pull)/(\d+)|(?:([\w.-]+)/([\w.-]+))?#(\d+)`)
+
+// badges maps normalized status names to their badge
+var badges = map[string]string{
+	"done":       "✅",
+	"closed":     "✅",
+	"complete":   "✅",
+	"completed":  "✅",
+	"shipped":    "✅",
+	"released":   "✅",
+	"inprogress": "🔄",
+	"doing":      "🔄",
+	"active":     "🔄",
+	"inreview":   "👀",
+	"review":     "👀",
+	"qa":         "👀",
+	"blocked":    "⛔",
+}
+
+const (
+	defaultBadge  = "⬜"
+	noStatusBadge = "⚪"
+	noStatus      = "No status"
+	separator     = " — "
+)
+
+// existingPattern matches an annotation written by a previous pass,
+// up to the start of its title
+var existingPattern = regexp.MustCompile(`^ (✅|🔄|👀|⛔|⬜|⚪) ([^—\n]*?)` + separator)
+
+// Badge returns the badge shown for a status
+func Badge(status string) string {
+	if status == "" {
+		return noStatusBadge
+	}
+	key := strings.Map(func(r rune) rune {
+		if r == ' ' || r == '_' || r == '-' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, status)
+	if badge, ok := badges[key]; ok {
+		return badge
+	}
+	return defaultBadge
+}
+
+// Format returns the annotation that follows a reference to issue
+func Format(issue Issue) string {
+	status := issue.Status
+	if status == "" {
+		status = noStatus
+	}
+	return " " + Badge(issue.Status) + " " + status + separator + issue.Title
+}
+
+// Text annotates every reference in text that lookup resolves. A bare #N
+// refers to defaultRepo (owner/name). References in fenced code blocks and
+// inline code are left alone. An annotation from a previous pass is
+// replaced; its title is taken to be the issue's current title or, when
+// that has changed, the rest of the line up to a table cell border.
+func Text(text, defaultRepo string, lookup Lookup) Result {
+	lines := strings.SplitAfter(text, "\n")
+	result := Result{}
+	inFence := false
+	var out strings.Builder
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			out.WriteString(line)
+			continue
+		}
+		if inFence {
+			out.WriteString(line)
+			continue
+		}
+		out.WriteString(annotateLine(line, defaultRepo, lookup, &result))
+	}
+	result.Text = out.String()
+	return result
+}
+
+func annotateLine(line, defaultRepo string, lookup Lookup, result *Result) string {
+	var out strings.Builder
+	last := 0
+	for _, m := range findRefs(line, defaultRepo) {
+		// Skip references inside a previous annotation's title
+		if m.start < last {
+			continue
+		}
+		issue, ok := lookup(m.Owner, m.Repo, m.Number)
+		if !ok {
+			continue
+		}
+		result.Refs++
+
+		annotation := Format(issue)
+		rest := line[m.end:]
+		old := existingLength(rest, issue.Title)
+		if rest[:old] != annotation {
+			result.Changed++
+		}
+
+		out.WriteString(line[last:m.end])
+		out.WriteString(annotation)
+		last = m.end + old
+	}
+	out.WriteString(line[last:])
+	return out.String()
+}
+
+// Ref is an issue reference found in text
+type Ref struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+// Refs returns the distinct issue references in text, in order of first
+// appearance. A bare #N refers to defaultRepo (owner/name) and is skipped
+// without one. References in fenced code blocks and inline code are
+// skipped.
+func Refs(text, defaultRepo string) []Ref {
+	var refs []Ref
+	seen := make(map[Ref]bool)
+	inFence := false
+	for _, line := range strings.Split(text, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		for _, m := range findRefs(line, defaultRepo) {
+			if !seen[m.Ref] {
+				seen[m.Ref] = true
+				refs = append(refs, m.Ref)
+			}
+		}
+	}
+	return refs
+}
+
+// refMatch is a reference and where it is in a line
+type refMatch struct {
+	Ref
+	start, end int
+}
+
+// findRefs returns the references in a line outside inline code
+func findRefs(line, defaultRepo string) []refMatch {
+	code := codeSpans(line)
+	var matches []refMatch
+	for _, m := range refPattern.FindAllStringSubmatchIndex(line, -1) {
+		start, end := m[0], m[1]
+		if inSpans(code, start) || !boundaryBefore(line, start) || !boundaryAfter(line, end) {
+			continue
+		}
+		owner, repo, number := matchRef(line, m, defaultRepo)
+		if owner == "" {
+			continue
+		}
+		matches = append(matches, refMatch{Ref: Ref{Owner: owner, Repo: repo, Number: number}, start: start, end: end})
+	}
+	return matches
+}
+
+// matchRef returns the issue a match refers to, or an empty owner when a
+// bare #N has no default repository
+func matchRef(line string, m []int, defaultRepo string) (owner, repo string, number int) {
+	group := func(i int) string {
+		if m[2*i] < 0 {
+			return ""
+		}
+		return line[m[2*i]:m[2*i+1]]
+	}
+
+	if group(3) != "" {
+		number, _ = strconv.Atoi(group(3))
+		return group(1), group(2), number
+	}
+	number, _ = strconv.Atoi(group(6))
+	if group(4) != "" {
+		return group(4), group(5), number
+	}
+	owner, repo, _ = strings.Cut(defaultRepo, "/")
+	if repo == "" {
+		return "", "", 0
+	}
+	return owner, repo, number
+}
+
+// existingLength returns the length of a previous annotation at the start
+// of rest, or 0 when there is none
+func existingLength(rest, title string) int {
+	loc := existingPattern.FindStringIndex(rest)
+	if loc == nil {
+		return 0
+	}
+	n := loc[1]
+	if title != "" && strings.HasPrefix(rest[n:], title) {
+		return n + len(title)
+	}
+
+	// The title changed; it runs to the end of the line or table cell
+	end := len(rest)
+	if i := strings.IndexAny(rest[n:], "\r\n"); i >= 0 {
+		end = n + i
+	}
+	if i := strings.Index(rest[n:end], " |"); i >= 0 {
+		end = n + i
+	}
+	return end
+}
+
+// codeSpans returns the [start, end) byte ranges of inline code in line
+func codeSpans(line string) [][2]int {
+	var spans [][2]int
+	open := -1
+	for i := 0; i < len(line); i++ {
+		if line[i] != '`' {
+			continue
+		}
+		if open < 0 {
+			open = i
+		} else {
+			spans = append(spans, [2]int{open, i + 1})
+			open = -1
+		}
+	}
+	return spans
+}
+
+func inSpans(spans [][2]int, i int) bool {
+	for _, s := range spans {
+		if i >= s[0] && i < s[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// boundaryBefore reports whether a reference may start at i, so that
+// HTML entities (&#123;), anchors in URLs, and words are not matched
+func boundaryBefore(line string, i int) bool {
+	if i == 0 {
+		return true
+	}
+	r, _ := utf8.DecodeLastRuneInString(line[:i])
+	if unicode.IsLetter(r) || unicode.IsDigit(r) {
+		return false
+	}
+	return !strings.ContainsRune("_&/.-:#", r)
+}
+
+// boundaryAfter reports whether a reference may end at i, so that #123abc
+// (a color, say) is not matched
+func boundaryAfter(line string, i int) bool {
+	if i == len(line) {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(line[i:])
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+}
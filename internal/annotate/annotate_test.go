@@ -0,0 +1,114 @@
+package annotate
+
+import (
+	"fmt"
+	"testing"
+)
+
+func testLookup(issues map[string]Issue) Lookup {
+	return func(owner, repo string, number int) (Issue, bool) {
+		issue, ok := issues[fmt.Sprintf("%s/%s#%d", owner, repo, number)]
+		return issue, ok
+	}
+}
+
+func TestBadge(t *testing.T) {
+	tests := map[string]string{
+		"Done":        "✅",
+		"In Progress": "🔄",
+		"in_review":   "👀",
+		"Blocked":     "⛔",
+		"Backlog":     "⬜",
+		"":            "⚪",
+	}
+	for status, want := range tests {
+		if got := Badge(status); got != want {
+			t.Errorf("Badge(%q) = %q, want %q", status, got, want)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	lookup := testLookup(map[string]Issue{
+		"owner/repo#123": {Title: "Fix login", Status: "Done"},
+		"owner/repo#7":   {Title: "Add SSO", Status: "In Progress"},
+		"other/lib#9":    {Title: "Dark mode"},
+	})
+
+	input := "# Plan\n" +
+		"- #123\n" +
+		"- See owner/repo#7, then other/lib#9.\n" +
+		"- https://github.com/owner/repo/issues/123\n" +
+		"- #404 is not in the project; color #123abc and &#123; are not refs\n" +
+		"- `#7` in code\n" +
+		"```\n#123\n```\n"
+	want := "# Plan\n" +
+		"- #123 ✅ Done — Fix login\n" +
+		"- See owner/repo#7 🔄 In Progress — Add SSO, then other/lib#9 ⚪ No status — Dark mode.\n" +
+		"- https://github.com/owner/repo/issues/123 ✅ Done — Fix login\n" +
+		"- #404 is not in the project; color #123abc and &#123; are not refs\n" +
+		"- `#7` in code\n" +
+		"```\n#123\n```\n"
+
+	result := Text(input, "owner/repo", lookup)
+	if result.Text != want {
+		t.Errorf("Unexpected text:\n%s\nwant:\n%s", result.Text, want)
+	}
+	if result.Refs != 4 || result.Changed != 4 {
+		t.Errorf("Expected 4 refs changed, got %d of %d", result.Changed, result.Refs)
+	}
+
+	again := Text(result.Text, "owner/repo", lookup)
+	if again.Text != want || again.Changed != 0 || again.Refs != 4 {
+		t.Errorf("Expected a second pass to change nothing, got %d changes:\n%s", again.Changed, again.Text)
+	}
+}
+
+func TestText_UpdatesStaleAnnotations(t *testing.T) {
+	lookup := testLookup(map[string]Issue{
+		"owner/repo#1": {Title: "Fix login for SSO users", Status: "Done"},
+		"owner/repo#2": {Title: "Export", Status: "In Review"},
+	})
+
+	input := "- #1 🔄 In Progress — Fix login\n" +
+		"| #2 ⬜ Backlog — Export | high |\n"
+	want := "- #1 ✅ Done — Fix login for SSO users\n" +
+		"| #2 👀 In Review — Export | high |\n"
+
+	result := Text(input, "owner/repo", lookup)
+	if result.Text != want || result.Changed != 2 {
+		t.Errorf("Unexpected result (%d changed):\n%s", result.Changed, result.Text)
+	}
+}
+
+func TestText_NoDefaultRepo(t *testing.T) {
+	lookup := testLookup(map[string]Issue{"owner/repo#1": {Title: "One", Status: "Done"}})
+
+	result := Text("#1 and owner/repo#1\n", "", lookup)
+	if result.Text != "#1 and owner/repo#1 ✅ Done — One\n" {
+		t.Errorf("Expected only the qualified ref annotated, got %q", result.Text)
+	}
+}
+
+func TestRefs(t *testing.T) {
+	text := "Merge pull request #20 from owner/sso\n\nFixes #4, other/lib#7 and #4 again\n`#9` is code\n```\n#10\n```\nhttps://github.com/owner/repo/issues/11"
+	got := Refs(text, "owner/repo")
+	want := []Ref{
+		{Owner: "owner", Repo: "repo", Number: 20},
+		{Owner: "owner", Repo: "repo", Number: 4},
+		{Owner: "other", Repo: "lib", Number: 7},
+		{Owner: "owner", Repo: "repo", Number: 11},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Refs() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Refs()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if refs := Refs("Fixes #4 and other/lib#7", ""); len(refs) != 1 || refs[0].Number != 7 {
+		t.Errorf("Expected bare refs skipped without a default repository, got %+v", refs)
+	}
+}
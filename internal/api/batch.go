@@ -0,0 +1,210 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cli/go-gh/v2/pkg/api"
+	graphql "github.com/cli/shurcooL-graphql"
+)
+
+// ItemFieldUpdate is one field value to set on a project item
+type ItemFieldUpdate struct {
+	ItemID string
+	Field  string // field name
+	Value  string
+}
+
+// itemFieldBatchSize caps the mutations sent in one request
+const itemFieldBatchSize = 50
+
+// SetProjectItemFields sets several field values at once. The project's
+// fields are looked up a single time and the mutations are sent together,
+// up to itemFieldBatchSize in a request, when the GraphQL client can run
+// raw documents. It returns one error per update, nil for the updates that
+// succeeded.
+func (c *Client) SetProjectItemFields(projectID string, updates []ItemFieldUpdate) []error {
+	errs := make([]error, len(updates))
+	failAll := func(err error) []error {
+		for i := range errs {
+			errs[i] = err
+		}
+		return errs
+	}
+
+	if len(updates) == 0 {
+		return errs
+	}
+	if c.gql == nil {
+		return failAll(fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?"))
+	}
+
+	fields, err := c.GetProjectFields(projectID)
+	if err != nil {
+		return failAll(fmt.Errorf("failed to get project fields: %w", err))
+	}
+
+	var inputs []UpdateProjectV2ItemFieldValueInput
+	var index []int // update index of each input
+	for i, u := range updates {
+		var field *ProjectField
+		for j := range fields {
+			if fields[j].Name == u.Field {
+				field = &fields[j]
+				break
+			}
+		}
+		if field == nil {
+			errs[i] = fmt.Errorf("field %q not found in project", u.Field)
+			continue
+		}
+
+		value, err := projectFieldValue(field, u.Value)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		inputs = append(inputs, UpdateProjectV2ItemFieldValueInput{
+			ProjectID: graphql.ID(projectID),
+			ItemID:    graphql.ID(u.ItemID),
+			FieldID:   graphql.ID(field.ID),
+			Value:     value,
+		})
+		index = append(index, i)
+	}
+
+	raw, batched := c.gql.(rawGraphQLClient)
+	for start := 0; start < len(inputs); start += itemFieldBatchSize {
+		end := min(start+itemFieldBatchSize, len(inputs))
+
+		var batchErrs []error
+		if batched {
+			batchErrs = updateItemFieldValues(raw, inputs[start:end])
+		} else {
+			batchErrs = c.updateItemFieldValuesEach(inputs[start:end])
+		}
+		for i, err := range batchErrs {
+			errs[index[start+i]] = err
+		}
+	}
+
+	return errs
+}
+
+// projectFieldValue resolves a value for a field, matching single select
+// options and iterations by name
+func projectFieldValue(field *ProjectField, value string) (ProjectV2FieldValue, error) {
+	switch field.DataType {
+	case "SINGLE_SELECT":
+		for _, opt := range field.Options {
+			if opt.Name == value {
+				return ProjectV2FieldValue{SingleSelectOptionId: graphql.String(opt.ID)}, nil
+			}
+		}
+		return ProjectV2FieldValue{}, fmt.Errorf("option %q not found for field %q", value, field.Name)
+	case "TEXT":
+		return ProjectV2FieldValue{Text: graphql.String(value)}, nil
+	case "NUMBER":
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return ProjectV2FieldValue{}, fmt.Errorf("invalid number %q", value)
+		}
+		return ProjectV2FieldValue{Number: graphql.Float(number)}, nil
+	case "DATE":
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			return ProjectV2FieldValue{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", value)
+		}
+		return ProjectV2FieldValue{Date: graphql.String(value)}, nil
+	case "ITERATION":
+		for _, it := range field.Iterations {
+			if strings.EqualFold(it.Title, value) || it.ID == value {
+				return ProjectV2FieldValue{IterationId: graphql.String(it.ID)}, nil
+			}
+		}
+		return ProjectV2FieldValue{}, fmt.Errorf("iteration %q not found for field %q", value, field.Name)
+	default:
+		return ProjectV2FieldValue{}, fmt.Errorf("unsupported field type: %s", field.DataType)
+	}
+}
+
+// updateItemFieldValues sends the inputs as one document of aliased
+// mutations, u0 to uN. GitHub runs each mutation even when another fails,
+// so errors are matched back to their inputs through the alias in their path.
+func updateItemFieldValues(raw rawGraphQLClient, inputs []UpdateProjectV2ItemFieldValueInput) []error {
+	var params, body []string
+	variables := make(map[string]interface{}, len(inputs))
+	for i, input := range inputs {
+		alias := fmt.Sprintf("u%d", i)
+		params = append(params, fmt.Sprintf("$%s: UpdateProjectV2ItemFieldValueInput!", alias))
+		body = append(body, fmt.Sprintf("  %s: updateProjectV2ItemFieldValue(input: $%s) { clientMutationId }", alias, alias))
+		variables[alias] = input
+	}
+	query := fmt.Sprintf("mutation UpdateProjectV2ItemFieldValues(%s) {\n%s\n}", strings.Join(params, ", "), strings.Join(body, "\n"))
+
+	errs := make([]error, len(inputs))
+	var data json.RawMessage
+	err := raw.Do(query, variables, &data)
+	if err == nil {
+		return errs
+	}
+
+	var gqlErr *api.GraphQLError
+	if errors.As(err, &gqlErr) && len(gqlErr.Errors) > 0 {
+		matched := true
+		for _, item := range gqlErr.Errors {
+			i := batchAliasIndex(item.Path)
+			if i < 0 || i >= len(inputs) {
+				matched = false
+				break
+			}
+			errs[i] = fmt.Errorf("failed to set field value: %s", item.Message)
+		}
+		if matched {
+			return errs
+		}
+	}
+
+	// The request as a whole failed
+	for i := range errs {
+		errs[i] = fmt.Errorf("failed to set field value: %w", err)
+	}
+	return errs
+}
+
+// batchAliasIndex returns the input index of an error path starting at a
+// uN alias, or -1
+func batchAliasIndex(path []interface{}) int {
+	if len(path) == 0 {
+		return -1
+	}
+	alias, ok := path[0].(string)
+	if !ok || !strings.HasPrefix(alias, "u") {
+		return -1
+	}
+	i, err := strconv.Atoi(alias[1:])
+	if err != nil {
+		return -1
+	}
+	return i
+}
+
+// updateItemFieldValuesEach sends the inputs one mutation at a time, for
+// GraphQL clients that cannot run raw documents
+func (c *Client) updateItemFieldValuesEach(inputs []UpdateProjectV2ItemFieldValueInput) []error {
+	errs := make([]error, len(inputs))
+	for i, input := range inputs {
+		var mutation struct {
+			UpdateProjectV2ItemFieldValue struct {
+				ClientMutationID string `graphql:"clientMutationId"`
+			} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+		}
+		if err := c.gql.Mutate("UpdateProjectV2ItemFieldValue", &mutation, map[string]interface{}{"input": input}); err != nil {
+			errs[i] = fmt.Errorf("failed to set field value: %w", err)
+		}
+	}
+	return errs
+}
@@ -0,0 +1,151 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	ghapi "github.com/cli/go-gh/v2/pkg/api"
+)
+
+// rawFieldsMockClient serves project fields and runs raw documents
+type rawFieldsMockClient struct {
+	*mockGraphQLClient
+	docs      []string
+	variables []map[string]interface{}
+	doErr     error
+}
+
+func (m *rawFieldsMockClient) Do(query string, variables map[string]interface{}, response interface{}) error {
+	m.docs = append(m.docs, query)
+	m.variables = append(m.variables, variables)
+	if m.doErr != nil {
+		return m.doErr
+	}
+	return json.Unmarshal([]byte(`{}`), response)
+}
+
+func TestSetProjectItemFields_BatchesMutations(t *testing.T) {
+	mock := &rawFieldsMockClient{mockGraphQLClient: createMockWithField("Status", "SINGLE_SELECT", []FieldOption{{ID: "opt-ready", Name: "Ready"}})}
+	client := NewClientWithGraphQL(mock)
+
+	errs := client.SetProjectItemFields("proj-1", []ItemFieldUpdate{
+		{ItemID: "item-1", Field: "Status", Value: "Ready"},
+		{ItemID: "item-2", Field: "Status", Value: "Unknown"},
+		{ItemID: "item-3", Field: "Status", Value: "Ready"},
+		{ItemID: "item-4", Field: "Estimate", Value: "3"},
+	})
+
+	if len(errs) != 4 || errs[0] != nil || errs[2] != nil {
+		t.Fatalf("Expected the valid updates to succeed, got %v", errs)
+	}
+	if errs[1] == nil || !strings.Contains(errs[1].Error(), `option "Unknown" not found`) {
+		t.Errorf("Expected unknown option error, got %v", errs[1])
+	}
+	if errs[3] == nil || !strings.Contains(errs[3].Error(), `field "Estimate" not found`) {
+		t.Errorf("Expected unknown field error, got %v", errs[3])
+	}
+
+	if len(mock.docs) != 1 {
+		t.Fatalf("Expected one request, got %d", len(mock.docs))
+	}
+	doc := mock.docs[0]
+	for _, want := range []string{"$u0: UpdateProjectV2ItemFieldValueInput!", "u0: updateProjectV2ItemFieldValue(input: $u0)", "u1: updateProjectV2ItemFieldValue(input: $u1)"} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("Expected document to contain %q, got:\n%s", want, doc)
+		}
+	}
+	if strings.Contains(doc, "u2:") {
+		t.Errorf("Expected only the valid updates in the document, got:\n%s", doc)
+	}
+	input, ok := mock.variables[0]["u1"].(UpdateProjectV2ItemFieldValueInput)
+	if !ok || input.ItemID != "item-3" || input.Value.SingleSelectOptionId != "opt-ready" {
+		t.Errorf("Expected u1 to set item-3 to the Ready option, got %+v", mock.variables[0]["u1"])
+	}
+}
+
+func TestSetProjectItemFields_SplitsLargeBatches(t *testing.T) {
+	mock := &rawFieldsMockClient{mockGraphQLClient: createMockWithField("Notes", "TEXT", nil)}
+	client := NewClientWithGraphQL(mock)
+
+	updates := make([]ItemFieldUpdate, itemFieldBatchSize+1)
+	for i := range updates {
+		updates[i] = ItemFieldUpdate{ItemID: "item", Field: "Notes", Value: "x"}
+	}
+	for _, err := range client.SetProjectItemFields("proj-1", updates) {
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if len(mock.docs) != 2 {
+		t.Errorf("Expected two requests, got %d", len(mock.docs))
+	}
+}
+
+func TestSetProjectItemFields_MapsErrorsToUpdates(t *testing.T) {
+	mock := &rawFieldsMockClient{
+		mockGraphQLClient: createMockWithField("Notes", "TEXT", nil),
+		doErr: &ghapi.GraphQLError{Errors: []ghapi.GraphQLErrorItem{
+			{Message: "Could not resolve to a node", Path: []interface{}{"u1"}},
+		}},
+	}
+	client := NewClientWithGraphQL(mock)
+
+	errs := client.SetProjectItemFields("proj-1", []ItemFieldUpdate{
+		{ItemID: "item-1", Field: "Notes", Value: "a"},
+		{ItemID: "gone", Field: "Notes", Value: "b"},
+	})
+	if errs[0] != nil {
+		t.Errorf("Expected the first update to succeed, got %v", errs[0])
+	}
+	if errs[1] == nil || !strings.Contains(errs[1].Error(), "Could not resolve") {
+		t.Errorf("Expected the second update to fail, got %v", errs[1])
+	}
+
+	// An error not tied to one mutation fails the whole batch
+	mock.doErr = errors.New("connection reset")
+	errs = client.SetProjectItemFields("proj-1", []ItemFieldUpdate{
+		{ItemID: "item-1", Field: "Notes", Value: "a"},
+		{ItemID: "item-2", Field: "Notes", Value: "b"},
+	})
+	for i, err := range errs {
+		if err == nil || !strings.Contains(err.Error(), "connection reset") {
+			t.Errorf("Expected update %d to fail with the request error, got %v", i, err)
+		}
+	}
+}
+
+func TestSetProjectItemFields_FallsBackToSingleMutations(t *testing.T) {
+	mock := createMockWithField("Estimate", "NUMBER", nil)
+	var mutations int
+	mock.mutateFunc = func(name string, mutation interface{}, variables map[string]interface{}) error {
+		mutations++
+		if variables["input"].(UpdateProjectV2ItemFieldValueInput).ItemID == "item-2" {
+			return errors.New("forbidden")
+		}
+		return nil
+	}
+	client := NewClientWithGraphQL(mock)
+
+	errs := client.SetProjectItemFields("proj-1", []ItemFieldUpdate{
+		{ItemID: "item-1", Field: "Estimate", Value: "3"},
+		{ItemID: "item-2", Field: "Estimate", Value: "5"},
+		{ItemID: "item-3", Field: "Estimate", Value: "lots"},
+	})
+	if mutations != 2 {
+		t.Errorf("Expected one mutation per valid update, got %d", mutations)
+	}
+	if errs[0] != nil || errs[1] == nil || errs[2] == nil {
+		t.Errorf("Unexpected errors: %v", errs)
+	}
+}
+
+func TestSetProjectItemFields_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+
+	errs := client.SetProjectItemFields("proj-1", []ItemFieldUpdate{{ItemID: "item-1", Field: "Status", Value: "Done"}})
+	if len(errs) != 1 || errs[0] == nil {
+		t.Errorf("Expected an error for the update, got %v", errs)
+	}
+}
@@ -1,6 +1,11 @@
 package api
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
 	"github.com/cli/go-gh/v2/pkg/api"
 )
 
@@ -20,6 +25,8 @@ type GraphQLClient interface {
 type Client struct {
 	gql  GraphQLClient
 	opts ClientOptions
+
+	viewerLogin string // Cached login of the authenticated user, for @me
 }
 
 // ClientOptions configures the API client
@@ -32,13 +39,30 @@ type ClientOptions struct {
 
 	// EnableIssueTypes enables the issue_types feature preview
 	EnableIssueTypes bool
+
+	// Timeout limits each API request; zero means no limit
+	Timeout time.Duration
+
+	// Context bounds every request; once it is done, requests fail. Nil
+	// means no bound.
+	Context context.Context
 }
 
+// DefaultRequestTimeout limits each API request made by clients from
+// NewClient; zero means no limit. It is set from --request-timeout.
+var DefaultRequestTimeout time.Duration
+
+// DefaultContext bounds every request made by clients from NewClient; nil
+// means no bound. It is set from --timeout.
+var DefaultContext context.Context
+
 // NewClient creates a new API client with default options
 func NewClient() *Client {
 	return NewClientWithOptions(ClientOptions{
 		EnableSubIssues:  true,
 		EnableIssueTypes: true,
+		Timeout:          DefaultRequestTimeout,
+		Context:          DefaultContext,
 	})
 }
 
@@ -65,6 +89,7 @@ func NewClientWithOptions(opts ClientOptions) *Client {
 	// Create GraphQL client options
 	apiOpts := api.ClientOptions{
 		Headers: headers,
+		Timeout: opts.Timeout,
 	}
 
 	if opts.Host != "" {
@@ -79,12 +104,33 @@ func NewClientWithOptions(opts ClientOptions) *Client {
 		return &Client{opts: opts}
 	}
 
+	if opts.Context != nil {
+		return &Client{gql: contextGraphQLClient{ctx: opts.Context, gql: gql}, opts: opts}
+	}
 	return &Client{
 		gql:  gql,
 		opts: opts,
 	}
 }
 
+// contextGraphQLClient runs every request of a go-gh GraphQL client under ctx
+type contextGraphQLClient struct {
+	ctx context.Context
+	gql *api.GraphQLClient
+}
+
+func (c contextGraphQLClient) Query(name string, query interface{}, variables map[string]interface{}) error {
+	return c.gql.QueryWithContext(c.ctx, name, query, variables)
+}
+
+func (c contextGraphQLClient) Mutate(name string, mutation interface{}, variables map[string]interface{}) error {
+	return c.gql.MutateWithContext(c.ctx, name, mutation, variables)
+}
+
+func (c contextGraphQLClient) Do(query string, variables map[string]interface{}, response interface{}) error {
+	return c.gql.DoWithContext(c.ctx, query, variables, response)
+}
+
 // NewClientWithGraphQL creates a Client with a custom GraphQL client (for testing)
 func NewClientWithGraphQL(gql GraphQLClient) *Client {
 	return &Client{gql: gql}
@@ -101,3 +147,29 @@ func joinFeatures(features []string) string {
 	}
 	return result
 }
+
+// rawGraphQLClient is implemented by GraphQL clients that can execute
+// query documents given as strings rather than structs
+type rawGraphQLClient interface {
+	Do(query string, variables map[string]interface{}, response interface{}) error
+}
+
+// ExecuteGraphQL runs a raw GraphQL document and returns the "data" portion
+// of the response as JSON
+func (c *Client) ExecuteGraphQL(query string, variables map[string]interface{}) (json.RawMessage, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	raw, ok := c.gql.(rawGraphQLClient)
+	if !ok {
+		return nil, fmt.Errorf("GraphQL client does not support raw queries")
+	}
+
+	var data json.RawMessage
+	if err := raw.Do(query, variables, &data); err != nil {
+		return nil, fmt.Errorf("GraphQL request failed: %w", err)
+	}
+
+	return data, nil
+}
@@ -1,6 +1,9 @@
 package api
 
 import (
+	"encoding/json"
+	"errors"
+	"strings"
 	"testing"
 )
 
@@ -80,3 +83,69 @@ func TestJoinFeatures_Multiple(t *testing.T) {
 		t.Errorf("Expected 'sub_issues,issue_types', got '%s'", result)
 	}
 }
+
+// rawMockClient implements both GraphQLClient and rawGraphQLClient
+type rawMockClient struct {
+	queryMockClient
+	doQuery     string
+	doVariables map[string]interface{}
+	doResponse  string
+	doErr       error
+}
+
+func (m *rawMockClient) Do(query string, variables map[string]interface{}, response interface{}) error {
+	m.doQuery = query
+	m.doVariables = variables
+	if m.doErr != nil {
+		return m.doErr
+	}
+	return json.Unmarshal([]byte(m.doResponse), response)
+}
+
+func TestExecuteGraphQL_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+
+	_, err := client.ExecuteGraphQL("query { viewer { login } }", nil)
+
+	if err == nil {
+		t.Fatal("Expected error when gql is nil, got nil")
+	}
+}
+
+func TestExecuteGraphQL_UnsupportedClient(t *testing.T) {
+	client := NewClientWithGraphQL(&queryMockClient{})
+
+	_, err := client.ExecuteGraphQL("query { viewer { login } }", nil)
+
+	if err == nil || !strings.Contains(err.Error(), "does not support raw queries") {
+		t.Errorf("Expected unsupported client error, got: %v", err)
+	}
+}
+
+func TestExecuteGraphQL_ReturnsData(t *testing.T) {
+	mock := &rawMockClient{doResponse: `{"viewer":{"login":"octocat"}}`}
+	client := NewClientWithGraphQL(mock)
+
+	data, err := client.ExecuteGraphQL("query($id: ID!) { node(id: $id) { id } }", map[string]interface{}{"id": "X"})
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(data) != `{"viewer":{"login":"octocat"}}` {
+		t.Errorf("Unexpected data: %s", data)
+	}
+	if mock.doVariables["id"] != "X" {
+		t.Errorf("Expected variables to be passed through, got %v", mock.doVariables)
+	}
+}
+
+func TestExecuteGraphQL_Error(t *testing.T) {
+	mock := &rawMockClient{doErr: errors.New("Field 'nope' doesn't exist")}
+	client := NewClientWithGraphQL(mock)
+
+	_, err := client.ExecuteGraphQL("query { nope }", nil)
+
+	if err == nil || !strings.Contains(err.Error(), "GraphQL request failed") {
+		t.Errorf("Expected wrapped error, got: %v", err)
+	}
+}
@@ -2,6 +2,9 @@ package api
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	graphql "github.com/cli/shurcooL-graphql"
 )
@@ -34,12 +37,20 @@ func (c *Client) CreateIssue(owner, repo, title, body string, labels []string) (
 	var mutation struct {
 		CreateIssue struct {
 			Issue struct {
-				ID     string
-				Number int
-				Title  string
-				Body   string
-				State  string
-				URL    string `graphql:"url"`
+				ID        string
+				Number    int
+				Title     string
+				Body      string
+				State     string
+				URL       string `graphql:"url"`
+				Assignees struct {
+					Nodes []struct {
+						Login string
+					}
+				} `graphql:"assignees(first: 20)"`
+				Milestone *struct {
+					Title string
+				}
 			}
 		} `graphql:"createIssue(input: $input)"`
 	}
@@ -157,11 +168,64 @@ func (c *Client) SetProjectItemField(projectID, itemID, fieldName, value string)
 		return c.setTextField(projectID, itemID, field.ID, value)
 	case "NUMBER":
 		return c.setNumberField(projectID, itemID, field.ID, value)
+	case "DATE":
+		return c.setDateField(projectID, itemID, field.ID, value)
+	case "ITERATION":
+		return c.setIterationField(projectID, itemID, field, value)
 	default:
 		return fmt.Errorf("unsupported field type: %s", field.DataType)
 	}
 }
 
+// ClearProjectItemField removes a field's value from a project item
+func (c *Client) ClearProjectItemField(projectID, itemID, fieldName string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	fields, err := c.GetProjectFields(projectID)
+	if err != nil {
+		return fmt.Errorf("failed to get project fields: %w", err)
+	}
+
+	var fieldID string
+	for _, f := range fields {
+		if f.Name == fieldName {
+			fieldID = f.ID
+			break
+		}
+	}
+	if fieldID == "" {
+		return fmt.Errorf("field %q not found in project", fieldName)
+	}
+
+	var mutation struct {
+		ClearProjectV2ItemFieldValue struct {
+			ClientMutationID string `graphql:"clientMutationId"`
+		} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
+	}
+
+	input := ClearProjectV2ItemFieldValueInput{
+		ProjectID: graphql.ID(projectID),
+		ItemID:    graphql.ID(itemID),
+		FieldID:   graphql.ID(fieldID),
+	}
+
+	err = c.gql.Mutate("ClearProjectV2ItemFieldValue", &mutation, map[string]interface{}{"input": input})
+	if err != nil {
+		return fmt.Errorf("failed to clear field value: %w", err)
+	}
+
+	return nil
+}
+
+// ClearProjectV2ItemFieldValueInput represents the input for clearing a field value
+type ClearProjectV2ItemFieldValueInput struct {
+	ProjectID graphql.ID `json:"projectId"`
+	ItemID    graphql.ID `json:"itemId"`
+	FieldID   graphql.ID `json:"fieldId"`
+}
+
 func (c *Client) setSingleSelectField(projectID, itemID string, field *ProjectField, value string) error {
 	// Find the option ID for the value
 	var optionID string
@@ -232,6 +296,11 @@ func (c *Client) setTextField(projectID, itemID, fieldID, value string) error {
 }
 
 func (c *Client) setNumberField(projectID, itemID, fieldID, value string) error {
+	number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return fmt.Errorf("invalid number %q", value)
+	}
+
 	var mutation struct {
 		UpdateProjectV2ItemFieldValue struct {
 			ClientMutationID string `graphql:"clientMutationId"`
@@ -243,7 +312,7 @@ func (c *Client) setNumberField(projectID, itemID, fieldID, value string) error
 		ItemID:    graphql.ID(itemID),
 		FieldID:   graphql.ID(fieldID),
 		Value: ProjectV2FieldValue{
-			Number: graphql.Float(0), // TODO: parse value to float
+			Number: graphql.Float(number),
 		},
 	}
 
@@ -251,7 +320,7 @@ func (c *Client) setNumberField(projectID, itemID, fieldID, value string) error
 		"input": input,
 	}
 
-	err := c.gql.Mutate("UpdateProjectV2ItemFieldValue", &mutation, variables)
+	err = c.gql.Mutate("UpdateProjectV2ItemFieldValue", &mutation, variables)
 	if err != nil {
 		return fmt.Errorf("failed to set number field value: %w", err)
 	}
@@ -259,6 +328,79 @@ func (c *Client) setNumberField(projectID, itemID, fieldID, value string) error
 	return nil
 }
 
+func (c *Client) setDateField(projectID, itemID, fieldID, value string) error {
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		return fmt.Errorf("invalid date %q: expected YYYY-MM-DD", value)
+	}
+
+	var mutation struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ClientMutationID string `graphql:"clientMutationId"`
+		} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+	}
+
+	input := UpdateProjectV2ItemFieldValueInput{
+		ProjectID: graphql.ID(projectID),
+		ItemID:    graphql.ID(itemID),
+		FieldID:   graphql.ID(fieldID),
+		Value: ProjectV2FieldValue{
+			Date: graphql.String(value),
+		},
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	err := c.gql.Mutate("UpdateProjectV2ItemFieldValue", &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to set date field value: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) setIterationField(projectID, itemID string, field *ProjectField, value string) error {
+	// Match the iteration by title, or by ID
+	var iterationID string
+	for _, it := range field.Iterations {
+		if strings.EqualFold(it.Title, value) || it.ID == value {
+			iterationID = it.ID
+			break
+		}
+	}
+
+	if iterationID == "" {
+		return fmt.Errorf("iteration %q not found for field %q", value, field.Name)
+	}
+
+	var mutation struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ClientMutationID string `graphql:"clientMutationId"`
+		} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+	}
+
+	input := UpdateProjectV2ItemFieldValueInput{
+		ProjectID: graphql.ID(projectID),
+		ItemID:    graphql.ID(itemID),
+		FieldID:   graphql.ID(field.ID),
+		Value: ProjectV2FieldValue{
+			IterationId: graphql.String(iterationID),
+		},
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	err := c.gql.Mutate("UpdateProjectV2ItemFieldValue", &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to set iteration field value: %w", err)
+	}
+
+	return nil
+}
+
 // UpdateProjectV2ItemFieldValueInput represents the input for updating a field value
 type UpdateProjectV2ItemFieldValueInput struct {
 	ProjectID graphql.ID          `json:"projectId"`
@@ -334,8 +476,41 @@ func (c *Client) AddSubIssue(parentIssueID, childIssueID string) error {
 
 // AddSubIssueInput represents the input for adding a sub-issue
 type AddSubIssueInput struct {
-	IssueID    graphql.ID `json:"issueId"`
-	SubIssueID graphql.ID `json:"subIssueId"`
+	IssueID       graphql.ID      `json:"issueId"`
+	SubIssueID    graphql.ID      `json:"subIssueId"`
+	ReplaceParent graphql.Boolean `json:"replaceParent,omitempty"`
+}
+
+// MoveSubIssue links a sub-issue under a new parent, replacing its current
+// parent in the same mutation
+func (c *Client) MoveSubIssue(newParentIssueID, subIssueID string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var mutation struct {
+		AddSubIssue struct {
+			Issue struct {
+				ID string
+			}
+			SubIssue struct {
+				ID string
+			}
+		} `graphql:"addSubIssue(input: $input)"`
+	}
+
+	input := AddSubIssueInput{
+		IssueID:       graphql.ID(newParentIssueID),
+		SubIssueID:    graphql.ID(subIssueID),
+		ReplaceParent: graphql.Boolean(true),
+	}
+
+	err := c.gql.Mutate("MoveSubIssue", &mutation, map[string]interface{}{"input": input})
+	if err != nil {
+		return fmt.Errorf("failed to move sub-issue: %w", err)
+	}
+
+	return nil
 }
 
 // RemoveSubIssue removes a child issue from its parent issue
@@ -378,6 +553,98 @@ type RemoveSubIssueInput struct {
 	SubIssueID graphql.ID `json:"subIssueId"`
 }
 
+// ReprioritizeSubIssue moves a sub-issue within its parent's list of
+// sub-issues, directly after afterID or before beforeID; give exactly one
+func (c *Client) ReprioritizeSubIssue(parentIssueID, subIssueID, afterID, beforeID string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+	if (afterID == "") == (beforeID == "") {
+		return fmt.Errorf("exactly one of afterID and beforeID is required")
+	}
+
+	var mutation struct {
+		ReprioritizeSubIssue struct {
+			Issue struct {
+				ID string
+			}
+		} `graphql:"reprioritizeSubIssue(input: $input)"`
+	}
+
+	input := ReprioritizeSubIssueInput{
+		IssueID:    graphql.ID(parentIssueID),
+		SubIssueID: graphql.ID(subIssueID),
+	}
+	if afterID != "" {
+		id := graphql.ID(afterID)
+		input.AfterID = &id
+	} else {
+		id := graphql.ID(beforeID)
+		input.BeforeID = &id
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	err := c.gql.Mutate("ReprioritizeSubIssue", &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to reorder sub-issue: %w", err)
+	}
+
+	return nil
+}
+
+// ReprioritizeSubIssueInput represents the input for reordering a sub-issue
+type ReprioritizeSubIssueInput struct {
+	IssueID    graphql.ID  `json:"issueId"`
+	SubIssueID graphql.ID  `json:"subIssueId"`
+	AfterID    *graphql.ID `json:"afterId,omitempty"`
+	BeforeID   *graphql.ID `json:"beforeId,omitempty"`
+}
+
+// UpdateProjectItemPosition moves a project item to directly after afterID,
+// or to the top of the project when afterID is empty. Views without a sort
+// show items in this order.
+func (c *Client) UpdateProjectItemPosition(projectID, itemID, afterID string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var mutation struct {
+		UpdateProjectV2ItemPosition struct {
+			ClientMutationID string
+		} `graphql:"updateProjectV2ItemPosition(input: $input)"`
+	}
+
+	input := UpdateProjectV2ItemPositionInput{
+		ProjectID: graphql.ID(projectID),
+		ItemID:    graphql.ID(itemID),
+	}
+	if afterID != "" {
+		id := graphql.ID(afterID)
+		input.AfterID = &id
+	}
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	err := c.gql.Mutate("UpdateProjectV2ItemPosition", &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to update item position: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateProjectV2ItemPositionInput represents the input for moving a project item
+type UpdateProjectV2ItemPositionInput struct {
+	ProjectID graphql.ID  `json:"projectId"`
+	ItemID    graphql.ID  `json:"itemId"`
+	AfterID   *graphql.ID `json:"afterId,omitempty"`
+}
+
 // AddLabelToIssue adds a label to an issue
 func (c *Client) AddLabelToIssue(issueID, labelName string) error {
 	if c.gql == nil {
@@ -500,13 +767,18 @@ func (c *Client) CreateIssueWithOptions(owner, repo, title, body string, labels,
 		}
 	}
 
-	// Get assignee IDs
+	// Get assignee IDs; @me is the authenticated user
 	var assigneeIDs []graphql.ID
 	if len(assignees) > 0 {
 		for _, login := range assignees {
+			login, err := c.ResolveOwner(login)
+			if err != nil {
+				return nil, err
+			}
 			userID, err := c.getUserID(login)
 			if err != nil {
-				// Skip users that don't exist
+				// Non-fatal, just warn
+				fmt.Printf("Warning: assignee %q not found\n", login)
 				continue
 			}
 			assigneeIDs = append(assigneeIDs, graphql.ID(userID))
@@ -529,12 +801,25 @@ func (c *Client) CreateIssueWithOptions(owner, repo, title, body string, labels,
 	var mutation struct {
 		CreateIssue struct {
 			Issue struct {
-				ID     string
-				Number int
-				Title  string
-				Body   string
-				State  string
-				URL    string `graphql:"url"`
+				ID        string
+				Number    int
+				Title     string
+				Body      string
+				State     string
+				URL       string `graphql:"url"`
+				Assignees struct {
+					Nodes []struct {
+						Login string
+					}
+				} `graphql:"assignees(first: 20)"`
+				Labels struct {
+					Nodes []struct {
+						Name string
+					}
+				} `graphql:"labels(first: 20)"`
+				Milestone *struct {
+					Title string
+				}
 			}
 		} `graphql:"createIssue(input: $input)"`
 	}
@@ -565,7 +850,7 @@ func (c *Client) CreateIssueWithOptions(owner, repo, title, body string, labels,
 		return nil, fmt.Errorf("failed to create issue: %w", err)
 	}
 
-	return &Issue{
+	issue := &Issue{
 		ID:     mutation.CreateIssue.Issue.ID,
 		Number: mutation.CreateIssue.Issue.Number,
 		Title:  mutation.CreateIssue.Issue.Title,
@@ -576,5 +861,528 @@ func (c *Client) CreateIssueWithOptions(owner, repo, title, body string, labels,
 			Owner: owner,
 			Name:  repo,
 		},
+	}
+	for _, a := range mutation.CreateIssue.Issue.Assignees.Nodes {
+		issue.Assignees = append(issue.Assignees, Actor{Login: a.Login})
+	}
+	for _, l := range mutation.CreateIssue.Issue.Labels.Nodes {
+		issue.Labels = append(issue.Labels, Label{Name: l.Name})
+	}
+	if m := mutation.CreateIssue.Issue.Milestone; m != nil {
+		issue.Milestone = &Milestone{Title: m.Title}
+	}
+
+	return issue, nil
+}
+
+// IssueUpdate holds the issue content changed by UpdateIssue. Nil fields
+// are left alone; Labels and Assignees replace the issue's full set.
+type IssueUpdate struct {
+	Title     *string
+	Body      *string
+	Labels    []string
+	Assignees []string // logins; @me is the authenticated user
+}
+
+// UpdateIssueInput represents the input for updating an issue
+type UpdateIssueInput struct {
+	ID          graphql.ID      `json:"id"`
+	Title       *graphql.String `json:"title,omitempty"`
+	Body        *graphql.String `json:"body,omitempty"`
+	LabelIDs    *[]graphql.ID   `json:"labelIds,omitempty"`
+	AssigneeIDs *[]graphql.ID   `json:"assigneeIds,omitempty"`
+}
+
+// UpdateIssue applies an IssueUpdate to an issue in owner/repo in a single
+// mutation. Unknown labels and users are errors, so nothing is changed
+// when any of them cannot be resolved.
+func (c *Client) UpdateIssue(owner, repo, issueID string, update IssueUpdate) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	input := UpdateIssueInput{ID: graphql.ID(issueID)}
+	if update.Title != nil {
+		title := graphql.String(*update.Title)
+		input.Title = &title
+	}
+	if update.Body != nil {
+		body := graphql.String(*update.Body)
+		input.Body = &body
+	}
+
+	if update.Labels != nil {
+		labelIDs := []graphql.ID{}
+		for _, name := range update.Labels {
+			id, err := c.getLabelID(owner, repo, name)
+			if err != nil {
+				return err
+			}
+			labelIDs = append(labelIDs, graphql.ID(id))
+		}
+		input.LabelIDs = &labelIDs
+	}
+
+	if update.Assignees != nil {
+		assigneeIDs := []graphql.ID{}
+		for _, login := range update.Assignees {
+			login, err := c.ResolveOwner(login)
+			if err != nil {
+				return err
+			}
+			id, err := c.getUserID(login)
+			if err != nil {
+				return err
+			}
+			assigneeIDs = append(assigneeIDs, graphql.ID(id))
+		}
+		input.AssigneeIDs = &assigneeIDs
+	}
+
+	var mutation struct {
+		UpdateIssue struct {
+			Issue struct {
+				ID string
+			}
+		} `graphql:"updateIssue(input: $input)"`
+	}
+
+	err := c.gql.Mutate("UpdateIssue", &mutation, map[string]interface{}{"input": input})
+	if err != nil {
+		return fmt.Errorf("failed to update issue: %w", err)
+	}
+
+	return nil
+}
+
+// PullRequest represents a pull request created by gh-pmu
+type PullRequest struct {
+	ID         string
+	Number     int
+	Title      string
+	URL        string
+	BaseRef    string
+	HeadRef    string
+	Repository Repository
+}
+
+// CreatePullRequestInput represents the input for creating a pull request
+type CreatePullRequestInput struct {
+	RepositoryID graphql.ID      `json:"repositoryId"`
+	BaseRefName  graphql.String  `json:"baseRefName"`
+	HeadRefName  graphql.String  `json:"headRefName"`
+	Title        graphql.String  `json:"title"`
+	Body         graphql.String  `json:"body,omitempty"`
+	Draft        graphql.Boolean `json:"draft"`
+}
+
+// CreatePullRequest opens a pull request from head into base. An empty
+// base targets the repository's default branch.
+func (c *Client) CreatePullRequest(owner, repo, base, head, title, body string, draft bool) (*PullRequest, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Repository struct {
+			ID               string
+			DefaultBranchRef struct {
+				Name string
+			}
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": graphql.String(owner),
+		"repo":  graphql.String(repo),
+	}
+
+	if err := c.gql.Query("GetRepositoryDefaultBranch", &query, variables); err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+
+	if base == "" {
+		base = query.Repository.DefaultBranchRef.Name
+		if base == "" {
+			return nil, fmt.Errorf("repository %s/%s has no default branch; specify a base branch", owner, repo)
+		}
+	}
+
+	var mutation struct {
+		CreatePullRequest struct {
+			PullRequest struct {
+				ID     string
+				Number int
+				Title  string
+				URL    string `graphql:"url"`
+			}
+		} `graphql:"createPullRequest(input: $input)"`
+	}
+
+	input := CreatePullRequestInput{
+		RepositoryID: graphql.ID(query.Repository.ID),
+		BaseRefName:  graphql.String(base),
+		HeadRefName:  graphql.String(head),
+		Title:        graphql.String(title),
+		Draft:        graphql.Boolean(draft),
+	}
+	if body != "" {
+		input.Body = graphql.String(body)
+	}
+
+	err := c.gql.Mutate("CreatePullRequest", &mutation, map[string]interface{}{"input": input})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pull request: %w", err)
+	}
+
+	pr := mutation.CreatePullRequest.PullRequest
+	return &PullRequest{
+		ID:      pr.ID,
+		Number:  pr.Number,
+		Title:   pr.Title,
+		URL:     pr.URL,
+		BaseRef: base,
+		HeadRef: head,
+		Repository: Repository{
+			Owner: owner,
+			Name:  repo,
+		},
+	}, nil
+}
+
+// AddIssueComment posts a comment on an issue and returns the comment URL
+func (c *Client) AddIssueComment(issueID, body string) (string, error) {
+	if c.gql == nil {
+		return "", fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var mutation struct {
+		AddComment struct {
+			CommentEdge struct {
+				Node struct {
+					URL string `graphql:"url"`
+				}
+			}
+		} `graphql:"addComment(input: $input)"`
+	}
+
+	input := AddCommentInput{
+		SubjectID: graphql.ID(issueID),
+		Body:      graphql.String(body),
+	}
+
+	err := c.gql.Mutate("AddComment", &mutation, map[string]interface{}{"input": input})
+	if err != nil {
+		return "", fmt.Errorf("failed to add comment: %w", err)
+	}
+
+	return mutation.AddComment.CommentEdge.Node.URL, nil
+}
+
+// AddCommentInput represents the input for commenting on an issue
+type AddCommentInput struct {
+	SubjectID graphql.ID     `json:"subjectId"`
+	Body      graphql.String `json:"body"`
+}
+
+// Discussion represents a discussion created by gh-pmu
+type Discussion struct {
+	ID         string
+	Number     int
+	Title      string
+	URL        string
+	Category   string
+	Repository Repository
+}
+
+// CreateDiscussionInput represents the input for creating a discussion
+type CreateDiscussionInput struct {
+	RepositoryID graphql.ID     `json:"repositoryId"`
+	CategoryID   graphql.ID     `json:"categoryId"`
+	Title        graphql.String `json:"title"`
+	Body         graphql.String `json:"body"`
+}
+
+// CreateDiscussion starts a discussion in the category named category
+// (matched by name or slug, case-insensitively)
+func (c *Client) CreateDiscussion(owner, repo, category, title, body string) (*Discussion, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Repository struct {
+			ID                   string
+			DiscussionCategories struct {
+				Nodes []struct {
+					ID   string
+					Name string
+					Slug string
+				}
+			} `graphql:"discussionCategories(first: 25)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": graphql.String(owner),
+		"repo":  graphql.String(repo),
+	}
+
+	if err := c.gql.Query("GetDiscussionCategories", &query, variables); err != nil {
+		return nil, fmt.Errorf("failed to get discussion categories: %w", err)
+	}
+
+	categoryID, categoryName := "", ""
+	var names []string
+	for _, node := range query.Repository.DiscussionCategories.Nodes {
+		names = append(names, node.Name)
+		if strings.EqualFold(node.Name, category) || strings.EqualFold(node.Slug, category) {
+			categoryID, categoryName = node.ID, node.Name
+		}
+	}
+	if categoryID == "" {
+		if len(names) == 0 {
+			return nil, fmt.Errorf("repository %s/%s has no discussion categories; are discussions enabled?", owner, repo)
+		}
+		return nil, fmt.Errorf("discussion category %q not found in %s/%s (available: %s)", category, owner, repo, strings.Join(names, ", "))
+	}
+
+	var mutation struct {
+		CreateDiscussion struct {
+			Discussion struct {
+				ID     string
+				Number int
+				Title  string
+				URL    string `graphql:"url"`
+			}
+		} `graphql:"createDiscussion(input: $input)"`
+	}
+
+	input := CreateDiscussionInput{
+		RepositoryID: graphql.ID(query.Repository.ID),
+		CategoryID:   graphql.ID(categoryID),
+		Title:        graphql.String(title),
+		Body:         graphql.String(body),
+	}
+
+	err := c.gql.Mutate("CreateDiscussion", &mutation, map[string]interface{}{"input": input})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create discussion: %w", err)
+	}
+
+	d := mutation.CreateDiscussion.Discussion
+	return &Discussion{
+		ID:       d.ID,
+		Number:   d.Number,
+		Title:    d.Title,
+		URL:      d.URL,
+		Category: categoryName,
+		Repository: Repository{
+			Owner: owner,
+			Name:  repo,
+		},
 	}, nil
 }
+
+// UpdateSubscription sets the authenticated user's notification
+// subscription for an issue. state is SUBSCRIBED, UNSUBSCRIBED, or IGNORED.
+func (c *Client) UpdateSubscription(subscribableID, state string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var mutation struct {
+		UpdateSubscription struct {
+			Subscribable struct {
+				ViewerSubscription string `graphql:"viewerSubscription"`
+			} `graphql:"subscribable"`
+		} `graphql:"updateSubscription(input: $input)"`
+	}
+
+	input := UpdateSubscriptionInput{
+		SubscribableID: graphql.ID(subscribableID),
+		State:          graphql.String(state),
+	}
+
+	err := c.gql.Mutate("UpdateSubscription", &mutation, map[string]interface{}{"input": input})
+	if err != nil {
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSubscriptionInput represents the input for changing a subscription
+type UpdateSubscriptionInput struct {
+	SubscribableID graphql.ID     `json:"subscribableId"`
+	State          graphql.String `json:"state"`
+}
+
+// CloseIssue closes an issue as completed
+func (c *Client) CloseIssue(issueID string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var mutation struct {
+		CloseIssue struct {
+			Issue struct {
+				State string
+			}
+		} `graphql:"closeIssue(input: $input)"`
+	}
+
+	input := CloseIssueInput{
+		IssueID:     graphql.ID(issueID),
+		StateReason: graphql.String("COMPLETED"),
+	}
+
+	err := c.gql.Mutate("CloseIssue", &mutation, map[string]interface{}{"input": input})
+	if err != nil {
+		return fmt.Errorf("failed to close issue: %w", err)
+	}
+
+	return nil
+}
+
+// CloseIssueInput represents the input for closing an issue
+type CloseIssueInput struct {
+	IssueID     graphql.ID     `json:"issueId"`
+	StateReason graphql.String `json:"stateReason,omitempty"`
+}
+
+// ReopenIssue reopens a closed issue
+func (c *Client) ReopenIssue(issueID string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var mutation struct {
+		ReopenIssue struct {
+			Issue struct {
+				State string
+			}
+		} `graphql:"reopenIssue(input: $input)"`
+	}
+
+	input := ReopenIssueInput{IssueID: graphql.ID(issueID)}
+
+	err := c.gql.Mutate("ReopenIssue", &mutation, map[string]interface{}{"input": input})
+	if err != nil {
+		return fmt.Errorf("failed to reopen issue: %w", err)
+	}
+
+	return nil
+}
+
+// ReopenIssueInput represents the input for reopening an issue
+type ReopenIssueInput struct {
+	IssueID graphql.ID `json:"issueId"`
+}
+
+// DeleteProjectItem removes an item from a project. The issue itself is
+// not changed.
+func (c *Client) DeleteProjectItem(projectID, itemID string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var mutation struct {
+		DeleteProjectV2Item struct {
+			DeletedItemID string `graphql:"deletedItemId"`
+		} `graphql:"deleteProjectV2Item(input: $input)"`
+	}
+
+	input := DeleteProjectV2ItemInput{
+		ProjectID: graphql.ID(projectID),
+		ItemID:    graphql.ID(itemID),
+	}
+
+	err := c.gql.Mutate("DeleteProjectV2Item", &mutation, map[string]interface{}{"input": input})
+	if err != nil {
+		return fmt.Errorf("failed to remove item from project: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteProjectV2ItemInput represents the input for removing a project item
+type DeleteProjectV2ItemInput struct {
+	ProjectID graphql.ID `json:"projectId"`
+	ItemID    graphql.ID `json:"itemId"`
+}
+
+// AddIssueAssignees assigns users to an issue, keeping its other assignees.
+// @me is the authenticated user.
+func (c *Client) AddIssueAssignees(issueID string, logins []string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	ids, err := c.assigneeIDs(logins)
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		AddAssigneesToAssignable struct {
+			ClientMutationID string `graphql:"clientMutationId"`
+		} `graphql:"addAssigneesToAssignable(input: $input)"`
+	}
+
+	input := AssigneesInput{AssignableID: graphql.ID(issueID), AssigneeIDs: ids}
+	if err := c.gql.Mutate("AddAssigneesToAssignable", &mutation, map[string]interface{}{"input": input}); err != nil {
+		return fmt.Errorf("failed to add assignees: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveIssueAssignees unassigns users from an issue, keeping its other
+// assignees. @me is the authenticated user.
+func (c *Client) RemoveIssueAssignees(issueID string, logins []string) error {
+	if c.gql == nil {
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	ids, err := c.assigneeIDs(logins)
+	if err != nil {
+		return err
+	}
+
+	var mutation struct {
+		RemoveAssigneesFromAssignable struct {
+			ClientMutationID string `graphql:"clientMutationId"`
+		} `graphql:"removeAssigneesFromAssignable(input: $input)"`
+	}
+
+	input := AssigneesInput{AssignableID: graphql.ID(issueID), AssigneeIDs: ids}
+	if err := c.gql.Mutate("RemoveAssigneesFromAssignable", &mutation, map[string]interface{}{"input": input}); err != nil {
+		return fmt.Errorf("failed to remove assignees: %w", err)
+	}
+
+	return nil
+}
+
+// assigneeIDs resolves logins, with @me for the authenticated user, to user IDs
+func (c *Client) assigneeIDs(logins []string) ([]graphql.ID, error) {
+	ids := []graphql.ID{}
+	for _, login := range logins {
+		login, err := c.ResolveOwner(login)
+		if err != nil {
+			return nil, err
+		}
+		id, err := c.getUserID(login)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, graphql.ID(id))
+	}
+	return ids, nil
+}
+
+// AssigneesInput represents the input for adding or removing assignees
+type AssigneesInput struct {
+	AssignableID graphql.ID   `json:"assignableId"`
+	AssigneeIDs  []graphql.ID `json:"assigneeIds"`
+}
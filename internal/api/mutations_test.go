@@ -5,6 +5,8 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+
+	graphql "github.com/cli/shurcooL-graphql"
 )
 
 // ============================================================================
@@ -262,11 +264,92 @@ func TestSetProjectItemField_NumberField_Success(t *testing.T) {
 	}
 }
 
+func TestSetProjectItemField_NumberField_SendsParsedValue(t *testing.T) {
+	mock := createMockWithField("Points", "NUMBER", nil)
+	var got graphql.Float
+	mock.mutateFunc = func(name string, mutation interface{}, variables map[string]interface{}) error {
+		got = variables["input"].(UpdateProjectV2ItemFieldValueInput).Value.Number
+		return nil
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.SetProjectItemField("proj-id", "item-id", "Points", "2.5"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != 2.5 {
+		t.Errorf("Expected number 2.5, got %v", got)
+	}
+
+	if err := client.SetProjectItemField("proj-id", "item-id", "Points", "lots"); err == nil || !strings.Contains(err.Error(), "invalid number") {
+		t.Errorf("Expected invalid number error, got %v", err)
+	}
+}
+
+func TestSetProjectItemField_DateField(t *testing.T) {
+	mock := createMockWithField("Target date", "DATE", nil)
+	var got graphql.String
+	mock.mutateFunc = func(name string, mutation interface{}, variables map[string]interface{}) error {
+		got = variables["input"].(UpdateProjectV2ItemFieldValueInput).Value.Date
+		return nil
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.SetProjectItemField("proj-id", "item-id", "Target date", "2024-01-15"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != "2024-01-15" {
+		t.Errorf("Expected date 2024-01-15, got %q", got)
+	}
+
+	if err := client.SetProjectItemField("proj-id", "item-id", "Target date", "15/01/2024"); err == nil || !strings.Contains(err.Error(), "invalid date") {
+		t.Errorf("Expected invalid date error, got %v", err)
+	}
+}
+
+func TestSetProjectItemField_IterationField(t *testing.T) {
+	var got graphql.String
+	mock := &mockGraphQLClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			nodes := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").FieldByName("Fields").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+			node := newNodes.Index(0)
+			node.FieldByName("TypeName").SetString("ProjectV2IterationField")
+			field := node.FieldByName("ProjectV2IterationField")
+			field.FieldByName("ID").SetString("field-it")
+			field.FieldByName("Name").SetString("Sprint")
+			field.FieldByName("DataType").SetString("ITERATION")
+			iterations := field.FieldByName("Configuration").FieldByName("Iterations")
+			newIterations := reflect.MakeSlice(iterations.Type(), 1, 1)
+			newIterations.Index(0).FieldByName("ID").SetString("it-7")
+			newIterations.Index(0).FieldByName("Title").SetString("Sprint 7")
+			iterations.Set(newIterations)
+			nodes.Set(newNodes)
+			return nil
+		},
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			got = variables["input"].(UpdateProjectV2ItemFieldValueInput).Value.IterationId
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.SetProjectItemField("proj-id", "item-id", "Sprint", "sprint 7"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != "it-7" {
+		t.Errorf("Expected iteration it-7, got %q", got)
+	}
+
+	if err := client.SetProjectItemField("proj-id", "item-id", "Sprint", "Sprint 9"); err == nil || !strings.Contains(err.Error(), "iteration \"Sprint 9\" not found") {
+		t.Errorf("Expected iteration not found error, got %v", err)
+	}
+}
+
 func TestSetProjectItemField_UnsupportedFieldType(t *testing.T) {
-	mock := createMockWithField("Date", "DATE", nil)
+	mock := createMockWithField("Assignees", "ASSIGNEES", nil)
 
 	client := NewClientWithGraphQL(mock)
-	err := client.SetProjectItemField("proj-id", "item-id", "Date", "2024-01-15")
+	err := client.SetProjectItemField("proj-id", "item-id", "Assignees", "alice")
 
 	if err == nil {
 		t.Fatal("Expected error for unsupported field type")
@@ -520,6 +603,64 @@ func TestCreateIssue_WithLabels_SkipsInvalidLabels(t *testing.T) {
 	}
 }
 
+func TestCreateIssueWithOptions_ResolvesMeAndReturnsAssignees(t *testing.T) {
+	var userLogins []interface{}
+	mock := &mockGraphQLClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			v := reflect.ValueOf(query).Elem()
+			switch name {
+			case "GetViewer":
+				v.FieldByName("Viewer").FieldByName("Login").SetString("octocat")
+			case "GetUserID":
+				userLogins = append(userLogins, variables["login"])
+				if variables["login"] == graphql.String("ghost") {
+					return errors.New("not found")
+				}
+				v.FieldByName("User").FieldByName("ID").SetString("user-id")
+			}
+			return nil
+		},
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			issue := reflect.ValueOf(mutation).Elem().FieldByName("CreateIssue").FieldByName("Issue")
+			issue.FieldByName("Number").SetInt(7)
+
+			nodes := issue.FieldByName("Assignees").FieldByName("Nodes")
+			node := reflect.New(nodes.Type().Elem()).Elem()
+			node.FieldByName("Login").SetString("octocat")
+			nodes.Set(reflect.Append(nodes, node))
+
+			labels := issue.FieldByName("Labels").FieldByName("Nodes")
+			label := reflect.New(labels.Type().Elem()).Elem()
+			label.FieldByName("Name").SetString("bug")
+			labels.Set(reflect.Append(labels, label))
+
+			milestone := issue.FieldByName("Milestone")
+			milestone.Set(reflect.New(milestone.Type().Elem()))
+			milestone.Elem().FieldByName("Title").SetString("v1.0")
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	issue, err := client.CreateIssueWithOptions("owner", "repo", "title", "", nil, []string{"@me", "ghost"}, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(userLogins) != 2 || userLogins[0] != graphql.String("octocat") {
+		t.Errorf("Expected @me to resolve to octocat, looked up %v", userLogins)
+	}
+	if len(issue.Assignees) != 1 || issue.Assignees[0].Login != "octocat" {
+		t.Errorf("Unexpected assignees: %+v", issue.Assignees)
+	}
+	if len(issue.Labels) != 1 || issue.Labels[0].Name != "bug" {
+		t.Errorf("Unexpected labels: %+v", issue.Labels)
+	}
+	if issue.Milestone == nil || issue.Milestone.Title != "v1.0" {
+		t.Errorf("Unexpected milestone: %+v", issue.Milestone)
+	}
+}
+
 // ============================================================================
 // getLabelID Tests with Mocking
 // ============================================================================
@@ -731,3 +872,560 @@ func TestCreateIssueInput_OptionalFields(t *testing.T) {
 	}
 	_ = milestoneID // Verify it can be assigned
 }
+
+func TestCreatePullRequest_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+
+	_, err := client.CreatePullRequest("owner", "repo", "", "feature", "title", "", false)
+	if err == nil {
+		t.Fatal("Expected error when gql is nil")
+	}
+}
+
+func TestCreatePullRequest_UsesDefaultBranch(t *testing.T) {
+	var input CreatePullRequestInput
+	mock := &mockGraphQLClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			repo := reflect.ValueOf(query).Elem().FieldByName("Repository")
+			repo.FieldByName("ID").SetString("repo-id")
+			repo.FieldByName("DefaultBranchRef").FieldByName("Name").SetString("main")
+			return nil
+		},
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "CreatePullRequest" {
+				t.Errorf("Expected mutation name 'CreatePullRequest', got '%s'", name)
+			}
+			input = variables["input"].(CreatePullRequestInput)
+			pr := reflect.ValueOf(mutation).Elem().FieldByName("CreatePullRequest").FieldByName("PullRequest")
+			pr.FieldByName("Number").SetInt(12)
+			pr.FieldByName("URL").SetString("https://github.com/owner/repo/pull/12")
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	pr, err := client.CreatePullRequest("owner", "repo", "", "feature", "Add toggle", "Closes #42", true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if input.RepositoryID != graphql.ID("repo-id") || input.BaseRefName != "main" || input.HeadRefName != "feature" {
+		t.Errorf("Unexpected input: %+v", input)
+	}
+	if input.Body != "Closes #42" || input.Draft != true {
+		t.Errorf("Expected body and draft to be sent, got %+v", input)
+	}
+	if pr.Number != 12 || pr.BaseRef != "main" || pr.Repository.Name != "repo" {
+		t.Errorf("Unexpected pull request: %+v", pr)
+	}
+}
+
+func TestCreatePullRequest_Errors(t *testing.T) {
+	client := NewClientWithGraphQL(&mockGraphQLClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return nil
+		},
+	})
+	if _, err := client.CreatePullRequest("owner", "repo", "", "feature", "t", "", false); err == nil || !strings.Contains(err.Error(), "no default branch") {
+		t.Errorf("Expected default branch error, got %v", err)
+	}
+
+	client = NewClientWithGraphQL(&mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			return errors.New("head sha can't be blank")
+		},
+	})
+	if _, err := client.CreatePullRequest("owner", "repo", "main", "feature", "t", "", false); err == nil || !strings.Contains(err.Error(), "failed to create pull request") {
+		t.Errorf("Expected mutation error, got %v", err)
+	}
+}
+
+func TestAddIssueComment(t *testing.T) {
+	var input AddCommentInput
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "AddComment" {
+				t.Errorf("Expected mutation name 'AddComment', got '%s'", name)
+			}
+			input = variables["input"].(AddCommentInput)
+			v := reflect.ValueOf(mutation).Elem()
+			v.FieldByName("AddComment").FieldByName("CommentEdge").FieldByName("Node").FieldByName("URL").
+				SetString("https://github.com/owner/repo/issues/42#issuecomment-1")
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	url, err := client.AddIssueComment("issue-id", "Progress update")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if input.SubjectID != graphql.ID("issue-id") || input.Body != "Progress update" {
+		t.Errorf("Unexpected input: %+v", input)
+	}
+	if !strings.HasSuffix(url, "#issuecomment-1") {
+		t.Errorf("Unexpected comment URL: %s", url)
+	}
+}
+
+func TestAddIssueComment_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+
+	if _, err := client.AddIssueComment("issue-id", "body"); err == nil || !strings.Contains(err.Error(), "GraphQL client not initialized") {
+		t.Errorf("Expected 'GraphQL client not initialized' error, got: %v", err)
+	}
+}
+
+func TestUpdateSubscription(t *testing.T) {
+	var input UpdateSubscriptionInput
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "UpdateSubscription" {
+				t.Errorf("Expected mutation name 'UpdateSubscription', got '%s'", name)
+			}
+			input = variables["input"].(UpdateSubscriptionInput)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.UpdateSubscription("issue-id", "UNSUBSCRIBED"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if input.SubscribableID != graphql.ID("issue-id") || input.State != "UNSUBSCRIBED" {
+		t.Errorf("Unexpected input: %+v", input)
+	}
+}
+
+func TestUpdateSubscription_Error(t *testing.T) {
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			return errors.New("not found")
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.UpdateSubscription("issue-id", "SUBSCRIBED"); err == nil || !strings.Contains(err.Error(), "failed to update subscription") {
+		t.Errorf("Expected wrapped error, got: %v", err)
+	}
+
+	client = &Client{gql: nil}
+	if err := client.UpdateSubscription("issue-id", "SUBSCRIBED"); err == nil || !strings.Contains(err.Error(), "GraphQL client not initialized") {
+		t.Errorf("Expected 'GraphQL client not initialized' error, got: %v", err)
+	}
+}
+
+func TestClearProjectItemField(t *testing.T) {
+	var input ClearProjectV2ItemFieldValueInput
+	mock := &mockGraphQLClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			nodes := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").FieldByName("Fields").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+			node := newNodes.Index(0)
+			node.FieldByName("TypeName").SetString("ProjectV2IterationField")
+			field := node.FieldByName("ProjectV2IterationField")
+			field.FieldByName("ID").SetString("field-it")
+			field.FieldByName("Name").SetString("Sprint")
+			field.FieldByName("DataType").SetString("ITERATION")
+			nodes.Set(newNodes)
+			return nil
+		},
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "ClearProjectV2ItemFieldValue" {
+				t.Errorf("Expected mutation name 'ClearProjectV2ItemFieldValue', got '%s'", name)
+			}
+			input = variables["input"].(ClearProjectV2ItemFieldValueInput)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.ClearProjectItemField("proj-id", "item-id", "Sprint"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if input.FieldID != graphql.ID("field-it") || input.ItemID != graphql.ID("item-id") {
+		t.Errorf("Unexpected input: %+v", input)
+	}
+
+	if err := client.ClearProjectItemField("proj-id", "item-id", "Missing"); err == nil || !strings.Contains(err.Error(), `field "Missing" not found`) {
+		t.Errorf("Expected field not found error, got %v", err)
+	}
+
+	client = &Client{gql: nil}
+	if err := client.ClearProjectItemField("proj-id", "item-id", "Sprint"); err == nil || !strings.Contains(err.Error(), "GraphQL client not initialized") {
+		t.Errorf("Expected 'GraphQL client not initialized' error, got: %v", err)
+	}
+}
+
+func TestUpdateIssue(t *testing.T) {
+	var input UpdateIssueInput
+	mutations := 0
+	mock := &mockGraphQLClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			v := reflect.ValueOf(query).Elem()
+			switch name {
+			case "GetLabelID":
+				label := string(variables["labelName"].(graphql.String))
+				if label == "missing" {
+					return nil
+				}
+				v.FieldByName("Repository").FieldByName("Label").FieldByName("ID").SetString("L_" + label)
+			case "GetUserID":
+				v.FieldByName("User").FieldByName("ID").SetString("U_" + string(variables["login"].(graphql.String)))
+			}
+			return nil
+		},
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "UpdateIssue" {
+				t.Errorf("Expected mutation name 'UpdateIssue', got '%s'", name)
+			}
+			mutations++
+			input = variables["input"].(UpdateIssueInput)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	title := "New title"
+	err := client.UpdateIssue("owner", "repo", "I_1", IssueUpdate{Title: &title, Labels: []string{"bug", "ui"}, Assignees: []string{}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if input.ID != graphql.ID("I_1") || input.Title == nil || *input.Title != "New title" || input.Body != nil {
+		t.Errorf("Unexpected input: %+v", input)
+	}
+	if input.LabelIDs == nil || len(*input.LabelIDs) != 2 || (*input.LabelIDs)[1] != graphql.ID("L_ui") {
+		t.Errorf("Expected label IDs, got %v", input.LabelIDs)
+	}
+	if input.AssigneeIDs == nil || len(*input.AssigneeIDs) != 0 {
+		t.Errorf("Expected assignees to be cleared, got %v", input.AssigneeIDs)
+	}
+
+	// An unknown label fails before the issue is changed
+	err = client.UpdateIssue("owner", "repo", "I_1", IssueUpdate{Labels: []string{"missing"}})
+	if err == nil || !strings.Contains(err.Error(), `label "missing" not found`) {
+		t.Errorf("Expected label not found error, got %v", err)
+	}
+	if mutations != 1 {
+		t.Errorf("Expected a single mutation, got %d", mutations)
+	}
+}
+
+func TestUpdateIssue_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+	if err := client.UpdateIssue("owner", "repo", "I_1", IssueUpdate{}); err == nil || !strings.Contains(err.Error(), "GraphQL client not initialized") {
+		t.Errorf("Expected 'GraphQL client not initialized' error, got: %v", err)
+	}
+}
+
+func TestCloseIssue(t *testing.T) {
+	var input CloseIssueInput
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "CloseIssue" {
+				t.Errorf("Expected mutation name 'CloseIssue', got '%s'", name)
+			}
+			input = variables["input"].(CloseIssueInput)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.CloseIssue("issue-id"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if input.IssueID != graphql.ID("issue-id") || input.StateReason != "COMPLETED" {
+		t.Errorf("Unexpected input: %+v", input)
+	}
+}
+
+func TestReopenIssue(t *testing.T) {
+	var input ReopenIssueInput
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "ReopenIssue" {
+				t.Errorf("Expected mutation name 'ReopenIssue', got '%s'", name)
+			}
+			input = variables["input"].(ReopenIssueInput)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.ReopenIssue("issue-id"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if input.IssueID != graphql.ID("issue-id") {
+		t.Errorf("Unexpected input: %+v", input)
+	}
+
+	client = &Client{gql: nil}
+	if err := client.ReopenIssue("issue-id"); err == nil || !strings.Contains(err.Error(), "GraphQL client not initialized") {
+		t.Errorf("Expected 'GraphQL client not initialized' error, got: %v", err)
+	}
+}
+
+func TestDeleteProjectItem(t *testing.T) {
+	var input DeleteProjectV2ItemInput
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "DeleteProjectV2Item" {
+				t.Errorf("Expected mutation name 'DeleteProjectV2Item', got '%s'", name)
+			}
+			input = variables["input"].(DeleteProjectV2ItemInput)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.DeleteProjectItem("proj-id", "item-id"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if input.ProjectID != graphql.ID("proj-id") || input.ItemID != graphql.ID("item-id") {
+		t.Errorf("Unexpected input: %+v", input)
+	}
+
+	mock.mutateFunc = func(name string, mutation interface{}, variables map[string]interface{}) error {
+		return errors.New("not found")
+	}
+	if err := client.DeleteProjectItem("proj-id", "item-id"); err == nil || !strings.Contains(err.Error(), "failed to remove item from project") {
+		t.Errorf("Expected a wrapped error, got: %v", err)
+	}
+}
+
+func TestReprioritizeSubIssue(t *testing.T) {
+	var inputs []ReprioritizeSubIssueInput
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "ReprioritizeSubIssue" {
+				t.Errorf("Expected mutation name 'ReprioritizeSubIssue', got '%s'", name)
+			}
+			inputs = append(inputs, variables["input"].(ReprioritizeSubIssueInput))
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.ReprioritizeSubIssue("parent-id", "child-id", "after-id", ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := client.ReprioritizeSubIssue("parent-id", "child-id", "", "before-id"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(inputs) != 2 || inputs[0].AfterID == nil || *inputs[0].AfterID != graphql.ID("after-id") || inputs[0].BeforeID != nil {
+		t.Errorf("Unexpected after input: %+v", inputs)
+	}
+	if inputs[1].BeforeID == nil || *inputs[1].BeforeID != graphql.ID("before-id") || inputs[1].AfterID != nil {
+		t.Errorf("Unexpected before input: %+v", inputs[1])
+	}
+
+	if err := client.ReprioritizeSubIssue("parent-id", "child-id", "a", "b"); err == nil {
+		t.Error("Expected an error when both afterID and beforeID are given")
+	}
+}
+
+func TestUpdateProjectItemPosition(t *testing.T) {
+	var inputs []UpdateProjectV2ItemPositionInput
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "UpdateProjectV2ItemPosition" {
+				t.Errorf("Expected mutation name 'UpdateProjectV2ItemPosition', got '%s'", name)
+			}
+			inputs = append(inputs, variables["input"].(UpdateProjectV2ItemPositionInput))
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.UpdateProjectItemPosition("proj-id", "item-2", "item-1"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := client.UpdateProjectItemPosition("proj-id", "item-1", ""); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(inputs) != 2 || inputs[0].AfterID == nil || *inputs[0].AfterID != graphql.ID("item-1") || inputs[0].ItemID != graphql.ID("item-2") {
+		t.Errorf("Unexpected after input: %+v", inputs)
+	}
+	if inputs[1].AfterID != nil {
+		t.Errorf("Expected no afterId when moving to the top, got %+v", inputs[1])
+	}
+}
+
+func TestUpdateProjectItemPosition_Error(t *testing.T) {
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			return errors.New("not found")
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.UpdateProjectItemPosition("proj-id", "item-1", ""); err == nil || !strings.Contains(err.Error(), "failed to update item position") {
+		t.Errorf("Expected wrapped error, got: %v", err)
+	}
+
+	client = &Client{gql: nil}
+	if err := client.UpdateProjectItemPosition("proj-id", "item-1", ""); err == nil || !strings.Contains(err.Error(), "GraphQL client not initialized") {
+		t.Errorf("Expected 'GraphQL client not initialized' error, got: %v", err)
+	}
+}
+
+func TestCloseIssue_Error(t *testing.T) {
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			return errors.New("not found")
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.CloseIssue("issue-id"); err == nil || !strings.Contains(err.Error(), "failed to close issue") {
+		t.Errorf("Expected wrapped error, got: %v", err)
+	}
+
+	client = &Client{gql: nil}
+	if err := client.CloseIssue("issue-id"); err == nil || !strings.Contains(err.Error(), "GraphQL client not initialized") {
+		t.Errorf("Expected 'GraphQL client not initialized' error, got: %v", err)
+	}
+}
+
+func TestAddAndRemoveIssueAssignees(t *testing.T) {
+	var names []string
+	var inputs []AssigneesInput
+	mock := &mockGraphQLClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			v := reflect.ValueOf(query).Elem()
+			switch name {
+			case "GetViewer":
+				v.FieldByName("Viewer").FieldByName("Login").SetString("me")
+			case "GetUserID":
+				login := string(variables["login"].(graphql.String))
+				if login == "ghost" {
+					return nil
+				}
+				v.FieldByName("User").FieldByName("ID").SetString("U_" + login)
+			}
+			return nil
+		},
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			names = append(names, name)
+			inputs = append(inputs, variables["input"].(AssigneesInput))
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.AddIssueAssignees("I_1", []string{"@me", "alice"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := client.RemoveIssueAssignees("I_1", []string{"bob"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(names) != 2 || names[0] != "AddAssigneesToAssignable" || names[1] != "RemoveAssigneesFromAssignable" {
+		t.Fatalf("Unexpected mutations: %v", names)
+	}
+	if inputs[0].AssignableID != graphql.ID("I_1") || len(inputs[0].AssigneeIDs) != 2 || inputs[0].AssigneeIDs[0] != graphql.ID("U_me") {
+		t.Errorf("Unexpected add input: %+v", inputs[0])
+	}
+	if len(inputs[1].AssigneeIDs) != 1 || inputs[1].AssigneeIDs[0] != graphql.ID("U_bob") {
+		t.Errorf("Unexpected remove input: %+v", inputs[1])
+	}
+
+	// An unknown user fails before the issue is changed
+	if err := client.AddIssueAssignees("I_1", []string{"ghost"}); err == nil || !strings.Contains(err.Error(), `user "ghost" not found`) {
+		t.Errorf("Expected user not found error, got %v", err)
+	}
+	if len(names) != 2 {
+		t.Errorf("Expected no mutation for an unknown user, got %v", names)
+	}
+
+	client = &Client{gql: nil}
+	if err := client.RemoveIssueAssignees("I_1", []string{"bob"}); err == nil || !strings.Contains(err.Error(), "GraphQL client not initialized") {
+		t.Errorf("Expected 'GraphQL client not initialized' error, got: %v", err)
+	}
+}
+
+func TestCreateDiscussion(t *testing.T) {
+	var input CreateDiscussionInput
+	mock := &mockGraphQLClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			repo := reflect.ValueOf(query).Elem().FieldByName("Repository")
+			repo.FieldByName("ID").SetString("repo-id")
+			nodes := repo.FieldByName("DiscussionCategories").FieldByName("Nodes")
+			for _, c := range [][3]string{{"cat-1", "General", "general"}, {"cat-2", "Meeting Notes", "meeting-notes"}} {
+				node := reflect.New(nodes.Type().Elem()).Elem()
+				node.FieldByName("ID").SetString(c[0])
+				node.FieldByName("Name").SetString(c[1])
+				node.FieldByName("Slug").SetString(c[2])
+				nodes.Set(reflect.Append(nodes, node))
+			}
+			return nil
+		},
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "CreateDiscussion" {
+				t.Errorf("Expected mutation name 'CreateDiscussion', got '%s'", name)
+			}
+			input = variables["input"].(CreateDiscussionInput)
+			d := reflect.ValueOf(mutation).Elem().FieldByName("CreateDiscussion").FieldByName("Discussion")
+			d.FieldByName("Number").SetInt(7)
+			d.FieldByName("URL").SetString("https://github.com/owner/repo/discussions/7")
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	d, err := client.CreateDiscussion("owner", "repo", "meeting-notes", "Planning agenda", "Body")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if input.RepositoryID != graphql.ID("repo-id") || input.CategoryID != graphql.ID("cat-2") || input.Title != "Planning agenda" {
+		t.Errorf("Unexpected input: %+v", input)
+	}
+	if d.Number != 7 || d.Category != "Meeting Notes" || d.Repository.Name != "repo" {
+		t.Errorf("Unexpected discussion: %+v", d)
+	}
+
+	if _, err := client.CreateDiscussion("owner", "repo", "Ideas", "t", "b"); err == nil || !strings.Contains(err.Error(), "available: General, Meeting Notes") {
+		t.Errorf("Expected unknown category error, got %v", err)
+	}
+}
+
+func TestCreateDiscussion_NoCategories(t *testing.T) {
+	client := NewClientWithGraphQL(&mockGraphQLClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return nil
+		},
+	})
+	if _, err := client.CreateDiscussion("owner", "repo", "General", "t", "b"); err == nil || !strings.Contains(err.Error(), "discussions enabled") {
+		t.Errorf("Expected discussions disabled error, got %v", err)
+	}
+}
+
+func TestMoveSubIssue(t *testing.T) {
+	var input AddSubIssueInput
+	mock := &mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			if name != "MoveSubIssue" {
+				t.Errorf("Expected mutation name 'MoveSubIssue', got '%s'", name)
+			}
+			input = variables["input"].(AddSubIssueInput)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	if err := client.MoveSubIssue("new-parent", "child"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if input.IssueID != graphql.ID("new-parent") || input.SubIssueID != graphql.ID("child") || !bool(input.ReplaceParent) {
+		t.Errorf("Unexpected input: %+v", input)
+	}
+
+	client = NewClientWithGraphQL(&mockGraphQLClient{
+		mutateFunc: func(name string, mutation interface{}, variables map[string]interface{}) error {
+			return errors.New("boom")
+		},
+	})
+	if err := client.MoveSubIssue("new-parent", "child"); err == nil || !strings.Contains(err.Error(), "failed to move sub-issue") {
+		t.Errorf("Expected wrapped error, got %v", err)
+	}
+}
@@ -1,32 +1,92 @@
 package api
 
 import (
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	graphql "github.com/cli/shurcooL-graphql"
 )
 
-// GetProject fetches a project by owner and number
+// ViewerOwner is the owner shorthand for the authenticated user's projects
+const ViewerOwner = "@me"
+
+// GetViewerLogin returns the login of the authenticated user
+func (c *Client) GetViewerLogin() (string, error) {
+	if c.gql == nil {
+		return "", fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	if c.viewerLogin != "" {
+		return c.viewerLogin, nil
+	}
+
+	var query struct {
+		Viewer struct {
+			Login string
+		}
+	}
+
+	err := c.gql.Query("GetViewer", &query, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to get authenticated user: %w", err)
+	}
+	if query.Viewer.Login == "" {
+		return "", fmt.Errorf("failed to get authenticated user: empty login")
+	}
+
+	c.viewerLogin = query.Viewer.Login
+	return c.viewerLogin, nil
+}
+
+// ResolveOwner returns owner with @me replaced by the authenticated user's login
+func (c *Client) ResolveOwner(owner string) (string, error) {
+	if owner != ViewerOwner {
+		return owner, nil
+	}
+	return c.GetViewerLogin()
+}
+
+// GetProject fetches a project by owner and number. The owner may be a
+// user, an organization, or @me.
 func (c *Client) GetProject(owner string, number int) (*Project, error) {
 	if c.gql == nil {
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
+	owner, err := c.ResolveOwner(owner)
+	if err != nil {
+		return nil, err
+	}
+
 	// First try as user project
-	project, err := c.getUserProject(owner, number)
-	if err == nil {
+	project, userErr := c.getUserProject(owner, number)
+	if userErr == nil {
 		return project, nil
 	}
 
 	// If that fails, try as organization project
 	project, err = c.getOrgProject(owner, number)
 	if err != nil {
+		// Report the user lookup's error when the owner is not an
+		// organization, so a missing personal project isn't described as
+		// a missing organization
+		if isNotOrganizationError(err) {
+			err = userErr
+		}
 		return nil, fmt.Errorf("failed to get project %s/%d: %w", owner, number, err)
 	}
 
 	return project, nil
 }
 
+// isNotOrganizationError reports whether err says the login is not an organization
+func isNotOrganizationError(err error) bool {
+	return strings.Contains(err.Error(), "Could not resolve to an Organization")
+}
+
 func (c *Client) getUserProject(owner string, number int) (*Project, error) {
 	var query struct {
 		User struct {
@@ -49,6 +109,9 @@ func (c *Client) getUserProject(owner string, number int) (*Project, error) {
 	if err != nil {
 		return nil, err
 	}
+	if query.User.ProjectV2.ID == "" {
+		return nil, fmt.Errorf("project %d not found for user %s", number, owner)
+	}
 
 	return &Project{
 		ID:     query.User.ProjectV2.ID,
@@ -85,6 +148,9 @@ func (c *Client) getOrgProject(owner string, number int) (*Project, error) {
 	if err != nil {
 		return nil, err
 	}
+	if query.Organization.ProjectV2.ID == "" {
+		return nil, fmt.Errorf("project %d not found for organization %s", number, owner)
+	}
 
 	return &Project{
 		ID:     query.Organization.ProjectV2.ID,
@@ -99,6 +165,79 @@ func (c *Client) getOrgProject(owner string, number int) (*Project, error) {
 	}, nil
 }
 
+// GetProjectViews fetches the saved views of a project
+func (c *Client) GetProjectViews(projectID string) ([]ProjectView, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Node struct {
+			ProjectV2 struct {
+				Views struct {
+					Nodes []struct {
+						ID           string
+						Number       int
+						Name         string
+						Layout       string
+						Filter       string
+						SortByFields struct {
+							Nodes []struct {
+								Direction string
+								Field     struct {
+									ProjectV2Field struct {
+										Name string
+									} `graphql:"... on ProjectV2Field"`
+									ProjectV2SingleSelectField struct {
+										Name string
+									} `graphql:"... on ProjectV2SingleSelectField"`
+									ProjectV2IterationField struct {
+										Name string
+									} `graphql:"... on ProjectV2IterationField"`
+								}
+							}
+						} `graphql:"sortByFields(first: 10)"`
+					}
+				} `graphql:"views(first: 50)"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $projectId)"`
+	}
+
+	variables := map[string]interface{}{
+		"projectId": graphql.ID(projectID),
+	}
+
+	err := c.gql.Query("GetProjectViews", &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project views: %w", err)
+	}
+
+	var views []ProjectView
+	for _, node := range query.Node.ProjectV2.Views.Nodes {
+		view := ProjectView{
+			ID:     node.ID,
+			Number: node.Number,
+			Name:   node.Name,
+			Layout: node.Layout,
+			Filter: node.Filter,
+		}
+		for _, s := range node.SortByFields.Nodes {
+			// Only one of the fragments is populated
+			name := s.Field.ProjectV2Field.Name
+			if name == "" {
+				name = s.Field.ProjectV2SingleSelectField.Name
+			}
+			if name == "" {
+				name = s.Field.ProjectV2IterationField.Name
+			}
+			view.SortBy = append(view.SortBy, name+" "+s.Direction)
+		}
+		views = append(views, view)
+	}
+
+	return views, nil
+}
+
 // GetProjectFields fetches all fields for a project
 func (c *Client) GetProjectFields(projectID string) ([]ProjectField, error) {
 	if c.gql == nil {
@@ -127,6 +266,26 @@ func (c *Client) GetProjectFields(projectID string) ([]ProjectField, error) {
 								Name string
 							}
 						} `graphql:"... on ProjectV2SingleSelectField"`
+						// Iteration fields have active and completed iterations
+						ProjectV2IterationField struct {
+							ID            string
+							Name          string
+							DataType      string
+							Configuration struct {
+								Iterations []struct {
+									ID        string
+									Title     string
+									StartDate string
+									Duration  int
+								}
+								CompletedIterations []struct {
+									ID        string
+									Title     string
+									StartDate string
+									Duration  int
+								}
+							}
+						} `graphql:"... on ProjectV2IterationField"`
 					}
 				} `graphql:"fields(first: 50)"`
 			} `graphql:"... on ProjectV2"`
@@ -157,12 +316,33 @@ func (c *Client) GetProjectFields(projectID string) ([]ProjectField, error) {
 					Name: opt.Name,
 				})
 			}
+		case "ProjectV2IterationField":
+			field.ID = node.ProjectV2IterationField.ID
+			field.Name = node.ProjectV2IterationField.Name
+			field.DataType = node.ProjectV2IterationField.DataType
+			config := node.ProjectV2IterationField.Configuration
+			for _, it := range config.CompletedIterations {
+				field.Iterations = append(field.Iterations, Iteration{
+					ID:        it.ID,
+					Title:     it.Title,
+					StartDate: it.StartDate,
+					Duration:  it.Duration,
+				})
+			}
+			for _, it := range config.Iterations {
+				field.Iterations = append(field.Iterations, Iteration{
+					ID:        it.ID,
+					Title:     it.Title,
+					StartDate: it.StartDate,
+					Duration:  it.Duration,
+				})
+			}
 		case "ProjectV2Field":
 			field.ID = node.ProjectV2Field.ID
 			field.Name = node.ProjectV2Field.Name
 			field.DataType = node.ProjectV2Field.DataType
 		default:
-			// Skip iteration/other field types for now
+			// Skip other field types for now
 			continue
 		}
 
@@ -256,22 +436,40 @@ type ProjectItemsFilter struct {
 // GetProjectItems fetches all items from a project with their field values.
 // Uses cursor-based pagination to retrieve all items regardless of project size.
 func (c *Client) GetProjectItems(projectID string, filter *ProjectItemsFilter) ([]ProjectItem, error) {
+	var allItems []ProjectItem
+	err := c.EachProjectItemsBatch(projectID, filter, func(batch []ProjectItem) error {
+		allItems = append(allItems, batch...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return allItems, nil
+}
+
+// ErrStopBatches is returned by an EachProjectItemsBatch callback to stop
+// fetching further pages; EachProjectItemsBatch then returns nil
+var ErrStopBatches = errors.New("stop fetching batches")
+
+// EachProjectItemsBatch fetches a project's items one page at a time and
+// calls fn with each page, after the repository filter. Only one page is
+// held at a time, so callers that keep just what they need stay within a
+// bounded amount of memory on large projects.
+func (c *Client) EachProjectItemsBatch(projectID string, filter *ProjectItemsFilter, fn func(batch []ProjectItem) error) error {
 	if c.gql == nil {
-		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+		return fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
-	var allItems []ProjectItem
 	var cursor *string
-
 	for {
 		items, pageInfo, err := c.getProjectItemsPage(projectID, cursor)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		// Filter and process items from this page
+		// Apply repository filter if specified
+		batch := items[:0]
 		for _, item := range items {
-			// Apply repository filter if specified
 			if filter != nil && filter.Repository != "" {
 				if item.Issue != nil && item.Issue.Repository.Owner != "" {
 					repoName := item.Issue.Repository.Owner + "/" + item.Issue.Repository.Name
@@ -280,17 +478,24 @@ func (c *Client) GetProjectItems(projectID string, filter *ProjectItemsFilter) (
 					}
 				}
 			}
-			allItems = append(allItems, item)
+			batch = append(batch, item)
+		}
+
+		if len(batch) > 0 {
+			if err := fn(batch); err != nil {
+				if errors.Is(err, ErrStopBatches) {
+					return nil
+				}
+				return err
+			}
 		}
 
 		// Check if there are more pages
 		if !pageInfo.HasNextPage {
-			break
+			return nil
 		}
 		cursor = &pageInfo.EndCursor
 	}
-
-	return allItems, nil
 }
 
 // pageInfo holds pagination information from GraphQL responses
@@ -306,8 +511,9 @@ func (c *Client) getProjectItemsPage(projectID string, cursor *string) ([]Projec
 			ProjectV2 struct {
 				Items struct {
 					Nodes []struct {
-						ID      string
-						Content struct {
+						ID         string
+						DatabaseID int `graphql:"databaseId"`
+						Content    struct {
 							TypeName string `graphql:"__typename"`
 							Issue    struct {
 								ID         string
@@ -323,6 +529,11 @@ func (c *Client) getProjectItemsPage(projectID string, cursor *string) ([]Projec
 										Login string
 									}
 								} `graphql:"assignees(first: 10)"`
+								Labels struct {
+									Nodes []struct {
+										Name string
+									}
+								} `graphql:"labels(first: 20)"`
 							} `graphql:"... on Issue"`
 						}
 						FieldValues struct {
@@ -346,6 +557,33 @@ func (c *Client) getProjectItemsPage(projectID string, cursor *string) ([]Projec
 										} `graphql:"... on ProjectV2Field"`
 									}
 								} `graphql:"... on ProjectV2ItemFieldTextValue"`
+								// Iteration field value
+								ProjectV2ItemFieldIterationValue struct {
+									Title string
+									Field struct {
+										ProjectV2IterationField struct {
+											Name string
+										} `graphql:"... on ProjectV2IterationField"`
+									}
+								} `graphql:"... on ProjectV2ItemFieldIterationValue"`
+								// Number field value
+								ProjectV2ItemFieldNumberValue struct {
+									Number float64
+									Field  struct {
+										ProjectV2Field struct {
+											Name string
+										} `graphql:"... on ProjectV2Field"`
+									}
+								} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+								// Date field value
+								ProjectV2ItemFieldDateValue struct {
+									Date  string
+									Field struct {
+										ProjectV2Field struct {
+											Name string
+										} `graphql:"... on ProjectV2Field"`
+									}
+								} `graphql:"... on ProjectV2ItemFieldDateValue"`
 							}
 						} `graphql:"fieldValues(first: 20)"`
 					}
@@ -379,7 +617,8 @@ func (c *Client) getProjectItemsPage(projectID string, cursor *string) ([]Projec
 		}
 
 		item := ProjectItem{
-			ID: node.ID,
+			ID:         node.ID,
+			DatabaseID: node.DatabaseID,
 			Issue: &Issue{
 				ID:     node.Content.Issue.ID,
 				Number: node.Content.Issue.Number,
@@ -405,6 +644,11 @@ func (c *Client) getProjectItemsPage(projectID string, cursor *string) ([]Projec
 			item.Issue.Assignees = append(item.Issue.Assignees, Actor{Login: a.Login})
 		}
 
+		// Parse labels
+		for _, l := range node.Content.Issue.Labels.Nodes {
+			item.Issue.Labels = append(item.Issue.Labels, Label{Name: l.Name})
+		}
+
 		// Parse field values
 		for _, fv := range node.FieldValues.Nodes {
 			switch fv.TypeName {
@@ -422,6 +666,25 @@ func (c *Client) getProjectItemsPage(projectID string, cursor *string) ([]Projec
 						Value: fv.ProjectV2ItemFieldTextValue.Text,
 					})
 				}
+			case "ProjectV2ItemFieldIterationValue":
+				if fv.ProjectV2ItemFieldIterationValue.Title != "" {
+					item.FieldValues = append(item.FieldValues, FieldValue{
+						Field: fv.ProjectV2ItemFieldIterationValue.Field.ProjectV2IterationField.Name,
+						Value: fv.ProjectV2ItemFieldIterationValue.Title,
+					})
+				}
+			case "ProjectV2ItemFieldNumberValue":
+				item.FieldValues = append(item.FieldValues, FieldValue{
+					Field: fv.ProjectV2ItemFieldNumberValue.Field.ProjectV2Field.Name,
+					Value: strconv.FormatFloat(fv.ProjectV2ItemFieldNumberValue.Number, 'f', -1, 64),
+				})
+			case "ProjectV2ItemFieldDateValue":
+				if fv.ProjectV2ItemFieldDateValue.Date != "" {
+					item.FieldValues = append(item.FieldValues, FieldValue{
+						Field: fv.ProjectV2ItemFieldDateValue.Field.ProjectV2Field.Name,
+						Value: fv.ProjectV2ItemFieldDateValue.Date,
+					})
+				}
 			}
 		}
 
@@ -567,21 +830,136 @@ func (c *Client) GetRepositoryIssues(owner, repo, state string) ([]Issue, error)
 	return issues, nil
 }
 
-// GetParentIssue fetches the parent issue for a given sub-issue
-func (c *Client) GetParentIssue(owner, repo string, number int) (*Issue, error) {
+// GetLabeledIssues fetches issues with any of labels in a repository,
+// including their bodies. state is open, closed, or all.
+func (c *Client) GetLabeledIssues(owner, repo string, labels []string, state string) ([]Issue, error) {
 	if c.gql == nil {
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
+	var issues []Issue
+	var cursor *string
+	for {
+		page, info, err := c.getLabeledIssuesPage(owner, repo, labels, state, cursor)
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, page...)
+
+		if !info.HasNextPage {
+			break
+		}
+		cursor = &info.EndCursor
+	}
+
+	return issues, nil
+}
+
+// getLabeledIssuesPage fetches a single page of labeled issues
+func (c *Client) getLabeledIssuesPage(owner, repo string, labels []string, state string, cursor *string) ([]Issue, pageInfo, error) {
+	var states []graphql.String
+	switch state {
+	case "open":
+		states = []graphql.String{"OPEN"}
+	case "closed":
+		states = []graphql.String{"CLOSED"}
+	default:
+		states = []graphql.String{"OPEN", "CLOSED"}
+	}
+
+	labelNames := make([]graphql.String, 0, len(labels))
+	for _, l := range labels {
+		labelNames = append(labelNames, graphql.String(l))
+	}
+
 	var query struct {
 		Repository struct {
-			Issue struct {
-				Parent struct {
+			Issues struct {
+				Nodes []struct {
 					ID     string
 					Number int
 					Title  string
+					Body   string
 					State  string
 					URL    string `graphql:"url"`
+					Labels struct {
+						Nodes []struct {
+							Name string
+						}
+					} `graphql:"labels(first: 20)"`
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"issues(first: 100, after: $cursor, states: $states, labels: $labels)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  graphql.String(owner),
+		"repo":   graphql.String(repo),
+		"states": states,
+		"labels": labelNames,
+		"cursor": (*graphql.String)(nil),
+	}
+	if cursor != nil {
+		variables["cursor"] = graphql.String(*cursor)
+	}
+
+	err := c.gql.Query("GetLabeledIssues", &query, variables)
+	if err != nil {
+		return nil, pageInfo{}, fmt.Errorf("failed to get issues from %s/%s: %w", owner, repo, err)
+	}
+
+	var issues []Issue
+	for _, node := range query.Repository.Issues.Nodes {
+		issue := Issue{
+			ID:     node.ID,
+			Number: node.Number,
+			Title:  node.Title,
+			Body:   node.Body,
+			State:  node.State,
+			URL:    node.URL,
+			Repository: Repository{
+				Owner: owner,
+				Name:  repo,
+			},
+		}
+		for _, l := range node.Labels.Nodes {
+			issue.Labels = append(issue.Labels, Label{Name: l.Name})
+		}
+		issues = append(issues, issue)
+	}
+
+	info := pageInfo{
+		HasNextPage: query.Repository.Issues.PageInfo.HasNextPage,
+		EndCursor:   query.Repository.Issues.PageInfo.EndCursor,
+	}
+	return issues, info, nil
+}
+
+// GetParentIssue fetches the parent issue for a given sub-issue
+func (c *Client) GetParentIssue(owner, repo string, number int) (*Issue, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Repository struct {
+			Issue struct {
+				Parent struct {
+					ID         string
+					Number     int
+					Title      string
+					State      string
+					URL        string `graphql:"url"`
+					Repository struct {
+						Name  string
+						Owner struct {
+							Login string
+						}
+					}
 				} `graphql:"parent"`
 			} `graphql:"issue(number: $number)"`
 		} `graphql:"repository(owner: $owner, name: $repo)"`
@@ -609,26 +987,35 @@ func (c *Client) GetParentIssue(owner, repo string, number int) (*Issue, error)
 		Title:  query.Repository.Issue.Parent.Title,
 		State:  query.Repository.Issue.Parent.State,
 		URL:    query.Repository.Issue.Parent.URL,
+		Repository: Repository{
+			Owner: query.Repository.Issue.Parent.Repository.Owner.Login,
+			Name:  query.Repository.Issue.Parent.Repository.Name,
+		},
 	}, nil
 }
 
-// ListProjects fetches all projects for an owner (user or organization)
+// ListProjects fetches all projects for an owner (user, organization, or @me)
 func (c *Client) ListProjects(owner string) ([]Project, error) {
 	if c.gql == nil {
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
+	owner, err := c.ResolveOwner(owner)
+	if err != nil {
+		return nil, err
+	}
+
 	// First try as user projects
-	projects, err := c.listUserProjects(owner)
-	if err == nil && len(projects) > 0 {
+	projects, userErr := c.listUserProjects(owner)
+	if userErr == nil && len(projects) > 0 {
 		return projects, nil
 	}
 
 	// If that fails or returns empty, try as organization projects
 	orgProjects, err := c.listOrgProjects(owner)
 	if err != nil {
-		// If both fail, return user error if we had one
-		if projects != nil {
+		// The owner is a user without open projects
+		if userErr == nil {
 			return projects, nil
 		}
 		return nil, fmt.Errorf("failed to list projects for %s: %w", owner, err)
@@ -684,77 +1071,645 @@ func (c *Client) listUserProjects(owner string) ([]Project, error) {
 
 // Comment represents an issue comment
 type Comment struct {
-	ID        string
-	Author    string
-	Body      string
-	CreatedAt string
+	ID                string
+	Author            string
+	AuthorAssociation string // e.g. MEMBER, OWNER, COLLABORATOR, CONTRIBUTOR, NONE
+	Body              string
+	CreatedAt         string
 }
 
-// GetIssueComments fetches comments for an issue
+// GetIssueComments fetches all comments for an issue, oldest first.
+// Uses cursor-based pagination, so long discussions are complete.
 func (c *Client) GetIssueComments(owner, repo string, number int) ([]Comment, error) {
 	if c.gql == nil {
 		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
 	}
 
-	var query struct {
-		Repository struct {
-			Issue struct {
-				Comments struct {
-					Nodes []struct {
-						ID        string
-						Body      string
-						CreatedAt string
-						Author    struct {
-							Login string
+	var comments []Comment
+	var cursor *string
+	for {
+		var query struct {
+			Repository struct {
+				Issue struct {
+					Comments struct {
+						Nodes []struct {
+							ID        string
+							Body      string
+							CreatedAt string
+							Author    struct {
+								Login string
+							}
 						}
-					}
-				} `graphql:"comments(first: 50)"`
-			} `graphql:"issue(number: $number)"`
-		} `graphql:"repository(owner: $owner, name: $repo)"`
-	}
+						PageInfo struct {
+							HasNextPage bool
+							EndCursor   string
+						}
+					} `graphql:"comments(first: 100, after: $cursor)"`
+				} `graphql:"issue(number: $number)"`
+			} `graphql:"repository(owner: $owner, name: $repo)"`
+		}
 
-	variables := map[string]interface{}{
-		"owner":  graphql.String(owner),
-		"repo":   graphql.String(repo),
-		"number": graphql.Int(number),
-	}
+		variables := map[string]interface{}{
+			"owner":  graphql.String(owner),
+			"repo":   graphql.String(repo),
+			"number": graphql.Int(number),
+			"cursor": (*graphql.String)(nil),
+		}
+		if cursor != nil {
+			variables["cursor"] = graphql.String(*cursor)
+		}
 
-	err := c.gql.Query("GetIssueComments", &query, variables)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get comments for %s/%s#%d: %w", owner, repo, number, err)
-	}
+		err := c.gql.Query("GetIssueComments", &query, variables)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get comments for %s/%s#%d: %w", owner, repo, number, err)
+		}
 
-	var comments []Comment
-	for _, node := range query.Repository.Issue.Comments.Nodes {
-		comments = append(comments, Comment{
-			ID:        node.ID,
-			Author:    node.Author.Login,
-			Body:      node.Body,
-			CreatedAt: node.CreatedAt,
-		})
+		page := query.Repository.Issue.Comments
+		for _, node := range page.Nodes {
+			comments = append(comments, Comment{
+				ID:        node.ID,
+				Author:    node.Author.Login,
+				Body:      node.Body,
+				CreatedAt: node.CreatedAt,
+			})
+		}
+
+		if !page.PageInfo.HasNextPage {
+			break
+		}
+		cursor = &page.PageInfo.EndCursor
 	}
 
 	return comments, nil
 }
 
-func (c *Client) listOrgProjects(owner string) ([]Project, error) {
-	var query struct {
-		Organization struct {
-			ProjectsV2 struct {
-				Nodes []struct {
-					ID     string
-					Number int
-					Title  string
-					URL    string `graphql:"url"`
-					Closed bool
-				}
-			} `graphql:"projectsV2(first: 20, orderBy: {field: UPDATED_AT, direction: DESC})"`
-		} `graphql:"organization(login: $owner)"`
-	}
+// ProjectEvent is a project-related change in an issue's history
+type ProjectEvent struct {
+	Type          string // added, removed, status_changed, or field_updated
+	ProjectNumber int
+	ProjectTitle  string
+	Field         string // Field name for status_changed and field_updated
+	From          string // Previous value (status_changed only)
+	To            string // New or current value
+	Actor         string // Empty for field_updated, which GitHub does not attribute
+	CreatedAt     string
+}
 
-	variables := map[string]interface{}{
-		"owner": graphql.String(owner),
-	}
+// Project event types
+const (
+	ProjectEventAdded         = "added"
+	ProjectEventRemoved       = "removed"
+	ProjectEventStatusChanged = "status_changed"
+	ProjectEventFieldUpdated  = "field_updated"
+)
+
+// GetIssueProjectHistory fetches the project timeline of an issue, oldest first.
+// Additions, removals, and status changes come from issue timeline events.
+// GitHub does not record other field changes in the timeline, so each
+// remaining field contributes a field_updated event for its current value
+// at its last update time.
+func (c *Client) GetIssueProjectHistory(owner, repo string, number int) ([]ProjectEvent, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	type eventProject struct {
+		Number int
+		Title  string
+	}
+	type eventActor struct {
+		Login string
+	}
+
+	var query struct {
+		Repository struct {
+			Issue struct {
+				TimelineItems struct {
+					Nodes []struct {
+						TypeName              string `graphql:"__typename"`
+						AddedToProjectV2Event struct {
+							CreatedAt string
+							Actor     eventActor
+							Project   eventProject
+						} `graphql:"... on AddedToProjectV2Event"`
+						RemovedFromProjectV2Event struct {
+							CreatedAt string
+							Actor     eventActor
+							Project   eventProject
+						} `graphql:"... on RemovedFromProjectV2Event"`
+						ProjectV2ItemStatusChangedEvent struct {
+							CreatedAt      string
+							Actor          eventActor
+							Project        eventProject
+							PreviousStatus string
+							Status         string
+						} `graphql:"... on ProjectV2ItemStatusChangedEvent"`
+					}
+				} `graphql:"timelineItems(first: 100, itemTypes: [ADDED_TO_PROJECT_V2_EVENT, REMOVED_FROM_PROJECT_V2_EVENT, PROJECT_V2_ITEM_STATUS_CHANGED_EVENT])"`
+				ProjectItems struct {
+					Nodes []struct {
+						Project     eventProject
+						FieldValues struct {
+							Nodes []struct {
+								TypeName                            string `graphql:"__typename"`
+								ProjectV2ItemFieldSingleSelectValue struct {
+									Name      string
+									UpdatedAt string
+									Field     struct {
+										ProjectV2SingleSelectField struct {
+											Name string
+										} `graphql:"... on ProjectV2SingleSelectField"`
+									}
+								} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+								ProjectV2ItemFieldTextValue struct {
+									Text      string
+									UpdatedAt string
+									Field     struct {
+										ProjectV2Field struct {
+											Name string
+										} `graphql:"... on ProjectV2Field"`
+									}
+								} `graphql:"... on ProjectV2ItemFieldTextValue"`
+								ProjectV2ItemFieldNumberValue struct {
+									Number    float64
+									UpdatedAt string
+									Field     struct {
+										ProjectV2Field struct {
+											Name string
+										} `graphql:"... on ProjectV2Field"`
+									}
+								} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+								ProjectV2ItemFieldDateValue struct {
+									Date      string
+									UpdatedAt string
+									Field     struct {
+										ProjectV2Field struct {
+											Name string
+										} `graphql:"... on ProjectV2Field"`
+									}
+								} `graphql:"... on ProjectV2ItemFieldDateValue"`
+								ProjectV2ItemFieldIterationValue struct {
+									Title     string
+									UpdatedAt string
+									Field     struct {
+										ProjectV2IterationField struct {
+											Name string
+										} `graphql:"... on ProjectV2IterationField"`
+									}
+								} `graphql:"... on ProjectV2ItemFieldIterationValue"`
+							}
+						} `graphql:"fieldValues(first: 20)"`
+					}
+				} `graphql:"projectItems(first: 10)"`
+			} `graphql:"issue(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  graphql.String(owner),
+		"repo":   graphql.String(repo),
+		"number": graphql.Int(number),
+	}
+
+	err := c.gql.Query("GetIssueProjectHistory", &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project history for %s/%s#%d: %w", owner, repo, number, err)
+	}
+
+	var events []ProjectEvent
+	for _, node := range query.Repository.Issue.TimelineItems.Nodes {
+		switch node.TypeName {
+		case "AddedToProjectV2Event":
+			e := node.AddedToProjectV2Event
+			events = append(events, ProjectEvent{
+				Type:          ProjectEventAdded,
+				ProjectNumber: e.Project.Number,
+				ProjectTitle:  e.Project.Title,
+				Actor:         e.Actor.Login,
+				CreatedAt:     e.CreatedAt,
+			})
+		case "RemovedFromProjectV2Event":
+			e := node.RemovedFromProjectV2Event
+			events = append(events, ProjectEvent{
+				Type:          ProjectEventRemoved,
+				ProjectNumber: e.Project.Number,
+				ProjectTitle:  e.Project.Title,
+				Actor:         e.Actor.Login,
+				CreatedAt:     e.CreatedAt,
+			})
+		case "ProjectV2ItemStatusChangedEvent":
+			e := node.ProjectV2ItemStatusChangedEvent
+			events = append(events, ProjectEvent{
+				Type:          ProjectEventStatusChanged,
+				ProjectNumber: e.Project.Number,
+				ProjectTitle:  e.Project.Title,
+				Field:         "Status",
+				From:          e.PreviousStatus,
+				To:            e.Status,
+				Actor:         e.Actor.Login,
+				CreatedAt:     e.CreatedAt,
+			})
+		}
+	}
+
+	for _, item := range query.Repository.Issue.ProjectItems.Nodes {
+		for _, fv := range item.FieldValues.Nodes {
+			var field, value, updatedAt string
+			switch fv.TypeName {
+			case "ProjectV2ItemFieldSingleSelectValue":
+				field = fv.ProjectV2ItemFieldSingleSelectValue.Field.ProjectV2SingleSelectField.Name
+				value = fv.ProjectV2ItemFieldSingleSelectValue.Name
+				updatedAt = fv.ProjectV2ItemFieldSingleSelectValue.UpdatedAt
+			case "ProjectV2ItemFieldTextValue":
+				field = fv.ProjectV2ItemFieldTextValue.Field.ProjectV2Field.Name
+				value = fv.ProjectV2ItemFieldTextValue.Text
+				updatedAt = fv.ProjectV2ItemFieldTextValue.UpdatedAt
+			case "ProjectV2ItemFieldNumberValue":
+				field = fv.ProjectV2ItemFieldNumberValue.Field.ProjectV2Field.Name
+				value = strconv.FormatFloat(fv.ProjectV2ItemFieldNumberValue.Number, 'f', -1, 64)
+				updatedAt = fv.ProjectV2ItemFieldNumberValue.UpdatedAt
+			case "ProjectV2ItemFieldDateValue":
+				field = fv.ProjectV2ItemFieldDateValue.Field.ProjectV2Field.Name
+				value = fv.ProjectV2ItemFieldDateValue.Date
+				updatedAt = fv.ProjectV2ItemFieldDateValue.UpdatedAt
+			case "ProjectV2ItemFieldIterationValue":
+				field = fv.ProjectV2ItemFieldIterationValue.Field.ProjectV2IterationField.Name
+				value = fv.ProjectV2ItemFieldIterationValue.Title
+				updatedAt = fv.ProjectV2ItemFieldIterationValue.UpdatedAt
+			default:
+				continue
+			}
+
+			// Status changes are already covered by timeline events
+			if field == "" || value == "" || field == "Status" {
+				continue
+			}
+
+			events = append(events, ProjectEvent{
+				Type:          ProjectEventFieldUpdated,
+				ProjectNumber: item.Project.Number,
+				ProjectTitle:  item.Project.Title,
+				Field:         field,
+				To:            value,
+				CreatedAt:     updatedAt,
+			})
+		}
+	}
+
+	// Timestamps are ISO 8601, so string order is chronological
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].CreatedAt < events[j].CreatedAt
+	})
+
+	return events, nil
+}
+
+// IssueSignals holds the issue data used to score priority suggestions
+type IssueSignals struct {
+	Body      string
+	Labels    []string
+	Reactions int
+	CreatedAt string
+	Blocking  int // Open issues blocked by this issue
+}
+
+// GetIssueSignals fetches reactions, age, labels, and dependency counts for an issue
+func (c *Client) GetIssueSignals(owner, repo string, number int) (*IssueSignals, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Repository struct {
+			Issue struct {
+				Body      string
+				CreatedAt string
+				Labels    struct {
+					Nodes []struct {
+						Name string
+					}
+				} `graphql:"labels(first: 20)"`
+				Reactions struct {
+					TotalCount int
+				}
+				IssueDependenciesSummary struct {
+					Blocking int
+				}
+			} `graphql:"issue(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  graphql.String(owner),
+		"repo":   graphql.String(repo),
+		"number": graphql.Int(number),
+	}
+
+	err := c.gql.Query("GetIssueSignals", &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get signals for %s/%s#%d: %w", owner, repo, number, err)
+	}
+
+	issue := query.Repository.Issue
+	signals := &IssueSignals{
+		Body:      issue.Body,
+		Reactions: issue.Reactions.TotalCount,
+		CreatedAt: issue.CreatedAt,
+		Blocking:  issue.IssueDependenciesSummary.Blocking,
+	}
+	for _, l := range issue.Labels.Nodes {
+		signals.Labels = append(signals.Labels, l.Name)
+	}
+
+	return signals, nil
+}
+
+// ReactionCount is the number of reactions of one kind on an issue
+type ReactionCount struct {
+	Content string // GraphQL ReactionContent, e.g. THUMBS_UP
+	Count   int
+}
+
+// IssueEngagement holds an issue's reactions and participants
+type IssueEngagement struct {
+	Reactions         []ReactionCount // Non-zero reactions in GitHub's display order
+	Participants      []string        // Logins, up to the first 50
+	TotalParticipants int
+}
+
+// GetIssueEngagement fetches reaction counts and participants for an issue
+func (c *Client) GetIssueEngagement(owner, repo string, number int) (*IssueEngagement, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Repository struct {
+			Issue struct {
+				ReactionGroups []struct {
+					Content  string
+					Reactors struct {
+						TotalCount int
+					}
+				}
+				Participants struct {
+					TotalCount int
+					Nodes      []struct {
+						Login string
+					}
+				} `graphql:"participants(first: 50)"`
+			} `graphql:"issue(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  graphql.String(owner),
+		"repo":   graphql.String(repo),
+		"number": graphql.Int(number),
+	}
+
+	err := c.gql.Query("GetIssueEngagement", &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions for %s/%s#%d: %w", owner, repo, number, err)
+	}
+
+	issue := query.Repository.Issue
+	engagement := &IssueEngagement{TotalParticipants: issue.Participants.TotalCount}
+	for _, g := range issue.ReactionGroups {
+		if g.Reactors.TotalCount > 0 {
+			engagement.Reactions = append(engagement.Reactions, ReactionCount{Content: g.Content, Count: g.Reactors.TotalCount})
+		}
+	}
+	for _, p := range issue.Participants.Nodes {
+		engagement.Participants = append(engagement.Participants, p.Login)
+	}
+
+	return engagement, nil
+}
+
+// GetCommentReactions fetches the non-zero reaction counts on an issue comment
+func (c *Client) GetCommentReactions(commentID string) ([]ReactionCount, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Node struct {
+			IssueComment struct {
+				ReactionGroups []struct {
+					Content  string
+					Reactors struct {
+						TotalCount int
+					}
+				}
+			} `graphql:"... on IssueComment"`
+		} `graphql:"node(id: $commentId)"`
+	}
+
+	variables := map[string]interface{}{
+		"commentId": graphql.ID(commentID),
+	}
+
+	err := c.gql.Query("GetCommentReactions", &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reactions for comment: %w", err)
+	}
+
+	var reactions []ReactionCount
+	for _, g := range query.Node.IssueComment.ReactionGroups {
+		if g.Reactors.TotalCount > 0 {
+			reactions = append(reactions, ReactionCount{Content: g.Content, Count: g.Reactors.TotalCount})
+		}
+	}
+	return reactions, nil
+}
+
+// LinkedPullRequest is a pull request that closes or references an issue
+type LinkedPullRequest struct {
+	Number         int
+	Title          string
+	State          string // OPEN, CLOSED, or MERGED
+	URL            string
+	Repository     Repository
+	IsDraft        bool
+	ReviewDecision string // APPROVED, CHANGES_REQUESTED, REVIEW_REQUIRED, or empty
+	ChecksState    string // Status check rollup of the head commit, e.g. SUCCESS, FAILURE, PENDING
+	Closes         bool   // True if merging the PR closes the issue
+}
+
+// linkedPullRequestNode holds the pull request fields queried for linked PRs
+type linkedPullRequestNode struct {
+	Number         int
+	Title          string
+	State          string
+	URL            string `graphql:"url"`
+	IsDraft        bool
+	ReviewDecision string
+	Repository     struct {
+		NameWithOwner string
+	}
+	Commits struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup *struct {
+					State string
+				}
+			}
+		}
+	} `graphql:"commits(last: 1)"`
+}
+
+func (n linkedPullRequestNode) toLinkedPullRequest(closes bool) LinkedPullRequest {
+	pr := LinkedPullRequest{
+		Number:         n.Number,
+		Title:          n.Title,
+		State:          n.State,
+		URL:            n.URL,
+		IsDraft:        n.IsDraft,
+		ReviewDecision: n.ReviewDecision,
+		Closes:         closes,
+	}
+	if parts := splitRepoName(n.Repository.NameWithOwner); len(parts) == 2 {
+		pr.Repository = Repository{Owner: parts[0], Name: parts[1]}
+	}
+	if len(n.Commits.Nodes) > 0 && n.Commits.Nodes[0].Commit.StatusCheckRollup != nil {
+		pr.ChecksState = n.Commits.Nodes[0].Commit.StatusCheckRollup.State
+	}
+	return pr
+}
+
+// GetLinkedPullRequests fetches pull requests that close or reference an issue.
+// Closing PRs come first; each PR appears once.
+func (c *Client) GetLinkedPullRequests(owner, repo string, number int) ([]LinkedPullRequest, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Repository struct {
+			Issue struct {
+				ClosedByPullRequestsReferences struct {
+					Nodes []linkedPullRequestNode
+				} `graphql:"closedByPullRequestsReferences(first: 10, includeClosedPrs: true)"`
+				TimelineItems struct {
+					Nodes []struct {
+						TypeName             string `graphql:"__typename"`
+						CrossReferencedEvent struct {
+							Source struct {
+								TypeName    string                `graphql:"__typename"`
+								PullRequest linkedPullRequestNode `graphql:"... on PullRequest"`
+							}
+						} `graphql:"... on CrossReferencedEvent"`
+					}
+				} `graphql:"timelineItems(first: 50, itemTypes: [CROSS_REFERENCED_EVENT])"`
+			} `graphql:"issue(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  graphql.String(owner),
+		"repo":   graphql.String(repo),
+		"number": graphql.Int(number),
+	}
+
+	err := c.gql.Query("GetLinkedPullRequests", &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get linked pull requests for %s/%s#%d: %w", owner, repo, number, err)
+	}
+
+	var prs []LinkedPullRequest
+	seen := make(map[string]bool)
+
+	for _, node := range query.Repository.Issue.ClosedByPullRequestsReferences.Nodes {
+		if seen[node.URL] {
+			continue
+		}
+		seen[node.URL] = true
+		prs = append(prs, node.toLinkedPullRequest(true))
+	}
+
+	for _, node := range query.Repository.Issue.TimelineItems.Nodes {
+		if node.TypeName != "CrossReferencedEvent" || node.CrossReferencedEvent.Source.TypeName != "PullRequest" {
+			continue
+		}
+		pr := node.CrossReferencedEvent.Source.PullRequest
+		if seen[pr.URL] {
+			continue
+		}
+		seen[pr.URL] = true
+		prs = append(prs, pr.toLinkedPullRequest(false))
+	}
+
+	return prs, nil
+}
+
+// GetPullRequestClosingIssues returns the issues a pull request closes when
+// merged, as Repository and Number only. A number that is an issue rather
+// than a pull request yields none.
+func (c *Client) GetPullRequestClosingIssues(owner, repo string, number int) ([]Issue, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Repository struct {
+			IssueOrPullRequest struct {
+				PullRequest struct {
+					ClosingIssuesReferences struct {
+						Nodes []struct {
+							Number     int
+							Repository struct {
+								Name  string
+								Owner struct {
+									Login string
+								}
+							}
+						}
+					} `graphql:"closingIssuesReferences(first: 25)"`
+				} `graphql:"... on PullRequest"`
+			} `graphql:"issueOrPullRequest(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  graphql.String(owner),
+		"repo":   graphql.String(repo),
+		"number": graphql.Int(number),
+	}
+
+	err := c.gql.Query("GetPullRequestClosingIssues", &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issues closed by %s/%s#%d: %w", owner, repo, number, err)
+	}
+
+	var issues []Issue
+	for _, node := range query.Repository.IssueOrPullRequest.PullRequest.ClosingIssuesReferences.Nodes {
+		issues = append(issues, Issue{
+			Number:     node.Number,
+			Repository: Repository{Owner: node.Repository.Owner.Login, Name: node.Repository.Name},
+		})
+	}
+	return issues, nil
+}
+
+func (c *Client) listOrgProjects(owner string) ([]Project, error) {
+	var query struct {
+		Organization struct {
+			ProjectsV2 struct {
+				Nodes []struct {
+					ID     string
+					Number int
+					Title  string
+					URL    string `graphql:"url"`
+					Closed bool
+				}
+			} `graphql:"projectsV2(first: 20, orderBy: {field: UPDATED_AT, direction: DESC})"`
+		} `graphql:"organization(login: $owner)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": graphql.String(owner),
+	}
 
 	err := c.gql.Query("ListOrgProjects", &query, variables)
 	if err != nil {
@@ -781,3 +1736,609 @@ func (c *Client) listOrgProjects(owner string) ([]Project, error) {
 
 	return projects, nil
 }
+
+// IssueActivity holds the timestamps needed to measure how quickly an issue
+// received its first response from the team
+type IssueActivity struct {
+	Issue        Issue
+	CreatedAt    string
+	FieldValues  []FieldValue
+	FieldUpdates []FieldUpdate
+	Comments     []Comment
+}
+
+// FieldUpdate records when a project field value was last set on an item
+type FieldUpdate struct {
+	Field     string
+	Value     string
+	UpdatedAt string
+}
+
+// GetProjectIssueActivity fetches creation, comment, and field update timestamps
+// for every issue in a project. Uses cursor-based pagination.
+func (c *Client) GetProjectIssueActivity(projectID string, filter *ProjectItemsFilter) ([]IssueActivity, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var all []IssueActivity
+	var cursor *string
+
+	for {
+		activities, page, err := c.getProjectIssueActivityPage(projectID, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range activities {
+			if filter != nil && filter.Repository != "" && a.Issue.Repository.Owner != "" {
+				if a.Issue.Repository.Owner+"/"+a.Issue.Repository.Name != filter.Repository {
+					continue
+				}
+			}
+			all = append(all, a)
+		}
+
+		if !page.HasNextPage {
+			break
+		}
+		cursor = &page.EndCursor
+	}
+
+	return all, nil
+}
+
+// getProjectIssueActivityPage fetches a single page of issue activity
+func (c *Client) getProjectIssueActivityPage(projectID string, cursor *string) ([]IssueActivity, pageInfo, error) {
+	var query struct {
+		Node struct {
+			ProjectV2 struct {
+				Items struct {
+					Nodes []struct {
+						Content struct {
+							TypeName string `graphql:"__typename"`
+							Issue    struct {
+								ID        string
+								Number    int
+								Title     string
+								State     string
+								URL       string `graphql:"url"`
+								CreatedAt string
+								Author    struct {
+									Login string
+								}
+								Repository struct {
+									NameWithOwner string
+								}
+								Comments struct {
+									Nodes []struct {
+										ID                string
+										CreatedAt         string
+										AuthorAssociation string
+										Author            struct {
+											Login string
+										}
+									}
+								} `graphql:"comments(first: 20)"`
+							} `graphql:"... on Issue"`
+						}
+						FieldValues struct {
+							Nodes []struct {
+								TypeName                            string `graphql:"__typename"`
+								ProjectV2ItemFieldSingleSelectValue struct {
+									Name      string
+									UpdatedAt string
+									Field     struct {
+										ProjectV2SingleSelectField struct {
+											Name string
+										} `graphql:"... on ProjectV2SingleSelectField"`
+									}
+								} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+							}
+						} `graphql:"fieldValues(first: 20)"`
+					}
+					PageInfo struct {
+						HasNextPage bool
+						EndCursor   string
+					}
+				} `graphql:"items(first: 50, after: $cursor)"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id: $projectId)"`
+	}
+
+	variables := map[string]interface{}{
+		"projectId": graphql.ID(projectID),
+		"cursor":    (*graphql.String)(nil),
+	}
+	if cursor != nil {
+		variables["cursor"] = graphql.String(*cursor)
+	}
+
+	err := c.gql.Query("GetProjectIssueActivity", &query, variables)
+	if err != nil {
+		return nil, pageInfo{}, fmt.Errorf("failed to get project issue activity: %w", err)
+	}
+
+	var activities []IssueActivity
+	for _, node := range query.Node.ProjectV2.Items.Nodes {
+		if node.Content.TypeName != "Issue" {
+			continue
+		}
+
+		content := node.Content.Issue
+		activity := IssueActivity{
+			Issue: Issue{
+				ID:     content.ID,
+				Number: content.Number,
+				Title:  content.Title,
+				State:  content.State,
+				URL:    content.URL,
+				Author: Actor{Login: content.Author.Login},
+			},
+			CreatedAt: content.CreatedAt,
+		}
+
+		if parts := splitRepoName(content.Repository.NameWithOwner); len(parts) == 2 {
+			activity.Issue.Repository = Repository{Owner: parts[0], Name: parts[1]}
+		}
+
+		for _, cm := range content.Comments.Nodes {
+			activity.Comments = append(activity.Comments, Comment{
+				ID:                cm.ID,
+				Author:            cm.Author.Login,
+				AuthorAssociation: cm.AuthorAssociation,
+				CreatedAt:         cm.CreatedAt,
+			})
+		}
+
+		for _, fv := range node.FieldValues.Nodes {
+			if fv.TypeName != "ProjectV2ItemFieldSingleSelectValue" || fv.ProjectV2ItemFieldSingleSelectValue.Name == "" {
+				continue
+			}
+			value := fv.ProjectV2ItemFieldSingleSelectValue
+			activity.FieldValues = append(activity.FieldValues, FieldValue{
+				Field: value.Field.ProjectV2SingleSelectField.Name,
+				Value: value.Name,
+			})
+			activity.FieldUpdates = append(activity.FieldUpdates, FieldUpdate{
+				Field:     value.Field.ProjectV2SingleSelectField.Name,
+				Value:     value.Name,
+				UpdatedAt: value.UpdatedAt,
+			})
+		}
+
+		activities = append(activities, activity)
+	}
+
+	return activities, pageInfo{
+		HasNextPage: query.Node.ProjectV2.Items.PageInfo.HasNextPage,
+		EndCursor:   query.Node.ProjectV2.Items.PageInfo.EndCursor,
+	}, nil
+}
+
+// itemFieldValueNode is the fieldValues selection used by the archived item
+// queries, covering single select, text, iteration, and number values
+type itemFieldValueNode struct {
+	TypeName                            string `graphql:"__typename"`
+	ProjectV2ItemFieldSingleSelectValue struct {
+		Name      string
+		UpdatedAt string
+		Field     struct {
+			ProjectV2SingleSelectField struct {
+				Name string
+			} `graphql:"... on ProjectV2SingleSelectField"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	ProjectV2ItemFieldTextValue struct {
+		Text  string
+		Field struct {
+			ProjectV2Field struct {
+				Name string
+			} `graphql:"... on ProjectV2Field"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldTextValue"`
+	ProjectV2ItemFieldIterationValue struct {
+		Title string
+		Field struct {
+			ProjectV2IterationField struct {
+				Name string
+			} `graphql:"... on ProjectV2IterationField"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldIterationValue"`
+	ProjectV2ItemFieldNumberValue struct {
+		Number float64
+		Field  struct {
+			ProjectV2Field struct {
+				Name string
+			} `graphql:"... on ProjectV2Field"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+	ProjectV2ItemFieldDateValue struct {
+		Date  string
+		Field struct {
+			ProjectV2Field struct {
+				Name string
+			} `graphql:"... on ProjectV2Field"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldDateValue"`
+}
+
+// fieldValue converts the node to a FieldValue; ok is false for empty or
+// unsupported values
+func (n itemFieldValueNode) fieldValue() (FieldValue, bool) {
+	switch n.TypeName {
+	case "ProjectV2ItemFieldSingleSelectValue":
+		v := n.ProjectV2ItemFieldSingleSelectValue
+		return FieldValue{Field: v.Field.ProjectV2SingleSelectField.Name, Value: v.Name, UpdatedAt: v.UpdatedAt}, v.Name != ""
+	case "ProjectV2ItemFieldTextValue":
+		v := n.ProjectV2ItemFieldTextValue
+		return FieldValue{Field: v.Field.ProjectV2Field.Name, Value: v.Text}, v.Text != ""
+	case "ProjectV2ItemFieldIterationValue":
+		v := n.ProjectV2ItemFieldIterationValue
+		return FieldValue{Field: v.Field.ProjectV2IterationField.Name, Value: v.Title}, v.Title != ""
+	case "ProjectV2ItemFieldNumberValue":
+		v := n.ProjectV2ItemFieldNumberValue
+		return FieldValue{Field: v.Field.ProjectV2Field.Name, Value: strconv.FormatFloat(v.Number, 'f', -1, 64)}, true
+	case "ProjectV2ItemFieldDateValue":
+		v := n.ProjectV2ItemFieldDateValue
+		return FieldValue{Field: v.Field.ProjectV2Field.Name, Value: v.Date}, v.Date != ""
+	}
+	return FieldValue{}, false
+}
+
+// issueProjectItemNode is an issue's project item as returned by
+// Issue.projectItems, which unlike ProjectV2.items includes archived items
+type issueProjectItemNode struct {
+	ID         string
+	DatabaseID int `graphql:"databaseId"`
+	IsArchived bool
+	Project    struct {
+		ID string
+	}
+	FieldValues struct {
+		Nodes []itemFieldValueNode
+	} `graphql:"fieldValues(first: 20)"`
+}
+
+// toProjectItem converts the node to a ProjectItem for issue
+func (n issueProjectItemNode) toProjectItem(issue *Issue) ProjectItem {
+	item := ProjectItem{
+		ID:         n.ID,
+		DatabaseID: n.DatabaseID,
+		Issue:      issue,
+		IsArchived: n.IsArchived,
+	}
+	for _, fv := range n.FieldValues.Nodes {
+		if value, ok := fv.fieldValue(); ok {
+			item.FieldValues = append(item.FieldValues, value)
+		}
+	}
+	return item
+}
+
+// GetIssueProjectItem fetches an issue's item in the given project,
+// including archived items. Returns nil if the issue is not in the project.
+func (c *Client) GetIssueProjectItem(owner, repo string, number int, projectID string) (*ProjectItem, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var query struct {
+		Repository struct {
+			Issue struct {
+				ProjectItems struct {
+					Nodes []issueProjectItemNode
+				} `graphql:"projectItems(first: 20, includeArchived: true)"`
+			} `graphql:"issue(number: $number)"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  graphql.String(owner),
+		"repo":   graphql.String(repo),
+		"number": graphql.Int(number),
+	}
+
+	err := c.gql.Query("GetIssueProjectItem", &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project item for %s/%s#%d: %w", owner, repo, number, err)
+	}
+
+	for _, node := range query.Repository.Issue.ProjectItems.Nodes {
+		if node.Project.ID == projectID {
+			item := node.toProjectItem(nil)
+			return &item, nil
+		}
+	}
+	return nil, nil
+}
+
+// archivedIssue is an issue with its archived item in a project and the
+// activity needed by reports
+type archivedIssue struct {
+	Item     ProjectItem
+	Activity IssueActivity
+}
+
+// GetArchivedProjectItems fetches the project's archived issue items.
+// ProjectV2.items omits archived items, so the issues of the given
+// repositories (owner/repo) are scanned for archived items in the project.
+func (c *Client) GetArchivedProjectItems(projectID string, repositories []string) ([]ProjectItem, error) {
+	archived, err := c.getArchivedIssues(projectID, repositories)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]ProjectItem, 0, len(archived))
+	for _, a := range archived {
+		items = append(items, a.Item)
+	}
+	return items, nil
+}
+
+// GetArchivedIssueActivity fetches issue activity for the project's archived
+// items, the counterpart of GetProjectIssueActivity
+func (c *Client) GetArchivedIssueActivity(projectID string, repositories []string) ([]IssueActivity, error) {
+	archived, err := c.getArchivedIssues(projectID, repositories)
+	if err != nil {
+		return nil, err
+	}
+
+	activities := make([]IssueActivity, 0, len(archived))
+	for _, a := range archived {
+		activities = append(activities, a.Activity)
+	}
+	return activities, nil
+}
+
+// getArchivedIssues scans each repository's issues for archived project items
+func (c *Client) getArchivedIssues(projectID string, repositories []string) ([]archivedIssue, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var all []archivedIssue
+	for _, fullName := range repositories {
+		parts := splitRepoName(fullName)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid repository format %q: expected owner/repo", fullName)
+		}
+
+		var cursor *string
+		for {
+			archived, page, err := c.getArchivedIssuesPage(projectID, parts[0], parts[1], cursor)
+			if err != nil {
+				return nil, err
+			}
+			all = append(all, archived...)
+
+			if !page.HasNextPage {
+				break
+			}
+			cursor = &page.EndCursor
+		}
+	}
+
+	return all, nil
+}
+
+// getArchivedIssuesPage fetches a single page of a repository's issues and
+// keeps those with an archived item in the project
+func (c *Client) getArchivedIssuesPage(projectID, owner, repo string, cursor *string) ([]archivedIssue, pageInfo, error) {
+	var query struct {
+		Repository struct {
+			Issues struct {
+				Nodes []struct {
+					ID        string
+					Number    int
+					Title     string
+					State     string
+					URL       string `graphql:"url"`
+					CreatedAt string
+					Author    struct {
+						Login string
+					}
+					Assignees struct {
+						Nodes []struct {
+							Login string
+						}
+					} `graphql:"assignees(first: 10)"`
+					Labels struct {
+						Nodes []struct {
+							Name string
+						}
+					} `graphql:"labels(first: 20)"`
+					Comments struct {
+						Nodes []struct {
+							ID                string
+							CreatedAt         string
+							AuthorAssociation string
+							Author            struct {
+								Login string
+							}
+						}
+					} `graphql:"comments(first: 20)"`
+					ProjectItems struct {
+						Nodes []issueProjectItemNode
+					} `graphql:"projectItems(first: 10, includeArchived: true)"`
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"issues(first: 50, after: $cursor, orderBy: {field: UPDATED_AT, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  graphql.String(owner),
+		"repo":   graphql.String(repo),
+		"cursor": (*graphql.String)(nil),
+	}
+	if cursor != nil {
+		variables["cursor"] = graphql.String(*cursor)
+	}
+
+	err := c.gql.Query("GetArchivedProjectItems", &query, variables)
+	if err != nil {
+		return nil, pageInfo{}, fmt.Errorf("failed to get archived items from %s/%s: %w", owner, repo, err)
+	}
+
+	var archived []archivedIssue
+	for _, node := range query.Repository.Issues.Nodes {
+		for _, itemNode := range node.ProjectItems.Nodes {
+			if !itemNode.IsArchived || itemNode.Project.ID != projectID {
+				continue
+			}
+
+			issue := &Issue{
+				ID:         node.ID,
+				Number:     node.Number,
+				Title:      node.Title,
+				State:      node.State,
+				URL:        node.URL,
+				Author:     Actor{Login: node.Author.Login},
+				Repository: Repository{Owner: owner, Name: repo},
+			}
+			for _, a := range node.Assignees.Nodes {
+				issue.Assignees = append(issue.Assignees, Actor{Login: a.Login})
+			}
+			for _, l := range node.Labels.Nodes {
+				issue.Labels = append(issue.Labels, Label{Name: l.Name})
+			}
+
+			item := itemNode.toProjectItem(issue)
+			activity := IssueActivity{
+				Issue:       *issue,
+				CreatedAt:   node.CreatedAt,
+				FieldValues: item.FieldValues,
+			}
+			for _, cm := range node.Comments.Nodes {
+				activity.Comments = append(activity.Comments, Comment{
+					ID:                cm.ID,
+					Author:            cm.Author.Login,
+					AuthorAssociation: cm.AuthorAssociation,
+					CreatedAt:         cm.CreatedAt,
+				})
+			}
+			for _, fv := range itemNode.FieldValues.Nodes {
+				v := fv.ProjectV2ItemFieldSingleSelectValue
+				if fv.TypeName == "ProjectV2ItemFieldSingleSelectValue" && v.Name != "" {
+					activity.FieldUpdates = append(activity.FieldUpdates, FieldUpdate{
+						Field:     v.Field.ProjectV2SingleSelectField.Name,
+						Value:     v.Name,
+						UpdatedAt: v.UpdatedAt,
+					})
+				}
+			}
+
+			archived = append(archived, archivedIssue{Item: item, Activity: activity})
+			break
+		}
+	}
+
+	return archived, pageInfo{
+		HasNextPage: query.Repository.Issues.PageInfo.HasNextPage,
+		EndCursor:   query.Repository.Issues.PageInfo.EndCursor,
+	}, nil
+}
+
+// DateTime is the GraphQL DateTime scalar, an RFC 3339 timestamp
+type DateTime string
+
+// GetChangedIssues fetches a repository's issues updated at or after since
+// (RFC 3339), most recently updated first, with their items in the project.
+// Archived items count as not in the project. This lets syncs fetch only
+// what changed instead of every project item.
+func (c *Client) GetChangedIssues(projectID, owner, repo, since string) ([]IssueChange, error) {
+	if c.gql == nil {
+		return nil, fmt.Errorf("GraphQL client not initialized - are you authenticated with gh?")
+	}
+
+	var all []IssueChange
+	var cursor *string
+	for {
+		changes, page, err := c.getChangedIssuesPage(projectID, owner, repo, since, cursor)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, changes...)
+
+		if !page.HasNextPage {
+			break
+		}
+		cursor = &page.EndCursor
+	}
+
+	return all, nil
+}
+
+// getChangedIssuesPage fetches a single page of changed issues
+func (c *Client) getChangedIssuesPage(projectID, owner, repo, since string, cursor *string) ([]IssueChange, pageInfo, error) {
+	var query struct {
+		Repository struct {
+			Issues struct {
+				Nodes []struct {
+					ID           string
+					Number       int
+					Title        string
+					Body         string
+					State        string
+					URL          string `graphql:"url"`
+					UpdatedAt    string
+					ProjectItems struct {
+						Nodes []issueProjectItemNode
+					} `graphql:"projectItems(first: 10, includeArchived: true)"`
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"issues(first: 50, after: $cursor, filterBy: {since: $since}, orderBy: {field: UPDATED_AT, direction: DESC})"`
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  graphql.String(owner),
+		"repo":   graphql.String(repo),
+		"since":  DateTime(since),
+		"cursor": (*graphql.String)(nil),
+	}
+	if cursor != nil {
+		variables["cursor"] = graphql.String(*cursor)
+	}
+
+	err := c.gql.Query("GetChangedIssues", &query, variables)
+	if err != nil {
+		return nil, pageInfo{}, fmt.Errorf("failed to get changed issues from %s/%s: %w", owner, repo, err)
+	}
+
+	var changes []IssueChange
+	for _, node := range query.Repository.Issues.Nodes {
+		change := IssueChange{
+			Issue: Issue{
+				ID:         node.ID,
+				Number:     node.Number,
+				Title:      node.Title,
+				Body:       node.Body,
+				State:      node.State,
+				URL:        node.URL,
+				Repository: Repository{Owner: owner, Name: repo},
+			},
+			UpdatedAt: node.UpdatedAt,
+		}
+		for _, itemNode := range node.ProjectItems.Nodes {
+			if itemNode.Project.ID == projectID && !itemNode.IsArchived {
+				item := itemNode.toProjectItem(&change.Issue)
+				change.Item = &item
+				break
+			}
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, pageInfo{
+		HasNextPage: query.Repository.Issues.PageInfo.HasNextPage,
+		EndCursor:   query.Repository.Issues.PageInfo.EndCursor,
+	}, nil
+}
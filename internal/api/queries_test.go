@@ -1,10 +1,14 @@
 package api
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
+
+	graphql "github.com/cli/shurcooL-graphql"
 )
 
 func TestSplitRepoName(t *testing.T) {
@@ -467,6 +471,8 @@ func TestGetParentIssue_HasParent(t *testing.T) {
 				parent.FieldByName("Title").SetString("Parent Issue")
 				parent.FieldByName("State").SetString("OPEN")
 				parent.FieldByName("URL").SetString("https://github.com/owner/repo/issues/42")
+				parent.FieldByName("Repository").FieldByName("Name").SetString("repo")
+				parent.FieldByName("Repository").FieldByName("Owner").FieldByName("Login").SetString("owner")
 			}
 			return nil
 		},
@@ -487,6 +493,9 @@ func TestGetParentIssue_HasParent(t *testing.T) {
 	if parent.Number != 42 {
 		t.Errorf("Expected parent number 42, got %d", parent.Number)
 	}
+	if parent.Repository.Owner != "owner" || parent.Repository.Name != "repo" {
+		t.Errorf("Expected parent repository owner/repo, got %+v", parent.Repository)
+	}
 }
 
 func TestGetParentIssue_QueryError(t *testing.T) {
@@ -785,6 +794,139 @@ func TestListProjects_BothFail(t *testing.T) {
 	}
 }
 
+func TestGetProject_PersonalProjectMissing(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name == "GetUserProject" {
+				return errors.New("Could not resolve to a ProjectV2 with the number 9")
+			}
+			if name == "GetOrgProject" {
+				return errors.New("Could not resolve to an Organization with the login of 'octocat'")
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	_, err := client.GetProject("octocat", 9)
+
+	if err == nil {
+		t.Fatal("Expected error for missing personal project")
+	}
+	if !strings.Contains(err.Error(), "ProjectV2 with the number 9") {
+		t.Errorf("Expected the user lookup error, got: %v", err)
+	}
+}
+
+func TestGetProject_EmptyUserProjectFallsBackToOrg(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name == "GetOrgProject" {
+				v := reflect.ValueOf(query).Elem()
+				v.FieldByName("Organization").FieldByName("ProjectV2").FieldByName("ID").SetString("org-proj")
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	project, err := client.GetProject("org", 1)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if project.ID != "org-proj" || project.Owner.Type != "Organization" {
+		t.Errorf("Expected organization project, got %+v", project)
+	}
+}
+
+func TestGetProject_ViewerOwner(t *testing.T) {
+	var gotOwner interface{}
+	viewerQueries := 0
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			switch name {
+			case "GetViewer":
+				viewerQueries++
+				v := reflect.ValueOf(query).Elem()
+				v.FieldByName("Viewer").FieldByName("Login").SetString("octocat")
+			case "GetUserProject":
+				gotOwner = variables["owner"]
+				v := reflect.ValueOf(query).Elem()
+				v.FieldByName("User").FieldByName("ProjectV2").FieldByName("ID").SetString("user-proj")
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	for i := 0; i < 2; i++ {
+		project, err := client.GetProject(ViewerOwner, 1)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if project.Owner.Login != "octocat" || project.Owner.Type != "User" {
+			t.Errorf("Expected octocat's user project, got %+v", project.Owner)
+		}
+	}
+
+	if gotOwner != graphql.String("octocat") {
+		t.Errorf("Expected owner variable octocat, got %v", gotOwner)
+	}
+	if viewerQueries != 1 {
+		t.Errorf("Expected viewer login to be cached, queried %d times", viewerQueries)
+	}
+}
+
+func TestGetViewerLogin_Error(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("unauthorized")
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	_, err := client.GetViewerLogin()
+	if err == nil || !strings.Contains(err.Error(), "failed to get authenticated user") {
+		t.Errorf("Expected authenticated user error, got %v", err)
+	}
+}
+
+func TestResolveOwner_PassesThroughLogins(t *testing.T) {
+	client := NewClientWithGraphQL(&queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			t.Errorf("Unexpected query %s", name)
+			return nil
+		},
+	})
+
+	owner, err := client.ResolveOwner("my-org")
+	if err != nil || owner != "my-org" {
+		t.Errorf("ResolveOwner() = %q, %v", owner, err)
+	}
+}
+
+func TestListProjects_UserWithoutProjects(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name == "ListOrgProjects" {
+				return errors.New("Could not resolve to an Organization with the login of 'octocat'")
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	projects, err := client.ListProjects("octocat")
+
+	if err != nil {
+		t.Fatalf("Expected no error for a user without projects, got %v", err)
+	}
+	if len(projects) != 0 {
+		t.Errorf("Expected no projects, got %d", len(projects))
+	}
+}
+
 // ============================================================================
 // GetProjectFields Additional Tests
 // ============================================================================
@@ -810,6 +952,127 @@ func TestGetProjectFields_QueryError(t *testing.T) {
 	}
 }
 
+func TestGetProjectViews(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectViews" {
+				t.Errorf("Expected query name 'GetProjectViews', got '%s'", name)
+			}
+			v := reflect.ValueOf(query).Elem()
+			nodes := v.FieldByName("Node").FieldByName("ProjectV2").FieldByName("Views").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 2, 2)
+
+			board := newNodes.Index(0)
+			board.FieldByName("ID").SetString("PVTV_1")
+			board.FieldByName("Number").SetInt(1)
+			board.FieldByName("Name").SetString("Priority board")
+			board.FieldByName("Layout").SetString("BOARD_LAYOUT")
+
+			table := newNodes.Index(1)
+			table.FieldByName("ID").SetString("PVTV_2")
+			table.FieldByName("Name").SetString("Backlog")
+			table.FieldByName("Filter").SetString("is:open")
+			sorts := table.FieldByName("SortByFields").FieldByName("Nodes")
+			newSorts := reflect.MakeSlice(sorts.Type(), 1, 1)
+			newSorts.Index(0).FieldByName("Direction").SetString("DESC")
+			newSorts.Index(0).FieldByName("Field").FieldByName("ProjectV2SingleSelectField").FieldByName("Name").SetString("Priority")
+			sorts.Set(newSorts)
+
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	views, err := client.GetProjectViews("proj-id")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(views) != 2 {
+		t.Fatalf("Expected 2 views, got %d", len(views))
+	}
+	if views[0].Name != "Priority board" || views[0].Layout != "BOARD_LAYOUT" || len(views[0].SortBy) != 0 {
+		t.Errorf("Unexpected board view: %+v", views[0])
+	}
+	if views[1].Filter != "is:open" || len(views[1].SortBy) != 1 || views[1].SortBy[0] != "Priority DESC" {
+		t.Errorf("Unexpected backlog view: %+v", views[1])
+	}
+}
+
+func TestGetProjectViews_Error(t *testing.T) {
+	client := NewClientWithGraphQL(&queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("boom")
+		},
+	})
+	if _, err := client.GetProjectViews("proj-id"); err == nil || !strings.Contains(err.Error(), "failed to get project views") {
+		t.Errorf("Expected wrapped error, got %v", err)
+	}
+
+	client = &Client{gql: nil}
+	if _, err := client.GetProjectViews("proj-id"); err == nil {
+		t.Error("Expected error when gql is nil")
+	}
+}
+
+func TestGetProjectFields_IterationField(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			v := reflect.ValueOf(query).Elem()
+			nodes := v.FieldByName("Node").FieldByName("ProjectV2").FieldByName("Fields").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+
+			node := reflect.New(nodes.Type().Elem()).Elem()
+			node.FieldByName("TypeName").SetString("ProjectV2IterationField")
+			field := node.FieldByName("ProjectV2IterationField")
+			field.FieldByName("ID").SetString("PVTIF_1")
+			field.FieldByName("Name").SetString("Sprint")
+			field.FieldByName("DataType").SetString("ITERATION")
+
+			cfg := field.FieldByName("Configuration")
+			for _, spec := range []struct {
+				name, id, title, start string
+			}{
+				{"CompletedIterations", "it-1", "Sprint 1", "2024-01-01"},
+				{"Iterations", "it-2", "Sprint 2", "2024-01-15"},
+			} {
+				list := cfg.FieldByName(spec.name)
+				newList := reflect.MakeSlice(list.Type(), 1, 1)
+				it := reflect.New(list.Type().Elem()).Elem()
+				it.FieldByName("ID").SetString(spec.id)
+				it.FieldByName("Title").SetString(spec.title)
+				it.FieldByName("StartDate").SetString(spec.start)
+				it.FieldByName("Duration").SetInt(14)
+				newList.Index(0).Set(it)
+				list.Set(newList)
+			}
+
+			newNodes.Index(0).Set(node)
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	fields, err := client.GetProjectFields("proj-id")
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(fields) != 1 || fields[0].DataType != "ITERATION" || fields[0].Name != "Sprint" {
+		t.Fatalf("Expected Sprint iteration field, got %+v", fields)
+	}
+	if len(fields[0].Iterations) != 2 {
+		t.Fatalf("Expected completed and active iterations, got %+v", fields[0].Iterations)
+	}
+	if fields[0].Iterations[0].Title != "Sprint 1" || fields[0].Iterations[1].Title != "Sprint 2" {
+		t.Errorf("Expected completed iterations first, got %+v", fields[0].Iterations)
+	}
+	if fields[0].Iterations[1].StartDate != "2024-01-15" || fields[0].Iterations[1].Duration != 14 {
+		t.Errorf("Unexpected iteration dates: %+v", fields[0].Iterations[1])
+	}
+}
+
 // ============================================================================
 // GetIssue Tests - Improved Coverage
 // ============================================================================
@@ -1010,6 +1273,7 @@ func TestGetProjectItems_WithItems(t *testing.T) {
 				newNode := reflect.New(nodeType).Elem()
 
 				newNode.FieldByName("ID").SetString("item-1")
+				newNode.FieldByName("DatabaseID").SetInt(1001)
 
 				// Set content
 				content := newNode.FieldByName("Content")
@@ -1051,6 +1315,9 @@ func TestGetProjectItems_WithItems(t *testing.T) {
 	if items[0].Issue.Repository.Owner != "owner" {
 		t.Errorf("Expected repository owner 'owner', got '%s'", items[0].Issue.Repository.Owner)
 	}
+	if items[0].DatabaseID != 1001 {
+		t.Errorf("Expected database ID 1001, got %d", items[0].DatabaseID)
+	}
 }
 
 func TestGetProjectItems_WithFilter(t *testing.T) {
@@ -1259,6 +1526,137 @@ func TestGetProjectItems_WithFieldValues(t *testing.T) {
 	}
 }
 
+func TestGetProjectItems_WithIterationValue(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			v := reflect.ValueOf(query).Elem()
+			nodes := v.FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+
+			node := reflect.New(nodes.Type().Elem()).Elem()
+			node.FieldByName("ID").SetString("item-1")
+			content := node.FieldByName("Content")
+			content.FieldByName("TypeName").SetString("Issue")
+			content.FieldByName("Issue").FieldByName("Number").SetInt(1)
+
+			fvNodes := node.FieldByName("FieldValues").FieldByName("Nodes")
+			newFvNodes := reflect.MakeSlice(fvNodes.Type(), 1, 1)
+			fv := reflect.New(fvNodes.Type().Elem()).Elem()
+			fv.FieldByName("TypeName").SetString("ProjectV2ItemFieldIterationValue")
+			iteration := fv.FieldByName("ProjectV2ItemFieldIterationValue")
+			iteration.FieldByName("Title").SetString("Sprint 2")
+			iteration.FieldByName("Field").FieldByName("ProjectV2IterationField").FieldByName("Name").SetString("Sprint")
+			newFvNodes.Index(0).Set(fv)
+			fvNodes.Set(newFvNodes)
+
+			newNodes.Index(0).Set(node)
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 || len(items[0].FieldValues) != 1 {
+		t.Fatalf("Expected 1 item with 1 field value, got %+v", items)
+	}
+	if fv := items[0].FieldValues[0]; fv.Field != "Sprint" || fv.Value != "Sprint 2" {
+		t.Errorf("Expected Sprint=Sprint 2, got %s=%s", fv.Field, fv.Value)
+	}
+}
+
+func TestGetProjectItems_WithNumberValue(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			v := reflect.ValueOf(query).Elem()
+			nodes := v.FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+
+			node := reflect.New(nodes.Type().Elem()).Elem()
+			node.FieldByName("ID").SetString("item-1")
+			content := node.FieldByName("Content")
+			content.FieldByName("TypeName").SetString("Issue")
+			content.FieldByName("Issue").FieldByName("Number").SetInt(1)
+
+			fvNodes := node.FieldByName("FieldValues").FieldByName("Nodes")
+			newFvNodes := reflect.MakeSlice(fvNodes.Type(), 1, 1)
+			fv := reflect.New(fvNodes.Type().Elem()).Elem()
+			fv.FieldByName("TypeName").SetString("ProjectV2ItemFieldNumberValue")
+			number := fv.FieldByName("ProjectV2ItemFieldNumberValue")
+			number.FieldByName("Number").SetFloat(3.5)
+			number.FieldByName("Field").FieldByName("ProjectV2Field").FieldByName("Name").SetString("Time Spent")
+			newFvNodes.Index(0).Set(fv)
+			fvNodes.Set(newFvNodes)
+
+			newNodes.Index(0).Set(node)
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 || len(items[0].FieldValues) != 1 {
+		t.Fatalf("Expected 1 item with 1 field value, got %+v", items)
+	}
+	if fv := items[0].FieldValues[0]; fv.Field != "Time Spent" || fv.Value != "3.5" {
+		t.Errorf("Expected Time Spent=3.5, got %s=%s", fv.Field, fv.Value)
+	}
+}
+
+func TestGetProjectItems_WithDateValue(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			v := reflect.ValueOf(query).Elem()
+			nodes := v.FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+
+			node := reflect.New(nodes.Type().Elem()).Elem()
+			node.FieldByName("ID").SetString("item-1")
+			content := node.FieldByName("Content")
+			content.FieldByName("TypeName").SetString("Issue")
+			content.FieldByName("Issue").FieldByName("Number").SetInt(1)
+
+			fvNodes := node.FieldByName("FieldValues").FieldByName("Nodes")
+			newFvNodes := reflect.MakeSlice(fvNodes.Type(), 2, 2)
+			for i, date := range []string{"2026-10-16", ""} {
+				fv := newFvNodes.Index(i)
+				fv.FieldByName("TypeName").SetString("ProjectV2ItemFieldDateValue")
+				value := fv.FieldByName("ProjectV2ItemFieldDateValue")
+				value.FieldByName("Date").SetString(date)
+				value.FieldByName("Field").FieldByName("ProjectV2Field").FieldByName("Name").SetString("Due Date")
+			}
+			fvNodes.Set(newFvNodes)
+
+			newNodes.Index(0).Set(node)
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// An unset date is skipped
+	if len(items) != 1 || len(items[0].FieldValues) != 1 {
+		t.Fatalf("Expected 1 item with 1 field value, got %+v", items)
+	}
+	if fv := items[0].FieldValues[0]; fv.Field != "Due Date" || fv.Value != "2026-10-16" {
+		t.Errorf("Expected Due Date=2026-10-16, got %s=%s", fv.Field, fv.Value)
+	}
+}
+
 func TestGetProjectItems_WithAssignees(t *testing.T) {
 	mock := &queryMockClient{
 		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
@@ -1318,6 +1716,52 @@ func TestGetProjectItems_WithAssignees(t *testing.T) {
 	}
 }
 
+func TestGetProjectItems_WithLabels(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name == "GetProjectItems" {
+				v := reflect.ValueOf(query).Elem()
+				nodes := v.FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items").FieldByName("Nodes")
+
+				newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+				newNode := reflect.New(nodes.Type().Elem()).Elem()
+
+				newNode.FieldByName("ID").SetString("item-1")
+				content := newNode.FieldByName("Content")
+				content.FieldByName("TypeName").SetString("Issue")
+				issue := content.FieldByName("Issue")
+				issue.FieldByName("Number").SetInt(1)
+				issue.FieldByName("Repository").FieldByName("NameWithOwner").SetString("owner/repo")
+
+				// Set labels
+				labelNodes := issue.FieldByName("Labels").FieldByName("Nodes")
+				newLabelNodes := reflect.MakeSlice(labelNodes.Type(), 2, 2)
+				newLabelNodes.Index(0).FieldByName("Name").SetString("bug")
+				newLabelNodes.Index(1).FieldByName("Name").SetString("pm-tracked")
+				labelNodes.Set(newLabelNodes)
+
+				newNodes.Index(0).Set(newNode)
+				nodes.Set(newNodes)
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	items, err := client.GetProjectItems("proj-id", nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("Expected 1 item, got %d", len(items))
+	}
+	labels := items[0].Issue.Labels
+	if len(labels) != 2 || labels[0].Name != "bug" || labels[1].Name != "pm-tracked" {
+		t.Errorf("Expected labels bug and pm-tracked, got %+v", labels)
+	}
+}
+
 // ============================================================================
 // GetSubIssues Tests - Improved Coverage
 // ============================================================================
@@ -1543,6 +1987,52 @@ func TestGetProjectItems_Pagination_MultiplePages(t *testing.T) {
 	}
 }
 
+func TestEachProjectItemsBatch_StopsEarly(t *testing.T) {
+	callCount := 0
+
+	// Every page has one item and another page after it
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name == "GetProjectItems" {
+				callCount++
+				items := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items")
+				nodes := items.FieldByName("Nodes")
+				newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+				node := reflect.New(nodes.Type().Elem()).Elem()
+				node.FieldByName("ID").SetString(fmt.Sprintf("item-%d", callCount))
+				content := node.FieldByName("Content")
+				content.FieldByName("TypeName").SetString("Issue")
+				content.FieldByName("Issue").FieldByName("Number").SetInt(int64(callCount))
+				content.FieldByName("Issue").FieldByName("Repository").FieldByName("NameWithOwner").SetString("owner/repo")
+				newNodes.Index(0).Set(node)
+				nodes.Set(newNodes)
+				items.FieldByName("PageInfo").FieldByName("HasNextPage").SetBool(true)
+				items.FieldByName("PageInfo").FieldByName("EndCursor").SetString(fmt.Sprintf("cursor-%d", callCount))
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	var numbers []int
+	err := client.EachProjectItemsBatch("proj-id", nil, func(batch []ProjectItem) error {
+		for _, item := range batch {
+			numbers = append(numbers, item.Issue.Number)
+		}
+		if len(numbers) == 2 {
+			return ErrStopBatches
+		}
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if callCount != 2 || len(numbers) != 2 || numbers[1] != 2 {
+		t.Errorf("Expected two pages fetched one at a time, got %d calls and %v", callCount, numbers)
+	}
+}
+
 func TestGetProjectItems_Pagination_SinglePage(t *testing.T) {
 	callCount := 0
 
@@ -1825,3 +2315,856 @@ func TestGetProjectItems_Pagination_WithFilter(t *testing.T) {
 		t.Errorf("Expected second item 'Match 2', got '%s'", items[1].Issue.Title)
 	}
 }
+
+// ============================================================================
+// GetProjectIssueActivity Tests
+// ============================================================================
+
+func TestGetProjectIssueActivity_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+
+	activities, err := client.GetProjectIssueActivity("proj-id", nil)
+
+	if err == nil {
+		t.Fatal("Expected error when gql is nil, got nil")
+	}
+	if activities != nil {
+		t.Error("Expected nil activities when error occurs")
+	}
+}
+
+func TestGetProjectIssueActivity_QueryError(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("network error")
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	_, err := client.GetProjectIssueActivity("proj-id", nil)
+
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "failed to get project issue activity") {
+		t.Errorf("Expected wrapped error, got: %v", err)
+	}
+}
+
+func TestGetProjectIssueActivity_ParsesTimestamps(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetProjectIssueActivity" {
+				return errors.New("unexpected query")
+			}
+			v := reflect.ValueOf(query).Elem()
+			items := v.FieldByName("Node").FieldByName("ProjectV2").FieldByName("Items")
+			nodes := items.FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 2, 2)
+
+			// Issue item with one comment and one single-select value
+			node := reflect.New(nodes.Type().Elem()).Elem()
+			content := node.FieldByName("Content")
+			content.FieldByName("TypeName").SetString("Issue")
+			issue := content.FieldByName("Issue")
+			issue.FieldByName("Number").SetInt(7)
+			issue.FieldByName("CreatedAt").SetString("2024-01-01T10:00:00Z")
+			issue.FieldByName("Author").FieldByName("Login").SetString("reporter")
+			issue.FieldByName("Repository").FieldByName("NameWithOwner").SetString("owner/repo")
+
+			comments := issue.FieldByName("Comments").FieldByName("Nodes")
+			newComments := reflect.MakeSlice(comments.Type(), 1, 1)
+			comment := reflect.New(comments.Type().Elem()).Elem()
+			comment.FieldByName("CreatedAt").SetString("2024-01-01T11:00:00Z")
+			comment.FieldByName("AuthorAssociation").SetString("MEMBER")
+			comment.FieldByName("Author").FieldByName("Login").SetString("alice")
+			newComments.Index(0).Set(comment)
+			comments.Set(newComments)
+
+			fvNodes := node.FieldByName("FieldValues").FieldByName("Nodes")
+			newFvNodes := reflect.MakeSlice(fvNodes.Type(), 1, 1)
+			fv := reflect.New(fvNodes.Type().Elem()).Elem()
+			fv.FieldByName("TypeName").SetString("ProjectV2ItemFieldSingleSelectValue")
+			ss := fv.FieldByName("ProjectV2ItemFieldSingleSelectValue")
+			ss.FieldByName("Name").SetString("P1")
+			ss.FieldByName("UpdatedAt").SetString("2024-01-01T12:00:00Z")
+			ss.FieldByName("Field").FieldByName("ProjectV2SingleSelectField").FieldByName("Name").SetString("Priority")
+			newFvNodes.Index(0).Set(fv)
+			fvNodes.Set(newFvNodes)
+			newNodes.Index(0).Set(node)
+
+			// Draft issue should be skipped
+			draft := reflect.New(nodes.Type().Elem()).Elem()
+			draft.FieldByName("Content").FieldByName("TypeName").SetString("DraftIssue")
+			newNodes.Index(1).Set(draft)
+
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	activities, err := client.GetProjectIssueActivity("proj-id", nil)
+
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(activities) != 1 {
+		t.Fatalf("Expected 1 activity, got %d", len(activities))
+	}
+
+	a := activities[0]
+	if a.CreatedAt != "2024-01-01T10:00:00Z" || a.Issue.Author.Login != "reporter" {
+		t.Errorf("Unexpected issue data: %+v", a)
+	}
+	if a.Issue.Repository.Owner != "owner" || a.Issue.Repository.Name != "repo" {
+		t.Errorf("Expected repository owner/repo, got %+v", a.Issue.Repository)
+	}
+	if len(a.Comments) != 1 || a.Comments[0].AuthorAssociation != "MEMBER" || a.Comments[0].Author != "alice" {
+		t.Errorf("Unexpected comments: %+v", a.Comments)
+	}
+	if len(a.FieldUpdates) != 1 || a.FieldUpdates[0].Field != "Priority" || a.FieldUpdates[0].UpdatedAt != "2024-01-01T12:00:00Z" {
+		t.Errorf("Unexpected field updates: %+v", a.FieldUpdates)
+	}
+	if len(a.FieldValues) != 1 || a.FieldValues[0].Value != "P1" {
+		t.Errorf("Unexpected field values: %+v", a.FieldValues)
+	}
+}
+
+// ============================================================================
+// GetIssueProjectHistory Tests
+// ============================================================================
+
+func TestGetIssueProjectHistory_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+
+	_, err := client.GetIssueProjectHistory("owner", "repo", 1)
+	if err == nil {
+		t.Fatal("Expected error when gql is nil")
+	}
+}
+
+func TestGetIssueProjectHistory_QueryError(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("network error")
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	_, err := client.GetIssueProjectHistory("owner", "repo", 1)
+	if err == nil || !strings.Contains(err.Error(), "failed to get project history") {
+		t.Errorf("Expected wrapped error, got %v", err)
+	}
+}
+
+func TestGetIssueProjectHistory_ParsesEvents(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetIssueProjectHistory" {
+				return errors.New("unexpected query")
+			}
+			issue := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("Issue")
+
+			// Timeline: status change listed before the add event to verify sorting
+			nodes := issue.FieldByName("TimelineItems").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 2, 2)
+
+			changed := reflect.New(nodes.Type().Elem()).Elem()
+			changed.FieldByName("TypeName").SetString("ProjectV2ItemStatusChangedEvent")
+			ev := changed.FieldByName("ProjectV2ItemStatusChangedEvent")
+			ev.FieldByName("CreatedAt").SetString("2024-02-01T09:00:00Z")
+			ev.FieldByName("Actor").FieldByName("Login").SetString("alice")
+			ev.FieldByName("Project").FieldByName("Number").SetInt(3)
+			ev.FieldByName("PreviousStatus").SetString("Backlog")
+			ev.FieldByName("Status").SetString("In review")
+			newNodes.Index(0).Set(changed)
+
+			added := reflect.New(nodes.Type().Elem()).Elem()
+			added.FieldByName("TypeName").SetString("AddedToProjectV2Event")
+			ae := added.FieldByName("AddedToProjectV2Event")
+			ae.FieldByName("CreatedAt").SetString("2024-01-01T09:00:00Z")
+			ae.FieldByName("Actor").FieldByName("Login").SetString("bot")
+			ae.FieldByName("Project").FieldByName("Number").SetInt(3)
+			ae.FieldByName("Project").FieldByName("Title").SetString("Board")
+			newNodes.Index(1).Set(added)
+			nodes.Set(newNodes)
+
+			// Project item with a Status value (skipped) and a Priority value
+			items := issue.FieldByName("ProjectItems").FieldByName("Nodes")
+			newItems := reflect.MakeSlice(items.Type(), 1, 1)
+			item := reflect.New(items.Type().Elem()).Elem()
+			item.FieldByName("Project").FieldByName("Number").SetInt(3)
+
+			fvNodes := item.FieldByName("FieldValues").FieldByName("Nodes")
+			newFv := reflect.MakeSlice(fvNodes.Type(), 3, 3)
+			for i, pair := range [][3]string{
+				{"Status", "In review", "2024-02-01T09:00:00Z"},
+				{"Priority", "P1", "2024-01-15T09:00:00Z"},
+			} {
+				fv := reflect.New(fvNodes.Type().Elem()).Elem()
+				fv.FieldByName("TypeName").SetString("ProjectV2ItemFieldSingleSelectValue")
+				ss := fv.FieldByName("ProjectV2ItemFieldSingleSelectValue")
+				ss.FieldByName("Field").FieldByName("ProjectV2SingleSelectField").FieldByName("Name").SetString(pair[0])
+				ss.FieldByName("Name").SetString(pair[1])
+				ss.FieldByName("UpdatedAt").SetString(pair[2])
+				newFv.Index(i).Set(fv)
+			}
+			num := reflect.New(fvNodes.Type().Elem()).Elem()
+			num.FieldByName("TypeName").SetString("ProjectV2ItemFieldNumberValue")
+			nv := num.FieldByName("ProjectV2ItemFieldNumberValue")
+			nv.FieldByName("Field").FieldByName("ProjectV2Field").FieldByName("Name").SetString("Estimate")
+			nv.FieldByName("Number").SetFloat(3)
+			nv.FieldByName("UpdatedAt").SetString("2024-01-20T09:00:00Z")
+			newFv.Index(2).Set(num)
+			fvNodes.Set(newFv)
+			newItems.Index(0).Set(item)
+			items.Set(newItems)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	events, err := client.GetIssueProjectHistory("owner", "repo", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(events) != 4 {
+		t.Fatalf("Expected 4 events, got %d: %+v", len(events), events)
+	}
+	if events[0].Type != ProjectEventAdded || events[0].Actor != "bot" || events[0].ProjectTitle != "Board" {
+		t.Errorf("Unexpected first event: %+v", events[0])
+	}
+	if events[1].Type != ProjectEventFieldUpdated || events[1].Field != "Priority" || events[1].To != "P1" {
+		t.Errorf("Unexpected second event: %+v", events[1])
+	}
+	if events[2].Type != ProjectEventFieldUpdated || events[2].Field != "Estimate" || events[2].To != "3" {
+		t.Errorf("Unexpected third event: %+v", events[2])
+	}
+	if events[3].Type != ProjectEventStatusChanged || events[3].From != "Backlog" || events[3].To != "In review" {
+		t.Errorf("Unexpected fourth event: %+v", events[3])
+	}
+}
+
+func TestGetLinkedPullRequests_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+
+	_, err := client.GetLinkedPullRequests("owner", "repo", 1)
+	if err == nil {
+		t.Fatal("Expected error when gql is nil")
+	}
+}
+
+func TestGetLinkedPullRequests_QueryError(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("network error")
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	_, err := client.GetLinkedPullRequests("owner", "repo", 1)
+	if err == nil || !strings.Contains(err.Error(), "failed to get linked pull requests") {
+		t.Errorf("Expected wrapped error, got %v", err)
+	}
+}
+
+func TestGetLinkedPullRequests_MergesClosingAndReferencing(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetLinkedPullRequests" {
+				return errors.New("unexpected query")
+			}
+			issue := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("Issue")
+
+			fillPR := func(pr reflect.Value, number int, url, checks string) {
+				pr.FieldByName("Number").SetInt(int64(number))
+				pr.FieldByName("Title").SetString("PR " + url)
+				pr.FieldByName("State").SetString("OPEN")
+				pr.FieldByName("URL").SetString(url)
+				pr.FieldByName("ReviewDecision").SetString("APPROVED")
+				pr.FieldByName("Repository").FieldByName("NameWithOwner").SetString("owner/repo")
+				if checks != "" {
+					commits := pr.FieldByName("Commits").FieldByName("Nodes")
+					newCommits := reflect.MakeSlice(commits.Type(), 1, 1)
+					rollup := newCommits.Index(0).FieldByName("Commit").FieldByName("StatusCheckRollup")
+					rollup.Set(reflect.New(rollup.Type().Elem()))
+					rollup.Elem().FieldByName("State").SetString(checks)
+					commits.Set(newCommits)
+				}
+			}
+
+			// One closing PR
+			closing := issue.FieldByName("ClosedByPullRequestsReferences").FieldByName("Nodes")
+			newClosing := reflect.MakeSlice(closing.Type(), 1, 1)
+			fillPR(newClosing.Index(0), 10, "https://github.com/owner/repo/pull/10", "SUCCESS")
+			closing.Set(newClosing)
+
+			// Timeline: the closing PR again, a referencing PR, and an issue reference
+			nodes := issue.FieldByName("TimelineItems").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 3, 3)
+			for i, n := range []struct {
+				source string
+				number int
+				url    string
+			}{
+				{"PullRequest", 10, "https://github.com/owner/repo/pull/10"},
+				{"PullRequest", 11, "https://github.com/owner/repo/pull/11"},
+				{"Issue", 12, "https://github.com/owner/repo/issues/12"},
+			} {
+				node := newNodes.Index(i)
+				node.FieldByName("TypeName").SetString("CrossReferencedEvent")
+				src := node.FieldByName("CrossReferencedEvent").FieldByName("Source")
+				src.FieldByName("TypeName").SetString(n.source)
+				fillPR(src.FieldByName("PullRequest"), n.number, n.url, "")
+			}
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	prs, err := client.GetLinkedPullRequests("owner", "repo", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(prs) != 2 {
+		t.Fatalf("Expected 2 linked PRs, got %d: %+v", len(prs), prs)
+	}
+	if prs[0].Number != 10 || !prs[0].Closes || prs[0].ChecksState != "SUCCESS" {
+		t.Errorf("Unexpected closing PR: %+v", prs[0])
+	}
+	if prs[0].Repository.Owner != "owner" || prs[0].Repository.Name != "repo" {
+		t.Errorf("Expected repository owner/repo, got %+v", prs[0].Repository)
+	}
+	if prs[1].Number != 11 || prs[1].Closes || prs[1].ChecksState != "" {
+		t.Errorf("Unexpected referencing PR: %+v", prs[1])
+	}
+}
+
+func TestGetPullRequestClosingIssues_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+
+	_, err := client.GetPullRequestClosingIssues("owner", "repo", 1)
+	if err == nil {
+		t.Fatal("Expected error when gql is nil")
+	}
+}
+
+func TestGetPullRequestClosingIssues_ReturnsIssues(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetPullRequestClosingIssues" {
+				return errors.New("unexpected query")
+			}
+			if variables["number"] != graphql.Int(10) {
+				t.Errorf("Expected number 10, got %v", variables["number"])
+			}
+			nodes := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("IssueOrPullRequest").
+				FieldByName("PullRequest").FieldByName("ClosingIssuesReferences").FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 2, 2)
+			for i, n := range []struct {
+				owner, repo string
+				number      int
+			}{{"owner", "repo", 4}, {"other", "lib", 7}} {
+				node := newNodes.Index(i)
+				node.FieldByName("Number").SetInt(int64(n.number))
+				node.FieldByName("Repository").FieldByName("Name").SetString(n.repo)
+				node.FieldByName("Repository").FieldByName("Owner").FieldByName("Login").SetString(n.owner)
+			}
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	issues, err := client.GetPullRequestClosingIssues("owner", "repo", 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %d", len(issues))
+	}
+	if issues[0].Number != 4 || issues[0].Repository.Owner != "owner" || issues[0].Repository.Name != "repo" {
+		t.Errorf("Unexpected first issue: %+v", issues[0])
+	}
+	if issues[1].Number != 7 || issues[1].Repository.Owner != "other" || issues[1].Repository.Name != "lib" {
+		t.Errorf("Unexpected second issue: %+v", issues[1])
+	}
+}
+
+func TestGetIssueSignals_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+
+	_, err := client.GetIssueSignals("owner", "repo", 1)
+	if err == nil {
+		t.Fatal("Expected error when gql is nil")
+	}
+}
+
+func TestGetIssueSignals_QueryError(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("network error")
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	_, err := client.GetIssueSignals("owner", "repo", 1)
+	if err == nil || !strings.Contains(err.Error(), "failed to get signals") {
+		t.Errorf("Expected wrapped error, got %v", err)
+	}
+}
+
+func TestGetIssueSignals_ParsesFields(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetIssueSignals" {
+				return errors.New("unexpected query")
+			}
+			issue := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("Issue")
+			issue.FieldByName("Body").SetString("Crash on start")
+			issue.FieldByName("CreatedAt").SetString("2024-01-01T00:00:00Z")
+			issue.FieldByName("Reactions").FieldByName("TotalCount").SetInt(7)
+			issue.FieldByName("IssueDependenciesSummary").FieldByName("Blocking").SetInt(2)
+
+			labels := issue.FieldByName("Labels").FieldByName("Nodes")
+			newLabels := reflect.MakeSlice(labels.Type(), 1, 1)
+			newLabels.Index(0).FieldByName("Name").SetString("bug")
+			labels.Set(newLabels)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	signals, err := client.GetIssueSignals("owner", "repo", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if signals.Body != "Crash on start" || signals.Reactions != 7 || signals.Blocking != 2 ||
+		signals.CreatedAt != "2024-01-01T00:00:00Z" || len(signals.Labels) != 1 || signals.Labels[0] != "bug" {
+		t.Errorf("Unexpected signals: %+v", signals)
+	}
+}
+
+// ============================================================================
+// Archived Item Tests
+// ============================================================================
+
+// testItemSpec describes an issue's project item for mocked responses
+type testItemSpec struct {
+	project  string
+	archived bool
+	status   string
+}
+
+// setIssueProjectItemNodes fills a projectItems Nodes slice with items
+func setIssueProjectItemNodes(nodes reflect.Value, items ...testItemSpec) {
+	newNodes := reflect.MakeSlice(nodes.Type(), len(items), len(items))
+	for i, it := range items {
+		n := newNodes.Index(i)
+		n.FieldByName("ID").SetString("item-" + it.project)
+		n.FieldByName("DatabaseID").SetInt(int64(100 + i))
+		n.FieldByName("IsArchived").SetBool(it.archived)
+		n.FieldByName("Project").FieldByName("ID").SetString(it.project)
+
+		fvNodes := n.FieldByName("FieldValues").FieldByName("Nodes")
+		newFV := reflect.MakeSlice(fvNodes.Type(), 1, 1)
+		fv := newFV.Index(0)
+		fv.FieldByName("TypeName").SetString("ProjectV2ItemFieldSingleSelectValue")
+		ss := fv.FieldByName("ProjectV2ItemFieldSingleSelectValue")
+		ss.FieldByName("Name").SetString(it.status)
+		ss.FieldByName("UpdatedAt").SetString("2025-01-02T00:00:00Z")
+		ss.FieldByName("Field").FieldByName("ProjectV2SingleSelectField").FieldByName("Name").SetString("Status")
+		fvNodes.Set(newFV)
+	}
+	nodes.Set(newNodes)
+}
+
+func TestGetIssueProjectItem(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetIssueProjectItem" {
+				return errors.New("unexpected query " + name)
+			}
+			v := reflect.ValueOf(query).Elem()
+			nodes := v.FieldByName("Repository").FieldByName("Issue").FieldByName("ProjectItems").FieldByName("Nodes")
+			setIssueProjectItemNodes(nodes,
+				testItemSpec{"other-proj", false, "Todo"},
+				testItemSpec{"proj-1", true, "Done"},
+			)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	item, err := client.GetIssueProjectItem("owner", "repo", 5, "proj-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if item == nil || !item.IsArchived || item.ID != "item-proj-1" || item.DatabaseID != 101 {
+		t.Fatalf("Expected archived item in proj-1, got %+v", item)
+	}
+	if len(item.FieldValues) != 1 || item.FieldValues[0] != (FieldValue{Field: "Status", Value: "Done", UpdatedAt: "2025-01-02T00:00:00Z"}) {
+		t.Errorf("Unexpected field values: %+v", item.FieldValues)
+	}
+
+	item, err = client.GetIssueProjectItem("owner", "repo", 5, "missing")
+	if err != nil || item != nil {
+		t.Errorf("Expected nil item for another project, got %+v, %v", item, err)
+	}
+}
+
+func TestGetIssueProjectItem_Error(t *testing.T) {
+	client := NewClientWithGraphQL(&queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("boom")
+		},
+	})
+
+	_, err := client.GetIssueProjectItem("owner", "repo", 5, "proj-1")
+	if err == nil || !strings.Contains(err.Error(), "owner/repo#5") {
+		t.Errorf("Expected wrapped error, got %v", err)
+	}
+}
+
+func archivedItemsMock(t *testing.T, pages *int) *queryMockClient {
+	return &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetArchivedProjectItems" {
+				return errors.New("unexpected query " + name)
+			}
+			*pages++
+
+			v := reflect.ValueOf(query).Elem()
+			issues := v.FieldByName("Repository").FieldByName("Issues")
+			nodes := issues.FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 2, 2)
+
+			for i := 0; i < 2; i++ {
+				n := newNodes.Index(i)
+				n.FieldByName("ID").SetString("issue-" + string(rune('a'+i)))
+				n.FieldByName("Number").SetInt(int64(*pages*10 + i))
+				n.FieldByName("Title").SetString("Issue")
+				n.FieldByName("State").SetString("CLOSED")
+				n.FieldByName("CreatedAt").SetString("2025-01-01T00:00:00Z")
+				n.FieldByName("Author").FieldByName("Login").SetString("alice")
+
+				comments := n.FieldByName("Comments").FieldByName("Nodes")
+				newComments := reflect.MakeSlice(comments.Type(), 1, 1)
+				newComments.Index(0).FieldByName("AuthorAssociation").SetString("MEMBER")
+				newComments.Index(0).FieldByName("CreatedAt").SetString("2025-01-01T02:00:00Z")
+				comments.Set(newComments)
+
+				// Only the first issue on each page is archived in proj-1
+				setIssueProjectItemNodes(n.FieldByName("ProjectItems").FieldByName("Nodes"),
+					testItemSpec{"proj-1", i == 0, "Done"})
+			}
+			nodes.Set(newNodes)
+
+			if *pages == 1 {
+				if variables["cursor"] != (*graphql.String)(nil) {
+					t.Errorf("Expected nil cursor on first page, got %v", variables["cursor"])
+				}
+				issues.FieldByName("PageInfo").FieldByName("HasNextPage").SetBool(true)
+				issues.FieldByName("PageInfo").FieldByName("EndCursor").SetString("next")
+			}
+			return nil
+		},
+	}
+}
+
+func TestGetArchivedProjectItems(t *testing.T) {
+	pages := 0
+	client := NewClientWithGraphQL(archivedItemsMock(t, &pages))
+
+	items, err := client.GetArchivedProjectItems("proj-1", []string{"owner/repo"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if pages != 2 {
+		t.Errorf("Expected 2 pages, got %d", pages)
+	}
+	if len(items) != 2 {
+		t.Fatalf("Expected 2 archived items, got %d", len(items))
+	}
+	if items[0].Issue.Number != 10 || items[1].Issue.Number != 20 || !items[0].IsArchived {
+		t.Errorf("Unexpected items: %+v, %+v", items[0], items[1])
+	}
+	if items[0].Issue.Repository != (Repository{Owner: "owner", Name: "repo"}) {
+		t.Errorf("Unexpected repository: %+v", items[0].Issue.Repository)
+	}
+}
+
+func TestGetArchivedIssueActivity(t *testing.T) {
+	pages := 0
+	client := NewClientWithGraphQL(archivedItemsMock(t, &pages))
+
+	activities, err := client.GetArchivedIssueActivity("proj-1", []string{"owner/repo"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(activities) != 2 {
+		t.Fatalf("Expected 2 activities, got %d", len(activities))
+	}
+	a := activities[0]
+	if a.CreatedAt != "2025-01-01T00:00:00Z" || len(a.Comments) != 1 || a.Comments[0].AuthorAssociation != "MEMBER" {
+		t.Errorf("Unexpected activity: %+v", a)
+	}
+	if len(a.FieldUpdates) != 1 || a.FieldUpdates[0].UpdatedAt != "2025-01-02T00:00:00Z" {
+		t.Errorf("Unexpected field updates: %+v", a.FieldUpdates)
+	}
+}
+
+func TestGetArchivedProjectItems_InvalidRepository(t *testing.T) {
+	client := NewClientWithGraphQL(&queryMockClient{})
+	_, err := client.GetArchivedProjectItems("proj-1", []string{"not-a-repo"})
+	if err == nil || !strings.Contains(err.Error(), "invalid repository format") {
+		t.Errorf("Expected invalid repository error, got %v", err)
+	}
+}
+
+func TestGetArchivedProjectItems_NilClient(t *testing.T) {
+	client := &Client{gql: nil}
+	if _, err := client.GetArchivedProjectItems("proj-1", []string{"owner/repo"}); err == nil {
+		t.Error("Expected error when gql is nil")
+	}
+}
+
+func TestGetIssueEngagement(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetIssueEngagement" {
+				return errors.New("unexpected query " + name)
+			}
+			issue := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("Issue")
+
+			groups := issue.FieldByName("ReactionGroups")
+			newGroups := reflect.MakeSlice(groups.Type(), 3, 3)
+			for i, g := range []struct {
+				content string
+				count   int64
+			}{{"THUMBS_UP", 4}, {"THUMBS_DOWN", 0}, {"ROCKET", 1}} {
+				newGroups.Index(i).FieldByName("Content").SetString(g.content)
+				newGroups.Index(i).FieldByName("Reactors").FieldByName("TotalCount").SetInt(g.count)
+			}
+			groups.Set(newGroups)
+
+			participants := issue.FieldByName("Participants")
+			participants.FieldByName("TotalCount").SetInt(3)
+			nodes := participants.FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 2, 2)
+			newNodes.Index(0).FieldByName("Login").SetString("alice")
+			newNodes.Index(1).FieldByName("Login").SetString("bob")
+			nodes.Set(newNodes)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	e, err := client.GetIssueEngagement("owner", "repo", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	wantReactions := []ReactionCount{{Content: "THUMBS_UP", Count: 4}, {Content: "ROCKET", Count: 1}}
+	if !reflect.DeepEqual(e.Reactions, wantReactions) {
+		t.Errorf("Reactions = %+v, want %+v", e.Reactions, wantReactions)
+	}
+	if !reflect.DeepEqual(e.Participants, []string{"alice", "bob"}) || e.TotalParticipants != 3 {
+		t.Errorf("Unexpected participants: %v (%d)", e.Participants, e.TotalParticipants)
+	}
+}
+
+func TestGetIssueEngagement_Error(t *testing.T) {
+	client := NewClientWithGraphQL(&queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("boom")
+		},
+	})
+	if _, err := client.GetIssueEngagement("owner", "repo", 1); err == nil || !strings.Contains(err.Error(), "owner/repo#1") {
+		t.Errorf("Expected wrapped error, got %v", err)
+	}
+}
+
+func TestGetLabeledIssues_Paginates(t *testing.T) {
+	pages := 0
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetLabeledIssues" {
+				return errors.New("unexpected query " + name)
+			}
+			labels := variables["labels"].([]graphql.String)
+			if len(labels) != 1 || labels[0] != "downstream" {
+				t.Errorf("Unexpected labels: %v", labels)
+			}
+			pages++
+
+			issues := reflect.ValueOf(query).Elem().FieldByName("Repository").FieldByName("Issues")
+			nodes := issues.FieldByName("Nodes")
+			node := reflect.New(nodes.Type().Elem()).Elem()
+			node.FieldByName("Number").SetInt(int64(pages))
+			node.FieldByName("Body").SetString("body")
+			labelNodes := node.FieldByName("Labels").FieldByName("Nodes")
+			label := reflect.New(labelNodes.Type().Elem()).Elem()
+			label.FieldByName("Name").SetString("downstream")
+			labelNodes.Set(reflect.Append(labelNodes, label))
+			nodes.Set(reflect.Append(nodes, node))
+
+			if pages == 1 {
+				issues.FieldByName("PageInfo").FieldByName("HasNextPage").SetBool(true)
+				issues.FieldByName("PageInfo").FieldByName("EndCursor").SetString("c1")
+			} else if variables["cursor"] != graphql.String("c1") {
+				t.Errorf("Expected cursor c1, got %v", variables["cursor"])
+			}
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	issues, err := client.GetLabeledIssues("up", "stream", []string{"downstream"}, "open")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(issues) != 2 || issues[1].Number != 2 || issues[0].Body != "body" || issues[0].Labels[0].Name != "downstream" || issues[0].Repository.Owner != "up" {
+		t.Errorf("Unexpected issues: %+v", issues)
+	}
+}
+
+func TestGetCommentReactions(t *testing.T) {
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetCommentReactions" {
+				return errors.New("unexpected query " + name)
+			}
+			if variables["commentId"] != graphql.ID("comment-1") {
+				t.Errorf("Unexpected comment ID: %v", variables["commentId"])
+			}
+			groups := reflect.ValueOf(query).Elem().FieldByName("Node").FieldByName("IssueComment").FieldByName("ReactionGroups")
+			newGroups := reflect.MakeSlice(groups.Type(), 2, 2)
+			newGroups.Index(0).FieldByName("Content").SetString("HOORAY")
+			newGroups.Index(0).FieldByName("Reactors").FieldByName("TotalCount").SetInt(2)
+			newGroups.Index(1).FieldByName("Content").SetString("EYES")
+			groups.Set(newGroups)
+			return nil
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	reactions, err := client.GetCommentReactions("comment-1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(reactions, []ReactionCount{{Content: "HOORAY", Count: 2}}) {
+		t.Errorf("Unexpected reactions: %+v", reactions)
+	}
+}
+
+func TestGetChangedIssues(t *testing.T) {
+	pages := 0
+	mock := &queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			if name != "GetChangedIssues" {
+				return errors.New("unexpected query " + name)
+			}
+			pages++
+			if variables["since"] != DateTime("2025-01-01T00:00:00Z") {
+				t.Errorf("Unexpected since variable: %v", variables["since"])
+			}
+
+			v := reflect.ValueOf(query).Elem()
+			issues := v.FieldByName("Repository").FieldByName("Issues")
+			nodes := issues.FieldByName("Nodes")
+			newNodes := reflect.MakeSlice(nodes.Type(), 1, 1)
+			n := newNodes.Index(0)
+			n.FieldByName("Number").SetInt(int64(pages))
+			n.FieldByName("Title").SetString("Issue")
+			n.FieldByName("Body").SetString("Body")
+			n.FieldByName("UpdatedAt").SetString("2025-01-03T00:00:00Z")
+
+			// The first issue is in proj-1; the second only has an archived item
+			setIssueProjectItemNodes(n.FieldByName("ProjectItems").FieldByName("Nodes"),
+				testItemSpec{"other", false, "Todo"}, testItemSpec{"proj-1", pages == 2, "Done"})
+			nodes.Set(newNodes)
+
+			if pages == 1 {
+				issues.FieldByName("PageInfo").FieldByName("HasNextPage").SetBool(true)
+				issues.FieldByName("PageInfo").FieldByName("EndCursor").SetString("next")
+			} else if variables["cursor"] != graphql.String("next") {
+				t.Errorf("Expected cursor for second page, got %v", variables["cursor"])
+			}
+			return nil
+		},
+	}
+	client := NewClientWithGraphQL(mock)
+
+	changes, err := client.GetChangedIssues("proj-1", "owner", "repo", "2025-01-01T00:00:00Z")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %d", len(changes))
+	}
+	first := changes[0]
+	if first.Issue.Body != "Body" || first.UpdatedAt != "2025-01-03T00:00:00Z" || first.Issue.Repository != (Repository{Owner: "owner", Name: "repo"}) {
+		t.Errorf("Unexpected change: %+v", first)
+	}
+	if first.Item == nil || first.Item.ID != "item-proj-1" || first.Item.FieldValues[0].Value != "Done" {
+		t.Errorf("Expected the proj-1 item, got %+v", first.Item)
+	}
+	if changes[1].Item != nil {
+		t.Errorf("Expected an archived item to count as not in the project, got %+v", changes[1].Item)
+	}
+}
+
+func TestGetChangedIssues_Error(t *testing.T) {
+	client := NewClientWithGraphQL(&queryMockClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			return errors.New("boom")
+		},
+	})
+	if _, err := client.GetChangedIssues("proj-1", "owner", "repo", "2025-01-01T00:00:00Z"); err == nil || !strings.Contains(err.Error(), "owner/repo") {
+		t.Errorf("Expected wrapped error, got %v", err)
+	}
+
+	client = &Client{gql: nil}
+	if _, err := client.GetChangedIssues("proj-1", "owner", "repo", ""); err == nil {
+		t.Error("Expected error when gql is nil")
+	}
+}
+
+func TestGetIssueComments_Paginates(t *testing.T) {
+	// 120 comments in pages of 100, as GitHub returns them
+	var cursors []string
+	mock := &mockGraphQLClient{
+		queryFunc: func(name string, query interface{}, variables map[string]interface{}) error {
+			start, end, next := 1, 100, true
+			if c, ok := variables["cursor"].(graphql.String); ok {
+				cursors = append(cursors, string(c))
+				start, end, next = 101, 120, false
+			}
+			var nodes []string
+			for i := start; i <= end; i++ {
+				nodes = append(nodes, fmt.Sprintf(`{"id":"c%d","body":"comment %d","author":{"login":"octocat"}}`, i, i))
+			}
+			data := fmt.Sprintf(`{"repository":{"issue":{"comments":{"nodes":[%s],"pageInfo":{"hasNextPage":%t,"endCursor":"page-1"}}}}}`,
+				strings.Join(nodes, ","), next)
+			return json.Unmarshal([]byte(data), query)
+		},
+	}
+
+	client := NewClientWithGraphQL(mock)
+	comments, err := client.GetIssueComments("owner", "repo", 1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(comments) != 120 || comments[119].Body != "comment 120" || comments[0].Author != "octocat" {
+		t.Errorf("Expected all 120 comments in order, got %d ending %+v", len(comments), comments[len(comments)-1])
+	}
+	if strings.Join(cursors, ",") != "page-1" {
+		t.Errorf("Expected the second page requested after page-1, got %v", cursors)
+	}
+}
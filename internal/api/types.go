@@ -18,10 +18,29 @@ type ProjectOwner struct {
 
 // ProjectField represents a field in a GitHub project
 type ProjectField struct {
-	ID       string
-	Name     string
-	DataType string
-	Options  []FieldOption // For SINGLE_SELECT fields
+	ID         string
+	Name       string
+	DataType   string
+	Options    []FieldOption // For SINGLE_SELECT fields
+	Iterations []Iteration   // For ITERATION fields
+}
+
+// ProjectView represents a saved view of a project, as shown in the web UI
+type ProjectView struct {
+	ID     string
+	Number int
+	Name   string
+	Layout string   // TABLE_LAYOUT, BOARD_LAYOUT or ROADMAP_LAYOUT
+	Filter string   // The view's filter query, if any
+	SortBy []string // Fields the view sorts by, as "Field ASC" or "Field DESC"
+}
+
+// Iteration represents one iteration (sprint) of an iteration field
+type Iteration struct {
+	ID        string
+	Title     string
+	StartDate string // YYYY-MM-DD
+	Duration  int    // Length in days
 }
 
 // FieldOption represents an option for a single-select field
@@ -71,14 +90,17 @@ type Milestone struct {
 // ProjectItem represents an issue or PR within a project
 type ProjectItem struct {
 	ID          string
+	DatabaseID  int // Numeric item ID used in project board URLs
 	Issue       *Issue
 	FieldValues []FieldValue
+	IsArchived  bool
 }
 
 // FieldValue represents a field value on a project item
 type FieldValue struct {
-	Field string // Field name
-	Value string // Resolved value
+	Field     string // Field name
+	Value     string // Resolved value
+	UpdatedAt string // When the value was last set (RFC 3339), if known
 }
 
 // SubIssue represents a sub-issue relationship
@@ -91,3 +113,11 @@ type SubIssue struct {
 	ParentID   string
 	Repository Repository // Repository where the sub-issue lives
 }
+
+// IssueChange is an issue updated since a sync cursor, with its item in a
+// project
+type IssueChange struct {
+	Issue     Issue
+	UpdatedAt string       // RFC 3339
+	Item      *ProjectItem // nil when the issue is not (or no longer) in the project
+}
@@ -0,0 +1,95 @@
+// Package board groups project items into status columns and renders the
+// board headlessly, e.g. as an SVG image for slide decks.
+package board
+
+import (
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// NoStatus is the column for items without a status
+const NoStatus = "No Status"
+
+// Card is an issue on the board
+type Card struct {
+	Repository string // owner/repo
+	Number     int
+	Title      string
+	Priority   string
+	Assignees  []string
+	Notes      int // local notes on the issue, filled in by the caller
+}
+
+// Column is one status and its cards
+type Column struct {
+	Name  string
+	Cards []Card
+}
+
+// Board is a project's items grouped by status
+type Board struct {
+	Title   string
+	Columns []Column
+}
+
+// Build groups issue items into a column per status. Columns follow order
+// (the status field's options), then any other statuses in the order they
+// are first seen, then NoStatus. Empty columns from order are kept.
+func Build(title string, items []api.ProjectItem, statusField, priorityField string, order []string) *Board {
+	b := &Board{Title: title}
+	index := map[string]int{}
+	column := func(name string) int {
+		key := strings.ToLower(name)
+		if i, ok := index[key]; ok {
+			return i
+		}
+		b.Columns = append(b.Columns, Column{Name: name})
+		index[key] = len(b.Columns) - 1
+		return index[key]
+	}
+
+	for _, name := range order {
+		column(name)
+	}
+
+	var unset []Card
+	for _, item := range items {
+		if item.Issue == nil {
+			continue
+		}
+
+		card := Card{
+			Repository: item.Issue.Repository.Owner + "/" + item.Issue.Repository.Name,
+			Number:     item.Issue.Number,
+			Title:      item.Issue.Title,
+			Priority:   fieldValue(item, priorityField),
+		}
+		for _, a := range item.Issue.Assignees {
+			card.Assignees = append(card.Assignees, a.Login)
+		}
+
+		status := fieldValue(item, statusField)
+		if status == "" {
+			unset = append(unset, card)
+			continue
+		}
+		i := column(status)
+		b.Columns[i].Cards = append(b.Columns[i].Cards, card)
+	}
+
+	if len(unset) > 0 {
+		b.Columns = append(b.Columns, Column{Name: NoStatus, Cards: unset})
+	}
+	return b
+}
+
+// fieldValue returns an item's value for a field, matched case-insensitively
+func fieldValue(item api.ProjectItem, field string) string {
+	for _, fv := range item.FieldValues {
+		if strings.EqualFold(fv.Field, field) {
+			return fv.Value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package board
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+func testItem(number int, title, status, priority string, assignees ...string) api.ProjectItem {
+	issue := &api.Issue{Number: number, Title: title, Repository: api.Repository{Owner: "owner", Name: "repo"}}
+	for _, a := range assignees {
+		issue.Assignees = append(issue.Assignees, api.Actor{Login: a})
+	}
+	item := api.ProjectItem{Issue: issue}
+	if status != "" {
+		item.FieldValues = append(item.FieldValues, api.FieldValue{Field: "Status", Value: status})
+	}
+	if priority != "" {
+		item.FieldValues = append(item.FieldValues, api.FieldValue{Field: "Priority", Value: priority})
+	}
+	return item
+}
+
+func TestBuild(t *testing.T) {
+	items := []api.ProjectItem{
+		testItem(1, "Login", "In Progress", "P1", "alice"),
+		testItem(2, "Docs", "", ""),
+		testItem(3, "Crash", "Blocked", "P0"),
+		testItem(4, "Search", "in progress", ""),
+		{ID: "draft"},
+	}
+
+	b := Build("Roadmap", items, "Status", "Priority", []string{"Todo", "In Progress", "Done"})
+
+	var names []string
+	for _, c := range b.Columns {
+		names = append(names, c.Name)
+	}
+	if got := strings.Join(names, ","); got != "Todo,In Progress,Done,Blocked,No Status" {
+		t.Fatalf("Unexpected columns: %s", got)
+	}
+
+	inProgress := b.Columns[1].Cards
+	if len(inProgress) != 2 || inProgress[0].Number != 1 || inProgress[1].Number != 4 {
+		t.Errorf("Expected #1 and #4 in progress, got %+v", inProgress)
+	}
+	if inProgress[0].Priority != "P1" || inProgress[0].Assignees[0] != "alice" || inProgress[0].Repository != "owner/repo" {
+		t.Errorf("Unexpected card: %+v", inProgress[0])
+	}
+	if len(b.Columns[0].Cards) != 0 || len(b.Columns[4].Cards) != 1 {
+		t.Errorf("Expected an empty Todo column and one card without status, got %+v", b.Columns)
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	items := []api.ProjectItem{
+		testItem(1, "Fix <script> & escaping in a title that is far too long to fit", "Todo", "P1", "alice"),
+		testItem(2, "Two", "Todo", ""),
+		testItem(3, "Three", "Todo", ""),
+		testItem(4, "Four", "Done", ""),
+	}
+	b := Build("Q3 <Roadmap>", items, "Status", "Priority", nil)
+	b.Columns[0].Cards[1].Notes = 2
+
+	var out strings.Builder
+	if err := b.WriteSVG(&out, SVGOptions{MaxCards: 2}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	svg := out.String()
+
+	// The output must be well-formed XML
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		if _, err := decoder.Token(); err != nil {
+			if err != io.EOF {
+				t.Fatalf("Invalid SVG: %v\n%s", err, svg)
+			}
+			break
+		}
+	}
+
+	for _, want := range []string{
+		`width="584"`,
+		"Q3 &lt;Roadmap&gt;",
+		"Fix &lt;script&gt; &amp; escaping in a titl…",
+		"#1 · P1 · @alice",
+		"#2 · 2 note(s)",
+		"+1 more",
+		">Done <tspan",
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("Expected SVG to contain %q, got:\n%s", want, svg)
+		}
+	}
+	if strings.Contains(svg, ">Three<") {
+		t.Errorf("Expected cards beyond MaxCards to be left out, got:\n%s", svg)
+	}
+}
@@ -0,0 +1,111 @@
+package board
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Layout of the SVG rendering, in pixels
+const (
+	columnWidth  = 260
+	columnGap    = 16
+	margin       = 24
+	titleHeight  = 40
+	headerHeight = 36
+	cardHeight   = 58
+	cardGap      = 8
+	maxTitleLen  = 34
+)
+
+// SVGOptions controls the SVG rendering
+type SVGOptions struct {
+	MaxCards int // cards drawn per column before a "+N more" line; 0 for all
+}
+
+// WriteSVG renders the board as a standalone SVG image
+func (b *Board) WriteSVG(w io.Writer, opts SVGOptions) error {
+	columns := len(b.Columns)
+	if columns == 0 {
+		columns = 1
+	}
+
+	tallest := 0
+	for _, c := range b.Columns {
+		rows := len(c.Cards)
+		if opts.MaxCards > 0 && rows > opts.MaxCards {
+			rows = opts.MaxCards + 1 // the "+N more" line
+		}
+		if rows > tallest {
+			tallest = rows
+		}
+	}
+
+	width := 2*margin + columns*columnWidth + (columns-1)*columnGap
+	height := 2*margin + titleHeight + headerHeight + tallest*(cardHeight+cardGap)
+
+	var s strings.Builder
+	fmt.Fprintf(&s, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="-apple-system, 'Segoe UI', Helvetica, Arial, sans-serif">`+"\n", width, height, width, height)
+	fmt.Fprintf(&s, `<rect width="%d" height="%d" fill="#ffffff"/>`+"\n", width, height)
+	fmt.Fprintf(&s, `<text x="%d" y="%d" font-size="20" font-weight="600" fill="#1f2328">%s</text>`+"\n", margin, margin+22, escape(b.Title))
+
+	top := margin + titleHeight
+	for i, c := range b.Columns {
+		x := margin + i*(columnWidth+columnGap)
+		columnHeight := height - top - margin
+		fmt.Fprintf(&s, `<rect x="%d" y="%d" width="%d" height="%d" rx="8" fill="#f6f8fa" stroke="#d0d7de"/>`+"\n", x, top, columnWidth, columnHeight)
+		fmt.Fprintf(&s, `<text x="%d" y="%d" font-size="14" font-weight="600" fill="#1f2328">%s <tspan fill="#656d76" font-weight="400">%d</tspan></text>`+"\n", x+12, top+23, escape(c.Name), len(c.Cards))
+
+		cards := c.Cards
+		if opts.MaxCards > 0 && len(cards) > opts.MaxCards {
+			cards = cards[:opts.MaxCards]
+		}
+		y := top + headerHeight
+		for _, card := range cards {
+			writeSVGCard(&s, card, x+8, y)
+			y += cardHeight + cardGap
+		}
+		if hidden := len(c.Cards) - len(cards); hidden > 0 {
+			fmt.Fprintf(&s, `<text x="%d" y="%d" font-size="12" fill="#656d76">+%d more</text>`+"\n", x+12, y+18, hidden)
+		}
+	}
+
+	s.WriteString("</svg>\n")
+	_, err := io.WriteString(w, s.String())
+	return err
+}
+
+// writeSVGCard draws one card with its top-left corner at x, y
+func writeSVGCard(s *strings.Builder, card Card, x, y int) {
+	fmt.Fprintf(s, `<rect x="%d" y="%d" width="%d" height="%d" rx="6" fill="#ffffff" stroke="#d0d7de"/>`+"\n", x, y, columnWidth-16, cardHeight)
+	fmt.Fprintf(s, `<text x="%d" y="%d" font-size="13" fill="#1f2328">%s</text>`+"\n", x+10, y+22, escape(truncate(card.Title, maxTitleLen)))
+
+	meta := fmt.Sprintf("#%d", card.Number)
+	if card.Priority != "" {
+		meta += " · " + card.Priority
+	}
+	if len(card.Assignees) > 0 {
+		meta += " · @" + strings.Join(card.Assignees, " @")
+	}
+	if card.Notes > 0 {
+		meta += fmt.Sprintf(" · %d note(s)", card.Notes)
+	}
+	fmt.Fprintf(s, `<text x="%d" y="%d" font-size="11" fill="#656d76">%s</text>`+"\n", x+10, y+43, escape(truncate(meta, maxTitleLen+6)))
+}
+
+// truncate shortens s to at most n runes, ending in an ellipsis
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
+
+// escape escapes text for use in SVG markup
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
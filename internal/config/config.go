@@ -5,18 +5,27 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
 // Config represents the .gh-pmu.yml configuration file
 type Config struct {
-	Project      Project           `yaml:"project"`
-	Repositories []string          `yaml:"repositories"`
-	Defaults     Defaults          `yaml:"defaults,omitempty"`
-	Fields       map[string]Field  `yaml:"fields,omitempty"`
-	Triage       map[string]Triage `yaml:"triage,omitempty"`
-	Metadata     *Metadata         `yaml:"metadata,omitempty"`
+	Version        int               `yaml:"version,omitempty"` // Schema version; see CurrentVersion
+	Project        Project           `yaml:"project"`
+	Repositories   []string          `yaml:"repositories"`
+	Defaults       Defaults          `yaml:"defaults,omitempty"`
+	Fields         map[string]Field  `yaml:"fields,omitempty"`
+	Triage         map[string]Triage `yaml:"triage,omitempty"`
+	Views          map[string]View   `yaml:"views,omitempty"`
+	AI             *AI               `yaml:"ai,omitempty"`
+	Prioritization *Prioritization   `yaml:"prioritization,omitempty"`
+	Metadata       *Metadata         `yaml:"metadata,omitempty"`
+	Upstreams      []Upstream        `yaml:"upstreams,omitempty"`
+	Limits         *Limits           `yaml:"limits,omitempty"`
+	Notify         *Notify           `yaml:"notify,omitempty"`
 }
 
 // Project contains GitHub project configuration
@@ -31,6 +40,16 @@ type Defaults struct {
 	Priority string   `yaml:"priority,omitempty"`
 	Status   string   `yaml:"status,omitempty"`
 	Labels   []string `yaml:"labels,omitempty"`
+	State    string   `yaml:"state,omitempty"` // Issue state shown by list, similar, and report: open, closed, or all
+	// Inherit is what sub create copies from the parent: labels, milestone,
+	// assignees, or project fields by alias or name. Nil means the default
+	// set; an empty list inherits nothing.
+	Inherit []string `yaml:"inherit,omitempty"`
+	// SplitInherit is what split copies from the parent to the sub-issues
+	// it creates, with the same keys as Inherit. Setting it makes split
+	// inherit by default; otherwise only --inherit does, copying status,
+	// priority, iteration, labels, and assignees.
+	SplitInherit []string `yaml:"split_inherit,omitempty"`
 }
 
 // Field maps field aliases to GitHub project field names and values
@@ -58,6 +77,176 @@ type TriageInteractive struct {
 	Estimate bool `yaml:"estimate,omitempty"`
 }
 
+// View is a named filter, sort, and column preset for the list command
+type View struct {
+	Status       string   `yaml:"status,omitempty"`
+	Priority     string   `yaml:"priority,omitempty"`
+	Assignee     string   `yaml:"assignee,omitempty"`
+	Label        string   `yaml:"label,omitempty"`
+	Search       string   `yaml:"search,omitempty"`
+	Iteration    string   `yaml:"iteration,omitempty"`
+	State        string   `yaml:"state,omitempty"`
+	HasSubIssues bool     `yaml:"has_sub_issues,omitempty"`
+	Limit        int      `yaml:"limit,omitempty"`
+	Sort         string   `yaml:"sort,omitempty"`    // Column to sort by; prefix with "-" for descending
+	Columns      []string `yaml:"columns,omitempty"` // Table columns in display order
+}
+
+// Upstream is a repository, such as the one a fork follows, whose issues
+// with any of Labels are mirrored into the project by intake --upstream
+type Upstream struct {
+	Repository string   `yaml:"repository"`       // owner/repo
+	Labels     []string `yaml:"labels"`           // Issues with any of these labels are mirrored
+	Target     string   `yaml:"target,omitempty"` // Repository for mirror issues; defaults to the first repository
+}
+
+// Notify configures notifications delivered outside the terminal
+type Notify struct {
+	Email *EmailNotify `yaml:"email,omitempty"`
+}
+
+// EmailNotify is the SMTP server email is sent through, and the addresses
+// of team members by GitHub login
+type EmailNotify struct {
+	SMTP        string            `yaml:"smtp"`                   // host:port
+	From        string            `yaml:"from"`                   // Sender address
+	Username    string            `yaml:"username,omitempty"`     // SMTP login; defaults to From
+	PasswordEnv string            `yaml:"password_env,omitempty"` // Environment variable holding the SMTP password
+	Recipients  map[string]string `yaml:"recipients,omitempty"`   // GitHub login to email address
+}
+
+// Limits bounds the resources used on large projects
+type Limits struct {
+	// Memory is a soft ceiling on the heap, such as 512MiB or 2GB. The
+	// garbage collector runs more often as it is approached.
+	Memory string `yaml:"memory,omitempty"`
+
+	// Timeout bounds how long a command runs, such as 5m. Servers
+	// (daemon, serve, mcp) only stop for an explicit --timeout.
+	Timeout string `yaml:"timeout,omitempty"`
+
+	// RequestTimeout bounds each API request, such as 30s
+	RequestTimeout string `yaml:"request_timeout,omitempty"`
+
+	// Concurrency is how many repositories multi-repository commands
+	// (intake, triage) work on at once; 0 means the default of 4
+	Concurrency int `yaml:"concurrency,omitempty"`
+}
+
+// Timeouts returns limits.timeout and limits.request_timeout, each 0 when
+// it is not set
+func (c *Config) Timeouts() (total, request time.Duration, err error) {
+	if c.Limits == nil {
+		return 0, 0, nil
+	}
+	if total, err = parseTimeout(c.Limits.Timeout); err != nil {
+		return 0, 0, fmt.Errorf("limits.timeout: %w", err)
+	}
+	if request, err = parseTimeout(c.Limits.RequestTimeout); err != nil {
+		return 0, 0, fmt.Errorf("limits.request_timeout: %w", err)
+	}
+	return total, request, nil
+}
+
+// Concurrency returns limits.concurrency, or 0 when it is not set
+func (c *Config) Concurrency() int {
+	if c.Limits == nil {
+		return 0
+	}
+	return c.Limits.Concurrency
+}
+
+// parseTimeout parses a positive duration such as 90s or 5m; "" is 0
+func parseTimeout(s string) (time.Duration, error) {
+	if strings.TrimSpace(s) == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(strings.TrimSpace(s))
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q: use a positive duration such as 30s or 5m", s)
+	}
+	return d, nil
+}
+
+// MemoryLimit returns limits.memory in bytes, or 0 when it is not set
+func (c *Config) MemoryLimit() (int64, error) {
+	if c.Limits == nil || strings.TrimSpace(c.Limits.Memory) == "" {
+		return 0, nil
+	}
+	return ParseByteSize(c.Limits.Memory)
+}
+
+// byteUnits are the size suffixes accepted by ParseByteSize, longest first
+var byteUnits = []struct {
+	suffix string
+	size   int64
+}{
+	{"kib", 1 << 10}, {"mib", 1 << 20}, {"gib", 1 << 30},
+	{"kb", 1000}, {"mb", 1000 * 1000}, {"gb", 1000 * 1000 * 1000},
+	{"k", 1 << 10}, {"m", 1 << 20}, {"g", 1 << 30},
+	{"b", 1},
+}
+
+// ParseByteSize parses a size such as 512MiB, 2GB, or 1048576
+func ParseByteSize(s string) (int64, error) {
+	text := strings.ToLower(strings.TrimSpace(s))
+	size := int64(1)
+	for _, u := range byteUnits {
+		if strings.HasSuffix(text, u.suffix) {
+			text, size = strings.TrimSpace(strings.TrimSuffix(text, u.suffix)), u.size
+			break
+		}
+	}
+	n, err := strconv.ParseFloat(text, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid size %q: use a number with B, KiB, MiB, GiB, KB, MB, or GB", s)
+	}
+	return int64(n * float64(size)), nil
+}
+
+// Prioritization configures the signals scored by suggest-priority.
+// Each signal adds points to an issue's score; Levels map the total
+// score to a priority alias.
+type Prioritization struct {
+	Query     string             `yaml:"query,omitempty"`     // Default item query, e.g. "status:backlog"
+	Reactions float64            `yaml:"reactions,omitempty"` // Points per reaction
+	Age       float64            `yaml:"age,omitempty"`       // Points per week open
+	AgeCap    float64            `yaml:"age_cap,omitempty"`   // Maximum points from age (0 = unlimited)
+	Blocking  float64            `yaml:"blocking,omitempty"`  // Points per open issue this issue blocks
+	SLA       *PrioritySLA       `yaml:"sla,omitempty"`       // Bonus once an issue is open longer than the SLA
+	Keywords  map[string]float64 `yaml:"keywords,omitempty"`  // Points when the title, body, or a label contains the keyword
+	Levels    []PriorityLevel    `yaml:"levels"`              // Score thresholds mapped to priority aliases
+}
+
+// PrioritySLA adds Points once an issue has been open for more than Days
+type PrioritySLA struct {
+	Days   int     `yaml:"days"`
+	Points float64 `yaml:"points"`
+}
+
+// PriorityLevel maps scores of at least MinScore to a priority alias
+type PriorityLevel struct {
+	Priority string  `yaml:"priority"`
+	MinScore float64 `yaml:"min_score"`
+}
+
+// AI configures the optional language model backend used for summaries
+// and similar-issue search.
+// AI features make no requests unless this section is present.
+type AI struct {
+	Provider      string `yaml:"provider"`                  // openai, azure, or ollama
+	Model         string `yaml:"model,omitempty"`           // Model name (openai, ollama)
+	Endpoint      string `yaml:"endpoint,omitempty"`        // Base URL; required for azure
+	Deployment    string `yaml:"deployment,omitempty"`      // Azure deployment name
+	APIVersion    string `yaml:"api_version,omitempty"`     // Azure API version
+	APIKeyEnv     string `yaml:"api_key_env,omitempty"`     // Environment variable holding the API key
+	MaxInputChars int    `yaml:"max_input_chars,omitempty"` // Limit on issue text sent to the model
+
+	// EmbeddingModel selects the embedding model used by 'sync' and 'similar'
+	// (the embedding deployment name for azure)
+	EmbeddingModel string `yaml:"embedding_model,omitempty"`
+}
+
 // Metadata contains cached project metadata from GitHub API
 type Metadata struct {
 	Project ProjectMetadata `yaml:"project,omitempty"`
@@ -71,10 +260,11 @@ type ProjectMetadata struct {
 
 // FieldMetadata contains cached field info
 type FieldMetadata struct {
-	Name     string           `yaml:"name"`
-	ID       string           `yaml:"id"`
-	DataType string           `yaml:"data_type"`
-	Options  []OptionMetadata `yaml:"options,omitempty"`
+	Name       string              `yaml:"name"`
+	ID         string              `yaml:"id"`
+	DataType   string              `yaml:"data_type"`
+	Options    []OptionMetadata    `yaml:"options,omitempty"`
+	Iterations []IterationMetadata `yaml:"iterations,omitempty"`
 }
 
 // OptionMetadata contains cached field option info
@@ -83,28 +273,72 @@ type OptionMetadata struct {
 	ID   string `yaml:"id"`
 }
 
+// IterationMetadata contains cached iteration field info
+type IterationMetadata struct {
+	Title     string `yaml:"title"`
+	ID        string `yaml:"id"`
+	StartDate string `yaml:"start_date"`
+	Duration  int    `yaml:"duration"`
+}
+
 // ConfigFileName is the default configuration file name
 const ConfigFileName = ".gh-pmu.yml"
 
-// Load reads and parses a configuration file from the given path
+// Load reads and parses a configuration file from the given path. Older
+// schema versions are migrated in memory, and deprecated keys are warned
+// about on stderr.
 func Load(path string) (*Config, error) {
+	cfg, _, err := load(path)
+	return cfg, err
+}
+
+// load is Load, also returning the schema version of the file
+func load(path string) (*Config, int, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+		return nil, 0, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, 0, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	from, _, err := migrateDocument(&doc)
+	if err != nil {
+		return nil, from, err
 	}
 
 	var cfg Config
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	if root := documentRoot(&doc); root != nil {
+		for _, w := range checkDeprecations(root) {
+			warnOnce(fmt.Sprintf("%s: %s", filepath.Base(path), w))
+		}
+	}
+	if doc.Kind != 0 {
+		if err := doc.Decode(&cfg); err != nil {
+			return nil, from, fmt.Errorf("failed to parse config file: %w", err)
+		}
 	}
 
-	return &cfg, nil
+	return &cfg, from, nil
 }
 
-// LoadFromDirectory finds and loads the config file from the given directory
+// LoadFromDirectory finds and loads the config file from the given
+// directory. A file from an older schema version is migrated in memory
+// only; when its schema changed, a warning points to 'gh pmu config
+// migrate', which rewrites it.
 func LoadFromDirectory(dir string) (*Config, error) {
 	path := filepath.Join(dir, ConfigFileName)
-	return Load(path)
+	cfg, from, err := load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if from < CurrentVersion && changesSchema(from) {
+		warnOnce(fmt.Sprintf("%s uses config version %d and is migrated in memory; run 'gh pmu config migrate' to upgrade it", ConfigFileName, from))
+	}
+	return cfg, nil
 }
 
 // Validate checks that required configuration fields are present
@@ -121,9 +355,63 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("at least one repository is required")
 	}
 
+	if c.Defaults.State != "" && !IsValidState(c.Defaults.State) {
+		return fmt.Errorf("defaults.state must be open, closed, or all")
+	}
+
+	if _, err := c.MemoryLimit(); err != nil {
+		return fmt.Errorf("limits.memory: %w", err)
+	}
+	if _, _, err := c.Timeouts(); err != nil {
+		return err
+	}
+	if c.Concurrency() < 0 {
+		return fmt.Errorf("limits.concurrency must not be negative")
+	}
+
+	if c.Notify != nil && c.Notify.Email != nil {
+		email := c.Notify.Email
+		if host, port, ok := strings.Cut(email.SMTP, ":"); !ok || host == "" || port == "" {
+			return fmt.Errorf("notify.email.smtp must be host:port")
+		}
+		if email.From == "" {
+			return fmt.Errorf("notify.email.from is required")
+		}
+	}
+
+	for i, u := range c.Upstreams {
+		if parts := strings.Split(u.Repository, "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("upstreams[%d].repository must be owner/repo", i)
+		}
+		if len(u.Labels) == 0 {
+			return fmt.Errorf("upstreams[%d].labels is required", i)
+		}
+		if parts := strings.Split(u.Target, "/"); u.Target != "" && (len(parts) != 2 || parts[0] == "" || parts[1] == "") {
+			return fmt.Errorf("upstreams[%d].target must be owner/repo", i)
+		}
+	}
+
 	return nil
 }
 
+// IsValidState reports whether s is an issue state filter: open, closed, or all
+func IsValidState(s string) bool {
+	switch strings.ToLower(s) {
+	case "open", "closed", "all":
+		return true
+	}
+	return false
+}
+
+// GetView returns the named view from the views section
+func (c *Config) GetView(name string) (View, error) {
+	view, ok := c.Views[name]
+	if !ok {
+		return View{}, fmt.Errorf("view %q not found in %s", name, ConfigFileName)
+	}
+	return view, nil
+}
+
 // ResolveFieldValue maps an alias to its actual GitHub field value.
 // If no alias is found, returns the original value unchanged.
 func (c *Config) ResolveFieldValue(fieldKey, alias string) string {
@@ -154,6 +442,15 @@ func (c *Config) GetFieldName(fieldKey string) string {
 	return fieldKey
 }
 
+// FieldNameOr returns the GitHub field name for fieldKey when the key is
+// configured, and defaultName otherwise
+func (c *Config) FieldNameOr(fieldKey, defaultName string) string {
+	if _, ok := c.Fields[fieldKey]; !ok {
+		return defaultName
+	}
+	return c.GetFieldName(fieldKey)
+}
+
 // ApplyEnvOverrides applies environment variable overrides to the config.
 // Supported environment variables:
 //   - GH_PM_PROJECT_OWNER: overrides project.owner
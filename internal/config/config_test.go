@@ -3,7 +3,9 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestLoad_ValidConfig_ReturnsProjectDetails(t *testing.T) {
@@ -135,6 +137,72 @@ func TestValidate_MissingRepositories_ReturnsError(t *testing.T) {
 	}
 }
 
+func TestValidate_InvalidDefaultState_ReturnsError(t *testing.T) {
+	cfg := &Config{
+		Project:      Project{Owner: "scooter-indie", Number: 13},
+		Repositories: []string{"scooter-indie/gh-pm-test"},
+		Defaults:     Defaults{State: "resolved"},
+	}
+
+	err := cfg.Validate()
+	if err == nil || !strings.Contains(err.Error(), "defaults.state") {
+		t.Fatalf("Expected defaults.state validation error, got %v", err)
+	}
+
+	for _, state := range []string{"open", "CLOSED", "all"} {
+		cfg.Defaults.State = state
+		if err := cfg.Validate(); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", state, err)
+		}
+	}
+}
+
+func TestValidate_Upstreams(t *testing.T) {
+	cfg := &Config{
+		Project:      Project{Owner: "scooter-indie", Number: 13},
+		Repositories: []string{"scooter-indie/gh-pm-fork"},
+		Upstreams:    []Upstream{{Repository: "upstream/gh-pm", Labels: []string{"fork-attention"}}},
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Expected a valid upstream, got %v", err)
+	}
+
+	tests := map[string]Upstream{
+		"repository must be owner/repo": {Repository: "gh-pm", Labels: []string{"x"}},
+		"labels is required":            {Repository: "upstream/gh-pm"},
+		"target must be owner/repo":     {Repository: "upstream/gh-pm", Labels: []string{"x"}, Target: "fork"},
+	}
+	for want, upstream := range tests {
+		cfg.Upstreams = []Upstream{upstream}
+		if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "upstreams[0]."+want) {
+			t.Errorf("Expected %q, got %v", want, err)
+		}
+	}
+}
+
+func TestValidate_NotifyEmail(t *testing.T) {
+	cfg := &Config{
+		Project:      Project{Owner: "scooter-indie", Number: 13},
+		Repositories: []string{"scooter-indie/gh-pmu"},
+		Notify:       &Notify{Email: &EmailNotify{SMTP: "smtp.example.com:587", From: "pm@example.com"}},
+	}
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Expected a valid email config, got %v", err)
+	}
+
+	tests := map[string]EmailNotify{
+		"smtp must be host:port": {SMTP: "smtp.example.com", From: "pm@example.com"},
+		"from is required":       {SMTP: "smtp.example.com:587"},
+	}
+	for want, email := range tests {
+		email := email
+		cfg.Notify.Email = &email
+		if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "notify.email."+want) {
+			t.Errorf("Expected %q, got %v", want, err)
+		}
+	}
+}
+
 func TestValidate_ValidConfig_ReturnsNil(t *testing.T) {
 	// ARRANGE: Valid config
 	cfg := &Config{
@@ -253,6 +321,28 @@ func TestGetFieldName_NoMapping_ReturnsOriginal(t *testing.T) {
 	}
 }
 
+func TestFieldNameOr(t *testing.T) {
+	cfg := &Config{
+		Fields: map[string]Field{
+			"estimate": {Field: "Story Points"},
+			"due":      {},
+		},
+	}
+
+	tests := []struct {
+		key, def, want string
+	}{
+		{"estimate", "Estimate", "Story Points"},
+		{"due", "Due", "due"},
+		{"timebox", "Timebox", "Timebox"},
+	}
+	for _, tt := range tests {
+		if got := cfg.FieldNameOr(tt.key, tt.def); got != tt.want {
+			t.Errorf("FieldNameOr(%q, %q) = %q, want %q", tt.key, tt.def, got, tt.want)
+		}
+	}
+}
+
 func TestLoadFromDirectory_FindsConfigFile(t *testing.T) {
 	// ARRANGE: Directory containing valid config
 	dir := filepath.Join("..", "..", "testdata", "config")
@@ -378,3 +468,190 @@ func TestApplyEnvOverrides_NoEnvVars_Unchanged(t *testing.T) {
 		t.Errorf("Expected project number 13, got %d", cfg.Project.Number)
 	}
 }
+
+func TestLoad_WithViews_ParsesPresets(t *testing.T) {
+	// ARRANGE: Config with a views section
+	content := `project:
+  owner: scooter-indie
+  number: 13
+repositories:
+  - scooter-indie/gh-pmu
+views:
+  sprint-board:
+    status: in_progress
+    assignee: alice
+    sort: -priority
+    columns: [number, title, priority]
+    limit: 25
+`
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	// ACT: Load and look up the view
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	view, err := cfg.GetView("sprint-board")
+
+	// ASSERT: View values are parsed
+	if err != nil {
+		t.Fatalf("Expected view to exist, got: %v", err)
+	}
+	if view.Status != "in_progress" || view.Assignee != "alice" || view.Sort != "-priority" || view.Limit != 25 {
+		t.Errorf("Unexpected view values: %+v", view)
+	}
+	if len(view.Columns) != 3 || view.Columns[2] != "priority" {
+		t.Errorf("Expected columns [number title priority], got %v", view.Columns)
+	}
+}
+
+func TestLoad_WithAI_ParsesBackend(t *testing.T) {
+	// ARRANGE: Config with an ai section
+	content := `project:
+  owner: scooter-indie
+  number: 13
+repositories:
+  - scooter-indie/gh-pmu
+ai:
+  provider: azure
+  endpoint: https://example.openai.azure.com
+  deployment: gpt-4o
+  api_key_env: MY_AZURE_KEY
+  max_input_chars: 8000
+`
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	// ACT: Load the config
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// ASSERT: AI settings are parsed
+	if cfg.AI == nil {
+		t.Fatal("Expected ai section to be parsed")
+	}
+	if cfg.AI.Provider != "azure" || cfg.AI.Deployment != "gpt-4o" || cfg.AI.APIKeyEnv != "MY_AZURE_KEY" || cfg.AI.MaxInputChars != 8000 {
+		t.Errorf("Unexpected ai values: %+v", cfg.AI)
+	}
+}
+
+func TestLoad_WithoutAI_LeavesItNil(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	content := "project:\n  owner: o\n  number: 1\nrepositories:\n  - o/r\n"
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write test config: %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if cfg.AI != nil {
+		t.Errorf("Expected no ai section, got %+v", cfg.AI)
+	}
+}
+
+func TestLoad_DefaultsInherit_DistinguishesEmptyFromUnset(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantNil bool
+		wantLen int
+	}{
+		{"unset", "", true, 0},
+		{"empty", "defaults:\n  inherit: []\n", false, 0},
+		{"listed", "defaults:\n  inherit: [labels, iteration]\n", false, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ConfigFileName)
+			content := "project:\n  owner: o\n  number: 1\nrepositories:\n  - o/r\n" + tt.yaml
+			if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+				t.Fatalf("Failed to write test config: %v", err)
+			}
+
+			cfg, err := Load(path)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if (cfg.Defaults.Inherit == nil) != tt.wantNil || len(cfg.Defaults.Inherit) != tt.wantLen {
+				t.Errorf("Inherit = %#v", cfg.Defaults.Inherit)
+			}
+		})
+	}
+}
+
+func TestGetView_Unknown_ReturnsError(t *testing.T) {
+	cfg := &Config{}
+
+	_, err := cfg.GetView("missing")
+
+	if err == nil {
+		t.Fatal("Expected error for unknown view, got nil")
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{"1048576", 1 << 20},
+		{"512MiB", 512 << 20},
+		{"512 mib", 512 << 20},
+		{"2GB", 2000000000},
+		{"1.5GiB", 3 << 29},
+		{"64k", 64 << 10},
+	}
+	for _, tt := range tests {
+		got, err := ParseByteSize(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseByteSize(%q) = %d, %v; want %d", tt.input, got, err, tt.want)
+		}
+	}
+
+	for _, input := range []string{"", "lots", "-1GB", "0"} {
+		if _, err := ParseByteSize(input); err == nil {
+			t.Errorf("ParseByteSize(%q) expected an error", input)
+		}
+	}
+}
+
+func TestValidate_InvalidMemoryLimit_ReturnsError(t *testing.T) {
+	cfg := &Config{
+		Project:      Project{Owner: "o", Number: 1},
+		Repositories: []string{"o/r"},
+		Limits:       &Limits{Memory: "a lot"},
+	}
+
+	err := cfg.Validate()
+
+	if err == nil || !strings.Contains(err.Error(), "limits.memory") {
+		t.Errorf("Expected a limits.memory error, got %v", err)
+	}
+}
+
+func TestTimeouts(t *testing.T) {
+	cfg := &Config{Limits: &Limits{Timeout: "5m", RequestTimeout: "30s"}}
+	total, request, err := cfg.Timeouts()
+	if err != nil || total != 5*time.Minute || request != 30*time.Second {
+		t.Errorf("Timeouts() = %v, %v, %v", total, request, err)
+	}
+
+	if total, request, err := (&Config{}).Timeouts(); err != nil || total != 0 || request != 0 {
+		t.Errorf("Expected no timeouts when unset, got %v, %v, %v", total, request, err)
+	}
+
+	cfg.Limits.RequestTimeout = "-1s"
+	if _, _, err := cfg.Timeouts(); err == nil || !strings.Contains(err.Error(), "limits.request_timeout") {
+		t.Errorf("Expected a limits.request_timeout error, got %v", err)
+	}
+}
@@ -0,0 +1,316 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+	"gopkg.in/yaml.v3"
+)
+
+// CurrentVersion is the schema version of the config files this release
+// reads and writes. A file without a version key is version 0.
+const CurrentVersion = 1
+
+// migration upgrades a config document from version From to From+1.
+// Apply edits the top-level mapping in place, so comments and key order
+// survive the rewrite. A nil Apply only records the new version: the
+// schema is unchanged, so the file does not need rewriting.
+type migration struct {
+	From        int
+	Description string
+	Apply       func(root *yaml.Node) error
+}
+
+// migrations run in order on files older than CurrentVersion. A format
+// change adds one here and bumps CurrentVersion.
+var migrations = []migration{
+	{From: 0, Description: "record the config schema version"},
+}
+
+// deprecation is a config key that still works but is being phased out.
+// Key is dotted, and * matches any key, as in triage.*.apply.
+type deprecation struct {
+	Key     string
+	Message string
+}
+
+// deprecations are warned about whenever a config using them is loaded
+var deprecations []deprecation
+
+// warningOutput receives migration notices and deprecation warnings
+var warningOutput io.Writer = os.Stderr
+
+var (
+	warnedMu sync.Mutex
+	warned   = map[string]bool{}
+)
+
+// warnOnce prints a warning the first time it is seen, since commands
+// may load the config more than once
+func warnOnce(msg string) {
+	warnedMu.Lock()
+	defer warnedMu.Unlock()
+	if warned[msg] {
+		return
+	}
+	warned[msg] = true
+	fmt.Fprintf(warningOutput, "Warning: %s\n", msg)
+}
+
+// BackupDir returns the directory Migrate keeps the originals of migrated
+// config files in, outside the repository
+func BackupDir() (string, error) {
+	return localstore.ConfigDir("config-backups")
+}
+
+// Migrate upgrades the config file at path to CurrentVersion in place,
+// after copying the original into backupDir. It reports whether the file
+// was rewritten; a current file is left untouched. When the migrations
+// only record the version, the version key is the only line changed;
+// otherwise the file is re-encoded with its own indentation.
+func Migrate(path, backupDir string) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read config file: %w", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return false, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	from, applied, err := migrateDocument(&doc)
+	if err != nil || len(applied) == 0 {
+		return false, err
+	}
+
+	migrated := stampVersion(data, CurrentVersion)
+	if changesSchema(from) {
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(documentIndent(data))
+		if err := enc.Encode(&doc); err != nil {
+			return false, fmt.Errorf("failed to encode config file: %w", err)
+		}
+		if err := enc.Close(); err != nil {
+			return false, fmt.Errorf("failed to encode config file: %w", err)
+		}
+		migrated = buf.Bytes()
+	}
+
+	if err := os.MkdirAll(backupDir, 0700); err != nil {
+		return false, fmt.Errorf("failed to create backup directory: %w", err)
+	}
+	backup := filepath.Join(backupDir, fmt.Sprintf("%s.v%d.%s.bak", filepath.Base(path), from, time.Now().UTC().Format("20060102T150405")))
+	if err := os.WriteFile(backup, data, 0600); err != nil {
+		return false, fmt.Errorf("failed to back up config file: %w", err)
+	}
+	if err := os.WriteFile(path, migrated, info.Mode().Perm()); err != nil {
+		return false, fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	fmt.Fprintf(warningOutput, "Migrated %s from config version %d to %d (backup: %s):\n",
+		filepath.Base(path), from, CurrentVersion, backup)
+	for _, d := range applied {
+		fmt.Fprintf(warningOutput, "  - %s\n", d)
+	}
+	return true, nil
+}
+
+// changesSchema reports whether a file at version from needs a migration
+// that does more than record the version
+func changesSchema(from int) bool {
+	for _, m := range migrations {
+		if m.From >= from && m.From < CurrentVersion && m.Apply != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// stampVersion returns the file with its top-level version key set,
+// changing only that line: an existing key is rewritten, otherwise one is
+// inserted below the comments heading the file
+func stampVersion(data []byte, version int) []byte {
+	newline := "\n"
+	if bytes.Contains(data, []byte("\r\n")) {
+		newline = "\r\n"
+	}
+	line := fmt.Sprintf("version: %d", version)
+
+	lines := strings.Split(string(data), "\n")
+	insert := -1
+	for i, l := range lines {
+		text := strings.TrimSuffix(l, "\r")
+		if strings.HasPrefix(text, "version:") {
+			lines[i] = line + strings.TrimPrefix(l, text)
+			return []byte(strings.Join(lines, "\n"))
+		}
+		trimmed := strings.TrimSpace(text)
+		if insert < 0 && trimmed != "" && !strings.HasPrefix(trimmed, "#") && trimmed != "---" {
+			insert = i
+		}
+	}
+	if insert < 0 {
+		return []byte(strings.TrimRight(string(data), "\r\n") + newline + line + newline)
+	}
+	lines = append(lines[:insert], append([]string{line + strings.TrimSuffix(newline, "\n")}, lines[insert:]...)...)
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// documentIndent returns the indentation the file uses for nested keys,
+// so a re-encoded file keeps its layout; 2 when it has none
+func documentIndent(data []byte) int {
+	indent := 0
+	for _, l := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimLeft(l, " ")
+		n := len(l) - len(trimmed)
+		if n == 0 || strings.TrimSpace(trimmed) == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		if indent == 0 || n < indent {
+			indent = n
+		}
+	}
+	if indent < 2 {
+		return 2
+	}
+	return indent
+}
+
+// migrateDocument runs the migrations a parsed config needs and stamps it
+// with CurrentVersion. It returns the version the document had and the
+// descriptions of the migrations applied.
+func migrateDocument(doc *yaml.Node) (int, []string, error) {
+	root := documentRoot(doc)
+	if root == nil {
+		// Empty, or not a mapping; decoding reports the problem
+		return CurrentVersion, nil, nil
+	}
+
+	from, err := documentVersion(root)
+	if err != nil {
+		return 0, nil, err
+	}
+	if from > CurrentVersion {
+		return from, nil, fmt.Errorf("config version %d is newer than this gh pmu supports (%d); upgrade with 'gh extension upgrade pmu'", from, CurrentVersion)
+	}
+
+	var applied []string
+	for _, m := range migrations {
+		if m.From < from || m.From >= CurrentVersion {
+			continue
+		}
+		if m.Apply == nil {
+			applied = append(applied, m.Description)
+			continue
+		}
+		if err := m.Apply(root); err != nil {
+			return from, nil, fmt.Errorf("failed to migrate config from version %d: %w", m.From, err)
+		}
+		applied = append(applied, m.Description)
+	}
+	if from < CurrentVersion {
+		setMappingValue(root, "version", strconv.Itoa(CurrentVersion))
+	}
+	return from, applied, nil
+}
+
+// documentRoot returns the top-level mapping of a parsed document, or nil
+func documentRoot(doc *yaml.Node) *yaml.Node {
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	return doc.Content[0]
+}
+
+// documentVersion returns the version key of the top-level mapping, or 0
+func documentVersion(root *yaml.Node) (int, error) {
+	value := mappingValue(root, "version")
+	if value == nil {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(value.Value)
+	if err != nil || version < 0 {
+		return 0, fmt.Errorf("invalid config version %q (line %d)", value.Value, value.Line)
+	}
+	return version, nil
+}
+
+// mappingValue returns the value node for key in a mapping, or nil
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue sets key to a scalar value, adding it first in the
+// mapping if it is missing
+func setMappingValue(mapping *yaml.Node, key, value string) {
+	if node := mappingValue(mapping, key); node != nil {
+		node.Kind, node.Tag, node.Value, node.Content = yaml.ScalarNode, "!!int", value, nil
+		return
+	}
+	keyNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}
+	if len(mapping.Content) > 0 {
+		// Keep a comment at the top of the file above the new key
+		keyNode.HeadComment, mapping.Content[0].HeadComment = mapping.Content[0].HeadComment, ""
+	}
+	mapping.Content = append([]*yaml.Node{
+		keyNode,
+		{Kind: yaml.ScalarNode, Tag: "!!int", Value: value},
+	}, mapping.Content...)
+}
+
+// checkDeprecations returns a warning for each deprecated key in the
+// top-level mapping, with the key's full path and line
+func checkDeprecations(root *yaml.Node) []string {
+	var warnings []string
+	for _, d := range deprecations {
+		for _, match := range findKeys(root, strings.Split(d.Key, "."), nil) {
+			warnings = append(warnings, fmt.Sprintf("%s (line %d) is deprecated: %s", match.path, match.line, d.Message))
+		}
+	}
+	return warnings
+}
+
+type keyMatch struct {
+	path string
+	line int
+}
+
+// findKeys returns the keys under mapping that match the path segments,
+// where * matches any key
+func findKeys(mapping *yaml.Node, segments, prefix []string) []keyMatch {
+	if mapping == nil || mapping.Kind != yaml.MappingNode || len(segments) == 0 {
+		return nil
+	}
+	var matches []keyMatch
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := mapping.Content[i]
+		if segments[0] != "*" && segments[0] != key.Value {
+			continue
+		}
+		path := append(append([]string{}, prefix...), key.Value)
+		if len(segments) == 1 {
+			matches = append(matches, keyMatch{path: strings.Join(path, "."), line: key.Line})
+			continue
+		}
+		matches = append(matches, findKeys(mapping.Content[i+1], segments[1:], path)...)
+	}
+	return matches
+}
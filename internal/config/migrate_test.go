@@ -0,0 +1,231 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const unversionedConfig = `# Team config
+project:
+  owner: octo-org
+  number: 3
+repositories:
+  - octo-org/app
+`
+
+// captureWarnings redirects warnings to a buffer for the test
+func captureWarnings(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	buf := new(bytes.Buffer)
+	old := warningOutput
+	warningOutput = buf
+	warnedMu.Lock()
+	warned = map[string]bool{}
+	warnedMu.Unlock()
+	t.Cleanup(func() { warningOutput = old })
+	return buf
+}
+
+func TestLoadFromDirectory_DoesNotRewrite(t *testing.T) {
+	warnings := captureWarnings(t)
+	dir := t.TempDir()
+	path := filepath.Join(dir, ConfigFileName)
+	if err := os.WriteFile(path, []byte(unversionedConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := LoadFromDirectory(dir)
+	if err != nil {
+		t.Fatalf("LoadFromDirectory() error = %v", err)
+	}
+	if cfg.Version != CurrentVersion || cfg.Project.Owner != "octo-org" {
+		t.Errorf("Unexpected config: %+v", cfg)
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != unversionedConfig {
+		t.Errorf("Expected the file unchanged, got:\n%s", data)
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("Expected no backup next to the file, got %d entries", len(entries))
+	}
+	// Version 0 only lacks the stamp, so there is nothing to warn about
+	if warnings.Len() != 0 {
+		t.Errorf("Expected no warning, got %q", warnings.String())
+	}
+
+	// A schema change is pointed out, but still not written
+	old := migrations
+	t.Cleanup(func() { migrations = old })
+	migrations = []migration{{From: 0, Description: "rename", Apply: func(*yaml.Node) error { return nil }}}
+	if _, err := LoadFromDirectory(dir); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(warnings.String(), "run 'gh pmu config migrate' to upgrade it") {
+		t.Errorf("Expected a migrate hint, got %q", warnings.String())
+	}
+	if data, _ := os.ReadFile(path); string(data) != unversionedConfig {
+		t.Errorf("Expected the file unchanged, got:\n%s", data)
+	}
+}
+
+func TestMigrate_StampsVersionOnly(t *testing.T) {
+	warnings := captureWarnings(t)
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	original := "# Team config\r\nproject:\r\n    owner: octo-org\r\n    number: 3\r\nrepositories:\r\n    - octo-org/app\r\n"
+	if err := os.WriteFile(path, []byte(original), 0644); err != nil {
+		t.Fatal(err)
+	}
+	backups := t.TempDir()
+
+	migrated, err := Migrate(path, backups)
+	if err != nil || !migrated {
+		t.Fatalf("Migrate() = %v, %v; want true, nil", migrated, err)
+	}
+	data, _ := os.ReadFile(path)
+	if want := "# Team config\r\nversion: 1\r\n" + strings.TrimPrefix(original, "# Team config\r\n"); string(data) != want {
+		t.Errorf("Expected only the version line added, got %q", data)
+	}
+
+	entries, _ := os.ReadDir(backups)
+	if len(entries) != 1 || !strings.HasPrefix(entries[0].Name(), ".gh-pmu.yml.v0.") {
+		t.Fatalf("Expected one backup, got %v", entries)
+	}
+	if backup, _ := os.ReadFile(filepath.Join(backups, entries[0].Name())); string(backup) != original {
+		t.Errorf("Expected the original in the backup, got %q", backup)
+	}
+	if !strings.Contains(warnings.String(), "Migrated .gh-pmu.yml from config version 0 to 1") {
+		t.Errorf("Expected a migration notice, got %q", warnings.String())
+	}
+
+	// A current file is left alone
+	warnings.Reset()
+	if migrated, err := Migrate(path, backups); err != nil || migrated {
+		t.Errorf("Migrate() = %v, %v; want false, nil", migrated, err)
+	}
+	if warnings.Len() != 0 {
+		t.Errorf("Expected no notice for a current file, got %q", warnings.String())
+	}
+}
+
+func TestMigrate_KeepsIndentation(t *testing.T) {
+	captureWarnings(t)
+	old := migrations
+	t.Cleanup(func() { migrations = old })
+	migrations = []migration{{From: 0, Description: "rename", Apply: func(root *yaml.Node) error {
+		mappingValue(root, "project").Content[0].Value = "org"
+		return nil
+	}}}
+
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	if err := os.WriteFile(path, []byte("project:\n    owner: octo-org\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Migrate(path, t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "version: 1\nproject:\n    org: octo-org\n" {
+		t.Errorf("Expected the 4-space indentation kept, got %q", data)
+	}
+}
+
+func TestStampVersion(t *testing.T) {
+	tests := map[string]string{
+		"version: 0\nproject: {}\n": "version: 1\nproject: {}\n",
+		"---\n# c\n\nproject: {}\n": "---\n# c\n\nversion: 1\nproject: {}\n",
+		"# only a comment\n":        "# only a comment\nversion: 1\n",
+	}
+	for in, want := range tests {
+		if got := string(stampVersion([]byte(in), 1)); got != want {
+			t.Errorf("stampVersion(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestLoad_DoesNotRewrite(t *testing.T) {
+	captureWarnings(t)
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	if err := os.WriteFile(path, []byte(unversionedConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if cfg.Version != CurrentVersion {
+		t.Errorf("Version = %d, want %d", cfg.Version, CurrentVersion)
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != unversionedConfig {
+		t.Errorf("Expected Load to leave the file unchanged, got:\n%s", data)
+	}
+}
+
+func TestLoad_NewerVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	if err := os.WriteFile(path, []byte("version: 99\n"+unversionedConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil || !strings.Contains(err.Error(), "config version 99 is newer") {
+		t.Errorf("Expected a newer-version error, got %v", err)
+	}
+}
+
+func TestMigrateDocument_RunsPendingMigrations(t *testing.T) {
+	old := migrations
+	t.Cleanup(func() { migrations = old })
+	var ran []int
+	migrations = []migration{
+		{From: 0, Description: "zero", Apply: func(*yaml.Node) error { ran = append(ran, 0); return nil }},
+		{From: CurrentVersion, Description: "future", Apply: func(*yaml.Node) error { ran = append(ran, 1); return nil }},
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte("project: {}\n"), &doc); err != nil {
+		t.Fatal(err)
+	}
+	from, applied, err := migrateDocument(&doc)
+	if err != nil {
+		t.Fatalf("migrateDocument() error = %v", err)
+	}
+	// Migrations at or beyond CurrentVersion belong to a later release
+	if from != 0 || strings.Join(applied, ",") != "zero" || len(ran) != 1 {
+		t.Errorf("from = %d, applied = %v, ran = %v", from, applied, ran)
+	}
+	if v, _ := documentVersion(documentRoot(&doc)); v != CurrentVersion {
+		t.Errorf("Expected the document stamped with version %d, got %d", CurrentVersion, v)
+	}
+}
+
+func TestCheckDeprecations(t *testing.T) {
+	old := deprecations
+	t.Cleanup(func() { deprecations = old })
+	deprecations = []deprecation{{Key: "triage.*.apply.status", Message: "use apply.fields.status"}}
+
+	var doc yaml.Node
+	input := "triage:\n  bugs:\n    apply:\n      status: ready\n  stale:\n    query: is:open\n"
+	if err := yaml.Unmarshal([]byte(input), &doc); err != nil {
+		t.Fatal(err)
+	}
+
+	got := checkDeprecations(documentRoot(&doc))
+	want := []string{"triage.bugs.apply.status (line 4) is deprecated: use apply.fields.status"}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("checkDeprecations() = %q, want %q", got, want)
+	}
+}
+
+func TestWarnOnce(t *testing.T) {
+	warnings := captureWarnings(t)
+	warnOnce("x is deprecated")
+	warnOnce("x is deprecated")
+	if warnings.String() != "Warning: x is deprecated\n" {
+		t.Errorf("Expected one warning, got %q", warnings.String())
+	}
+}
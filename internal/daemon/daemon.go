@@ -0,0 +1,178 @@
+// Package daemon lets a long-running gh-pmu process serve a project over a
+// localhost HTTP/JSON API, and lets CLI invocations, editors, and scripts
+// find and call it.
+package daemon
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// connectTimeout bounds the check that a recorded daemon still answers, so
+// a stale state file never slows a command down noticeably
+const connectTimeout = 500 * time.Millisecond
+
+// State records a running daemon so other processes can reach it
+type State struct {
+	Project   string `json:"project"`   // owner/number
+	Addr      string `json:"addr"`      // host:port on localhost
+	Token     string `json:"token"`     // bearer token required by every request
+	PID       int    `json:"pid"`       // daemon process
+	StartedAt string `json:"startedAt"` // RFC 3339
+}
+
+// DefaultPath returns the state file for a project's daemon in the user
+// config directory
+func DefaultPath(owner string, number int) (string, error) {
+	return localstore.ConfigPath("daemon", localstore.ProjectFile(owner, number))
+}
+
+// Load reads the state at path. A missing file yields an empty state.
+func Load(path string) (*State, error) {
+	state := &State{}
+	if _, err := localstore.Load(path, "daemon state", state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// Save writes the state to path, creating parent directories as needed.
+// The file holds the token, so only the user can read it.
+func (s *State) Save(path string) error {
+	return localstore.Save(path, "daemon state", s)
+}
+
+// Remove deletes the state file at path, if any
+func Remove(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove daemon state: %w", err)
+	}
+	return nil
+}
+
+// NewToken returns a random token for authorizing requests
+func NewToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Authorize wraps h, rejecting requests that do not carry the token
+func Authorize(token string, h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(got, []byte("Bearer "+token)) != 1 {
+			WriteError(w, http.StatusUnauthorized, errors.New("missing or invalid token"))
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+// WriteJSON writes v as a JSON response with the given status
+func WriteJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// WriteError writes err as a JSON error response: {"error": "..."}
+func WriteError(w http.ResponseWriter, status int, err error) {
+	WriteJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+// Client calls a running daemon
+type Client struct {
+	State State
+	http  *http.Client
+}
+
+// NewClient returns a client for the daemon described by state
+func NewClient(state State) *Client {
+	return &Client{State: state, http: &http.Client{}}
+}
+
+// Connect returns a client for the daemon recorded at path, or nil when
+// none is recorded or it does not answer
+func Connect(path string) *Client {
+	state, err := Load(path)
+	if err != nil || state.Addr == "" {
+		return nil
+	}
+
+	c := NewClient(*state)
+	c.http.Timeout = connectTimeout
+	if err := c.Get("/v1/status", nil); err != nil {
+		return nil
+	}
+	c.http.Timeout = 0
+	return c
+}
+
+// Get requests path and decodes the JSON response into out, if not nil
+func (c *Client) Get(path string, out interface{}) error {
+	return c.do(http.MethodGet, path, nil, out)
+}
+
+// Post sends in as JSON to path and decodes the response into out, if not nil
+func (c *Client) Post(path string, in, out interface{}) error {
+	return c.do(http.MethodPost, path, in, out)
+}
+
+func (c *Client) do(method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode daemon request: %w", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, "http://"+c.State.Addr+path, body)
+	if err != nil {
+		return fmt.Errorf("failed to create daemon request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.State.Token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("daemon request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		var e struct {
+			Error string `json:"error"`
+		}
+		if json.NewDecoder(resp.Body).Decode(&e) == nil && e.Error != "" {
+			return fmt.Errorf("daemon: %s", e.Error)
+		}
+		return fmt.Errorf("daemon: %s", strings.ToLower(http.StatusText(resp.StatusCode)))
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to parse daemon response: %w", err)
+	}
+	return nil
+}
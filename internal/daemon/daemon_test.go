@@ -0,0 +1,120 @@
+package daemon
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestStateSaveLoadRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "daemon", "owner-1.json")
+
+	state, err := Load(path)
+	if err != nil || state.Addr != "" {
+		t.Fatalf("Expected empty state for a missing file, got %+v, %v", state, err)
+	}
+
+	state = &State{Project: "owner/1", Addr: "127.0.0.1:4000", Token: "secret", PID: 42}
+	if err := state.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if *loaded != *state {
+		t.Errorf("Expected %+v, got %+v", state, loaded)
+	}
+
+	if err := Remove(path); err != nil {
+		t.Fatalf("Remove failed: %v", err)
+	}
+	if err := Remove(path); err != nil {
+		t.Errorf("Expected removing a missing file to succeed, got %v", err)
+	}
+}
+
+func TestNewToken(t *testing.T) {
+	a, err := NewToken()
+	if err != nil {
+		t.Fatalf("NewToken failed: %v", err)
+	}
+	b, _ := NewToken()
+	if len(a) != 32 || a == b {
+		t.Errorf("Expected distinct 32-character tokens, got %q and %q", a, b)
+	}
+}
+
+func newTestServer(t *testing.T) (*httptest.Server, State) {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/status", func(w http.ResponseWriter, r *http.Request) {
+		WriteJSON(w, http.StatusOK, map[string]string{"project": "owner/1"})
+	})
+	mux.HandleFunc("POST /v1/echo", func(w http.ResponseWriter, r *http.Request) {
+		var in map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&in)
+		WriteJSON(w, http.StatusOK, in)
+	})
+	mux.HandleFunc("GET /v1/fail", func(w http.ResponseWriter, r *http.Request) {
+		WriteError(w, http.StatusBadRequest, errors.New("issue #9 is not in the project"))
+	})
+	server := httptest.NewServer(Authorize("secret", mux))
+	t.Cleanup(server.Close)
+	return server, State{Addr: strings.TrimPrefix(server.URL, "http://"), Token: "secret"}
+}
+
+func TestClient_GetPostAndErrors(t *testing.T) {
+	_, state := newTestServer(t)
+	c := NewClient(state)
+
+	var status map[string]string
+	if err := c.Get("/v1/status", &status); err != nil || status["project"] != "owner/1" {
+		t.Errorf("Unexpected status %v, %v", status, err)
+	}
+
+	var echo map[string]string
+	if err := c.Post("/v1/echo", map[string]string{"status": "done"}, &echo); err != nil || echo["status"] != "done" {
+		t.Errorf("Unexpected echo %v, %v", echo, err)
+	}
+
+	err := c.Get("/v1/fail", nil)
+	if err == nil || err.Error() != "daemon: issue #9 is not in the project" {
+		t.Errorf("Expected the daemon's error, got %v", err)
+	}
+
+	state.Token = "wrong"
+	if err := NewClient(state).Get("/v1/status", nil); err == nil || !strings.Contains(err.Error(), "invalid token") {
+		t.Errorf("Expected unauthorized error, got %v", err)
+	}
+}
+
+func TestConnect(t *testing.T) {
+	dir := t.TempDir()
+	_, state := newTestServer(t)
+
+	if c := Connect(filepath.Join(dir, "missing.json")); c != nil {
+		t.Error("Expected no client without a state file")
+	}
+
+	path := filepath.Join(dir, "running.json")
+	if err := state.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	if c := Connect(path); c == nil {
+		t.Error("Expected a client for the running daemon")
+	}
+
+	stale := State{Addr: "127.0.0.1:1", Token: "secret"}
+	path = filepath.Join(dir, "stale.json")
+	if err := stale.Save(path); err != nil {
+		t.Fatal(err)
+	}
+	if c := Connect(path); c != nil {
+		t.Error("Expected no client for a daemon that does not answer")
+	}
+}
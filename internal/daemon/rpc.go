@@ -0,0 +1,238 @@
+package daemon
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// JSON-RPC 2.0 error codes
+const (
+	CodeParseError     = -32700
+	CodeInvalidRequest = -32600
+	CodeMethodNotFound = -32601
+	CodeInvalidParams  = -32602
+	CodeServerError    = -32000
+)
+
+// RPCError is a JSON-RPC error. Methods return one to choose the code;
+// any other error is reported as a server error.
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *RPCError) Error() string {
+	return e.Message
+}
+
+// InvalidParams returns an invalid params error with a formatted message
+func InvalidParams(format string, args ...interface{}) *RPCError {
+	return &RPCError{Code: CodeInvalidParams, Message: fmt.Sprintf(format, args...)}
+}
+
+// Method handles one JSON-RPC method with its params, which are "{}" when
+// the request has none
+type Method func(params json.RawMessage) (interface{}, error)
+
+// RPC dispatches JSON-RPC 2.0 requests to registered methods
+type RPC struct {
+	methods map[string]Method
+	mu      sync.Mutex // serializes writes to a stream
+}
+
+// NewRPC creates a dispatcher with no methods
+func NewRPC() *RPC {
+	return &RPC{methods: make(map[string]Method)}
+}
+
+// Register adds a method, replacing any with the same name
+func (r *RPC) Register(name string, m Method) {
+	r.methods[name] = m
+}
+
+// Methods returns the registered method names in sorted order
+func (r *RPC) Methods() []string {
+	names := make([]string, 0, len(r.methods))
+	for name := range r.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+}
+
+// Handle processes one JSON-RPC message and returns the encoded response,
+// or nil for notifications. Notifications still run their method.
+func (r *RPC) Handle(msg []byte) []byte {
+	var req rpcRequest
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return encodeRPC(rpcResponse{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &RPCError{Code: CodeParseError, Message: "parse error"}})
+	}
+
+	notification := len(req.ID) == 0
+	resp := rpcResponse{JSONRPC: "2.0", ID: req.ID}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		if notification {
+			return nil
+		}
+		resp.Error = &RPCError{Code: CodeInvalidRequest, Message: "invalid request"}
+		return encodeRPC(resp)
+	}
+
+	method, ok := r.methods[req.Method]
+	if !ok {
+		if notification {
+			return nil
+		}
+		resp.Error = &RPCError{Code: CodeMethodNotFound, Message: fmt.Sprintf("method not found: %s", req.Method)}
+		return encodeRPC(resp)
+	}
+
+	params := req.Params
+	if len(params) == 0 || string(params) == "null" {
+		params = json.RawMessage("{}")
+	}
+	result, err := method(params)
+	if notification {
+		return nil
+	}
+
+	if err != nil {
+		var rpcErr *RPCError
+		if !errors.As(err, &rpcErr) {
+			rpcErr = &RPCError{Code: CodeServerError, Message: err.Error()}
+		}
+		resp.Error = rpcErr
+		return encodeRPC(resp)
+	}
+
+	// A nil result would be dropped by omitempty, leaving neither field
+	if result == nil {
+		result = struct{}{}
+	}
+	resp.Result = result
+	return encodeRPC(resp)
+}
+
+// ServeHTTP handles a JSON-RPC request posted as the body
+func (r *RPC) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	msg, err := io.ReadAll(req.Body)
+	if err != nil {
+		WriteError(w, http.StatusBadRequest, fmt.Errorf("failed to read request: %w", err))
+		return
+	}
+
+	resp := r.Handle(msg)
+	if resp == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(append(resp, '\n'))
+}
+
+// ServeStream reads requests from in and writes responses to out until in
+// is exhausted. When framed, every message is preceded by a
+// "Content-Length: N" header and a blank line, as in the Language Server
+// Protocol; otherwise messages are newline-delimited.
+func (r *RPC) ServeStream(in io.Reader, out io.Writer, framed bool) error {
+	reader := bufio.NewReaderSize(in, 64*1024)
+	for {
+		var msg []byte
+		var err error
+		if framed {
+			msg, err = readFramed(reader)
+		} else {
+			msg, err = reader.ReadBytes('\n')
+			if err == io.EOF && len(strings.TrimSpace(string(msg))) > 0 {
+				err = nil
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if !framed && len(strings.TrimSpace(string(msg))) == 0 {
+			continue
+		}
+
+		if resp := r.Handle(msg); resp != nil {
+			if err := r.write(out, resp, framed); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readFramed reads one Content-Length framed message
+func readFramed(reader *bufio.Reader) ([]byte, error) {
+	header, err := textproto.NewReader(reader).ReadMIMEHeader()
+	if err != nil {
+		if err == io.EOF && len(header) == 0 {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("failed to read message header: %w", err)
+	}
+
+	length, err := strconv.Atoi(header.Get("Content-Length"))
+	if err != nil || length < 0 {
+		return nil, fmt.Errorf("invalid Content-Length header %q", header.Get("Content-Length"))
+	}
+
+	msg := make([]byte, length)
+	if _, err := io.ReadFull(reader, msg); err != nil {
+		return nil, fmt.Errorf("failed to read message body: %w", err)
+	}
+	return msg, nil
+}
+
+func (r *RPC) write(w io.Writer, msg []byte, framed bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if framed {
+		msg = append([]byte(fmt.Sprintf("Content-Length: %d\r\n\r\n", len(msg))), msg...)
+	} else {
+		msg = append(msg, '\n')
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("failed to write response: %w", err)
+	}
+	return nil
+}
+
+func encodeRPC(resp rpcResponse) []byte {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		data, _ = json.Marshal(rpcResponse{
+			JSONRPC: "2.0",
+			ID:      resp.ID,
+			Error:   &RPCError{Code: CodeServerError, Message: err.Error()},
+		})
+	}
+	return data
+}
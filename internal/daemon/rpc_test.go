@@ -0,0 +1,126 @@
+package daemon
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newTestRPC() *RPC {
+	r := NewRPC()
+	r.Register("echo", func(params json.RawMessage) (interface{}, error) {
+		var p map[string]interface{}
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, InvalidParams("invalid params: %v", err)
+		}
+		return p, nil
+	})
+	r.Register("fail", func(params json.RawMessage) (interface{}, error) {
+		return nil, errors.New("upstream failed")
+	})
+	return r
+}
+
+func decodeRPC(t *testing.T, data []byte) (result map[string]interface{}, rpcErr *RPCError) {
+	t.Helper()
+	var resp struct {
+		Result map[string]interface{} `json:"result"`
+		Error  *RPCError              `json:"error"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatalf("Failed to parse response %q: %v", data, err)
+	}
+	return resp.Result, resp.Error
+}
+
+func TestRPC_Handle(t *testing.T) {
+	r := newTestRPC()
+
+	result, rpcErr := decodeRPC(t, r.Handle([]byte(`{"jsonrpc":"2.0","id":1,"method":"echo","params":{"a":"b"}}`)))
+	if rpcErr != nil || result["a"] != "b" {
+		t.Errorf("Expected the params echoed, got %v, %v", result, rpcErr)
+	}
+
+	result, rpcErr = decodeRPC(t, r.Handle([]byte(`{"jsonrpc":"2.0","id":2,"method":"echo"}`)))
+	if rpcErr != nil || len(result) != 0 {
+		t.Errorf("Expected empty params, got %v, %v", result, rpcErr)
+	}
+
+	tests := []struct {
+		msg  string
+		code int
+	}{
+		{`not json`, CodeParseError},
+		{`{"id":3,"method":"echo"}`, CodeInvalidRequest},
+		{`{"jsonrpc":"2.0","id":4,"method":"missing"}`, CodeMethodNotFound},
+		{`{"jsonrpc":"2.0","id":5,"method":"echo","params":[1]}`, CodeInvalidParams},
+		{`{"jsonrpc":"2.0","id":6,"method":"fail"}`, CodeServerError},
+	}
+	for _, tt := range tests {
+		_, rpcErr := decodeRPC(t, r.Handle([]byte(tt.msg)))
+		if rpcErr == nil || rpcErr.Code != tt.code {
+			t.Errorf("%s: expected code %d, got %v", tt.msg, tt.code, rpcErr)
+		}
+	}
+
+	if resp := r.Handle([]byte(`{"jsonrpc":"2.0","method":"echo"}`)); resp != nil {
+		t.Errorf("Expected no response to a notification, got %s", resp)
+	}
+	if got := r.Methods(); strings.Join(got, ",") != "echo,fail" {
+		t.Errorf("Unexpected methods %v", got)
+	}
+}
+
+func TestRPC_ServeStream(t *testing.T) {
+	r := newTestRPC()
+
+	in := strings.NewReader("{\"jsonrpc\":\"2.0\",\"id\":1,\"method\":\"echo\",\"params\":{\"n\":1}}\n\n{\"jsonrpc\":\"2.0\",\"id\":2,\"method\":\"echo\"}")
+	var out bytes.Buffer
+	if err := r.ServeStream(in, &out, false); err != nil {
+		t.Fatalf("ServeStream failed: %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(out.String()), "\n"); len(lines) != 2 {
+		t.Errorf("Expected 2 responses, got %q", out.String())
+	}
+
+	frame := func(msg string) string {
+		return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(msg), msg)
+	}
+	in = strings.NewReader(frame(`{"jsonrpc":"2.0","id":1,"method":"echo","params":{"text":"line\nbreak"}}`) + frame(`{"jsonrpc":"2.0","method":"initialized"}`))
+	out.Reset()
+	if err := r.ServeStream(in, &out, true); err != nil {
+		t.Fatalf("framed ServeStream failed: %v", err)
+	}
+	header, body, ok := strings.Cut(out.String(), "\r\n\r\n")
+	if !ok || header != fmt.Sprintf("Content-Length: %d", len(body)) {
+		t.Fatalf("Expected one framed response, got %q", out.String())
+	}
+	if result, _ := decodeRPC(t, []byte(body)); result["text"] != "line\nbreak" {
+		t.Errorf("Unexpected result %v", result)
+	}
+
+	if err := r.ServeStream(strings.NewReader("Content-Length: x\r\n\r\n{}"), &out, true); err == nil {
+		t.Error("Expected an error for a bad Content-Length")
+	}
+}
+
+func TestRPC_ServeHTTP(t *testing.T) {
+	r := newTestRPC()
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("POST", "/v1/rpc", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"echo","params":{"a":"b"}}`)))
+	if result, rpcErr := decodeRPC(t, rec.Body.Bytes()); rec.Code != http.StatusOK || rpcErr != nil || result["a"] != "b" {
+		t.Errorf("Unexpected response %d %s", rec.Code, rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("POST", "/v1/rpc", strings.NewReader(`{"jsonrpc":"2.0","method":"echo"}`)))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected no content for a notification, got %d", rec.Code)
+	}
+}
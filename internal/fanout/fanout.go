@@ -0,0 +1,102 @@
+// Package fanout runs a per-repository operation across the configured
+// repositories concurrently. A failure in one repository does not stop the
+// others; each result records its own error, and Summary renders them as
+// one table.
+package fanout
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// DefaultConcurrency is how many repositories are processed at once when
+// no limit is configured
+const DefaultConcurrency = 4
+
+// Result is the outcome of the operation for one repository
+type Result[T any] struct {
+	Repository string // owner/repo, as configured
+	Value      T
+	Err        error
+	Duration   time.Duration
+}
+
+// Run calls fn for each owner/repo in repos, at most concurrency at a time
+// (DefaultConcurrency if concurrency is not positive). Results are in the
+// order of repos. A repository that is not owner/repo fails without
+// calling fn.
+func Run[T any](repos []string, concurrency int, fn func(owner, repo string) (T, error)) []Result[T] {
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	results := make([]Result[T], len(repos))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, fullName := range repos {
+		results[i].Repository = fullName
+		owner, repo, ok := strings.Cut(fullName, "/")
+		if !ok || owner == "" || repo == "" {
+			results[i].Err = fmt.Errorf("invalid repository format %q, expected owner/repo", fullName)
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(r *Result[T], owner, repo string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			defer func() {
+				// One repository's panic must not take down the others
+				if p := recover(); p != nil {
+					r.Err = fmt.Errorf("panic: %v", p)
+				}
+			}()
+
+			start := time.Now()
+			r.Value, r.Err = fn(owner, repo)
+			r.Duration = time.Since(start)
+		}(&results[i], owner, repo)
+	}
+
+	wg.Wait()
+	return results
+}
+
+// Failed returns the number of results with an error
+func Failed[T any](results []Result[T]) int {
+	n := 0
+	for _, r := range results {
+		if r.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Summary writes a table with a row per repository: whether it succeeded,
+// what describe says about its value (or the error), and how long it took
+func Summary[T any](w io.Writer, results []Result[T], describe func(T) string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "REPOSITORY\tSTATUS\tRESULT\tTIME")
+	for _, r := range results {
+		status, detail := "ok", describe(r.Value)
+		if r.Err != nil {
+			status, detail = "failed", strings.SplitN(r.Err.Error(), "\n", 2)[0]
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.Repository, status, detail, r.Duration.Round(10*time.Millisecond))
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if failed := Failed(results); failed > 0 {
+		fmt.Fprintf(w, "%d of %d repositories failed\n", failed, len(results))
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package fanout
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	repos := []string{"octo/app", "octo/api", "not-a-repo", "octo/web"}
+
+	var running, peak int32
+	results := Run(repos, 2, func(owner, repo string) (string, error) {
+		n := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		switch repo {
+		case "api":
+			return "", errors.New("rate limited")
+		case "web":
+			panic("boom")
+		}
+		return owner + "/" + repo, nil
+	})
+
+	if len(results) != len(repos) {
+		t.Fatalf("Expected %d results, got %d", len(repos), len(results))
+	}
+	for i, r := range results {
+		if r.Repository != repos[i] {
+			t.Errorf("results[%d].Repository = %q, want %q", i, r.Repository, repos[i])
+		}
+	}
+	if results[0].Value != "octo/app" || results[0].Err != nil {
+		t.Errorf("Unexpected result for octo/app: %+v", results[0])
+	}
+	if results[1].Err == nil || results[1].Err.Error() != "rate limited" {
+		t.Errorf("Expected the error isolated to octo/api, got %+v", results[1])
+	}
+	if results[2].Err == nil || !strings.Contains(results[2].Err.Error(), "invalid repository format") {
+		t.Errorf("Expected an invalid format error, got %+v", results[2])
+	}
+	if results[3].Err == nil || results[3].Err.Error() != "panic: boom" {
+		t.Errorf("Expected the panic recovered as an error, got %+v", results[3])
+	}
+	if peak > 2 {
+		t.Errorf("Expected at most 2 repositories at once, got %d", peak)
+	}
+	if Failed(results) != 3 {
+		t.Errorf("Failed() = %d, want 3", Failed(results))
+	}
+}
+
+func TestSummary(t *testing.T) {
+	results := []Result[int]{
+		{Repository: "octo/app", Value: 3, Duration: 1234 * time.Millisecond},
+		{Repository: "octo/api", Err: errors.New("rate limited\nretry later")},
+	}
+
+	var buf bytes.Buffer
+	if err := Summary(&buf, results, func(n int) string { return strings.Repeat("*", n) }); err != nil {
+		t.Fatalf("Summary() error = %v", err)
+	}
+
+	want := "REPOSITORY  STATUS  RESULT        TIME\n" +
+		"octo/app    ok      ***           1.23s\n" +
+		"octo/api    failed  rate limited  0s\n" +
+		"1 of 2 repositories failed\n"
+	if buf.String() != want {
+		t.Errorf("Summary() =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
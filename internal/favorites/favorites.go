@@ -0,0 +1,73 @@
+// Package favorites keeps a personal, local set of bookmarked project
+// issues.
+package favorites
+
+import (
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// Favorite is a bookmarked issue
+type Favorite struct {
+	Key     string `json:"key"`     // owner/repo#number
+	AddedAt string `json:"addedAt"` // RFC 3339
+}
+
+// Store holds the favorites for one project, in the order they were added
+type Store struct {
+	Project   string     `json:"project"` // owner/number
+	Favorites []Favorite `json:"favorites"`
+}
+
+// DefaultPath returns the favorites file for a project in the user config directory
+func DefaultPath(owner string, number int) (string, error) {
+	return localstore.ConfigPath("favorites", localstore.ProjectFile(owner, number))
+}
+
+// Load reads the favorites file at path. A missing file yields an empty store.
+func Load(path string) (*Store, error) {
+	store := &Store{}
+	if _, err := localstore.Load(path, "favorites", store); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+// Save writes the store to path, creating parent directories as needed
+func (s *Store) Save(path string) error {
+	return localstore.Save(path, "favorites", s)
+}
+
+// Add bookmarks an issue, reporting false if it already was
+func (s *Store) Add(key string, now time.Time) bool {
+	if s.Has(key) {
+		return false
+	}
+	s.Favorites = append(s.Favorites, Favorite{Key: key, AddedAt: now.UTC().Format(time.RFC3339)})
+	return true
+}
+
+// Remove drops an issue from the favorites, reporting whether it was one
+func (s *Store) Remove(key string) bool {
+	for i, f := range s.Favorites {
+		if f.Key == key {
+			s.Favorites = append(s.Favorites[:i:i], s.Favorites[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Has reports whether an issue is a favorite. A nil store has none.
+func (s *Store) Has(key string) bool {
+	if s == nil {
+		return false
+	}
+	for _, f := range s.Favorites {
+		if f.Key == key {
+			return true
+		}
+	}
+	return false
+}
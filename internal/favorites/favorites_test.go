@@ -0,0 +1,73 @@
+package favorites
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStore_AddRemoveHas(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	store := &Store{}
+
+	if !store.Add("owner/repo#1", now) {
+		t.Fatal("Expected first add to succeed")
+	}
+	if store.Add("owner/repo#1", now) {
+		t.Error("Expected duplicate add to report false")
+	}
+	store.Add("owner/repo#2", now)
+
+	if !store.Has("owner/repo#1") || store.Has("owner/repo#3") {
+		t.Error("Has() returned unexpected results")
+	}
+	if store.Favorites[0].AddedAt != "2025-03-01T09:00:00Z" {
+		t.Errorf("Unexpected AddedAt: %q", store.Favorites[0].AddedAt)
+	}
+
+	if !store.Remove("owner/repo#1") || store.Remove("owner/repo#1") {
+		t.Error("Remove() returned unexpected results")
+	}
+	if len(store.Favorites) != 1 || store.Favorites[0].Key != "owner/repo#2" {
+		t.Errorf("Unexpected favorites after Remove: %+v", store.Favorites)
+	}
+
+	var nilStore *Store
+	if nilStore.Has("owner/repo#2") {
+		t.Error("Expected a nil store to have no favorites")
+	}
+}
+
+func TestStore_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "favorites.json")
+
+	store, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() of missing file error = %v", err)
+	}
+	if len(store.Favorites) != 0 {
+		t.Fatalf("Expected an empty store, got %+v", store.Favorites)
+	}
+
+	store.Project = "owner/1"
+	store.Add("owner/repo#7", time.Now())
+	if err := store.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Project != "owner/1" || !loaded.Has("owner/repo#7") {
+		t.Errorf("Unexpected loaded store: %+v", loaded)
+	}
+
+	if err := os.WriteFile(path, []byte("["), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Expected error for invalid favorites file")
+	}
+}
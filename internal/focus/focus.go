@@ -0,0 +1,56 @@
+// Package focus records the issue currently being worked on, so commands
+// can default to it when no issue is given.
+package focus
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// State is the focused issue for one project
+type State struct {
+	Project string `json:"project"` // owner/number
+	Issue   string `json:"issue"`   // owner/repo#number
+	SetAt   string `json:"setAt"`   // RFC 3339
+}
+
+// DefaultPath returns the focus file for a project in the user config directory
+func DefaultPath(owner string, number int) (string, error) {
+	return localstore.ConfigPath("focus", localstore.ProjectFile(owner, number))
+}
+
+// Load reads the focus file at path. A missing file yields an empty state.
+func Load(path string) (*State, error) {
+	state := &State{}
+	if _, err := localstore.Load(path, "focus", state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// Set focuses an issue, replacing any previous focus
+func (s *State) Set(issue string, now time.Time) {
+	s.Issue = issue
+	s.SetAt = now.UTC().Format(time.RFC3339)
+}
+
+// Save writes the state to path, creating parent directories as needed
+func (s *State) Save(path string) error {
+	return localstore.Save(path, "focus", s)
+}
+
+// Clear removes the focus file, reporting whether there was a focus
+func Clear(path string) (bool, error) {
+	state, err := Load(path)
+	if err != nil {
+		return false, err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("failed to clear focus: %w", err)
+	}
+	return state.Issue != "", nil
+}
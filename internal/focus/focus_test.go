@@ -0,0 +1,48 @@
+package focus
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestState_SaveLoadClear(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "focus.json")
+
+	state, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() of missing file error = %v", err)
+	}
+	if state.Issue != "" {
+		t.Fatalf("Expected no focus, got %+v", state)
+	}
+
+	state.Project = "owner/1"
+	state.Set("owner/repo#42", time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC))
+	if err := state.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Issue != "owner/repo#42" || loaded.SetAt != "2025-03-01T09:00:00Z" || loaded.Project != "owner/1" {
+		t.Errorf("Unexpected loaded state: %+v", loaded)
+	}
+
+	if cleared, err := Clear(path); err != nil || !cleared {
+		t.Errorf("Clear() = %v, %v; want true, nil", cleared, err)
+	}
+	if cleared, err := Clear(path); err != nil || cleared {
+		t.Errorf("Second Clear() = %v, %v; want false, nil", cleared, err)
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Expected error for invalid focus file")
+	}
+}
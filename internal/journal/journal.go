@@ -0,0 +1,111 @@
+// Package journal records what each move changed, with the values it
+// replaced, so the move can be undone.
+package journal
+
+import (
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// MaxEntries is how many moves are kept; older ones can no longer be undone
+const MaxEntries = 20
+
+// FieldChange is a project field set by a move
+type FieldChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"` // empty when the field was unset
+	New   string `json:"new"` // empty when the move cleared it
+}
+
+// Change is everything a move changed on one issue
+type Change struct {
+	Issue      string        `json:"issue"` // owner/repo#number
+	ItemID     string        `json:"itemId"`
+	IssueID    string        `json:"issueId"`
+	Fields     []FieldChange `json:"fields,omitempty"`
+	Assigned   []string      `json:"assigned,omitempty"`   // logins the move assigned
+	Unassigned []string      `json:"unassigned,omitempty"` // logins the move unassigned
+	Closed     bool          `json:"closed,omitempty"`     // the move closed the issue
+}
+
+// Empty reports whether the move changed nothing on the issue
+func (c *Change) Empty() bool {
+	return len(c.Fields) == 0 && len(c.Assigned) == 0 && len(c.Unassigned) == 0 && !c.Closed
+}
+
+// Entry is one move, possibly of many issues
+type Entry struct {
+	Summary string   `json:"summary"` // the changes requested, for display
+	At      string   `json:"at"`      // RFC 3339
+	Changes []Change `json:"changes"`
+}
+
+// Add appends a change for an issue and returns it, to be filled in as the
+// move proceeds. The pointer is valid until the next Add.
+func (e *Entry) Add(issue, itemID, issueID string) *Change {
+	e.Changes = append(e.Changes, Change{Issue: issue, ItemID: itemID, IssueID: issueID})
+	return &e.Changes[len(e.Changes)-1]
+}
+
+// Journal is the recent moves for one project, oldest first
+type Journal struct {
+	Project string  `json:"project"` // owner/number
+	Entries []Entry `json:"entries"`
+}
+
+// DefaultPath returns the journal file for a project in the user config directory
+func DefaultPath(owner string, number int) (string, error) {
+	return localstore.ConfigPath("journal", localstore.ProjectFile(owner, number))
+}
+
+// Load reads the journal at path. A missing file yields an empty journal.
+func Load(path string) (*Journal, error) {
+	j := &Journal{}
+	if _, err := localstore.Load(path, "journal", j); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// Record appends a move, dropping issues it did not change. A move that
+// changed nothing is not recorded. Only the last MaxEntries moves are kept.
+func (j *Journal) Record(e Entry, now time.Time) bool {
+	var changes []Change
+	for _, c := range e.Changes {
+		if !c.Empty() {
+			changes = append(changes, c)
+		}
+	}
+	if len(changes) == 0 {
+		return false
+	}
+
+	e.Changes = changes
+	e.At = now.UTC().Format(time.RFC3339)
+	j.Entries = append(j.Entries, e)
+	if len(j.Entries) > MaxEntries {
+		j.Entries = j.Entries[len(j.Entries)-MaxEntries:]
+	}
+	return true
+}
+
+// Last returns the most recent move, or nil when there is none
+func (j *Journal) Last() *Entry {
+	if len(j.Entries) == 0 {
+		return nil
+	}
+	return &j.Entries[len(j.Entries)-1]
+}
+
+// Pop removes the most recent move
+func (j *Journal) Pop() {
+	if len(j.Entries) > 0 {
+		j.Entries = j.Entries[:len(j.Entries)-1]
+	}
+}
+
+// Save writes the journal to path, creating parent directories as needed
+func (j *Journal) Save(path string) error {
+	return localstore.Save(path, "journal", j)
+}
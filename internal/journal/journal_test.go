@@ -0,0 +1,66 @@
+package journal
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestJournal_RecordDropsUnchangedIssues(t *testing.T) {
+	j := &Journal{}
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	e := Entry{Summary: "Status → Done"}
+	e.Add("owner/repo#1", "item-1", "issue-1").Fields = []FieldChange{{Field: "Status", Old: "Todo", New: "Done"}}
+	e.Add("owner/repo#2", "item-2", "issue-2")
+	if !j.Record(e, now) {
+		t.Fatal("Expected the move to be recorded")
+	}
+	last := j.Last()
+	if len(last.Changes) != 1 || last.Changes[0].Issue != "owner/repo#1" || last.At != "2026-03-02T09:00:00Z" {
+		t.Errorf("Unexpected entry %+v", last)
+	}
+
+	if j.Record(Entry{Changes: []Change{{Issue: "owner/repo#3"}}}, now) {
+		t.Error("Expected a move that changed nothing not to be recorded")
+	}
+}
+
+func TestJournal_KeepsLastEntries(t *testing.T) {
+	j := &Journal{}
+	for i := 0; i < MaxEntries+5; i++ {
+		j.Record(Entry{Summary: fmt.Sprintf("move %d", i), Changes: []Change{{Issue: "owner/repo#1", Closed: true}}}, time.Now())
+	}
+	if len(j.Entries) != MaxEntries || j.Entries[0].Summary != "move 5" {
+		t.Errorf("Expected the last %d moves, got %d starting with %q", MaxEntries, len(j.Entries), j.Entries[0].Summary)
+	}
+
+	j.Pop()
+	if j.Last().Summary != fmt.Sprintf("move %d", MaxEntries+3) {
+		t.Errorf("Expected the previous move after Pop, got %q", j.Last().Summary)
+	}
+}
+
+func TestJournal_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal", "owner-1.json")
+
+	j, err := Load(path)
+	if err != nil || j.Last() != nil {
+		t.Fatalf("Expected an empty journal for a missing file, got %+v, %v", j, err)
+	}
+
+	j.Project = "owner/1"
+	j.Record(Entry{Summary: "Assignees: +@me", Changes: []Change{{Issue: "owner/repo#1", Assigned: []string{"me"}}}}, time.Now())
+	if err := j.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.Project != "owner/1" || len(loaded.Entries) != 1 || loaded.Last().Changes[0].Assigned[0] != "me" {
+		t.Errorf("Unexpected journal %+v", loaded)
+	}
+}
@@ -0,0 +1,83 @@
+// Package keyscript replays a keystroke script as terminal input, so
+// interactive flows can be driven unattended in tests and demos.
+//
+// A script is plain text. Each line is typed followed by Enter, so a blank
+// line presses Enter alone. A line that is only "#", or starts with "# ",
+// is a comment; "#42" is typed as written.
+package keyscript
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Parse returns the lines a script types, without its comments
+func Parse(text string) []string {
+	text = strings.TrimSuffix(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	if text == "" {
+		return nil
+	}
+
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line == "#" || strings.HasPrefix(line, "# ") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Load reads and parses the script at path
+func Load(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read script: %w", err)
+	}
+	return Parse(string(data)), nil
+}
+
+// Reader types a script's lines as input. Each read returns at most one
+// line, which is written to the echo writer as it is read, the way a
+// terminal echoes keystrokes, so prompts and answers interleave in the
+// output. After the last line it reports io.EOF.
+type Reader struct {
+	lines   []string
+	pending []byte // The rest of the line being read
+	echo    io.Writer
+}
+
+// NewReader returns a Reader typing lines. echo may be nil.
+func NewReader(lines []string, echo io.Writer) *Reader {
+	return &Reader{lines: lines, echo: echo}
+}
+
+// Read implements io.Reader
+func (r *Reader) Read(p []byte) (int, error) {
+	if len(r.pending) == 0 {
+		if len(r.lines) == 0 {
+			return 0, io.EOF
+		}
+		r.pending = []byte(r.lines[0] + "\n")
+		r.lines = r.lines[1:]
+	}
+
+	n := copy(p, r.pending)
+	if r.echo != nil {
+		_, _ = r.echo.Write(r.pending[:n])
+	}
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// Remaining returns the number of lines not read yet, including one
+// partly read
+func (r *Reader) Remaining() int {
+	n := len(r.lines)
+	if len(r.pending) > 0 {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,74 @@
+package keyscript
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	script := "# choose the project\r\n2\r\n\r\n#42\r\n#\r\ny\r\n"
+
+	want := []string{"2", "", "#42", "y"}
+	if got := Parse(script); !slices.Equal(got, want) {
+		t.Errorf("Parse() = %q, want %q", got, want)
+	}
+	if got := Parse(""); got != nil {
+		t.Errorf("Parse(\"\") = %q, want nil", got)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "demo.keys")
+	if err := os.WriteFile(path, []byte("a\nb\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	lines, err := Load(path)
+	if err != nil || !slices.Equal(lines, []string{"a", "b"}) {
+		t.Errorf("Load() = %q, %v", lines, err)
+	}
+	if _, err := Load(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Error("Expected error for a missing script")
+	}
+}
+
+func TestReader_EchoesEachLineAsRead(t *testing.T) {
+	var out strings.Builder
+	r := NewReader([]string{"2", "", "yes"}, &out)
+	in := bufio.NewReader(r)
+
+	// A prompt, then the answer it reads, as on a terminal
+	for _, prompt := range []string{"Project: ", "Name [default]: ", "Confirm? "} {
+		fmt.Fprint(&out, prompt)
+		if _, err := in.ReadString('\n'); err != nil {
+			t.Fatalf("ReadString() error = %v", err)
+		}
+	}
+	want := "Project: 2\nName [default]: \nConfirm? yes\n"
+	if out.String() != want {
+		t.Errorf("Output = %q, want %q", out.String(), want)
+	}
+
+	if _, err := in.ReadString('\n'); err != io.EOF {
+		t.Errorf("Expected EOF after the script, got %v", err)
+	}
+}
+
+func TestReader_SmallReads(t *testing.T) {
+	r := NewReader([]string{"abc"}, nil)
+	buf := make([]byte, 2)
+
+	n, _ := r.Read(buf)
+	if string(buf[:n]) != "ab" || r.Remaining() != 1 {
+		t.Errorf("First read = %q, remaining %d", buf[:n], r.Remaining())
+	}
+	n, _ = r.Read(buf)
+	if string(buf[:n]) != "c\n" || r.Remaining() != 0 {
+		t.Errorf("Second read = %q, remaining %d", buf[:n], r.Remaining())
+	}
+}
@@ -0,0 +1,151 @@
+package llm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// Default embedding models per provider
+const (
+	defaultOpenAIEmbeddingModel = "text-embedding-3-small"
+	defaultOllamaEmbeddingModel = "nomic-embed-text"
+)
+
+// Embedder converts texts into embedding vectors
+type Embedder interface {
+	Embed(texts []string) ([][]float32, error)
+	Model() string
+}
+
+// NewEmbedder creates the embedding backend described by cfg
+func NewEmbedder(cfg *config.AI) (Embedder, error) {
+	if cfg == nil || cfg.Provider == "" {
+		return nil, ErrNotConfigured
+	}
+
+	httpClient := &http.Client{Timeout: defaultTimeout}
+
+	switch strings.ToLower(cfg.Provider) {
+	case ProviderOpenAI:
+		key, err := apiKey(cfg.APIKeyEnv, defaultOpenAIKeyEnv)
+		if err != nil {
+			return nil, err
+		}
+		model := orDefault(cfg.EmbeddingModel, defaultOpenAIEmbeddingModel)
+		return &openAIEmbedder{
+			http:    httpClient,
+			url:     strings.TrimSuffix(orDefault(cfg.Endpoint, defaultOpenAIEndpoint), "/") + "/embeddings",
+			model:   model,
+			name:    model,
+			headers: map[string]string{"Authorization": "Bearer " + key},
+		}, nil
+
+	case ProviderAzure:
+		if cfg.Endpoint == "" {
+			return nil, fmt.Errorf("ai.endpoint is required for the azure provider")
+		}
+		if cfg.EmbeddingModel == "" {
+			return nil, fmt.Errorf("ai.embedding_model is required for the azure provider (the embedding deployment name)")
+		}
+		key, err := apiKey(cfg.APIKeyEnv, defaultAzureKeyEnv)
+		if err != nil {
+			return nil, err
+		}
+		return &openAIEmbedder{
+			http: httpClient,
+			url: fmt.Sprintf("%s/openai/deployments/%s/embeddings?api-version=%s",
+				strings.TrimSuffix(cfg.Endpoint, "/"), cfg.EmbeddingModel, orDefault(cfg.APIVersion, defaultAzureVersion)),
+			name:    cfg.EmbeddingModel,
+			headers: map[string]string{"api-key": key},
+		}, nil
+
+	case ProviderOllama:
+		model := orDefault(cfg.EmbeddingModel, defaultOllamaEmbeddingModel)
+		return &ollamaEmbedder{
+			http:  httpClient,
+			url:   strings.TrimSuffix(orDefault(cfg.Endpoint, defaultOllamaEndpoint), "/") + "/api/embed",
+			model: model,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown ai.provider %q: must be openai, azure, or ollama", cfg.Provider)
+}
+
+// openAIEmbedder talks to OpenAI-compatible embedding APIs,
+// which covers both OpenAI and Azure OpenAI
+type openAIEmbedder struct {
+	http    *http.Client
+	url     string
+	model   string // empty for Azure, where the deployment selects the model
+	name    string
+	headers map[string]string
+}
+
+func (e *openAIEmbedder) Model() string {
+	return e.name
+}
+
+func (e *openAIEmbedder) Embed(texts []string) ([][]float32, error) {
+	body := map[string]interface{}{
+		"input": texts,
+	}
+	if e.model != "" {
+		body["model"] = e.model
+	}
+
+	var resp struct {
+		Data []struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		} `json:"data"`
+	}
+	if err := postJSON(e.http, e.url, e.headers, body, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Data) != len(texts) {
+		return nil, fmt.Errorf("model returned %d embeddings for %d inputs", len(resp.Data), len(texts))
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, d := range resp.Data {
+		if d.Index < 0 || d.Index >= len(texts) {
+			return nil, fmt.Errorf("model returned embedding with invalid index %d", d.Index)
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	return vectors, nil
+}
+
+// ollamaEmbedder talks to a local Ollama server
+type ollamaEmbedder struct {
+	http  *http.Client
+	url   string
+	model string
+}
+
+func (e *ollamaEmbedder) Model() string {
+	return e.model
+}
+
+func (e *ollamaEmbedder) Embed(texts []string) ([][]float32, error) {
+	body := map[string]interface{}{
+		"model": e.model,
+		"input": texts,
+	}
+
+	var resp struct {
+		Embeddings [][]float32 `json:"embeddings"`
+	}
+	if err := postJSON(e.http, e.url, nil, body, &resp); err != nil {
+		return nil, err
+	}
+
+	if len(resp.Embeddings) != len(texts) {
+		return nil, fmt.Errorf("model returned %d embeddings for %d inputs", len(resp.Embeddings), len(texts))
+	}
+	return resp.Embeddings, nil
+}
@@ -0,0 +1,88 @@
+package llm
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+func TestNewEmbedder_NotConfigured(t *testing.T) {
+	if _, err := NewEmbedder(nil); !errors.Is(err, ErrNotConfigured) {
+		t.Errorf("Expected ErrNotConfigured, got %v", err)
+	}
+}
+
+func TestNewEmbedder_AzureRequiresEmbeddingModel(t *testing.T) {
+	t.Setenv("TEST_LLM_KEY", "k")
+	_, err := NewEmbedder(&config.AI{Provider: "azure", Endpoint: "https://x", APIKeyEnv: "TEST_LLM_KEY"})
+	if err == nil || !strings.Contains(err.Error(), "ai.embedding_model is required") {
+		t.Errorf("Expected embedding_model error, got %v", err)
+	}
+}
+
+func TestOpenAIEmbedder_Embed(t *testing.T) {
+	var gotBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &gotBody)
+		// Return out of order to verify index handling
+		_, _ = w.Write([]byte(`{"data":[{"index":1,"embedding":[0,1]},{"index":0,"embedding":[1,0]}]}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("TEST_LLM_KEY", "secret")
+	e, err := NewEmbedder(&config.AI{Provider: "openai", Endpoint: server.URL + "/v1", APIKeyEnv: "TEST_LLM_KEY"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if e.Model() != defaultOpenAIEmbeddingModel {
+		t.Errorf("Expected default model, got %s", e.Model())
+	}
+
+	vectors, err := e.Embed([]string{"a", "b"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if vectors[0][0] != 1 || vectors[1][1] != 1 {
+		t.Errorf("Expected vectors ordered by index, got %v", vectors)
+	}
+	if gotBody["model"] != defaultOpenAIEmbeddingModel {
+		t.Errorf("Expected model in request, got %v", gotBody["model"])
+	}
+}
+
+func TestOllamaEmbedder_Embed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/embed" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"embeddings":[[0.5,0.5]]}`))
+	}))
+	defer server.Close()
+
+	e, err := NewEmbedder(&config.AI{Provider: "ollama", Endpoint: server.URL, EmbeddingModel: "mxbai-embed-large"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	vectors, err := e.Embed([]string{"a"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(vectors) != 1 || e.Model() != "mxbai-embed-large" {
+		t.Errorf("Unexpected result: %v (model %s)", vectors, e.Model())
+	}
+
+	if _, err := e.Embed([]string{"a", "b"}); err == nil || !strings.Contains(err.Error(), "1 embeddings for 2 inputs") {
+		t.Errorf("Expected count mismatch error, got %v", err)
+	}
+}
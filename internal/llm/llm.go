@@ -0,0 +1,217 @@
+// Package llm provides the optional language model backends used for
+// issue summarization and embeddings. No requests are made unless an "ai" section is
+// present in the configuration.
+package llm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// Supported providers
+const (
+	ProviderOpenAI = "openai"
+	ProviderAzure  = "azure"
+	ProviderOllama = "ollama"
+)
+
+// Default settings per provider
+const (
+	defaultOpenAIEndpoint = "https://api.openai.com/v1"
+	defaultOpenAIModel    = "gpt-4o-mini"
+	defaultOpenAIKeyEnv   = "OPENAI_API_KEY"
+	defaultAzureKeyEnv    = "AZURE_OPENAI_API_KEY"
+	defaultAzureVersion   = "2024-06-01"
+	defaultOllamaEndpoint = "http://localhost:11434"
+	defaultOllamaModel    = "llama3.1"
+	defaultTimeout        = 60 * time.Second
+)
+
+// Provider sends a prompt to a language model and returns its reply
+type Provider interface {
+	Complete(system, prompt string) (string, error)
+}
+
+// ErrNotConfigured is returned by New when the config has no ai section
+var ErrNotConfigured = fmt.Errorf("AI features are not configured\nAdd an 'ai' section to %s to enable them", config.ConfigFileName)
+
+// New creates the provider described by cfg
+func New(cfg *config.AI) (Provider, error) {
+	if cfg == nil || cfg.Provider == "" {
+		return nil, ErrNotConfigured
+	}
+
+	httpClient := &http.Client{Timeout: defaultTimeout}
+
+	switch strings.ToLower(cfg.Provider) {
+	case ProviderOpenAI:
+		key, err := apiKey(cfg.APIKeyEnv, defaultOpenAIKeyEnv)
+		if err != nil {
+			return nil, err
+		}
+		return &chatCompletionsProvider{
+			http:    httpClient,
+			url:     strings.TrimSuffix(orDefault(cfg.Endpoint, defaultOpenAIEndpoint), "/") + "/chat/completions",
+			model:   orDefault(cfg.Model, defaultOpenAIModel),
+			headers: map[string]string{"Authorization": "Bearer " + key},
+		}, nil
+
+	case ProviderAzure:
+		if cfg.Endpoint == "" {
+			return nil, fmt.Errorf("ai.endpoint is required for the azure provider")
+		}
+		if cfg.Deployment == "" {
+			return nil, fmt.Errorf("ai.deployment is required for the azure provider")
+		}
+		key, err := apiKey(cfg.APIKeyEnv, defaultAzureKeyEnv)
+		if err != nil {
+			return nil, err
+		}
+		return &chatCompletionsProvider{
+			http: httpClient,
+			url: fmt.Sprintf("%s/openai/deployments/%s/chat/completions?api-version=%s",
+				strings.TrimSuffix(cfg.Endpoint, "/"), cfg.Deployment, orDefault(cfg.APIVersion, defaultAzureVersion)),
+			headers: map[string]string{"api-key": key},
+		}, nil
+
+	case ProviderOllama:
+		return &ollamaProvider{
+			http:  httpClient,
+			url:   strings.TrimSuffix(orDefault(cfg.Endpoint, defaultOllamaEndpoint), "/") + "/api/chat",
+			model: orDefault(cfg.Model, defaultOllamaModel),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unknown ai.provider %q: must be openai, azure, or ollama", cfg.Provider)
+}
+
+func apiKey(envVar, fallback string) (string, error) {
+	name := orDefault(envVar, fallback)
+	key := os.Getenv(name)
+	if key == "" {
+		return "", fmt.Errorf("API key not found: set the %s environment variable", name)
+	}
+	return key, nil
+}
+
+func orDefault(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// chatCompletionsProvider talks to OpenAI-compatible chat completion APIs,
+// which covers both OpenAI and Azure OpenAI
+type chatCompletionsProvider struct {
+	http    *http.Client
+	url     string
+	model   string // empty for Azure, where the deployment selects the model
+	headers map[string]string
+}
+
+func (p *chatCompletionsProvider) Complete(system, prompt string) (string, error) {
+	body := map[string]interface{}{
+		"messages": []chatMessage{
+			{Role: "system", Content: system},
+			{Role: "user", Content: prompt},
+		},
+	}
+	if p.model != "" {
+		body["model"] = p.model
+	}
+
+	var resp struct {
+		Choices []struct {
+			Message chatMessage `json:"message"`
+		} `json:"choices"`
+	}
+	if err := postJSON(p.http, p.url, p.headers, body, &resp); err != nil {
+		return "", err
+	}
+
+	if len(resp.Choices) == 0 {
+		return "", fmt.Errorf("model returned no choices")
+	}
+	return strings.TrimSpace(resp.Choices[0].Message.Content), nil
+}
+
+// ollamaProvider talks to a local Ollama server
+type ollamaProvider struct {
+	http  *http.Client
+	url   string
+	model string
+}
+
+func (p *ollamaProvider) Complete(system, prompt string) (string, error) {
+	body := map[string]interface{}{
+		"model":  p.model,
+		"stream": false,
+		"messages": []chatMessage{
+			{Role: "system", Content: system},
+			{Role: "user", Content: prompt},
+		},
+	}
+
+	var resp struct {
+		Message chatMessage `json:"message"`
+	}
+	if err := postJSON(p.http, p.url, nil, body, &resp); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(resp.Message.Content), nil
+}
+
+func postJSON(client *http.Client, url string, headers map[string]string, body, out interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("AI request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read AI response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg := strings.TrimSpace(string(data))
+		if len(msg) > 200 {
+			msg = msg[:200] + "..."
+		}
+		return fmt.Errorf("AI request failed with status %d: %s", resp.StatusCode, msg)
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode AI response: %w", err)
+	}
+	return nil
+}
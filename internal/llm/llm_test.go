@@ -0,0 +1,234 @@
+package llm
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+func TestNew_NotConfigured(t *testing.T) {
+	if _, err := New(nil); !errors.Is(err, ErrNotConfigured) {
+		t.Errorf("Expected ErrNotConfigured for nil config, got %v", err)
+	}
+	if _, err := New(&config.AI{}); !errors.Is(err, ErrNotConfigured) {
+		t.Errorf("Expected ErrNotConfigured for empty provider, got %v", err)
+	}
+}
+
+func TestNew_Validation(t *testing.T) {
+	t.Setenv("TEST_LLM_KEY", "")
+
+	tests := []struct {
+		name    string
+		cfg     config.AI
+		wantErr string
+	}{
+		{"unknown provider", config.AI{Provider: "bard"}, "unknown ai.provider"},
+		{"openai missing key", config.AI{Provider: "openai", APIKeyEnv: "TEST_LLM_KEY"}, "TEST_LLM_KEY"},
+		{"azure missing endpoint", config.AI{Provider: "azure", Deployment: "d"}, "ai.endpoint is required"},
+		{"azure missing deployment", config.AI{Provider: "azure", Endpoint: "https://x"}, "ai.deployment is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(&tt.cfg)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestOpenAIProvider_Complete(t *testing.T) {
+	var gotAuth string
+	var gotBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("Unexpected path %s", r.URL.Path)
+		}
+		gotAuth = r.Header.Get("Authorization")
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &gotBody)
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"  short summary \n"}}]}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("TEST_LLM_KEY", "secret")
+	p, err := New(&config.AI{Provider: "openai", Endpoint: server.URL + "/v1", Model: "m1", APIKeyEnv: "TEST_LLM_KEY"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got, err := p.Complete("system", "prompt")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != "short summary" {
+		t.Errorf("Expected trimmed reply, got %q", got)
+	}
+	if gotAuth != "Bearer secret" {
+		t.Errorf("Expected bearer auth, got %q", gotAuth)
+	}
+	if gotBody["model"] != "m1" {
+		t.Errorf("Expected model m1, got %v", gotBody["model"])
+	}
+}
+
+func TestAzureProvider_Complete(t *testing.T) {
+	var gotKey, gotQuery, gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotKey = r.Header.Get("api-key")
+		gotPath = r.URL.Path
+		gotQuery = r.URL.RawQuery
+		_, _ = w.Write([]byte(`{"choices":[{"message":{"content":"ok"}}]}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("TEST_LLM_KEY", "azkey")
+	p, err := New(&config.AI{Provider: "azure", Endpoint: server.URL, Deployment: "gpt", APIKeyEnv: "TEST_LLM_KEY"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, err := p.Complete("s", "p"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if gotKey != "azkey" || gotPath != "/openai/deployments/gpt/chat/completions" || gotQuery != "api-version="+defaultAzureVersion {
+		t.Errorf("Unexpected request: key=%q path=%q query=%q", gotKey, gotPath, gotQuery)
+	}
+}
+
+func TestOllamaProvider_Complete(t *testing.T) {
+	var gotBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &gotBody)
+		_, _ = w.Write([]byte(`{"message":{"role":"assistant","content":"local summary"}}`))
+	}))
+	defer server.Close()
+
+	p, err := New(&config.AI{Provider: "ollama", Endpoint: server.URL})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	got, err := p.Complete("s", "p")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got != "local summary" {
+		t.Errorf("Expected reply, got %q", got)
+	}
+	if gotBody["stream"] != false || gotBody["model"] != defaultOllamaModel {
+		t.Errorf("Unexpected request body: %v", gotBody)
+	}
+}
+
+func TestProvider_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "model not found", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	p, _ := New(&config.AI{Provider: "ollama", Endpoint: server.URL})
+	_, err := p.Complete("s", "p")
+	if err == nil || !strings.Contains(err.Error(), "status 404") || !strings.Contains(err.Error(), "model not found") {
+		t.Errorf("Expected status error, got %v", err)
+	}
+}
+
+func TestBuildIssuePrompt(t *testing.T) {
+	issue := &api.Issue{
+		Number: 7,
+		Title:  "Login fails",
+		State:  "OPEN",
+		Body:   "Steps to reproduce",
+		Author: api.Actor{Login: "alice"},
+		Labels: []api.Label{{Name: "bug"}},
+	}
+	comments := []api.Comment{
+		{Author: "bob", Body: "first", CreatedAt: "2024-01-01"},
+		{Author: "carol", Body: "second", CreatedAt: "2024-01-02"},
+	}
+
+	prompt := BuildIssuePrompt(issue, comments, 0)
+	for _, want := range []string{"Issue #7: Login fails", "Labels: bug", "Steps to reproduce", "@bob", "@carol", "Comments (2)"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("Expected prompt to contain %q, got:\n%s", want, prompt)
+		}
+	}
+}
+
+func TestBuildIssuePrompt_DropsOldestComments(t *testing.T) {
+	issue := &api.Issue{Number: 1, Title: "T", Body: "body"}
+	comments := []api.Comment{
+		{Author: "old", Body: strings.Repeat("a", 100)},
+		{Author: "new", Body: strings.Repeat("b", 100)},
+	}
+
+	prompt := BuildIssuePrompt(issue, comments, 250)
+	if strings.Contains(prompt, "@old") {
+		t.Errorf("Expected oldest comment to be dropped, got:\n%s", prompt)
+	}
+	if !strings.Contains(prompt, "@new") || !strings.Contains(prompt, "oldest 1 omitted") {
+		t.Errorf("Expected newest comment and omission note, got:\n%s", prompt)
+	}
+}
+
+func TestBuildIssuePrompt_TruncatesBody(t *testing.T) {
+	issue := &api.Issue{Number: 1, Title: "T", Body: strings.Repeat("x", 1000)}
+
+	prompt := BuildIssuePrompt(issue, nil, 200)
+	if !strings.Contains(prompt, "[truncated]") || len(prompt) > 250 {
+		t.Errorf("Expected truncated body, got %d chars", len(prompt))
+	}
+}
+
+func TestSummarizeIssue_EmptyReply(t *testing.T) {
+	_, err := SummarizeIssue(stubProvider(""), &api.Issue{Number: 1}, nil, 0)
+	if err == nil || !strings.Contains(err.Error(), "empty response") {
+		t.Errorf("Expected empty response error, got %v", err)
+	}
+}
+
+type stubProvider string
+
+func (s stubProvider) Complete(system, prompt string) (string, error) {
+	return string(s), nil
+}
+
+func TestParseSuggestedTasks(t *testing.T) {
+	reply := "Here is a breakdown:\n" +
+		"- [ ] Design the API\n" +
+		"- [x] Already done\n" +
+		"* Write **tests**\n" +
+		"2. Update docs\n" +
+		"3. **Release**\n" +
+		"- [ ] design the api\n" +
+		"Thanks!"
+
+	got := ParseSuggestedTasks(reply)
+	want := []string{"Design the API", "Write **tests**", "Update docs", "Release"}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("ParseSuggestedTasks() = %q, want %q", got, want)
+	}
+}
+
+func TestSuggestSplit(t *testing.T) {
+	issue := &api.Issue{Number: 3, Title: "Epic", Body: "Build login"}
+
+	tasks, err := SuggestSplit(stubProvider("- [ ] Add form\n- [ ] Add session handling"), issue, 0)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(tasks) != 2 || tasks[1] != "Add session handling" {
+		t.Errorf("Unexpected tasks: %v", tasks)
+	}
+}
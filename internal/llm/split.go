@@ -0,0 +1,69 @@
+package llm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+const splitSystemPrompt = `You break GitHub epics into sub-issues.
+Reply only with a markdown checklist, one "- [ ] <title>" line per sub-task.
+Each title must be short, actionable, and independently completable.
+Suggest between 2 and 10 tasks. Do not repeat items already checked off.`
+
+// taskLine matches checklist, bullet, and numbered list items
+var taskLine = regexp.MustCompile(`^\s*(?:[-*+]\s*(?:\[\s*\]\s*)?|\d+[.)]\s+)(.+)$`)
+
+// SuggestSplit asks the provider to propose sub-task titles for an epic.
+// The result is only a suggestion; callers must confirm before creating issues.
+func SuggestSplit(p Provider, issue *api.Issue, maxChars int) ([]string, error) {
+	prompt := BuildIssuePrompt(issue, nil, maxChars)
+
+	reply, err := p.Complete(splitSystemPrompt, prompt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest sub-tasks: %w", err)
+	}
+
+	return ParseSuggestedTasks(reply), nil
+}
+
+// ParseSuggestedTasks extracts task titles from a model reply, accepting
+// checklist, bullet, and numbered list items. Checked items are skipped.
+func ParseSuggestedTasks(reply string) []string {
+	var tasks []string
+	seen := make(map[string]bool)
+
+	for _, line := range strings.Split(reply, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "- [x]") || strings.HasPrefix(trimmed, "- [X]") {
+			continue
+		}
+
+		m := taskLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+
+		task := stripEmphasis(strings.TrimSpace(m[1]))
+		key := strings.ToLower(task)
+		if task == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		tasks = append(tasks, task)
+	}
+
+	return tasks
+}
+
+// stripEmphasis removes markdown emphasis wrapping the whole title
+func stripEmphasis(s string) string {
+	for _, marker := range []string{"**", "__", "`", "*", "_"} {
+		if len(s) > 2*len(marker) && strings.HasPrefix(s, marker) && strings.HasSuffix(s, marker) {
+			return strings.TrimSpace(s[len(marker) : len(s)-len(marker)])
+		}
+	}
+	return s
+}
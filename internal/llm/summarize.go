@@ -0,0 +1,101 @@
+package llm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// DefaultMaxInputChars bounds the issue text sent to the model
+const DefaultMaxInputChars = 24000
+
+const summarySystemPrompt = `You summarize GitHub issues for project triage.
+Reply with a short plain-text summary (at most 5 bullet points) covering:
+the problem or request, current state of discussion, decisions made,
+open questions, and any blockers. Do not invent details.`
+
+// SummarizeIssue condenses an issue and its comment thread into a
+// triage-ready summary. maxChars limits the prompt size; zero uses
+// DefaultMaxInputChars.
+func SummarizeIssue(p Provider, issue *api.Issue, comments []api.Comment, maxChars int) (string, error) {
+	prompt := BuildIssuePrompt(issue, comments, maxChars)
+
+	summary, err := p.Complete(summarySystemPrompt, prompt)
+	if err != nil {
+		return "", fmt.Errorf("failed to summarize issue: %w", err)
+	}
+	if summary == "" {
+		return "", fmt.Errorf("failed to summarize issue: model returned an empty response")
+	}
+	return summary, nil
+}
+
+// BuildIssuePrompt renders an issue and its comments as prompt text.
+// When the text exceeds maxChars the oldest comments are dropped first,
+// then the body is truncated.
+func BuildIssuePrompt(issue *api.Issue, comments []api.Comment, maxChars int) string {
+	if maxChars <= 0 {
+		maxChars = DefaultMaxInputChars
+	}
+
+	var header strings.Builder
+	fmt.Fprintf(&header, "Issue #%d: %s\n", issue.Number, issue.Title)
+	fmt.Fprintf(&header, "State: %s\n", issue.State)
+	if issue.Author.Login != "" {
+		fmt.Fprintf(&header, "Author: @%s\n", issue.Author.Login)
+	}
+	if len(issue.Labels) > 0 {
+		labels := make([]string, 0, len(issue.Labels))
+		for _, l := range issue.Labels {
+			labels = append(labels, l.Name)
+		}
+		fmt.Fprintf(&header, "Labels: %s\n", strings.Join(labels, ", "))
+	}
+
+	body := strings.TrimSpace(issue.Body)
+	if body == "" {
+		body = "(no description)"
+	}
+
+	rendered := make([]string, len(comments))
+	for i, c := range comments {
+		rendered[i] = fmt.Sprintf("@%s (%s):\n%s\n", c.Author, c.CreatedAt, strings.TrimSpace(c.Body))
+	}
+
+	// Keep the most recent comments that fit alongside the header and body
+	budget := maxChars - header.Len() - len(body) - 32
+	start := len(rendered)
+	for start > 0 && budget-len(rendered[start-1]) >= 0 {
+		start--
+		budget -= len(rendered[start])
+	}
+
+	// Truncate the body if even the header and body alone do not fit
+	if room := maxChars - header.Len() - 32; len(body) > room {
+		if room < 0 {
+			room = 0
+		}
+		body = body[:room] + "\n[truncated]"
+	}
+
+	var b strings.Builder
+	b.WriteString(header.String())
+	b.WriteString("\nDescription:\n")
+	b.WriteString(body)
+	b.WriteString("\n")
+
+	if len(comments) > 0 {
+		fmt.Fprintf(&b, "\nComments (%d", len(comments))
+		if start > 0 {
+			fmt.Fprintf(&b, ", oldest %d omitted", start)
+		}
+		b.WriteString("):\n")
+		for _, c := range rendered[start:] {
+			b.WriteString("\n")
+			b.WriteString(c)
+		}
+	}
+
+	return b.String()
+}
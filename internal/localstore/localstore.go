@@ -0,0 +1,85 @@
+// Package localstore keeps gh-pmu's local, per-user state as JSON files in
+// the user config and cache directories. Nothing in it is sent to GitHub.
+package localstore
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Key builds the "owner/repo#number" key stores use for an issue
+func Key(repository string, number int) string {
+	return fmt.Sprintf("%s#%d", strings.ToLower(repository), number)
+}
+
+// ProjectFile names the file holding a project's state: "owner-number.json"
+func ProjectFile(owner string, number int) string {
+	return fmt.Sprintf("%s-%d.json", owner, number)
+}
+
+// ConfigDir returns the directory for kind under gh-pmu in the user config
+// directory
+func ConfigDir(kind string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate config directory: %w", err)
+	}
+	return filepath.Join(dir, "gh-pmu", kind), nil
+}
+
+// ConfigPath returns the file name for kind in the user config directory
+func ConfigPath(kind, name string) (string, error) {
+	dir, err := ConfigDir(kind)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, name), nil
+}
+
+// CachePath returns the file name for kind in the user cache directory
+func CachePath(kind, name string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to locate cache directory: %w", err)
+	}
+	return filepath.Join(dir, "gh-pmu", kind, name), nil
+}
+
+// Load decodes the JSON file at path into v, reporting whether it exists.
+// A missing file leaves v as it was. what names the contents in errors.
+func Load(path, what string, v interface{}) (bool, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", what, err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return false, fmt.Errorf("failed to parse %s %s: %w", what, path, err)
+	}
+	return true, nil
+}
+
+// Save writes v to path as indented JSON, creating parent directories as
+// needed. The file is readable only by the current user.
+func Save(path, what string, v interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("failed to create %s directory: %w", what, err)
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", what, err)
+	}
+
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", what, err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package localstore
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	if got := Key("Owner/Repo", 42); got != "owner/repo#42" {
+		t.Errorf("Key() = %q, want owner/repo#42", got)
+	}
+}
+
+func TestConfigPath(t *testing.T) {
+	path, err := ConfigPath("notes", ProjectFile("owner", 7))
+	if err != nil {
+		t.Fatalf("ConfigPath() error = %v", err)
+	}
+	if !strings.HasSuffix(path, filepath.Join("gh-pmu", "notes", "owner-7.json")) {
+		t.Errorf("ConfigPath() = %q", path)
+	}
+}
+
+func TestLoadSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "state.json")
+
+	var state struct {
+		Issue string `json:"issue"`
+	}
+	found, err := Load(path, "state", &state)
+	if err != nil || found {
+		t.Fatalf("Load() of a missing file = %v, %v", found, err)
+	}
+
+	state.Issue = "owner/repo#1"
+	if err := Save(path, "state", &state); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("Expected a 0600 file, got %v, %v", info, err)
+	}
+
+	state.Issue = ""
+	found, err = Load(path, "state", &state)
+	if err != nil || !found || state.Issue != "owner/repo#1" {
+		t.Errorf("Load() = %v, %v, %+v", found, err, state)
+	}
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+	if err := os.WriteFile(path, []byte("not json"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var state struct{}
+	if _, err := Load(path, "focus", &state); err == nil || !strings.Contains(err.Error(), "failed to parse focus") {
+		t.Errorf("Expected a parse error naming the contents, got %v", err)
+	}
+}
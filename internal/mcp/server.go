@@ -0,0 +1,228 @@
+// Package mcp implements a minimal Model Context Protocol server that
+// exposes tools over newline-delimited JSON-RPC 2.0 on stdio.
+package mcp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// ProtocolVersion is the MCP protocol revision implemented by the server
+const ProtocolVersion = "2024-11-05"
+
+// JSON-RPC 2.0 error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+)
+
+// Handler runs a tool with its JSON arguments and returns text content.
+// A returned error is reported to the client as a tool error result,
+// not as a protocol error.
+type Handler func(args json.RawMessage) (string, error)
+
+// Tool describes a tool exposed by the server
+type Tool struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	InputSchema map[string]interface{} `json:"inputSchema"`
+	Handler     Handler                `json:"-"`
+}
+
+// Server dispatches MCP requests to registered tools
+type Server struct {
+	name    string
+	version string
+	tools   []Tool
+	byName  map[string]Tool
+	mu      sync.Mutex
+}
+
+// NewServer creates a server that reports the given name and version
+func NewServer(name, version string) *Server {
+	return &Server{
+		name:    name,
+		version: version,
+		byName:  make(map[string]Tool),
+	}
+}
+
+// AddTool registers a tool. Registering the same name twice replaces it.
+func (s *Server) AddTool(tool Tool) {
+	if _, exists := s.byName[tool.Name]; !exists {
+		s.tools = append(s.tools, tool)
+	} else {
+		for i := range s.tools {
+			if s.tools[i].Name == tool.Name {
+				s.tools[i] = tool
+			}
+		}
+	}
+	s.byName[tool.Name] = tool
+}
+
+// Tools returns the registered tools in registration order
+func (s *Server) Tools() []Tool {
+	return s.tools
+}
+
+type request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// textContent is a single text block in a tool result
+type textContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type toolResult struct {
+	Content []textContent `json:"content"`
+	IsError bool          `json:"isError,omitempty"`
+}
+
+// Serve reads requests from r and writes responses to w until r is exhausted
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		resp := s.HandleMessage(line)
+		if resp == nil {
+			continue
+		}
+
+		if err := s.write(w, resp); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// HandleMessage processes one JSON-RPC message and returns the encoded
+// response, or nil for notifications
+func (s *Server) HandleMessage(msg []byte) []byte {
+	var req request
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return encode(response{JSONRPC: "2.0", ID: json.RawMessage("null"), Error: &rpcError{Code: codeParseError, Message: "parse error"}})
+	}
+
+	// Notifications carry no ID and get no response
+	if len(req.ID) == 0 {
+		return nil
+	}
+
+	resp := response{JSONRPC: "2.0", ID: req.ID}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		resp.Error = &rpcError{Code: codeInvalidRequest, Message: "invalid request"}
+		return encode(resp)
+	}
+
+	switch req.Method {
+	case "initialize":
+		resp.Result = map[string]interface{}{
+			"protocolVersion": ProtocolVersion,
+			"capabilities": map[string]interface{}{
+				"tools": map[string]interface{}{},
+			},
+			"serverInfo": map[string]string{
+				"name":    s.name,
+				"version": s.version,
+			},
+		}
+	case "ping":
+		resp.Result = map[string]interface{}{}
+	case "tools/list":
+		tools := s.tools
+		if tools == nil {
+			tools = []Tool{}
+		}
+		resp.Result = map[string]interface{}{"tools": tools}
+	case "tools/call":
+		result, rpcErr := s.callTool(req.Params)
+		if rpcErr != nil {
+			resp.Error = rpcErr
+		} else {
+			resp.Result = result
+		}
+	default:
+		resp.Error = &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not found: %s", req.Method)}
+	}
+
+	return encode(resp)
+}
+
+func (s *Server) callTool(params json.RawMessage) (*toolResult, *rpcError) {
+	var call struct {
+		Name      string          `json:"name"`
+		Arguments json.RawMessage `json:"arguments"`
+	}
+	if err := json.Unmarshal(params, &call); err != nil || call.Name == "" {
+		return nil, &rpcError{Code: codeInvalidParams, Message: "invalid tool call parameters"}
+	}
+
+	tool, ok := s.byName[call.Name]
+	if !ok {
+		return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown tool: %s", call.Name)}
+	}
+
+	args := call.Arguments
+	if len(args) == 0 {
+		args = json.RawMessage("{}")
+	}
+
+	text, err := tool.Handler(args)
+	if err != nil {
+		return &toolResult{Content: []textContent{{Type: "text", Text: err.Error()}}, IsError: true}, nil
+	}
+
+	return &toolResult{Content: []textContent{{Type: "text", Text: text}}}, nil
+}
+
+func (s *Server) write(w io.Writer, msg []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := w.Write(append(msg, '\n')); err != nil {
+		return fmt.Errorf("failed to write response: %w", err)
+	}
+	return nil
+}
+
+func encode(resp response) []byte {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		data, _ = json.Marshal(response{
+			JSONRPC: "2.0",
+			ID:      resp.ID,
+			Error:   &rpcError{Code: codeInvalidRequest, Message: err.Error()},
+		})
+	}
+	return data
+}
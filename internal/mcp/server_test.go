@@ -0,0 +1,167 @@
+package mcp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func newTestServer() *Server {
+	s := NewServer("test", "1.0.0")
+	s.AddTool(Tool{
+		Name:        "echo",
+		Description: "Echo the message argument",
+		InputSchema: map[string]interface{}{"type": "object"},
+		Handler: func(args json.RawMessage) (string, error) {
+			var in struct {
+				Message string `json:"message"`
+			}
+			if err := json.Unmarshal(args, &in); err != nil {
+				return "", err
+			}
+			if in.Message == "" {
+				return "", errors.New("message is required")
+			}
+			return in.Message, nil
+		},
+	})
+	return s
+}
+
+func decodeResponse(t *testing.T, data []byte) map[string]interface{} {
+	t.Helper()
+	var resp map[string]interface{}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatalf("invalid response JSON %q: %v", data, err)
+	}
+	return resp
+}
+
+func TestHandleMessage_Initialize(t *testing.T) {
+	s := newTestServer()
+
+	resp := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`)))
+
+	result := resp["result"].(map[string]interface{})
+	if result["protocolVersion"] != ProtocolVersion {
+		t.Errorf("Expected protocol version %s, got %v", ProtocolVersion, result["protocolVersion"])
+	}
+	info := result["serverInfo"].(map[string]interface{})
+	if info["name"] != "test" || info["version"] != "1.0.0" {
+		t.Errorf("Unexpected server info: %v", info)
+	}
+}
+
+func TestHandleMessage_NotificationHasNoResponse(t *testing.T) {
+	s := newTestServer()
+
+	if resp := s.HandleMessage([]byte(`{"jsonrpc":"2.0","method":"notifications/initialized"}`)); resp != nil {
+		t.Errorf("Expected no response for notification, got %s", resp)
+	}
+}
+
+func TestHandleMessage_ToolsList(t *testing.T) {
+	s := newTestServer()
+
+	resp := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":"a","method":"tools/list"}`)))
+
+	tools := resp["result"].(map[string]interface{})["tools"].([]interface{})
+	if len(tools) != 1 {
+		t.Fatalf("Expected 1 tool, got %d", len(tools))
+	}
+	tool := tools[0].(map[string]interface{})
+	if tool["name"] != "echo" || tool["inputSchema"] == nil {
+		t.Errorf("Unexpected tool: %v", tool)
+	}
+}
+
+func TestHandleMessage_ToolsCall(t *testing.T) {
+	s := newTestServer()
+
+	resp := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"echo","arguments":{"message":"hi"}}}`)))
+
+	result := resp["result"].(map[string]interface{})
+	content := result["content"].([]interface{})[0].(map[string]interface{})
+	if content["type"] != "text" || content["text"] != "hi" {
+		t.Errorf("Unexpected content: %v", content)
+	}
+	if result["isError"] != nil {
+		t.Errorf("Expected no isError, got %v", result["isError"])
+	}
+}
+
+func TestHandleMessage_ToolErrorIsResult(t *testing.T) {
+	s := newTestServer()
+
+	resp := decodeResponse(t, s.HandleMessage([]byte(`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"echo"}}`)))
+
+	result := resp["result"].(map[string]interface{})
+	if result["isError"] != true {
+		t.Errorf("Expected isError result, got %v", result)
+	}
+	content := result["content"].([]interface{})[0].(map[string]interface{})
+	if content["text"] != "message is required" {
+		t.Errorf("Unexpected error text: %v", content["text"])
+	}
+}
+
+func TestHandleMessage_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      string
+		wantCode float64
+	}{
+		{"parse error", `{not json`, codeParseError},
+		{"missing version", `{"id":1,"method":"ping"}`, codeInvalidRequest},
+		{"unknown method", `{"jsonrpc":"2.0","id":1,"method":"resources/list"}`, codeMethodNotFound},
+		{"unknown tool", `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"nope"}}`, codeInvalidParams},
+	}
+
+	s := newTestServer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := decodeResponse(t, s.HandleMessage([]byte(tt.msg)))
+			rpcErr, ok := resp["error"].(map[string]interface{})
+			if !ok {
+				t.Fatalf("Expected error response, got %v", resp)
+			}
+			if rpcErr["code"] != tt.wantCode {
+				t.Errorf("Expected code %v, got %v", tt.wantCode, rpcErr["code"])
+			}
+		})
+	}
+}
+
+func TestServe_ProcessesEachLine(t *testing.T) {
+	s := newTestServer()
+	in := strings.NewReader(strings.Join([]string{
+		`{"jsonrpc":"2.0","id":1,"method":"initialize"}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		``,
+		`{"jsonrpc":"2.0","id":2,"method":"ping"}`,
+	}, "\n"))
+	out := new(bytes.Buffer)
+
+	if err := s.Serve(in, out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 responses, got %d: %s", len(lines), out.String())
+	}
+	if resp := decodeResponse(t, []byte(lines[1])); resp["id"] != float64(2) {
+		t.Errorf("Expected second response for id 2, got %v", resp["id"])
+	}
+}
+
+func TestAddTool_ReplacesExisting(t *testing.T) {
+	s := newTestServer()
+	s.AddTool(Tool{Name: "echo", Description: "replaced", Handler: func(json.RawMessage) (string, error) { return "", nil }})
+
+	if len(s.Tools()) != 1 || s.Tools()[0].Description != "replaced" {
+		t.Errorf("Expected tool to be replaced, got %+v", s.Tools())
+	}
+}
@@ -0,0 +1,150 @@
+// Package mirror keeps the state of a field mirror between two projects:
+// the values last synchronized, which tell which side changed since, and a
+// log of what each sync did.
+package mirror
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// MaxLogEntries is how many log entries are kept; older ones are dropped
+const MaxLogEntries = 500
+
+// Policy decides a conflict: a field changed on both boards since the last sync
+type Policy string
+
+const (
+	PolicySkip   Policy = "skip"   // leave both values and report the conflict
+	PolicyOurs   Policy = "ours"   // our value wins
+	PolicyTheirs Policy = "theirs" // the partner's value wins
+	PolicyNewest Policy = "newest" // the most recently set value wins, when known
+)
+
+// ParsePolicy validates a conflict policy name
+func ParsePolicy(name string) (Policy, error) {
+	switch p := Policy(name); p {
+	case PolicySkip, PolicyOurs, PolicyTheirs, PolicyNewest:
+		return p, nil
+	}
+	return "", fmt.Errorf("invalid conflict policy %q: must be skip, ours, theirs, or newest", name)
+}
+
+// Action is what a sync does with one mapped field of one item
+type Action int
+
+const (
+	None     Action = iota // the boards agree
+	Push                   // copy our value to the partner
+	Pull                   // copy the partner's value to us
+	Conflict               // both changed and the policy does not decide
+)
+
+// Values are one mapped field's values on both boards. The times are when
+// each value was set (RFC 3339), where the API reports it.
+type Values struct {
+	Ours, Theirs     string
+	OursAt, TheirsAt string
+}
+
+// Decide returns the action for a field given the value last synchronized.
+// Without a base (first sync), a value on one side only is copied over.
+func Decide(base string, hasBase bool, v Values, policy Policy) Action {
+	if v.Ours == v.Theirs {
+		return None
+	}
+	switch {
+	case hasBase && base == v.Theirs, !hasBase && v.Theirs == "":
+		return Push
+	case hasBase && base == v.Ours, !hasBase && v.Ours == "":
+		return Pull
+	}
+
+	switch policy {
+	case PolicyOurs:
+		return Push
+	case PolicyTheirs:
+		return Pull
+	case PolicyNewest:
+		ours, errOurs := time.Parse(time.RFC3339, v.OursAt)
+		theirs, errTheirs := time.Parse(time.RFC3339, v.TheirsAt)
+		if errOurs == nil && errTheirs == nil && !ours.Equal(theirs) {
+			if ours.After(theirs) {
+				return Push
+			}
+			return Pull
+		}
+	}
+	return Conflict
+}
+
+// LogEntry is one field a sync changed or found in conflict
+type LogEntry struct {
+	At     string `json:"at"`    // RFC 3339
+	Issue  string `json:"issue"` // owner/repo#number
+	Field  string `json:"field"` // ours:Field=theirs:Field
+	Action string `json:"action"`
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+	Note   string `json:"note,omitempty"`
+}
+
+// State is the mirror between our project and one partner project
+type State struct {
+	Project string `json:"project"` // owner/number
+	Partner string `json:"partner"` // owner/number
+	// Base is the value last synchronized, by issue and then by mapping
+	Base map[string]map[string]string `json:"base"`
+	Log  []LogEntry                   `json:"log,omitempty"`
+}
+
+// DefaultPath returns the state file for a mirror in the user config directory
+func DefaultPath(owner string, number int, partnerOwner string, partnerNumber int) (string, error) {
+	name := fmt.Sprintf("%s-%d--%s-%d.json", owner, number, partnerOwner, partnerNumber)
+	return localstore.ConfigPath("mirror", name)
+}
+
+// Load reads the mirror state at path. A missing file yields an empty state.
+func Load(path string) (*State, error) {
+	s := &State{}
+	if _, err := localstore.Load(path, "mirror state", s); err != nil {
+		return nil, err
+	}
+	if s.Base == nil {
+		s.Base = make(map[string]map[string]string)
+	}
+	return s, nil
+}
+
+// BaseValue returns the value last synchronized for an issue's mapping
+func (s *State) BaseValue(issue, mapping string) (string, bool) {
+	value, ok := s.Base[issue][mapping]
+	return value, ok
+}
+
+// SetBase records the value both boards now agree on
+func (s *State) SetBase(issue, mapping, value string) {
+	if s.Base[issue] == nil {
+		s.Base[issue] = make(map[string]string)
+	}
+	s.Base[issue][mapping] = value
+}
+
+// Record appends log entries stamped with now, keeping the last MaxLogEntries
+func (s *State) Record(entries []LogEntry, now time.Time) {
+	at := now.UTC().Format(time.RFC3339)
+	for _, e := range entries {
+		e.At = at
+		s.Log = append(s.Log, e)
+	}
+	if len(s.Log) > MaxLogEntries {
+		s.Log = s.Log[len(s.Log)-MaxLogEntries:]
+	}
+}
+
+// Save writes the mirror state to path, creating parent directories as needed
+func (s *State) Save(path string) error {
+	return localstore.Save(path, "mirror state", s)
+}
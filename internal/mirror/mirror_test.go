@@ -0,0 +1,92 @@
+package mirror
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDecide(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    string
+		hasBase bool
+		values  Values
+		policy  Policy
+		want    Action
+	}{
+		{"agree", "Todo", true, Values{Ours: "Done", Theirs: "Done"}, PolicySkip, None},
+		{"we changed", "Todo", true, Values{Ours: "Done", Theirs: "Todo"}, PolicySkip, Push},
+		{"they changed", "Todo", true, Values{Ours: "Todo", Theirs: "Done"}, PolicySkip, Pull},
+		{"we cleared", "Todo", true, Values{Ours: "", Theirs: "Todo"}, PolicySkip, Push},
+		{"first sync, ours only", "", false, Values{Ours: "Done"}, PolicySkip, Push},
+		{"first sync, theirs only", "", false, Values{Theirs: "Done"}, PolicySkip, Pull},
+		{"first sync, both", "", false, Values{Ours: "Todo", Theirs: "Done"}, PolicySkip, Conflict},
+		{"both changed, skip", "Todo", true, Values{Ours: "Doing", Theirs: "Done"}, PolicySkip, Conflict},
+		{"both changed, ours", "Todo", true, Values{Ours: "Doing", Theirs: "Done"}, PolicyOurs, Push},
+		{"both changed, theirs", "Todo", true, Values{Ours: "Doing", Theirs: "Done"}, PolicyTheirs, Pull},
+		{"both changed, newest ours", "Todo", true,
+			Values{Ours: "Doing", Theirs: "Done", OursAt: "2026-03-02T10:00:00Z", TheirsAt: "2026-03-02T09:00:00Z"}, PolicyNewest, Push},
+		{"both changed, newest theirs", "Todo", true,
+			Values{Ours: "Doing", Theirs: "Done", OursAt: "2026-03-02T08:00:00Z", TheirsAt: "2026-03-02T09:00:00Z"}, PolicyNewest, Pull},
+		{"both changed, newest unknown", "Todo", true, Values{Ours: "Doing", Theirs: "Done"}, PolicyNewest, Conflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Decide(tt.base, tt.hasBase, tt.values, tt.policy); got != tt.want {
+				t.Errorf("Decide() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	if p, err := ParsePolicy("theirs"); err != nil || p != PolicyTheirs {
+		t.Errorf("ParsePolicy(theirs) = %v, %v", p, err)
+	}
+	if _, err := ParsePolicy("mine"); err == nil {
+		t.Error("Expected an error for an unknown policy")
+	}
+}
+
+func TestState_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mirror", "owner-1--partner-7.json")
+
+	s, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if _, ok := s.BaseValue("owner/repo#1", "Status=Stage"); ok {
+		t.Fatal("Expected no base in an empty state")
+	}
+
+	s.Project, s.Partner = "owner/1", "partner/7"
+	s.SetBase("owner/repo#1", "Status=Stage", "Done")
+	s.Record([]LogEntry{{Issue: "owner/repo#1", Field: "Status=Stage", Action: "push", New: "Done"}}, time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+	if err := s.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if value, ok := loaded.BaseValue("owner/repo#1", "Status=Stage"); !ok || value != "Done" {
+		t.Errorf("BaseValue = %q, %v", value, ok)
+	}
+	if len(loaded.Log) != 1 || loaded.Log[0].At != "2026-03-02T09:00:00Z" {
+		t.Errorf("Unexpected log %+v", loaded.Log)
+	}
+}
+
+func TestState_KeepsLastLogEntries(t *testing.T) {
+	s := &State{}
+	for i := 0; i < MaxLogEntries+5; i++ {
+		s.Record([]LogEntry{{Issue: fmt.Sprintf("owner/repo#%d", i)}}, time.Now())
+	}
+	if len(s.Log) != MaxLogEntries || s.Log[0].Issue != "owner/repo#5" {
+		t.Errorf("Expected the last %d entries, got %d starting with %q", MaxLogEntries, len(s.Log), s.Log[0].Issue)
+	}
+}
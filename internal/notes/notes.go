@@ -0,0 +1,104 @@
+// Package notes keeps private working notes on project issues in a local
+// file. Notes are never sent to GitHub.
+package notes
+
+import (
+	"sort"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// Note is a single annotation on an issue
+type Note struct {
+	ID        int    `json:"id"`
+	Text      string `json:"text"`
+	CreatedAt string `json:"createdAt"` // RFC 3339
+}
+
+// Store holds the notes for one project, keyed by "owner/repo#number"
+type Store struct {
+	Project string            `json:"project"` // owner/number
+	NextID  int               `json:"nextId"`
+	Notes   map[string][]Note `json:"notes"`
+}
+
+// DefaultPath returns the notes file for a project in the user config directory
+func DefaultPath(owner string, number int) (string, error) {
+	return localstore.ConfigPath("notes", localstore.ProjectFile(owner, number))
+}
+
+// Load reads the notes file at path. A missing file yields an empty store.
+func Load(path string) (*Store, error) {
+	store := &Store{Notes: map[string][]Note{}}
+	if _, err := localstore.Load(path, "notes", store); err != nil {
+		return nil, err
+	}
+	if store.Notes == nil {
+		store.Notes = map[string][]Note{}
+	}
+	return store, nil
+}
+
+// Save writes the store to path, creating parent directories as needed.
+// The file is readable only by the current user.
+func (s *Store) Save(path string) error {
+	return localstore.Save(path, "notes", s)
+}
+
+// Add appends a note to an issue and returns it
+func (s *Store) Add(key, text string, now time.Time) Note {
+	if s.Notes == nil {
+		s.Notes = map[string][]Note{}
+	}
+	s.NextID++
+	note := Note{ID: s.NextID, Text: text, CreatedAt: now.UTC().Format(time.RFC3339)}
+	s.Notes[key] = append(s.Notes[key], note)
+	return note
+}
+
+// List returns an issue's notes, oldest first. A nil store has no notes.
+func (s *Store) List(key string) []Note {
+	if s == nil {
+		return nil
+	}
+	return s.Notes[key]
+}
+
+// Keys returns the keys of all issues with notes, sorted
+func (s *Store) Keys() []string {
+	if s == nil {
+		return nil
+	}
+	keys := make([]string, 0, len(s.Notes))
+	for key, notes := range s.Notes {
+		if len(notes) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Remove deletes the note with the given ID from an issue, reporting
+// whether it was found
+func (s *Store) Remove(key string, id int) bool {
+	notes := s.Notes[key]
+	for i, n := range notes {
+		if n.ID == id {
+			s.Notes[key] = append(notes[:i:i], notes[i+1:]...)
+			if len(s.Notes[key]) == 0 {
+				delete(s.Notes, key)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// Clear deletes all notes on an issue and returns how many were removed
+func (s *Store) Clear(key string) int {
+	n := len(s.Notes[key])
+	delete(s.Notes, key)
+	return n
+}
@@ -0,0 +1,100 @@
+package notes
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStore_AddListRemove(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)
+	store := &Store{}
+
+	first := store.Add("o/r#1", "waiting on legal", now)
+	second := store.Add("o/r#1", "ping Dana on Friday", now)
+	store.Add("o/r#2", "duplicate of #1?", now)
+
+	if first.ID != 1 || second.ID != 2 || first.CreatedAt != "2025-03-01T09:30:00Z" {
+		t.Errorf("Unexpected notes: %+v, %+v", first, second)
+	}
+	if got := store.List("o/r#1"); len(got) != 2 || got[1].Text != "ping Dana on Friday" {
+		t.Errorf("List() = %+v", got)
+	}
+	if keys := store.Keys(); len(keys) != 2 || keys[0] != "o/r#1" {
+		t.Errorf("Keys() = %v", keys)
+	}
+
+	if !store.Remove("o/r#1", 1) {
+		t.Fatal("Expected note 1 to be removed")
+	}
+	if store.Remove("o/r#1", 1) {
+		t.Error("Expected removing a missing note to report false")
+	}
+	if got := store.List("o/r#1"); len(got) != 1 || got[0].ID != 2 {
+		t.Errorf("List() after Remove = %+v", got)
+	}
+
+	if n := store.Clear("o/r#2"); n != 1 {
+		t.Errorf("Clear() = %d, want 1", n)
+	}
+	if keys := store.Keys(); len(keys) != 1 {
+		t.Errorf("Keys() after Clear = %v", keys)
+	}
+
+	// IDs are not reused after removal
+	if n := store.Add("o/r#2", "again", now); n.ID != 4 {
+		t.Errorf("Expected next ID 4, got %d", n.ID)
+	}
+}
+
+func TestStore_NilList(t *testing.T) {
+	var store *Store
+	if store.List("o/r#1") != nil || store.Keys() != nil {
+		t.Error("Expected a nil store to have no notes")
+	}
+}
+
+func TestStore_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "notes.json")
+
+	store, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() of missing file error = %v", err)
+	}
+	if len(store.Keys()) != 0 {
+		t.Fatalf("Expected an empty store, got %v", store.Keys())
+	}
+
+	store.Project = "owner/1"
+	store.Add("o/r#7", "private note", time.Now())
+	if err := store.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("Expected notes file mode 0600, got %v", info.Mode().Perm())
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Project != "owner/1" || len(loaded.List("o/r#7")) != 1 || loaded.NextID != 1 {
+		t.Errorf("Unexpected loaded store: %+v", loaded)
+	}
+}
+
+func TestLoad_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.json")
+	if err := os.WriteFile(path, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Expected error for invalid notes file")
+	}
+}
@@ -0,0 +1,78 @@
+package notify
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Mail is a plain-text email
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer sends email through an SMTP server. The connection is upgraded
+// with STARTTLS when the server offers it; credentials are only sent over
+// TLS or to localhost.
+type Mailer struct {
+	Addr     string // host:port
+	From     string
+	Username string // Defaults to From
+	Password string // No authentication when empty
+}
+
+// smtpSendMail sends a message; replaced in tests
+var smtpSendMail = smtp.SendMail
+
+// Send delivers mail, dated now
+func (m *Mailer) Send(mail Mail, now time.Time) error {
+	var auth smtp.Auth
+	if m.Password != "" {
+		host, _, err := net.SplitHostPort(m.Addr)
+		if err != nil {
+			return fmt.Errorf("invalid SMTP address %q: %w", m.Addr, err)
+		}
+		username := m.Username
+		if username == "" {
+			username = m.From
+		}
+		auth = smtp.PlainAuth("", username, m.Password, host)
+	}
+
+	if err := smtpSendMail(m.Addr, auth, m.From, []string{mail.To}, Message(m.From, mail, now)); err != nil {
+		return fmt.Errorf("failed to send email to %s: %w", mail.To, err)
+	}
+	return nil
+}
+
+// Message formats mail as an RFC 5322 message with CRLF line endings
+func Message(from string, mail Mail, now time.Time) []byte {
+	var b bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&b, "%s: %s\r\n", name, value)
+	}
+	header("From", from)
+	header("To", mail.To)
+	header("Subject", mime.QEncoding.Encode("utf-8", mail.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", `text/plain; charset="utf-8"`)
+	header("Content-Transfer-Encoding", "8bit")
+	b.WriteString("\r\n")
+
+	body := strings.ReplaceAll(mail.Body, "\r\n", "\n")
+	for _, line := range strings.Split(body, "\n") {
+		// A lone dot would end the SMTP DATA section
+		if strings.HasPrefix(line, ".") {
+			line = "." + line
+		}
+		b.WriteString(line + "\r\n")
+	}
+	return b.Bytes()
+}
@@ -0,0 +1,64 @@
+package notify
+
+import (
+	"errors"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMessage(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	msg := string(Message("pm@example.com", Mail{
+		To:      "alice@example.com",
+		Subject: "Weekly plan – 3 overdue",
+		Body:    "Hi\n.hidden\nBye",
+	}, now))
+
+	for _, want := range []string{
+		"From: pm@example.com\r\n",
+		"To: alice@example.com\r\n",
+		"Subject: =?utf-8?q?Weekly_plan_=E2=80=93_3_overdue?=\r\n",
+		"Date: Fri, 16 Oct 2026 09:00:00 +0000\r\n",
+		"\r\n\r\nHi\r\n..hidden\r\nBye\r\n",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Message missing %q:\n%s", want, msg)
+		}
+	}
+}
+
+func TestMailer_Send(t *testing.T) {
+	orig := smtpSendMail
+	defer func() { smtpSendMail = orig }()
+
+	var gotAddr, gotFrom string
+	var gotAuth smtp.Auth
+	var gotTo []string
+	smtpSendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotAuth, gotFrom, gotTo = addr, a, from, to
+		return nil
+	}
+
+	m := &Mailer{Addr: "smtp.example.com:587", From: "pm@example.com"}
+	if err := m.Send(Mail{To: "alice@example.com", Subject: "s", Body: "b"}, time.Now()); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if gotAddr != "smtp.example.com:587" || gotFrom != "pm@example.com" || len(gotTo) != 1 || gotTo[0] != "alice@example.com" {
+		t.Errorf("Unexpected envelope: %s %s %v", gotAddr, gotFrom, gotTo)
+	}
+	if gotAuth != nil {
+		t.Error("Expected no authentication without a password")
+	}
+
+	m.Password = "secret"
+	if err := m.Send(Mail{To: "alice@example.com"}, time.Now()); err != nil || gotAuth == nil {
+		t.Errorf("Expected authentication with a password, got %v, %v", gotAuth, err)
+	}
+
+	smtpSendMail = func(string, smtp.Auth, string, []string, []byte) error { return errors.New("refused") }
+	if err := m.Send(Mail{To: "alice@example.com"}, time.Now()); err == nil || !strings.Contains(err.Error(), "alice@example.com: refused") {
+		t.Errorf("Expected a wrapped send error, got %v", err)
+	}
+}
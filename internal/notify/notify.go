@@ -0,0 +1,60 @@
+// Package notify delivers notifications: native desktop notifications
+// using the tools each platform ships with (notify-send on Linux and the
+// BSDs, osascript on macOS, and PowerShell on Windows), and email sent
+// through an SMTP server.
+package notify
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Command builds the command that shows a notification on goos
+func Command(goos, title, body string) (*exec.Cmd, error) {
+	switch goos {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(body), appleScriptString(title))
+		return exec.Command("osascript", "-e", script), nil
+	case "windows":
+		script := fmt.Sprintf(`Add-Type -AssemblyName System.Windows.Forms
+$n = New-Object System.Windows.Forms.NotifyIcon
+$n.Icon = [System.Drawing.SystemIcons]::Information
+$n.Visible = $true
+$n.ShowBalloonTip(10000, %s, %s, 'Info')
+Start-Sleep -Seconds 10
+$n.Dispose()`, powerShellString(title), powerShellString(body))
+		return exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", script), nil
+	default:
+		path, err := exec.LookPath("notify-send")
+		if err != nil {
+			return nil, fmt.Errorf("desktop notifications need notify-send (libnotify)")
+		}
+		return exec.Command(path, "--app-name=gh-pmu", title, body), nil
+	}
+}
+
+// Send shows a notification without waiting for it to be dismissed
+func Send(title, body string) error {
+	cmd, err := Command(runtime.GOOS, title, body)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to show notification: %w", err)
+	}
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
+
+// appleScriptString quotes s as an AppleScript string literal
+func appleScriptString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// powerShellString quotes s as a single-quoted PowerShell string literal
+func powerShellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
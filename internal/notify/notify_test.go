@@ -0,0 +1,31 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCommand_Darwin(t *testing.T) {
+	cmd, err := Command("darwin", `owner/repo#42`, `Status: "Todo" → Done`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cmd.Args[0] != "osascript" || cmd.Args[1] != "-e" {
+		t.Fatalf("Unexpected args: %v", cmd.Args)
+	}
+	want := `display notification "Status: \"Todo\" → Done" with title "owner/repo#42"`
+	if cmd.Args[2] != want {
+		t.Errorf("Script = %q, want %q", cmd.Args[2], want)
+	}
+}
+
+func TestCommand_Windows(t *testing.T) {
+	cmd, err := Command("windows", "gh-pmu", "it's merged")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	script := cmd.Args[len(cmd.Args)-1]
+	if !strings.Contains(script, `ShowBalloonTip(10000, 'gh-pmu', 'it''s merged', 'Info')`) {
+		t.Errorf("Unexpected script: %s", script)
+	}
+}
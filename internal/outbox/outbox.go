@@ -0,0 +1,64 @@
+// Package outbox queues project field changes locally so they can be made
+// offline and pushed to GitHub later.
+package outbox
+
+import (
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// Change is a queued project field change
+type Change struct {
+	Issue    string  `json:"issue"`          // owner/repo#number
+	Field    string  `json:"field"`          // project field name
+	Value    string  `json:"value"`          // empty clears the field
+	Base     *string `json:"base,omitempty"` // value on GitHub when queued; nil if unknown
+	QueuedAt string  `json:"queuedAt"`       // RFC 3339
+}
+
+// Outbox is the queue of changes for one project
+type Outbox struct {
+	Project string   `json:"project"` // owner/number
+	Changes []Change `json:"changes"`
+}
+
+// DefaultPath returns the outbox file for a project in the user config directory
+func DefaultPath(owner string, number int) (string, error) {
+	return localstore.ConfigPath("outbox", localstore.ProjectFile(owner, number))
+}
+
+// Load reads the outbox at path. A missing file yields an empty outbox.
+func Load(path string) (*Outbox, error) {
+	o := &Outbox{}
+	if _, err := localstore.Load(path, "outbox", o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// Add queues a change from base, the field's value on GitHub (nil if
+// unknown). A queued change to the same issue and field is replaced, so
+// only the latest value is pushed; it keeps the earlier change's base,
+// which is still the value on GitHub, and the time it was queued, so
+// conflicts are checked against the state the first change was made from.
+func (o *Outbox) Add(issue, field, value string, base *string, now time.Time) {
+	change := Change{Issue: issue, Field: field, Value: value, Base: base, QueuedAt: now.UTC().Format(time.RFC3339)}
+	for i, c := range o.Changes {
+		if strings.EqualFold(c.Issue, issue) && strings.EqualFold(c.Field, field) {
+			if c.Base != nil || base == nil {
+				change.Base = c.Base
+				change.QueuedAt = c.QueuedAt
+			}
+			o.Changes = append(o.Changes[:i], o.Changes[i+1:]...)
+			break
+		}
+	}
+	o.Changes = append(o.Changes, change)
+}
+
+// Save writes the outbox to path, creating parent directories as needed
+func (o *Outbox) Save(path string) error {
+	return localstore.Save(path, "outbox", o)
+}
@@ -0,0 +1,59 @@
+package outbox
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOutbox_AddReplacesSameField(t *testing.T) {
+	o := &Outbox{}
+	t1 := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	t2 := t1.Add(time.Minute)
+
+	todo, inProgress := "Todo", "In Progress"
+
+	o.Add("owner/repo#1", "Status", "In Progress", &todo, t1)
+	o.Add("owner/repo#2", "Status", "Todo", nil, t1)
+	o.Add("owner/repo#1", "Priority", "P1", nil, t1)
+	o.Add("owner/repo#1", "status", "Done", &inProgress, t2)
+
+	o.Add("owner/repo#2", "Status", "Done", nil, t2)
+	o.Add("owner/repo#1", "Priority", "P0", &todo, t2)
+
+	// A replaced change keeps the base and time it was first queued from,
+	// unless only the new change knows the value on GitHub
+	want := []Change{
+		{Issue: "owner/repo#1", Field: "status", Value: "Done", Base: &todo, QueuedAt: "2026-03-02T09:00:00Z"},
+		{Issue: "owner/repo#2", Field: "Status", Value: "Done", QueuedAt: "2026-03-02T09:00:00Z"},
+		{Issue: "owner/repo#1", Field: "Priority", Value: "P0", Base: &todo, QueuedAt: "2026-03-02T09:01:00Z"},
+	}
+	if !reflect.DeepEqual(o.Changes, want) {
+		t.Errorf("Changes = %+v, want %+v", o.Changes, want)
+	}
+}
+
+func TestOutbox_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outbox", "owner-1.json")
+
+	o, err := Load(path)
+	if err != nil || len(o.Changes) != 0 {
+		t.Fatalf("Expected an empty outbox for a missing file, got %+v, %v", o, err)
+	}
+
+	o.Project = "owner/1"
+	base := ""
+	o.Add("owner/repo#1", "Status", "Done", &base, time.Now())
+	if err := o.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, o) {
+		t.Errorf("Loaded %+v, want %+v", loaded, o)
+	}
+}
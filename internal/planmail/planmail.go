@@ -0,0 +1,74 @@
+// Package planmail records what each assignee was last mailed about, so
+// the next weekly plan mail can list only newly assigned issues.
+package planmail
+
+import (
+	"sort"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// Snapshot is the issues assigned to each person when plan mail was last
+// sent for one project
+type Snapshot struct {
+	Project     string              `json:"project"`     // owner/number
+	Assignments map[string][]string `json:"assignments"` // login to owner/repo#number
+	SentAt      map[string]string   `json:"sentAt"`      // login to RFC 3339
+}
+
+// DefaultPath returns the snapshot file for a project in the user config directory
+func DefaultPath(owner string, number int) (string, error) {
+	return localstore.ConfigPath("plan-mail", localstore.ProjectFile(owner, number))
+}
+
+// Load reads the snapshot at path. A missing file yields an empty snapshot.
+func Load(path string) (*Snapshot, error) {
+	s := &Snapshot{}
+	if _, err := localstore.Load(path, "plan mail snapshot", s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Known reports whether login has been mailed before; until then there is
+// nothing to compare against
+func (s *Snapshot) Known(login string) bool {
+	_, ok := s.Assignments[login]
+	return ok
+}
+
+// New returns the issues in current that were not assigned to login when
+// it was last mailed, in the order of current
+func (s *Snapshot) New(login string, current []string) []string {
+	before := make(map[string]bool, len(s.Assignments[login]))
+	for _, issue := range s.Assignments[login] {
+		before[issue] = true
+	}
+	var added []string
+	for _, issue := range current {
+		if !before[issue] {
+			added = append(added, issue)
+		}
+	}
+	return added
+}
+
+// Record stores the issues assigned to login when it was mailed
+func (s *Snapshot) Record(login string, issues []string, now time.Time) {
+	if s.Assignments == nil {
+		s.Assignments = map[string][]string{}
+	}
+	if s.SentAt == nil {
+		s.SentAt = map[string]string{}
+	}
+	sorted := append([]string{}, issues...)
+	sort.Strings(sorted)
+	s.Assignments[login] = sorted
+	s.SentAt[login] = now.UTC().Format(time.RFC3339)
+}
+
+// Save writes the snapshot to path, creating parent directories as needed
+func (s *Snapshot) Save(path string) error {
+	return localstore.Save(path, "plan mail snapshot", s)
+}
@@ -0,0 +1,52 @@
+package planmail
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSnapshot_New(t *testing.T) {
+	s := &Snapshot{}
+	if s.Known("alice") {
+		t.Error("Expected alice unknown in an empty snapshot")
+	}
+
+	s.Record("alice", []string{"o/r#2", "o/r#1"}, time.Now())
+	if !s.Known("alice") || s.Known("bob") {
+		t.Errorf("Unexpected Known: %+v", s.Assignments)
+	}
+	if got := s.New("alice", []string{"o/r#3", "o/r#1", "o/r#4"}); !reflect.DeepEqual(got, []string{"o/r#3", "o/r#4"}) {
+		t.Errorf("New() = %v", got)
+	}
+
+	// Recording an empty list still makes the assignee known
+	s.Record("bob", nil, time.Now())
+	if !s.Known("bob") {
+		t.Error("Expected bob known after an empty record")
+	}
+}
+
+func TestSnapshot_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plan-mail", "owner-1.json")
+
+	s, err := Load(path)
+	if err != nil || len(s.Assignments) != 0 {
+		t.Fatalf("Expected an empty snapshot for a missing file, got %+v, %v", s, err)
+	}
+
+	s.Project = "owner/1"
+	s.Record("alice", []string{"o/r#1"}, time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC))
+	if err := s.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if !reflect.DeepEqual(loaded, s) {
+		t.Errorf("Loaded %+v, want %+v", loaded, s)
+	}
+}
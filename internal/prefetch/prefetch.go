@@ -0,0 +1,190 @@
+// Package prefetch loads issue details in the background for interactive
+// modes, a few items ahead of the one on screen, so opening an item does
+// not stall on a fresh API call. Background fetches are spaced out and
+// capped so they stay within a rate budget.
+package prefetch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+const (
+	// DefaultInterval is the minimum gap between background fetches
+	DefaultInterval = 250 * time.Millisecond
+
+	// DefaultBudget is how many issues are fetched in the background at most
+	DefaultBudget = 100
+)
+
+// Key identifies an issue
+type Key struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+// Details is an issue with its comments
+type Details struct {
+	Issue    *api.Issue
+	Comments []api.Comment
+}
+
+// FetchFunc fetches one issue's details
+type FetchFunc func(key Key) (*Details, error)
+
+// Options tune a Prefetcher
+type Options struct {
+	Window   int           // items fetched ahead of the current one; 0 disables prefetching
+	Interval time.Duration // minimum gap between background fetches
+	Budget   int           // background fetches allowed in total
+}
+
+// entry is an issue's details, fetched or being fetched
+type entry struct {
+	done    chan struct{}
+	details *Details
+	err     error
+}
+
+// Prefetcher fetches the details of a list of issues ahead of the one
+// being looked at. It is safe for concurrent use.
+type Prefetcher struct {
+	fetch FetchFunc
+	opts  Options
+	keys  []Key
+
+	mu      sync.Mutex
+	entries map[Key]*entry
+	cursor  int
+	budget  int
+	moved   chan struct{}
+	stop    chan struct{}
+	stopped sync.Once
+	wg      sync.WaitGroup
+}
+
+// New returns a Prefetcher for keys, in the order they will be looked at
+func New(fetch FetchFunc, keys []Key, opts Options) *Prefetcher {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultInterval
+	}
+	return &Prefetcher{
+		fetch:   fetch,
+		opts:    opts,
+		keys:    keys,
+		entries: make(map[Key]*entry),
+		budget:  opts.Budget,
+		moved:   make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+	}
+}
+
+// Start begins fetching in the background
+func (p *Prefetcher) Start() {
+	if p.opts.Window <= 0 || p.budget <= 0 {
+		return
+	}
+	p.wg.Add(1)
+	go p.run()
+}
+
+// Stop ends background fetching and waits for a fetch in progress
+func (p *Prefetcher) Stop() {
+	p.stopped.Do(func() { close(p.stop) })
+	p.wg.Wait()
+}
+
+// Get returns an issue's details and makes it the current item. Details
+// already fetched are returned at once; one being fetched is waited for;
+// otherwise it is fetched now.
+func (p *Prefetcher) Get(key Key) (*Details, error) {
+	p.mu.Lock()
+	for i, k := range p.keys {
+		if k == key {
+			p.cursor = i
+			break
+		}
+	}
+	select {
+	case p.moved <- struct{}{}:
+	default:
+	}
+
+	e, ok := p.entries[key]
+	if !ok {
+		e = &entry{done: make(chan struct{})}
+		p.entries[key] = e
+		p.mu.Unlock()
+		e.details, e.err = p.fetch(key)
+		close(e.done)
+		return e.details, e.err
+	}
+	p.mu.Unlock()
+
+	<-e.done
+	return e.details, e.err
+}
+
+func (p *Prefetcher) run() {
+	defer p.wg.Done()
+	for {
+		key, e := p.next()
+		if e == nil {
+			if p.budgetSpent() {
+				return
+			}
+			// Everything in the window is fetched; wait for the cursor to move
+			select {
+			case <-p.stop:
+				return
+			case <-p.moved:
+			}
+			continue
+		}
+
+		e.details, e.err = p.fetch(key)
+		close(e.done)
+		if api.IsRateLimited(e.err) {
+			return
+		}
+
+		select {
+		case <-p.stop:
+			return
+		case <-time.After(p.opts.Interval):
+		}
+	}
+}
+
+// next claims the first item in the window that is not fetched yet
+func (p *Prefetcher) next() (Key, *entry) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.budget <= 0 {
+		return Key{}, nil
+	}
+
+	end := p.cursor + 1 + p.opts.Window
+	if end > len(p.keys) {
+		end = len(p.keys)
+	}
+	for _, key := range p.keys[p.cursor:end] {
+		if _, ok := p.entries[key]; ok {
+			continue
+		}
+		e := &entry{done: make(chan struct{})}
+		p.entries[key] = e
+		p.budget--
+		return key, e
+	}
+	return Key{}, nil
+}
+
+func (p *Prefetcher) budgetSpent() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.budget <= 0
+}
@@ -0,0 +1,123 @@
+package prefetch
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// recorder is a FetchFunc that records the issues it fetched
+type recorder struct {
+	mu      sync.Mutex
+	fetched []int
+	err     error
+}
+
+func (r *recorder) fetch(key Key) (*Details, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fetched = append(r.fetched, key.Number)
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &Details{Issue: &api.Issue{Number: key.Number}}, nil
+}
+
+func (r *recorder) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.fetched)
+}
+
+func testKeys(n int) []Key {
+	keys := make([]Key, n)
+	for i := range keys {
+		keys[i] = Key{Owner: "owner", Repo: "repo", Number: i + 1}
+	}
+	return keys
+}
+
+// waitFor polls until cond holds or a second passes
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for background fetches")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestPrefetcher_FetchesWindowAhead(t *testing.T) {
+	r := &recorder{}
+	p := New(r.fetch, testKeys(10), Options{Window: 2, Interval: time.Millisecond, Budget: 100})
+	p.Start()
+	defer p.Stop()
+
+	// The first item and the two after it
+	waitFor(t, func() bool { return r.count() == 3 })
+	time.Sleep(10 * time.Millisecond)
+	if r.count() != 3 {
+		t.Fatalf("Expected fetching to stop at the window, got %v", r.fetched)
+	}
+
+	// Getting a prefetched item makes no new call and moves the window
+	d, err := p.Get(Key{Owner: "owner", Repo: "repo", Number: 2})
+	if err != nil || d.Issue.Number != 2 {
+		t.Fatalf("Get() = %v, %v", d, err)
+	}
+	waitFor(t, func() bool { return r.count() == 4 })
+	p.Stop()
+	if r.fetched[3] != 4 {
+		t.Errorf("Expected #4 fetched next, got %v", r.fetched)
+	}
+}
+
+func TestPrefetcher_GetFetchesOnce(t *testing.T) {
+	r := &recorder{}
+	p := New(r.fetch, testKeys(3), Options{})
+	p.Start() // no window, so nothing in the background
+	defer p.Stop()
+
+	key := Key{Owner: "owner", Repo: "repo", Number: 3}
+	for i := 0; i < 2; i++ {
+		if d, err := p.Get(key); err != nil || d.Issue.Number != 3 {
+			t.Fatalf("Get() = %v, %v", d, err)
+		}
+	}
+	if r.count() != 1 {
+		t.Errorf("Expected one fetch, got %v", r.fetched)
+	}
+}
+
+func TestPrefetcher_Budget(t *testing.T) {
+	r := &recorder{}
+	p := New(r.fetch, testKeys(10), Options{Window: 5, Interval: time.Millisecond, Budget: 2})
+	p.Start()
+	waitFor(t, func() bool { return r.count() == 2 })
+	p.Stop()
+	if r.count() != 2 {
+		t.Errorf("Expected the budget to cap background fetches, got %v", r.fetched)
+	}
+}
+
+func TestPrefetcher_StopsWhenRateLimited(t *testing.T) {
+	r := &recorder{err: api.ErrRateLimited}
+	p := New(r.fetch, testKeys(10), Options{Window: 5, Interval: time.Millisecond, Budget: 100})
+	p.Start()
+	waitFor(t, func() bool { return r.count() == 1 })
+	time.Sleep(10 * time.Millisecond)
+	p.Stop()
+	if r.count() != 1 {
+		t.Errorf("Expected no fetches after a rate limit error, got %v", r.fetched)
+	}
+
+	// The error is kept for the item that failed
+	if _, err := p.Get(Key{Owner: "owner", Repo: "repo", Number: 1}); !errors.Is(err, api.ErrRateLimited) {
+		t.Errorf("Expected the rate limit error, got %v", err)
+	}
+}
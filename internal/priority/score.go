@@ -0,0 +1,130 @@
+// Package priority scores issues against the configured prioritization
+// signals and maps the score to a suggested priority.
+package priority
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// Signal names used in score contributions
+const (
+	SignalReactions = "reactions"
+	SignalAge       = "age"
+	SignalBlocking  = "blocking"
+	SignalSLA       = "sla"
+	SignalKeyword   = "keyword"
+)
+
+// Signals is the issue data a score is computed from
+type Signals struct {
+	Title     string
+	Body      string
+	Labels    []string
+	Reactions int
+	CreatedAt time.Time
+	Blocking  int
+}
+
+// Contribution is one signal's share of a score, with a human-readable reason
+type Contribution struct {
+	Signal string
+	Points float64
+	Reason string
+}
+
+// Score is an issue's total score and the contributions that explain it
+type Score struct {
+	Total         float64
+	Contributions []Contribution
+}
+
+// Evaluate scores an issue's signals using the prioritization config
+func Evaluate(cfg *config.Prioritization, s Signals, now time.Time) Score {
+	var score Score
+	add := func(signal string, points float64, reason string) {
+		if points == 0 {
+			return
+		}
+		score.Total += points
+		score.Contributions = append(score.Contributions, Contribution{Signal: signal, Points: points, Reason: reason})
+	}
+
+	if cfg.Reactions != 0 && s.Reactions > 0 {
+		add(SignalReactions, cfg.Reactions*float64(s.Reactions), fmt.Sprintf("%d reactions", s.Reactions))
+	}
+
+	ageDays := 0
+	if !s.CreatedAt.IsZero() && now.After(s.CreatedAt) {
+		ageDays = int(now.Sub(s.CreatedAt).Hours() / 24)
+	}
+
+	if cfg.Age != 0 && ageDays >= 7 {
+		weeks := ageDays / 7
+		points := cfg.Age * float64(weeks)
+		reason := fmt.Sprintf("open %d weeks", weeks)
+		if cfg.AgeCap > 0 && points > cfg.AgeCap {
+			points = cfg.AgeCap
+			reason += " (capped)"
+		}
+		add(SignalAge, points, reason)
+	}
+
+	if cfg.Blocking != 0 && s.Blocking > 0 {
+		add(SignalBlocking, cfg.Blocking*float64(s.Blocking), fmt.Sprintf("blocks %d open issues", s.Blocking))
+	}
+
+	if cfg.SLA != nil && cfg.SLA.Days > 0 && ageDays > cfg.SLA.Days {
+		add(SignalSLA, cfg.SLA.Points, fmt.Sprintf("open %d days, SLA is %d days", ageDays, cfg.SLA.Days))
+	}
+
+	// Sort keywords so explanations are stable
+	keywords := make([]string, 0, len(cfg.Keywords))
+	for k := range cfg.Keywords {
+		keywords = append(keywords, k)
+	}
+	sort.Strings(keywords)
+
+	text := strings.ToLower(s.Title + "\n" + s.Body + "\n" + strings.Join(s.Labels, "\n"))
+	for _, k := range keywords {
+		if strings.Contains(text, strings.ToLower(k)) {
+			add(SignalKeyword, cfg.Keywords[k], fmt.Sprintf("mentions %q", k))
+		}
+	}
+
+	return score
+}
+
+// Level returns the priority alias for a score: the level with the highest
+// MinScore not above total, or the lowest level if none qualifies
+func Level(cfg *config.Prioritization, total float64) string {
+	if len(cfg.Levels) == 0 {
+		return ""
+	}
+
+	levels := make([]config.PriorityLevel, len(cfg.Levels))
+	copy(levels, cfg.Levels)
+	sort.SliceStable(levels, func(i, j int) bool {
+		return levels[i].MinScore > levels[j].MinScore
+	})
+
+	for _, l := range levels {
+		if total >= l.MinScore {
+			return l.Priority
+		}
+	}
+	return levels[len(levels)-1].Priority
+}
+
+// FormatPoints renders points with a sign, dropping a zero fraction
+func FormatPoints(points float64) string {
+	if points == math.Trunc(points) {
+		return fmt.Sprintf("%+d", int(points))
+	}
+	return fmt.Sprintf("%+.1f", points)
+}
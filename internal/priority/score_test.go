@@ -0,0 +1,114 @@
+package priority
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+var testNow = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func testPrioritization() *config.Prioritization {
+	return &config.Prioritization{
+		Reactions: 2,
+		Age:       1,
+		AgeCap:    10,
+		Blocking:  5,
+		SLA:       &config.PrioritySLA{Days: 30, Points: 20},
+		Keywords:  map[string]float64{"crash": 15, "typo": -5},
+		Levels: []config.PriorityLevel{
+			{Priority: "p2", MinScore: 0},
+			{Priority: "p0", MinScore: 50},
+			{Priority: "p1", MinScore: 20},
+		},
+	}
+}
+
+func TestEvaluate_AllSignals(t *testing.T) {
+	s := Signals{
+		Title:     "App crash on login",
+		Labels:    []string{"bug"},
+		Reactions: 3,
+		CreatedAt: testNow.AddDate(0, 0, -100), // 14 weeks
+		Blocking:  2,
+	}
+
+	score := Evaluate(testPrioritization(), s, testNow)
+
+	// 6 reactions + 10 age (capped) + 10 blocking + 20 SLA + 15 keyword
+	if score.Total != 61 {
+		t.Errorf("Expected total 61, got %v (%+v)", score.Total, score.Contributions)
+	}
+
+	want := []string{SignalReactions, SignalAge, SignalBlocking, SignalSLA, SignalKeyword}
+	if len(score.Contributions) != len(want) {
+		t.Fatalf("Expected %d contributions, got %+v", len(want), score.Contributions)
+	}
+	for i, signal := range want {
+		if score.Contributions[i].Signal != signal {
+			t.Errorf("Contribution %d: expected %s, got %s", i, signal, score.Contributions[i].Signal)
+		}
+	}
+	if !strings.Contains(score.Contributions[1].Reason, "capped") {
+		t.Errorf("Expected age reason to mention cap, got %q", score.Contributions[1].Reason)
+	}
+	if score.Contributions[3].Reason != "open 100 days, SLA is 30 days" {
+		t.Errorf("Unexpected SLA reason: %q", score.Contributions[3].Reason)
+	}
+}
+
+func TestEvaluate_NewQuietIssue(t *testing.T) {
+	s := Signals{Title: "Fix typo in docs", CreatedAt: testNow.AddDate(0, 0, -2)}
+
+	score := Evaluate(testPrioritization(), s, testNow)
+
+	if score.Total != -5 || len(score.Contributions) != 1 || score.Contributions[0].Reason != `mentions "typo"` {
+		t.Errorf("Unexpected score: %+v", score)
+	}
+}
+
+func TestEvaluate_KeywordMatchesLabel(t *testing.T) {
+	cfg := &config.Prioritization{Keywords: map[string]float64{"Security": 30}}
+
+	score := Evaluate(cfg, Signals{Labels: []string{"security"}}, testNow)
+
+	if score.Total != 30 {
+		t.Errorf("Expected label keyword match, got %+v", score)
+	}
+}
+
+func TestLevel(t *testing.T) {
+	cfg := testPrioritization()
+
+	tests := []struct {
+		total float64
+		want  string
+	}{
+		{61, "p0"},
+		{50, "p0"},
+		{49.5, "p1"},
+		{20, "p1"},
+		{0, "p2"},
+		{-5, "p2"},
+	}
+
+	for _, tt := range tests {
+		if got := Level(cfg, tt.total); got != tt.want {
+			t.Errorf("Level(%v) = %q, want %q", tt.total, got, tt.want)
+		}
+	}
+
+	if got := Level(&config.Prioritization{}, 10); got != "" {
+		t.Errorf("Expected empty level without levels, got %q", got)
+	}
+}
+
+func TestFormatPoints(t *testing.T) {
+	for points, want := range map[float64]string{10: "+10", -5: "-5", 2.5: "+2.5"} {
+		if got := FormatPoints(points); got != want {
+			t.Errorf("FormatPoints(%v) = %q, want %q", points, got, want)
+		}
+	}
+}
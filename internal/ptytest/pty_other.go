@@ -0,0 +1,10 @@
+//go:build !linux
+
+package ptytest
+
+import "os"
+
+// openPTY is only implemented on Linux
+func openPTY() (master, tty *os.File, err error) {
+	return nil, nil, ErrUnsupported
+}
@@ -0,0 +1,103 @@
+// Package ptytest runs interactive flows on a pseudo-terminal in tests, so
+// they see a real terminal: input is typed with echo and line editing,
+// and output goes through the terminal's line discipline.
+package ptytest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnsupported is returned by Open where pseudo-terminals are not
+// available, in which case tests should skip
+var ErrUnsupported = errors.New("pseudo-terminals are not supported on this platform")
+
+// PTY is a pseudo-terminal. The command under test reads and writes TTY;
+// the test types on, and reads the screen from, the other side.
+type PTY struct {
+	TTY *os.File // The terminal, for the command's stdin and stdout
+
+	master *os.File
+	mu     sync.Mutex
+	screen strings.Builder
+	done   chan struct{}
+}
+
+// Open opens a pseudo-terminal and starts recording what it displays
+func Open() (*PTY, error) {
+	master, tty, err := openPTY()
+	if err != nil {
+		return nil, err
+	}
+
+	p := &PTY{TTY: tty, master: master, done: make(chan struct{})}
+	go p.record()
+	return p, nil
+}
+
+// record copies what the terminal displays until it is closed
+func (p *PTY) record() {
+	defer close(p.done)
+	buf := make([]byte, 4096)
+	for {
+		n, err := p.master.Read(buf)
+		if n > 0 {
+			p.mu.Lock()
+			p.screen.Write(buf[:n])
+			p.mu.Unlock()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Type types each line followed by Enter
+func (p *PTY) Type(lines ...string) error {
+	for _, line := range lines {
+		if _, err := p.master.WriteString(line + "\n"); err != nil {
+			return fmt.Errorf("failed to type %q: %w", line, err)
+		}
+	}
+	return nil
+}
+
+// Screen returns everything displayed so far, with the terminal's \r\n
+// line endings as \n
+func (p *PTY) Screen() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return strings.ReplaceAll(p.screen.String(), "\r\n", "\n")
+}
+
+// Expect waits until text has been displayed
+func (p *PTY) Expect(text string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		if strings.Contains(p.Screen(), text) {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %s waiting for %q; screen:\n%s", timeout, text, p.Screen())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// Close closes the terminal and waits for what it displayed to be recorded
+func (p *PTY) Close() error {
+	err := p.TTY.Close()
+	select {
+	case <-p.done:
+	case <-time.After(time.Second):
+	}
+	if cerr := p.master.Close(); err == nil {
+		err = cerr
+	}
+	<-p.done
+	return err
+}
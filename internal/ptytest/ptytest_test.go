@@ -0,0 +1,57 @@
+package ptytest
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"golang.org/x/term"
+)
+
+func TestPTY_TypeAndExpect(t *testing.T) {
+	p, err := Open()
+	if errors.Is(err, ErrUnsupported) {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer p.Close()
+
+	if !term.IsTerminal(int(p.TTY.Fd())) {
+		t.Fatal("Expected the TTY to be a terminal")
+	}
+
+	// A prompt answered on the terminal, as a command would see it
+	done := make(chan string)
+	go func() {
+		fmt.Fprint(p.TTY, "Name: ")
+		line, _ := bufio.NewReader(p.TTY).ReadString('\n')
+		fmt.Fprintf(p.TTY, "Hello, %s", line)
+		done <- line
+	}()
+
+	if err := p.Expect("Name: ", 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Type("Ada"); err != nil {
+		t.Fatal(err)
+	}
+	if line := <-done; line != "Ada\n" {
+		t.Errorf("Read %q, want %q", line, "Ada\n")
+	}
+	if err := p.Expect("Hello, Ada\n", 5*time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	// Typed input is echoed after the prompt
+	if screen := p.Screen(); screen != "Name: Ada\nHello, Ada\n" {
+		t.Errorf("Screen() = %q", screen)
+	}
+
+	if err := p.Expect("never shown", 50*time.Millisecond); err == nil {
+		t.Error("Expected a timeout")
+	}
+}
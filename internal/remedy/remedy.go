@@ -0,0 +1,124 @@
+// Package remedy turns common API failures into actionable suggestions,
+// each with the follow-up command to run.
+package remedy
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// Context fills in the suggested commands. Zero values are shown as
+// placeholders.
+type Context struct {
+	ProjectOwner  string
+	ProjectNumber int
+}
+
+// Suggestion explains a failure and how to recover from it
+type Suggestion struct {
+	Problem string // What went wrong, in plain terms
+	Fix     string // What to do about it
+	Command string // The command to run next
+}
+
+// String renders the suggestion as a hint for the end of an error message
+func (s *Suggestion) String() string {
+	return fmt.Sprintf("Hint: %s. %s\n  %s\n", s.Problem, s.Fix, s.Command)
+}
+
+var (
+	optionPattern    = regexp.MustCompile(`option "([^"]*)" not found for field "([^"]*)"`)
+	iterationPattern = regexp.MustCompile(`iteration "([^"]*)" not found for field "([^"]*)"`)
+	fieldPattern     = regexp.MustCompile(`field "([^"]*)" not found in project`)
+)
+
+// Suggest returns a suggestion for err, or nil if it is not a failure
+// with a known remedy
+func Suggest(err error, ctx Context) *Suggestion {
+	if err == nil {
+		return nil
+	}
+	msg := err.Error()
+	lower := strings.ToLower(msg)
+
+	if strings.Contains(msg, "INSUFFICIENT_SCOPES") || strings.Contains(lower, "required scopes") ||
+		strings.Contains(lower, "has not been granted") {
+		return &Suggestion{
+			Problem: "Your GitHub token lacks the project scope gh pmu needs",
+			Fix:     "Grant it with:",
+			Command: "gh auth refresh -s project",
+		}
+	}
+
+	if m := optionPattern.FindStringSubmatch(msg); m != nil {
+		return &Suggestion{
+			Problem: fmt.Sprintf("%q is not an option of the %s field", m[1], m[2]),
+			Fix:     "Use one of its options, or map an alias to one under fields in .gh-pmu.yml. List them with:",
+			Command: fieldListCommand(ctx),
+		}
+	}
+	if m := iterationPattern.FindStringSubmatch(msg); m != nil {
+		return &Suggestion{
+			Problem: fmt.Sprintf("The %s field has no iteration %q", m[2], m[1]),
+			Fix:     "Use an iteration title, or current, next, or previous. List them with:",
+			Command: fieldListCommand(ctx),
+		}
+	}
+	if m := fieldPattern.FindStringSubmatch(msg); m != nil {
+		return &Suggestion{
+			Problem: fmt.Sprintf("The project has no %q field", m[1]),
+			Fix:     "If it was renamed, refresh the field names in .gh-pmu.yml with:",
+			Command: "gh pmu init",
+		}
+	}
+
+	// The broader checks come last, so that an issue number such as #401
+	// is not taken for an HTTP status
+	switch {
+	case strings.Contains(lower, "is archived") || strings.Contains(lower, "an archived item"):
+		return &Suggestion{
+			Problem: "The project item is archived",
+			Fix:     "Restore it from the project's archived items, then retry. Find it with:",
+			Command: "gh pmu list --archived",
+		}
+
+	case strings.Contains(lower, "not in the project"):
+		return &Suggestion{
+			Problem: "The issue has not been added to the project",
+			Fix:     "Add it, with any other untracked issues, using:",
+			Command: "gh pmu intake --apply",
+		}
+
+	case api.IsRateLimited(err):
+		return &Suggestion{
+			Problem: "GitHub's API rate limit was reached",
+			Fix:     "Wait for it to reset, then retry. See when with:",
+			Command: "gh api rate_limit --jq .resources.graphql",
+		}
+
+	case api.IsAuthError(err):
+		return &Suggestion{
+			Problem: "gh is not authenticated with GitHub",
+			Fix:     "Check, and log in if needed, with:",
+			Command: "gh auth status",
+		}
+	}
+
+	return nil
+}
+
+// fieldListCommand returns the gh command that lists the project's fields
+// and their options
+func fieldListCommand(ctx Context) string {
+	number, owner := "<number>", "<owner>"
+	if ctx.ProjectNumber > 0 {
+		number = fmt.Sprint(ctx.ProjectNumber)
+	}
+	if ctx.ProjectOwner != "" {
+		owner = ctx.ProjectOwner
+	}
+	return fmt.Sprintf("gh project field-list %s --owner %s", number, owner)
+}
@@ -0,0 +1,100 @@
+package remedy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+func TestSuggest(t *testing.T) {
+	ctx := Context{ProjectOwner: "octo-org", ProjectNumber: 3}
+
+	tests := []struct {
+		name        string
+		err         error
+		wantCommand string
+		wantProblem string
+	}{
+		{
+			name:        "missing option",
+			err:         fmt.Errorf("failed to set status for #4: %w", errors.New(`option "Doing" not found for field "Status"`)),
+			wantCommand: "gh project field-list 3 --owner octo-org",
+			wantProblem: `"Doing" is not an option of the Status field`,
+		},
+		{
+			name:        "missing iteration",
+			err:         errors.New(`iteration "Sprint 9" not found for field "Sprint"`),
+			wantCommand: "gh project field-list 3 --owner octo-org",
+			wantProblem: `The Sprint field has no iteration "Sprint 9"`,
+		},
+		{
+			name:        "missing field",
+			err:         errors.New(`field "Estimate" not found in project`),
+			wantCommand: "gh pmu init",
+			wantProblem: `The project has no "Estimate" field`,
+		},
+		{
+			name:        "insufficient scopes",
+			err:         errors.New("GraphQL: Your token has not been granted the required scopes to execute this query (INSUFFICIENT_SCOPES)"),
+			wantCommand: "gh auth refresh -s project",
+		},
+		{
+			name:        "rate limited",
+			err:         fmt.Errorf("failed to get project items: %w", api.ErrRateLimited),
+			wantCommand: "gh api rate_limit --jq .resources.graphql",
+		},
+		{
+			name:        "not authenticated",
+			err:         api.ErrNotAuthenticated,
+			wantCommand: "gh auth status",
+		},
+		{
+			name:        "not in project",
+			err:         errors.New("issue #42 is not in the project"),
+			wantCommand: "gh pmu intake --apply",
+		},
+		{
+			name:        "not in project with an issue number like a status",
+			err:         errors.New("issue #401 is not in the project"),
+			wantCommand: "gh pmu intake --apply",
+		},
+		{
+			name:        "archived item",
+			err:         errors.New("failed to set status: the project item is archived"),
+			wantCommand: "gh pmu list --archived",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Suggest(tt.err, ctx)
+			if s == nil {
+				t.Fatal("Expected a suggestion")
+			}
+			if s.Command != tt.wantCommand {
+				t.Errorf("Command = %q, want %q", s.Command, tt.wantCommand)
+			}
+			if tt.wantProblem != "" && s.Problem != tt.wantProblem {
+				t.Errorf("Problem = %q, want %q", s.Problem, tt.wantProblem)
+			}
+		})
+	}
+}
+
+func TestSuggest_NoRemedy(t *testing.T) {
+	for _, err := range []error{nil, errors.New("invalid issue number: abc"), errors.New("failed to get archived items: timeout")} {
+		if s := Suggest(err, Context{}); s != nil {
+			t.Errorf("Suggest(%v) = %+v, want nil", err, s)
+		}
+	}
+}
+
+func TestSuggestion_String(t *testing.T) {
+	s := Suggest(errors.New(`option "x" not found for field "Status"`), Context{})
+	want := "Hint: \"x\" is not an option of the Status field. Use one of its options, or map an alias to one under fields in .gh-pmu.yml. List them with:\n  gh project field-list <number> --owner <owner>\n"
+	if s.String() != want {
+		t.Errorf("String() = %q, want %q", s.String(), want)
+	}
+}
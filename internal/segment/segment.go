@@ -0,0 +1,95 @@
+// Package segment caches the counts shown by 'gh pmu prompt-segment', so
+// shell prompts can render them without waiting on the network.
+package segment
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// Counts are the current user's items in the project that need attention
+type Counts struct {
+	InProgress int    `json:"inProgress"`
+	Blocked    int    `json:"blocked"`
+	Urgent     int    `json:"urgent"`
+	UrgentName string `json:"urgentName"` // Priority shown for urgent items, e.g. P0
+	FetchedAt  string `json:"fetchedAt"`  // RFC 3339
+
+	// RefreshingSince is when a background refresh was started, so that
+	// prompts drawn while it runs do not start another
+	RefreshingSince string `json:"refreshingSince,omitempty"`
+}
+
+// refreshTimeout is how long a started refresh holds off another one
+const refreshTimeout = time.Minute
+
+// DefaultPath returns the segment cache for a project in the user cache directory
+func DefaultPath(owner string, number int) (string, error) {
+	return localstore.CachePath("prompt", localstore.ProjectFile(owner, number))
+}
+
+// Load reads the cache at path. A missing file yields nil counts.
+func Load(path string) (*Counts, error) {
+	var counts Counts
+	found, err := localstore.Load(path, "prompt cache", &counts)
+	if err != nil || !found {
+		return nil, err
+	}
+	return &counts, nil
+}
+
+// Save writes the counts to path, creating parent directories as needed
+func (c *Counts) Save(path string) error {
+	return localstore.Save(path, "prompt cache", c)
+}
+
+// Stale reports whether the counts are missing or older than ttl
+func (c *Counts) Stale(ttl time.Duration, now time.Time) bool {
+	if c == nil {
+		return true
+	}
+	fetched, err := time.Parse(time.RFC3339, c.FetchedAt)
+	if err != nil {
+		return true
+	}
+	return now.Sub(fetched) > ttl
+}
+
+// NeedsRefresh reports whether the counts are stale and no refresh was
+// started within the last minute
+func (c *Counts) NeedsRefresh(ttl time.Duration, now time.Time) bool {
+	if !c.Stale(ttl, now) {
+		return false
+	}
+	if c == nil || c.RefreshingSince == "" {
+		return true
+	}
+	started, err := time.Parse(time.RFC3339, c.RefreshingSince)
+	return err != nil || now.Sub(started) > refreshTimeout
+}
+
+// Render formats the counts compactly, e.g. "▣3 ⚑1 P0:2". Zero counts are
+// left out, so a user with nothing pending gets an empty segment.
+func (c *Counts) Render() string {
+	if c == nil {
+		return ""
+	}
+	var parts []string
+	if c.InProgress > 0 {
+		parts = append(parts, fmt.Sprintf("▣%d", c.InProgress))
+	}
+	if c.Blocked > 0 {
+		parts = append(parts, fmt.Sprintf("⚑%d", c.Blocked))
+	}
+	if c.Urgent > 0 {
+		name := c.UrgentName
+		if name == "" {
+			name = "P0"
+		}
+		parts = append(parts, fmt.Sprintf("%s:%d", name, c.Urgent))
+	}
+	return strings.Join(parts, " ")
+}
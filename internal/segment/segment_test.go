@@ -0,0 +1,82 @@
+package segment
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCounts_Render(t *testing.T) {
+	tests := []struct {
+		counts *Counts
+		want   string
+	}{
+		{&Counts{InProgress: 3, Blocked: 1, Urgent: 2, UrgentName: "P0"}, "▣3 ⚑1 P0:2"},
+		{&Counts{InProgress: 1}, "▣1"},
+		{&Counts{Urgent: 1}, "P0:1"},
+		{&Counts{}, ""},
+		{nil, ""},
+	}
+	for _, tt := range tests {
+		if got := tt.counts.Render(); got != tt.want {
+			t.Errorf("Render(%+v) = %q, want %q", tt.counts, got, tt.want)
+		}
+	}
+}
+
+func TestCounts_Stale(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	fresh := &Counts{FetchedAt: now.Add(-time.Minute).Format(time.RFC3339)}
+	old := &Counts{FetchedAt: now.Add(-10 * time.Minute).Format(time.RFC3339)}
+
+	if fresh.Stale(5*time.Minute, now) {
+		t.Error("Expected counts fetched a minute ago to be fresh")
+	}
+	if !old.Stale(5*time.Minute, now) {
+		t.Error("Expected counts fetched ten minutes ago to be stale")
+	}
+	var missing *Counts
+	if !missing.Stale(5*time.Minute, now) || !(&Counts{}).Stale(5*time.Minute, now) {
+		t.Error("Expected missing counts to be stale")
+	}
+}
+
+func TestCounts_NeedsRefresh(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-10 * time.Minute).Format(time.RFC3339)
+
+	if !(&Counts{FetchedAt: old}).NeedsRefresh(5*time.Minute, now) {
+		t.Error("Expected stale counts to need a refresh")
+	}
+	if (&Counts{FetchedAt: old, RefreshingSince: now.Add(-10 * time.Second).Format(time.RFC3339)}).NeedsRefresh(5*time.Minute, now) {
+		t.Error("Expected a running refresh to hold off another")
+	}
+	if !(&Counts{FetchedAt: old, RefreshingSince: now.Add(-2 * time.Minute).Format(time.RFC3339)}).NeedsRefresh(5*time.Minute, now) {
+		t.Error("Expected an abandoned refresh to be retried")
+	}
+	var missing *Counts
+	if !missing.NeedsRefresh(5*time.Minute, now) {
+		t.Error("Expected missing counts to need a refresh")
+	}
+}
+
+func TestCounts_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "prompt.json")
+
+	counts, err := Load(path)
+	if err != nil || counts != nil {
+		t.Fatalf("Load() of missing file = %+v, %v", counts, err)
+	}
+
+	want := &Counts{InProgress: 2, Urgent: 1, UrgentName: "P0", FetchedAt: "2025-03-01T12:00:00Z"}
+	if err := want.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if *got != *want {
+		t.Errorf("Load() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,164 @@
+// Package similar maintains a local embedding index of project issues,
+// used to find semantically related items for duplicate detection.
+package similar
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// maxTextChars bounds the issue text embedded per entry
+const maxTextChars = 8000
+
+// Entry is an indexed issue and its embedding vector
+type Entry struct {
+	Repository string    `json:"repository"` // owner/repo
+	Number     int       `json:"number"`
+	Title      string    `json:"title"`
+	State      string    `json:"state"`
+	URL        string    `json:"url"`
+	Hash       string    `json:"hash"` // Hash of the embedded text
+	Vector     []float32 `json:"vector"`
+}
+
+// Key identifies an entry as "owner/repo#number"
+func (e Entry) Key() string {
+	return Key(e.Repository, e.Number)
+}
+
+// Key builds the "owner/repo#number" key for an issue
+func Key(repository string, number int) string {
+	return fmt.Sprintf("%s#%d", repository, number)
+}
+
+// Index is the on-disk embedding index for one project
+type Index struct {
+	Model     string  `json:"model"`
+	Project   string  `json:"project"` // owner/number
+	UpdatedAt string  `json:"updatedAt"`
+	Entries   []Entry `json:"entries"`
+
+	// Cursors holds, per repository (owner/repo), the time up to which
+	// issue changes have been synced (RFC 3339)
+	Cursors map[string]string `json:"cursors,omitempty"`
+}
+
+// Match is a search result with its cosine similarity score
+type Match struct {
+	Entry
+	Score float64
+}
+
+// DefaultPath returns the index location for a project in the user cache directory
+func DefaultPath(owner string, number int) (string, error) {
+	return localstore.CachePath("embeddings", localstore.ProjectFile(owner, number))
+}
+
+// Load reads an index from path. A missing file returns an error
+// satisfying errors.Is(err, os.ErrNotExist).
+func Load(path string) (*Index, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var idx Index
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("failed to parse index %s: %w", path, err)
+	}
+	return &idx, nil
+}
+
+// Save writes the index to path, creating parent directories as needed
+func (idx *Index) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create index directory: %w", err)
+	}
+
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return fmt.Errorf("failed to encode index: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write index: %w", err)
+	}
+	return nil
+}
+
+// Lookup returns the entry for key, if present
+func (idx *Index) Lookup(key string) (Entry, bool) {
+	for _, e := range idx.Entries {
+		if e.Key() == key {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Search returns up to limit entries most similar to vector, best first.
+// Entries for which skip returns true are ignored.
+func (idx *Index) Search(vector []float32, limit int, skip func(Entry) bool) []Match {
+	var matches []Match
+	for _, e := range idx.Entries {
+		if skip != nil && skip(e) {
+			continue
+		}
+		matches = append(matches, Match{Entry: e, Score: Cosine(vector, e.Vector)})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+// Cosine returns the cosine similarity of two vectors, or 0 if they
+// differ in length or either is zero
+func Cosine(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
+
+// Text renders the issue text that is embedded for an issue
+func Text(title, body string) string {
+	text := strings.TrimSpace(title)
+	if body = strings.TrimSpace(body); body != "" {
+		text += "\n\n" + body
+	}
+	if len(text) > maxTextChars {
+		text = text[:maxTextChars]
+	}
+	return text
+}
+
+// Hash returns a short content hash used to detect changed issue text
+func Hash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,102 @@
+package similar
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCosine(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []float32
+		want float64
+	}{
+		{"identical", []float32{1, 2}, []float32{1, 2}, 1},
+		{"orthogonal", []float32{1, 0}, []float32{0, 1}, 0},
+		{"opposite", []float32{1, 0}, []float32{-1, 0}, -1},
+		{"length mismatch", []float32{1}, []float32{1, 0}, 0},
+		{"zero vector", []float32{0, 0}, []float32{1, 0}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Cosine(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Cosine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndex_Search(t *testing.T) {
+	idx := &Index{Entries: []Entry{
+		{Repository: "o/r", Number: 1, Vector: []float32{1, 0}},
+		{Repository: "o/r", Number: 2, Vector: []float32{0.9, 0.1}},
+		{Repository: "o/r", Number: 3, Vector: []float32{0, 1}},
+		{Repository: "o/r", Number: 4, Vector: []float32{1, 0.01}},
+	}}
+
+	matches := idx.Search([]float32{1, 0}, 2, func(e Entry) bool { return e.Number == 1 })
+
+	if len(matches) != 2 {
+		t.Fatalf("Expected 2 matches, got %d", len(matches))
+	}
+	if matches[0].Number != 4 || matches[1].Number != 2 {
+		t.Errorf("Unexpected order: #%d, #%d", matches[0].Number, matches[1].Number)
+	}
+}
+
+func TestIndex_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "index.json")
+	idx := &Index{Model: "m", Project: "owner/1", Entries: []Entry{
+		{Repository: "owner/repo", Number: 7, Title: "T", Hash: "h", Vector: []float32{0.25, 0.5}},
+	}}
+
+	if err := idx.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Model != "m" || len(loaded.Entries) != 1 || loaded.Entries[0].Vector[1] != 0.5 {
+		t.Errorf("Unexpected loaded index: %+v", loaded)
+	}
+
+	e, ok := loaded.Lookup("owner/repo#7")
+	if !ok || e.Title != "T" {
+		t.Errorf("Lookup() = %+v, %v", e, ok)
+	}
+	if _, ok := loaded.Lookup("owner/repo#8"); ok {
+		t.Error("Expected missing entry")
+	}
+}
+
+func TestLoad_Missing(t *testing.T) {
+	_, err := Load(filepath.Join(t.TempDir(), "none.json"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected os.ErrNotExist, got %v", err)
+	}
+}
+
+func TestText(t *testing.T) {
+	if got := Text(" Title ", " body "); got != "Title\n\nbody" {
+		t.Errorf("Text() = %q", got)
+	}
+	if got := Text("Title", ""); got != "Title" {
+		t.Errorf("Text() without body = %q", got)
+	}
+	if got := Text("T", strings.Repeat("x", 2*maxTextChars)); len(got) != maxTextChars {
+		t.Errorf("Expected text truncated to %d, got %d", maxTextChars, len(got))
+	}
+}
+
+func TestHash(t *testing.T) {
+	if Hash("a") == Hash("b") || Hash("a") != Hash("a") || len(Hash("a")) != 16 {
+		t.Errorf("Unexpected hash behavior: %s %s", Hash("a"), Hash("b"))
+	}
+}
@@ -0,0 +1,70 @@
+package similar
+
+import (
+	"strings"
+	"unicode"
+)
+
+// titleStopWords are words ignored when comparing titles
+var titleStopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "and": true, "or": true, "of": true,
+	"to": true, "in": true, "on": true, "for": true, "with": true, "is": true,
+	"when": true, "from": true, "by": true, "at": true, "be": true,
+}
+
+// TitleWords returns the normalized words of a title: lowercased, split on
+// anything but letters and digits, without stop words, and with plural
+// endings trimmed
+func TitleWords(title string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var words []string
+	for _, w := range fields {
+		if titleStopWords[w] {
+			continue
+		}
+		words = append(words, singular(w))
+	}
+	return words
+}
+
+// TitleSimilarity scores how alike two titles are, from 0 to 1, as the
+// Dice coefficient of their normalized word sets
+func TitleSimilarity(a, b string) float64 {
+	setA := map[string]bool{}
+	for _, w := range TitleWords(a) {
+		setA[w] = true
+	}
+	setB := map[string]bool{}
+	for _, w := range TitleWords(b) {
+		setB[w] = true
+	}
+	if len(setA) == 0 || len(setB) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for w := range setA {
+		if setB[w] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(setA)+len(setB))
+}
+
+// singular trims a plural ending from a word: "crashes" and "pages"
+// become "crash" and "page", while "process" and "ios" are kept
+func singular(w string) string {
+	if len(w) <= 3 || !strings.HasSuffix(w, "s") || strings.HasSuffix(w, "ss") {
+		return w
+	}
+	stem := strings.TrimSuffix(w, "es")
+	for _, end := range []string{"sh", "ch", "ss", "x"} {
+		if strings.HasSuffix(w, "es") && strings.HasSuffix(stem, end) {
+			return stem
+		}
+	}
+	return strings.TrimSuffix(w, "s")
+}
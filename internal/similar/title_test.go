@@ -0,0 +1,41 @@
+package similar
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTitleWords(t *testing.T) {
+	got := TitleWords("Fix the crash in Login-page errors (iOS)")
+	want := []string{"fix", "crash", "login", "page", "error", "ios"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TitleWords() = %v, want %v", got, want)
+	}
+
+	if got := TitleWords("Add process flags, boxes, and patches"); !reflect.DeepEqual(got, []string{"add", "process", "flag", "box", "patch"}) {
+		t.Errorf("Expected plural endings trimmed, got %v", got)
+	}
+}
+
+func TestTitleSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{"identical", "Login page crashes", "login page crashes", 1},
+		{"punctuation and plurals", "Login page crash!", "login-pages crashes", 1},
+		{"stop words ignored", "Crash on the login page", "Crash login page", 1},
+		{"partial", "Login page crashes", "Login page is slow", 2 * 2.0 / 6},
+		{"unrelated", "Add dark mode", "Fix login crash", 0},
+		{"empty", "", "Add dark mode", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TitleSimilarity(tt.a, tt.b); got != tt.want {
+				t.Errorf("TitleSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Package splitstate records the progress of a split, so one that failed
+// part way can be resumed without creating any sub-issue twice.
+package splitstate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// Field is a project field set on a sub-issue, by project field name
+type Field struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Task is a sub-issue to create, with everything resolved when the split
+// started, so a resumed split creates what the first run would have
+type Task struct {
+	Text      string   `json:"text"` // The task as given, with annotations
+	Title     string   `json:"title"`
+	Body      string   `json:"body,omitempty"`
+	Labels    []string `json:"labels,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	Fields    []Field  `json:"fields,omitempty"`
+	Issue     int      `json:"issue,omitempty"`  // The created sub-issue; 0 until created
+	Linked    bool     `json:"linked,omitempty"` // Whether Issue is linked under the parent
+}
+
+// State is a split of one parent issue
+type State struct {
+	Parent         string `json:"parent"`         // owner/repo#number
+	Repo           string `json:"repo,omitempty"` // owner/repo the sub-issues go in, when not the parent's
+	StartedAt      string `json:"startedAt"`      // RFC 3339
+	Milestone      string `json:"milestone,omitempty"`
+	ParentEstimate string `json:"parentEstimate,omitempty"` // --parent-estimate, when not keep
+	LinkBody       string `json:"linkBody,omitempty"`       // --link-body mode
+	Tasks          []Task `json:"tasks"`
+}
+
+// DefaultPath returns the state file for splitting owner/repo#number in
+// the user config directory
+func DefaultPath(owner, repo string, number int) (string, error) {
+	return localstore.ConfigPath("split", fmt.Sprintf("%s-%s-%d.json", owner, repo, number))
+}
+
+// Load reads the state file at path. A missing file yields an empty state.
+func Load(path string) (*State, error) {
+	state := &State{}
+	if _, err := localstore.Load(path, "split state", state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// New starts the state of a split of parent
+func New(parent string, tasks []Task, now time.Time) *State {
+	return &State{Parent: parent, StartedAt: now.UTC().Format(time.RFC3339), Tasks: tasks}
+}
+
+// Pending returns the indexes of the tasks not created yet
+func (s *State) Pending() []int {
+	var pending []int
+	for i, task := range s.Tasks {
+		if task.Issue == 0 {
+			pending = append(pending, i)
+		}
+	}
+	return pending
+}
+
+// Unlinked returns the indexes of the tasks created but not yet linked
+// under the parent
+func (s *State) Unlinked() []int {
+	var unlinked []int
+	for i, task := range s.Tasks {
+		if task.Issue != 0 && !task.Linked {
+			unlinked = append(unlinked, i)
+		}
+	}
+	return unlinked
+}
+
+// Complete reports whether every task is created and linked
+func (s *State) Complete() bool {
+	return len(s.Pending()) == 0 && len(s.Unlinked()) == 0
+}
+
+// Created records the sub-issue created for the task at index i
+func (s *State) Created(i, issue int) {
+	s.Tasks[i].Issue = issue
+}
+
+// Linked records that the sub-issue of the task at index i is linked
+// under the parent
+func (s *State) Linked(i int) {
+	s.Tasks[i].Linked = true
+}
+
+// Save writes the state to path, creating parent directories as needed
+func (s *State) Save(path string) error {
+	return localstore.Save(path, "split state", s)
+}
+
+// Clear removes the state file once the split is complete
+func Clear(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove split state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package splitstate
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestState_SaveLoadClear(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "split.json")
+
+	state, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() of missing file error = %v", err)
+	}
+	if len(state.Tasks) != 0 {
+		t.Fatalf("Expected no tasks, got %+v", state)
+	}
+
+	state = New("owner/repo#42", []Task{
+		{Text: "Build [estimate:3]", Title: "Build", Fields: []Field{{Name: "Estimate", Value: "3"}}},
+		{Text: "Test", Title: "Test"},
+		{Text: "Ship", Title: "Ship"},
+	}, time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC))
+	state.Created(0, 101)
+	state.Linked(0)
+	state.Created(2, 103)
+	if err := state.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Parent != "owner/repo#42" || loaded.StartedAt != "2026-10-16T09:00:00Z" || loaded.Tasks[0].Fields[0].Name != "Estimate" {
+		t.Errorf("Unexpected loaded state: %+v", loaded)
+	}
+	if pending := loaded.Pending(); !slices.Equal(pending, []int{1}) {
+		t.Errorf("Pending() = %v, want [1]", pending)
+	}
+	if unlinked := loaded.Unlinked(); !slices.Equal(unlinked, []int{2}) {
+		t.Errorf("Unlinked() = %v, want [2]", unlinked)
+	}
+	if loaded.Complete() {
+		t.Error("Expected the split incomplete")
+	}
+	loaded.Created(1, 102)
+	loaded.Linked(1)
+	loaded.Linked(2)
+	if !loaded.Complete() {
+		t.Errorf("Expected the split complete, got %+v", loaded.Tasks)
+	}
+
+	if err := Clear(path); err != nil {
+		t.Errorf("Clear() error = %v", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("Expected the state file removed")
+	}
+	if err := Clear(path); err != nil {
+		t.Errorf("Clear() of missing file error = %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Expected error for invalid state file")
+	}
+}
@@ -0,0 +1,74 @@
+// Package triage implements the issue query matching shared by the triage
+// command and the public SDK.
+package triage
+
+import (
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// StateForQuery returns the issue state ("open", "closed", or "all") to
+// fetch for a query, based on its is:closed / is:all qualifiers
+func StateForQuery(query string) string {
+	if strings.Contains(query, "is:closed") {
+		return "closed"
+	}
+	if strings.Contains(query, "is:all") {
+		return "all"
+	}
+	return "open"
+}
+
+// Matches reports whether an issue satisfies a triage query.
+// Supports the label:, -label:, is:open and is:closed qualifiers.
+func Matches(issue api.Issue, query string) bool {
+	// Basic query matching - supports common GitHub search qualifiers
+	// This is a simplified version; full implementation would parse the query properly
+
+	// Check for label requirements
+	if strings.Contains(query, "-label:") {
+		// Extract label name after -label:
+		parts := strings.Split(query, "-label:")
+		for _, part := range parts[1:] {
+			labelName := strings.Fields(part)[0]
+			// Check if issue has this label
+			for _, label := range issue.Labels {
+				if label.Name == labelName {
+					return false // Has excluded label
+				}
+			}
+		}
+	}
+
+	if strings.Contains(query, "label:") && !strings.Contains(query, "-label:") {
+		// Must have specified label
+		parts := strings.Split(query, "label:")
+		for _, part := range parts[1:] {
+			if strings.HasPrefix(part, "-") {
+				continue // Skip negations
+			}
+			labelName := strings.Fields(part)[0]
+			found := false
+			for _, label := range issue.Labels {
+				if label.Name == labelName {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+
+	// Check state
+	if strings.Contains(query, "is:open") && issue.State != "OPEN" {
+		return false
+	}
+	if strings.Contains(query, "is:closed") && issue.State != "CLOSED" {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,42 @@
+package triage
+
+import (
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+func TestStateForQuery(t *testing.T) {
+	tests := map[string]string{
+		"is:open label:bug": "open",
+		"is:closed":         "closed",
+		"is:all":            "all",
+		"":                  "open",
+	}
+	for query, want := range tests {
+		if got := StateForQuery(query); got != want {
+			t.Errorf("StateForQuery(%q) = %q, want %q", query, got, want)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	issue := api.Issue{State: "OPEN", Labels: []api.Label{{Name: "bug"}}}
+
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"is:open", true},
+		{"is:closed", false},
+		{"label:bug", true},
+		{"label:feature", false},
+		{"-label:bug", false},
+		{"is:open -label:triaged", true},
+	}
+	for _, tt := range tests {
+		if got := Matches(issue, tt.query); got != tt.want {
+			t.Errorf("Matches(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
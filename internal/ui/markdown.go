@@ -0,0 +1,210 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Block-level markdown patterns
+var (
+	mdComment = regexp.MustCompile(`(?s)<!--.*?-->`)
+	mdHeading = regexp.MustCompile(`^\s{0,3}(#{1,6})\s+(.*?)(?:\s+#+)?\s*$`)
+	mdFence   = regexp.MustCompile("^\\s*(```|~~~)")
+	mdRule    = regexp.MustCompile(`^\s{0,3}(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	mdTask    = regexp.MustCompile(`^(\s*)[-*+]\s+\[([ xX])\]\s+(.*)$`)
+	mdBullet  = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	mdOrdered = regexp.MustCompile(`^(\s*)(\d+[.)])\s+(.*)$`)
+	mdQuote   = regexp.MustCompile(`^\s{0,3}>\s?(.*)$`)
+)
+
+// Inline markdown patterns
+var (
+	mdImage  = regexp.MustCompile(`!\[([^\]]*)\]\(([^)\s]+)[^)]*\)`)
+	mdLink   = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)[^)]*\)`)
+	mdBold   = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	mdItalic = regexp.MustCompile(`\*([^*\s][^*]*)\*|(^|[^\w])_([^_\s][^_]*)_([^\w]|$)`)
+	mdStrike = regexp.MustCompile(`~~([^~]+)~~`)
+)
+
+// MarkdownRenderer renders GitHub-flavored markdown as styled terminal text.
+// With color disabled the structure (bullets, indentation, rules) is kept
+// but no ANSI codes are emitted.
+type MarkdownRenderer struct {
+	width   int // Wrap width; 0 disables wrapping
+	noColor bool
+}
+
+// NewMarkdownRenderer creates a renderer wrapping at width columns
+func NewMarkdownRenderer(width int, noColor bool) *MarkdownRenderer {
+	return &MarkdownRenderer{
+		width:   width,
+		noColor: noColor,
+	}
+}
+
+// color wraps text in ANSI color codes if color is enabled
+func (r *MarkdownRenderer) color(c, text string) string {
+	if r.noColor || text == "" {
+		return text
+	}
+	return c + text + Reset
+}
+
+// Render converts markdown source to terminal text
+func (r *MarkdownRenderer) Render(src string) string {
+	src = strings.ReplaceAll(src, "\r\n", "\n")
+	src = mdComment.ReplaceAllString(src, "")
+
+	var out []string
+	inFence := false
+
+	for _, line := range strings.Split(src, "\n") {
+		if mdFence.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			out = append(out, "    "+r.color(Yellow, line))
+			continue
+		}
+
+		switch {
+		case mdHeading.MatchString(line):
+			m := mdHeading.FindStringSubmatch(line)
+			style := Bold
+			if len(m[1]) <= 2 {
+				style = Bold + Cyan
+			}
+			out = append(out, "", r.color(style, r.inline(m[2])))
+
+		case mdRule.MatchString(line):
+			width := r.width
+			if width <= 0 || width > 40 {
+				width = 40
+			}
+			out = append(out, r.color(Dim, strings.Repeat(BoxHorizontal, width)))
+
+		case mdTask.MatchString(line):
+			m := mdTask.FindStringSubmatch(line)
+			box := "[ ]"
+			if m[2] != " " {
+				box = r.color(Green, "["+SymbolCheck+"]")
+			}
+			out = append(out, r.wrap(r.inline(m[3]), m[1]+box+" ", m[1]+"    ")...)
+
+		case mdBullet.MatchString(line):
+			m := mdBullet.FindStringSubmatch(line)
+			out = append(out, r.wrap(r.inline(m[2]), m[1]+"• ", m[1]+"  ")...)
+
+		case mdOrdered.MatchString(line):
+			m := mdOrdered.FindStringSubmatch(line)
+			out = append(out, r.wrap(r.inline(m[3]), m[1]+m[2]+" ", m[1]+strings.Repeat(" ", len(m[2])+1))...)
+
+		case mdQuote.MatchString(line):
+			m := mdQuote.FindStringSubmatch(line)
+			bar := r.color(Dim, BoxVertical) + " "
+			out = append(out, r.wrap(r.color(Dim, r.inline(m[1])), bar, bar)...)
+
+		default:
+			out = append(out, r.wrap(r.inline(strings.TrimRight(line, " ")), "", "")...)
+		}
+	}
+
+	return collapseBlankLines(out)
+}
+
+// inline applies span-level formatting, leaving code spans untouched
+func (r *MarkdownRenderer) inline(text string) string {
+	parts := strings.Split(text, "`")
+	for i, part := range parts {
+		// Odd segments are code spans, unless the last backtick is unmatched
+		if i%2 == 1 && i < len(parts)-1 {
+			parts[i] = r.color(Yellow, part)
+			continue
+		}
+		if i%2 == 1 {
+			part = "`" + part
+		}
+
+		part = mdImage.ReplaceAllStringFunc(part, func(s string) string {
+			m := mdImage.FindStringSubmatch(s)
+			alt := m[1]
+			if alt == "" {
+				alt = "image"
+			}
+			return r.color(Under+Blue, "Image: "+alt) + " " + r.color(Dim, "("+m[2]+")")
+		})
+		part = mdLink.ReplaceAllStringFunc(part, func(s string) string {
+			m := mdLink.FindStringSubmatch(s)
+			if m[1] == m[2] {
+				return r.color(Under+Blue, m[2])
+			}
+			return r.color(Under+Blue, m[1]) + " " + r.color(Dim, "("+m[2]+")")
+		})
+		part = mdBold.ReplaceAllStringFunc(part, func(s string) string {
+			m := mdBold.FindStringSubmatch(s)
+			return r.color(Bold, m[1]+m[2])
+		})
+		part = mdItalic.ReplaceAllStringFunc(part, func(s string) string {
+			m := mdItalic.FindStringSubmatch(s)
+			if m[1] != "" {
+				return r.color(Italic, m[1])
+			}
+			return m[2] + r.color(Italic, m[3]) + m[4]
+		})
+		part = mdStrike.ReplaceAllStringFunc(part, func(s string) string {
+			return r.color(Strike, mdStrike.FindStringSubmatch(s)[1])
+		})
+		parts[i] = part
+	}
+	return strings.Join(parts, "")
+}
+
+// wrap splits text into lines no wider than the renderer width. The first
+// line starts with firstPrefix and continuation lines with restPrefix.
+func (r *MarkdownRenderer) wrap(text, firstPrefix, restPrefix string) []string {
+	if r.width <= 0 || visibleWidth(firstPrefix+text) <= r.width {
+		return []string{firstPrefix + text}
+	}
+
+	var lines []string
+	line := firstPrefix
+	lineHasWord := false
+
+	for _, word := range strings.Fields(text) {
+		if lineHasWord && visibleWidth(line)+1+visibleWidth(word) > r.width {
+			lines = append(lines, line)
+			line = restPrefix
+			lineHasWord = false
+		}
+		if lineHasWord {
+			line += " "
+		}
+		line += word
+		lineHasWord = true
+	}
+
+	return append(lines, line)
+}
+
+// collapseBlankLines joins lines, dropping leading, trailing, and repeated blank lines
+func collapseBlankLines(lines []string) string {
+	var out []string
+	blank := true // suppress leading blank lines
+	for _, line := range lines {
+		if strings.TrimSpace(stripANSI(line)) == "" {
+			if !blank {
+				out = append(out, "")
+			}
+			blank = true
+			continue
+		}
+		out = append(out, line)
+		blank = false
+	}
+
+	for len(out) > 0 && out[len(out)-1] == "" {
+		out = out[:len(out)-1]
+	}
+	return strings.Join(out, "\n")
+}
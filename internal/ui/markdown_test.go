@@ -0,0 +1,94 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMarkdownRenderer_NoColor(t *testing.T) {
+	src := "<!-- template hint -->\n" +
+		"## Steps\n" +
+		"1. Open **settings**\n" +
+		"2. Click [Save](https://example.com/save)\n" +
+		"\n\n\n" +
+		"- [ ] write tests\n" +
+		"- [x] fix bug\n" +
+		"* plain bullet with `code`\n" +
+		"> quoted _text_\n" +
+		"---\n" +
+		"```go\n" +
+		"fmt.Println(\"**not bold**\")\n" +
+		"```\n" +
+		"![screenshot](https://example.com/a.png)\n"
+
+	got := NewMarkdownRenderer(0, true).Render(src)
+
+	want := "Steps\n" +
+		"1. Open settings\n" +
+		"2. Click Save (https://example.com/save)\n" +
+		"\n" +
+		"[ ] write tests\n" +
+		"[" + SymbolCheck + "] fix bug\n" +
+		"• plain bullet with code\n" +
+		BoxVertical + " quoted text\n" +
+		strings.Repeat(BoxHorizontal, 40) + "\n" +
+		"    fmt.Println(\"**not bold**\")\n" +
+		"Image: screenshot (https://example.com/a.png)"
+
+	if got != want {
+		t.Errorf("Render() =\n%s\n\nwant:\n%s", got, want)
+	}
+	if strings.Contains(got, "\033[") {
+		t.Error("NoColor output should not contain ANSI codes")
+	}
+}
+
+func TestMarkdownRenderer_Color(t *testing.T) {
+	got := NewMarkdownRenderer(0, false).Render("# Title\nSome **bold** and `code` and ~~old~~")
+
+	for _, want := range []string{
+		Bold + Cyan + "Title" + Reset,
+		Bold + "bold" + Reset,
+		Yellow + "code" + Reset,
+		Strike + "old" + Reset,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Expected %q in output, got %q", want, got)
+		}
+	}
+}
+
+func TestMarkdownRenderer_InlineEdgeCases(t *testing.T) {
+	r := NewMarkdownRenderer(0, true)
+
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"snake_case_name stays", "snake_case_name stays"},
+		{"an unmatched ` backtick", "an unmatched ` backtick"},
+		{"`**kept**` in code", "**kept** in code"},
+		{"<https://x.y> and [https://x.y](https://x.y)", "<https://x.y> and https://x.y"},
+		{"*emphasis* here", "emphasis here"},
+	}
+
+	for _, tt := range tests {
+		if got := r.Render(tt.src); got != tt.want {
+			t.Errorf("Render(%q) = %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestMarkdownRenderer_Wrap(t *testing.T) {
+	got := NewMarkdownRenderer(20, true).Render("- one two three four five six seven")
+
+	want := "• one two three four\n  five six seven"
+	if got != want {
+		t.Errorf("Render() = %q, want %q", got, want)
+	}
+	for _, line := range strings.Split(got, "\n") {
+		if visibleWidth(line) > 20 {
+			t.Errorf("Line exceeds width: %q", line)
+		}
+	}
+}
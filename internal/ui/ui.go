@@ -14,6 +14,9 @@ const (
 	Reset   = "\033[0m"
 	Bold    = "\033[1m"
 	Dim     = "\033[2m"
+	Italic  = "\033[3m"
+	Under   = "\033[4m"
+	Strike  = "\033[9m"
 	Red     = "\033[31m"
 	Green   = "\033[32m"
 	Yellow  = "\033[33m"
@@ -0,0 +1,105 @@
+package warehouse
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+)
+
+// Drivers lists the supported warehouse drivers
+var Drivers = []string{"postgres", "sqlite"}
+
+// Command returns the database client that loads SQL from stdin for a
+// driver: psql for postgres and sqlite3 for sqlite. A postgres password is
+// passed to psql in PGPASSWORD rather than on its command line, where
+// other users could see it.
+func Command(driver, dsn string) (*exec.Cmd, error) {
+	var name, password string
+	var args []string
+	switch driver {
+	case "postgres":
+		var conn string
+		conn, password = splitPostgresPassword(dsn)
+		name, args = "psql", []string{conn, "--quiet", "--no-psqlrc", "--set", "ON_ERROR_STOP=1", "--file", "-"}
+	case "sqlite":
+		name, args = "sqlite3", []string{"-bail", dsn}
+	default:
+		return nil, fmt.Errorf("unsupported driver %q: use %s", driver, strings.Join(Drivers, " or "))
+	}
+
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return nil, fmt.Errorf("the %s driver needs %s on your PATH", driver, name)
+	}
+	cmd := exec.Command(path, args...)
+	if password != "" {
+		cmd.Env = append(os.Environ(), "PGPASSWORD="+password)
+	}
+	return cmd, nil
+}
+
+// postgresPasswordPattern matches a password setting in a key/value
+// connection string, quoted or not
+var postgresPasswordPattern = regexp.MustCompile(`(^|\s)password\s*=\s*('(?:[^'\\]|\\.)*'|\S+)`)
+
+// splitPostgresPassword removes the password from a postgres connection
+// string, in either URI or key/value form, and returns it separately
+func splitPostgresPassword(dsn string) (conn, password string) {
+	lower := strings.ToLower(dsn)
+	if strings.HasPrefix(lower, "postgres://") || strings.HasPrefix(lower, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return dsn, ""
+		}
+		if p, ok := u.User.Password(); ok {
+			password = p
+			u.User = url.User(u.User.Username())
+		}
+		if q := u.Query(); q.Has("password") {
+			password = q.Get("password")
+			q.Del("password")
+			u.RawQuery = q.Encode()
+		}
+		return u.String(), password
+	}
+
+	m := postgresPasswordPattern.FindStringSubmatchIndex(dsn)
+	if m == nil {
+		return dsn, ""
+	}
+	password = dsn[m[4]:m[5]]
+	if strings.HasPrefix(password, "'") {
+		password = strings.NewReplacer(`\'`, "'", `\\`, `\`).Replace(password[1 : len(password)-1])
+	}
+	return strings.TrimSpace(dsn[:m[0]] + dsn[m[1]:]), password
+}
+
+// Run loads sql into the warehouse with the driver's client
+func Run(driver, dsn, sql string) error {
+	return RunReader(driver, dsn, strings.NewReader(sql))
+}
+
+// RunReader loads the SQL read from sql, such as a spilled file, into the
+// warehouse with the driver's client
+func RunReader(driver, dsn string, sql io.Reader) error {
+	cmd, err := Command(driver, dsn)
+	if err != nil {
+		return err
+	}
+
+	var stderr bytes.Buffer
+	cmd.Stdin = sql
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("failed to load warehouse: %s", msg)
+		}
+		return fmt.Errorf("failed to load warehouse: %w", err)
+	}
+	return nil
+}
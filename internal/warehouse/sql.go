@@ -0,0 +1,91 @@
+package warehouse
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Schema creates the warehouse tables. It is valid for PostgreSQL and
+// SQLite; timestamps are RFC 3339 text.
+const Schema = `CREATE TABLE IF NOT EXISTS pmu_items (
+  id TEXT PRIMARY KEY,
+  project TEXT NOT NULL,
+  repository TEXT NOT NULL,
+  number INTEGER NOT NULL,
+  title TEXT NOT NULL,
+  state TEXT NOT NULL,
+  url TEXT NOT NULL,
+  synced_at TEXT NOT NULL
+);
+CREATE TABLE IF NOT EXISTS pmu_item_fields (
+  item_id TEXT NOT NULL,
+  field TEXT NOT NULL,
+  value TEXT NOT NULL,
+  PRIMARY KEY (item_id, field)
+);
+CREATE TABLE IF NOT EXISTS pmu_item_events (
+  item_id TEXT NOT NULL,
+  kind TEXT NOT NULL,
+  field TEXT NOT NULL,
+  old_value TEXT NOT NULL,
+  new_value TEXT NOT NULL,
+  observed_at TEXT NOT NULL
+);
+`
+
+// SQL renders the statements that load changes into the warehouse, as one
+// transaction that also creates missing tables
+func SQL(project string, changes Changes, now time.Time) string {
+	var b strings.Builder
+	_ = WriteSQL(&b, project, changes, now)
+	return b.String()
+}
+
+// WriteSQL writes the statements SQL renders to w as they are generated,
+// so a large sync can be spilled to a file instead of held in memory
+func WriteSQL(w io.Writer, project string, changes Changes, now time.Time) error {
+	at := quote(now.UTC().Format(time.RFC3339))
+
+	b := bufio.NewWriter(w)
+	b.WriteString(Schema)
+	b.WriteString("BEGIN;\n")
+
+	for _, item := range changes.Upserts {
+		fmt.Fprintf(b, "INSERT INTO pmu_items (id, project, repository, number, title, state, url, synced_at) VALUES (%s, %s, %s, %d, %s, %s, %s, %s)"+
+			" ON CONFLICT (id) DO UPDATE SET project = excluded.project, repository = excluded.repository, number = excluded.number,"+
+			" title = excluded.title, state = excluded.state, url = excluded.url, synced_at = excluded.synced_at;\n",
+			quote(item.ID), quote(project), quote(item.Repository), item.Number, quote(item.Title), quote(item.State), quote(item.URL), at)
+
+		fmt.Fprintf(b, "DELETE FROM pmu_item_fields WHERE item_id = %s;\n", quote(item.ID))
+		names := make([]string, 0, len(item.Fields))
+		for name := range item.Fields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(b, "INSERT INTO pmu_item_fields (item_id, field, value) VALUES (%s, %s, %s);\n", quote(item.ID), quote(name), quote(item.Fields[name]))
+		}
+	}
+
+	for _, id := range changes.Removed {
+		fmt.Fprintf(b, "DELETE FROM pmu_item_fields WHERE item_id = %s;\n", quote(id))
+		fmt.Fprintf(b, "DELETE FROM pmu_items WHERE id = %s;\n", quote(id))
+	}
+
+	for _, e := range changes.Events {
+		fmt.Fprintf(b, "INSERT INTO pmu_item_events (item_id, kind, field, old_value, new_value, observed_at) VALUES (%s, %s, %s, %s, %s, %s);\n",
+			quote(e.ItemID), quote(e.Kind), quote(e.Field), quote(e.OldValue), quote(e.NewValue), at)
+	}
+
+	b.WriteString("COMMIT;\n")
+	return b.Flush()
+}
+
+// quote renders s as an SQL string literal
+func quote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,163 @@
+// Package warehouse keeps a relational copy of project items for BI tools.
+// It remembers what was last loaded into a warehouse, so each sync only
+// upserts changed items and records their field changes as events.
+package warehouse
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// Item is a project item as stored in the warehouse
+type Item struct {
+	ID         string            `json:"id"`
+	Repository string            `json:"repository"` // owner/repo
+	Number     int               `json:"number"`
+	Title      string            `json:"title"`
+	State      string            `json:"state"`
+	URL        string            `json:"url"`
+	Fields     map[string]string `json:"fields"`
+}
+
+// Event kinds
+const (
+	EventAdded   = "added"
+	EventRemoved = "removed"
+	EventChanged = "changed"
+)
+
+// Event is a change to an item seen between two syncs
+type Event struct {
+	ItemID   string
+	Kind     string // EventAdded, EventRemoved, or EventChanged
+	Field    string // field name, or "state" or "title", for EventChanged
+	OldValue string
+	NewValue string
+}
+
+// Changes is what a sync loads into the warehouse
+type Changes struct {
+	Upserts []Item
+	Removed []string // item IDs
+	Events  []Event
+}
+
+// Empty reports whether there is nothing to load
+func (c Changes) Empty() bool {
+	return len(c.Upserts) == 0 && len(c.Removed) == 0 && len(c.Events) == 0
+}
+
+// State is the set of items last loaded into one warehouse
+type State struct {
+	Project  string          `json:"project"` // owner/number
+	SyncedAt string          `json:"syncedAt"`
+	Items    map[string]Item `json:"items"`
+}
+
+// DefaultPath returns the state file for a project and warehouse in the
+// user cache directory. The DSN is hashed so credentials are not stored.
+func DefaultPath(owner string, number int, driver, dsn string) (string, error) {
+	sum := sha256.Sum256([]byte(driver + "\x00" + dsn))
+	name := fmt.Sprintf("%s-%d-%s.json", owner, number, hex.EncodeToString(sum[:6]))
+	return localstore.CachePath("warehouse", name)
+}
+
+// Load reads the state at path. A missing file yields an empty state.
+func Load(path string) (*State, error) {
+	state := &State{Items: map[string]Item{}}
+	if _, err := localstore.Load(path, "warehouse state", state); err != nil {
+		return nil, err
+	}
+	if state.Items == nil {
+		state.Items = map[string]Item{}
+	}
+	return state, nil
+}
+
+// Save writes the state to path, creating parent directories as needed
+func (s *State) Save(path string) error {
+	return localstore.Save(path, "warehouse state", s)
+}
+
+// Diff compares the current items with the state. New and changed items
+// are upserted; items no longer in the project are removed. Changes to
+// the title, state, and field values are returned as events.
+func (s *State) Diff(items []Item) Changes {
+	var changes Changes
+	seen := map[string]bool{}
+
+	for _, item := range items {
+		seen[item.ID] = true
+		old, found := s.Items[item.ID]
+		if !found {
+			changes.Upserts = append(changes.Upserts, item)
+			changes.Events = append(changes.Events, Event{ItemID: item.ID, Kind: EventAdded})
+			continue
+		}
+
+		events := diffItem(old, item)
+		if len(events) > 0 || old.URL != item.URL || old.Repository != item.Repository || old.Number != item.Number {
+			changes.Upserts = append(changes.Upserts, item)
+		}
+		changes.Events = append(changes.Events, events...)
+	}
+
+	var removed []string
+	for id := range s.Items {
+		if !seen[id] {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(removed)
+	for _, id := range removed {
+		changes.Removed = append(changes.Removed, id)
+		changes.Events = append(changes.Events, Event{ItemID: id, Kind: EventRemoved})
+	}
+
+	return changes
+}
+
+// diffItem returns the changed title, state, and fields of an item
+func diffItem(old, item Item) []Event {
+	var events []Event
+	changed := func(field, from, to string) {
+		if from != to {
+			events = append(events, Event{ItemID: item.ID, Kind: EventChanged, Field: field, OldValue: from, NewValue: to})
+		}
+	}
+
+	changed("title", old.Title, item.Title)
+	changed("state", old.State, item.State)
+
+	names := map[string]bool{}
+	for name := range old.Fields {
+		names[name] = true
+	}
+	for name := range item.Fields {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	for _, name := range sorted {
+		changed(name, old.Fields[name], item.Fields[name])
+	}
+	return events
+}
+
+// Apply records the items as loaded
+func (s *State) Apply(project string, items []Item, now time.Time) {
+	s.Project = project
+	s.SyncedAt = now.UTC().Format(time.RFC3339)
+	s.Items = make(map[string]Item, len(items))
+	for _, item := range items {
+		s.Items[item.ID] = item
+	}
+}
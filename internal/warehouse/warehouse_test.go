@@ -0,0 +1,182 @@
+package warehouse
+
+import (
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testItems() []Item {
+	return []Item{
+		{ID: "I1", Repository: "owner/repo", Number: 1, Title: "Login", State: "OPEN", URL: "u1", Fields: map[string]string{"Status": "Todo", "Priority": "P1"}},
+		{ID: "I2", Repository: "owner/repo", Number: 2, Title: "Search", State: "OPEN", URL: "u2", Fields: map[string]string{"Status": "Done"}},
+	}
+}
+
+func TestState_DiffAndApply(t *testing.T) {
+	state := &State{Items: map[string]Item{}}
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	changes := state.Diff(testItems())
+	if len(changes.Upserts) != 2 || len(changes.Events) != 2 || changes.Events[0].Kind != EventAdded {
+		t.Fatalf("Expected both items added, got %+v", changes)
+	}
+	state.Apply("owner/1", testItems(), now)
+
+	if changes := state.Diff(testItems()); !changes.Empty() {
+		t.Errorf("Expected no changes for the same items, got %+v", changes)
+	}
+
+	// #1 moves and loses its priority, #2 is removed, #3 is new
+	items := testItems()[:1]
+	items[0].Fields = map[string]string{"Status": "In Progress"}
+	items[0].State = "OPEN"
+	items = append(items, Item{ID: "I3", Repository: "owner/repo", Number: 3, Title: "Docs", State: "OPEN"})
+
+	changes = state.Diff(items)
+	var upserted []string
+	for _, item := range changes.Upserts {
+		upserted = append(upserted, item.ID)
+	}
+	if !reflect.DeepEqual(upserted, []string{"I1", "I3"}) || !reflect.DeepEqual(changes.Removed, []string{"I2"}) {
+		t.Errorf("Unexpected upserts %v and removals %v", upserted, changes.Removed)
+	}
+
+	want := []Event{
+		{ItemID: "I1", Kind: EventChanged, Field: "Priority", OldValue: "P1"},
+		{ItemID: "I1", Kind: EventChanged, Field: "Status", OldValue: "Todo", NewValue: "In Progress"},
+		{ItemID: "I3", Kind: EventAdded},
+		{ItemID: "I2", Kind: EventRemoved},
+	}
+	if !reflect.DeepEqual(changes.Events, want) {
+		t.Errorf("Events = %+v, want %+v", changes.Events, want)
+	}
+}
+
+func TestState_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state.json")
+
+	state, err := Load(path)
+	if err != nil || len(state.Items) != 0 {
+		t.Fatalf("Expected an empty state for a missing file, got %+v, %v", state, err)
+	}
+
+	state.Apply("owner/1", testItems(), time.Now())
+	if err := state.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Items, state.Items) || loaded.Project != "owner/1" {
+		t.Errorf("Loaded state differs: %+v", loaded)
+	}
+}
+
+func TestDefaultPath_HidesDSN(t *testing.T) {
+	a, err := DefaultPath("owner", 1, "postgres", "postgres://user:secret@db/bi")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	b, _ := DefaultPath("owner", 1, "postgres", "postgres://user:secret@db/other")
+	if a == b || strings.Contains(a, "secret") {
+		t.Errorf("Expected distinct paths without the DSN, got %s and %s", a, b)
+	}
+}
+
+func TestSQL(t *testing.T) {
+	state := &State{Items: map[string]Item{}}
+	items := testItems()
+	items[0].Title = "Don't crash"
+	sql := SQL("owner/1", state.Diff(items), time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC))
+
+	for _, want := range []string{
+		"CREATE TABLE IF NOT EXISTS pmu_items",
+		"BEGIN;",
+		"'Don''t crash'",
+		"ON CONFLICT (id) DO UPDATE SET",
+		"INSERT INTO pmu_item_fields (item_id, field, value) VALUES ('I1', 'Priority', 'P1');",
+		"'I2', 'added', '', '', '', '2026-03-02T09:00:00Z'",
+		"COMMIT;",
+	} {
+		if !strings.Contains(sql, want) {
+			t.Errorf("Expected SQL to contain %q, got:\n%s", want, sql)
+		}
+	}
+}
+
+func TestWriteSQL_MatchesSQL(t *testing.T) {
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	state := &State{Items: map[string]Item{}}
+	changes := state.Diff(testItems())
+
+	var b strings.Builder
+	if err := WriteSQL(&b, "owner/1", changes, now); err != nil {
+		t.Fatalf("WriteSQL() error = %v", err)
+	}
+	if b.String() != SQL("owner/1", changes, now) {
+		t.Error("Expected WriteSQL to write the same statements as SQL")
+	}
+}
+
+func TestRun_SQLite(t *testing.T) {
+	if _, err := exec.LookPath("sqlite3"); err != nil {
+		t.Skip("sqlite3 not installed")
+	}
+	dsn := filepath.Join(t.TempDir(), "bi.db")
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	state := &State{Items: map[string]Item{}}
+	if err := Run("sqlite", dsn, SQL("owner/1", state.Diff(testItems()), now)); err != nil {
+		t.Fatalf("Initial load failed: %v", err)
+	}
+	state.Apply("owner/1", testItems(), now)
+
+	items := testItems()
+	items[1].Fields["Status"] = "Todo"
+	if err := Run("sqlite", dsn, SQL("owner/1", state.Diff(items), now)); err != nil {
+		t.Fatalf("Incremental load failed: %v", err)
+	}
+
+	out, err := exec.Command("sqlite3", dsn,
+		"SELECT count(*) FROM pmu_items; SELECT value FROM pmu_item_fields WHERE item_id = 'I2'; SELECT count(*) FROM pmu_item_events;").Output()
+	if err != nil {
+		t.Fatalf("Query failed: %v", err)
+	}
+	if got := strings.Fields(string(out)); !reflect.DeepEqual(got, []string{"2", "Todo", "3"}) {
+		t.Errorf("Unexpected warehouse contents: %v", got)
+	}
+
+	if err := Run("sqlite", dsn, "SELECT * FROM missing;"); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("Expected the client's error, got %v", err)
+	}
+}
+
+func TestCommand_UnknownDriver(t *testing.T) {
+	if _, err := Command("mysql", "dsn"); err == nil || !strings.Contains(err.Error(), "postgres or sqlite") {
+		t.Errorf("Expected unsupported driver error, got %v", err)
+	}
+}
+
+func TestSplitPostgresPassword(t *testing.T) {
+	tests := []struct {
+		dsn, conn, password string
+	}{
+		{"postgres://bi:s3cret@db:5432/pm?sslmode=require", "postgres://bi@db:5432/pm?sslmode=require", "s3cret"},
+		{"postgresql://bi@db/pm?password=s3cret", "postgresql://bi@db/pm", "s3cret"},
+		{"postgres://bi@db/pm", "postgres://bi@db/pm", ""},
+		{"host=db password=s3cret dbname=pm", "host=db dbname=pm", "s3cret"},
+		{"password = 'it\\'s secret' host=db", "host=db", "it's secret"},
+		{"host=db dbname=pm", "host=db dbname=pm", ""},
+	}
+	for _, tt := range tests {
+		conn, password := splitPostgresPassword(tt.dsn)
+		if conn != tt.conn || password != tt.password {
+			t.Errorf("splitPostgresPassword(%q) = %q, %q, want %q, %q", tt.dsn, conn, password, tt.conn, tt.password)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Package worklog records time spent on project issues in a local file,
+// for teams that bill or track effort.
+package worklog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/scooter-indie/gh-pmu/internal/localstore"
+)
+
+// Entry is a single block of time logged against an issue
+type Entry struct {
+	ID       int    `json:"id"`
+	Issue    string `json:"issue"` // owner/repo#number
+	Minutes  int    `json:"minutes"`
+	Note     string `json:"note,omitempty"`
+	LoggedAt string `json:"loggedAt"` // RFC 3339
+}
+
+// Log holds the time entries for one project, oldest first
+type Log struct {
+	Project string  `json:"project"` // owner/number
+	NextID  int     `json:"nextId"`
+	Entries []Entry `json:"entries"`
+}
+
+// DefaultPath returns the worklog file for a project in the user config directory
+func DefaultPath(owner string, number int) (string, error) {
+	return localstore.ConfigPath("worklog", localstore.ProjectFile(owner, number))
+}
+
+// Load reads the worklog file at path. A missing file yields an empty log.
+func Load(path string) (*Log, error) {
+	log := &Log{}
+	if _, err := localstore.Load(path, "worklog", log); err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+// Save writes the log to path, creating parent directories as needed.
+// The file is readable only by the current user.
+func (l *Log) Save(path string) error {
+	return localstore.Save(path, "worklog", l)
+}
+
+// Add records time against an issue and returns the new entry
+func (l *Log) Add(issue string, minutes int, note string, now time.Time) Entry {
+	l.NextID++
+	entry := Entry{
+		ID:       l.NextID,
+		Issue:    issue,
+		Minutes:  minutes,
+		Note:     note,
+		LoggedAt: now.UTC().Format(time.RFC3339),
+	}
+	l.Entries = append(l.Entries, entry)
+	return entry
+}
+
+// Since returns the entries logged at or after t; a zero t returns all entries
+func (l *Log) Since(t time.Time) []Entry {
+	if t.IsZero() {
+		return l.Entries
+	}
+	var entries []Entry
+	for _, e := range l.Entries {
+		logged, err := time.Parse(time.RFC3339, e.LoggedAt)
+		if err != nil || logged.Before(t) {
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// Total returns the minutes logged against an issue
+func (l *Log) Total(issue string) int {
+	total := 0
+	for _, e := range l.Entries {
+		if e.Issue == issue {
+			total += e.Minutes
+		}
+	}
+	return total
+}
+
+// ParseDuration parses an amount of time such as 2h, 45m, 1h30m, or 1.5h
+// into whole minutes
+func ParseDuration(s string) (int, error) {
+	d, err := time.ParseDuration(strings.ToLower(strings.TrimSpace(s)))
+	if err != nil || d < time.Minute {
+		return 0, fmt.Errorf("invalid duration %q: expected an amount like 2h, 45m, or 1h30m", s)
+	}
+	return int(d.Round(time.Minute).Minutes()), nil
+}
+
+// FormatMinutes renders minutes compactly (e.g., 45m, 2h, 1h30m)
+func FormatMinutes(minutes int) string {
+	h, m := minutes/60, minutes%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	default:
+		return fmt.Sprintf("%dh%dm", h, m)
+	}
+}
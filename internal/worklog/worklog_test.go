@@ -0,0 +1,91 @@
+package worklog
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{"2h", 120, false},
+		{"45m", 45, false},
+		{"1h30m", 90, false},
+		{"1.5H", 90, false},
+		{"30s", 0, true},
+		{"-1h", 0, true},
+		{"2", 0, true},
+		{"soon", 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseDuration(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseDuration(%q) = %d, want %d", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFormatMinutes(t *testing.T) {
+	for minutes, want := range map[int]string{45: "45m", 120: "2h", 90: "1h30m", 0: "0m"} {
+		if got := FormatMinutes(minutes); got != want {
+			t.Errorf("FormatMinutes(%d) = %q, want %q", minutes, got, want)
+		}
+	}
+}
+
+func TestLog_AddSinceTotal(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	log := &Log{}
+
+	first := log.Add("o/r#1", 120, "debugging", now.AddDate(0, 0, -20))
+	log.Add("o/r#1", 30, "", now.AddDate(0, 0, -1))
+	log.Add("o/r#2", 45, "review", now)
+
+	if first.ID != 1 || first.LoggedAt != "2025-02-18T12:00:00Z" {
+		t.Errorf("Unexpected entry: %+v", first)
+	}
+	if got := log.Total("o/r#1"); got != 150 {
+		t.Errorf("Total() = %d, want 150", got)
+	}
+	if got := log.Since(time.Time{}); len(got) != 3 {
+		t.Errorf("Since(zero) returned %d entries, want 3", len(got))
+	}
+	if got := log.Since(now.AddDate(0, 0, -14)); len(got) != 2 || got[0].Minutes != 30 {
+		t.Errorf("Since(2w) = %+v", got)
+	}
+}
+
+func TestLog_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "worklog.json")
+
+	log, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() of missing file error = %v", err)
+	}
+	if len(log.Entries) != 0 {
+		t.Fatalf("Expected an empty log, got %+v", log.Entries)
+	}
+
+	log.Project = "owner/1"
+	log.Add("o/r#7", 60, "pairing", time.Now())
+	if err := log.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if loaded.Project != "owner/1" || loaded.NextID != 1 || len(loaded.Entries) != 1 || loaded.Entries[0].Note != "pairing" {
+		t.Errorf("Unexpected loaded log: %+v", loaded)
+	}
+}
@@ -0,0 +1,471 @@
+// Package pmu is the programmatic Go API for gh-pmu.
+//
+// It exposes the operations behind the gh pmu CLI - reading project items,
+// updating project fields, navigating sub-issues and running triage rules -
+// so other tools can embed them instead of shelling out to the CLI.
+//
+// A Client is normally created from the .gh-pmu.yml file of a repository:
+//
+//	client, err := pmu.NewFromDir(".")
+//	if err != nil {
+//		return err
+//	}
+//	items, err := client.Items(pmu.ItemFilter{Status: "in_progress"})
+//
+// Field names and values accept the same aliases as the CLI (for example
+// "in_progress" for "In progress") when the config defines them.
+package pmu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/config"
+)
+
+// Config identifies the project and repositories a Client works with
+type Config struct {
+	Owner        string                // Project owner (user or organization login)
+	Number       int                   // Project number
+	Repositories []string              // Repositories in owner/repo format; the first is the default
+	Fields       map[string]FieldAlias // Field aliases keyed by alias (e.g. "status")
+	TriageRules  map[string]TriageRule // Named triage rules
+}
+
+// FieldAlias maps an alias to a GitHub project field name and value aliases
+type FieldAlias struct {
+	Field  string
+	Values map[string]string
+}
+
+// Options configures the underlying GitHub API client
+type Options struct {
+	// Host is the GitHub hostname (default: github.com)
+	Host string
+}
+
+// Project is a GitHub Projects v2 project
+type Project struct {
+	ID     string
+	Number int
+	Title  string
+	URL    string
+	Owner  string
+}
+
+// Issue is a GitHub issue
+type Issue struct {
+	ID         string
+	Number     int
+	Title      string
+	Body       string
+	State      string
+	URL        string
+	Repository string // owner/repo
+	Author     string
+	Assignees  []string
+	Labels     []string
+}
+
+// Item is an issue within the project together with its field values
+type Item struct {
+	ID     string
+	Issue  Issue
+	Fields map[string]string // Field name -> value
+}
+
+// ItemFilter narrows the items returned by Client.Items.
+// Empty fields are ignored; Status and Priority accept config aliases.
+type ItemFilter struct {
+	Repository string
+	Status     string
+	Priority   string
+	Assignee   string
+	Label      string
+}
+
+// backend is the subset of the internal API client used by the SDK
+type backend interface {
+	GetProject(owner string, number int) (*api.Project, error)
+	GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error)
+	GetIssue(owner, repo string, number int) (*api.Issue, error)
+	GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error)
+	GetRepositoryIssues(owner, repo, state string) ([]api.Issue, error)
+	AddIssueToProject(projectID, issueID string) (string, error)
+	AddLabelToIssue(issueID, labelName string) error
+	SetProjectItemField(projectID, itemID, fieldName, value string) error
+}
+
+// Client performs project management operations for one project
+type Client struct {
+	cfg     *config.Config
+	rules   map[string]TriageRule
+	api     backend
+	project *Project
+}
+
+// LoadConfig reads the .gh-pmu.yml file in dir, applies environment
+// overrides and validates it
+func LoadConfig(dir string) (*Config, error) {
+	cfg, err := config.LoadFromDirectory(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.ApplyEnvOverrides()
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	out := &Config{
+		Owner:        cfg.Project.Owner,
+		Number:       cfg.Project.Number,
+		Repositories: cfg.Repositories,
+		Fields:       make(map[string]FieldAlias, len(cfg.Fields)),
+		TriageRules:  make(map[string]TriageRule, len(cfg.Triage)),
+	}
+	for key, f := range cfg.Fields {
+		out.Fields[key] = FieldAlias{Field: f.Field, Values: f.Values}
+	}
+	for name, t := range cfg.Triage {
+		out.TriageRules[name] = TriageRule{
+			Name:   name,
+			Query:  t.Query,
+			Labels: t.Apply.Labels,
+			Fields: t.Apply.Fields,
+		}
+	}
+
+	return out, nil
+}
+
+// NewFromDir creates a Client from the .gh-pmu.yml file in dir
+func NewFromDir(dir string) (*Client, error) {
+	cfg, err := LoadConfig(dir)
+	if err != nil {
+		return nil, err
+	}
+	return New(*cfg, Options{})
+}
+
+// New creates a Client for the given configuration
+func New(cfg Config, opts Options) (*Client, error) {
+	client := api.NewClientWithOptions(api.ClientOptions{
+		Host:             opts.Host,
+		EnableSubIssues:  true,
+		EnableIssueTypes: true,
+	})
+	return newWithBackend(cfg, client)
+}
+
+// newWithBackend creates a Client using the given backend (for testing)
+func newWithBackend(cfg Config, b backend) (*Client, error) {
+	if cfg.Owner == "" {
+		return nil, fmt.Errorf("owner is required")
+	}
+	if cfg.Number == 0 {
+		return nil, fmt.Errorf("project number is required")
+	}
+
+	internal := &config.Config{
+		Project:      config.Project{Owner: cfg.Owner, Number: cfg.Number},
+		Repositories: cfg.Repositories,
+		Fields:       make(map[string]config.Field, len(cfg.Fields)),
+	}
+	for key, f := range cfg.Fields {
+		internal.Fields[key] = config.Field{Field: f.Field, Values: f.Values}
+	}
+
+	return &Client{cfg: internal, rules: cfg.TriageRules, api: b}, nil
+}
+
+// Project returns the configured project. The result is cached.
+func (c *Client) Project() (*Project, error) {
+	if c.project != nil {
+		return c.project, nil
+	}
+
+	p, err := c.api.GetProject(c.cfg.Project.Owner, c.cfg.Project.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	c.project = &Project{
+		ID:     p.ID,
+		Number: p.Number,
+		Title:  p.Title,
+		URL:    p.URL,
+		Owner:  p.Owner.Login,
+	}
+	return c.project, nil
+}
+
+// Items returns the project items matching filter
+func (c *Client) Items(filter ItemFilter) ([]Item, error) {
+	project, err := c.Project()
+	if err != nil {
+		return nil, err
+	}
+
+	var apiFilter *api.ProjectItemsFilter
+	if filter.Repository != "" {
+		apiFilter = &api.ProjectItemsFilter{Repository: filter.Repository}
+	}
+
+	raw, err := c.api.GetProjectItems(project.ID, apiFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	status := ""
+	if filter.Status != "" {
+		status = c.cfg.ResolveFieldValue("status", filter.Status)
+	}
+	priority := ""
+	if filter.Priority != "" {
+		priority = c.cfg.ResolveFieldValue("priority", filter.Priority)
+	}
+
+	var items []Item
+	for _, r := range raw {
+		if r.Issue == nil {
+			continue
+		}
+		item := convertItem(r)
+
+		if status != "" && !strings.EqualFold(item.Field(c.cfg.GetFieldName("status")), status) {
+			continue
+		}
+		if priority != "" && !strings.EqualFold(item.Field(c.cfg.GetFieldName("priority")), priority) {
+			continue
+		}
+		if filter.Assignee != "" && !containsFold(item.Issue.Assignees, filter.Assignee) {
+			continue
+		}
+		if filter.Label != "" && !containsFold(item.Issue.Labels, filter.Label) {
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Field returns the value of a project field by name (case-insensitive)
+func (i Item) Field(name string) string {
+	for k, v := range i.Fields {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return ""
+}
+
+// Item returns the project item for an issue reference ("42", "#42",
+// "owner/repo#42" or an issue URL)
+func (c *Client) Item(ref string) (*Item, error) {
+	owner, repo, number, err := c.resolveRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := c.Items(ItemFilter{Repository: owner + "/" + repo})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range items {
+		if items[i].Issue.Number == number {
+			return &items[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("issue %s/%s#%d is not in the project", owner, repo, number)
+}
+
+// Issue fetches an issue by reference
+func (c *Client) Issue(ref string) (*Issue, error) {
+	owner, repo, number, err := c.resolveRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	issue, err := c.api.GetIssue(owner, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue: %w", err)
+	}
+
+	converted := convertIssue(*issue)
+	return &converted, nil
+}
+
+// SubIssues returns the direct sub-issues of an issue
+func (c *Client) SubIssues(ref string) ([]Issue, error) {
+	owner, repo, number, err := c.resolveRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	subs, err := c.api.GetSubIssues(owner, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sub-issues: %w", err)
+	}
+
+	issues := make([]Issue, 0, len(subs))
+	for _, s := range subs {
+		repoName := s.Repository.Owner + "/" + s.Repository.Name
+		if s.Repository.Owner == "" {
+			repoName = owner + "/" + repo
+		}
+		issues = append(issues, Issue{
+			ID:         s.ID,
+			Number:     s.Number,
+			Title:      s.Title,
+			State:      s.State,
+			URL:        s.URL,
+			Repository: repoName,
+		})
+	}
+	return issues, nil
+}
+
+// SetField sets a project field on an issue's item. field and value accept
+// config aliases (e.g. SetField("#42", "status", "in_progress")).
+func (c *Client) SetField(ref, field, value string) error {
+	item, err := c.Item(ref)
+	if err != nil {
+		return err
+	}
+
+	project, err := c.Project()
+	if err != nil {
+		return err
+	}
+
+	fieldName := c.cfg.GetFieldName(field)
+	resolved := c.cfg.ResolveFieldValue(field, value)
+	if err := c.api.SetProjectItemField(project.ID, item.ID, fieldName, resolved); err != nil {
+		return fmt.Errorf("failed to set %s: %w", fieldName, err)
+	}
+
+	item.Fields[fieldName] = resolved
+	return nil
+}
+
+// resolveRef parses an issue reference, defaulting to the first configured repository
+func (c *Client) resolveRef(ref string) (owner, repo string, number int, err error) {
+	owner, repo, number, err = ParseIssueRef(ref)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	if owner == "" {
+		if len(c.cfg.Repositories) == 0 {
+			return "", "", 0, fmt.Errorf("no repository specified and none configured")
+		}
+		parts := strings.SplitN(c.cfg.Repositories[0], "/", 2)
+		if len(parts) != 2 {
+			return "", "", 0, fmt.Errorf("invalid repository format in config: %s", c.cfg.Repositories[0])
+		}
+		owner, repo = parts[0], parts[1]
+	}
+
+	return owner, repo, number, nil
+}
+
+// ParseIssueRef parses "42", "#42", "owner/repo#42" or a GitHub issue URL.
+// owner and repo are empty when the reference does not include them.
+func ParseIssueRef(ref string) (owner, repo string, number int, err error) {
+	s := strings.TrimSpace(ref)
+
+	for _, prefix := range []string{"https://github.com/", "http://github.com/"} {
+		if strings.HasPrefix(s, prefix) {
+			parts := strings.Split(strings.TrimPrefix(s, prefix), "/")
+			if len(parts) < 4 || parts[2] != "issues" {
+				return "", "", 0, fmt.Errorf("invalid GitHub issue URL: %s", ref)
+			}
+			numStr, _, _ := strings.Cut(parts[3], "#")
+			number, err = parsePositive(numStr)
+			if err != nil {
+				return "", "", 0, err
+			}
+			return parts[0], parts[1], number, nil
+		}
+	}
+
+	if idx := strings.Index(s, "#"); idx > 0 {
+		repoRef := s[:idx]
+		o, r, ok := strings.Cut(repoRef, "/")
+		if !ok || o == "" || r == "" {
+			return "", "", 0, fmt.Errorf("invalid issue reference: %s", ref)
+		}
+		number, err = parsePositive(s[idx+1:])
+		if err != nil {
+			return "", "", 0, err
+		}
+		return o, r, number, nil
+	}
+
+	number, err = parsePositive(strings.TrimPrefix(s, "#"))
+	if err != nil {
+		return "", "", 0, fmt.Errorf("invalid issue reference: %s", ref)
+	}
+	return "", "", number, nil
+}
+
+func parsePositive(s string) (int, error) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid issue number: %s", s)
+	}
+	return n, nil
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func convertIssue(i api.Issue) Issue {
+	out := Issue{
+		ID:        i.ID,
+		Number:    i.Number,
+		Title:     i.Title,
+		Body:      i.Body,
+		State:     i.State,
+		URL:       i.URL,
+		Author:    i.Author.Login,
+		Assignees: make([]string, 0, len(i.Assignees)),
+		Labels:    make([]string, 0, len(i.Labels)),
+	}
+	if i.Repository.Owner != "" {
+		out.Repository = i.Repository.Owner + "/" + i.Repository.Name
+	}
+	for _, a := range i.Assignees {
+		out.Assignees = append(out.Assignees, a.Login)
+	}
+	for _, l := range i.Labels {
+		out.Labels = append(out.Labels, l.Name)
+	}
+	return out
+}
+
+func convertItem(i api.ProjectItem) Item {
+	item := Item{
+		ID:     i.ID,
+		Issue:  convertIssue(*i.Issue),
+		Fields: make(map[string]string, len(i.FieldValues)),
+	}
+	for _, fv := range i.FieldValues {
+		item.Fields[fv.Field] = fv.Value
+	}
+	return item
+}
@@ -0,0 +1,293 @@
+package pmu
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+// mockBackend implements backend for testing
+type mockBackend struct {
+	project      *api.Project
+	projectErr   error
+	projectCalls int
+	items        []api.ProjectItem
+	issue        *api.Issue
+	subIssues    []api.SubIssue
+	repoIssues   map[string][]api.Issue
+	addedItemID  string
+	labelsAdded  []string
+	fieldsSet    map[string]string
+	setFieldErr  error
+}
+
+func (m *mockBackend) GetProject(owner string, number int) (*api.Project, error) {
+	m.projectCalls++
+	return m.project, m.projectErr
+}
+
+func (m *mockBackend) GetProjectItems(projectID string, filter *api.ProjectItemsFilter) ([]api.ProjectItem, error) {
+	if filter == nil || filter.Repository == "" {
+		return m.items, nil
+	}
+	var out []api.ProjectItem
+	for _, item := range m.items {
+		if item.Issue != nil && item.Issue.Repository.Owner+"/"+item.Issue.Repository.Name == filter.Repository {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+func (m *mockBackend) GetIssue(owner, repo string, number int) (*api.Issue, error) {
+	if m.issue == nil {
+		return nil, errors.New("not found")
+	}
+	return m.issue, nil
+}
+
+func (m *mockBackend) GetSubIssues(owner, repo string, number int) ([]api.SubIssue, error) {
+	return m.subIssues, nil
+}
+
+func (m *mockBackend) GetRepositoryIssues(owner, repo, state string) ([]api.Issue, error) {
+	return m.repoIssues[owner+"/"+repo], nil
+}
+
+func (m *mockBackend) AddIssueToProject(projectID, issueID string) (string, error) {
+	return m.addedItemID, nil
+}
+
+func (m *mockBackend) AddLabelToIssue(issueID, labelName string) error {
+	m.labelsAdded = append(m.labelsAdded, labelName)
+	return nil
+}
+
+func (m *mockBackend) SetProjectItemField(projectID, itemID, fieldName, value string) error {
+	if m.setFieldErr != nil {
+		return m.setFieldErr
+	}
+	if m.fieldsSet == nil {
+		m.fieldsSet = make(map[string]string)
+	}
+	m.fieldsSet[itemID+"/"+fieldName] = value
+	return nil
+}
+
+func testConfig() Config {
+	return Config{
+		Owner:        "owner",
+		Number:       1,
+		Repositories: []string{"owner/repo"},
+		Fields: map[string]FieldAlias{
+			"status": {Field: "Status", Values: map[string]string{"in_progress": "In progress"}},
+		},
+		TriageRules: map[string]TriageRule{
+			"new": {Query: "is:open -label:triaged", Labels: []string{"triaged"}, Fields: map[string]string{"status": "in_progress"}},
+		},
+	}
+}
+
+func testBackend() *mockBackend {
+	repo := api.Repository{Owner: "owner", Name: "repo"}
+	return &mockBackend{
+		project: &api.Project{ID: "PVT_1", Number: 1, Title: "Board", Owner: api.ProjectOwner{Login: "owner"}},
+		items: []api.ProjectItem{
+			{
+				ID:          "PVTI_1",
+				Issue:       &api.Issue{ID: "I_1", Number: 1, Title: "One", Repository: repo, Labels: []api.Label{{Name: "bug"}}},
+				FieldValues: []api.FieldValue{{Field: "Status", Value: "In progress"}},
+			},
+			{
+				ID:          "PVTI_2",
+				Issue:       &api.Issue{ID: "I_2", Number: 2, Title: "Two", Repository: repo},
+				FieldValues: []api.FieldValue{{Field: "Status", Value: "Done"}},
+			},
+			{ID: "PVTI_draft"},
+		},
+		addedItemID: "PVTI_new",
+	}
+}
+
+func TestNew_RequiresOwnerAndNumber(t *testing.T) {
+	if _, err := newWithBackend(Config{Number: 1}, testBackend()); err == nil {
+		t.Error("Expected error for missing owner")
+	}
+	if _, err := newWithBackend(Config{Owner: "owner"}, testBackend()); err == nil {
+		t.Error("Expected error for missing number")
+	}
+}
+
+func TestClient_Project_IsCached(t *testing.T) {
+	b := testBackend()
+	c, _ := newWithBackend(testConfig(), b)
+
+	for i := 0; i < 2; i++ {
+		p, err := c.Project()
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if p.ID != "PVT_1" || p.Owner != "owner" {
+			t.Errorf("Unexpected project: %+v", p)
+		}
+	}
+	if b.projectCalls != 1 {
+		t.Errorf("Expected 1 GetProject call, got %d", b.projectCalls)
+	}
+}
+
+func TestClient_Project_Error(t *testing.T) {
+	b := testBackend()
+	b.projectErr = errors.New("boom")
+	c, _ := newWithBackend(testConfig(), b)
+
+	if _, err := c.Project(); err == nil || !strings.Contains(err.Error(), "failed to get project") {
+		t.Errorf("Expected wrapped error, got %v", err)
+	}
+}
+
+func TestClient_Items_FiltersWithAliases(t *testing.T) {
+	c, _ := newWithBackend(testConfig(), testBackend())
+
+	items, err := c.Items(ItemFilter{Status: "in_progress"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(items) != 1 || items[0].Issue.Number != 1 {
+		t.Fatalf("Expected only issue #1, got %+v", items)
+	}
+	if items[0].Field("status") != "In progress" {
+		t.Errorf("Expected case-insensitive field lookup, got %q", items[0].Field("status"))
+	}
+	if items[0].Issue.Repository != "owner/repo" || items[0].Issue.Labels[0] != "bug" {
+		t.Errorf("Unexpected issue conversion: %+v", items[0].Issue)
+	}
+
+	all, _ := c.Items(ItemFilter{})
+	if len(all) != 2 {
+		t.Errorf("Expected draft items to be skipped, got %d items", len(all))
+	}
+
+	labeled, _ := c.Items(ItemFilter{Label: "BUG"})
+	if len(labeled) != 1 {
+		t.Errorf("Expected 1 item with label bug, got %d", len(labeled))
+	}
+}
+
+func TestClient_SetField_ResolvesAliases(t *testing.T) {
+	b := testBackend()
+	c, _ := newWithBackend(testConfig(), b)
+
+	if err := c.SetField("#2", "status", "in_progress"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := b.fieldsSet["PVTI_2/Status"]; got != "In progress" {
+		t.Errorf("Expected Status=In progress on PVTI_2, got %q", got)
+	}
+}
+
+func TestClient_SetField_NotInProject(t *testing.T) {
+	c, _ := newWithBackend(testConfig(), testBackend())
+
+	err := c.SetField("owner/repo#99", "status", "done")
+	if err == nil || !strings.Contains(err.Error(), "not in the project") {
+		t.Errorf("Expected not in project error, got %v", err)
+	}
+}
+
+func TestClient_SubIssues_DefaultsRepository(t *testing.T) {
+	b := testBackend()
+	b.subIssues = []api.SubIssue{
+		{ID: "I_3", Number: 3, Title: "Child"},
+		{ID: "I_4", Number: 4, Title: "Other", Repository: api.Repository{Owner: "owner", Name: "other"}},
+	}
+	c, _ := newWithBackend(testConfig(), b)
+
+	subs, err := c.SubIssues("1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if subs[0].Repository != "owner/repo" || subs[1].Repository != "owner/other" {
+		t.Errorf("Unexpected repositories: %q, %q", subs[0].Repository, subs[1].Repository)
+	}
+}
+
+func TestParseIssueRef(t *testing.T) {
+	tests := []struct {
+		ref                 string
+		wantOwner, wantRepo string
+		wantNumber          int
+		wantErr             bool
+	}{
+		{ref: "42", wantNumber: 42},
+		{ref: "#42", wantNumber: 42},
+		{ref: "owner/repo#42", wantOwner: "owner", wantRepo: "repo", wantNumber: 42},
+		{ref: "https://github.com/owner/repo/issues/42", wantOwner: "owner", wantRepo: "repo", wantNumber: 42},
+		{ref: "https://github.com/owner/repo/pull/42", wantErr: true},
+		{ref: "repo#42", wantErr: true},
+		{ref: "abc", wantErr: true},
+		{ref: "0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			owner, repo, number, err := ParseIssueRef(tt.ref)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error for %q", tt.ref)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if owner != tt.wantOwner || repo != tt.wantRepo || number != tt.wantNumber {
+				t.Errorf("ParseIssueRef(%q) = %q, %q, %d", tt.ref, owner, repo, number)
+			}
+		})
+	}
+}
+
+func TestLoadConfig_ConvertsTriageRules(t *testing.T) {
+	dir := t.TempDir()
+	content := `project:
+  owner: owner
+  number: 1
+repositories:
+  - owner/repo
+fields:
+  status:
+    field: Status
+    values:
+      backlog: Backlog
+triage:
+  new:
+    query: "is:open -label:triaged"
+    apply:
+      labels: [triaged]
+      fields:
+        status: backlog
+`
+	if err := os.WriteFile(filepath.Join(dir, ".gh-pmu.yml"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	cfg, err := LoadConfig(dir)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if cfg.Owner != "owner" || cfg.Number != 1 {
+		t.Errorf("Unexpected project: %s/%d", cfg.Owner, cfg.Number)
+	}
+	rule := cfg.TriageRules["new"]
+	if rule.Name != "new" || rule.Labels[0] != "triaged" || rule.Fields["status"] != "backlog" {
+		t.Errorf("Unexpected triage rule: %+v", rule)
+	}
+	if cfg.Fields["status"].Values["backlog"] != "Backlog" {
+		t.Errorf("Unexpected field aliases: %+v", cfg.Fields)
+	}
+}
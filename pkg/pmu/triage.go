@@ -0,0 +1,112 @@
+package pmu
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+	"github.com/scooter-indie/gh-pmu/internal/triage"
+)
+
+// TriageRule is a query plus the labels and fields applied to matching issues
+type TriageRule struct {
+	Name   string
+	Query  string            // e.g. "is:open -label:triaged"
+	Labels []string          // Labels to add
+	Fields map[string]string // Field aliases or names -> values
+}
+
+// TriageRule returns a named rule from the configuration
+func (c *Client) TriageRule(name string) (TriageRule, error) {
+	rule, ok := c.rules[name]
+	if !ok {
+		return TriageRule{}, fmt.Errorf("triage rule %q not found", name)
+	}
+	if rule.Name == "" {
+		rule.Name = name
+	}
+	return rule, nil
+}
+
+// FindTriageMatches returns open or closed issues (per the rule's query)
+// that match the rule. repo limits the search to one repository in
+// owner/repo format; empty searches all configured repositories.
+func (c *Client) FindTriageMatches(rule TriageRule, repo string) ([]Issue, error) {
+	repos := c.cfg.Repositories
+	if repo != "" {
+		if !strings.Contains(repo, "/") {
+			return nil, fmt.Errorf("invalid repository format %q: expected owner/repo", repo)
+		}
+		repos = []string{repo}
+	}
+
+	state := triage.StateForQuery(rule.Query)
+
+	var matches []Issue
+	for _, fullName := range repos {
+		owner, name, ok := strings.Cut(fullName, "/")
+		if !ok {
+			continue
+		}
+
+		issues, err := c.api.GetRepositoryIssues(owner, name, state)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get issues for %s: %w", fullName, err)
+		}
+
+		for _, issue := range issues {
+			if !triage.Matches(issue, rule.Query) {
+				continue
+			}
+			converted := convertIssue(issue)
+			if converted.Repository == "" {
+				converted.Repository = fullName
+			}
+			matches = append(matches, converted)
+		}
+	}
+
+	return matches, nil
+}
+
+// ApplyTriage adds the issue to the project and applies the rule's labels
+// and fields. Label failures are ignored, as the label may already be present.
+func (c *Client) ApplyTriage(rule TriageRule, issue Issue) error {
+	if issue.ID == "" {
+		return fmt.Errorf("issue #%d has no node ID", issue.Number)
+	}
+
+	project, err := c.Project()
+	if err != nil {
+		return err
+	}
+
+	itemID, err := c.api.AddIssueToProject(project.ID, issue.ID)
+	if err != nil {
+		return fmt.Errorf("failed to add issue to project: %w", err)
+	}
+
+	for _, label := range rule.Labels {
+		_ = c.api.AddLabelToIssue(issue.ID, label)
+	}
+
+	for field, value := range rule.Fields {
+		fieldName := c.cfg.GetFieldName(field)
+		resolved := c.cfg.ResolveFieldValue(field, value)
+		if err := c.api.SetProjectItemField(project.ID, itemID, fieldName, resolved); err != nil {
+			return fmt.Errorf("failed to set %s: %w", field, err)
+		}
+	}
+
+	return nil
+}
+
+// MatchesTriageQuery reports whether an issue satisfies a triage query.
+// Supports the label:, -label:, is:open and is:closed qualifiers.
+func MatchesTriageQuery(issue Issue, query string) bool {
+	labels := make([]api.Label, 0, len(issue.Labels))
+	for _, l := range issue.Labels {
+		labels = append(labels, api.Label{Name: l})
+	}
+	return triage.Matches(api.Issue{State: issue.State, Labels: labels}, query)
+}
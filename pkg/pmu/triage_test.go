@@ -0,0 +1,98 @@
+package pmu
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/scooter-indie/gh-pmu/internal/api"
+)
+
+func TestClient_TriageRule(t *testing.T) {
+	c, _ := newWithBackend(testConfig(), testBackend())
+
+	rule, err := c.TriageRule("new")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if rule.Name != "new" {
+		t.Errorf("Expected rule name to default to key, got %q", rule.Name)
+	}
+
+	if _, err := c.TriageRule("missing"); err == nil {
+		t.Error("Expected error for unknown rule")
+	}
+}
+
+func TestClient_FindTriageMatches(t *testing.T) {
+	b := testBackend()
+	b.repoIssues = map[string][]api.Issue{
+		"owner/repo": {
+			{ID: "I_1", Number: 1, State: "OPEN"},
+			{ID: "I_2", Number: 2, State: "OPEN", Labels: []api.Label{{Name: "triaged"}}},
+		},
+	}
+	c, _ := newWithBackend(testConfig(), b)
+	rule, _ := c.TriageRule("new")
+
+	matches, err := c.FindTriageMatches(rule, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(matches) != 1 || matches[0].Number != 1 {
+		t.Fatalf("Expected only issue #1, got %+v", matches)
+	}
+	if matches[0].Repository != "owner/repo" {
+		t.Errorf("Expected repository to default to searched repo, got %q", matches[0].Repository)
+	}
+
+	if _, err := c.FindTriageMatches(rule, "norepo"); err == nil {
+		t.Error("Expected error for invalid repository")
+	}
+}
+
+func TestClient_ApplyTriage(t *testing.T) {
+	b := testBackend()
+	c, _ := newWithBackend(testConfig(), b)
+	rule, _ := c.TriageRule("new")
+
+	if err := c.ApplyTriage(rule, Issue{ID: "I_9", Number: 9}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(b.labelsAdded) != 1 || b.labelsAdded[0] != "triaged" {
+		t.Errorf("Expected triaged label, got %v", b.labelsAdded)
+	}
+	if b.fieldsSet["PVTI_new/Status"] != "In progress" {
+		t.Errorf("Expected resolved Status on new item, got %v", b.fieldsSet)
+	}
+}
+
+func TestClient_ApplyTriage_Errors(t *testing.T) {
+	b := testBackend()
+	c, _ := newWithBackend(testConfig(), b)
+	rule, _ := c.TriageRule("new")
+
+	if err := c.ApplyTriage(rule, Issue{Number: 9}); err == nil {
+		t.Error("Expected error for issue without node ID")
+	}
+
+	b.setFieldErr = errors.New("boom")
+	err := c.ApplyTriage(rule, Issue{ID: "I_9", Number: 9})
+	if err == nil || !strings.Contains(err.Error(), "failed to set status") {
+		t.Errorf("Expected field error, got %v", err)
+	}
+}
+
+func TestMatchesTriageQuery(t *testing.T) {
+	issue := Issue{State: "OPEN", Labels: []string{"bug"}}
+
+	if !MatchesTriageQuery(issue, "is:open label:bug") {
+		t.Error("Expected match for open bug")
+	}
+	if MatchesTriageQuery(issue, "is:open -label:bug") {
+		t.Error("Expected no match when label is excluded")
+	}
+	if MatchesTriageQuery(issue, "is:closed") {
+		t.Error("Expected no match for closed query")
+	}
+}